@@ -41,6 +41,20 @@ const (
 	LoadBalancerSchemeInternetFacing LoadBalancerScheme = "internet-facing"
 )
 
+// +kubebuilder:validation:Enum=Delete;Retain;Protect
+// DeletionPolicy is the policy applied to the load balancer once it's no longer needed.
+//
+// * Delete deletes the load balancer along with its resources.
+// * Retain leaves the load balancer in place, and stops tracking it.
+// * Protect enables deletion protection of the load balancer, and refuses to delete it while deletion protection is enabled.
+type DeletionPolicy string
+
+const (
+	DeletionPolicyDelete  DeletionPolicy = "Delete"
+	DeletionPolicyRetain  DeletionPolicy = "Retain"
+	DeletionPolicyProtect DeletionPolicy = "Protect"
+)
+
 // IngressGroup defines IngressGroup configuration.
 type IngressGroup struct {
 	// Name is the name of IngressGroup.
@@ -56,6 +70,67 @@ type Tag struct {
 	Value string `json:"value"`
 }
 
+// Attribute defines a AWS attribute on resources.
+type Attribute struct {
+	// The key of the attribute.
+	Key string `json:"key"`
+
+	// The value of the attribute.
+	Value string `json:"value"`
+}
+
+// AccessLogs defines the access logs configuration of load balancer.
+type AccessLogs struct {
+	// S3Bucket is the name of the S3 bucket for access logs.
+	S3Bucket string `json:"s3Bucket"`
+
+	// S3Prefix is the prefix for access logs within the S3 bucket.
+	// +optional
+	S3Prefix string `json:"s3Prefix,omitempty"`
+}
+
+// AdoptLoadBalancer defines an existing load balancer to adopt.
+type AdoptLoadBalancer struct {
+	// ARN is the ARN of the existing load balancer.
+	ARN string `json:"arn"`
+
+	// PreserveUnmanagedListeners defines whether listeners on the load balancer that are not managed by controller are left alone.
+	// * if absent, it defaults to true, and such listeners are left alone. Set it to false to have them deleted.
+	// +optional
+	PreserveUnmanagedListeners *bool `json:"preserveUnmanagedListeners,omitempty"`
+}
+
+// SubnetDiscovery defines the configuration to auto-discover subnets.
+type SubnetDiscovery struct {
+	// Tags defines additional tags that subnets must have to be discovered.
+	// * if the values of a tag are empty, subnets with the tag key are matched regardless of its value.
+	// +optional
+	Tags map[string][]string `json:"tags,omitempty"`
+
+	// MinAvailableIPAddresses defines the minimal count of available IP addresses for subnets to be discovered.
+	// * if absent, it defaults to 8.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinAvailableIPAddresses *int64 `json:"minAvailableIPAddresses,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=subnets;securityGroups;sslPolicy;certificateARNs;inboundCIDRs;targetType;loadBalancerAttributes;wafv2ACLARN;accessLogs;deletionPolicy
+// IngressClassParamsField is the name of a IngressClassParams field that can be overridden by Ingress annotations.
+type IngressClassParamsField string
+
+const (
+	IngressClassParamsFieldSubnets                IngressClassParamsField = "subnets"
+	IngressClassParamsFieldSecurityGroups         IngressClassParamsField = "securityGroups"
+	IngressClassParamsFieldSSLPolicy              IngressClassParamsField = "sslPolicy"
+	IngressClassParamsFieldCertificateARNs        IngressClassParamsField = "certificateARNs"
+	IngressClassParamsFieldInboundCIDRs           IngressClassParamsField = "inboundCIDRs"
+	IngressClassParamsFieldTargetType             IngressClassParamsField = "targetType"
+	IngressClassParamsFieldLoadBalancerAttributes IngressClassParamsField = "loadBalancerAttributes"
+	IngressClassParamsFieldWAFv2ACLARN            IngressClassParamsField = "wafv2ACLARN"
+	IngressClassParamsFieldAccessLogs             IngressClassParamsField = "accessLogs"
+	IngressClassParamsFieldDeletionPolicy         IngressClassParamsField = "deletionPolicy"
+)
+
 // IngressClassParamsSpec defines the desired state of IngressClassParams
 type IngressClassParamsSpec struct {
 	// NamespaceSelector restrict the namespaces of Ingresses that are allowed to specify the IngressClass with this IngressClassParams.
@@ -77,6 +152,61 @@ type IngressClassParamsSpec struct {
 
 	// Tags defines list of Tags on AWS resources provisioned for Ingresses that belong to IngressClass with this IngressClassParams.
 	Tags []Tag `json:"tags,omitempty"`
+
+	// Subnets defines the subnets(ID or Name) for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetDiscovery defines how subnets are auto-discovered for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// It only applies when subnets are not specified explicitly.
+	// +optional
+	SubnetDiscovery *SubnetDiscovery `json:"subnetDiscovery,omitempty"`
+
+	// SecurityGroups defines the securityGroups(ID or Name) for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// SSLPolicy defines the SSL policy of HTTPS listeners for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// CertificateARNs defines the certificates of HTTPS listeners for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	CertificateARNs []string `json:"certificateARNs,omitempty"`
+
+	// InboundCIDRs defines the CIDRs that are allowed to access the load balancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	InboundCIDRs []string `json:"inboundCIDRs,omitempty"`
+
+	// TargetType defines the targetType of TargetGroups for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	TargetType *TargetType `json:"targetType,omitempty"`
+
+	// LoadBalancerAttributes defines the attributes of load balancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// WAFv2ACLARN defines the WAFv2 WebACL associated with load balancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	WAFv2ACLARN *string `json:"wafv2ACLARN,omitempty"`
+
+	// AccessLogs defines the access logs configuration of load balancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	AccessLogs *AccessLogs `json:"accessLogs,omitempty"`
+
+	// DeletionPolicy defines the policy applied to load balancer once all Ingresses that belong to IngressClass with this IngressClassParams are deleted.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AdoptLoadBalancer defines an existing load balancer to adopt for all Ingresses that belong to IngressClass with this IngressClassParams, instead of creating a new one.
+	// +optional
+	AdoptLoadBalancer *AdoptLoadBalancer `json:"adoptLoadBalancer,omitempty"`
+
+	// AnnotationOverrides defines the fields of this IngressClassParams that can be overridden by annotations on Ingresses.
+	// * fields not listed here are enforced, the corresponding annotations on Ingresses are ignored.
+	// * fields listed here are used as defaults, when the corresponding annotations are absent on Ingresses.
+	// +optional
+	AnnotationOverrides []IngressClassParamsField `json:"annotationOverrides,omitempty"`
 }
 
 // +kubebuilder:object:root=true
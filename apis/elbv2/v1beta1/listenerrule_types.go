@@ -0,0 +1,93 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListenerRuleSpec defines the desired state of ListenerRule
+type ListenerRuleSpec struct {
+	// listenerARN is the ARN of an existing listener on an Application Load Balancer that is managed outside of this controller.
+	// Only the rule created for this ListenerRule is modified on the listener.
+	// +kubebuilder:validation:MinLength=1
+	ListenerARN string `json:"listenerARN"`
+
+	// conditions are the routing conditions of the rule.
+	// +kubebuilder:validation:MinItems=1
+	Conditions []RuleCondition `json:"conditions"`
+
+	// action is the action performed for requests matching conditions.
+	// Target groups in forward action can reference Services in the same namespace by serviceName and servicePort,
+	// a TargetGroup and TargetGroupBinding will be created for each referenced Service port.
+	Action RuleAction `json:"action"`
+
+	// targetType is the TargetType of TargetGroups created for referenced Services. Defaults to instance.
+	// +optional
+	TargetType *TargetType `json:"targetType,omitempty"`
+
+	// tags are the AWS tags applied to the rule and TargetGroups created for this ListenerRule.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ListenerRuleStatus defines the observed state of ListenerRule
+type ListenerRuleStatus struct {
+	// The generation observed by the ListenerRule controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// listenerARN is the ARN of listener the rule is currently attached to.
+	// +optional
+	ListenerARN *string `json:"listenerARN,omitempty"`
+
+	// ruleARN is the ARN of rule created for this ListenerRule.
+	// +optional
+	RuleARN *string `json:"ruleARN,omitempty"`
+
+	// priority is the priority allocated to the rule.
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="ACTION-TYPE",type="string",JSONPath=".spec.action.type",description="The type of action"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".status.priority",description="The priority allocated to the rule"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// ListenerRule is the Schema for the ListenerRule API
+type ListenerRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerRuleSpec   `json:"spec,omitempty"`
+	Status ListenerRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerRuleList contains a list of ListenerRule
+type ListenerRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ListenerRule{}, &ListenerRuleList{})
+}
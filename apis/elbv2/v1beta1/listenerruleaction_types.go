@@ -0,0 +1,360 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// NOTE: the action and condition types below mirror the JSON schema of the `actions.${action-name}` and
+// `conditions.${conditions-name}` Ingress annotations, so that the same configuration can be moved between them.
+
+// +kubebuilder:validation:Enum=fixed-response;forward;redirect
+// RuleActionType is the type of action.
+type RuleActionType string
+
+const (
+	RuleActionTypeFixedResponse RuleActionType = "fixed-response"
+	RuleActionTypeForward       RuleActionType = "forward"
+	RuleActionTypeRedirect      RuleActionType = "redirect"
+)
+
+// FixedResponseActionConfig defines an action that returns a custom HTTP response.
+type FixedResponseActionConfig struct {
+	// The content type.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The message.
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+
+	// The HTTP response code.
+	StatusCode string `json:"statusCode"`
+}
+
+// RedirectActionConfig defines a redirect action.
+type RedirectActionConfig struct {
+	// The hostname.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// The absolute path.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The port.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// The protocol.
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The query parameters
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// The HTTP redirect code.
+	StatusCode string `json:"statusCode"`
+}
+
+// TargetGroupTuple defines how traffic will be distributed to a target group in a forward action.
+type TargetGroupTuple struct {
+	// The Amazon Resource Name (ARN) of the target group.
+	// If specified, none of serviceName and servicePort can be set.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupARN,omitempty"`
+
+	// the K8s service Name in the same namespace.
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// the K8s service port
+	// +optional
+	ServicePort *intstr.IntOrString `json:"servicePort,omitempty"`
+
+	// The weight.
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// TargetGroupStickinessConfig defines the target group stickiness for a forward action.
+type TargetGroupStickinessConfig struct {
+	// Indicates whether target group stickiness is enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The time period, in seconds, during which requests from a client should be routed to the same target group.
+	// +optional
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+}
+
+// ForwardActionConfig defines a forward action.
+type ForwardActionConfig struct {
+	// One or more target groups.
+	// +kubebuilder:validation:MinItems=1
+	TargetGroups []TargetGroupTuple `json:"targetGroups"`
+
+	// The target group stickiness for the rule.
+	// +optional
+	TargetGroupStickinessConfig *TargetGroupStickinessConfig `json:"targetGroupStickinessConfig,omitempty"`
+}
+
+// RuleAction defines an action for a listener rule.
+type RuleAction struct {
+	// The type of action.
+	Type RuleActionType `json:"type"`
+
+	// The Amazon Resource Name (ARN) of the target group. Specify only when Type
+	// is forward and you want to route to a single target group. To route to one
+	// or more target groups, use ForwardConfig instead.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupARN,omitempty"`
+
+	// Information for creating an action that returns a custom HTTP response.
+	// +optional
+	FixedResponseConfig *FixedResponseActionConfig `json:"fixedResponseConfig,omitempty"`
+
+	// Information for creating a redirect action.
+	// +optional
+	RedirectConfig *RedirectActionConfig `json:"redirectConfig,omitempty"`
+
+	// Information for creating an action that distributes requests among one or more target groups.
+	// +optional
+	ForwardConfig *ForwardActionConfig `json:"forwardConfig,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=http-header;http-request-method;host-header;path-pattern;query-string;source-ip
+// RuleConditionField is the field in the HTTP request of a rule condition.
+type RuleConditionField string
+
+const (
+	RuleConditionFieldHTTPHeader        RuleConditionField = "http-header"
+	RuleConditionFieldHTTPRequestMethod RuleConditionField = "http-request-method"
+	RuleConditionFieldHostHeader        RuleConditionField = "host-header"
+	RuleConditionFieldPathPattern       RuleConditionField = "path-pattern"
+	RuleConditionFieldQueryString       RuleConditionField = "query-string"
+	RuleConditionFieldSourceIP          RuleConditionField = "source-ip"
+)
+
+// HostHeaderConditionConfig defines a host header condition.
+type HostHeaderConditionConfig struct {
+	// One or more host names.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// HTTPHeaderConditionConfig defines an HTTP header condition.
+type HTTPHeaderConditionConfig struct {
+	// The name of the HTTP header field.
+	HTTPHeaderName string `json:"httpHeaderName"`
+
+	// One or more strings to compare against the value of the HTTP header.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// HTTPRequestMethodConditionConfig defines an HTTP method condition.
+type HTTPRequestMethodConditionConfig struct {
+	// The name of the request method.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// PathPatternConditionConfig defines a path pattern condition.
+type PathPatternConditionConfig struct {
+	// One or more path patterns to compare against the request URL.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// QueryStringKeyValuePair defines a key/value pair.
+type QueryStringKeyValuePair struct {
+	// The key.
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// The value.
+	Value string `json:"value"`
+}
+
+// QueryStringConditionConfig defines a query string condition.
+type QueryStringConditionConfig struct {
+	// One or more key/value pairs or values to find in the query string.
+	// +kubebuilder:validation:MinItems=1
+	Values []QueryStringKeyValuePair `json:"values"`
+}
+
+// SourceIPConditionConfig defines a source IP condition.
+type SourceIPConditionConfig struct {
+	// One or more source IP addresses, in CIDR format.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// RuleCondition defines a condition for a listener rule.
+type RuleCondition struct {
+	// The field in the HTTP request.
+	Field RuleConditionField `json:"field"`
+
+	// Information for a host header condition.
+	// +optional
+	HostHeaderConfig *HostHeaderConditionConfig `json:"hostHeaderConfig,omitempty"`
+
+	// Information for an HTTP header condition.
+	// +optional
+	HTTPHeaderConfig *HTTPHeaderConditionConfig `json:"httpHeaderConfig,omitempty"`
+
+	// Information for an HTTP method condition.
+	// +optional
+	HTTPRequestMethodConfig *HTTPRequestMethodConditionConfig `json:"httpRequestMethodConfig,omitempty"`
+
+	// Information for a path pattern condition.
+	// +optional
+	PathPatternConfig *PathPatternConditionConfig `json:"pathPatternConfig,omitempty"`
+
+	// Information for a query string condition.
+	// +optional
+	QueryStringConfig *QueryStringConditionConfig `json:"queryStringConfig,omitempty"`
+
+	// Information for a source IP condition.
+	// +optional
+	SourceIPConfig *SourceIPConditionConfig `json:"sourceIPConfig,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=cognito;oidc
+// AuthenticationType is the type of authentication.
+type AuthenticationType string
+
+const (
+	AuthenticationTypeCognito AuthenticationType = "cognito"
+	AuthenticationTypeOIDC    AuthenticationType = "oidc"
+)
+
+// AuthIDPConfigCognito defines the Amazon Cognito identity provider.
+type AuthIDPConfigCognito struct {
+	// The Amazon Resource Name (ARN) of the Amazon Cognito user pool.
+	UserPoolARN string `json:"userPoolARN"`
+
+	// The ID of the Amazon Cognito user pool client.
+	UserPoolClientID string `json:"userPoolClientID"`
+
+	// The domain prefix or fully-qualified domain name of the Amazon Cognito user pool.
+	UserPoolDomain string `json:"userPoolDomain"`
+
+	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +optional
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthIDPConfigOIDC defines the OpenID Connect compliant identity provider.
+type AuthIDPConfigOIDC struct {
+	// The OIDC issuer identifier of the IdP.
+	Issuer string `json:"issuer"`
+
+	// The authorization endpoint of the IdP.
+	AuthorizationEndpoint string `json:"authorizationEndpoint"`
+
+	// The token endpoint of the IdP.
+	TokenEndpoint string `json:"tokenEndpoint"`
+
+	// The user info endpoint of the IdP.
+	UserInfoEndpoint string `json:"userInfoEndpoint"`
+
+	// The name of the K8s secret in the same namespace that contains the clientID and clientSecret.
+	SecretName string `json:"secretName"`
+
+	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +optional
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthenticationConfig defines an authenticate action performed before the action.
+type AuthenticationConfig struct {
+	// The type of authentication.
+	Type AuthenticationType `json:"type"`
+
+	// The Amazon Cognito identity provider, required when type is cognito.
+	// +optional
+	IDPConfigCognito *AuthIDPConfigCognito `json:"idpConfigCognito,omitempty"`
+
+	// The OpenID Connect compliant identity provider, required when type is oidc.
+	// +optional
+	IDPConfigOIDC *AuthIDPConfigOIDC `json:"idpConfigOIDC,omitempty"`
+
+	// The behavior if the user is not authenticated.
+	// +kubebuilder:validation:Enum=authenticate;allow;deny
+	// +optional
+	OnUnauthenticatedRequest *string `json:"onUnauthenticatedRequest,omitempty"`
+
+	// The set of user claims to be requested from the IdP.
+	// +optional
+	Scope *string `json:"scope,omitempty"`
+
+	// The name of the cookie used to maintain session information.
+	// +optional
+	SessionCookieName *string `json:"sessionCookieName,omitempty"`
+
+	// The maximum duration of the authentication session, in seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	// +optional
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
+}
+
+// ListenerRuleActionSpec defines the desired state of ListenerRuleAction
+type ListenerRuleActionSpec struct {
+	// Action is the action performed for requests routed to the Ingress backend referencing this ListenerRuleAction.
+	Action RuleAction `json:"action"`
+
+	// Conditions are additional routing conditions for the Ingress path referencing this ListenerRuleAction.
+	// Conditions are ignored when used as Ingress defaultBackend.
+	// +optional
+	Conditions []RuleCondition `json:"conditions,omitempty"`
+
+	// Authentication defines an authenticate action performed before Action on HTTPS listeners.
+	// +optional
+	Authentication *AuthenticationConfig `json:"authentication,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="ACTION-TYPE",type="string",JSONPath=".spec.action.type",description="The type of action"
+// +kubebuilder:printcolumn:name="AUTHENTICATION-TYPE",type="string",JSONPath=".spec.authentication.type",description="The type of authentication"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// ListenerRuleAction is the Schema for the ListenerRuleAction API
+type ListenerRuleAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ListenerRuleActionSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerRuleActionList contains a list of ListenerRuleAction
+type ListenerRuleActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerRuleAction `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ListenerRuleAction{}, &ListenerRuleActionList{})
+}
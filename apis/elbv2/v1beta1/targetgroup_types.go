@@ -0,0 +1,179 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// TargetGroupProtocol is the protocol used for routing traffic to the targets.
+// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+type TargetGroupProtocol string
+
+const (
+	TargetGroupProtocolHTTP   TargetGroupProtocol = "HTTP"
+	TargetGroupProtocolHTTPS  TargetGroupProtocol = "HTTPS"
+	TargetGroupProtocolTCP    TargetGroupProtocol = "TCP"
+	TargetGroupProtocolTLS    TargetGroupProtocol = "TLS"
+	TargetGroupProtocolUDP    TargetGroupProtocol = "UDP"
+	TargetGroupProtocolTCPUDP TargetGroupProtocol = "TCP_UDP"
+)
+
+// TargetGroupProtocolVersion is the protocol version of HTTP/HTTPS TargetGroups.
+// +kubebuilder:validation:Enum=HTTP1;HTTP2;GRPC
+type TargetGroupProtocolVersion string
+
+const (
+	TargetGroupProtocolVersionHTTP1 TargetGroupProtocolVersion = "HTTP1"
+	TargetGroupProtocolVersionHTTP2 TargetGroupProtocolVersion = "HTTP2"
+	TargetGroupProtocolVersionGRPC  TargetGroupProtocolVersion = "GRPC"
+)
+
+// TargetGroupIPAddressType is the type of IP address used by TargetGroup.
+// +kubebuilder:validation:Enum=ipv4;ipv6
+type TargetGroupIPAddressType string
+
+const (
+	TargetGroupIPAddressTypeIPv4 TargetGroupIPAddressType = "ipv4"
+	TargetGroupIPAddressTypeIPv6 TargetGroupIPAddressType = "ipv6"
+)
+
+// HealthCheckMatcher defines the codes to use when checking for a successful response from a target.
+type HealthCheckMatcher struct {
+	// httpCode is the HTTP codes.
+	// +optional
+	HTTPCode *string `json:"httpCode,omitempty"`
+
+	// grpcCode is the gRPC codes.
+	// +optional
+	GRPCCode *string `json:"grpcCode,omitempty"`
+}
+
+// TargetGroupHealthCheckConfig defines the health check configuration of TargetGroup.
+type TargetGroupHealthCheckConfig struct {
+	// port is the port the load balancer uses when performing health checks on targets.
+	// +optional
+	Port *intstr.IntOrString `json:"port,omitempty"`
+
+	// protocol is the protocol the load balancer uses when performing health checks on targets.
+	// +optional
+	Protocol *TargetGroupProtocol `json:"protocol,omitempty"`
+
+	// path is the ping path that is the destination on the targets for HTTP/HTTPS health checks.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// matcher is the codes to use when checking for a successful response from a target.
+	// +optional
+	Matcher *HealthCheckMatcher `json:"matcher,omitempty"`
+
+	// intervalSeconds is the approximate amount of time, in seconds, between health checks of an individual target.
+	// +optional
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+
+	// timeoutSeconds is the amount of time, in seconds, during which no response from a target means a failed health check.
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// healthyThresholdCount is the number of consecutive health checks successes required before considering an unhealthy target healthy.
+	// +optional
+	HealthyThresholdCount *int64 `json:"healthyThresholdCount,omitempty"`
+
+	// unhealthyThresholdCount is the number of consecutive health check failures required before considering a target unhealthy.
+	// +optional
+	UnhealthyThresholdCount *int64 `json:"unhealthyThresholdCount,omitempty"`
+}
+
+// TargetGroupSpec defines the desired state of TargetGroup
+type TargetGroupSpec struct {
+	// name is the name of TargetGroup in AWS. If unspecified, it will be generated from the namespace and name of TargetGroup.
+	// +kubebuilder:validation:MaxLength=32
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// targetType is the type of target registered with TargetGroup.
+	TargetType TargetType `json:"targetType"`
+
+	// port is the port on which the targets receive traffic. It cannot be changed.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// protocol is the protocol used for routing traffic to the targets.
+	Protocol TargetGroupProtocol `json:"protocol"`
+
+	// protocolVersion is the protocol version of HTTP/HTTPS TargetGroups.
+	// +optional
+	ProtocolVersion *TargetGroupProtocolVersion `json:"protocolVersion,omitempty"`
+
+	// ipAddressType is the type of IP address used by TargetGroup. Defaults to ipv4.
+	// +optional
+	IPAddressType *TargetGroupIPAddressType `json:"ipAddressType,omitempty"`
+
+	// healthCheckConfig is the health check configuration of TargetGroup.
+	// +optional
+	HealthCheckConfig *TargetGroupHealthCheckConfig `json:"healthCheckConfig,omitempty"`
+
+	// targetGroupAttributes are the attributes of TargetGroup.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+
+	// tags are the AWS tags applied to TargetGroup.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// TargetGroupStatus defines the observed state of TargetGroup
+type TargetGroupStatus struct {
+	// The generation observed by the TargetGroup controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// targetGroupARN is the ARN of TargetGroup provisioned in AWS.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupARN,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="TARGET-TYPE",type="string",JSONPath=".spec.targetType",description="The target type of TargetGroup"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.protocol",description="The protocol of TargetGroup"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.targetGroupARN",description="The AWS TargetGroup's Amazon Resource Name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// TargetGroup is the Schema for the TargetGroup API
+type TargetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupSpec   `json:"spec,omitempty"`
+	Status TargetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupList contains a list of TargetGroup
+type TargetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TargetGroup{}, &TargetGroupList{})
+}
@@ -115,8 +115,15 @@ type TargetGroupBindingNetworking struct {
 // TargetGroupBindingSpec defines the desired state of TargetGroupBinding
 type TargetGroupBindingSpec struct {
 	// targetGroupARN is the Amazon Resource Name (ARN) for the TargetGroup.
+	// If unspecified, it will be resolved from the TargetGroup referenced by targetGroupName once it's provisioned, and follows the TargetGroup when it's replaced.
 	// +kubebuilder:validation:MinLength=1
-	TargetGroupARN string `json:"targetGroupARN"`
+	// +optional
+	TargetGroupARN string `json:"targetGroupARN,omitempty"`
+
+	// targetGroupName is the name of a TargetGroup object in the same namespace that provisions the TargetGroup.
+	// Either targetGroupARN or targetGroupName must be specified.
+	// +optional
+	TargetGroupName string `json:"targetGroupName,omitempty"`
 
 	// targetType is the TargetType of TargetGroup. If unspecified, it will be automatically inferred.
 	// +optional
@@ -132,13 +139,124 @@ type TargetGroupBindingSpec struct {
 	// node selector for instance type target groups to only register certain nodes
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// multiClusterTargetGroup denotes whether the TargetGroup is shared with other clusters.
+	// When enabled, only targets registered by current cluster will be deregistered.
+	// +optional
+	MultiClusterTargetGroup bool `json:"multiClusterTargetGroup,omitempty"`
+
+	// ipAddressType is the IP address type of TargetGroup's targets.
+	// When specified, Service endpoints must be of the same IP family, and networking rules are restricted to the same IP family.
+	// +optional
+	IPAddressType *TargetGroupIPAddressType `json:"ipAddressType,omitempty"`
+}
+
+// DrainingTarget defines a target that is being deregistered from TargetGroup.
+type DrainingTarget struct {
+	// ID is the target's ID, either the pod IP or the EC2 instanceID.
+	ID string `json:"id"`
+
+	// Port is the target's port.
+	Port int64 `json:"port"`
+
+	// Pod is the name of pod that backs this target, if known.
+	// +optional
+	Pod *string `json:"pod,omitempty"`
+}
+
+// ZoneEndpoints summarizes the active pod endpoints of an availability zone.
+type ZoneEndpoints struct {
+	// Zone is the availability zone.
+	Zone string `json:"zone"`
+
+	// Endpoints is the number of active endpoints residing in the zone.
+	Endpoints int32 `json:"endpoints"`
+
+	// HintedEndpoints is the number of active endpoints that topology aware hints allocate to serve the zone.
+	// it's only set when the endpoints are hinted.
+	// +optional
+	HintedEndpoints *int32 `json:"hintedEndpoints,omitempty"`
+}
+
+// UnhealthyTarget defines a target that failed health checks of TargetGroup.
+type UnhealthyTarget struct {
+	// ID is the target's ID, either the pod IP or the EC2 instanceID.
+	ID string `json:"id"`
+
+	// Port is the target's port.
+	Port int64 `json:"port"`
+
+	// Pod is the name of pod that backs this target, if known.
+	// +optional
+	Pod *string `json:"pod,omitempty"`
+
+	// Reason is the ELB reason code of target health, e.g. Target.ResponseCodeMismatch.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Description is the ELB description of target health.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// TargetHealthSummary defines the number of targets in TargetGroup by health state.
+type TargetHealthSummary struct {
+	// Registered is the number of targets registered by this TargetGroupBinding and not draining.
+	Registered int32 `json:"registered"`
+
+	// Healthy is the number of targets that passed health checks.
+	Healthy int32 `json:"healthy"`
+
+	// Unhealthy is the number of targets that failed health checks.
+	Unhealthy int32 `json:"unhealthy"`
+
+	// Draining is the number of targets being deregistered.
+	Draining int32 `json:"draining"`
 }
 
+const (
+	// TargetGroupBindingConditionReady indicates whether targets of TargetGroupBinding are successfully reconciled.
+	TargetGroupBindingConditionReady = "Ready"
+	// TargetGroupBindingConditionDegraded indicates whether some targets of TargetGroupBinding are unhealthy.
+	TargetGroupBindingConditionDegraded = "Degraded"
+)
+
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
 type TargetGroupBindingStatus struct {
 	// The generation observed by the TargetGroupBinding controller.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Targets summarizes the targets in TargetGroup by health state.
+	// +optional
+	Targets *TargetHealthSummary `json:"targets,omitempty"`
+
+	// UnhealthyTargets are the targets that failed health checks, at most 100 targets are listed.
+	// +optional
+	UnhealthyTargets []UnhealthyTarget `json:"unhealthyTargets,omitempty"`
+
+	// DrainingTargets are the targets being deregistered from TargetGroup, at most 100 targets are listed.
+	// +optional
+	DrainingTargets []DrainingTarget `json:"drainingTargets,omitempty"`
+
+	// Zones summarizes the active pod endpoints per availability zone along with their topology aware hints,
+	// only available for ip TargetType when endpoints are resolved from EndpointSlices.
+	// +optional
+	Zones []ZoneEndpoints `json:"zones,omitempty"`
+
+	// LastRegisterTime is the last time targets are successfully registered into TargetGroup.
+	// +optional
+	LastRegisterTime *metav1.Time `json:"lastRegisterTime,omitempty"`
+
+	// LastDeregisterTime is the last time targets are successfully deregistered from TargetGroup.
+	// +optional
+	LastDeregisterTime *metav1.Time `json:"lastDeregisterTime,omitempty"`
+
+	// Conditions are the Ready and Degraded conditions of TargetGroupBinding.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -147,6 +265,11 @@ type TargetGroupBindingStatus struct {
 // +kubebuilder:printcolumn:name="SERVICE-NAME",type="string",JSONPath=".spec.serviceRef.name",description="The Kubernetes Service's name"
 // +kubebuilder:printcolumn:name="SERVICE-PORT",type="string",JSONPath=".spec.serviceRef.port",description="The Kubernetes Service's port"
 // +kubebuilder:printcolumn:name="TARGET-TYPE",type="string",JSONPath=".spec.targetType",description="The AWS TargetGroup's TargetType"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether targets are successfully reconciled"
+// +kubebuilder:printcolumn:name="HEALTHY",type="integer",JSONPath=".status.targets.healthy",description="The number of healthy targets"
+// +kubebuilder:printcolumn:name="REGISTERED",type="integer",JSONPath=".status.targets.registered",description="The number of registered targets"
+// +kubebuilder:printcolumn:name="UNHEALTHY",type="integer",JSONPath=".status.targets.unhealthy",description="The number of unhealthy targets",priority=1
+// +kubebuilder:printcolumn:name="DRAINING",type="integer",JSONPath=".status.targets.draining",description="The number of draining targets",priority=1
 // +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".spec.targetGroupARN",description="The AWS TargetGroup's Amazon Resource Name",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // TargetGroupBinding is the Schema for the TargetGroupBinding API
@@ -0,0 +1,149 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrafficShiftStep defines a step of traffic shifting.
+type TrafficShiftStep struct {
+	// weight is the percentage of traffic forwarded to canaryService during this step.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// pause is the duration to stay at this step before proceeding to next step.
+	// If unspecified, the next step proceeds as soon as canary passes analysis.
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// TrafficShiftAnalysis defines the thresholds on canary health.
+// Traffic is shifted back to stableService once any threshold is breached.
+type TrafficShiftAnalysis struct {
+	// maxUnhealthyTargets is the max number of unhealthy targets in TargetGroups of canaryService.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxUnhealthyTargets *int32 `json:"maxUnhealthyTargets,omitempty"`
+
+	// max5xxPercent is the max percentage of requests to canaryService that targets responded with 5xx code.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Max5xxPercent *int32 `json:"max5xxPercent,omitempty"`
+
+	// interval is the interval between analysis. Defaults to 1m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// TrafficShiftSpec defines the desired state of TrafficShift
+type TrafficShiftSpec struct {
+	// ingressName is the name of Ingress in the same namespace, whose backend action is shifted.
+	// +kubebuilder:validation:MinLength=1
+	IngressName string `json:"ingressName"`
+
+	// actionName is the name of backend action specified via `alb.ingress.kubernetes.io/actions.${actionName}` annotation.
+	// The action must forward to both stableService and canaryService.
+	// +kubebuilder:validation:MinLength=1
+	ActionName string `json:"actionName"`
+
+	// stableService is the name of Service that serves the current version.
+	// +kubebuilder:validation:MinLength=1
+	StableService string `json:"stableService"`
+
+	// canaryService is the name of Service that serves the new version.
+	// +kubebuilder:validation:MinLength=1
+	CanaryService string `json:"canaryService"`
+
+	// steps are the weights of canaryService to be applied in order.
+	// +kubebuilder:validation:MinItems=1
+	Steps []TrafficShiftStep `json:"steps"`
+
+	// analysis defines the thresholds on canary health.
+	// +optional
+	Analysis *TrafficShiftAnalysis `json:"analysis,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Progressing;Succeeded;RolledBack
+// TrafficShiftPhase is the phase of TrafficShift.
+type TrafficShiftPhase string
+
+const (
+	TrafficShiftPhaseProgressing TrafficShiftPhase = "Progressing"
+	TrafficShiftPhaseSucceeded   TrafficShiftPhase = "Succeeded"
+	TrafficShiftPhaseRolledBack  TrafficShiftPhase = "RolledBack"
+)
+
+// TrafficShiftStatus defines the observed state of TrafficShift
+type TrafficShiftStatus struct {
+	// The generation observed by the TrafficShift controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// phase is the phase of traffic shifting.
+	// +optional
+	Phase TrafficShiftPhase `json:"phase,omitempty"`
+
+	// currentStep is the index of current step.
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// canaryWeight is the percentage of traffic currently forwarded to canaryService.
+	// +optional
+	CanaryWeight *int32 `json:"canaryWeight,omitempty"`
+
+	// stepStartTime is the time current step started.
+	// +optional
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+
+	// message is a human readable message about current phase, e.g. why traffic is rolled back.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="INGRESS",type="string",JSONPath=".spec.ingressName",description="The Ingress's name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.actionName",description="The name of backend action"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.phase",description="The phase of traffic shifting"
+// +kubebuilder:printcolumn:name="STEP",type="integer",JSONPath=".status.currentStep",description="The index of current step"
+// +kubebuilder:printcolumn:name="CANARY-WEIGHT",type="integer",JSONPath=".status.canaryWeight",description="The percentage of traffic forwarded to canaryService"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// TrafficShift is the Schema for the TrafficShift API
+type TrafficShift struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrafficShiftSpec   `json:"spec,omitempty"`
+	Status TrafficShiftStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrafficShiftList contains a list of TrafficShift
+type TrafficShiftList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficShift `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrafficShift{}, &TrafficShiftList{})
+}
@@ -26,73 +26,1358 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogs) DeepCopyInto(out *AccessLogs) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogs.
+func (in *AccessLogs) DeepCopy() *AccessLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdoptLoadBalancer) DeepCopyInto(out *AdoptLoadBalancer) {
+	*out = *in
+	if in.PreserveUnmanagedListeners != nil {
+		in, out := &in.PreserveUnmanagedListeners, &out.PreserveUnmanagedListeners
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptLoadBalancer.
+func (in *AdoptLoadBalancer) DeepCopy() *AdoptLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(AdoptLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Attribute) DeepCopyInto(out *Attribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Attribute.
+func (in *Attribute) DeepCopy() *Attribute {
+	if in == nil {
+		return nil
+	}
+	out := new(Attribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthIDPConfigCognito) DeepCopyInto(out *AuthIDPConfigCognito) {
+	*out = *in
+	if in.AuthenticationRequestExtraParams != nil {
+		in, out := &in.AuthenticationRequestExtraParams, &out.AuthenticationRequestExtraParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthIDPConfigCognito.
+func (in *AuthIDPConfigCognito) DeepCopy() *AuthIDPConfigCognito {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthIDPConfigCognito)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthIDPConfigOIDC) DeepCopyInto(out *AuthIDPConfigOIDC) {
+	*out = *in
+	if in.AuthenticationRequestExtraParams != nil {
+		in, out := &in.AuthenticationRequestExtraParams, &out.AuthenticationRequestExtraParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthIDPConfigOIDC.
+func (in *AuthIDPConfigOIDC) DeepCopy() *AuthIDPConfigOIDC {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthIDPConfigOIDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationConfig) DeepCopyInto(out *AuthenticationConfig) {
+	*out = *in
+	if in.IDPConfigCognito != nil {
+		in, out := &in.IDPConfigCognito, &out.IDPConfigCognito
+		*out = new(AuthIDPConfigCognito)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IDPConfigOIDC != nil {
+		in, out := &in.IDPConfigOIDC, &out.IDPConfigOIDC
+		*out = new(AuthIDPConfigOIDC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnUnauthenticatedRequest != nil {
+		in, out := &in.OnUnauthenticatedRequest, &out.OnUnauthenticatedRequest
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionCookieName != nil {
+		in, out := &in.SessionCookieName, &out.SessionCookieName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionTimeout != nil {
+		in, out := &in.SessionTimeout, &out.SessionTimeout
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationConfig.
+func (in *AuthenticationConfig) DeepCopy() *AuthenticationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainingTarget) DeepCopyInto(out *DrainingTarget) {
+	*out = *in
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainingTarget.
+func (in *DrainingTarget) DeepCopy() *DrainingTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainingTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedResponseActionConfig) DeepCopyInto(out *FixedResponseActionConfig) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MessageBody != nil {
+		in, out := &in.MessageBody, &out.MessageBody
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedResponseActionConfig.
+func (in *FixedResponseActionConfig) DeepCopy() *FixedResponseActionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedResponseActionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardActionConfig) DeepCopyInto(out *ForwardActionConfig) {
+	*out = *in
+	if in.TargetGroups != nil {
+		in, out := &in.TargetGroups, &out.TargetGroups
+		*out = make([]TargetGroupTuple, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetGroupStickinessConfig != nil {
+		in, out := &in.TargetGroupStickinessConfig, &out.TargetGroupStickinessConfig
+		*out = new(TargetGroupStickinessConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardActionConfig.
+func (in *ForwardActionConfig) DeepCopy() *ForwardActionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardActionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderConditionConfig) DeepCopyInto(out *HTTPHeaderConditionConfig) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderConditionConfig.
+func (in *HTTPHeaderConditionConfig) DeepCopy() *HTTPHeaderConditionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderConditionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestMethodConditionConfig) DeepCopyInto(out *HTTPRequestMethodConditionConfig) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestMethodConditionConfig.
+func (in *HTTPRequestMethodConditionConfig) DeepCopy() *HTTPRequestMethodConditionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestMethodConditionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckMatcher) DeepCopyInto(out *HealthCheckMatcher) {
+	*out = *in
+	if in.HTTPCode != nil {
+		in, out := &in.HTTPCode, &out.HTTPCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.GRPCCode != nil {
+		in, out := &in.GRPCCode, &out.GRPCCode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckMatcher.
+func (in *HealthCheckMatcher) DeepCopy() *HealthCheckMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostHeaderConditionConfig) DeepCopyInto(out *HostHeaderConditionConfig) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostHeaderConditionConfig.
+func (in *HostHeaderConditionConfig) DeepCopy() *HostHeaderConditionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostHeaderConditionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlock) DeepCopyInto(out *IPBlock) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPBlock.
-func (in *IPBlock) DeepCopy() *IPBlock {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPBlock.
+func (in *IPBlock) DeepCopy() *IPBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(IPBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParams) DeepCopyInto(out *IngressClassParams) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParams.
+func (in *IngressClassParams) DeepCopy() *IngressClassParams {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressClassParams) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParamsList) DeepCopyInto(out *IngressClassParamsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IngressClassParams, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsList.
+func (in *IngressClassParamsList) DeepCopy() *IngressClassParamsList {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParamsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(IngressGroup)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.IPAddressType != nil {
+		in, out := &in.IPAddressType, &out.IPAddressType
+		*out = new(IPAddressType)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetDiscovery != nil {
+		in, out := &in.SubnetDiscovery, &out.SubnetDiscovery
+		*out = new(SubnetDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSLPolicy != nil {
+		in, out := &in.SSLPolicy, &out.SSLPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificateARNs != nil {
+		in, out := &in.CertificateARNs, &out.CertificateARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InboundCIDRs != nil {
+		in, out := &in.InboundCIDRs, &out.InboundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(TargetType)
+		**out = **in
+	}
+	if in.LoadBalancerAttributes != nil {
+		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.WAFv2ACLARN != nil {
+		in, out := &in.WAFv2ACLARN, &out.WAFv2ACLARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(AccessLogs)
+		**out = **in
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
+	if in.AdoptLoadBalancer != nil {
+		in, out := &in.AdoptLoadBalancer, &out.AdoptLoadBalancer
+		*out = new(AdoptLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AnnotationOverrides != nil {
+		in, out := &in.AnnotationOverrides, &out.AnnotationOverrides
+		*out = make([]IngressClassParamsField, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
+func (in *IngressClassParamsSpec) DeepCopy() *IngressClassParamsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParamsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressGroup) DeepCopyInto(out *IngressGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGroup.
+func (in *IngressGroup) DeepCopy() *IngressGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRule) DeepCopyInto(out *ListenerRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRule.
+func (in *ListenerRule) DeepCopy() *ListenerRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleAction) DeepCopyInto(out *ListenerRuleAction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleAction.
+func (in *ListenerRuleAction) DeepCopy() *ListenerRuleAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerRuleAction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleActionList) DeepCopyInto(out *ListenerRuleActionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListenerRuleAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleActionList.
+func (in *ListenerRuleActionList) DeepCopy() *ListenerRuleActionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleActionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerRuleActionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleActionSpec) DeepCopyInto(out *ListenerRuleActionSpec) {
+	*out = *in
+	in.Action.DeepCopyInto(&out.Action)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]RuleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleActionSpec.
+func (in *ListenerRuleActionSpec) DeepCopy() *ListenerRuleActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleList) DeepCopyInto(out *ListenerRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListenerRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleList.
+func (in *ListenerRuleList) DeepCopy() *ListenerRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleSpec) DeepCopyInto(out *ListenerRuleSpec) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]RuleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Action.DeepCopyInto(&out.Action)
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(TargetType)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleSpec.
+func (in *ListenerRuleSpec) DeepCopy() *ListenerRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerRuleStatus) DeepCopyInto(out *ListenerRuleStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ListenerARN != nil {
+		in, out := &in.ListenerARN, &out.ListenerARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RuleARN != nil {
+		in, out := &in.RuleARN, &out.RuleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleStatus.
+func (in *ListenerRuleStatus) DeepCopy() *ListenerRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingIngressRule) DeepCopyInto(out *NetworkingIngressRule) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]NetworkingPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]NetworkingPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingIngressRule.
+func (in *NetworkingIngressRule) DeepCopy() *NetworkingIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingPeer) DeepCopyInto(out *NetworkingPeer) {
+	*out = *in
+	if in.IPBlock != nil {
+		in, out := &in.IPBlock, &out.IPBlock
+		*out = new(IPBlock)
+		**out = **in
+	}
+	if in.SecurityGroup != nil {
+		in, out := &in.SecurityGroup, &out.SecurityGroup
+		*out = new(SecurityGroup)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingPeer.
+func (in *NetworkingPeer) DeepCopy() *NetworkingPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingPort) DeepCopyInto(out *NetworkingPort) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(NetworkingProtocol)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingPort.
+func (in *NetworkingPort) DeepCopy() *NetworkingPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PathPatternConditionConfig) DeepCopyInto(out *PathPatternConditionConfig) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PathPatternConditionConfig.
+func (in *PathPatternConditionConfig) DeepCopy() *PathPatternConditionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PathPatternConditionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryStringConditionConfig) DeepCopyInto(out *QueryStringConditionConfig) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]QueryStringKeyValuePair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryStringConditionConfig.
+func (in *QueryStringConditionConfig) DeepCopy() *QueryStringConditionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryStringConditionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryStringKeyValuePair) DeepCopyInto(out *QueryStringKeyValuePair) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryStringKeyValuePair.
+func (in *QueryStringKeyValuePair) DeepCopy() *QueryStringKeyValuePair {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryStringKeyValuePair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectActionConfig) DeepCopyInto(out *RedirectActionConfig) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectActionConfig.
+func (in *RedirectActionConfig) DeepCopy() *RedirectActionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RedirectActionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleAction) DeepCopyInto(out *RuleAction) {
+	*out = *in
+	if in.TargetGroupARN != nil {
+		in, out := &in.TargetGroupARN, &out.TargetGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.FixedResponseConfig != nil {
+		in, out := &in.FixedResponseConfig, &out.FixedResponseConfig
+		*out = new(FixedResponseActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedirectConfig != nil {
+		in, out := &in.RedirectConfig, &out.RedirectConfig
+		*out = new(RedirectActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForwardConfig != nil {
+		in, out := &in.ForwardConfig, &out.ForwardConfig
+		*out = new(ForwardActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleAction.
+func (in *RuleAction) DeepCopy() *RuleAction {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCondition) DeepCopyInto(out *RuleCondition) {
+	*out = *in
+	if in.HostHeaderConfig != nil {
+		in, out := &in.HostHeaderConfig, &out.HostHeaderConfig
+		*out = new(HostHeaderConditionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPHeaderConfig != nil {
+		in, out := &in.HTTPHeaderConfig, &out.HTTPHeaderConfig
+		*out = new(HTTPHeaderConditionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRequestMethodConfig != nil {
+		in, out := &in.HTTPRequestMethodConfig, &out.HTTPRequestMethodConfig
+		*out = new(HTTPRequestMethodConditionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PathPatternConfig != nil {
+		in, out := &in.PathPatternConfig, &out.PathPatternConfig
+		*out = new(PathPatternConditionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryStringConfig != nil {
+		in, out := &in.QueryStringConfig, &out.QueryStringConfig
+		*out = new(QueryStringConditionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceIPConfig != nil {
+		in, out := &in.SourceIPConfig, &out.SourceIPConfig
+		*out = new(SourceIPConditionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCondition.
+func (in *RuleCondition) DeepCopy() *RuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+	out.Port = in.Port
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIPConditionConfig) DeepCopyInto(out *SourceIPConditionConfig) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIPConditionConfig.
+func (in *SourceIPConditionConfig) DeepCopy() *SourceIPConditionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIPConditionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetDiscovery) DeepCopyInto(out *SubnetDiscovery) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.MinAvailableIPAddresses != nil {
+		in, out := &in.MinAvailableIPAddresses, &out.MinAvailableIPAddresses
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetDiscovery.
+func (in *SubnetDiscovery) DeepCopy() *SubnetDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroup) DeepCopyInto(out *TargetGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroup.
+func (in *TargetGroup) DeepCopy() *TargetGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBinding) DeepCopyInto(out *TargetGroupBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBinding.
+func (in *TargetGroupBinding) DeepCopy() *TargetGroupBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingList) DeepCopyInto(out *TargetGroupBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetGroupBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingList.
+func (in *TargetGroupBindingList) DeepCopy() *TargetGroupBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingNetworking) DeepCopyInto(out *TargetGroupBindingNetworking) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkingIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingNetworking.
+func (in *TargetGroupBindingNetworking) DeepCopy() *TargetGroupBindingNetworking {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupBindingNetworking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
+	*out = *in
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(TargetType)
+		**out = **in
+	}
+	out.ServiceRef = in.ServiceRef
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(TargetGroupBindingNetworking)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAddressType != nil {
+		in, out := &in.IPAddressType, &out.IPAddressType
+		*out = new(TargetGroupIPAddressType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingSpec.
+func (in *TargetGroupBindingSpec) DeepCopy() *TargetGroupBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingStatus) DeepCopyInto(out *TargetGroupBindingStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = new(TargetHealthSummary)
+		**out = **in
+	}
+	if in.UnhealthyTargets != nil {
+		in, out := &in.UnhealthyTargets, &out.UnhealthyTargets
+		*out = make([]UnhealthyTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DrainingTargets != nil {
+		in, out := &in.DrainingTargets, &out.DrainingTargets
+		*out = make([]DrainingTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneEndpoints, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRegisterTime != nil {
+		in, out := &in.LastRegisterTime, &out.LastRegisterTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeregisterTime != nil {
+		in, out := &in.LastDeregisterTime, &out.LastDeregisterTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingStatus.
+func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IPBlock)
+	out := new(TargetGroupBindingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParams) DeepCopyInto(out *IngressClassParams) {
+func (in *TargetGroupHealthCheckConfig) DeepCopyInto(out *TargetGroupHealthCheckConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(TargetGroupProtocol)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Matcher != nil {
+		in, out := &in.Matcher, &out.Matcher
+		*out = new(HealthCheckMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HealthyThresholdCount != nil {
+		in, out := &in.HealthyThresholdCount, &out.HealthyThresholdCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UnhealthyThresholdCount != nil {
+		in, out := &in.UnhealthyThresholdCount, &out.UnhealthyThresholdCount
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParams.
-func (in *IngressClassParams) DeepCopy() *IngressClassParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupHealthCheckConfig.
+func (in *TargetGroupHealthCheckConfig) DeepCopy() *TargetGroupHealthCheckConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressClassParams)
+	out := new(TargetGroupHealthCheckConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressClassParams) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParamsList) DeepCopyInto(out *IngressClassParamsList) {
+func (in *TargetGroupList) DeepCopyInto(out *TargetGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IngressClassParams, len(*in))
+		*out = make([]TargetGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsList.
-func (in *IngressClassParamsList) DeepCopy() *IngressClassParamsList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupList.
+func (in *TargetGroupList) DeepCopy() *TargetGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressClassParamsList)
+	out := new(TargetGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
+func (in *TargetGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -100,206 +1385,235 @@ func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
+func (in *TargetGroupSpec) DeepCopyInto(out *TargetGroupSpec) {
 	*out = *in
-	if in.NamespaceSelector != nil {
-		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = new(IngressGroup)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
-	if in.Scheme != nil {
-		in, out := &in.Scheme, &out.Scheme
-		*out = new(LoadBalancerScheme)
+	if in.ProtocolVersion != nil {
+		in, out := &in.ProtocolVersion, &out.ProtocolVersion
+		*out = new(TargetGroupProtocolVersion)
 		**out = **in
 	}
 	if in.IPAddressType != nil {
 		in, out := &in.IPAddressType, &out.IPAddressType
-		*out = new(IPAddressType)
+		*out = new(TargetGroupIPAddressType)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
+	if in.HealthCheckConfig != nil {
+		in, out := &in.HealthCheckConfig, &out.HealthCheckConfig
+		*out = new(TargetGroupHealthCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = make([]Attribute, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
-func (in *IngressClassParamsSpec) DeepCopy() *IngressClassParamsSpec {
-	if in == nil {
-		return nil
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(IngressClassParamsSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressGroup) DeepCopyInto(out *IngressGroup) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGroup.
-func (in *IngressGroup) DeepCopy() *IngressGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupSpec.
+func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressGroup)
+	out := new(TargetGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkingIngressRule) DeepCopyInto(out *NetworkingIngressRule) {
+func (in *TargetGroupStatus) DeepCopyInto(out *TargetGroupStatus) {
 	*out = *in
-	if in.From != nil {
-		in, out := &in.From, &out.From
-		*out = make([]NetworkingPeer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
 	}
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]NetworkingPort, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.TargetGroupARN != nil {
+		in, out := &in.TargetGroupARN, &out.TargetGroupARN
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingIngressRule.
-func (in *NetworkingIngressRule) DeepCopy() *NetworkingIngressRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupStatus.
+func (in *TargetGroupStatus) DeepCopy() *TargetGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkingIngressRule)
+	out := new(TargetGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkingPeer) DeepCopyInto(out *NetworkingPeer) {
+func (in *TargetGroupStickinessConfig) DeepCopyInto(out *TargetGroupStickinessConfig) {
 	*out = *in
-	if in.IPBlock != nil {
-		in, out := &in.IPBlock, &out.IPBlock
-		*out = new(IPBlock)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.SecurityGroup != nil {
-		in, out := &in.SecurityGroup, &out.SecurityGroup
-		*out = new(SecurityGroup)
+	if in.DurationSeconds != nil {
+		in, out := &in.DurationSeconds, &out.DurationSeconds
+		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingPeer.
-func (in *NetworkingPeer) DeepCopy() *NetworkingPeer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupStickinessConfig.
+func (in *TargetGroupStickinessConfig) DeepCopy() *TargetGroupStickinessConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkingPeer)
+	out := new(TargetGroupStickinessConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkingPort) DeepCopyInto(out *NetworkingPort) {
+func (in *TargetGroupTuple) DeepCopyInto(out *TargetGroupTuple) {
 	*out = *in
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(NetworkingProtocol)
+	if in.TargetGroupARN != nil {
+		in, out := &in.TargetGroupARN, &out.TargetGroupARN
+		*out = new(string)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicePort != nil {
+		in, out := &in.ServicePort, &out.ServicePort
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingPort.
-func (in *NetworkingPort) DeepCopy() *NetworkingPort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupTuple.
+func (in *TargetGroupTuple) DeepCopy() *TargetGroupTuple {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkingPort)
+	out := new(TargetGroupTuple)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+func (in *TargetHealthSummary) DeepCopyInto(out *TargetHealthSummary) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
-func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetHealthSummary.
+func (in *TargetHealthSummary) DeepCopy() *TargetHealthSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroup)
+	out := new(TargetHealthSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+func (in *TrafficShift) DeepCopyInto(out *TrafficShift) {
 	*out = *in
-	out.Port = in.Port
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
-func (in *ServiceReference) DeepCopy() *ServiceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShift.
+func (in *TrafficShift) DeepCopy() *TrafficShift {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceReference)
+	out := new(TrafficShift)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficShift) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *TrafficShiftAnalysis) DeepCopyInto(out *TrafficShiftAnalysis) {
 	*out = *in
+	if in.MaxUnhealthyTargets != nil {
+		in, out := &in.MaxUnhealthyTargets, &out.MaxUnhealthyTargets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Max5xxPercent != nil {
+		in, out := &in.Max5xxPercent, &out.Max5xxPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftAnalysis.
+func (in *TrafficShiftAnalysis) DeepCopy() *TrafficShiftAnalysis {
 	if in == nil {
 		return nil
 	}
-	out := new(Tag)
+	out := new(TrafficShiftAnalysis)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetGroupBinding) DeepCopyInto(out *TargetGroupBinding) {
+func (in *TrafficShiftList) DeepCopyInto(out *TrafficShiftList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficShift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBinding.
-func (in *TargetGroupBinding) DeepCopy() *TargetGroupBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftList.
+func (in *TrafficShiftList) DeepCopy() *TrafficShiftList {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetGroupBinding)
+	out := new(TrafficShiftList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TargetGroupBinding) DeepCopyObject() runtime.Object {
+func (in *TrafficShiftList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -307,106 +1621,117 @@ func (in *TargetGroupBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetGroupBindingList) DeepCopyInto(out *TargetGroupBindingList) {
+func (in *TrafficShiftSpec) DeepCopyInto(out *TrafficShiftSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]TargetGroupBinding, len(*in))
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]TrafficShiftStep, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(TrafficShiftAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingList.
-func (in *TargetGroupBindingList) DeepCopy() *TargetGroupBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftSpec.
+func (in *TrafficShiftSpec) DeepCopy() *TrafficShiftSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetGroupBindingList)
+	out := new(TrafficShiftSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TargetGroupBindingList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficShiftStatus) DeepCopyInto(out *TrafficShiftStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
 	}
-	return nil
+	if in.CanaryWeight != nil {
+		in, out := &in.CanaryWeight, &out.CanaryWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StepStartTime != nil {
+		in, out := &in.StepStartTime, &out.StepStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftStatus.
+func (in *TrafficShiftStatus) DeepCopy() *TrafficShiftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficShiftStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetGroupBindingNetworking) DeepCopyInto(out *TargetGroupBindingNetworking) {
+func (in *TrafficShiftStep) DeepCopyInto(out *TrafficShiftStep) {
 	*out = *in
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = make([]NetworkingIngressRule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingNetworking.
-func (in *TargetGroupBindingNetworking) DeepCopy() *TargetGroupBindingNetworking {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftStep.
+func (in *TrafficShiftStep) DeepCopy() *TrafficShiftStep {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetGroupBindingNetworking)
+	out := new(TrafficShiftStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
+func (in *UnhealthyTarget) DeepCopyInto(out *UnhealthyTarget) {
 	*out = *in
-	if in.TargetType != nil {
-		in, out := &in.TargetType, &out.TargetType
-		*out = new(TargetType)
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(string)
 		**out = **in
 	}
-	out.ServiceRef = in.ServiceRef
-	if in.Networking != nil {
-		in, out := &in.Networking, &out.Networking
-		*out = new(TargetGroupBindingNetworking)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingSpec.
-func (in *TargetGroupBindingSpec) DeepCopy() *TargetGroupBindingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyTarget.
+func (in *UnhealthyTarget) DeepCopy() *UnhealthyTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetGroupBindingSpec)
+	out := new(UnhealthyTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetGroupBindingStatus) DeepCopyInto(out *TargetGroupBindingStatus) {
+func (in *ZoneEndpoints) DeepCopyInto(out *ZoneEndpoints) {
 	*out = *in
-	if in.ObservedGeneration != nil {
-		in, out := &in.ObservedGeneration, &out.ObservedGeneration
-		*out = new(int64)
+	if in.HintedEndpoints != nil {
+		in, out := &in.HintedEndpoints, &out.HintedEndpoints
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingStatus.
-func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneEndpoints.
+func (in *ZoneEndpoints) DeepCopy() *ZoneEndpoints {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetGroupBindingStatus)
+	out := new(ZoneEndpoints)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,98 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueListenerRulesForServiceEvent constructs new enqueueListenerRulesForServiceEvent.
+func NewEnqueueListenerRulesForServiceEvent(k8sClient client.Client, logger logr.Logger) handler.EventHandler {
+	return &enqueueListenerRulesForServiceEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+type enqueueListenerRulesForServiceEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+// Create is called in response to an create event - e.g. Pod Creation.
+func (h *enqueueListenerRulesForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	svcNew := e.Object.(*corev1.Service)
+	h.enqueueImpactedListenerRules(queue, svcNew)
+}
+
+// Update is called in response to an update event -  e.g. Pod Updated.
+func (h *enqueueListenerRulesForServiceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	svcOld := e.ObjectOld.(*corev1.Service)
+	svcNew := e.ObjectNew.(*corev1.Service)
+	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) {
+		return
+	}
+	h.enqueueImpactedListenerRules(queue, svcNew)
+}
+
+// Delete is called in response to a delete event - e.g. Pod Deleted.
+func (h *enqueueListenerRulesForServiceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	svcOld := e.Object.(*corev1.Service)
+	h.enqueueImpactedListenerRules(queue, svcOld)
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request - e.g. reconcile AutoScaling, or a WebHook.
+func (h *enqueueListenerRulesForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here
+}
+
+// enqueueImpactedListenerRules will enqueue all ListenerRules that forward to the service.
+func (h *enqueueListenerRulesForServiceEvent) enqueueImpactedListenerRules(queue workqueue.RateLimitingInterface, svc *corev1.Service) {
+	lrList := &elbv2api.ListenerRuleList{}
+	if err := h.k8sClient.List(context.Background(), lrList, client.InNamespace(svc.Namespace)); err != nil {
+		h.logger.Error(err, "failed to fetch listenerRules")
+		return
+	}
+
+	svcKey := k8s.NamespacedName(svc)
+	for i := range lrList.Items {
+		lr := &lrList.Items[i]
+		if !isServiceReferredByListenerRule(lr, svc.Name) {
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue listenerRule for service event",
+			"service", svcKey,
+			"listenerRule", k8s.NamespacedName(lr),
+		)
+		queue.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: lr.Namespace,
+				Name:      lr.Name,
+			},
+		})
+	}
+}
+
+func isServiceReferredByListenerRule(lr *elbv2api.ListenerRule, svcName string) bool {
+	if lr.Spec.Action.ForwardConfig == nil {
+		return false
+	}
+	for _, tgt := range lr.Spec.Action.ForwardConfig.TargetGroups {
+		if tgt.ServiceName != nil && *tgt.ServiceName == svcName {
+			return true
+		}
+	}
+	return false
+}
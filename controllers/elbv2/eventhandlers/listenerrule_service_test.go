@@ -0,0 +1,73 @@
+package eventhandlers
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"testing"
+)
+
+func Test_isServiceReferredByListenerRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  elbv2api.RuleAction
+		svcName string
+		want    bool
+	}{
+		{
+			name: "forward to service",
+			action: elbv2api.RuleAction{
+				Type: elbv2api.RuleActionTypeForward,
+				ForwardConfig: &elbv2api.ForwardActionConfig{
+					TargetGroups: []elbv2api.TargetGroupTuple{
+						{
+							TargetGroupARN: awssdk.String("tg-arn"),
+						},
+						{
+							ServiceName: awssdk.String("awesome-svc"),
+						},
+					},
+				},
+			},
+			svcName: "awesome-svc",
+			want:    true,
+		},
+		{
+			name: "forward to other service",
+			action: elbv2api.RuleAction{
+				Type: elbv2api.RuleActionTypeForward,
+				ForwardConfig: &elbv2api.ForwardActionConfig{
+					TargetGroups: []elbv2api.TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("other-svc"),
+						},
+					},
+				},
+			},
+			svcName: "awesome-svc",
+			want:    false,
+		},
+		{
+			name: "fixed response",
+			action: elbv2api.RuleAction{
+				Type: elbv2api.RuleActionTypeFixedResponse,
+				FixedResponseConfig: &elbv2api.FixedResponseActionConfig{
+					StatusCode: "404",
+				},
+			},
+			svcName: "awesome-svc",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := &elbv2api.ListenerRule{
+				Spec: elbv2api.ListenerRuleSpec{
+					Action: tt.action,
+				},
+			}
+			got := isServiceReferredByListenerRule(lr, tt.svcName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
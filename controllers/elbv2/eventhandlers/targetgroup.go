@@ -0,0 +1,85 @@
+package eventhandlers
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForTargetGroupEvent constructs new enqueueRequestsForTargetGroupEvent.
+func NewEnqueueRequestsForTargetGroupEvent(k8sClient client.Client, logger logr.Logger) handler.EventHandler {
+	return &enqueueRequestsForTargetGroupEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+type enqueueRequestsForTargetGroupEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+// Create is called in response to an create event - e.g. Pod Creation.
+func (h *enqueueRequestsForTargetGroupEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	tgNew := e.Object.(*elbv2api.TargetGroup)
+	if tgNew.Status.TargetGroupARN != nil {
+		h.enqueueReferringTargetGroupBindings(queue, tgNew)
+	}
+}
+
+// Update is called in response to an update event -  e.g. Pod Updated.
+// TargetGroupBindings are enqueued when the TargetGroup is provisioned or replaced.
+func (h *enqueueRequestsForTargetGroupEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	tgOld := e.ObjectOld.(*elbv2api.TargetGroup)
+	tgNew := e.ObjectNew.(*elbv2api.TargetGroup)
+	if awssdk.StringValue(tgOld.Status.TargetGroupARN) != awssdk.StringValue(tgNew.Status.TargetGroupARN) {
+		h.enqueueReferringTargetGroupBindings(queue, tgNew)
+	}
+}
+
+// Delete is called in response to a delete event - e.g. Pod Deleted.
+func (h *enqueueRequestsForTargetGroupEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request - e.g. reconcile AutoScaling, or a WebHook.
+func (h *enqueueRequestsForTargetGroupEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here
+}
+
+// enqueueReferringTargetGroupBindings will enqueue all TargetGroupBindings that refer to TargetGroup by targetGroupName.
+func (h *enqueueRequestsForTargetGroupEvent) enqueueReferringTargetGroupBindings(queue workqueue.RateLimitingInterface, tg *elbv2api.TargetGroup) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := h.k8sClient.List(context.Background(), tgbList, client.InNamespace(tg.Namespace)); err != nil {
+		h.logger.Error(err, "failed to fetch targetGroupBindings")
+		return
+	}
+
+	tgKey := k8s.NamespacedName(tg)
+	for _, tgb := range tgbList.Items {
+		if tgb.Spec.TargetGroupName != tg.Name {
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue targetGroupBinding for targetGroup event",
+			"targetGroup", tgKey,
+			"targetGroupBinding", k8s.NamespacedName(&tgb),
+		)
+		queue.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: tgb.Namespace,
+				Name:      tgb.Name,
+			},
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueTargetGroupsForTargetGroupBindingEvent constructs new enqueueTargetGroupsForTargetGroupBindingEvent.
+func NewEnqueueTargetGroupsForTargetGroupBindingEvent(logger logr.Logger) handler.EventHandler {
+	return &enqueueTargetGroupsForTargetGroupBindingEvent{
+		logger: logger,
+	}
+}
+
+type enqueueTargetGroupsForTargetGroupBindingEvent struct {
+	logger logr.Logger
+}
+
+// Create is called in response to an create event - e.g. Pod Creation.
+func (h *enqueueTargetGroupsForTargetGroupBindingEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here
+}
+
+// Update is called in response to an update event -  e.g. Pod Updated.
+func (h *enqueueTargetGroupsForTargetGroupBindingEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here
+}
+
+// Delete is called in response to a delete event - e.g. Pod Deleted.
+// TargetGroup deletion is blocked while TargetGroupBindings refer to it, so it's re-enqueued once they're gone.
+func (h *enqueueTargetGroupsForTargetGroupBindingEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	tgbOld := e.Object.(*elbv2api.TargetGroupBinding)
+	h.enqueueReferredTargetGroup(queue, tgbOld)
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request - e.g. reconcile AutoScaling, or a WebHook.
+func (h *enqueueTargetGroupsForTargetGroupBindingEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here
+}
+
+// enqueueReferredTargetGroup will enqueue the TargetGroup referred by targetGroupBinding.
+func (h *enqueueTargetGroupsForTargetGroupBindingEvent) enqueueReferredTargetGroup(queue workqueue.RateLimitingInterface, tgb *elbv2api.TargetGroupBinding) {
+	if tgb.Spec.TargetGroupName == "" {
+		return
+	}
+	tgKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.TargetGroupName}
+	h.logger.V(1).Info("enqueue targetGroup for targetGroupBinding event",
+		"targetGroupBinding", k8s.NamespacedName(tgb),
+		"targetGroup", tgKey,
+	)
+	queue.Add(reconcile.Request{NamespacedName: tgKey})
+}
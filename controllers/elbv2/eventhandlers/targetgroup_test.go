@@ -0,0 +1,105 @@
+package eventhandlers
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	ctrl "sigs.k8s.io/controller-runtime"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_enqueueRequestsForTargetGroupEvent_Update(t *testing.T) {
+	tgbs := []*elbv2api.TargetGroupBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb-1"},
+			Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupName: "awesome-tg"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb-2"},
+			Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupName: "other-tg"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb-3"},
+			Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-1"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "tgb-4"},
+			Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupName: "awesome-tg"},
+		},
+	}
+	type args struct {
+		tgOldARN *string
+		tgNewARN *string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantRequests []ctrl.Request
+	}{
+		{
+			name: "targetGroup is provisioned",
+			args: args{
+				tgOldARN: nil,
+				tgNewARN: awssdk.String("tg-1"),
+			},
+			wantRequests: []ctrl.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-1"}},
+			},
+		},
+		{
+			name: "targetGroup is replaced",
+			args: args{
+				tgOldARN: awssdk.String("tg-1"),
+				tgNewARN: awssdk.String("tg-2"),
+			},
+			wantRequests: []ctrl.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-1"}},
+			},
+		},
+		{
+			name: "targetGroupARN isn't changed",
+			args: args{
+				tgOldARN: awssdk.String("tg-1"),
+				tgNewARN: awssdk.String("tg-1"),
+			},
+			wantRequests: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, tgb := range tgbs {
+				assert.NoError(t, k8sClient.Create(context.Background(), tgb.DeepCopy()))
+			}
+
+			h := NewEnqueueRequestsForTargetGroupEvent(k8sClient, &log.NullLogger{})
+			queue := controllertest.Queue{Interface: workqueue.New()}
+			tgOld := &elbv2api.TargetGroup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+				Status:     elbv2api.TargetGroupStatus{TargetGroupARN: tt.args.tgOldARN},
+			}
+			tgNew := tgOld.DeepCopy()
+			tgNew.Status.TargetGroupARN = tt.args.tgNewARN
+			h.Update(event.UpdateEvent{ObjectOld: tgOld, ObjectNew: tgNew}, queue)
+			gotRequests := testutils.ExtractCTRLRequestsFromQueue(queue)
+			assert.True(t, cmp.Equal(tt.wantRequests, gotRequests),
+				"diff", cmp.Diff(tt.wantRequests, gotRequests))
+		})
+	}
+}
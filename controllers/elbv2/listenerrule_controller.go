@@ -0,0 +1,256 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/listenerrule"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	listenerRuleControllerName = "listenerRule"
+	listenerRuleFinalizer      = "listener-rule.k8s.aws/resources"
+	listenerRuleTagPrefix      = "listener-rule.k8s.aws"
+)
+
+// NewListenerRuleReconciler constructs new listenerRuleReconciler
+func NewListenerRuleReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
+	networkingSGReconciler networkingpkg.SecurityGroupReconciler, config config.ControllerConfig,
+	logger logr.Logger) *listenerRuleReconciler {
+
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	trackingProvider := tracking.NewDefaultProvider(listenerRuleTagPrefix, config.ClusterName)
+	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), logger)
+	modelBuilder := listenerrule.NewDefaultModelBuilder(k8sClient, cloud.ELBV2(), annotationParser, trackingProvider,
+		elbv2TaggingManager, cloud.VpcID(), config.ClusterName, config.DefaultTags, config.ExternalManagedTags,
+		config.ListenerRuleConfig.MinPriority, config.ListenerRuleConfig.MaxPriority,
+		config.ListenerRuleConfig.AllowedListenerARNs, logger)
+	stackMarshaller := deploy.NewDefaultStackMarshaller()
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
+		config, listenerRuleTagPrefix, logger)
+	lrManager := elbv2deploy.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager,
+		config.ExternalManagedTags, logger)
+
+	return &listenerRuleReconciler{
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		finalizerManager:    finalizerManager,
+		modelBuilder:        modelBuilder,
+		stackMarshaller:     stackMarshaller,
+		stackDeployer:       stackDeployer,
+		trackingProvider:    trackingProvider,
+		elbv2TaggingManager: elbv2TaggingManager,
+		lrManager:           lrManager,
+		logger:              logger,
+
+		maxConcurrentReconciles: config.ListenerRuleConfig.MaxConcurrentReconciles,
+	}
+}
+
+// listenerRuleReconciler reconciles a ListenerRule object.
+// It manages the rule created on the shared listener, along with the TargetGroups and TargetGroupBindings for referenced Services.
+type listenerRuleReconciler struct {
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	finalizerManager    k8s.FinalizerManager
+	modelBuilder        listenerrule.ModelBuilder
+	stackMarshaller     deploy.StackMarshaller
+	stackDeployer       deploy.StackDeployer
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	lrManager           elbv2deploy.ListenerRuleManager
+	logger              logr.Logger
+
+	maxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=listenerrules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=listenerrules/status,verbs=update;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *listenerRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *listenerRuleReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	lr := &elbv2api.ListenerRule{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, lr); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !lr.DeletionTimestamp.IsZero() {
+		return r.cleanupListenerRuleResources(ctx, lr)
+	}
+	return r.reconcileListenerRuleResources(ctx, lr)
+}
+
+func (r *listenerRuleReconciler) reconcileListenerRuleResources(ctx context.Context, lr *elbv2api.ListenerRule) error {
+	if err := r.finalizerManager.AddFinalizers(ctx, lr, listenerRuleFinalizer); err != nil {
+		r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	stack, resLR, err := r.modelBuilder.Build(ctx, lr)
+	if err != nil {
+		r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if err := r.deployModel(ctx, lr, stack); err != nil {
+		return err
+	}
+	// the rule on previous listener is no longer part of the stack once listenerARN changes, so it's deleted explicitly.
+	if lr.Status.ListenerARN != nil && *lr.Status.ListenerARN != lr.Spec.ListenerARN {
+		if err := r.deleteListenerRules(ctx, lr, *lr.Status.ListenerARN); err != nil {
+			r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedCleanup, fmt.Sprintf("Failed cleanup due to %v", err))
+			return err
+		}
+	}
+	if err := r.updateListenerRuleStatus(ctx, lr, resLR.Status.RuleARN, resLR.Spec.Priority); err != nil {
+		r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	r.eventRecorder.Event(lr, corev1.EventTypeNormal, k8s.ListenerRuleEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+// cleanupListenerRuleResources deletes the rule on shared listener, then deletes the other AWS resources provisioned
+// for ListenerRule by deploying an empty stack.
+func (r *listenerRuleReconciler) cleanupListenerRuleResources(ctx context.Context, lr *elbv2api.ListenerRule) error {
+	if !k8s.HasFinalizer(lr, listenerRuleFinalizer) {
+		return nil
+	}
+	lsARNs := sets.NewString(lr.Spec.ListenerARN)
+	if lr.Status.ListenerARN != nil {
+		lsARNs.Insert(*lr.Status.ListenerARN)
+	}
+	for _, lsARN := range lsARNs.List() {
+		if err := r.deleteListenerRules(ctx, lr, lsARN); err != nil {
+			r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedCleanup, fmt.Sprintf("Failed cleanup due to %v", err))
+			return err
+		}
+	}
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(lr)))
+	if err := r.deployModel(ctx, lr, stack); err != nil {
+		return err
+	}
+	if err := r.finalizerManager.RemoveFinalizers(ctx, lr, listenerRuleFinalizer); err != nil {
+		r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// deleteListenerRules deletes the rules created for ListenerRule on specified listener.
+// rules owned by others on the listener are left untouched.
+func (r *listenerRuleReconciler) deleteListenerRules(ctx context.Context, lr *elbv2api.ListenerRule, lsARN string) error {
+	sdkLRs, err := r.elbv2TaggingManager.ListListenerRules(ctx, lsARN)
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == "ListenerNotFound" {
+			return nil
+		}
+		return err
+	}
+	stackTags := r.trackingProvider.StackTags(core.NewDefaultStack(core.StackID(k8s.NamespacedName(lr))))
+	for _, sdkLR := range sdkLRs {
+		if awssdk.BoolValue(sdkLR.ListenerRule.IsDefault) || !algorithm.IsStringMapSubset(stackTags, sdkLR.Tags) {
+			continue
+		}
+		if err := r.lrManager.Delete(ctx, sdkLR); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *listenerRuleReconciler) deployModel(ctx context.Context, lr *elbv2api.ListenerRule, stack core.Stack) error {
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(lr, corev1.EventTypeWarning, k8s.ListenerRuleEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "listenerRule", k8s.NamespacedName(lr))
+	return nil
+}
+
+func (r *listenerRuleReconciler) updateListenerRuleStatus(ctx context.Context, lr *elbv2api.ListenerRule, ruleARN string, priority int64) error {
+	lrOld := lr.DeepCopy()
+	lr.Status.ObservedGeneration = awssdk.Int64(lr.Generation)
+	lr.Status.ListenerARN = awssdk.String(lr.Spec.ListenerARN)
+	lr.Status.RuleARN = awssdk.String(ruleARN)
+	lr.Status.Priority = awssdk.Int64(priority)
+	if equality.Semantic.DeepEqual(lrOld.Status, lr.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, lr, client.MergeFrom(lrOld)); err != nil {
+		return errors.Wrapf(err, "failed to update listenerRule status: %v", k8s.NamespacedName(lr))
+	}
+	return nil
+}
+
+func (r *listenerRuleReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	c, err := controller.New(listenerRuleControllerName, mgr, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		Reconciler:              r,
+	})
+	if err != nil {
+		return err
+	}
+	svcEventHandler := eventhandlers.NewEnqueueListenerRulesForServiceEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("service"))
+	if err := c.Watch(&source.Kind{Type: &elbv2api.ListenerRule{}}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
+		return err
+	}
+	return nil
+}
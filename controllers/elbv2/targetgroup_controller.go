@@ -0,0 +1,222 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroup"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	targetGroupControllerName = "targetGroup"
+	targetGroupFinalizer      = "target-group.k8s.aws/resources"
+	targetGroupTagPrefix      = "target-group.k8s.aws"
+)
+
+// NewTargetGroupReconciler constructs new targetGroupReconciler
+func NewTargetGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
+	networkingSGReconciler networkingpkg.SecurityGroupReconciler, config config.ControllerConfig,
+	logger logr.Logger) *targetGroupReconciler {
+
+	modelBuilder := targetgroup.NewDefaultModelBuilder(config.ClusterName, config.DefaultTags, config.ExternalManagedTags)
+	stackMarshaller := deploy.NewDefaultStackMarshaller()
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
+		config, targetGroupTagPrefix, logger)
+
+	return &targetGroupReconciler{
+		k8sClient:        k8sClient,
+		eventRecorder:    eventRecorder,
+		finalizerManager: finalizerManager,
+		modelBuilder:     modelBuilder,
+		stackMarshaller:  stackMarshaller,
+		stackDeployer:    stackDeployer,
+		logger:           logger,
+
+		maxConcurrentReconciles: config.TargetGroupMaxConcurrentReconciles,
+	}
+}
+
+// targetGroupReconciler reconciles a TargetGroup object.
+type targetGroupReconciler struct {
+	k8sClient        client.Client
+	eventRecorder    record.EventRecorder
+	finalizerManager k8s.FinalizerManager
+	modelBuilder     targetgroup.ModelBuilder
+	stackMarshaller  deploy.StackMarshaller
+	stackDeployer    deploy.StackDeployer
+	logger           logr.Logger
+
+	maxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroups/status,verbs=update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *targetGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *targetGroupReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	tg := &elbv2api.TargetGroup{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, tg); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !tg.DeletionTimestamp.IsZero() {
+		return r.cleanupTargetGroupResources(ctx, tg)
+	}
+	return r.reconcileTargetGroupResources(ctx, tg)
+}
+
+func (r *targetGroupReconciler) reconcileTargetGroupResources(ctx context.Context, tg *elbv2api.TargetGroup) error {
+	if err := r.finalizerManager.AddFinalizers(ctx, tg, targetGroupFinalizer); err != nil {
+		r.eventRecorder.Event(tg, corev1.EventTypeWarning, k8s.TargetGroupEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	stack, resTG, err := r.modelBuilder.Build(ctx, tg)
+	if err != nil {
+		r.eventRecorder.Event(tg, corev1.EventTypeWarning, k8s.TargetGroupEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if err := r.deployModel(ctx, tg, stack); err != nil {
+		return err
+	}
+	tgARN, err := resTG.TargetGroupARN().Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	if err := r.updateTargetGroupStatus(ctx, tg, tgARN); err != nil {
+		r.eventRecorder.Event(tg, corev1.EventTypeWarning, k8s.TargetGroupEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	r.eventRecorder.Event(tg, corev1.EventTypeNormal, k8s.TargetGroupEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+// cleanupTargetGroupResources deletes the AWS TargetGroup by deploying an empty stack.
+// the deletion is deferred until no TargetGroupBinding refers to the TargetGroup, so that targets can be deregistered first.
+func (r *targetGroupReconciler) cleanupTargetGroupResources(ctx context.Context, tg *elbv2api.TargetGroup) error {
+	if !k8s.HasFinalizer(tg, targetGroupFinalizer) {
+		return nil
+	}
+	tgbs, err := r.listReferringTargetGroupBindings(ctx, tg)
+	if err != nil {
+		return err
+	}
+	if len(tgbs) != 0 {
+		r.eventRecorder.Event(tg, corev1.EventTypeWarning, k8s.TargetGroupEventReasonFailedCleanup,
+			fmt.Sprintf("Failed cleanup due to TargetGroupBinding %v still refers to it", k8s.NamespacedName(tgbs[0])))
+		return runtime.NewRequeueNeeded("targetGroup is still referred by targetGroupBindings")
+	}
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(tg)))
+	if err := r.deployModel(ctx, tg, stack); err != nil {
+		return err
+	}
+	if err := r.finalizerManager.RemoveFinalizers(ctx, tg, targetGroupFinalizer); err != nil {
+		r.eventRecorder.Event(tg, corev1.EventTypeWarning, k8s.TargetGroupEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// listReferringTargetGroupBindings returns the TargetGroupBindings that refer to TargetGroup by targetGroupName.
+func (r *targetGroupReconciler) listReferringTargetGroupBindings(ctx context.Context, tg *elbv2api.TargetGroup) ([]*elbv2api.TargetGroupBinding, error) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := r.k8sClient.List(ctx, tgbList, client.InNamespace(tg.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list targetGroupBindings")
+	}
+	var tgbs []*elbv2api.TargetGroupBinding
+	for i := range tgbList.Items {
+		tgb := &tgbList.Items[i]
+		if tgb.Spec.TargetGroupName == tg.Name {
+			tgbs = append(tgbs, tgb)
+		}
+	}
+	return tgbs, nil
+}
+
+func (r *targetGroupReconciler) deployModel(ctx context.Context, tg *elbv2api.TargetGroup, stack core.Stack) error {
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.eventRecorder.Event(tg, corev1.EventTypeWarning, k8s.TargetGroupEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(tg, corev1.EventTypeWarning, k8s.TargetGroupEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "targetGroup", k8s.NamespacedName(tg))
+	return nil
+}
+
+func (r *targetGroupReconciler) updateTargetGroupStatus(ctx context.Context, tg *elbv2api.TargetGroup, tgARN string) error {
+	tgOld := tg.DeepCopy()
+	tg.Status.ObservedGeneration = awssdk.Int64(tg.Generation)
+	tg.Status.TargetGroupARN = awssdk.String(tgARN)
+	if equality.Semantic.DeepEqual(tgOld.Status, tg.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, tg, client.MergeFrom(tgOld)); err != nil {
+		return errors.Wrapf(err, "failed to update targetGroup status: %v", k8s.NamespacedName(tg))
+	}
+	return nil
+}
+
+func (r *targetGroupReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	c, err := controller.New(targetGroupControllerName, mgr, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		Reconciler:              r,
+	})
+	if err != nil {
+		return err
+	}
+	tgbEventHandler := eventhandlers.NewEnqueueTargetGroupsForTargetGroupBindingEvent(
+		r.logger.WithName("eventHandlers").WithName("targetGroupBinding"))
+	if err := c.Watch(&source.Kind{Type: &elbv2api.TargetGroup{}}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &elbv2api.TargetGroupBinding{}}, tgbEventHandler); err != nil {
+		return err
+	}
+	return nil
+}
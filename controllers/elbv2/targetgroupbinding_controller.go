@@ -24,6 +24,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
@@ -48,7 +53,7 @@ const (
 
 // NewTargetGroupBindingReconciler constructs new targetGroupBindingReconciler
 func NewTargetGroupBindingReconciler(k8sClient client.Client, eventRecorder record.EventRecorder, finalizerManager k8s.FinalizerManager,
-	tgbResourceManager targetgroupbinding.ResourceManager, config config.ControllerConfig,
+	tgbResourceManager targetgroupbinding.ResourceManager, config config.ControllerConfig, endpointSliceEnabled bool,
 	logger logr.Logger) *targetGroupBindingReconciler {
 
 	return &targetGroupBindingReconciler{
@@ -58,6 +63,7 @@ func NewTargetGroupBindingReconciler(k8sClient client.Client, eventRecorder reco
 		tgbResourceManager: tgbResourceManager,
 		logger:             logger,
 
+		endpointSliceEnabled:       endpointSliceEnabled,
 		maxConcurrentReconciles:    config.TargetGroupBindingMaxConcurrentReconciles,
 		maxExponentialBackoffDelay: config.TargetGroupBindingMaxExponentialBackoffDelay,
 	}
@@ -71,17 +77,21 @@ type targetGroupBindingReconciler struct {
 	tgbResourceManager targetgroupbinding.ResourceManager
 	logger             logr.Logger
 
+	endpointSliceEnabled       bool
 	maxConcurrentReconciles    int
 	maxExponentialBackoffDelay time.Duration
 }
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=get;list;watch;update;patch;create;delete
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings/status,verbs=update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroups,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups="discovery.k8s.io",resources=endpointslices,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update;delete
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
@@ -106,23 +116,88 @@ func (r *targetGroupBindingReconciler) reconcileTargetGroupBinding(ctx context.C
 		r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
-	if err := r.tgbResourceManager.Reconcile(ctx, tgb); err != nil {
+	if err := r.resolveTargetGroupARN(ctx, tgb); err != nil {
 		return err
 	}
-	if err := r.updateTargetGroupBindingStatus(ctx, tgb); err != nil {
+	tgbOld := tgb.DeepCopy()
+	// the resourceManager populates observed status into tgb, which should be persisted even if requeue is needed.
+	reconcileErr := r.tgbResourceManager.Reconcile(ctx, tgb)
+	if reconcileErr == nil {
+		tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
+	}
+	updateTargetGroupBindingConditions(tgb, reconcileErr)
+	if err := r.updateTargetGroupBindingStatus(ctx, tgbOld, tgb); err != nil {
 		r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
+	if reconcileErr != nil {
+		return reconcileErr
+	}
 
 	r.eventRecorder.Event(tgb, corev1.EventTypeNormal, k8s.TargetGroupBindingEventReasonSuccessfullyReconciled, "Successfully reconciled")
 	return nil
 }
 
+// resolveTargetGroupARN resolves targetGroupARN from the TargetGroup referenced by targetGroupName.
+// the TargetGroupBinding follows the TargetGroup once it's provisioned, and when it's replaced with a new ARN.
+func (r *targetGroupBindingReconciler) resolveTargetGroupARN(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if tgb.Spec.TargetGroupName == "" {
+		return nil
+	}
+	tgKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.TargetGroupName}
+	tg := &elbv2api.TargetGroup{}
+	if err := r.k8sClient.Get(ctx, tgKey, tg); err != nil {
+		r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedResolveTargetGroup, fmt.Sprintf("Failed resolve TargetGroup due to %v", err))
+		return errors.Wrapf(err, "couldn't load TargetGroup: %v", tgKey)
+	}
+	if tg.Status.TargetGroupARN == nil {
+		return runtime.NewRequeueNeeded(fmt.Sprintf("TargetGroup %v is not provisioned yet", tgKey))
+	}
+	tgARN := aws.StringValue(tg.Status.TargetGroupARN)
+	if tgb.Spec.TargetGroupARN == tgARN {
+		return nil
+	}
+	// targetType and ipAddressType of TargetGroupBinding are immutable, it cannot follow a TargetGroup replaced due to them.
+	if err := checkTargetGroupBindingMatchesTargetGroup(tgb, tg); err != nil {
+		r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedResolveTargetGroup, fmt.Sprintf("Failed resolve TargetGroup due to %v", err))
+		return err
+	}
+	tgbOld := tgb.DeepCopy()
+	tgb.Spec.TargetGroupARN = tgARN
+	if err := r.k8sClient.Patch(ctx, tgb, client.MergeFrom(tgbOld)); err != nil {
+		r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedResolveTargetGroup, fmt.Sprintf("Failed resolve TargetGroup due to %v", err))
+		return errors.Wrapf(err, "failed to update targetGroupBinding targetGroupARN: %v", k8s.NamespacedName(tgb))
+	}
+	return nil
+}
+
+// checkTargetGroupBindingMatchesTargetGroup checks the targetType and ipAddressType of TargetGroupBinding matches the TargetGroup.
+func checkTargetGroupBindingMatchesTargetGroup(tgb *elbv2api.TargetGroupBinding, tg *elbv2api.TargetGroup) error {
+	if tgb.Spec.TargetType != nil && *tgb.Spec.TargetType != tg.Spec.TargetType {
+		return errors.Errorf("targetType %v mismatches TargetGroup %v: %v", *tgb.Spec.TargetType, k8s.NamespacedName(tg), tg.Spec.TargetType)
+	}
+	tgbIPAddressType := elbv2api.TargetGroupIPAddressTypeIPv4
+	if tgb.Spec.IPAddressType != nil {
+		tgbIPAddressType = *tgb.Spec.IPAddressType
+	}
+	tgIPAddressType := elbv2api.TargetGroupIPAddressTypeIPv4
+	if tg.Spec.IPAddressType != nil {
+		tgIPAddressType = *tg.Spec.IPAddressType
+	}
+	if tgbIPAddressType != tgIPAddressType {
+		return errors.Errorf("ipAddressType %v mismatches TargetGroup %v: %v", tgbIPAddressType, k8s.NamespacedName(tg), tgIPAddressType)
+	}
+	return nil
+}
+
 func (r *targetGroupBindingReconciler) cleanupTargetGroupBinding(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	if k8s.HasFinalizer(tgb, targetGroupBindingFinalizer) {
-		if err := r.tgbResourceManager.Cleanup(ctx, tgb); err != nil {
-			r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedCleanup, fmt.Sprintf("Failed cleanup due to %v", err))
-			return err
+		// there is nothing registered if the TargetGroup referenced by targetGroupName was never resolved.
+		if tgb.Spec.TargetGroupARN != "" {
+			if err := r.tgbResourceManager.Cleanup(ctx, tgb); err != nil {
+				r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedCleanup, fmt.Sprintf("Failed cleanup due to %v", err))
+				return err
+			}
 		}
 		if err := r.finalizerManager.RemoveFinalizers(ctx, tgb, targetGroupBindingFinalizer); err != nil {
 			r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
@@ -132,18 +207,50 @@ func (r *targetGroupBindingReconciler) cleanupTargetGroupBinding(ctx context.Con
 	return nil
 }
 
-func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	if aws.Int64Value(tgb.Status.ObservedGeneration) == tgb.Generation {
+func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgbOld *elbv2api.TargetGroupBinding, tgb *elbv2api.TargetGroupBinding) error {
+	if equality.Semantic.DeepEqual(tgbOld.Status, tgb.Status) {
 		return nil
 	}
-	tgbOld := tgb.DeepCopy()
-	tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
 	if err := r.k8sClient.Status().Patch(ctx, tgb, client.MergeFrom(tgbOld)); err != nil {
 		return errors.Wrapf(err, "failed to update targetGroupBinding status: %v", k8s.NamespacedName(tgb))
 	}
 	return nil
 }
 
+// updateTargetGroupBindingConditions updates the Ready and Degraded conditions of TargetGroupBinding.
+// requeue requests from resourceManager are not considered as reconcile failures.
+func updateTargetGroupBindingConditions(tgb *elbv2api.TargetGroupBinding, reconcileErr error) {
+	var requeueNeeded *runtime.RequeueNeeded
+	var requeueNeededAfter *runtime.RequeueNeededAfter
+	readyCond := metav1.Condition{
+		Type:               elbv2api.TargetGroupBindingConditionReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Reconciled",
+		Message:            "Targets are successfully reconciled",
+		ObservedGeneration: tgb.Generation,
+	}
+	if reconcileErr != nil && !errors.As(reconcileErr, &requeueNeeded) && !errors.As(reconcileErr, &requeueNeededAfter) {
+		readyCond.Status = metav1.ConditionFalse
+		readyCond.Reason = "ReconcileFailed"
+		readyCond.Message = reconcileErr.Error()
+	}
+	meta.SetStatusCondition(&tgb.Status.Conditions, readyCond)
+
+	degradedCond := metav1.Condition{
+		Type:               elbv2api.TargetGroupBindingConditionDegraded,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NoUnhealthyTargets",
+		Message:            "No targets are unhealthy",
+		ObservedGeneration: tgb.Generation,
+	}
+	if tgb.Status.Targets != nil && tgb.Status.Targets.Unhealthy > 0 {
+		degradedCond.Status = metav1.ConditionTrue
+		degradedCond.Reason = "UnhealthyTargets"
+		degradedCond.Message = fmt.Sprintf("%d of %d targets are unhealthy", tgb.Status.Targets.Unhealthy, tgb.Status.Targets.Registered)
+	}
+	meta.SetStatusCondition(&tgb.Status.Conditions, degradedCond)
+}
+
 func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	if err := r.setupIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
 		return err
@@ -151,16 +258,26 @@ func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr
 
 	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(r.k8sClient,
 		r.logger.WithName("eventHandlers").WithName("service"))
-	epsEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointsEvent(r.k8sClient,
-		r.logger.WithName("eventHandlers").WithName("endpoints"))
 	nodeEventsHandler := eventhandlers.NewEnqueueRequestsForNodeEvent(r.k8sClient,
 		r.logger.WithName("eventHandlers").WithName("node"))
-	return ctrl.NewControllerManagedBy(mgr).
+	tgEventsHandler := eventhandlers.NewEnqueueRequestsForTargetGroupEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("targetGroup"))
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&elbv2api.TargetGroupBinding{}).
 		Named(controllerName).
 		Watches(&source.Kind{Type: &corev1.Service{}}, svcEventHandler).
-		Watches(&source.Kind{Type: &corev1.Endpoints{}}, epsEventsHandler).
 		Watches(&source.Kind{Type: &corev1.Node{}}, nodeEventsHandler).
+		Watches(&source.Kind{Type: &elbv2api.TargetGroup{}}, tgEventsHandler)
+	if r.endpointSliceEnabled {
+		epSlicesEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointSlicesEvent(r.k8sClient,
+			r.logger.WithName("eventHandlers").WithName("endpointSlices"))
+		builder = builder.Watches(&source.Kind{Type: &discovery.EndpointSlice{}}, epSlicesEventsHandler)
+	} else {
+		epsEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointsEvent(r.k8sClient,
+			r.logger.WithName("eventHandlers").WithName("endpoints"))
+		builder = builder.Watches(&source.Kind{Type: &corev1.Endpoints{}}, epsEventsHandler)
+	}
+	return builder.
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.maxConcurrentReconciles,
 			RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay)}).
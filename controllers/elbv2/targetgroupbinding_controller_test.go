@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_targetGroupBindingReconciler_resolveTargetGroupARN(t *testing.T) {
+	instanceTargetType := elbv2api.TargetTypeInstance
+	ipv6AddressType := elbv2api.TargetGroupIPAddressTypeIPv6
+	tests := []struct {
+		name         string
+		tgs          []*elbv2api.TargetGroup
+		tgb          *elbv2api.TargetGroupBinding
+		wantTGARN    string
+		wantRequeue  bool
+		wantErrorMsg string
+	}{
+		{
+			name: "targetGroupARN is resolved once targetGroup is provisioned",
+			tgs: []*elbv2api.TargetGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					Spec:       elbv2api.TargetGroupSpec{TargetType: elbv2api.TargetTypeInstance},
+					Status:     elbv2api.TargetGroupStatus{TargetGroupARN: awssdk.String("tg-1")},
+				},
+			},
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+			wantTGARN: "tg-1",
+		},
+		{
+			name: "targetGroupARN follows replaced targetGroup",
+			tgs: []*elbv2api.TargetGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					Spec:       elbv2api.TargetGroupSpec{TargetType: elbv2api.TargetTypeInstance},
+					Status:     elbv2api.TargetGroupStatus{TargetGroupARN: awssdk.String("tg-2")},
+				},
+			},
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:  "tg-1",
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+			wantTGARN: "tg-2",
+		},
+		{
+			name: "targetGroupARN doesn't follow targetGroup replaced with another targetType",
+			tgs: []*elbv2api.TargetGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					Spec:       elbv2api.TargetGroupSpec{TargetType: elbv2api.TargetTypeIP},
+					Status:     elbv2api.TargetGroupStatus{TargetGroupARN: awssdk.String("tg-2")},
+				},
+			},
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:  "tg-1",
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+			wantTGARN:    "tg-1",
+			wantErrorMsg: "targetType instance mismatches TargetGroup awesome-ns/awesome-tg: ip",
+		},
+		{
+			name: "targetGroupARN doesn't follow targetGroup replaced with another ipAddressType",
+			tgs: []*elbv2api.TargetGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					Spec: elbv2api.TargetGroupSpec{
+						TargetType:    elbv2api.TargetTypeInstance,
+						IPAddressType: &ipv6AddressType,
+					},
+					Status: elbv2api.TargetGroupStatus{TargetGroupARN: awssdk.String("tg-2")},
+				},
+			},
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:  "tg-1",
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+			wantTGARN:    "tg-1",
+			wantErrorMsg: "ipAddressType ipv4 mismatches TargetGroup awesome-ns/awesome-tg: ipv6",
+		},
+		{
+			name: "targetGroup isn't provisioned yet",
+			tgs: []*elbv2api.TargetGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+				},
+			},
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+			wantTGARN:   "",
+			wantRequeue: true,
+		},
+		{
+			name: "targetGroup doesn't exist",
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+			wantTGARN:    "",
+			wantErrorMsg: "couldn't load TargetGroup: awesome-ns/awesome-tg",
+		},
+		{
+			name: "targetGroupBinding without targetGroupName",
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-1",
+					TargetType:     &instanceTargetType,
+				},
+			},
+			wantTGARN: "tg-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := k8sruntime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, tg := range tt.tgs {
+				assert.NoError(t, k8sClient.Create(ctx, tg.DeepCopy()))
+			}
+			tgb := tt.tgb.DeepCopy()
+			assert.NoError(t, k8sClient.Create(ctx, tgb))
+
+			r := &targetGroupBindingReconciler{
+				k8sClient:     k8sClient,
+				eventRecorder: record.NewFakeRecorder(10),
+				logger:        &log.NullLogger{},
+			}
+			err := r.resolveTargetGroupARN(ctx, tgb)
+			var requeueNeeded *runtime.RequeueNeeded
+			switch {
+			case tt.wantRequeue:
+				assert.True(t, errors.As(err, &requeueNeeded))
+			case tt.wantErrorMsg != "":
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrorMsg)
+			default:
+				assert.NoError(t, err)
+			}
+
+			persistedTGB := &elbv2api.TargetGroupBinding{}
+			assert.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(tgb), persistedTGB))
+			assert.Equal(t, tt.wantTGARN, persistedTGB.Spec.TargetGroupARN)
+			assert.Equal(t, tt.wantTGARN, tgb.Spec.TargetGroupARN)
+		})
+	}
+}
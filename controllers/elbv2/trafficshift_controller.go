@@ -0,0 +1,141 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/trafficshift"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	trafficShiftControllerName = "trafficShift"
+)
+
+// NewTrafficShiftReconciler constructs new trafficShiftReconciler
+func NewTrafficShiftReconciler(k8sClient client.Client, eventRecorder record.EventRecorder,
+	canaryAnalyzer trafficshift.CanaryAnalyzer, logger logr.Logger) *trafficShiftReconciler {
+	return &trafficShiftReconciler{
+		k8sClient:      k8sClient,
+		eventRecorder:  eventRecorder,
+		canaryAnalyzer: canaryAnalyzer,
+		logger:         logger,
+	}
+}
+
+// trafficShiftReconciler reconciles a TrafficShift object.
+// It only progresses the status of TrafficShift, the weights are applied by ingress controller according to status.
+type trafficShiftReconciler struct {
+	k8sClient      client.Client
+	eventRecorder  record.EventRecorder
+	canaryAnalyzer trafficshift.CanaryAnalyzer
+	logger         logr.Logger
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficshifts,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficshifts/status,verbs=update;patch
+
+func (r *trafficShiftReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *trafficShiftReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	ts := &elbv2api.TrafficShift{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, ts); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !ts.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	tsOld := ts.DeepCopy()
+	now := time.Now()
+	if ts.Status.ObservedGeneration == nil || *ts.Status.ObservedGeneration != ts.Generation {
+		trafficshift.Start(ts, now)
+	}
+	var requeueAfter time.Duration
+	if ts.Status.Phase == elbv2api.TrafficShiftPhaseProgressing {
+		result, err := r.canaryAnalyzer.Analyze(ctx, ts)
+		if err != nil {
+			r.eventRecorder.Event(ts, corev1.EventTypeWarning, k8s.TrafficShiftEventReasonFailedAnalysis, fmt.Sprintf("Failed analysis due to %v", err))
+			if statusErr := r.updateTrafficShiftStatus(ctx, tsOld, ts); statusErr != nil {
+				return statusErr
+			}
+			return err
+		}
+		requeueAfter = trafficshift.Progress(ts, result, now)
+	}
+	if err := r.updateTrafficShiftStatus(ctx, tsOld, ts); err != nil {
+		return err
+	}
+	r.recordTransitionEvents(tsOld, ts)
+
+	if requeueAfter > 0 {
+		return runtime.NewRequeueNeededAfter("trafficShift progressing", requeueAfter)
+	}
+	return nil
+}
+
+func (r *trafficShiftReconciler) updateTrafficShiftStatus(ctx context.Context, tsOld *elbv2api.TrafficShift, ts *elbv2api.TrafficShift) error {
+	if equality.Semantic.DeepEqual(tsOld.Status, ts.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, ts, client.MergeFrom(tsOld)); err != nil {
+		r.eventRecorder.Event(ts, corev1.EventTypeWarning, k8s.TrafficShiftEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return errors.Wrapf(err, "failed to update trafficShift status: %v", k8s.NamespacedName(ts))
+	}
+	return nil
+}
+
+func (r *trafficShiftReconciler) recordTransitionEvents(tsOld *elbv2api.TrafficShift, ts *elbv2api.TrafficShift) {
+	if tsOld.Status.Phase == ts.Status.Phase &&
+		tsOld.Status.CurrentStep == ts.Status.CurrentStep &&
+		aws.Int32Value(tsOld.Status.CanaryWeight) == aws.Int32Value(ts.Status.CanaryWeight) {
+		return
+	}
+	switch ts.Status.Phase {
+	case elbv2api.TrafficShiftPhaseProgressing:
+		r.eventRecorder.Event(ts, corev1.EventTypeNormal, k8s.TrafficShiftEventReasonStepProgressed,
+			fmt.Sprintf("Shifted %d%% of traffic to %v at step %d", aws.Int32Value(ts.Status.CanaryWeight), ts.Spec.CanaryService, ts.Status.CurrentStep))
+	case elbv2api.TrafficShiftPhaseSucceeded:
+		r.eventRecorder.Event(ts, corev1.EventTypeNormal, k8s.TrafficShiftEventReasonSucceeded,
+			fmt.Sprintf("Successfully shifted %d%% of traffic to %v", aws.Int32Value(ts.Status.CanaryWeight), ts.Spec.CanaryService))
+	case elbv2api.TrafficShiftPhaseRolledBack:
+		r.eventRecorder.Event(ts, corev1.EventTypeWarning, k8s.TrafficShiftEventReasonRolledBack,
+			fmt.Sprintf("Shifted traffic back to %v due to %v", ts.Spec.StableService, ts.Status.Message))
+	}
+}
+
+func (r *trafficShiftReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.TrafficShift{}).
+		Named(trafficShiftControllerName).
+		Complete(r)
+}
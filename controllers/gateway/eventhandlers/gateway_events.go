@@ -0,0 +1,59 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// NewEnqueueRequestsForGatewayEvent constructs new enqueueRequestsForGatewayEvent.
+func NewEnqueueRequestsForGatewayEvent(logger logr.Logger) *enqueueRequestsForGatewayEvent {
+	return &enqueueRequestsForGatewayEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForGatewayEvent)(nil)
+
+type enqueueRequestsForGatewayEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForGatewayEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueGateway(queue, e.Object.(*gwapi.Gateway))
+}
+
+func (h *enqueueRequestsForGatewayEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	gwOld := e.ObjectOld.(*gwapi.Gateway)
+	gwNew := e.ObjectNew.(*gwapi.Gateway)
+
+	// we only care below update event:
+	//	1. Gateway annotation updates
+	//	2. Gateway spec updates
+	//	3. Gateway deletion
+	if equality.Semantic.DeepEqual(gwOld.Annotations, gwNew.Annotations) &&
+		equality.Semantic.DeepEqual(gwOld.Spec, gwNew.Spec) &&
+		equality.Semantic.DeepEqual(gwOld.DeletionTimestamp.IsZero(), gwNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueGateway(queue, gwNew)
+}
+
+func (h *enqueueRequestsForGatewayEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	// we attach a finalizer during reconcile, and handle the user triggered delete action during the update event.
+	// In case of delete, there will first be an update event with nonzero deletionTimestamp set on the object. Since
+	// deletion is already taken care of during update event, we will ignore this event.
+}
+
+func (h *enqueueRequestsForGatewayEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueGateway(queue, e.Object.(*gwapi.Gateway))
+}
+
+func (h *enqueueRequestsForGatewayEvent) enqueueGateway(queue workqueue.RateLimitingInterface, gw *gwapi.Gateway) {
+	queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)})
+}
@@ -0,0 +1,84 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// NewEnqueueRequestsForHTTPRouteEvent constructs new enqueueRequestsForHTTPRouteEvent.
+func NewEnqueueRequestsForHTTPRouteEvent(k8sClient client.Client, logger logr.Logger) *enqueueRequestsForHTTPRouteEvent {
+	return &enqueueRequestsForHTTPRouteEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForHTTPRouteEvent)(nil)
+
+type enqueueRequestsForHTTPRouteEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwapi.HTTPRoute))
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	routeOld := e.ObjectOld.(*gwapi.HTTPRoute)
+	routeNew := e.ObjectNew.(*gwapi.HTTPRoute)
+
+	if equality.Semantic.DeepEqual(routeOld.Spec, routeNew.Spec) &&
+		equality.Semantic.DeepEqual(routeOld.DeletionTimestamp.IsZero(), routeNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueImpactedGateways(queue, routeOld, routeNew)
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwapi.HTTPRoute))
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwapi.HTTPRoute))
+}
+
+// enqueueImpactedGateways will enqueue all Gateways that routes references.
+func (h *enqueueRequestsForHTTPRouteEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, routes ...*gwapi.HTTPRoute) {
+	enqueueGatewaysReferencedByRoutes(h.k8sClient, queue, h.logger, routes)
+}
+
+// enqueueGatewaysReferencedByRoutes will enqueue all Gateways that any of routes references.
+func enqueueGatewaysReferencedByRoutes(k8sClient client.Client, queue workqueue.RateLimitingInterface, logger logr.Logger, routes []*gwapi.HTTPRoute) {
+	if len(routes) == 0 {
+		return
+	}
+	gwList := &gwapi.GatewayList{}
+	if err := k8sClient.List(context.Background(), gwList); err != nil {
+		logger.Error(err, "failed to fetch gateways")
+		return
+	}
+	for i := range gwList.Items {
+		gw := &gwList.Items[i]
+		for _, route := range routes {
+			if !gateway.IsGatewayReferencedByRoute(gw, route) {
+				continue
+			}
+			logger.V(1).Info("enqueue gateway for httpRoute event",
+				"httpRoute", k8s.NamespacedName(route),
+				"gateway", k8s.NamespacedName(gw))
+			queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)})
+			break
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// NewEnqueueRequestsForReferencePolicyEvent constructs new enqueueRequestsForReferencePolicyEvent.
+func NewEnqueueRequestsForReferencePolicyEvent(k8sClient client.Client, logger logr.Logger) *enqueueRequestsForReferencePolicyEvent {
+	return &enqueueRequestsForReferencePolicyEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForReferencePolicyEvent)(nil)
+
+type enqueueRequestsForReferencePolicyEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForReferencePolicyEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwapi.ReferencePolicy))
+}
+
+func (h *enqueueRequestsForReferencePolicyEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	policyOld := e.ObjectOld.(*gwapi.ReferencePolicy)
+	policyNew := e.ObjectNew.(*gwapi.ReferencePolicy)
+	if equality.Semantic.DeepEqual(policyOld.Spec, policyNew.Spec) {
+		return
+	}
+	h.enqueueImpactedGateways(queue, policyOld, policyNew)
+}
+
+func (h *enqueueRequestsForReferencePolicyEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwapi.ReferencePolicy))
+}
+
+func (h *enqueueRequestsForReferencePolicyEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwapi.ReferencePolicy))
+}
+
+// enqueueImpactedGateways will enqueue all Gateways that routes permitted by the ReferencePolicies references.
+func (h *enqueueRequestsForReferencePolicyEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, policies ...*gwapi.ReferencePolicy) {
+	var impactedRoutes []*gwapi.HTTPRoute
+	for _, policy := range policies {
+		for _, from := range policy.Spec.From {
+			if from.Group != gwapi.GroupName || from.Kind != "HTTPRoute" {
+				continue
+			}
+			routeList := &gwapi.HTTPRouteList{}
+			if err := h.k8sClient.List(context.Background(), routeList, client.InNamespace(string(from.Namespace))); err != nil {
+				h.logger.Error(err, "failed to fetch httpRoutes")
+				return
+			}
+			for i := range routeList.Items {
+				impactedRoutes = append(impactedRoutes, &routeList.Items[i])
+			}
+		}
+	}
+	enqueueGatewaysReferencedByRoutes(h.k8sClient, queue, h.logger, impactedRoutes)
+}
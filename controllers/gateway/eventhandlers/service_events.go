@@ -0,0 +1,88 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
+func NewEnqueueRequestsForServiceEvent(k8sClient client.Client, logger logr.Logger) *enqueueRequestsForServiceEvent {
+	return &enqueueRequestsForServiceEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
+
+type enqueueRequestsForServiceEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	svcOld := e.ObjectOld.(*corev1.Service)
+	svcNew := e.ObjectNew.(*corev1.Service)
+
+	// we only care below update event:
+	//	1. Service annotation updates
+	//	2. Service spec updates
+	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) {
+		return
+	}
+	h.enqueueImpactedGateways(queue, svcNew)
+}
+
+func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+// enqueueImpactedGateways will enqueue all Gateways that routes referencing the Service references.
+// routes can reference Services across namespaces, thus all routes are checked.
+func (h *enqueueRequestsForServiceEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, svc *corev1.Service) {
+	routeList := &gwapi.HTTPRouteList{}
+	if err := h.k8sClient.List(context.Background(), routeList); err != nil {
+		h.logger.Error(err, "failed to fetch httpRoutes")
+		return
+	}
+	var impactedRoutes []*gwapi.HTTPRoute
+	for i := range routeList.Items {
+		route := &routeList.Items[i]
+		if isServiceReferredByRoute(route, svc) {
+			impactedRoutes = append(impactedRoutes, route)
+		}
+	}
+	enqueueGatewaysReferencedByRoutes(h.k8sClient, queue, h.logger, impactedRoutes)
+}
+
+func isServiceReferredByRoute(route *gwapi.HTTPRoute, svc *corev1.Service) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			namespace := route.Namespace
+			if backendRef.Namespace != nil {
+				namespace = string(*backendRef.Namespace)
+			}
+			if namespace == svc.Namespace && string(backendRef.Name) == svc.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
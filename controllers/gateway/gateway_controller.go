@@ -0,0 +1,256 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway/eventhandlers"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	gatewayFinalizer = "gateway.k8s.aws/resources"
+	gatewayTagPrefix = "gateway.k8s.aws"
+	controllerName   = "gateway"
+)
+
+// NewGatewayReconciler constructs new gatewayReconciler
+func NewGatewayReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
+	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
+	config config.ControllerConfig, logger logr.Logger) *gatewayReconciler {
+
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	trackingProvider := tracking.NewDefaultProvider(gatewayTagPrefix, config.ClusterName)
+	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), logger)
+	certDiscovery := ingress.NewACMCertDiscovery(cloud.ACM(), logger)
+	modelBuilder := gateway.NewDefaultModelBuilder(k8sClient, annotationParser, subnetsResolver, certDiscovery,
+		trackingProvider, elbv2TaggingManager, config.ClusterName, config.DefaultTags, config.ExternalManagedTags,
+		config.DefaultSSLPolicy, logger)
+	stackMarshaller := deploy.NewDefaultStackMarshaller()
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
+		config, gatewayTagPrefix, logger)
+	routeLoader := gateway.NewDefaultRouteLoader(k8sClient)
+
+	return &gatewayReconciler{
+		k8sClient:        k8sClient,
+		eventRecorder:    eventRecorder,
+		finalizerManager: finalizerManager,
+		routeLoader:      routeLoader,
+		modelBuilder:     modelBuilder,
+		stackMarshaller:  stackMarshaller,
+		stackDeployer:    stackDeployer,
+		logger:           logger,
+
+		maxConcurrentReconciles: config.GatewayConfig.MaxConcurrentReconciles,
+	}
+}
+
+// gatewayReconciler reconciles a Gateway
+type gatewayReconciler struct {
+	k8sClient        client.Client
+	eventRecorder    record.EventRecorder
+	finalizerManager k8s.FinalizerManager
+	routeLoader      gateway.RouteLoader
+	modelBuilder     gateway.ModelBuilder
+	stackMarshaller  deploy.StackMarshaller
+	stackDeployer    deploy.StackDeployer
+	logger           logr.Logger
+
+	maxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencepolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *gatewayReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	gw := &gwapi.Gateway{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, gw); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !gw.DeletionTimestamp.IsZero() {
+		return r.cleanupGatewayResources(ctx, gw)
+	}
+	gwClass := &gwapi.GatewayClass{}
+	if err := r.k8sClient.Get(ctx, client.ObjectKey{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			// the GatewayClass might be recreated or not synced yet, AWS resources are kept until it's known to be managed by another controller.
+			// Gateways will be enqueued again once the GatewayClass is created.
+			r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonGatewayClassNotFound, fmt.Sprintf("GatewayClass %v not found", gw.Spec.GatewayClassName))
+			return nil
+		}
+		return err
+	}
+	if !gateway.IsGatewayClassManaged(gwClass) {
+		return r.cleanupGatewayResources(ctx, gw)
+	}
+	return r.reconcileGatewayResources(ctx, gw)
+}
+
+func (r *gatewayReconciler) reconcileGatewayResources(ctx context.Context, gw *gwapi.Gateway) error {
+	if err := r.finalizerManager.AddFinalizers(ctx, gw, gatewayFinalizer); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	attachedGW, err := r.routeLoader.Load(ctx, gw)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedLoadRoutes, fmt.Sprintf("Failed load routes due to %v", err))
+		return err
+	}
+	stack, lb, err := r.modelBuilder.Build(ctx, attachedGW)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return err
+	}
+	lbDNS, err := lb.DNSName().Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	if err := r.updateGatewayStatus(ctx, gw, lbDNS); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	r.eventRecorder.Event(gw, corev1.EventTypeNormal, k8s.GatewayEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+// cleanupGatewayResources deletes the AWS resources provisioned for Gateway by deploying an empty stack.
+func (r *gatewayReconciler) cleanupGatewayResources(ctx context.Context, gw *gwapi.Gateway) error {
+	if !k8s.HasFinalizer(gw, gatewayFinalizer) {
+		return nil
+	}
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return err
+	}
+	if err := r.finalizerManager.RemoveFinalizers(ctx, gw, gatewayFinalizer); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) deployModel(ctx context.Context, gw *gwapi.Gateway, stack core.Stack) error {
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "gateway", k8s.NamespacedName(gw))
+	return nil
+}
+
+func (r *gatewayReconciler) updateGatewayStatus(ctx context.Context, gw *gwapi.Gateway, lbDNS string) error {
+	gwOld := gw.DeepCopy()
+	addressType := gwapi.HostnameAddressType
+	gw.Status.Addresses = []gwapi.GatewayAddress{
+		{
+			Type:  &addressType,
+			Value: lbDNS,
+		},
+	}
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               string(gwapi.GatewayConditionScheduled),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwapi.GatewayConditionScheduled),
+		ObservedGeneration: gw.Generation,
+	})
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               string(gwapi.GatewayConditionReady),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwapi.GatewayConditionReady),
+		ObservedGeneration: gw.Generation,
+	})
+	if equality.Semantic.DeepEqual(gwOld.Status, gw.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gateway status: %v", k8s.NamespacedName(gw))
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	c, err := controller.New(controllerName, mgr, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		Reconciler:              r,
+	})
+	if err != nil {
+		return err
+	}
+	if err := r.setupWatches(ctx, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) setupWatches(_ context.Context, c controller.Controller) error {
+	gwEventHandler := eventhandlers.NewEnqueueRequestsForGatewayEvent(r.logger.WithName("eventHandlers").WithName("gateway"))
+	gwClassEventHandler := eventhandlers.NewEnqueueRequestsForGatewayClassEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("gatewayClass"))
+	routeEventHandler := eventhandlers.NewEnqueueRequestsForHTTPRouteEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("httpRoute"))
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("service"))
+	referencePolicyEventHandler := eventhandlers.NewEnqueueRequestsForReferencePolicyEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("referencePolicy"))
+	if err := c.Watch(&source.Kind{Type: &gwapi.Gateway{}}, gwEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &gwapi.GatewayClass{}}, gwClassEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &gwapi.HTTPRoute{}}, routeEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &gwapi.ReferencePolicy{}}, referencePolicyEventHandler); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ctrl "sigs.k8s.io/controller-runtime"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// stubStackDeployer records the stacks deployed.
+type stubStackDeployer struct {
+	stacks []core.Stack
+}
+
+func (d *stubStackDeployer) Deploy(_ context.Context, stack core.Stack) error {
+	d.stacks = append(d.stacks, stack)
+	return nil
+}
+
+func Test_gatewayReconciler_reconcile_cleanup(t *testing.T) {
+	tests := []struct {
+		name          string
+		gwClass       *gwapi.GatewayClass
+		deleting      bool
+		wantCleanedUp bool
+	}{
+		{
+			name:          "gatewayClass not found",
+			gwClass:       nil,
+			wantCleanedUp: false,
+		},
+		{
+			name: "gatewayClass managed by another controller",
+			gwClass: &gwapi.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+				Spec:       gwapi.GatewayClassSpec{ControllerName: "example.com/other-controller"},
+			},
+			wantCleanedUp: true,
+		},
+		{
+			name:          "gateway being deleted without gatewayClass",
+			gwClass:       nil,
+			deleting:      true,
+			wantCleanedUp: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwapi.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			if tt.gwClass != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.gwClass.DeepCopy()))
+			}
+			gw := &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "awesome-gw",
+					Finalizers: []string{gatewayFinalizer},
+				},
+				Spec: gwapi.GatewaySpec{GatewayClassName: "awesome-class"},
+			}
+			assert.NoError(t, k8sClient.Create(ctx, gw))
+			if tt.deleting {
+				now := metav1.Now()
+				gw.DeletionTimestamp = &now
+				assert.NoError(t, k8sClient.Update(ctx, gw))
+			}
+
+			stackDeployer := &stubStackDeployer{}
+			r := &gatewayReconciler{
+				k8sClient:        k8sClient,
+				eventRecorder:    record.NewFakeRecorder(10),
+				finalizerManager: k8s.NewDefaultFinalizerManager(k8sClient, &log.NullLogger{}),
+				stackMarshaller:  deploy.NewDefaultStackMarshaller(),
+				stackDeployer:    stackDeployer,
+				logger:           &log.NullLogger{},
+			}
+			err := r.reconcile(ctx, ctrl.Request{NamespacedName: k8s.NamespacedName(gw)})
+			assert.NoError(t, err)
+
+			reconciledGW := &gwapi.Gateway{}
+			getErr := k8sClient.Get(ctx, k8s.NamespacedName(gw), reconciledGW)
+			if tt.wantCleanedUp {
+				assert.Len(t, stackDeployer.stacks, 1)
+				if getErr == nil {
+					assert.NotContains(t, reconciledGW.Finalizers, gatewayFinalizer)
+				}
+			} else {
+				assert.Empty(t, stackDeployer.stacks)
+				assert.NoError(t, getErr)
+				assert.Contains(t, reconciledGW.Finalizers, gatewayFinalizer)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForListenerRuleActionEvent constructs new enqueueRequestsForListenerRuleActionEvent.
+func NewEnqueueRequestsForListenerRuleActionEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForListenerRuleActionEvent {
+	return &enqueueRequestsForListenerRuleActionEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForListenerRuleActionEvent)(nil)
+
+type enqueueRequestsForListenerRuleActionEvent struct {
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForListenerRuleActionEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	ruleActionNew := e.Object.(*elbv2api.ListenerRuleAction)
+	h.enqueueImpactedIngresses(ruleActionNew)
+}
+
+func (h *enqueueRequestsForListenerRuleActionEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	ruleActionOld := e.ObjectOld.(*elbv2api.ListenerRuleAction)
+	ruleActionNew := e.ObjectNew.(*elbv2api.ListenerRuleAction)
+
+	// we only care below update event:
+	//	1. ListenerRuleAction spec updates
+	//	2. ListenerRuleAction deletion
+	if equality.Semantic.DeepEqual(ruleActionOld.Spec, ruleActionNew.Spec) &&
+		equality.Semantic.DeepEqual(ruleActionOld.DeletionTimestamp.IsZero(), ruleActionNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedIngresses(ruleActionNew)
+}
+
+func (h *enqueueRequestsForListenerRuleActionEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	ruleActionOld := e.Object.(*elbv2api.ListenerRuleAction)
+	h.enqueueImpactedIngresses(ruleActionOld)
+}
+
+func (h *enqueueRequestsForListenerRuleActionEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	ruleAction := e.Object.(*elbv2api.ListenerRuleAction)
+	h.enqueueImpactedIngresses(ruleAction)
+}
+
+func (h *enqueueRequestsForListenerRuleActionEvent) enqueueImpactedIngresses(ruleAction *elbv2api.ListenerRuleAction) {
+	ingList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), ingList,
+		client.InNamespace(ruleAction.GetNamespace()),
+		client.MatchingFields{ingress.IndexKeyListenerRuleActionRefName: ruleAction.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+
+	ruleActionKey := k8s.NamespacedName(ruleAction)
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		h.logger.V(1).Info("enqueue ingress for listenerRuleAction event",
+			"listenerRuleAction", ruleActionKey,
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.GenericEvent{
+			Object: ing,
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,24 +18,27 @@ import (
 
 // NewEnqueueRequestsForSecretEvent constructs new enqueueRequestsForSecretEvent.
 func NewEnqueueRequestsForSecretEvent(ingEventChan chan<- event.GenericEvent, svcEventChan chan<- event.GenericEvent,
-	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForSecretEvent {
+	ruleActionEventChan chan<- event.GenericEvent, k8sClient client.Client, eventRecorder record.EventRecorder,
+	logger logr.Logger) *enqueueRequestsForSecretEvent {
 	return &enqueueRequestsForSecretEvent{
-		ingEventChan:  ingEventChan,
-		svcEventChan:  svcEventChan,
-		k8sClient:     k8sClient,
-		eventRecorder: eventRecorder,
-		logger:        logger,
+		ingEventChan:        ingEventChan,
+		svcEventChan:        svcEventChan,
+		ruleActionEventChan: ruleActionEventChan,
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		logger:              logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForSecretEvent)(nil)
 
 type enqueueRequestsForSecretEvent struct {
-	ingEventChan  chan<- event.GenericEvent
-	svcEventChan  chan<- event.GenericEvent
-	k8sClient     client.Client
-	eventRecorder record.EventRecorder
-	logger        logr.Logger
+	ingEventChan        chan<- event.GenericEvent
+	svcEventChan        chan<- event.GenericEvent
+	ruleActionEventChan chan<- event.GenericEvent
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	logger              logr.Logger
 }
 
 func (h *enqueueRequestsForSecretEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
@@ -104,4 +108,22 @@ func (h *enqueueRequestsForSecretEvent) enqueueImpactedObjects(secret *corev1.Se
 			Object: svc,
 		}
 	}
+
+	ruleActionList := &elbv2api.ListenerRuleActionList{}
+	if err := h.k8sClient.List(context.Background(), ruleActionList,
+		client.InNamespace(secret.GetNamespace()),
+		client.MatchingFields{ingress.IndexKeySecretRefName: secret.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch listenerRuleActions")
+		return
+	}
+	for index := range ruleActionList.Items {
+		ruleAction := &ruleActionList.Items[index]
+
+		h.logger.V(1).Info("enqueue listenerRuleAction for secret event",
+			"secret", secretKey,
+			"listenerRuleAction", k8s.NamespacedName(ruleAction))
+		h.ruleActionEventChan <- event.GenericEvent{
+			Object: ruleAction,
+		}
+	}
 }
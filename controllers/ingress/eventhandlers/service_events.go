@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,23 +17,25 @@ import (
 )
 
 // NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
-func NewEnqueueRequestsForServiceEvent(ingEventChan chan<- event.GenericEvent,
+func NewEnqueueRequestsForServiceEvent(ingEventChan chan<- event.GenericEvent, ruleActionEventChan chan<- event.GenericEvent,
 	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForServiceEvent {
 	return &enqueueRequestsForServiceEvent{
-		ingEventChan:  ingEventChan,
-		k8sClient:     k8sClient,
-		eventRecorder: eventRecorder,
-		logger:        logger,
+		ingEventChan:        ingEventChan,
+		ruleActionEventChan: ruleActionEventChan,
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		logger:              logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
 
 type enqueueRequestsForServiceEvent struct {
-	ingEventChan  chan<- event.GenericEvent
-	k8sClient     client.Client
-	eventRecorder record.EventRecorder
-	logger        logr.Logger
+	ingEventChan        chan<- event.GenericEvent
+	ruleActionEventChan chan<- event.GenericEvent
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	logger              logr.Logger
 }
 
 func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
@@ -87,4 +90,22 @@ func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Se
 			Object: ing,
 		}
 	}
+
+	ruleActionList := &elbv2api.ListenerRuleActionList{}
+	if err := h.k8sClient.List(context.Background(), ruleActionList,
+		client.InNamespace(svc.GetNamespace()),
+		client.MatchingFields{ingress.IndexKeyServiceRefName: svc.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch listenerRuleActions")
+		return
+	}
+	for index := range ruleActionList.Items {
+		ruleAction := &ruleActionList.Items[index]
+
+		h.logger.V(1).Info("enqueue listenerRuleAction for service event",
+			"service", svcKey,
+			"listenerRuleAction", k8s.NamespacedName(ruleAction))
+		h.ruleActionEventChan <- event.GenericEvent{
+			Object: ruleAction,
+		}
+	}
 }
@@ -0,0 +1,87 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForTrafficShiftEvent constructs new enqueueRequestsForTrafficShiftEvent.
+func NewEnqueueRequestsForTrafficShiftEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForTrafficShiftEvent {
+	return &enqueueRequestsForTrafficShiftEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForTrafficShiftEvent)(nil)
+
+type enqueueRequestsForTrafficShiftEvent struct {
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	tsNew := e.Object.(*elbv2api.TrafficShift)
+	h.enqueueImpactedIngress(tsNew)
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	tsOld := e.ObjectOld.(*elbv2api.TrafficShift)
+	tsNew := e.ObjectNew.(*elbv2api.TrafficShift)
+
+	// we only care below update event:
+	//	1. TrafficShift spec updates
+	//	2. TrafficShift canaryWeight updates
+	if equality.Semantic.DeepEqual(tsOld.Spec, tsNew.Spec) &&
+		equality.Semantic.DeepEqual(tsOld.Status.CanaryWeight, tsNew.Status.CanaryWeight) {
+		return
+	}
+	if tsOld.Spec.IngressName != tsNew.Spec.IngressName {
+		h.enqueueImpactedIngress(tsOld)
+	}
+	h.enqueueImpactedIngress(tsNew)
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	tsOld := e.Object.(*elbv2api.TrafficShift)
+	h.enqueueImpactedIngress(tsOld)
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	ts := e.Object.(*elbv2api.TrafficShift)
+	h.enqueueImpactedIngress(ts)
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) enqueueImpactedIngress(ts *elbv2api.TrafficShift) {
+	ing := &networking.Ingress{}
+	ingKey := types.NamespacedName{Namespace: ts.Namespace, Name: ts.Spec.IngressName}
+	if err := h.k8sClient.Get(context.Background(), ingKey, ing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			h.logger.Error(err, "failed to fetch ingress", "ingress", ingKey)
+		}
+		return
+	}
+
+	h.logger.V(1).Info("enqueue ingress for trafficShift event",
+		"trafficShift", k8s.NamespacedName(ts),
+		"ingress", ingKey)
+	h.ingEventChan <- event.GenericEvent{
+		Object: ing,
+	}
+}
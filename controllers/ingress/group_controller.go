@@ -17,6 +17,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -41,7 +42,7 @@ const (
 )
 
 // NewGroupReconciler constructs new GroupReconciler
-func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, apiReader client.Reader, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
 	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
 	config config.ControllerConfig, logger logr.Logger) *groupReconciler {
@@ -49,15 +50,16 @@ func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder
 	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
 	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
 	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder)
-	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, logger)
+	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, annotationParser,
+		config.EnableCertificateImport, logger)
 	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, config.ClusterName)
 	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), logger)
-	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, apiReader, eventRecorder,
 		cloud.EC2(), cloud.ACM(),
 		annotationParser, subnetsResolver,
 		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager,
 		cloud.VpcID(), config.ClusterName, config.DefaultTags, config.ExternalManagedTags,
-		config.DefaultSSLPolicy, logger)
+		config.DefaultSSLPolicy, config.EnableCertificateImport, logger)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
 		config, ingressTagPrefix, logger)
@@ -66,18 +68,26 @@ func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder
 	manageIngressesWithoutIngressClass := config.IngressConfig.IngressClass == ""
 	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
 	groupFinalizerManager := ingress.NewDefaultFinalizerManager(finalizerManager)
+	deletionPolicyResolver := ingress.NewDefaultDeletionPolicyResolver(classLoader, annotationParser, logger)
+	deletionPolicyEnforcer := deploy.NewDefaultDeletionPolicyEnforcer(cloud, k8sClient, config, ingressTagPrefix, logger)
+	reconcileStatusManager := k8s.NewDefaultReconcileStatusManager(k8sClient, annotations.IngressAnnotationReconcileStatus, logger)
 
 	return &groupReconciler{
 		k8sClient:        k8sClient,
 		eventRecorder:    eventRecorder,
+		annotationParser: annotationParser,
 		referenceIndexer: referenceIndexer,
 		modelBuilder:     modelBuilder,
 		stackMarshaller:  stackMarshaller,
 		stackDeployer:    stackDeployer,
+		stackPlanner:     stackDeployer,
 
-		groupLoader:           groupLoader,
-		groupFinalizerManager: groupFinalizerManager,
-		logger:                logger,
+		groupLoader:            groupLoader,
+		groupFinalizerManager:  groupFinalizerManager,
+		reconcileStatusManager: reconcileStatusManager,
+		deletionPolicyResolver: deletionPolicyResolver,
+		deletionPolicyEnforcer: deletionPolicyEnforcer,
+		logger:                 logger,
 
 		maxConcurrentReconciles: config.IngressConfig.MaxConcurrentReconciles,
 	}
@@ -87,19 +97,26 @@ func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder
 type groupReconciler struct {
 	k8sClient        client.Client
 	eventRecorder    record.EventRecorder
+	annotationParser annotations.Parser
 	referenceIndexer ingress.ReferenceIndexer
 	modelBuilder     ingress.ModelBuilder
 	stackMarshaller  deploy.StackMarshaller
 	stackDeployer    deploy.StackDeployer
+	stackPlanner     deploy.StackPlanner
 
-	groupLoader           ingress.GroupLoader
-	groupFinalizerManager ingress.FinalizerManager
-	logger                logr.Logger
+	groupLoader            ingress.GroupLoader
+	groupFinalizerManager  ingress.FinalizerManager
+	reconcileStatusManager k8s.ReconcileStatusManager
+	deletionPolicyResolver ingress.DeletionPolicyResolver
+	deletionPolicyEnforcer deploy.DeletionPolicyEnforcer
+	logger                 logr.Logger
 
 	maxConcurrentReconciles int
 }
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=listenerruleactions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficshifts,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
@@ -117,26 +134,56 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 	ingGroupID := ingress.DecodeGroupIDFromReconcileRequest(req)
 	ingGroup, err := r.groupLoader.Load(ctx, ingGroupID)
 	if err != nil {
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageLoadGroup, err)
 		return err
 	}
 
+	dryRun, err := r.isDryRunGroup(ingGroup)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		// Ingresses pending finalization keep their finalizer until the IngressGroup is deployed,
+		// since their AWS resources are only cleaned up then.
+		return r.buildAndPlanModel(ctx, ingGroup)
+	}
+
 	if err := r.groupFinalizerManager.AddGroupFinalizer(ctx, ingGroupID, ingGroup.Members); err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageAddFinalizer, err)
 		return err
 	}
 
+	if len(ingGroup.Members) == 0 && len(ingGroup.InactiveMembers) > 0 {
+		if err := r.enforceDeletionPolicy(ctx, ingGroup); err != nil {
+			return err
+		}
+	}
+
 	_, lb, err := r.buildAndDeployModel(ctx, ingGroup)
 	if err != nil {
 		return err
 	}
 
+	lbARN := ""
 	if len(ingGroup.Members) > 0 && lb != nil {
 		lbDNS, err := lb.DNSName().Resolve(ctx)
 		if err != nil {
 			return err
 		}
+		if lbARN, err = lb.LoadBalancerARN().Resolve(ctx); err != nil {
+			return err
+		}
 		if err := r.updateIngressGroupStatus(ctx, ingGroup, lbDNS); err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+			r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageUpdateStatus, err)
+			return err
+		}
+	}
+
+	for _, member := range ingGroup.Members {
+		if err := r.updateIngressDeployPlan(ctx, member.Ing, ""); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update deploy plan due to %v", err))
 			return err
 		}
 	}
@@ -144,6 +191,14 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 	if len(ingGroup.InactiveMembers) > 0 {
 		if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroupID, ingGroup.InactiveMembers); err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+			r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageRemoveFinalizer, err)
+			return err
+		}
+	}
+
+	for _, member := range ingGroup.Members {
+		if err := r.reconcileStatusManager.RecordSuccess(ctx, member.Ing, lbARN); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
 	}
@@ -156,29 +211,172 @@ func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingr
 	stack, lb, err := r.modelBuilder.Build(ctx, ingGroup)
 	if err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageBuildModel, err)
 		return nil, nil, err
 	}
 	stackJSON, err := r.stackMarshaller.Marshal(stack)
 	if err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageBuildModel, err)
 		return nil, nil, err
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
 
 	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageDeployModel, err)
 		return nil, nil, err
 	}
 	r.logger.Info("successfully deployed model", "ingressGroup", ingGroup.ID)
 	return stack, lb, err
 }
 
+// buildAndPlanModel computes the changes needed to deploy IngressGroup without mutating any AWS resources,
+// and surfaces the planned changes via events and annotation on member Ingresses.
+// failures are recorded at the same reconcile stages as deploying, with planning in place of the DeployModel stage.
+func (r *groupReconciler) buildAndPlanModel(ctx context.Context, ingGroup ingress.Group) error {
+	stack, _, err := r.modelBuilder.Build(ctx, ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageBuildModel, err)
+		return err
+	}
+	changeSet, err := r.stackPlanner.Plan(ctx, stack)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageDeployModel, err)
+		return err
+	}
+	changeSetJSON, err := changeSet.Marshal()
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageDeployModel, err)
+		return err
+	}
+	r.logger.Info("successfully planned model", "ingressGroup", ingGroup.ID, "changeSet", changeSetJSON)
+
+	deployPlan, err := changeSet.MarshalWithSizeLimit(plan.MaxAnnotationSize)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		r.recordIngressGroupReconcileFailure(ctx, ingGroup, k8s.ReconcileStageDeployModel, err)
+		return err
+	}
+	for _, member := range ingGroup.Members {
+		if err := r.updateIngressDeployPlan(ctx, member.Ing, deployPlan); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update deploy plan due to %v", err))
+			return err
+		}
+	}
+	// deleted Ingresses are pending on the planned deletions, Ingresses that moved to another IngressGroup are left to it.
+	pendingDeletions := pendingDeletionIngresses(ingGroup)
+	for _, ing := range pendingDeletions {
+		if err := r.updateIngressDeployPlan(ctx, ing, deployPlan); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update deploy plan due to %v", err))
+			return err
+		}
+	}
+	eventType := corev1.EventTypeNormal
+	if len(changeSet.Deletions()) != 0 {
+		eventType = corev1.EventTypeWarning
+	}
+	message := fmt.Sprintf("Planned changes: %v", changeSet.Summary())
+	r.recordIngressGroupEvent(ctx, ingGroup, eventType, k8s.IngressEventReasonPlannedChanges, message)
+	for _, ing := range pendingDeletions {
+		r.eventRecorder.Event(ing, eventType, k8s.IngressEventReasonPlannedChanges, message+", deletion is pending until dry-run is disabled")
+	}
+	return nil
+}
+
+// enforceDeletionPolicy enforces the deletion policy before the LoadBalancer of IngressGroup is deleted.
+// the deletion policy is resolved from Ingresses pending finalization, since there are no active members left.
+func (r *groupReconciler) enforceDeletionPolicy(ctx context.Context, ingGroup ingress.Group) error {
+	deletionPolicy, err := r.deletionPolicyResolver.Resolve(ctx, ingGroup.InactiveMembers)
+	if err != nil {
+		r.recordInactiveIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeletionPolicy, fmt.Sprintf("Failed resolve deletion policy due to %v", err))
+		return err
+	}
+	if err := r.deletionPolicyEnforcer.Enforce(ctx, core.StackID(ingGroup.ID), deletionPolicy); err != nil {
+		var deletionProtectedErr *deploy.DeletionProtectedError
+		if errors.As(err, &deletionProtectedErr) {
+			r.recordInactiveIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonDeletionProtected,
+				fmt.Sprintf("Refused to delete load balancer %v since deletion protection is enabled", deletionProtectedErr.LoadBalancerARN))
+		} else {
+			r.recordInactiveIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeletionPolicy, fmt.Sprintf("Failed enforce %v deletion policy due to %v", deletionPolicy, err))
+		}
+		return err
+	}
+	return nil
+}
+
+// isDryRunGroup checks whether IngressGroup should be planned instead of deployed.
+// an IngressGroup is in dry-run mode if any of its active Ingresses requests so,
+// Ingresses pending finalization are not considered so that a deleted IngressGroup is always cleaned up.
+func (r *groupReconciler) isDryRunGroup(ingGroup ingress.Group) (bool, error) {
+	for _, member := range ingGroup.Members {
+		dryRun := false
+		if _, err := r.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixDryRun, &dryRun, member.Ing.Annotations); err != nil {
+			return false, errors.Wrapf(err, "failed to parse dry-run annotation on ingress: %v", k8s.NamespacedName(member.Ing))
+		}
+		if dryRun {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// pendingDeletionIngresses returns the Ingresses pending finalization that are being deleted.
+func pendingDeletionIngresses(ingGroup ingress.Group) []*networking.Ingress {
+	var ingList []*networking.Ingress
+	for _, ing := range ingGroup.InactiveMembers {
+		if !ing.DeletionTimestamp.IsZero() {
+			ingList = append(ingList, ing)
+		}
+	}
+	return ingList
+}
+
+// updateIngressDeployPlan sets the deploy plan annotation on Ingress, an empty changeSetJSON removes it.
+func (r *groupReconciler) updateIngressDeployPlan(ctx context.Context, ing *networking.Ingress, changeSetJSON string) error {
+	if ing.Annotations[annotations.IngressAnnotationDeployPlan] == changeSetJSON {
+		return nil
+	}
+	ingOld := ing.DeepCopy()
+	if changeSetJSON == "" {
+		delete(ing.Annotations, annotations.IngressAnnotationDeployPlan)
+	} else {
+		if ing.Annotations == nil {
+			ing.Annotations = make(map[string]string)
+		}
+		ing.Annotations[annotations.IngressAnnotationDeployPlan] = changeSetJSON
+	}
+	if err := r.k8sClient.Patch(ctx, ing, client.MergeFrom(ingOld)); err != nil {
+		return errors.Wrapf(err, "failed to update ingress deploy plan: %v", k8s.NamespacedName(ing))
+	}
+	return nil
+}
+
 func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
 	for _, member := range ingGroup.Members {
 		r.eventRecorder.Event(member.Ing, eventType, reason, message)
 	}
 }
 
+func (r *groupReconciler) recordInactiveIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
+	for _, ing := range ingGroup.InactiveMembers {
+		r.eventRecorder.Event(ing, eventType, reason, message)
+	}
+}
+
+// recordIngressGroupReconcileFailure records the reconcile failure onto each member Ingress.
+// it's best-effort since the reconcile error will be retried anyway.
+func (r *groupReconciler) recordIngressGroupReconcileFailure(ctx context.Context, ingGroup ingress.Group, stage k8s.ReconcileStage, reconcileErr error) {
+	for _, member := range ingGroup.Members {
+		if err := r.reconcileStatusManager.RecordFailure(ctx, member.Ing, stage, reconcileErr); err != nil {
+			r.logger.Error(err, "failed to record reconcile failure", "ingress", k8s.NamespacedName(member.Ing))
+		}
+	}
+}
+
 func (r *groupReconciler) updateIngressGroupStatus(ctx context.Context, ingGroup ingress.Group, lbDNS string) error {
 	for _, member := range ingGroup.Members {
 		if err := r.updateIngressStatus(ctx, lbDNS, member.Ing); err != nil {
@@ -250,6 +448,27 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 	); err != nil {
 		return err
 	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyListenerRuleActionRefName,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildListenerRuleActionRefIndexes(context.Background(), obj.(*networking.Ingress))
+		},
+	); err != nil {
+		return err
+	}
+	if err := fieldIndexer.IndexField(ctx, &elbv2api.ListenerRuleAction{}, ingress.IndexKeyServiceRefName,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildListenerRuleActionServiceRefIndexes(context.Background(), obj.(*elbv2api.ListenerRuleAction))
+		},
+	); err != nil {
+		return err
+	}
+	if err := fieldIndexer.IndexField(ctx, &elbv2api.ListenerRuleAction{}, ingress.IndexKeySecretRefName,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildListenerRuleActionSecretRefIndexes(context.Background(), obj.(*elbv2api.ListenerRuleAction))
+		},
+	); err != nil {
+		return err
+	}
 	if ingressClassResourceAvailable {
 		if err := fieldIndexer.IndexField(ctx, &networking.IngressClass{}, ingress.IndexKeyIngressClassParamsRefName,
 			func(obj client.Object) []string {
@@ -272,18 +491,26 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controller, ingressClassResourceAvailable bool) error {
 	ingEventChan := make(chan event.GenericEvent)
 	svcEventChan := make(chan event.GenericEvent)
+	ruleActionEventChan := make(chan event.GenericEvent)
 	ingEventHandler := eventhandlers.NewEnqueueRequestsForIngressEvent(r.groupLoader, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("ingress"))
-	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(ingEventChan, ruleActionEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("service"))
-	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, r.k8sClient, r.eventRecorder,
+	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, ruleActionEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("secret"))
+	ruleActionEventHandler := eventhandlers.NewEnqueueRequestsForListenerRuleActionEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+		r.logger.WithName("eventHandlers").WithName("listenerRuleAction"))
+	trafficShiftEventHandler := eventhandlers.NewEnqueueRequestsForTrafficShiftEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+		r.logger.WithName("eventHandlers").WithName("trafficShift"))
 	if err := c.Watch(&source.Channel{Source: ingEventChan}, ingEventHandler); err != nil {
 		return err
 	}
 	if err := c.Watch(&source.Channel{Source: svcEventChan}, svcEventHandler); err != nil {
 		return err
 	}
+	if err := c.Watch(&source.Channel{Source: ruleActionEventChan}, ruleActionEventHandler); err != nil {
+		return err
+	}
 	if err := c.Watch(&source.Kind{Type: &networking.Ingress{}}, ingEventHandler); err != nil {
 		return err
 	}
@@ -293,6 +520,12 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, secretEventHandler); err != nil {
 		return err
 	}
+	if err := c.Watch(&source.Kind{Type: &elbv2api.ListenerRuleAction{}}, ruleActionEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &elbv2api.TrafficShift{}}, trafficShiftEventHandler); err != nil {
+		return err
+	}
 
 	if ingressClassResourceAvailable {
 		ingClassEventChan := make(chan event.GenericEvent)
@@ -0,0 +1,251 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// newGroupReconcilerWithFakeCloud constructs a groupReconciler against fake AWS services with two tagged subnets.
+func newGroupReconcilerWithFakeCloud(t *testing.T) (*groupReconciler, *fake.Cloud, client.Client) {
+	ctx := context.Background()
+	cloud := fake.NewCloud("us-west-2")
+	for _, subnet := range []struct {
+		cidrBlock string
+		az        string
+	}{
+		{cidrBlock: "192.168.0.0/19", az: "us-west-2a"},
+		{cidrBlock: "192.168.32.0/19", az: "us-west-2b"},
+	} {
+		_, err := cloud.EC2().CreateSubnetWithContext(ctx, &ec2sdk.CreateSubnetInput{
+			VpcId:            awssdk.String(cloud.VpcID()),
+			CidrBlock:        awssdk.String(subnet.cidrBlock),
+			AvailabilityZone: awssdk.String(subnet.az),
+			TagSpecifications: []*ec2sdk.TagSpecification{
+				{
+					ResourceType: awssdk.String(ec2sdk.ResourceTypeSubnet),
+					Tags:         []*ec2sdk.Tag{{Key: awssdk.String("kubernetes.io/role/elb"), Value: awssdk.String("1")}},
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP}},
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, svc))
+
+	logger := &log.NullLogger{}
+	sgManager := networkingpkg.NewDefaultSecurityGroupManager(cloud.EC2(), logger)
+	sgReconciler := networkingpkg.NewDefaultSecurityGroupReconciler(sgManager, logger)
+	azInfoProvider := networkingpkg.NewDefaultAZInfoProvider(cloud.EC2(), logger)
+	subnetsResolver := networkingpkg.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), "cluster-name", logger)
+	reconciler := NewGroupReconciler(cloud, k8sClient, k8sClient, record.NewFakeRecorder(100),
+		k8s.NewDefaultFinalizerManager(k8sClient, logger), sgManager, sgReconciler, subnetsResolver,
+		config.ControllerConfig{ClusterName: "cluster-name"}, logger)
+	return reconciler, cloud, k8sClient
+}
+
+// newIngressForFakeCloud constructs an Ingress routing path to awesome-svc.
+func newIngressForFakeCloud(name string, path string, ingAnnotations map[string]string) *networking.Ingress {
+	pathType := networking.PathTypePrefix
+	annotations := map[string]string{
+		"kubernetes.io/ingress.class":           "alb",
+		"alb.ingress.kubernetes.io/scheme":      "internet-facing",
+		"alb.ingress.kubernetes.io/target-type": "ip",
+	}
+	for k, v := range ingAnnotations {
+		annotations[k] = v
+	}
+	return &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "awesome-ns",
+			Name:        name,
+			Annotations: annotations,
+		},
+		Spec: networking.IngressSpec{
+			Rules: []networking.IngressRule{
+				{
+					IngressRuleValue: networking.IngressRuleValue{
+						HTTP: &networking.HTTPIngressRuleValue{
+							Paths: []networking.HTTPIngressPath{
+								{
+									Path:     path,
+									PathType: &pathType,
+									Backend: networking.IngressBackend{
+										ServiceName: "awesome-svc",
+										ServicePort: intstr.FromInt(80),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Test_groupReconciler_reconcile_withFakeCloud runs the Ingress reconcile loop end-to-end against fake AWS services.
+func Test_groupReconciler_reconcile_withFakeCloud(t *testing.T) {
+	ctx := context.Background()
+	reconciler, cloud, k8sClient := newGroupReconcilerWithFakeCloud(t)
+	ing := newIngressForFakeCloud("awesome-ing", "/", nil)
+	require.NoError(t, k8sClient.Create(ctx, ing))
+	req := ingress.EncodeGroupIDToReconcileRequest(ingress.NewGroupIDForImplicitGroup(k8s.NamespacedName(ing)))
+
+	// reconciling twice should converge to the same ALB.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, reconciler.reconcile(ctx, req))
+	}
+	sdkLBs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	require.Len(t, sdkLBs, 1)
+	assert.Equal(t, elbv2sdk.LoadBalancerTypeEnumApplication, awssdk.StringValue(sdkLBs[0].Type))
+	sdkLSs, err := cloud.ELBV2().DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{
+		LoadBalancerArn: sdkLBs[0].LoadBalancerArn,
+	})
+	require.NoError(t, err)
+	require.Len(t, sdkLSs, 1)
+	assert.Equal(t, int64(80), awssdk.Int64Value(sdkLSs[0].Port))
+
+	reconciledIng := &networking.Ingress{}
+	require.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(ing), reconciledIng))
+	assert.Contains(t, reconciledIng.Finalizers, "ingress.k8s.aws/resources")
+	require.Len(t, reconciledIng.Status.LoadBalancer.Ingress, 1)
+	assert.Equal(t, awssdk.StringValue(sdkLBs[0].DNSName), reconciledIng.Status.LoadBalancer.Ingress[0].Hostname)
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	require.NoError(t, k8sClient.List(ctx, tgbList, client.InNamespace("awesome-ns")))
+	require.Len(t, tgbList.Items, 1)
+	assert.Equal(t, "awesome-svc", tgbList.Items[0].Spec.ServiceRef.Name)
+
+	// deleting the Ingress should delete the ALB and release the finalizer.
+	now := metav1.Now()
+	reconciledIng.DeletionTimestamp = &now
+	require.NoError(t, k8sClient.Update(ctx, reconciledIng))
+	require.NoError(t, reconciler.reconcile(ctx, req))
+	sdkLBs, err = cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	assert.Empty(t, sdkLBs)
+	deletedIng := &networking.Ingress{}
+	if err := k8sClient.Get(ctx, k8s.NamespacedName(ing), deletedIng); err != nil {
+		assert.True(t, apierrors.IsNotFound(err))
+	} else {
+		assert.NotContains(t, deletedIng.Finalizers, "ingress.k8s.aws/resources")
+	}
+}
+
+// Test_groupReconciler_reconcile_dryRunWithDeletedIngress verifies Ingresses deleted from an IngressGroup under dry-run
+// keep their finalizer and AWS resources until the IngressGroup is deployed.
+func Test_groupReconciler_reconcile_dryRunWithDeletedIngress(t *testing.T) {
+	ctx := context.Background()
+	reconciler, cloud, k8sClient := newGroupReconcilerWithFakeCloud(t)
+	groupAnnotations := map[string]string{"alb.ingress.kubernetes.io/group.name": "awesome-group"}
+	ingA := newIngressForFakeCloud("ing-a", "/a", groupAnnotations)
+	ingB := newIngressForFakeCloud("ing-b", "/b", groupAnnotations)
+	require.NoError(t, k8sClient.Create(ctx, ingA))
+	require.NoError(t, k8sClient.Create(ctx, ingB))
+	req := ingress.EncodeGroupIDToReconcileRequest(ingress.NewGroupIDForExplicitGroup("awesome-group"))
+	require.NoError(t, reconciler.reconcile(ctx, req))
+
+	sdkLBs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	require.Len(t, sdkLBs, 1)
+	sdkLSs, err := cloud.ELBV2().DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{
+		LoadBalancerArn: sdkLBs[0].LoadBalancerArn,
+	})
+	require.NoError(t, err)
+	require.Len(t, sdkLSs, 1)
+	countListenerRules := func() int {
+		sdkRules, err := cloud.ELBV2().DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{
+			ListenerArn: sdkLSs[0].ListenerArn,
+		})
+		require.NoError(t, err)
+		return len(sdkRules)
+	}
+	// two path rules plus the default rule.
+	require.Equal(t, 3, countListenerRules())
+
+	// enable dry-run on ing-a and delete ing-b.
+	reconciledIngA := &networking.Ingress{}
+	require.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(ingA), reconciledIngA))
+	reconciledIngA.Annotations["alb.ingress.kubernetes.io/dry-run"] = "true"
+	require.NoError(t, k8sClient.Update(ctx, reconciledIngA))
+	reconciledIngB := &networking.Ingress{}
+	require.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(ingB), reconciledIngB))
+	now := metav1.Now()
+	reconciledIngB.DeletionTimestamp = &now
+	require.NoError(t, k8sClient.Update(ctx, reconciledIngB))
+	require.NoError(t, reconciler.reconcile(ctx, req))
+
+	assert.Equal(t, 3, countListenerRules())
+	pendingIngB := &networking.Ingress{}
+	require.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(ingB), pendingIngB))
+	assert.Contains(t, pendingIngB.Finalizers, "group.ingress.k8s.aws/awesome-group")
+	assert.Contains(t, pendingIngB.Annotations["ingress.k8s.aws/deploy-plan"], `"action":"Delete","resourceType":"AWS::ElasticLoadBalancingV2::ListenerRule"`)
+	plannedIngA := &networking.Ingress{}
+	require.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(ingA), plannedIngA))
+	assert.Equal(t, plannedIngA.Annotations["ingress.k8s.aws/deploy-plan"], pendingIngB.Annotations["ingress.k8s.aws/deploy-plan"])
+
+	// disabling dry-run deletes the AWS resources of ing-b and releases its finalizer.
+	delete(plannedIngA.Annotations, "alb.ingress.kubernetes.io/dry-run")
+	require.NoError(t, k8sClient.Update(ctx, plannedIngA))
+	require.NoError(t, reconciler.reconcile(ctx, req))
+
+	assert.Equal(t, 2, countListenerRules())
+	deletedIngB := &networking.Ingress{}
+	if err := k8sClient.Get(ctx, k8s.NamespacedName(ingB), deletedIngB); err != nil {
+		assert.True(t, apierrors.IsNotFound(err))
+	} else {
+		assert.NotContains(t, deletedIngB.Finalizers, "group.ingress.k8s.aws/awesome-group")
+	}
+}
+
+// Test_groupReconciler_reconcile_dryRunWithBuildFailure verifies build failures under dry-run are recorded in the reconcile status.
+func Test_groupReconciler_reconcile_dryRunWithBuildFailure(t *testing.T) {
+	ctx := context.Background()
+	reconciler, _, k8sClient := newGroupReconcilerWithFakeCloud(t)
+	ing := newIngressForFakeCloud("awesome-ing", "/", map[string]string{
+		"alb.ingress.kubernetes.io/dry-run": "true",
+		"alb.ingress.kubernetes.io/scheme":  "unknown-scheme",
+	})
+	require.NoError(t, k8sClient.Create(ctx, ing))
+	req := ingress.EncodeGroupIDToReconcileRequest(ingress.NewGroupIDForImplicitGroup(k8s.NamespacedName(ing)))
+	require.Error(t, reconciler.reconcile(ctx, req))
+
+	failedIng := &networking.Ingress{}
+	require.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(ing), failedIng))
+	assert.Contains(t, failedIng.Annotations["ingress.k8s.aws/reconcile-status"], `"succeeded":false`)
+	assert.Contains(t, failedIng.Annotations["ingress.k8s.aws/reconcile-status"], `"failedStage":"BuildModel"`)
+}
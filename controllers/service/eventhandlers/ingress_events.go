@@ -0,0 +1,93 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForIngressEvent constructs new enqueueRequestsForIngressEvent.
+func NewEnqueueRequestsForIngressEvent(k8sClient client.Client, ingressAnnotationParser annotations.Parser, logger logr.Logger) *enqueueRequestsForIngressEvent {
+	return &enqueueRequestsForIngressEvent{
+		k8sClient:               k8sClient,
+		ingressAnnotationParser: ingressAnnotationParser,
+		logger:                  logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForIngressEvent)(nil)
+
+// enqueueRequestsForIngressEvent enqueues Services that register the Application LoadBalancer of an IngressGroup as target,
+// so that they are re-registered once the Application LoadBalancer is provisioned or replaced.
+type enqueueRequestsForIngressEvent struct {
+	k8sClient               client.Client
+	ingressAnnotationParser annotations.Parser
+	logger                  logr.Logger
+}
+
+func (h *enqueueRequestsForIngressEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here, the Application LoadBalancer is reported via status update.
+}
+
+func (h *enqueueRequestsForIngressEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	ingOld := e.ObjectOld.(*networking.Ingress)
+	ingNew := e.ObjectNew.(*networking.Ingress)
+
+	// we only care below update event:
+	//	1. Ingress status updates, which happens when Application LoadBalancer is provisioned or replaced.
+	if equality.Semantic.DeepEqual(ingOld.Status, ingNew.Status) {
+		return
+	}
+	h.enqueueImpactedServices(queue, ingNew)
+}
+
+func (h *enqueueRequestsForIngressEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	ingOld := e.Object.(*networking.Ingress)
+	h.enqueueImpactedServices(queue, ingOld)
+}
+
+func (h *enqueueRequestsForIngressEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for ingresses.
+}
+
+func (h *enqueueRequestsForIngressEvent) enqueueImpactedServices(queue workqueue.RateLimitingInterface, ing *networking.Ingress) {
+	for _, stackID := range h.buildIngressStackIDs(ing) {
+		svcList := &corev1.ServiceList{}
+		if err := h.k8sClient.List(context.Background(), svcList,
+			client.MatchingFields{svcpkg.IndexKeyTargetIngressStackID: stackID.String()}); err != nil {
+			h.logger.Error(err, "failed to fetch services")
+			return
+		}
+		for index := range svcList.Items {
+			svc := &svcList.Items[index]
+
+			h.logger.V(1).Info("enqueue service for ingress event",
+				"ingress", k8s.NamespacedName(ing),
+				"service", k8s.NamespacedName(svc))
+			queue.Add(reconcile.Request{
+				NamespacedName: k8s.NamespacedName(svc),
+			})
+		}
+	}
+}
+
+// buildIngressStackIDs returns the stackIDs an Ingress can be referenced by, i.e. the Ingress itself and its explicit IngressGroup.
+func (h *enqueueRequestsForIngressEvent) buildIngressStackIDs(ing *networking.Ingress) []core.StackID {
+	stackIDs := []core.StackID{core.StackID(k8s.NamespacedName(ing))}
+	var groupName string
+	if exists := h.ingressAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, ing.Annotations); exists && len(groupName) != 0 {
+		stackIDs = append(stackIDs, core.StackID{Name: groupName})
+	}
+	return stackIDs
+}
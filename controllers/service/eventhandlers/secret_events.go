@@ -0,0 +1,79 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForSecretEvent constructs new enqueueRequestsForSecretEvent.
+func NewEnqueueRequestsForSecretEvent(k8sClient client.Client, logger logr.Logger) *enqueueRequestsForSecretEvent {
+	return &enqueueRequestsForSecretEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForSecretEvent)(nil)
+
+type enqueueRequestsForSecretEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForSecretEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	secretNew := e.Object.(*corev1.Secret)
+	h.enqueueImpactedServices(queue, secretNew)
+}
+
+func (h *enqueueRequestsForSecretEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	secretOld := e.ObjectOld.(*corev1.Secret)
+	secretNew := e.ObjectNew.(*corev1.Secret)
+
+	// we only care below update event:
+	//	1. Secret data updates
+	//	2. Secret deletions
+	if equality.Semantic.DeepEqual(secretOld.Data, secretNew.Data) &&
+		equality.Semantic.DeepEqual(secretOld.DeletionTimestamp.IsZero(), secretNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedServices(queue, secretNew)
+}
+
+func (h *enqueueRequestsForSecretEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	secretOld := e.Object.(*corev1.Secret)
+	h.enqueueImpactedServices(queue, secretOld)
+}
+
+func (h *enqueueRequestsForSecretEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for secrets.
+}
+
+func (h *enqueueRequestsForSecretEvent) enqueueImpactedServices(queue workqueue.RateLimitingInterface, secret *corev1.Secret) {
+	svcList := &corev1.ServiceList{}
+	if err := h.k8sClient.List(context.Background(), svcList,
+		client.InNamespace(secret.GetNamespace()),
+		client.MatchingFields{svcpkg.IndexKeySecretRefName: secret.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch services")
+		return
+	}
+	for index := range svcList.Items {
+		svc := &svcList.Items[index]
+
+		h.logger.V(1).Info("enqueue service for secret event",
+			"secret", k8s.NamespacedName(secret),
+			"service", k8s.NamespacedName(svc))
+		queue.Add(reconcile.Request{
+			NamespacedName: k8s.NamespacedName(svc),
+		})
+	}
+}
@@ -15,20 +15,20 @@ import (
 )
 
 // NewEnqueueRequestForServiceEvent constructs new enqueueRequestsForServiceEvent.
-func NewEnqueueRequestForServiceEvent(eventRecorder record.EventRecorder, annotationParser annotations.Parser, logger logr.Logger) *enqueueRequestsForServiceEvent {
+func NewEnqueueRequestForServiceEvent(eventRecorder record.EventRecorder, serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForServiceEvent {
 	return &enqueueRequestsForServiceEvent{
-		eventRecorder:    eventRecorder,
-		annotationParser: annotationParser,
-		logger:           logger,
+		eventRecorder: eventRecorder,
+		serviceUtils:  serviceUtils,
+		logger:        logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
 
 type enqueueRequestsForServiceEvent struct {
-	eventRecorder    record.EventRecorder
-	annotationParser annotations.Parser
-	logger           logr.Logger
+	eventRecorder record.EventRecorder
+	serviceUtils  svcpkg.ServiceUtils
+	logger        logr.Logger
 }
 
 func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
@@ -39,7 +39,7 @@ func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workq
 	oldSvc := e.ObjectOld.(*corev1.Service)
 	newSvc := e.ObjectNew.(*corev1.Service)
 
-	if equality.Semantic.DeepEqual(oldSvc.Annotations, newSvc.Annotations) &&
+	if equality.Semantic.DeepEqual(annotations.WithoutControllerManaged(oldSvc.Annotations), annotations.WithoutControllerManaged(newSvc.Annotations)) &&
 		equality.Semantic.DeepEqual(oldSvc.Spec, newSvc.Spec) &&
 		equality.Semantic.DeepEqual(oldSvc.DeletionTimestamp.IsZero(), newSvc.DeletionTimestamp.IsZero()) {
 		return
@@ -57,24 +57,9 @@ func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workq
 func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
 }
 
-func (h *enqueueRequestsForServiceEvent) isServiceSupported(service *corev1.Service) bool {
-	lbType := ""
-	_ = h.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, service.Annotations)
-	if lbType == svcpkg.LoadBalancerTypeNLBIP {
-		return true
-	}
-	var lbTargetType string
-	_ = h.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &lbTargetType, service.Annotations)
-	if lbType == svcpkg.LoadBalancerTypeExternal && (lbTargetType == svcpkg.LoadBalancerTargetTypeIP ||
-		lbTargetType == svcpkg.LoadBalancerTargetTypeInstance) {
-		return true
-	}
-	return false
-}
-
 func (h *enqueueRequestsForServiceEvent) enqueueManagedService(queue workqueue.RateLimitingInterface, service *corev1.Service) {
-	// Check if the svc needs to be handled
-	if !h.isServiceSupported(service) {
+	// Check if the svc needs to be handled, services that are no longer supported still need cleanup of AWS resources.
+	if !h.serviceUtils.IsServiceSupported(service) && !h.serviceUtils.IsServicePendingFinalization(service) {
 		return
 	}
 	queue.Add(reconcile.Request{
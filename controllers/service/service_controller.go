@@ -6,6 +6,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -29,7 +31,7 @@ import (
 const (
 	serviceFinalizer        = "service.k8s.aws/resources"
 	serviceTagPrefix        = "service.k8s.aws"
-	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+	serviceAnnotationPrefix = annotations.AnnotationPrefixService
 	controllerName          = "service"
 )
 
@@ -41,42 +43,58 @@ func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorde
 	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
 	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, config.ClusterName)
 	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), logger)
-	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcResolver, trackingProvider,
-		elbv2TaggingManager, config.ClusterName, config.DefaultTags, config.ExternalManagedTags, config.DefaultSSLPolicy)
+	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
+	modelBuilder := service.NewDefaultModelBuilder(k8sClient, eventRecorder, annotationParser, subnetsResolver, vpcResolver, sgResolver, trackingProvider,
+		elbv2TaggingManager, config.ClusterName, config.DefaultTags, config.ExternalManagedTags, config.DefaultSSLPolicy, config.EnableCertificateImport,
+		config.EnableNLBSecurityGroups)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, config, serviceTagPrefix, logger)
+	reconcileStatusManager := k8s.NewDefaultReconcileStatusManager(k8sClient, annotations.SvcAnnotationReconcileStatus, logger)
+	serviceUtils := service.NewServiceUtils(annotationParser, serviceFinalizer, config.ServiceConfig.LoadBalancerClass)
+	deletionPolicyEnforcer := deploy.NewDefaultDeletionPolicyEnforcer(cloud, k8sClient, config, serviceTagPrefix, logger)
 	return &serviceReconciler{
-		k8sClient:        k8sClient,
-		eventRecorder:    eventRecorder,
-		finalizerManager: finalizerManager,
-		annotationParser: annotationParser,
+		k8sClient:              k8sClient,
+		eventRecorder:          eventRecorder,
+		finalizerManager:       finalizerManager,
+		reconcileStatusManager: reconcileStatusManager,
+		annotationParser:       annotationParser,
+		serviceUtils:           serviceUtils,
 
-		modelBuilder:    modelBuilder,
-		stackMarshaller: stackMarshaller,
-		stackDeployer:   stackDeployer,
-		logger:          logger,
+		modelBuilder:           modelBuilder,
+		stackMarshaller:        stackMarshaller,
+		stackDeployer:          stackDeployer,
+		stackPlanner:           stackDeployer,
+		deletionPolicyEnforcer: deletionPolicyEnforcer,
+		logger:                 logger,
 
+		enableCertImport:        config.EnableCertificateImport,
 		maxConcurrentReconciles: config.ServiceMaxConcurrentReconciles,
 	}
 }
 
 type serviceReconciler struct {
-	k8sClient        client.Client
-	eventRecorder    record.EventRecorder
-	finalizerManager k8s.FinalizerManager
-	annotationParser annotations.Parser
+	k8sClient              client.Client
+	eventRecorder          record.EventRecorder
+	finalizerManager       k8s.FinalizerManager
+	reconcileStatusManager k8s.ReconcileStatusManager
+	annotationParser       annotations.Parser
+	serviceUtils           service.ServiceUtils
 
-	modelBuilder    service.ModelBuilder
-	stackMarshaller deploy.StackMarshaller
-	stackDeployer   deploy.StackDeployer
-	logger          logr.Logger
+	modelBuilder           service.ModelBuilder
+	stackMarshaller        deploy.StackMarshaller
+	stackDeployer          deploy.StackDeployer
+	stackPlanner           deploy.StackPlanner
+	deletionPolicyEnforcer deploy.DeletionPolicyEnforcer
+	logger                 logr.Logger
 
+	enableCertImport        bool
 	maxConcurrentReconciles int
 }
 
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
@@ -87,27 +105,50 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) err
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, svc); err != nil {
 		return client.IgnoreNotFound(err)
 	}
+	// Services being deleted are always cleaned up regardless of dry-run, otherwise they will be stuck with our finalizer.
 	if !svc.DeletionTimestamp.IsZero() {
 		return r.cleanupLoadBalancerResources(ctx, svc)
 	}
+	dryRun := false
+	if _, err := r.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixDryRun, &dryRun, svc.Annotations); err != nil {
+		return err
+	}
+	if dryRun {
+		return r.buildAndPlanModel(ctx, svc)
+	}
+	if !r.serviceUtils.IsServiceSupported(svc) {
+		return r.cleanupLoadBalancerResources(ctx, svc)
+	}
 	return r.reconcileLoadBalancerResources(ctx, svc)
 }
 
+// buildModel builds the model stack for Service.
+// Services that are no longer supported by this controller get an empty stack, so that their AWS resources are cleaned up.
+func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error) {
+	if !r.serviceUtils.IsServiceSupported(svc) {
+		return core.NewDefaultStack(core.StackID(k8s.NamespacedName(svc))), nil, nil
+	}
+	return r.modelBuilder.Build(ctx, svc)
+}
+
 func (r *serviceReconciler) buildAndDeployModel(ctx context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error) {
-	stack, lb, err := r.modelBuilder.Build(ctx, svc)
+	stack, lb, err := r.buildModel(ctx, svc)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.recordServiceReconcileFailure(ctx, svc, k8s.ReconcileStageBuildModel, err)
 		return nil, nil, err
 	}
 	stackJSON, err := r.stackMarshaller.Marshal(stack)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.recordServiceReconcileFailure(ctx, svc, k8s.ReconcileStageBuildModel, err)
 		return nil, nil, err
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
 
 	if err = r.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		r.recordServiceReconcileFailure(ctx, svc, k8s.ReconcileStageDeployModel, err)
 		return nil, nil, err
 	}
 	r.logger.Info("successfully deployed model", "service", k8s.NamespacedName(svc))
@@ -115,9 +156,67 @@ func (r *serviceReconciler) buildAndDeployModel(ctx context.Context, svc *corev1
 	return stack, lb, nil
 }
 
+// buildAndPlanModel computes the changes needed to deploy Service without mutating any AWS resources,
+// and surfaces the planned changes via events and annotation on Service.
+func (r *serviceReconciler) buildAndPlanModel(ctx context.Context, svc *corev1.Service) error {
+	stack, _, err := r.buildModel(ctx, svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	changeSet, err := r.stackPlanner.Plan(ctx, stack)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	changeSetJSON, err := changeSet.Marshal()
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully planned model", "service", k8s.NamespacedName(svc), "changeSet", changeSetJSON)
+
+	deployPlan, err := changeSet.MarshalWithSizeLimit(plan.MaxAnnotationSize)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	if err := r.updateServiceDeployPlan(ctx, svc, deployPlan); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update deploy plan due to %v", err))
+		return err
+	}
+	eventType := corev1.EventTypeNormal
+	if len(changeSet.Deletions()) != 0 {
+		eventType = corev1.EventTypeWarning
+	}
+	r.eventRecorder.Event(svc, eventType, k8s.ServiceEventReasonPlannedChanges, fmt.Sprintf("Planned changes: %v", changeSet.Summary()))
+	return nil
+}
+
+// updateServiceDeployPlan sets the deploy plan annotation on Service, an empty changeSetJSON removes it.
+func (r *serviceReconciler) updateServiceDeployPlan(ctx context.Context, svc *corev1.Service, changeSetJSON string) error {
+	if svc.Annotations[annotations.SvcAnnotationDeployPlan] == changeSetJSON {
+		return nil
+	}
+	svcOld := svc.DeepCopy()
+	if changeSetJSON == "" {
+		delete(svc.Annotations, annotations.SvcAnnotationDeployPlan)
+	} else {
+		if svc.Annotations == nil {
+			svc.Annotations = make(map[string]string)
+		}
+		svc.Annotations[annotations.SvcAnnotationDeployPlan] = changeSetJSON
+	}
+	if err := r.k8sClient.Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
+		return errors.Wrapf(err, "failed to update service deploy plan: %v", k8s.NamespacedName(svc))
+	}
+	return nil
+}
+
 func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context, svc *corev1.Service) error {
 	if err := r.finalizerManager.AddFinalizers(ctx, svc, serviceFinalizer); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		r.recordServiceReconcileFailure(ctx, svc, k8s.ReconcileStageAddFinalizer, err)
 		return err
 	}
 	_, lb, err := r.buildAndDeployModel(ctx, svc)
@@ -128,8 +227,21 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 	if err != nil {
 		return err
 	}
+	lbARN, err := lb.LoadBalancerARN().Resolve(ctx)
+	if err != nil {
+		return err
+	}
 
 	if err = r.updateServiceStatus(ctx, lbDNS, svc); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		r.recordServiceReconcileFailure(ctx, svc, k8s.ReconcileStageUpdateStatus, err)
+		return err
+	}
+	if err := r.updateServiceDeployPlan(ctx, svc, ""); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update deploy plan due to %v", err))
+		return err
+	}
+	if err := r.reconcileStatusManager.RecordSuccess(ctx, svc, lbARN); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
@@ -139,18 +251,56 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 
 func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, svc *corev1.Service) error {
 	if k8s.HasFinalizer(svc, serviceFinalizer) {
+		if err := r.enforceDeletionPolicy(ctx, svc); err != nil {
+			return err
+		}
 		_, _, err := r.buildAndDeployModel(ctx, svc)
 		if err != nil {
 			return err
 		}
 		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, serviceFinalizer); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+			r.recordServiceReconcileFailure(ctx, svc, k8s.ReconcileStageRemoveFinalizer, err)
 			return err
 		}
+		if svc.DeletionTimestamp.IsZero() {
+			if err := r.cleanupServiceStatus(ctx, svc); err != nil {
+				r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// enforceDeletionPolicy enforces the deletion policy before the LoadBalancer of Service is deleted.
+func (r *serviceReconciler) enforceDeletionPolicy(ctx context.Context, svc *corev1.Service) error {
+	deletionPolicy, err := service.BuildDeletionPolicy(r.annotationParser, svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeletionPolicy, fmt.Sprintf("Failed resolve deletion policy due to %v", err))
+		return err
+	}
+	if err := r.deletionPolicyEnforcer.Enforce(ctx, core.StackID(k8s.NamespacedName(svc)), deletionPolicy); err != nil {
+		var deletionProtectedErr *deploy.DeletionProtectedError
+		if errors.As(err, &deletionProtectedErr) {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonDeletionProtected,
+				fmt.Sprintf("Refused to delete load balancer %v since deletion protection is enabled", deletionProtectedErr.LoadBalancerARN))
+		} else {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeletionPolicy, fmt.Sprintf("Failed enforce %v deletion policy due to %v", deletionPolicy, err))
+		}
+		return err
 	}
 	return nil
 }
 
+// recordServiceReconcileFailure records the reconcile failure onto Service.
+// it's best-effort since the reconcile error will be retried anyway.
+func (r *serviceReconciler) recordServiceReconcileFailure(ctx context.Context, svc *corev1.Service, stage k8s.ReconcileStage, reconcileErr error) {
+	if err := r.reconcileStatusManager.RecordFailure(ctx, svc, stage, reconcileErr); err != nil {
+		r.logger.Error(err, "failed to record reconcile failure", "service", k8s.NamespacedName(svc))
+	}
+}
+
 func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, svc *corev1.Service) error {
 	if len(svc.Status.LoadBalancer.Ingress) != 1 ||
 		svc.Status.LoadBalancer.Ingress[0].IP != "" ||
@@ -168,6 +318,19 @@ func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS strin
 	return nil
 }
 
+// cleanupServiceStatus removes the load balancer from status of Services that are no longer supported by this controller.
+func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev1.Service) error {
+	if len(svc.Status.LoadBalancer.Ingress) == 0 {
+		return nil
+	}
+	svcOld := svc.DeepCopy()
+	svc.Status.LoadBalancer = corev1.LoadBalancerStatus{}
+	if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
+		return errors.Wrapf(err, "failed to cleanup service status: %v", k8s.NamespacedName(svc))
+	}
+	return nil
+}
+
 func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	c, err := controller.New(controllerName, mgr, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
@@ -176,17 +339,53 @@ func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 	if err != nil {
 		return err
 	}
+	if err := r.setupIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
 	if err := r.setupWatches(ctx, c); err != nil {
 		return err
 	}
 	return nil
 }
 
+func (r *serviceReconciler) setupIndexes(ctx context.Context, fieldIndexer client.FieldIndexer) error {
+	if r.enableCertImport {
+		if err := fieldIndexer.IndexField(ctx, &corev1.Service{}, service.IndexKeySecretRefName,
+			func(obj client.Object) []string {
+				return service.BuildSecretRefIndexes(r.annotationParser, obj.(*corev1.Service))
+			},
+		); err != nil {
+			return err
+		}
+	}
+	if err := fieldIndexer.IndexField(ctx, &corev1.Service{}, service.IndexKeyTargetIngressStackID,
+		func(obj client.Object) []string {
+			return service.BuildTargetIngressStackIDIndexes(r.annotationParser, obj.(*corev1.Service))
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *serviceReconciler) setupWatches(_ context.Context, c controller.Controller) error {
-	svcEventHandler := eventhandlers.NewEnqueueRequestForServiceEvent(r.eventRecorder, r.annotationParser,
+	svcEventHandler := eventhandlers.NewEnqueueRequestForServiceEvent(r.eventRecorder, r.serviceUtils,
 		r.logger.WithName("eventHandlers").WithName("service"))
 	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
 		return err
 	}
+	if r.enableCertImport {
+		secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(r.k8sClient,
+			r.logger.WithName("eventHandlers").WithName("secret"))
+		if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, secretEventHandler); err != nil {
+			return err
+		}
+	}
+	ingEventHandler := eventhandlers.NewEnqueueRequestsForIngressEvent(r.k8sClient,
+		annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
+		r.logger.WithName("eventHandlers").WithName("ingress"))
+	if err := c.Watch(&source.Kind{Type: &networkingv1.Ingress{}}, ingEventHandler); err != nil {
+		return err
+	}
 	return nil
 }
@@ -27,16 +27,20 @@ import (
 	"os"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	elbv2controller "sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gc"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/trafficshift"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
 	corewebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/core"
 	elbv2webhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/elbv2"
@@ -45,6 +49,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -57,6 +62,7 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 
 	_ = elbv2api.AddToScheme(scheme)
+	_ = gwapi.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -97,6 +103,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	endpointSliceEnabled, err := k8s.IsEndpointSliceAPIAvailable(clientSet.Discovery())
+	if err != nil {
+		setupLog.Error(err, "unable to detect EndpointSlice API")
+		os.Exit(1)
+	}
+	if !endpointSliceEnabled {
+		setupLog.Info("EndpointSlice API is not available, falling back to Endpoints")
+		if controllerCFG.EnableGracefulDraining {
+			setupLog.Info("graceful draining requires EndpointSlice API, terminating pods won't be signaled")
+		}
+	}
+
 	podInfoRepo := k8s.NewDefaultPodInfoRepo(clientSet.CoreV1().RESTClient(), rtOpts.Namespace, ctrl.Log)
 	finalizerManager := k8s.NewDefaultFinalizerManager(mgr.GetClient(), ctrl.Log)
 	podENIResolver := networking.NewDefaultPodENIInfoResolver(cloud.EC2(), cloud.VpcID(), ctrl.Log)
@@ -106,9 +124,10 @@ func main() {
 	azInfoProvider := networking.NewDefaultAZInfoProvider(cloud.EC2(), ctrl.Log.WithName("az-info-provider"))
 	subnetResolver := networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, ctrl.Log.WithName("subnets-resolver"))
 	vpcResolver := networking.NewDefaultVPCResolver(cloud.EC2(), cloud.VpcID(), ctrl.Log.WithName("vpc-resolver"))
-	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(),
-		podInfoRepo, podENIResolver, nodeENIResolver, sgManager, sgReconciler, cloud.VpcID(), controllerCFG.ClusterName, mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log)
-	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
+	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), mgr.GetAPIReader(), cloud.ELBV2(),
+		podInfoRepo, podENIResolver, nodeENIResolver, sgManager, sgReconciler, cloud.VpcID(), controllerCFG.ClusterName,
+		endpointSliceEnabled, controllerCFG.EnableGracefulDraining, mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log)
+	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetAPIReader(), mgr.GetEventRecorderFor("ingress"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver,
 		controllerCFG, ctrl.Log.WithName("controllers").WithName("ingress"))
 	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
@@ -116,7 +135,17 @@ func main() {
 		controllerCFG, ctrl.Log.WithName("controllers").WithName("service"))
 	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("targetGroupBinding"),
 		finalizerManager, tgbResManager,
-		controllerCFG, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+		controllerCFG, endpointSliceEnabled, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+	canaryAnalyzer := trafficshift.NewDefaultCanaryAnalyzer(mgr.GetClient(), cloud.ELBV2(), cloud.CloudWatch(),
+		annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress), ctrl.Log.WithName("canary-analyzer"))
+	tsReconciler := elbv2controller.NewTrafficShiftReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("trafficShift"),
+		canaryAnalyzer, ctrl.Log.WithName("controllers").WithName("trafficShift"))
+	lrReconciler := elbv2controller.NewListenerRuleReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("listenerRule"),
+		finalizerManager, sgManager, sgReconciler,
+		controllerCFG, ctrl.Log.WithName("controllers").WithName("listenerRule"))
+	tgReconciler := elbv2controller.NewTargetGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("targetGroup"),
+		finalizerManager, sgManager, sgReconciler,
+		controllerCFG, ctrl.Log.WithName("controllers").WithName("targetGroup"))
 
 	ctx := ctrl.SetupSignalHandler()
 	if err = ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
@@ -131,6 +160,40 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
 		os.Exit(1)
 	}
+	if err := tsReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TrafficShift")
+		os.Exit(1)
+	}
+	if err := lrReconciler.SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ListenerRule")
+		os.Exit(1)
+	}
+	if err := tgReconciler.SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TargetGroup")
+		os.Exit(1)
+	}
+	if controllerCFG.GatewayConfig.EnableGatewayAPI {
+		gwReconciler := gateway.NewGatewayReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("gateway"),
+			finalizerManager, sgManager, sgReconciler, subnetResolver,
+			controllerCFG, ctrl.Log.WithName("controllers").WithName("gateway"))
+		if err := gwReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Gateway")
+			os.Exit(1)
+		}
+	}
+
+	if controllerCFG.GCConfig.EnableOrphanGC {
+		orphanCollector, err := gc.NewDefaultOrphanCollector(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("orphan-gc"),
+			metrics.Registry, controllerCFG, ctrl.Log.WithName("orphan-gc"))
+		if err != nil {
+			setupLog.Error(err, "unable to create orphan collector")
+			os.Exit(1)
+		}
+		if err := mgr.Add(orphanCollector); err != nil {
+			setupLog.Error(err, "unable to add orphan collector")
+			os.Exit(1)
+		}
+	}
 
 	// Add liveness probe
 	err = mgr.AddHealthzCheck("health-ping", healthz.Ping)
@@ -143,8 +206,13 @@ func main() {
 	podReadinessGateInjector := inject.NewPodReadinessGate(controllerCFG.PodWebhookConfig,
 		mgr.GetClient(), ctrl.Log.WithName("pod-readiness-gate-injector"))
 	corewebhook.NewPodMutator(podReadinessGateInjector).SetupWithManager(mgr)
-	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
+	if controllerCFG.ServiceConfig.EnableServiceMutatorWebhook {
+		corewebhook.NewServiceMutator(controllerCFG.ServiceConfig.LoadBalancerClass,
+			annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixService), ctrl.Log).SetupWithManager(mgr)
+	}
+	elbv2webhook.NewTargetGroupBindingMutator(mgr.GetClient(), cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
 	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewTargetGroupValidator(mgr.GetClient(), ctrl.Log).SetupWithManager(mgr)
 	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, ctrl.Log).SetupWithManager(mgr)
 	//+kubebuilder:scaffold:builder
 
@@ -47,3 +47,14 @@ func DiffStringMap(desired map[string]string, current map[string]string) (map[st
 
 	return modify, remove
 }
+
+// IsStringMapSubset checks whether all k/v in subset exists in superset.
+// e.g. IsStringMapSubset(map[string]string{"a": "1"}, map[string]string{"a": "1", "b": "2"}) == true
+func IsStringMapSubset(subset map[string]string, superset map[string]string) bool {
+	for key, value := range subset {
+		if supersetValue, ok := superset[key]; !ok || supersetValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -275,3 +275,70 @@ func TestDiffStringMap(t *testing.T) {
 		})
 	}
 }
+
+func TestIsStringMapSubset(t *testing.T) {
+	type args struct {
+		subset   map[string]string
+		superset map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "subset is empty",
+			args: args{
+				subset: nil,
+				superset: map[string]string{
+					"a": "a1",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "all k/v exists in superset",
+			args: args{
+				subset: map[string]string{
+					"a": "a1",
+				},
+				superset: map[string]string{
+					"a": "a1",
+					"b": "b1",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "value differs in superset",
+			args: args{
+				subset: map[string]string{
+					"a": "a1",
+				},
+				superset: map[string]string{
+					"a": "a2",
+				},
+			},
+			want: false,
+		},
+		{
+			name: "key missing in superset",
+			args: args{
+				subset: map[string]string{
+					"a": "a1",
+					"b": "b1",
+				},
+				superset: map[string]string{
+					"a": "a1",
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsStringMapSubset(tt.args.subset, tt.args.superset)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
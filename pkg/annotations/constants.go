@@ -45,7 +45,13 @@ const (
 	IngressSuffixAuthSessionCookie            = "auth-session-cookie"
 	IngressSuffixAuthSessionTimeout           = "auth-session-timeout"
 	IngressSuffixTargetNodeLabels             = "target-node-labels"
+	IngressSuffixDryRun                       = "dry-run"
+	IngressSuffixMutualAuthentication         = "mutual-authentication"
+	IngressSuffixDeletionPolicy               = "deletion-policy"
+	IngressSuffixAdoptLoadBalancerARN         = "adopt-load-balancer-arn"
+	IngressSuffixPreserveUnmanagedListeners   = "preserve-unmanaged-listeners"
 
+	AnnotationPrefixService = "service.beta.kubernetes.io"
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
 	SvcLBSuffixSourceRanges                  = "load-balancer-source-ranges"
@@ -61,6 +67,7 @@ const (
 	SvcLBSuffixAccessLogS3BucketPrefix       = "aws-load-balancer-access-log-s3-bucket-prefix"
 	SvcLBSuffixCrossZoneLoadBalancingEnabled = "aws-load-balancer-cross-zone-load-balancing-enabled"
 	SvcLBSuffixSSLCertificate                = "aws-load-balancer-ssl-cert"
+	SvcLBSuffixSSLCertSecrets                = "aws-load-balancer-ssl-cert-secrets"
 	SvcLBSuffixSSLPorts                      = "aws-load-balancer-ssl-ports"
 	SvcLBSuffixSSLNegotiationPolicy          = "aws-load-balancer-ssl-negotiation-policy"
 	SvcLBSuffixBEProtocol                    = "aws-load-balancer-backend-protocol"
@@ -79,4 +86,19 @@ const (
 	SvcLBSuffixALPNPolicy                    = "aws-load-balancer-alpn-policy"
 	SvcLBSuffixTargetNodeLabels              = "aws-load-balancer-target-node-labels"
 	SvcLBSuffixLoadBalancerAttributes        = "aws-load-balancer-attributes"
+	SvcLBSuffixDryRun                        = "aws-load-balancer-dry-run"
+	SvcLBSuffixSubnetDiscoveryTags           = "aws-load-balancer-subnet-discovery-tags"
+	SvcLBSuffixSubnetDiscoveryMinIPs         = "aws-load-balancer-subnet-discovery-min-available-ip-addresses"
+	SvcLBSuffixSecurityGroups                = "aws-load-balancer-security-groups"
+	SvcLBSuffixDeletionPolicy                = "aws-load-balancer-deletion-policy"
+	SvcLBSuffixAdoptARN                      = "aws-load-balancer-adopt-arn"
+	SvcLBSuffixPreserveUnmanagedListeners    = "aws-load-balancer-preserve-unmanaged-listeners"
+	SvcLBSuffixTargetIngress                 = "aws-load-balancer-target-ingress"
+	SvcLBSuffixTargetIngressGroup            = "aws-load-balancer-target-ingress-group"
+
+	// Annotations managed by controller to report reconcile results.
+	IngressAnnotationDeployPlan      = "ingress.k8s.aws/deploy-plan"
+	IngressAnnotationReconcileStatus = "ingress.k8s.aws/reconcile-status"
+	SvcAnnotationDeployPlan          = "service.k8s.aws/deploy-plan"
+	SvcAnnotationReconcileStatus     = "service.k8s.aws/reconcile-status"
 )
@@ -0,0 +1,24 @@
+package annotations
+
+import "k8s.io/apimachinery/pkg/util/sets"
+
+// controllerManagedAnnotations are the annotations written by controller itself.
+var controllerManagedAnnotations = sets.NewString(
+	IngressAnnotationDeployPlan,
+	IngressAnnotationReconcileStatus,
+	SvcAnnotationDeployPlan,
+	SvcAnnotationReconcileStatus,
+)
+
+// WithoutControllerManaged returns a copy of annotations without the ones written by controller itself.
+// It's used to filter out object updates made by controller, which shouldn't trigger another reconcile.
+func WithoutControllerManaged(annotations map[string]string) map[string]string {
+	filtered := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		if controllerManagedAnnotations.Has(key) {
+			continue
+		}
+		filtered[key] = value
+	}
+	return filtered
+}
@@ -33,6 +33,12 @@ type Cloud interface {
 	// RGT provides API to AWS RGT
 	RGT() services.RGT
 
+	// CloudWatch provides API to AWS CloudWatch
+	CloudWatch() services.CloudWatch
+
+	// S3 provides API to AWS S3
+	S3() services.S3
+
 	// Region for the kubernetes cluster
 	Region() string
 
@@ -85,6 +91,8 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 		wafRegional: services.NewWAFRegional(sess, cfg.Region),
 		shield:      services.NewShield(sess),
 		rgt:         services.NewRGT(sess),
+		cloudWatch:  services.NewCloudWatch(sess),
+		s3:          services.NewS3(sess),
 	}, nil
 }
 
@@ -101,6 +109,8 @@ type defaultCloud struct {
 	wafRegional services.WAFRegional
 	shield      services.Shield
 	rgt         services.RGT
+	cloudWatch  services.CloudWatch
+	s3          services.S3
 }
 
 func (c *defaultCloud) EC2() services.EC2 {
@@ -131,6 +141,14 @@ func (c *defaultCloud) RGT() services.RGT {
 	return c.rgt
 }
 
+func (c *defaultCloud) CloudWatch() services.CloudWatch {
+	return c.cloudWatch
+}
+
+func (c *defaultCloud) S3() services.S3 {
+	return c.s3
+}
+
 func (c *defaultCloud) Region() string {
 	return c.cfg.Region
 }
@@ -0,0 +1,237 @@
+package fake
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+type certificateRecord struct {
+	detail *acmsdk.CertificateDetail
+	tags   map[string]string
+}
+
+var _ services.ACM = &fakeACM{}
+
+// fakeACM is an in-memory implementation of services.ACM.
+type fakeACM struct {
+	unimplementedACM
+	backend *backend
+}
+
+func (c *fakeACM) ImportCertificateWithContext(_ context.Context, input *acmsdk.ImportCertificateInput, _ ...request.Option) (*acmsdk.ImportCertificateOutput, error) {
+	release, err := c.backend.begin(ServiceACM, "ImportCertificate")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	x509Cert, err := parseCertificatePEM(input.Certificate)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(input.PrivateKey); block == nil {
+		return nil, NewAPIError("ValidationException", "The private key is not supported")
+	}
+	certARN := awssdk.StringValue(input.CertificateArn)
+	var record *certificateRecord
+	if len(certARN) != 0 {
+		existingRecord, err := c.backend.findCertificate(certARN)
+		if err != nil {
+			return nil, err
+		}
+		if len(input.Tags) != 0 {
+			return nil, NewAPIError("ValidationException", "Tags cannot be specified when reimporting a certificate")
+		}
+		record = existingRecord
+	} else {
+		certARN = fmt.Sprintf("arn:aws:acm:%v:%v:certificate/%v", c.backend.region, c.backend.accountID, c.backend.nextUUID())
+		record = &certificateRecord{tags: make(map[string]string)}
+		for _, tag := range input.Tags {
+			record.tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		}
+		c.backend.certificates[certARN] = record
+	}
+	domainName := x509Cert.Subject.CommonName
+	if len(domainName) == 0 && len(x509Cert.DNSNames) != 0 {
+		domainName = x509Cert.DNSNames[0]
+	}
+	sans := sets.NewString(x509Cert.DNSNames...)
+	if len(domainName) != 0 {
+		sans.Insert(domainName)
+	}
+	status := acmsdk.CertificateStatusIssued
+	if time.Now().After(x509Cert.NotAfter) {
+		status = acmsdk.CertificateStatusExpired
+	}
+	record.detail = &acmsdk.CertificateDetail{
+		CertificateArn:          awssdk.String(certARN),
+		DomainName:              awssdk.String(domainName),
+		SubjectAlternativeNames: awssdk.StringSlice(sans.List()),
+		Subject:                 awssdk.String(x509Cert.Subject.String()),
+		Issuer:                  awssdk.String(x509Cert.Issuer.String()),
+		Serial:                  awssdk.String(x509Cert.SerialNumber.String()),
+		NotBefore:               awssdk.Time(x509Cert.NotBefore),
+		NotAfter:                awssdk.Time(x509Cert.NotAfter),
+		ImportedAt:              awssdk.Time(time.Now()),
+		Status:                  awssdk.String(status),
+		Type:                    awssdk.String(acmsdk.CertificateTypeImported),
+	}
+	return &acmsdk.ImportCertificateOutput{CertificateArn: awssdk.String(certARN)}, nil
+}
+
+func (c *fakeACM) DescribeCertificateWithContext(_ context.Context, input *acmsdk.DescribeCertificateInput, _ ...request.Option) (*acmsdk.DescribeCertificateOutput, error) {
+	release, err := c.backend.begin(ServiceACM, "DescribeCertificate")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	certARN := awssdk.StringValue(input.CertificateArn)
+	record, err := c.backend.findCertificate(certARN)
+	if err != nil {
+		return nil, err
+	}
+	detail := copyOf(record.detail).(*acmsdk.CertificateDetail)
+	detail.InUseBy = awssdk.StringSlice(c.backend.certificateInUseBy(certARN))
+	return &acmsdk.DescribeCertificateOutput{Certificate: detail}, nil
+}
+
+func (c *fakeACM) ListCertificatesAsList(_ context.Context, input *acmsdk.ListCertificatesInput) ([]*acmsdk.CertificateSummary, error) {
+	release, err := c.backend.begin(ServiceACM, "ListCertificates")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	statuses := sets.NewString(awssdk.StringValueSlice(input.CertificateStatuses)...)
+	var summaries []*acmsdk.CertificateSummary
+	for _, certARN := range sets.StringKeySet(c.backend.certificates).List() {
+		detail := c.backend.certificates[certARN].detail
+		if len(statuses) != 0 && !statuses.Has(awssdk.StringValue(detail.Status)) {
+			continue
+		}
+		summaries = append(summaries, &acmsdk.CertificateSummary{
+			CertificateArn: detail.CertificateArn,
+			DomainName:     detail.DomainName,
+			Status:         detail.Status,
+			Type:           detail.Type,
+			NotAfter:       detail.NotAfter,
+			InUse:          awssdk.Bool(len(c.backend.certificateInUseBy(certARN)) != 0),
+		})
+	}
+	return copyOf(summaries).([]*acmsdk.CertificateSummary), nil
+}
+
+func (c *fakeACM) DeleteCertificateWithContext(_ context.Context, input *acmsdk.DeleteCertificateInput, _ ...request.Option) (*acmsdk.DeleteCertificateOutput, error) {
+	release, err := c.backend.begin(ServiceACM, "DeleteCertificate")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	certARN := awssdk.StringValue(input.CertificateArn)
+	if _, err := c.backend.findCertificate(certARN); err != nil {
+		return nil, err
+	}
+	if len(c.backend.certificateInUseBy(certARN)) != 0 {
+		return nil, newAPIErrorf("ResourceInUseException", "Certificate %v in account %v is in use.", certARN, c.backend.accountID)
+	}
+	delete(c.backend.certificates, certARN)
+	return &acmsdk.DeleteCertificateOutput{}, nil
+}
+
+func (c *fakeACM) ListTagsForCertificateWithContext(_ context.Context, input *acmsdk.ListTagsForCertificateInput, _ ...request.Option) (*acmsdk.ListTagsForCertificateOutput, error) {
+	release, err := c.backend.begin(ServiceACM, "ListTagsForCertificate")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findCertificate(awssdk.StringValue(input.CertificateArn))
+	if err != nil {
+		return nil, err
+	}
+	var tags []*acmsdk.Tag
+	for _, key := range sets.StringKeySet(record.tags).List() {
+		tags = append(tags, &acmsdk.Tag{Key: awssdk.String(key), Value: awssdk.String(record.tags[key])})
+	}
+	return &acmsdk.ListTagsForCertificateOutput{Tags: tags}, nil
+}
+
+func (c *fakeACM) AddTagsToCertificateWithContext(_ context.Context, input *acmsdk.AddTagsToCertificateInput, _ ...request.Option) (*acmsdk.AddTagsToCertificateOutput, error) {
+	release, err := c.backend.begin(ServiceACM, "AddTagsToCertificate")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findCertificate(awssdk.StringValue(input.CertificateArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range input.Tags {
+		record.tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+	}
+	return &acmsdk.AddTagsToCertificateOutput{}, nil
+}
+
+func (c *fakeACM) RemoveTagsFromCertificateWithContext(_ context.Context, input *acmsdk.RemoveTagsFromCertificateInput, _ ...request.Option) (*acmsdk.RemoveTagsFromCertificateOutput, error) {
+	release, err := c.backend.begin(ServiceACM, "RemoveTagsFromCertificate")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findCertificate(awssdk.StringValue(input.CertificateArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range input.Tags {
+		tagKey := awssdk.StringValue(tag.Key)
+		// when tag value is specified, the tag is only removed if value matches.
+		if tag.Value != nil && record.tags[tagKey] != awssdk.StringValue(tag.Value) {
+			continue
+		}
+		delete(record.tags, tagKey)
+	}
+	return &acmsdk.RemoveTagsFromCertificateOutput{}, nil
+}
+
+func (b *backend) findCertificate(certARN string) (*certificateRecord, error) {
+	record, ok := b.certificates[certARN]
+	if !ok {
+		return nil, newAPIErrorf("ResourceNotFoundException", "Could not find certificate %v.", certARN)
+	}
+	return record, nil
+}
+
+// certificateInUseBy computes the ARNs of load balancers using certificate.
+func (b *backend) certificateInUseBy(certARN string) []string {
+	lbARNs := sets.NewString()
+	for _, record := range b.listeners {
+		if findCertificate(record.listener.Certificates, certARN) != -1 || findCertificate(record.extraCertificates, certARN) != -1 {
+			lbARNs.Insert(awssdk.StringValue(record.listener.LoadBalancerArn))
+		}
+	}
+	return lbARNs.List()
+}
+
+// nextUUID generates an unique identifier in UUID format.
+func (b *backend) nextUUID() string {
+	id := b.nextID(32)
+	return fmt.Sprintf("%v-%v-%v-%v-%v", id[0:8], id[8:12], id[12:16], id[16:20], id[20:32])
+}
+
+func parseCertificatePEM(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, NewAPIError("ValidationException", "Could not validate the certificate with the certificate chain")
+	}
+	x509Cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, NewAPIError("ValidationException", "Could not validate the certificate with the certificate chain")
+	}
+	return x509Cert, nil
+}
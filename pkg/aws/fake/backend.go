@@ -0,0 +1,214 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	shieldsdk "github.com/aws/aws-sdk-go/service/shield"
+)
+
+const (
+	// the AWS account ID owning all fake resources.
+	defaultAccountID = "123456789012"
+)
+
+// backend holds the state of all fake AWS services.
+// A single backend is shared between fake services, so that invariants spanning services (e.g. a certificate cannot be
+// deleted while used by a listener) can be enforced the same way as AWS.
+type backend struct {
+	mutex     sync.Mutex
+	region    string
+	accountID string
+	idCounter int64
+	faults    map[string][]error
+
+	// EC2 resources
+	vpcs              map[string]*ec2sdk.Vpc
+	availabilityZones []*ec2sdk.AvailabilityZone
+	subnets           map[string]*subnetRecord
+	securityGroups    map[string]*ec2sdk.SecurityGroup
+	instances         map[string]*ec2sdk.Instance
+	networkInterfaces map[string]*ec2sdk.NetworkInterface
+
+	// ELBV2 resources
+	loadBalancers map[string]*loadBalancerRecord
+	listeners     map[string]*listenerRecord
+	rules         map[string]*ruleRecord
+	targetGroups  map[string]*targetGroupRecord
+	trustStores   map[string]*trustStoreRecord
+
+	// ACM resources
+	certificates map[string]*certificateRecord
+
+	// WAFv2 / WAFRegional resources
+	webACLs                  map[string]*webACLRecord
+	webACLByResource         map[string]string
+	regionalWebACLs          map[string]*webACLRecord
+	regionalWebACLByResource map[string]string
+
+	// Shield resources
+	shieldSubscribed bool
+	protections      map[string]*shieldsdk.Protection
+
+	// S3 resources
+	buckets map[string]map[string][]byte
+
+	// CloudWatch resources
+	metricData []*metricDatumRecord
+}
+
+func newBackend(region string) *backend {
+	return &backend{
+		region:                   region,
+		accountID:                defaultAccountID,
+		faults:                   make(map[string][]error),
+		vpcs:                     make(map[string]*ec2sdk.Vpc),
+		subnets:                  make(map[string]*subnetRecord),
+		securityGroups:           make(map[string]*ec2sdk.SecurityGroup),
+		instances:                make(map[string]*ec2sdk.Instance),
+		networkInterfaces:        make(map[string]*ec2sdk.NetworkInterface),
+		loadBalancers:            make(map[string]*loadBalancerRecord),
+		listeners:                make(map[string]*listenerRecord),
+		rules:                    make(map[string]*ruleRecord),
+		targetGroups:             make(map[string]*targetGroupRecord),
+		trustStores:              make(map[string]*trustStoreRecord),
+		certificates:             make(map[string]*certificateRecord),
+		webACLs:                  make(map[string]*webACLRecord),
+		webACLByResource:         make(map[string]string),
+		regionalWebACLs:          make(map[string]*webACLRecord),
+		regionalWebACLByResource: make(map[string]string),
+		protections:              make(map[string]*shieldsdk.Protection),
+		buckets:                  make(map[string]map[string][]byte),
+	}
+}
+
+// begin acquires the backend lock for an API call, and returns the injected fault for the operation of service if any.
+// the returned function must be called to release the lock.
+func (b *backend) begin(service string, operation string) (func(), error) {
+	b.mutex.Lock()
+	faultKey := service + ":" + operation
+	if errs := b.faults[faultKey]; len(errs) != 0 {
+		b.faults[faultKey] = errs[1:]
+		return b.mutex.Unlock, errs[0]
+	}
+	return b.mutex.Unlock, nil
+}
+
+// injectError queues errors to be returned by subsequent calls of the operation of service, one error per call.
+func (b *backend) injectError(service string, operation string, errs ...error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	faultKey := service + ":" + operation
+	b.faults[faultKey] = append(b.faults[faultKey], errs...)
+}
+
+// nextID generates an unique hexadecimal identifier with specified length.
+func (b *backend) nextID(length int) string {
+	b.idCounter++
+	return fmt.Sprintf("%0*x", length, b.idCounter)
+}
+
+// NewAPIError constructs an error the same way as the AWS SDK does for a failed API request with error code and message.
+func NewAPIError(code string, message string) error {
+	return awserr.NewRequestFailure(awserr.New(code, message, nil), http.StatusBadRequest, "00000000-0000-0000-0000-000000000000")
+}
+
+// NewThrottlingError constructs an error the same way as AWS does when requests are throttled for service.
+// EC2 uses "RequestLimitExceeded" as error code, while other services use "Throttling".
+func NewThrottlingError(service string) error {
+	if service == ServiceEC2 {
+		return NewAPIError("RequestLimitExceeded", "Request limit exceeded.")
+	}
+	return NewAPIError("Throttling", "Rate exceeded")
+}
+
+func newAPIErrorf(code string, format string, args ...interface{}) error {
+	return NewAPIError(code, fmt.Sprintf(format, args...))
+}
+
+// newNotImplementedError constructs the error returned by APIs that are not modeled.
+func newNotImplementedError(service string, operation string) error {
+	return newAPIErrorf("NotImplemented", "%v:%v is not modeled by fake", service, operation)
+}
+
+// newNotImplementedRequest constructs a request for APIs that are not modeled, which fails with NotImplemented error when sent.
+func newNotImplementedRequest(service string, operation string) *request.Request {
+	req := request.New(awssdk.Config{}, metadata.ClientInfo{ServiceName: service}, request.Handlers{}, nil, &request.Operation{Name: operation}, nil, nil)
+	req.Handlers.Send.PushBack(func(r *request.Request) {
+		r.Error = newNotImplementedError(service, operation)
+	})
+	return req
+}
+
+// copyOf returns a deep copy of AWS sdk object, so that the backend state cannot be mutated by callers.
+func copyOf(obj interface{}) interface{} {
+	// awsutil.CopyOf cannot copy slices directly, so they are copied via a pointer to slice.
+	if value := reflect.ValueOf(obj); value.Kind() == reflect.Slice {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		return reflect.ValueOf(awsutil.CopyOf(ptr.Interface())).Elem().Interface()
+	}
+	return awsutil.CopyOf(obj)
+}
+
+// matchesAnyValue checks whether value matches any of the filter values, which may contain "*" and "?" wildcards.
+func matchesAnyValue(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if pattern == value {
+			return true
+		}
+		if strings.ContainsAny(pattern, "*?") {
+			expr := regexp.QuoteMeta(pattern)
+			expr = strings.ReplaceAll(expr, `\*`, ".*")
+			expr = strings.ReplaceAll(expr, `\?`, ".")
+			if regexp.MustCompile("^" + expr + "$").MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// arnResourceType returns the resource type in form of "service:resource-type" for ARN.
+func arnResourceType(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 {
+		return ""
+	}
+	resource := parts[5]
+	if idx := strings.IndexAny(resource, "/:"); idx != -1 {
+		resource = resource[:idx]
+	}
+	return parts[2] + ":" + resource
+}
+
+func stringValueOrDefault(value *string, defaultValue string) string {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
+
+func int64ValueOrDefault(value *int64, defaultValue int64) int64 {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
+
+func boolValueOrDefault(value *bool, defaultValue bool) bool {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
@@ -0,0 +1,144 @@
+// Package fake provides an in-memory implementation of aws.Cloud for hermetic tests.
+//
+// Unlike the gomock based mocks under pkg/aws/services, the fake services share a single backend that models the
+// AWS resources together with the validations AWS performs on them (e.g. a target group cannot be deleted while
+// it's used by a listener), so that controller logic can be exercised end-to-end without network access.
+// APIs that are not used by the controller are not modeled, and return NotImplemented error when invoked.
+package fake
+
+//go:generate go run gen_unimplemented.go
+
+import (
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// the service names used for fault injection, which matches the IAM service prefixes.
+const (
+	ServiceEC2         = "ec2"
+	ServiceELBV2       = "elasticloadbalancing"
+	ServiceACM         = "acm"
+	ServiceWAFv2       = "wafv2"
+	ServiceWAFRegional = "waf-regional"
+	ServiceShield      = "shield"
+	ServiceRGT         = "tag"
+	ServiceS3          = "s3"
+	ServiceCloudWatch  = "cloudwatch"
+)
+
+const (
+	// the CIDR block of the VPC for kubernetes cluster.
+	defaultVPCCIDRBlock = "192.168.0.0/16"
+	// the number of availability zones in region.
+	defaultAvailabilityZoneCount = 3
+)
+
+var _ aws.Cloud = &Cloud{}
+
+// Cloud is an in-memory implementation of aws.Cloud.
+type Cloud struct {
+	backend *backend
+	vpcID   string
+}
+
+// NewCloud constructs new fake Cloud for region.
+// The region is seeded with three availability zones, and a VPC for the kubernetes cluster without any subnets.
+func NewCloud(region string) *Cloud {
+	b := newBackend(region)
+	for idx := 0; idx < defaultAvailabilityZoneCount; idx++ {
+		b.availabilityZones = append(b.availabilityZones, &ec2sdk.AvailabilityZone{
+			RegionName: awssdk.String(region),
+			ZoneName:   awssdk.String(fmt.Sprintf("%v%c", region, 'a'+idx)),
+			ZoneId:     awssdk.String(fmt.Sprintf("%v-az%d", region, idx+1)),
+			ZoneType:   awssdk.String("availability-zone"),
+			State:      awssdk.String(ec2sdk.AvailabilityZoneStateAvailable),
+		})
+	}
+	vpc, err := b.createVPC(defaultVPCCIDRBlock, nil)
+	if err != nil {
+		panic(err)
+	}
+	return &Cloud{
+		backend: b,
+		vpcID:   awssdk.StringValue(vpc.VpcId),
+	}
+}
+
+func (c *Cloud) EC2() services.EC2 {
+	return &fakeEC2{backend: c.backend}
+}
+
+func (c *Cloud) ELBV2() services.ELBV2 {
+	return &fakeELBV2{backend: c.backend}
+}
+
+func (c *Cloud) ACM() services.ACM {
+	return &fakeACM{backend: c.backend}
+}
+
+func (c *Cloud) WAFv2() services.WAFv2 {
+	return &fakeWAFv2{backend: c.backend}
+}
+
+func (c *Cloud) WAFRegional() services.WAFRegional {
+	return &fakeWAFRegional{backend: c.backend}
+}
+
+func (c *Cloud) Shield() services.Shield {
+	return &fakeShield{backend: c.backend}
+}
+
+func (c *Cloud) RGT() services.RGT {
+	return &fakeRGT{backend: c.backend}
+}
+
+func (c *Cloud) CloudWatch() services.CloudWatch {
+	return &fakeCloudWatch{backend: c.backend}
+}
+
+func (c *Cloud) S3() services.S3 {
+	return &fakeS3{backend: c.backend}
+}
+
+func (c *Cloud) Region() string {
+	return c.backend.region
+}
+
+func (c *Cloud) VpcID() string {
+	return c.vpcID
+}
+
+// AccountID returns the AWS account ID owning all resources.
+func (c *Cloud) AccountID() string {
+	return c.backend.accountID
+}
+
+// InjectError queues errors to be returned by subsequent calls of operation of service, one error per call.
+// service is one of the Service* constants, and operation is the API name without suffix(e.g. "CreateLoadBalancer").
+// Both the WithContext and AsList variants of operation consumes the injected errors.
+func (c *Cloud) InjectError(service string, operation string, errs ...error) {
+	c.backend.injectError(service, operation, errs...)
+}
+
+// SetTargetHealth overrides the health of target registered to target group, which are healthy by default.
+// The override is ignored while the target group isn't used by any load balancer.
+func (c *Cloud) SetTargetHealth(tgARN string, targetID string, port int64, health *elbv2sdk.TargetHealth) error {
+	c.backend.mutex.Lock()
+	defer c.backend.mutex.Unlock()
+	record, err := c.backend.findTargetGroup(tgARN)
+	if err != nil {
+		return err
+	}
+	idx := findTarget(record.targets, &elbv2sdk.TargetDescription{Id: awssdk.String(targetID), Port: awssdk.Int64(port)})
+	if idx == -1 {
+		return errors.Errorf("target %v:%v is not registered to targetGroup %v", targetID, port, tgARN)
+	}
+	record.targets[idx].health = copyOf(health).(*elbv2sdk.TargetHealth)
+	return nil
+}
@@ -0,0 +1,118 @@
+package fake
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	cloudwatchsdk "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+type metricDatumRecord struct {
+	namespace string
+	datum     *cloudwatchsdk.MetricDatum
+}
+
+var _ services.CloudWatch = &fakeCloudWatch{}
+
+// fakeCloudWatch is an in-memory implementation of services.CloudWatch.
+type fakeCloudWatch struct {
+	unimplementedCloudWatch
+	backend *backend
+}
+
+func (c *fakeCloudWatch) PutMetricDataWithContext(_ context.Context, input *cloudwatchsdk.PutMetricDataInput, _ ...request.Option) (*cloudwatchsdk.PutMetricDataOutput, error) {
+	release, err := c.backend.begin(ServiceCloudWatch, "PutMetricData")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	for _, datum := range input.MetricData {
+		datum = copyOf(datum).(*cloudwatchsdk.MetricDatum)
+		if datum.Timestamp == nil {
+			datum.Timestamp = awssdk.Time(time.Now())
+		}
+		c.backend.metricData = append(c.backend.metricData, &metricDatumRecord{
+			namespace: awssdk.StringValue(input.Namespace),
+			datum:     datum,
+		})
+	}
+	return &cloudwatchsdk.PutMetricDataOutput{}, nil
+}
+
+func (c *fakeCloudWatch) GetMetricDataWithContext(_ context.Context, input *cloudwatchsdk.GetMetricDataInput, _ ...request.Option) (*cloudwatchsdk.GetMetricDataOutput, error) {
+	release, err := c.backend.begin(ServiceCloudWatch, "GetMetricData")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.getMetricData(input), nil
+}
+
+func (c *fakeCloudWatch) GetMetricDataPagesWithContext(_ context.Context, input *cloudwatchsdk.GetMetricDataInput, fn func(*cloudwatchsdk.GetMetricDataOutput, bool) bool, _ ...request.Option) error {
+	release, err := c.backend.begin(ServiceCloudWatch, "GetMetricData")
+	if err != nil {
+		release()
+		return err
+	}
+	output := c.backend.getMetricData(input)
+	// the callback is invoked without lock, so that it can call other APIs.
+	release()
+	fn(output, true)
+	return nil
+}
+
+// getMetricData aggregates the metric data put within time range for each query.
+// each matching datapoint is returned as a separate value, regardless of the period of query.
+func (b *backend) getMetricData(input *cloudwatchsdk.GetMetricDataInput) *cloudwatchsdk.GetMetricDataOutput {
+	startTime := awssdk.TimeValue(input.StartTime)
+	endTime := awssdk.TimeValue(input.EndTime)
+	var results []*cloudwatchsdk.MetricDataResult
+	for _, query := range input.MetricDataQueries {
+		result := &cloudwatchsdk.MetricDataResult{
+			Id:         query.Id,
+			Label:      query.Label,
+			StatusCode: awssdk.String(cloudwatchsdk.StatusCodeComplete),
+			Timestamps: []*time.Time{},
+			Values:     []*float64{},
+		}
+		if query.MetricStat != nil && query.MetricStat.Metric != nil {
+			for _, record := range b.metricData {
+				timestamp := awssdk.TimeValue(record.datum.Timestamp)
+				if timestamp.Before(startTime) || !timestamp.Before(endTime) {
+					continue
+				}
+				if !matchesMetric(query.MetricStat.Metric, record) {
+					continue
+				}
+				result.Timestamps = append(result.Timestamps, awssdk.Time(timestamp))
+				result.Values = append(result.Values, awssdk.Float64(awssdk.Float64Value(record.datum.Value)))
+			}
+		}
+		results = append(results, result)
+	}
+	return &cloudwatchsdk.GetMetricDataOutput{MetricDataResults: results}
+}
+
+// matchesMetric checks whether metric datum is for metric, all dimensions must match.
+func matchesMetric(metric *cloudwatchsdk.Metric, record *metricDatumRecord) bool {
+	if awssdk.StringValue(metric.Namespace) != record.namespace ||
+		awssdk.StringValue(metric.MetricName) != awssdk.StringValue(record.datum.MetricName) {
+		return false
+	}
+	if len(metric.Dimensions) != len(record.datum.Dimensions) {
+		return false
+	}
+	dimensions := make(map[string]string, len(record.datum.Dimensions))
+	for _, dimension := range record.datum.Dimensions {
+		dimensions[awssdk.StringValue(dimension.Name)] = awssdk.StringValue(dimension.Value)
+	}
+	for _, dimension := range metric.Dimensions {
+		if value, ok := dimensions[awssdk.StringValue(dimension.Name)]; !ok || value != awssdk.StringValue(dimension.Value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,947 @@
+package fake
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// subnetRecord tracks a subnet along with the private IP addresses allocated from it.
+type subnetRecord struct {
+	subnet *ec2sdk.Subnet
+	ipNet  *net.IPNet
+	// number of private IP addresses allocated from subnet.
+	allocatedIPCount int64
+}
+
+var _ services.EC2 = &fakeEC2{}
+
+// fakeEC2 is an in-memory implementation of services.EC2.
+type fakeEC2 struct {
+	unimplementedEC2
+	backend *backend
+}
+
+func (c *fakeEC2) CreateVpcWithContext(_ context.Context, input *ec2sdk.CreateVpcInput, _ ...request.Option) (*ec2sdk.CreateVpcOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "CreateVpc")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	vpc, err := c.backend.createVPC(awssdk.StringValue(input.CidrBlock), input.TagSpecifications)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2sdk.CreateVpcOutput{Vpc: copyOf(vpc).(*ec2sdk.Vpc)}, nil
+}
+
+func (c *fakeEC2) DescribeVpcsWithContext(_ context.Context, input *ec2sdk.DescribeVpcsInput, _ ...request.Option) (*ec2sdk.DescribeVpcsOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeVpcs")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	var vpcs []*ec2sdk.Vpc
+	for _, vpcID := range awssdk.StringValueSlice(input.VpcIds) {
+		if _, ok := c.backend.vpcs[vpcID]; !ok {
+			return nil, newAPIErrorf("InvalidVpcID.NotFound", "The vpc ID '%v' does not exist", vpcID)
+		}
+	}
+	for _, vpcID := range sets.StringKeySet(c.backend.vpcs).List() {
+		vpc := c.backend.vpcs[vpcID]
+		if len(input.VpcIds) != 0 && !sets.NewString(awssdk.StringValueSlice(input.VpcIds)...).Has(vpcID) {
+			continue
+		}
+		matches, err := matchesEC2Filters(input.Filters, vpc.Tags, map[string][]string{
+			"vpc-id":     {vpcID},
+			"cidr-block": {awssdk.StringValue(vpc.CidrBlock)},
+			"state":      {awssdk.StringValue(vpc.State)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			vpcs = append(vpcs, copyOf(vpc).(*ec2sdk.Vpc))
+		}
+	}
+	return &ec2sdk.DescribeVpcsOutput{Vpcs: vpcs}, nil
+}
+
+func (c *fakeEC2) DescribeAvailabilityZonesWithContext(_ context.Context, input *ec2sdk.DescribeAvailabilityZonesInput, _ ...request.Option) (*ec2sdk.DescribeAvailabilityZonesOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeAvailabilityZones")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	zoneIDs := sets.NewString(awssdk.StringValueSlice(input.ZoneIds)...)
+	zoneNames := sets.NewString(awssdk.StringValueSlice(input.ZoneNames)...)
+	unknownZoneIDs := sets.NewString(zoneIDs.List()...)
+	unknownZoneNames := sets.NewString(zoneNames.List()...)
+	var azs []*ec2sdk.AvailabilityZone
+	for _, az := range c.backend.availabilityZones {
+		if len(zoneIDs) != 0 && !zoneIDs.Has(awssdk.StringValue(az.ZoneId)) {
+			continue
+		}
+		if len(zoneNames) != 0 && !zoneNames.Has(awssdk.StringValue(az.ZoneName)) {
+			continue
+		}
+		unknownZoneIDs.Delete(awssdk.StringValue(az.ZoneId))
+		unknownZoneNames.Delete(awssdk.StringValue(az.ZoneName))
+		matches, err := matchesEC2Filters(input.Filters, nil, map[string][]string{
+			"zone-id":   {awssdk.StringValue(az.ZoneId)},
+			"zone-name": {awssdk.StringValue(az.ZoneName)},
+			"zone-type": {awssdk.StringValue(az.ZoneType)},
+			"state":     {awssdk.StringValue(az.State)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			azs = append(azs, copyOf(az).(*ec2sdk.AvailabilityZone))
+		}
+	}
+	if len(unknownZoneIDs) != 0 {
+		return nil, newAPIErrorf("InvalidParameterValue", "The zone id '%v' does not exist", unknownZoneIDs.List()[0])
+	}
+	if len(unknownZoneNames) != 0 {
+		return nil, newAPIErrorf("InvalidParameterValue", "The zone name '%v' does not exist", unknownZoneNames.List()[0])
+	}
+	return &ec2sdk.DescribeAvailabilityZonesOutput{AvailabilityZones: azs}, nil
+}
+
+func (c *fakeEC2) CreateSubnetWithContext(_ context.Context, input *ec2sdk.CreateSubnetInput, _ ...request.Option) (*ec2sdk.CreateSubnetOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "CreateSubnet")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	vpcID := awssdk.StringValue(input.VpcId)
+	vpc, ok := c.backend.vpcs[vpcID]
+	if !ok {
+		return nil, newAPIErrorf("InvalidVpcID.NotFound", "The vpc ID '%v' does not exist", vpcID)
+	}
+	az := c.backend.findAvailabilityZone(awssdk.StringValue(input.AvailabilityZone), awssdk.StringValue(input.AvailabilityZoneId))
+	if az == nil {
+		return nil, newAPIErrorf("InvalidParameterValue", "Value (%v) for parameter availabilityZone is invalid.", awssdk.StringValue(input.AvailabilityZone))
+	}
+	cidrBlock := awssdk.StringValue(input.CidrBlock)
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, newAPIErrorf("InvalidParameterValue", "Value (%v) for parameter cidrBlock is invalid. This is not a valid CIDR block.", cidrBlock)
+	}
+	_, vpcIPNet, _ := net.ParseCIDR(awssdk.StringValue(vpc.CidrBlock))
+	if !vpcIPNet.Contains(ipNet.IP) || !vpcIPNet.Contains(lastIP(ipNet)) {
+		return nil, newAPIErrorf("InvalidSubnet.Range", "The CIDR '%v' is invalid.", cidrBlock)
+	}
+	for _, record := range c.backend.subnets {
+		if awssdk.StringValue(record.subnet.VpcId) == vpcID && (record.ipNet.Contains(ipNet.IP) || ipNet.Contains(record.ipNet.IP)) {
+			return nil, newAPIErrorf("InvalidSubnet.Conflict", "The CIDR '%v' conflicts with another subnet", cidrBlock)
+		}
+	}
+	ones, bits := ipNet.Mask.Size()
+	subnetID := "subnet-" + c.backend.nextID(17)
+	subnet := &ec2sdk.Subnet{
+		SubnetId:                awssdk.String(subnetID),
+		SubnetArn:               awssdk.String(c.backend.ec2ARN("subnet", subnetID)),
+		VpcId:                   awssdk.String(vpcID),
+		CidrBlock:               awssdk.String(ipNet.String()),
+		AvailabilityZone:        az.ZoneName,
+		AvailabilityZoneId:      az.ZoneId,
+		AvailableIpAddressCount: awssdk.Int64(int64(1)<<uint(bits-ones) - 5),
+		OwnerId:                 awssdk.String(c.backend.accountID),
+		State:                   awssdk.String(ec2sdk.SubnetStateAvailable),
+		Tags:                    tagsFromTagSpecifications(input.TagSpecifications, ec2sdk.ResourceTypeSubnet),
+	}
+	c.backend.subnets[subnetID] = &subnetRecord{subnet: subnet, ipNet: ipNet}
+	return &ec2sdk.CreateSubnetOutput{Subnet: copyOf(subnet).(*ec2sdk.Subnet)}, nil
+}
+
+func (c *fakeEC2) DescribeSubnetsWithContext(_ context.Context, input *ec2sdk.DescribeSubnetsInput, _ ...request.Option) (*ec2sdk.DescribeSubnetsOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeSubnets")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := c.backend.describeSubnets(input)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2sdk.DescribeSubnetsOutput{Subnets: subnets}, nil
+}
+
+func (c *fakeEC2) DescribeSubnetsAsList(_ context.Context, input *ec2sdk.DescribeSubnetsInput) ([]*ec2sdk.Subnet, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeSubnets")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeSubnets(input)
+}
+
+func (c *fakeEC2) CreateSecurityGroupWithContext(_ context.Context, input *ec2sdk.CreateSecurityGroupInput, _ ...request.Option) (*ec2sdk.CreateSecurityGroupOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "CreateSecurityGroup")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	vpcID := awssdk.StringValue(input.VpcId)
+	if _, ok := c.backend.vpcs[vpcID]; !ok {
+		return nil, newAPIErrorf("InvalidVpcID.NotFound", "The vpc ID '%v' does not exist", vpcID)
+	}
+	groupName := awssdk.StringValue(input.GroupName)
+	if len(groupName) == 0 || len(awssdk.StringValue(input.Description)) == 0 {
+		return nil, NewAPIError("MissingParameter", "The request must contain the parameters groupName and groupDescription")
+	}
+	for _, sg := range c.backend.securityGroups {
+		if awssdk.StringValue(sg.VpcId) == vpcID && awssdk.StringValue(sg.GroupName) == groupName {
+			return nil, newAPIErrorf("InvalidGroup.Duplicate", "The security group '%v' already exists for VPC '%v'", groupName, vpcID)
+		}
+	}
+	groupID := "sg-" + c.backend.nextID(17)
+	c.backend.securityGroups[groupID] = &ec2sdk.SecurityGroup{
+		GroupId:     awssdk.String(groupID),
+		GroupName:   awssdk.String(groupName),
+		Description: input.Description,
+		VpcId:       awssdk.String(vpcID),
+		OwnerId:     awssdk.String(c.backend.accountID),
+		IpPermissionsEgress: []*ec2sdk.IpPermission{
+			{
+				IpProtocol: awssdk.String("-1"),
+				IpRanges:   []*ec2sdk.IpRange{{CidrIp: awssdk.String("0.0.0.0/0")}},
+			},
+		},
+		Tags: tagsFromTagSpecifications(input.TagSpecifications, ec2sdk.ResourceTypeSecurityGroup),
+	}
+	return &ec2sdk.CreateSecurityGroupOutput{GroupId: awssdk.String(groupID)}, nil
+}
+
+func (c *fakeEC2) DeleteSecurityGroupWithContext(_ context.Context, input *ec2sdk.DeleteSecurityGroupInput, _ ...request.Option) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DeleteSecurityGroup")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	groupID := awssdk.StringValue(input.GroupId)
+	if _, ok := c.backend.securityGroups[groupID]; !ok {
+		return nil, newAPIErrorf("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+	}
+	if c.backend.isSecurityGroupInUse(groupID) {
+		return nil, newAPIErrorf("DependencyViolation", "resource %v has a dependent object", groupID)
+	}
+	delete(c.backend.securityGroups, groupID)
+	return &ec2sdk.DeleteSecurityGroupOutput{}, nil
+}
+
+func (c *fakeEC2) DescribeSecurityGroupsWithContext(_ context.Context, input *ec2sdk.DescribeSecurityGroupsInput, _ ...request.Option) (*ec2sdk.DescribeSecurityGroupsOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeSecurityGroups")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	sgs, err := c.backend.describeSecurityGroups(input)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2sdk.DescribeSecurityGroupsOutput{SecurityGroups: sgs}, nil
+}
+
+func (c *fakeEC2) DescribeSecurityGroupsAsList(_ context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]*ec2sdk.SecurityGroup, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeSecurityGroups")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeSecurityGroups(input)
+}
+
+func (c *fakeEC2) AuthorizeSecurityGroupIngressWithContext(_ context.Context, input *ec2sdk.AuthorizeSecurityGroupIngressInput, _ ...request.Option) (*ec2sdk.AuthorizeSecurityGroupIngressOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "AuthorizeSecurityGroupIngress")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	groupID := awssdk.StringValue(input.GroupId)
+	sg, ok := c.backend.securityGroups[groupID]
+	if !ok {
+		return nil, newAPIErrorf("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+	}
+	var newPermissions []*ec2sdk.IpPermission
+	for _, permission := range expandIPPermissions(input.IpPermissions) {
+		for _, pair := range permission.UserIdGroupPairs {
+			if _, ok := c.backend.securityGroups[awssdk.StringValue(pair.GroupId)]; !ok {
+				return nil, newAPIErrorf("InvalidGroup.NotFound", "The security group '%v' does not exist", awssdk.StringValue(pair.GroupId))
+			}
+		}
+		if findIPPermission(append(sg.IpPermissions, newPermissions...), permission) != -1 {
+			return nil, newAPIErrorf("InvalidPermission.Duplicate", "the specified rule %v already exists", ipPermissionKey(permission))
+		}
+		newPermissions = append(newPermissions, permission)
+	}
+	sg.IpPermissions = append(sg.IpPermissions, newPermissions...)
+	return &ec2sdk.AuthorizeSecurityGroupIngressOutput{Return: awssdk.Bool(true)}, nil
+}
+
+func (c *fakeEC2) RevokeSecurityGroupIngressWithContext(_ context.Context, input *ec2sdk.RevokeSecurityGroupIngressInput, _ ...request.Option) (*ec2sdk.RevokeSecurityGroupIngressOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "RevokeSecurityGroupIngress")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	groupID := awssdk.StringValue(input.GroupId)
+	sg, ok := c.backend.securityGroups[groupID]
+	if !ok {
+		return nil, newAPIErrorf("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+	}
+	permissions := append([]*ec2sdk.IpPermission(nil), sg.IpPermissions...)
+	for _, permission := range expandIPPermissions(input.IpPermissions) {
+		idx := findIPPermission(permissions, permission)
+		if idx == -1 {
+			return nil, newAPIErrorf("InvalidPermission.NotFound", "The specified rule does not exist in this security group.")
+		}
+		permissions = append(permissions[:idx], permissions[idx+1:]...)
+	}
+	sg.IpPermissions = permissions
+	return &ec2sdk.RevokeSecurityGroupIngressOutput{Return: awssdk.Bool(true)}, nil
+}
+
+func (c *fakeEC2) RunInstancesWithContext(_ context.Context, input *ec2sdk.RunInstancesInput, _ ...request.Option) (*ec2sdk.Reservation, error) {
+	release, err := c.backend.begin(ServiceEC2, "RunInstances")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	count := awssdk.Int64Value(input.MinCount)
+	if count < 1 {
+		count = 1
+	}
+	if count > 1 && input.PrivateIpAddress != nil {
+		return nil, NewAPIError("InvalidParameterCombination", "Cannot specify a private IP address when launching more than one instance")
+	}
+	reservation := &ec2sdk.Reservation{
+		ReservationId: awssdk.String("r-" + c.backend.nextID(17)),
+		OwnerId:       awssdk.String(c.backend.accountID),
+	}
+	for i := int64(0); i < count; i++ {
+		instanceID := "i-" + c.backend.nextID(17)
+		eni, err := c.backend.createNetworkInterface(awssdk.StringValue(input.SubnetId), awssdk.StringValue(input.PrivateIpAddress),
+			awssdk.StringValueSlice(input.SecurityGroupIds), "Primary network interface", nil)
+		if err != nil {
+			return nil, err
+		}
+		eni.Attachment = &ec2sdk.NetworkInterfaceAttachment{
+			AttachmentId: awssdk.String("eni-attach-" + c.backend.nextID(17)),
+			DeviceIndex:  awssdk.Int64(0),
+			InstanceId:   awssdk.String(instanceID),
+			Status:       awssdk.String(ec2sdk.AttachmentStatusAttached),
+		}
+		eni.Status = awssdk.String(ec2sdk.NetworkInterfaceStatusInUse)
+		instance := &ec2sdk.Instance{
+			InstanceId:       awssdk.String(instanceID),
+			InstanceType:     input.InstanceType,
+			ImageId:          input.ImageId,
+			LaunchTime:       awssdk.Time(time.Now()),
+			SubnetId:         eni.SubnetId,
+			VpcId:            eni.VpcId,
+			PrivateIpAddress: eni.PrivateIpAddress,
+			Placement:        &ec2sdk.Placement{AvailabilityZone: eni.AvailabilityZone},
+			State:            &ec2sdk.InstanceState{Code: awssdk.Int64(16), Name: awssdk.String(ec2sdk.InstanceStateNameRunning)},
+			Tags:             tagsFromTagSpecifications(input.TagSpecifications, ec2sdk.ResourceTypeInstance),
+		}
+		c.backend.instances[instanceID] = instance
+		reservation.Instances = append(reservation.Instances, c.backend.buildInstance(instance))
+	}
+	return reservation, nil
+}
+
+func (c *fakeEC2) DescribeInstancesWithContext(_ context.Context, input *ec2sdk.DescribeInstancesInput, _ ...request.Option) (*ec2sdk.DescribeInstancesOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeInstances")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := c.backend.describeInstances(input)
+	if err != nil {
+		return nil, err
+	}
+	var reservations []*ec2sdk.Reservation
+	for _, instance := range instances {
+		reservations = append(reservations, &ec2sdk.Reservation{
+			OwnerId:   awssdk.String(c.backend.accountID),
+			Instances: []*ec2sdk.Instance{instance},
+		})
+	}
+	return &ec2sdk.DescribeInstancesOutput{Reservations: reservations}, nil
+}
+
+func (c *fakeEC2) DescribeInstancesAsList(_ context.Context, input *ec2sdk.DescribeInstancesInput) ([]*ec2sdk.Instance, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeInstances")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeInstances(input)
+}
+
+func (c *fakeEC2) CreateNetworkInterfaceWithContext(_ context.Context, input *ec2sdk.CreateNetworkInterfaceInput, _ ...request.Option) (*ec2sdk.CreateNetworkInterfaceOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "CreateNetworkInterface")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	eni, err := c.backend.createNetworkInterface(awssdk.StringValue(input.SubnetId), awssdk.StringValue(input.PrivateIpAddress),
+		awssdk.StringValueSlice(input.Groups), awssdk.StringValue(input.Description), input.TagSpecifications)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2sdk.CreateNetworkInterfaceOutput{NetworkInterface: copyOf(eni).(*ec2sdk.NetworkInterface)}, nil
+}
+
+func (c *fakeEC2) DescribeNetworkInterfacesWithContext(_ context.Context, input *ec2sdk.DescribeNetworkInterfacesInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInterfacesOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeNetworkInterfaces")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	enis, err := c.backend.describeNetworkInterfaces(input)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2sdk.DescribeNetworkInterfacesOutput{NetworkInterfaces: enis}, nil
+}
+
+func (c *fakeEC2) DescribeNetworkInterfacesAsList(_ context.Context, input *ec2sdk.DescribeNetworkInterfacesInput) ([]*ec2sdk.NetworkInterface, error) {
+	release, err := c.backend.begin(ServiceEC2, "DescribeNetworkInterfaces")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeNetworkInterfaces(input)
+}
+
+func (c *fakeEC2) CreateTagsWithContext(_ context.Context, input *ec2sdk.CreateTagsInput, _ ...request.Option) (*ec2sdk.CreateTagsOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "CreateTags")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	for _, resID := range awssdk.StringValueSlice(input.Resources) {
+		tags, err := c.backend.ec2ResourceTags(resID)
+		if err != nil {
+			return nil, err
+		}
+		tagMap := ec2TagsAsMap(*tags)
+		for _, tag := range input.Tags {
+			tagMap[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		}
+		*tags = ec2TagsFromMap(tagMap)
+	}
+	return &ec2sdk.CreateTagsOutput{}, nil
+}
+
+func (c *fakeEC2) DeleteTagsWithContext(_ context.Context, input *ec2sdk.DeleteTagsInput, _ ...request.Option) (*ec2sdk.DeleteTagsOutput, error) {
+	release, err := c.backend.begin(ServiceEC2, "DeleteTags")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	for _, resID := range awssdk.StringValueSlice(input.Resources) {
+		tags, err := c.backend.ec2ResourceTags(resID)
+		if err != nil {
+			return nil, err
+		}
+		tagMap := ec2TagsAsMap(*tags)
+		for _, tag := range input.Tags {
+			tagKey := awssdk.StringValue(tag.Key)
+			// when tag value is specified, the tag is only deleted if value matches.
+			if tag.Value != nil && tagMap[tagKey] != awssdk.StringValue(tag.Value) {
+				continue
+			}
+			delete(tagMap, tagKey)
+		}
+		*tags = ec2TagsFromMap(tagMap)
+	}
+	return &ec2sdk.DeleteTagsOutput{}, nil
+}
+
+// createVPC creates a new VPC with cidrBlock.
+func (b *backend) createVPC(cidrBlock string, tagSpecs []*ec2sdk.TagSpecification) (*ec2sdk.Vpc, error) {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, newAPIErrorf("InvalidParameterValue", "Value (%v) for parameter cidrBlock is invalid. This is not a valid CIDR block.", cidrBlock)
+	}
+	vpcID := "vpc-" + b.nextID(17)
+	vpc := &ec2sdk.Vpc{
+		VpcId:     awssdk.String(vpcID),
+		CidrBlock: awssdk.String(ipNet.String()),
+		CidrBlockAssociationSet: []*ec2sdk.VpcCidrBlockAssociation{
+			{
+				AssociationId:  awssdk.String("vpc-cidr-assoc-" + b.nextID(17)),
+				CidrBlock:      awssdk.String(ipNet.String()),
+				CidrBlockState: &ec2sdk.VpcCidrBlockState{State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated)},
+			},
+		},
+		OwnerId: awssdk.String(b.accountID),
+		State:   awssdk.String(ec2sdk.VpcStateAvailable),
+		Tags:    tagsFromTagSpecifications(tagSpecs, ec2sdk.ResourceTypeVpc),
+	}
+	b.vpcs[vpcID] = vpc
+	return vpc, nil
+}
+
+// findAvailabilityZone finds availabilityZone by name or ID.
+func (b *backend) findAvailabilityZone(zoneName string, zoneID string) *ec2sdk.AvailabilityZone {
+	for _, az := range b.availabilityZones {
+		if (len(zoneName) != 0 && awssdk.StringValue(az.ZoneName) == zoneName) ||
+			(len(zoneID) != 0 && awssdk.StringValue(az.ZoneId) == zoneID) {
+			return az
+		}
+	}
+	return nil
+}
+
+func (b *backend) describeSubnets(input *ec2sdk.DescribeSubnetsInput) ([]*ec2sdk.Subnet, error) {
+	subnetIDs := sets.NewString(awssdk.StringValueSlice(input.SubnetIds)...)
+	for _, subnetID := range subnetIDs.List() {
+		if _, ok := b.subnets[subnetID]; !ok {
+			return nil, newAPIErrorf("InvalidSubnetID.NotFound", "The subnet ID '%v' does not exist", subnetID)
+		}
+	}
+	var subnets []*ec2sdk.Subnet
+	for _, subnetID := range sets.StringKeySet(b.subnets).List() {
+		if len(subnetIDs) != 0 && !subnetIDs.Has(subnetID) {
+			continue
+		}
+		subnet := b.subnets[subnetID].subnet
+		matches, err := matchesEC2Filters(input.Filters, subnet.Tags, map[string][]string{
+			"subnet-id":            {subnetID},
+			"vpc-id":               {awssdk.StringValue(subnet.VpcId)},
+			"cidr-block":           {awssdk.StringValue(subnet.CidrBlock)},
+			"availability-zone":    {awssdk.StringValue(subnet.AvailabilityZone)},
+			"availability-zone-id": {awssdk.StringValue(subnet.AvailabilityZoneId)},
+			"state":                {awssdk.StringValue(subnet.State)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			subnets = append(subnets, copyOf(subnet).(*ec2sdk.Subnet))
+		}
+	}
+	return subnets, nil
+}
+
+func (b *backend) describeSecurityGroups(input *ec2sdk.DescribeSecurityGroupsInput) ([]*ec2sdk.SecurityGroup, error) {
+	groupIDs := sets.NewString(awssdk.StringValueSlice(input.GroupIds)...)
+	for _, groupID := range groupIDs.List() {
+		if _, ok := b.securityGroups[groupID]; !ok {
+			return nil, newAPIErrorf("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+		}
+	}
+	var sgs []*ec2sdk.SecurityGroup
+	for _, groupID := range sets.StringKeySet(b.securityGroups).List() {
+		if len(groupIDs) != 0 && !groupIDs.Has(groupID) {
+			continue
+		}
+		sg := b.securityGroups[groupID]
+		matches, err := matchesEC2Filters(input.Filters, sg.Tags, map[string][]string{
+			"group-id":   {groupID},
+			"group-name": {awssdk.StringValue(sg.GroupName)},
+			"vpc-id":     {awssdk.StringValue(sg.VpcId)},
+			"owner-id":   {awssdk.StringValue(sg.OwnerId)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			sgs = append(sgs, copyOf(sg).(*ec2sdk.SecurityGroup))
+		}
+	}
+	return sgs, nil
+}
+
+// isSecurityGroupInUse checks whether securityGroup is referenced by other resources.
+func (b *backend) isSecurityGroupInUse(groupID string) bool {
+	for _, lb := range b.loadBalancers {
+		if sets.NewString(awssdk.StringValueSlice(lb.lb.SecurityGroups)...).Has(groupID) {
+			return true
+		}
+	}
+	for _, eni := range b.networkInterfaces {
+		for _, group := range eni.Groups {
+			if awssdk.StringValue(group.GroupId) == groupID {
+				return true
+			}
+		}
+	}
+	for otherGroupID, sg := range b.securityGroups {
+		if otherGroupID == groupID {
+			continue
+		}
+		for _, permission := range sg.IpPermissions {
+			for _, pair := range permission.UserIdGroupPairs {
+				if awssdk.StringValue(pair.GroupId) == groupID {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// createNetworkInterface creates a new network interface in subnet.
+// a private IP address is allocated from subnet if privateIP is empty.
+func (b *backend) createNetworkInterface(subnetID string, privateIP string, groupIDs []string, description string, tagSpecs []*ec2sdk.TagSpecification) (*ec2sdk.NetworkInterface, error) {
+	record, ok := b.subnets[subnetID]
+	if !ok {
+		return nil, newAPIErrorf("InvalidSubnetID.NotFound", "The subnet ID '%v' does not exist", subnetID)
+	}
+	var groups []*ec2sdk.GroupIdentifier
+	for _, groupID := range groupIDs {
+		sg, ok := b.securityGroups[groupID]
+		if !ok {
+			return nil, newAPIErrorf("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+		}
+		groups = append(groups, &ec2sdk.GroupIdentifier{GroupId: sg.GroupId, GroupName: sg.GroupName})
+	}
+	if len(privateIP) == 0 {
+		record.allocatedIPCount++
+		// the first four IP addresses of subnet are reserved by AWS.
+		base := binary.BigEndian.Uint32(record.ipNet.IP.To4())
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, base+3+uint32(record.allocatedIPCount))
+		privateIP = ip.String()
+	} else if ip := net.ParseIP(privateIP); ip == nil || !record.ipNet.Contains(ip) {
+		return nil, newAPIErrorf("InvalidParameterValue", "Address %v does not fall within the subnet's address range", privateIP)
+	}
+	for _, eni := range b.networkInterfaces {
+		if awssdk.StringValue(eni.SubnetId) == subnetID && awssdk.StringValue(eni.PrivateIpAddress) == privateIP {
+			return nil, newAPIErrorf("InvalidIPAddress.InUse", "Address %v is in use.", privateIP)
+		}
+	}
+	record.subnet.AvailableIpAddressCount = awssdk.Int64(awssdk.Int64Value(record.subnet.AvailableIpAddressCount) - 1)
+	eniID := "eni-" + b.nextID(17)
+	eni := &ec2sdk.NetworkInterface{
+		NetworkInterfaceId: awssdk.String(eniID),
+		Description:        awssdk.String(description),
+		SubnetId:           awssdk.String(subnetID),
+		VpcId:              record.subnet.VpcId,
+		AvailabilityZone:   record.subnet.AvailabilityZone,
+		Groups:             groups,
+		InterfaceType:      awssdk.String(ec2sdk.NetworkInterfaceTypeInterface),
+		OwnerId:            awssdk.String(b.accountID),
+		PrivateIpAddress:   awssdk.String(privateIP),
+		PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+			{
+				Primary:          awssdk.Bool(true),
+				PrivateIpAddress: awssdk.String(privateIP),
+			},
+		},
+		Status:     awssdk.String(ec2sdk.NetworkInterfaceStatusAvailable),
+		TagSet:     tagsFromTagSpecifications(tagSpecs, ec2sdk.ResourceTypeNetworkInterface),
+		MacAddress: awssdk.String("02:00:00:00:00:00"),
+	}
+	b.networkInterfaces[eniID] = eni
+	return eni, nil
+}
+
+func (b *backend) describeNetworkInterfaces(input *ec2sdk.DescribeNetworkInterfacesInput) ([]*ec2sdk.NetworkInterface, error) {
+	eniIDs := sets.NewString(awssdk.StringValueSlice(input.NetworkInterfaceIds)...)
+	for _, eniID := range eniIDs.List() {
+		if _, ok := b.networkInterfaces[eniID]; !ok {
+			return nil, newAPIErrorf("InvalidNetworkInterfaceID.NotFound", "The networkInterface ID '%v' does not exist", eniID)
+		}
+	}
+	var enis []*ec2sdk.NetworkInterface
+	for _, eniID := range sets.StringKeySet(b.networkInterfaces).List() {
+		if len(eniIDs) != 0 && !eniIDs.Has(eniID) {
+			continue
+		}
+		eni := b.networkInterfaces[eniID]
+		var privateIPs []string
+		for _, addr := range eni.PrivateIpAddresses {
+			privateIPs = append(privateIPs, awssdk.StringValue(addr.PrivateIpAddress))
+		}
+		var groupIDs []string
+		for _, group := range eni.Groups {
+			groupIDs = append(groupIDs, awssdk.StringValue(group.GroupId))
+		}
+		attrs := map[string][]string{
+			"network-interface-id":         {eniID},
+			"subnet-id":                    {awssdk.StringValue(eni.SubnetId)},
+			"vpc-id":                       {awssdk.StringValue(eni.VpcId)},
+			"availability-zone":            {awssdk.StringValue(eni.AvailabilityZone)},
+			"private-ip-address":           {awssdk.StringValue(eni.PrivateIpAddress)},
+			"addresses.private-ip-address": privateIPs,
+			"group-id":                     groupIDs,
+			"status":                       {awssdk.StringValue(eni.Status)},
+			"attachment.instance-id":       nil,
+		}
+		if eni.Attachment != nil {
+			attrs["attachment.instance-id"] = []string{awssdk.StringValue(eni.Attachment.InstanceId)}
+		}
+		matches, err := matchesEC2Filters(input.Filters, eni.TagSet, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			enis = append(enis, copyOf(eni).(*ec2sdk.NetworkInterface))
+		}
+	}
+	return enis, nil
+}
+
+func (b *backend) describeInstances(input *ec2sdk.DescribeInstancesInput) ([]*ec2sdk.Instance, error) {
+	instanceIDs := sets.NewString(awssdk.StringValueSlice(input.InstanceIds)...)
+	for _, instanceID := range instanceIDs.List() {
+		if _, ok := b.instances[instanceID]; !ok {
+			return nil, newAPIErrorf("InvalidInstanceID.NotFound", "The instance ID '%v' does not exist", instanceID)
+		}
+	}
+	var instances []*ec2sdk.Instance
+	for _, instanceID := range sets.StringKeySet(b.instances).List() {
+		if len(instanceIDs) != 0 && !instanceIDs.Has(instanceID) {
+			continue
+		}
+		instance := b.instances[instanceID]
+		matches, err := matchesEC2Filters(input.Filters, instance.Tags, map[string][]string{
+			"instance-id":         {instanceID},
+			"subnet-id":           {awssdk.StringValue(instance.SubnetId)},
+			"vpc-id":              {awssdk.StringValue(instance.VpcId)},
+			"private-ip-address":  {awssdk.StringValue(instance.PrivateIpAddress)},
+			"instance-state-name": {awssdk.StringValue(instance.State.Name)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			instances = append(instances, b.buildInstance(instance))
+		}
+	}
+	return instances, nil
+}
+
+// buildInstance builds a copy of instance, with network interfaces and security groups from attached network interfaces.
+func (b *backend) buildInstance(instance *ec2sdk.Instance) *ec2sdk.Instance {
+	instanceCopy := copyOf(instance).(*ec2sdk.Instance)
+	groups := make(map[string]*ec2sdk.GroupIdentifier)
+	for _, eniID := range sets.StringKeySet(b.networkInterfaces).List() {
+		eni := b.networkInterfaces[eniID]
+		if eni.Attachment == nil || awssdk.StringValue(eni.Attachment.InstanceId) != awssdk.StringValue(instance.InstanceId) {
+			continue
+		}
+		var privateIPs []*ec2sdk.InstancePrivateIpAddress
+		for _, addr := range eni.PrivateIpAddresses {
+			privateIPs = append(privateIPs, &ec2sdk.InstancePrivateIpAddress{
+				Primary:          addr.Primary,
+				PrivateIpAddress: addr.PrivateIpAddress,
+			})
+		}
+		instanceCopy.NetworkInterfaces = append(instanceCopy.NetworkInterfaces, &ec2sdk.InstanceNetworkInterface{
+			NetworkInterfaceId: eni.NetworkInterfaceId,
+			SubnetId:           eni.SubnetId,
+			VpcId:              eni.VpcId,
+			PrivateIpAddress:   eni.PrivateIpAddress,
+			PrivateIpAddresses: privateIPs,
+			Groups:             copyOf(eni.Groups).([]*ec2sdk.GroupIdentifier),
+			Status:             eni.Status,
+			Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
+				AttachmentId: eni.Attachment.AttachmentId,
+				DeviceIndex:  eni.Attachment.DeviceIndex,
+				Status:       eni.Attachment.Status,
+			},
+		})
+		for _, group := range eni.Groups {
+			groups[awssdk.StringValue(group.GroupId)] = group
+		}
+	}
+	for _, groupID := range sets.StringKeySet(groups).List() {
+		instanceCopy.SecurityGroups = append(instanceCopy.SecurityGroups, copyOf(groups[groupID]).(*ec2sdk.GroupIdentifier))
+	}
+	return instanceCopy
+}
+
+// ec2ResourceTags returns the tags of EC2 resource by ID.
+func (b *backend) ec2ResourceTags(resID string) (*[]*ec2sdk.Tag, error) {
+	switch {
+	case strings.HasPrefix(resID, "vpc-"):
+		if vpc, ok := b.vpcs[resID]; ok {
+			return &vpc.Tags, nil
+		}
+		return nil, newAPIErrorf("InvalidVpcID.NotFound", "The vpc ID '%v' does not exist", resID)
+	case strings.HasPrefix(resID, "subnet-"):
+		if record, ok := b.subnets[resID]; ok {
+			return &record.subnet.Tags, nil
+		}
+		return nil, newAPIErrorf("InvalidSubnetID.NotFound", "The subnet ID '%v' does not exist", resID)
+	case strings.HasPrefix(resID, "sg-"):
+		if sg, ok := b.securityGroups[resID]; ok {
+			return &sg.Tags, nil
+		}
+		return nil, newAPIErrorf("InvalidGroup.NotFound", "The security group '%v' does not exist", resID)
+	case strings.HasPrefix(resID, "i-"):
+		if instance, ok := b.instances[resID]; ok {
+			return &instance.Tags, nil
+		}
+		return nil, newAPIErrorf("InvalidInstanceID.NotFound", "The instance ID '%v' does not exist", resID)
+	case strings.HasPrefix(resID, "eni-"):
+		if eni, ok := b.networkInterfaces[resID]; ok {
+			return &eni.TagSet, nil
+		}
+		return nil, newAPIErrorf("InvalidNetworkInterfaceID.NotFound", "The networkInterface ID '%v' does not exist", resID)
+	}
+	return nil, newAPIErrorf("InvalidID", "The ID '%v' is not valid", resID)
+}
+
+// ec2ARN returns the ARN for EC2 resource.
+func (b *backend) ec2ARN(resourceType string, resID string) string {
+	return "arn:aws:ec2:" + b.region + ":" + b.accountID + ":" + resourceType + "/" + resID
+}
+
+// matchesEC2Filters checks whether a resource with tags and attributes matches EC2 filters.
+// Each filter matches if any resource value matches any of the filter values, and all filters must match.
+func matchesEC2Filters(filters []*ec2sdk.Filter, tags []*ec2sdk.Tag, attrs map[string][]string) (bool, error) {
+	tagMap := ec2TagsAsMap(tags)
+	for _, filter := range filters {
+		filterName := awssdk.StringValue(filter.Name)
+		var resValues []string
+		switch {
+		case strings.HasPrefix(filterName, "tag:"):
+			if tagValue, ok := tagMap[strings.TrimPrefix(filterName, "tag:")]; ok {
+				resValues = []string{tagValue}
+			}
+		case filterName == "tag-key":
+			resValues = sets.StringKeySet(tagMap).List()
+		default:
+			values, ok := attrs[filterName]
+			if !ok {
+				return false, newAPIErrorf("InvalidParameterValue", "The filter '%v' is invalid", filterName)
+			}
+			resValues = values
+		}
+		filterValues := awssdk.StringValueSlice(filter.Values)
+		matches := false
+		for _, resValue := range resValues {
+			if matchesAnyValue(filterValues, resValue) {
+				matches = true
+				break
+			}
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// expandIPPermissions expands permissions so that each permission only contains a single source.
+func expandIPPermissions(permissions []*ec2sdk.IpPermission) []*ec2sdk.IpPermission {
+	var expanded []*ec2sdk.IpPermission
+	for _, permission := range permissions {
+		base := ec2sdk.IpPermission{
+			IpProtocol: permission.IpProtocol,
+			FromPort:   permission.FromPort,
+			ToPort:     permission.ToPort,
+		}
+		for _, ipRange := range permission.IpRanges {
+			expandedPermission := base
+			expandedPermission.IpRanges = []*ec2sdk.IpRange{copyOf(ipRange).(*ec2sdk.IpRange)}
+			expanded = append(expanded, &expandedPermission)
+		}
+		for _, ipv6Range := range permission.Ipv6Ranges {
+			expandedPermission := base
+			expandedPermission.Ipv6Ranges = []*ec2sdk.Ipv6Range{copyOf(ipv6Range).(*ec2sdk.Ipv6Range)}
+			expanded = append(expanded, &expandedPermission)
+		}
+		for _, prefixListID := range permission.PrefixListIds {
+			expandedPermission := base
+			expandedPermission.PrefixListIds = []*ec2sdk.PrefixListId{copyOf(prefixListID).(*ec2sdk.PrefixListId)}
+			expanded = append(expanded, &expandedPermission)
+		}
+		for _, pair := range permission.UserIdGroupPairs {
+			expandedPermission := base
+			expandedPermission.UserIdGroupPairs = []*ec2sdk.UserIdGroupPair{copyOf(pair).(*ec2sdk.UserIdGroupPair)}
+			expanded = append(expanded, &expandedPermission)
+		}
+	}
+	return expanded
+}
+
+// findIPPermission returns the index of expanded permission in permissions, or -1 if not found.
+// descriptions are not considered when comparing permissions.
+func findIPPermission(permissions []*ec2sdk.IpPermission, permission *ec2sdk.IpPermission) int {
+	key := ipPermissionKey(permission)
+	for idx, candidate := range permissions {
+		if ipPermissionKey(candidate) == key {
+			return idx
+		}
+	}
+	return -1
+}
+
+// ipPermissionKey computes the identity of expanded permission.
+func ipPermissionKey(permission *ec2sdk.IpPermission) string {
+	protocol := strings.ToLower(awssdk.StringValue(permission.IpProtocol))
+	var source string
+	switch {
+	case len(permission.IpRanges) == 1:
+		source = awssdk.StringValue(permission.IpRanges[0].CidrIp)
+	case len(permission.Ipv6Ranges) == 1:
+		source = awssdk.StringValue(permission.Ipv6Ranges[0].CidrIpv6)
+	case len(permission.PrefixListIds) == 1:
+		source = awssdk.StringValue(permission.PrefixListIds[0].PrefixListId)
+	case len(permission.UserIdGroupPairs) == 1:
+		source = awssdk.StringValue(permission.UserIdGroupPairs[0].GroupId)
+	}
+	if protocol == "-1" {
+		return fmt.Sprintf("%v/%v", protocol, source)
+	}
+	return fmt.Sprintf("%v/%v-%v/%v", protocol, awssdk.Int64Value(permission.FromPort), awssdk.Int64Value(permission.ToPort), source)
+}
+
+// tagsFromTagSpecifications returns the tags for resourceType from tag specifications.
+func tagsFromTagSpecifications(tagSpecs []*ec2sdk.TagSpecification, resourceType string) []*ec2sdk.Tag {
+	tagMap := make(map[string]string)
+	for _, tagSpec := range tagSpecs {
+		if awssdk.StringValue(tagSpec.ResourceType) != resourceType {
+			continue
+		}
+		for _, tag := range tagSpec.Tags {
+			tagMap[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		}
+	}
+	return ec2TagsFromMap(tagMap)
+}
+
+func ec2TagsAsMap(tags []*ec2sdk.Tag) map[string]string {
+	tagMap := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		tagMap[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+func ec2TagsFromMap(tagMap map[string]string) []*ec2sdk.Tag {
+	var tags []*ec2sdk.Tag
+	for _, key := range sets.StringKeySet(tagMap).List() {
+		tags = append(tags, &ec2sdk.Tag{Key: awssdk.String(key), Value: awssdk.String(tagMap[key])})
+	}
+	return tags
+}
+
+// lastIP returns the last IP address within ipNet.
+func lastIP(ipNet *net.IPNet) net.IP {
+	ip := make(net.IP, len(ipNet.IP))
+	for i := range ipNet.IP {
+		ip[i] = ipNet.IP[i] | ^ipNet.Mask[i]
+	}
+	return ip
+}
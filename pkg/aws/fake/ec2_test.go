@@ -0,0 +1,192 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fakeEC2_CreateSubnetWithContext(t *testing.T) {
+	tests := []struct {
+		name          string
+		existingCIDRs []string
+		input         *ec2sdk.CreateSubnetInput
+		wantErrCode   string
+	}{
+		{
+			name: "subnet within VPC",
+			input: &ec2sdk.CreateSubnetInput{
+				CidrBlock:        awssdk.String("192.168.0.0/24"),
+				AvailabilityZone: awssdk.String("us-west-2a"),
+			},
+		},
+		{
+			name: "subnet by availability zone ID",
+			input: &ec2sdk.CreateSubnetInput{
+				CidrBlock:          awssdk.String("192.168.0.0/24"),
+				AvailabilityZoneId: awssdk.String("us-west-2-az3"),
+			},
+		},
+		{
+			name: "subnet outside VPC",
+			input: &ec2sdk.CreateSubnetInput{
+				CidrBlock:        awssdk.String("10.0.0.0/24"),
+				AvailabilityZone: awssdk.String("us-west-2a"),
+			},
+			wantErrCode: "InvalidSubnet.Range",
+		},
+		{
+			name:          "subnet conflicts with existing subnet",
+			existingCIDRs: []string{"192.168.0.0/16"},
+			input: &ec2sdk.CreateSubnetInput{
+				CidrBlock:        awssdk.String("192.168.1.0/24"),
+				AvailabilityZone: awssdk.String("us-west-2a"),
+			},
+			wantErrCode: "InvalidSubnet.Conflict",
+		},
+		{
+			name: "unknown availability zone",
+			input: &ec2sdk.CreateSubnetInput{
+				CidrBlock:        awssdk.String("192.168.0.0/24"),
+				AvailabilityZone: awssdk.String("us-east-1a"),
+			},
+			wantErrCode: "InvalidParameterValue",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := NewCloud("us-west-2")
+			for _, cidr := range tt.existingCIDRs {
+				_, err := cloud.EC2().CreateSubnetWithContext(ctx, &ec2sdk.CreateSubnetInput{
+					VpcId:            awssdk.String(cloud.VpcID()),
+					CidrBlock:        awssdk.String(cidr),
+					AvailabilityZone: awssdk.String("us-west-2a"),
+				})
+				require.NoError(t, err)
+			}
+			tt.input.VpcId = awssdk.String(cloud.VpcID())
+			resp, err := cloud.EC2().CreateSubnetWithContext(ctx, tt.input)
+			if tt.wantErrCode != "" {
+				assertAPIErrorCode(t, tt.wantErrCode, err)
+				return
+			}
+			require.NoError(t, err)
+			subnets, err := cloud.EC2().DescribeSubnetsAsList(ctx, &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{
+					{Name: awssdk.String("vpc-id"), Values: awssdk.StringSlice([]string{cloud.VpcID()})},
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []*ec2sdk.Subnet{resp.Subnet}, subnets)
+			assert.Equal(t, int64(251), awssdk.Int64Value(resp.Subnet.AvailableIpAddressCount))
+		})
+	}
+}
+
+func Test_fakeEC2_SecurityGroupIngress(t *testing.T) {
+	ctx := context.Background()
+	cloud := NewCloud("us-west-2")
+	createResp, err := cloud.EC2().CreateSecurityGroupWithContext(ctx, &ec2sdk.CreateSecurityGroupInput{
+		VpcId:       awssdk.String(cloud.VpcID()),
+		GroupName:   awssdk.String("sg"),
+		Description: awssdk.String("sg"),
+	})
+	require.NoError(t, err)
+	sgID := createResp.GroupId
+	permissions := []*ec2sdk.IpPermission{
+		{
+			IpProtocol: awssdk.String("tcp"),
+			FromPort:   awssdk.Int64(80),
+			ToPort:     awssdk.Int64(80),
+			IpRanges:   []*ec2sdk.IpRange{{CidrIp: awssdk.String("0.0.0.0/0")}},
+		},
+	}
+
+	_, err = cloud.EC2().AuthorizeSecurityGroupIngressWithContext(ctx, &ec2sdk.AuthorizeSecurityGroupIngressInput{
+		GroupId:       sgID,
+		IpPermissions: permissions,
+	})
+	require.NoError(t, err)
+	_, err = cloud.EC2().AuthorizeSecurityGroupIngressWithContext(ctx, &ec2sdk.AuthorizeSecurityGroupIngressInput{
+		GroupId:       sgID,
+		IpPermissions: permissions,
+	})
+	assertAPIErrorCode(t, "InvalidPermission.Duplicate", err)
+
+	sgs, err := cloud.EC2().DescribeSecurityGroupsAsList(ctx, &ec2sdk.DescribeSecurityGroupsInput{GroupIds: []*string{sgID}})
+	require.NoError(t, err)
+	require.Len(t, sgs, 1)
+	assert.Len(t, sgs[0].IpPermissions, 1)
+
+	_, err = cloud.EC2().RevokeSecurityGroupIngressWithContext(ctx, &ec2sdk.RevokeSecurityGroupIngressInput{
+		GroupId:       sgID,
+		IpPermissions: permissions,
+	})
+	require.NoError(t, err)
+	_, err = cloud.EC2().RevokeSecurityGroupIngressWithContext(ctx, &ec2sdk.RevokeSecurityGroupIngressInput{
+		GroupId:       sgID,
+		IpPermissions: permissions,
+	})
+	assertAPIErrorCode(t, "InvalidPermission.NotFound", err)
+
+	_, err = cloud.EC2().DeleteSecurityGroupWithContext(ctx, &ec2sdk.DeleteSecurityGroupInput{GroupId: sgID})
+	require.NoError(t, err)
+	_, err = cloud.EC2().DescribeSecurityGroupsAsList(ctx, &ec2sdk.DescribeSecurityGroupsInput{GroupIds: []*string{sgID}})
+	assertAPIErrorCode(t, "InvalidGroup.NotFound", err)
+}
+
+func Test_Cloud_InjectError(t *testing.T) {
+	ctx := context.Background()
+	cloud := NewCloud("us-west-2")
+	cloud.InjectError(ServiceEC2, "DescribeVpcs", NewThrottlingError(ServiceEC2))
+
+	_, err := cloud.EC2().DescribeVpcsWithContext(ctx, &ec2sdk.DescribeVpcsInput{})
+	assertAPIErrorCode(t, "RequestLimitExceeded", err)
+	// injected errors are consumed once.
+	resp, err := cloud.EC2().DescribeVpcsWithContext(ctx, &ec2sdk.DescribeVpcsInput{})
+	require.NoError(t, err)
+	require.Len(t, resp.Vpcs, 1)
+	assert.Equal(t, cloud.VpcID(), awssdk.StringValue(resp.Vpcs[0].VpcId))
+}
+
+func Test_fakeEC2_DescribeAvailabilityZonesWithContext(t *testing.T) {
+	ctx := context.Background()
+	cloud := NewCloud("us-west-2")
+
+	resp, err := cloud.EC2().DescribeAvailabilityZonesWithContext(ctx, &ec2sdk.DescribeAvailabilityZonesInput{
+		ZoneIds: awssdk.StringSlice([]string{"us-west-2-az2"}),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.AvailabilityZones, 1)
+	assert.Equal(t, "us-west-2b", awssdk.StringValue(resp.AvailabilityZones[0].ZoneName))
+	_, err = cloud.EC2().DescribeAvailabilityZonesWithContext(ctx, &ec2sdk.DescribeAvailabilityZonesInput{
+		ZoneIds: awssdk.StringSlice([]string{"us-west-2-az2", "us-west-2-az9"}),
+	})
+	assertAPIErrorCode(t, "InvalidParameterValue", err)
+}
+
+func Test_Cloud_NotImplemented(t *testing.T) {
+	ctx := context.Background()
+	cloud := NewCloud("us-west-2")
+
+	_, err := cloud.EC2().DescribeInstanceStatusWithContext(ctx, &ec2sdk.DescribeInstanceStatusInput{})
+	assertAPIErrorCode(t, "NotImplemented", err)
+	err = cloud.EC2().DescribeInstanceStatusPagesWithContext(ctx, &ec2sdk.DescribeInstanceStatusInput{},
+		func(_ *ec2sdk.DescribeInstanceStatusOutput, _ bool) bool { return true })
+	assertAPIErrorCode(t, "NotImplemented", err)
+	req, _ := cloud.EC2().DescribeInstanceStatusRequest(&ec2sdk.DescribeInstanceStatusInput{})
+	assertAPIErrorCode(t, "NotImplemented", req.Send())
+}
+
+func assertAPIErrorCode(t *testing.T, wantCode string, err error) {
+	require.Error(t, err)
+	var awsErr awserr.Error
+	require.ErrorAs(t, err, &awsErr)
+	assert.Equal(t, wantCode, awsErr.Code())
+}
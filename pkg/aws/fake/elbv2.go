@@ -0,0 +1,1760 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	// the maximum number of resources per DescribeTags call.
+	describeTagsMaxResources = 20
+	// the maximum number of tags per ELBV2 resource.
+	elbv2MaxTagsPerResource = 50
+	// the hosted zone ID for load balancer DNS names.
+	canonicalHostedZoneID = "Z00000000000000000000"
+)
+
+var (
+	// load balancer and target group names can only contain alphanumeric characters and hyphens.
+	elbv2NamePattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?$`)
+)
+
+type loadBalancerRecord struct {
+	lb         *elbv2sdk.LoadBalancer
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type listenerRecord struct {
+	listener *elbv2sdk.Listener
+	// certificates besides the default certificate.
+	extraCertificates []*elbv2sdk.Certificate
+	tags              map[string]string
+}
+
+type ruleRecord struct {
+	rule        *elbv2sdk.Rule
+	listenerARN string
+	tags        map[string]string
+}
+
+type targetGroupRecord struct {
+	tg         *elbv2sdk.TargetGroup
+	attributes map[string]string
+	targets    []*targetRecord
+	tags       map[string]string
+}
+
+type targetRecord struct {
+	target *elbv2sdk.TargetDescription
+	// health overridden via Cloud.SetTargetHealth, nil means the health is derived from target group.
+	health *elbv2sdk.TargetHealth
+}
+
+type trustStoreRecord struct {
+	trustStore *elbv2sdk.TrustStore
+	tags       map[string]string
+}
+
+var _ services.ELBV2 = &fakeELBV2{}
+
+// fakeELBV2 is an in-memory implementation of services.ELBV2.
+type fakeELBV2 struct {
+	unimplementedELBV2
+	backend *backend
+}
+
+func (c *fakeELBV2) CreateLoadBalancerWithContext(_ context.Context, input *elbv2sdk.CreateLoadBalancerInput, _ ...request.Option) (*elbv2sdk.CreateLoadBalancerOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "CreateLoadBalancer")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	name := awssdk.StringValue(input.Name)
+	if !elbv2NamePattern.MatchString(name) || strings.HasPrefix(name, "internal-") {
+		return nil, newAPIErrorf("ValidationError", "The load balancer name '%v' is invalid", name)
+	}
+	for _, record := range c.backend.loadBalancers {
+		if awssdk.StringValue(record.lb.LoadBalancerName) == name {
+			return nil, NewAPIError("DuplicateLoadBalancerName", "A load balancer with the same name exists")
+		}
+	}
+	lbType := stringValueOrDefault(input.Type, elbv2sdk.LoadBalancerTypeEnumApplication)
+	scheme := stringValueOrDefault(input.Scheme, elbv2sdk.LoadBalancerSchemeEnumInternetFacing)
+	ipAddressType := stringValueOrDefault(input.IpAddressType, elbv2sdk.IpAddressTypeIpv4)
+	if err := c.backend.validateTags(nil, input.Tags); err != nil {
+		return nil, err
+	}
+	vpcID, azs, err := c.backend.buildLoadBalancerAZs(lbType, input.Subnets, input.SubnetMappings)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.backend.validateLoadBalancerSecurityGroups(vpcID, awssdk.StringValueSlice(input.SecurityGroups)); err != nil {
+		return nil, err
+	}
+
+	lbID := c.backend.nextID(16)
+	lbARN := fmt.Sprintf("arn:aws:elasticloadbalancing:%v:%v:loadbalancer/%v/%v/%v", c.backend.region, c.backend.accountID, lbTypeShortName(lbType), name, lbID)
+	dnsPrefix := name + "-" + lbID[len(lbID)-10:]
+	if scheme == elbv2sdk.LoadBalancerSchemeEnumInternal {
+		dnsPrefix = "internal-" + dnsPrefix
+	}
+	dnsName := fmt.Sprintf("%v.%v.elb.amazonaws.com", dnsPrefix, c.backend.region)
+	if lbType == elbv2sdk.LoadBalancerTypeEnumNetwork {
+		dnsName = fmt.Sprintf("%v.elb.%v.amazonaws.com", dnsPrefix, c.backend.region)
+	}
+	lb := &elbv2sdk.LoadBalancer{
+		LoadBalancerArn:       awssdk.String(lbARN),
+		LoadBalancerName:      awssdk.String(name),
+		DNSName:               awssdk.String(dnsName),
+		CanonicalHostedZoneId: awssdk.String(canonicalHostedZoneID),
+		CreatedTime:           awssdk.Time(time.Now()),
+		Scheme:                awssdk.String(scheme),
+		Type:                  awssdk.String(lbType),
+		IpAddressType:         awssdk.String(ipAddressType),
+		VpcId:                 awssdk.String(vpcID),
+		AvailabilityZones:     azs,
+		SecurityGroups:        awssdk.StringSlice(sets.NewString(awssdk.StringValueSlice(input.SecurityGroups)...).List()),
+		CustomerOwnedIpv4Pool: input.CustomerOwnedIpv4Pool,
+		State:                 &elbv2sdk.LoadBalancerState{Code: awssdk.String(elbv2sdk.LoadBalancerStateEnumActive)},
+	}
+	if len(lb.SecurityGroups) == 0 {
+		lb.SecurityGroups = nil
+	}
+	c.backend.loadBalancers[lbARN] = &loadBalancerRecord{
+		lb:         lb,
+		attributes: defaultLoadBalancerAttributes(lbType),
+		tags:       elbv2TagsAsMap(input.Tags),
+	}
+	return &elbv2sdk.CreateLoadBalancerOutput{LoadBalancers: []*elbv2sdk.LoadBalancer{copyOf(lb).(*elbv2sdk.LoadBalancer)}}, nil
+}
+
+func (c *fakeELBV2) DeleteLoadBalancerWithContext(_ context.Context, input *elbv2sdk.DeleteLoadBalancerInput, _ ...request.Option) (*elbv2sdk.DeleteLoadBalancerOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DeleteLoadBalancer")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	record, ok := c.backend.loadBalancers[lbARN]
+	// deleting a load balancer that doesn't exist succeeds, same as AWS.
+	if !ok {
+		return &elbv2sdk.DeleteLoadBalancerOutput{}, nil
+	}
+	if record.attributes["deletion_protection.enabled"] == "true" {
+		return nil, newAPIErrorf("OperationNotPermitted", "Load balancer '%v' cannot be deleted because deletion protection is enabled", lbARN)
+	}
+	for lsARN, lsRecord := range c.backend.listeners {
+		if awssdk.StringValue(lsRecord.listener.LoadBalancerArn) == lbARN {
+			c.backend.deleteListener(lsARN)
+		}
+	}
+	delete(c.backend.webACLByResource, lbARN)
+	delete(c.backend.regionalWebACLByResource, lbARN)
+	delete(c.backend.loadBalancers, lbARN)
+	return &elbv2sdk.DeleteLoadBalancerOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeLoadBalancersWithContext(_ context.Context, input *elbv2sdk.DescribeLoadBalancersInput, _ ...request.Option) (*elbv2sdk.DescribeLoadBalancersOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeLoadBalancers")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lbs, err := c.backend.describeLoadBalancers(input)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeLoadBalancersOutput{LoadBalancers: lbs}, nil
+}
+
+func (c *fakeELBV2) DescribeLoadBalancersAsList(_ context.Context, input *elbv2sdk.DescribeLoadBalancersInput) ([]*elbv2sdk.LoadBalancer, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeLoadBalancers")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeLoadBalancers(input)
+}
+
+func (c *fakeELBV2) DescribeLoadBalancerAttributesWithContext(_ context.Context, input *elbv2sdk.DescribeLoadBalancerAttributesInput, _ ...request.Option) (*elbv2sdk.DescribeLoadBalancerAttributesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeLoadBalancerAttributes")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeLoadBalancerAttributesOutput{Attributes: buildLoadBalancerAttributes(record.attributes)}, nil
+}
+
+func (c *fakeELBV2) ModifyLoadBalancerAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyLoadBalancerAttributesInput, _ ...request.Option) (*elbv2sdk.ModifyLoadBalancerAttributesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "ModifyLoadBalancerAttributes")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range input.Attributes {
+		record.attributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+	}
+	return &elbv2sdk.ModifyLoadBalancerAttributesOutput{Attributes: buildLoadBalancerAttributes(record.attributes)}, nil
+}
+
+func (c *fakeELBV2) SetIpAddressTypeWithContext(_ context.Context, input *elbv2sdk.SetIpAddressTypeInput, _ ...request.Option) (*elbv2sdk.SetIpAddressTypeOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "SetIpAddressType")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	ipAddressType := awssdk.StringValue(input.IpAddressType)
+	if ipAddressType != elbv2sdk.IpAddressTypeIpv4 && ipAddressType != elbv2sdk.IpAddressTypeDualstack {
+		return nil, newAPIErrorf("ValidationError", "IP address type '%v' is invalid", ipAddressType)
+	}
+	record.lb.IpAddressType = awssdk.String(ipAddressType)
+	return &elbv2sdk.SetIpAddressTypeOutput{IpAddressType: awssdk.String(ipAddressType)}, nil
+}
+
+func (c *fakeELBV2) SetSecurityGroupsWithContext(_ context.Context, input *elbv2sdk.SetSecurityGroupsInput, _ ...request.Option) (*elbv2sdk.SetSecurityGroupsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "SetSecurityGroups")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	sgIDs := awssdk.StringValueSlice(input.SecurityGroups)
+	if err := c.backend.validateLoadBalancerSecurityGroups(awssdk.StringValue(record.lb.VpcId), sgIDs); err != nil {
+		return nil, err
+	}
+	record.lb.SecurityGroups = awssdk.StringSlice(sets.NewString(sgIDs...).List())
+	return &elbv2sdk.SetSecurityGroupsOutput{SecurityGroupIds: copyOf(record.lb.SecurityGroups).([]*string)}, nil
+}
+
+func (c *fakeELBV2) SetSubnetsWithContext(_ context.Context, input *elbv2sdk.SetSubnetsInput, _ ...request.Option) (*elbv2sdk.SetSubnetsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "SetSubnets")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	vpcID, azs, err := c.backend.buildLoadBalancerAZs(awssdk.StringValue(record.lb.Type), input.Subnets, input.SubnetMappings)
+	if err != nil {
+		return nil, err
+	}
+	if vpcID != awssdk.StringValue(record.lb.VpcId) {
+		return nil, NewAPIError("InvalidSubnet", "The subnets must belong to the VPC of load balancer")
+	}
+	record.lb.AvailabilityZones = azs
+	return &elbv2sdk.SetSubnetsOutput{AvailabilityZones: copyOf(azs).([]*elbv2sdk.AvailabilityZone)}, nil
+}
+
+func (c *fakeELBV2) CreateListenerWithContext(_ context.Context, input *elbv2sdk.CreateListenerInput, _ ...request.Option) (*elbv2sdk.CreateListenerOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "CreateListener")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	lbRecord, err := c.backend.findLoadBalancer(lbARN)
+	if err != nil {
+		return nil, err
+	}
+	for _, lsRecord := range c.backend.listeners {
+		if awssdk.StringValue(lsRecord.listener.LoadBalancerArn) == lbARN && awssdk.Int64Value(lsRecord.listener.Port) == awssdk.Int64Value(input.Port) {
+			return nil, NewAPIError("DuplicateListener", "A listener already exists on this port for this load balancer")
+		}
+	}
+	if err := c.backend.validateTags(nil, input.Tags); err != nil {
+		return nil, err
+	}
+	listener := &elbv2sdk.Listener{
+		LoadBalancerArn:      awssdk.String(lbARN),
+		Port:                 input.Port,
+		Protocol:             input.Protocol,
+		SslPolicy:            input.SslPolicy,
+		AlpnPolicy:           input.AlpnPolicy,
+		Certificates:         input.Certificates,
+		DefaultActions:       input.DefaultActions,
+		MutualAuthentication: input.MutualAuthentication,
+	}
+	if err := c.backend.validateListener(lbRecord, listener); err != nil {
+		return nil, err
+	}
+	if err := c.backend.validateActions(lbRecord, listener.DefaultActions); err != nil {
+		return nil, err
+	}
+
+	lbARNParts := strings.SplitN(lbARN, ":loadbalancer/", 2)
+	lsARN := fmt.Sprintf("%v:listener/%v/%v", lbARNParts[0], lbARNParts[1], c.backend.nextID(16))
+	listener = copyOf(listener).(*elbv2sdk.Listener)
+	listener.ListenerArn = awssdk.String(lsARN)
+	normalizeActions(listener.DefaultActions)
+	c.backend.listeners[lsARN] = &listenerRecord{
+		listener: listener,
+		tags:     elbv2TagsAsMap(input.Tags),
+	}
+	lsARNParts := strings.SplitN(lsARN, ":listener/", 2)
+	ruleARN := fmt.Sprintf("%v:listener-rule/%v/%v", lsARNParts[0], lsARNParts[1], c.backend.nextID(16))
+	c.backend.rules[ruleARN] = &ruleRecord{
+		rule: &elbv2sdk.Rule{
+			RuleArn:    awssdk.String(ruleARN),
+			Priority:   awssdk.String("default"),
+			IsDefault:  awssdk.Bool(true),
+			Actions:    copyOf(listener.DefaultActions).([]*elbv2sdk.Action),
+			Conditions: []*elbv2sdk.RuleCondition{},
+		},
+		listenerARN: lsARN,
+		tags:        make(map[string]string),
+	}
+	return &elbv2sdk.CreateListenerOutput{Listeners: []*elbv2sdk.Listener{copyOf(listener).(*elbv2sdk.Listener)}}, nil
+}
+
+func (c *fakeELBV2) ModifyListenerWithContext(_ context.Context, input *elbv2sdk.ModifyListenerInput, _ ...request.Option) (*elbv2sdk.ModifyListenerOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "ModifyListener")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lsRecord, err := c.backend.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	lbRecord := c.backend.loadBalancers[awssdk.StringValue(lsRecord.listener.LoadBalancerArn)]
+	listener := copyOf(lsRecord.listener).(*elbv2sdk.Listener)
+	if input.Port != nil && awssdk.Int64Value(input.Port) != awssdk.Int64Value(listener.Port) {
+		for _, other := range c.backend.listeners {
+			if awssdk.StringValue(other.listener.LoadBalancerArn) == awssdk.StringValue(listener.LoadBalancerArn) &&
+				awssdk.Int64Value(other.listener.Port) == awssdk.Int64Value(input.Port) {
+				return nil, NewAPIError("DuplicateListener", "A listener already exists on this port for this load balancer")
+			}
+		}
+		listener.Port = input.Port
+	}
+	if input.Protocol != nil {
+		listener.Protocol = input.Protocol
+	}
+	if input.SslPolicy != nil {
+		listener.SslPolicy = input.SslPolicy
+	}
+	if input.AlpnPolicy != nil {
+		listener.AlpnPolicy = input.AlpnPolicy
+	}
+	if input.Certificates != nil {
+		listener.Certificates = input.Certificates
+	}
+	if input.MutualAuthentication != nil {
+		listener.MutualAuthentication = input.MutualAuthentication
+	}
+	if input.DefaultActions != nil {
+		if err := c.backend.validateActions(lbRecord, input.DefaultActions); err != nil {
+			return nil, err
+		}
+		listener.DefaultActions = input.DefaultActions
+	}
+	if !isSecureProtocol(awssdk.StringValue(listener.Protocol)) {
+		listener.SslPolicy = nil
+		listener.Certificates = nil
+		listener.AlpnPolicy = nil
+	}
+	if err := c.backend.validateListener(lbRecord, listener); err != nil {
+		return nil, err
+	}
+	lsRecord.listener = copyOf(listener).(*elbv2sdk.Listener)
+	normalizeActions(lsRecord.listener.DefaultActions)
+	for _, ruleRecord := range c.backend.rules {
+		if ruleRecord.listenerARN == awssdk.StringValue(listener.ListenerArn) && awssdk.BoolValue(ruleRecord.rule.IsDefault) {
+			ruleRecord.rule.Actions = copyOf(lsRecord.listener.DefaultActions).([]*elbv2sdk.Action)
+		}
+	}
+	return &elbv2sdk.ModifyListenerOutput{Listeners: []*elbv2sdk.Listener{copyOf(lsRecord.listener).(*elbv2sdk.Listener)}}, nil
+}
+
+func (c *fakeELBV2) DeleteListenerWithContext(_ context.Context, input *elbv2sdk.DeleteListenerInput, _ ...request.Option) (*elbv2sdk.DeleteListenerOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DeleteListener")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	if _, err := c.backend.findListener(lsARN); err != nil {
+		return nil, err
+	}
+	c.backend.deleteListener(lsARN)
+	return &elbv2sdk.DeleteListenerOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeListenersWithContext(_ context.Context, input *elbv2sdk.DescribeListenersInput, _ ...request.Option) (*elbv2sdk.DescribeListenersOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeListeners")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	listeners, err := c.backend.describeListeners(input)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeListenersOutput{Listeners: listeners}, nil
+}
+
+func (c *fakeELBV2) DescribeListenersAsList(_ context.Context, input *elbv2sdk.DescribeListenersInput) ([]*elbv2sdk.Listener, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeListeners")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeListeners(input)
+}
+
+func (c *fakeELBV2) AddListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.AddListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.AddListenerCertificatesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "AddListenerCertificates")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lsRecord, err := c.backend.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.backend.validateCertificates(input.Certificates); err != nil {
+		return nil, err
+	}
+	for _, cert := range input.Certificates {
+		if findCertificate(lsRecord.extraCertificates, awssdk.StringValue(cert.CertificateArn)) == -1 {
+			lsRecord.extraCertificates = append(lsRecord.extraCertificates, &elbv2sdk.Certificate{CertificateArn: cert.CertificateArn})
+		}
+	}
+	return &elbv2sdk.AddListenerCertificatesOutput{Certificates: copyOf(lsRecord.extraCertificates).([]*elbv2sdk.Certificate)}, nil
+}
+
+func (c *fakeELBV2) RemoveListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.RemoveListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.RemoveListenerCertificatesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "RemoveListenerCertificates")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lsRecord, err := c.backend.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range input.Certificates {
+		if findCertificate(lsRecord.listener.Certificates, awssdk.StringValue(cert.CertificateArn)) != -1 {
+			return nil, NewAPIError("OperationNotPermitted", "The default certificate of listener cannot be removed")
+		}
+		if idx := findCertificate(lsRecord.extraCertificates, awssdk.StringValue(cert.CertificateArn)); idx != -1 {
+			lsRecord.extraCertificates = append(lsRecord.extraCertificates[:idx], lsRecord.extraCertificates[idx+1:]...)
+		}
+	}
+	return &elbv2sdk.RemoveListenerCertificatesOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.DescribeListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.DescribeListenerCertificatesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeListenerCertificates")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	certs, err := c.backend.describeListenerCertificates(input)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeListenerCertificatesOutput{Certificates: certs}, nil
+}
+
+func (c *fakeELBV2) DescribeListenerCertificatesAsList(_ context.Context, input *elbv2sdk.DescribeListenerCertificatesInput) ([]*elbv2sdk.Certificate, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeListenerCertificates")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeListenerCertificates(input)
+}
+
+func (c *fakeELBV2) CreateRuleWithContext(_ context.Context, input *elbv2sdk.CreateRuleInput, _ ...request.Option) (*elbv2sdk.CreateRuleOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "CreateRule")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	lsRecord, err := c.backend.findListener(lsARN)
+	if err != nil {
+		return nil, err
+	}
+	priority := awssdk.Int64Value(input.Priority)
+	if err := c.backend.validateRulePriority(lsARN, priority, nil); err != nil {
+		return nil, err
+	}
+	if len(input.Conditions) == 0 {
+		return nil, NewAPIError("ValidationError", "A condition must be specified")
+	}
+	lbRecord := c.backend.loadBalancers[awssdk.StringValue(lsRecord.listener.LoadBalancerArn)]
+	if err := c.backend.validateActions(lbRecord, input.Actions); err != nil {
+		return nil, err
+	}
+	if err := c.backend.validateTags(nil, input.Tags); err != nil {
+		return nil, err
+	}
+	lsARNParts := strings.SplitN(lsARN, ":listener/", 2)
+	ruleARN := fmt.Sprintf("%v:listener-rule/%v/%v", lsARNParts[0], lsARNParts[1], c.backend.nextID(16))
+	rule := &elbv2sdk.Rule{
+		RuleArn:    awssdk.String(ruleARN),
+		Priority:   awssdk.String(strconv.FormatInt(priority, 10)),
+		IsDefault:  awssdk.Bool(false),
+		Actions:    copyOf(input.Actions).([]*elbv2sdk.Action),
+		Conditions: copyOf(input.Conditions).([]*elbv2sdk.RuleCondition),
+	}
+	normalizeActions(rule.Actions)
+	c.backend.rules[ruleARN] = &ruleRecord{
+		rule:        rule,
+		listenerARN: lsARN,
+		tags:        elbv2TagsAsMap(input.Tags),
+	}
+	return &elbv2sdk.CreateRuleOutput{Rules: []*elbv2sdk.Rule{copyOf(rule).(*elbv2sdk.Rule)}}, nil
+}
+
+func (c *fakeELBV2) ModifyRuleWithContext(_ context.Context, input *elbv2sdk.ModifyRuleInput, _ ...request.Option) (*elbv2sdk.ModifyRuleOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "ModifyRule")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	ruleRecord, err := c.backend.findRule(awssdk.StringValue(input.RuleArn))
+	if err != nil {
+		return nil, err
+	}
+	if input.Conditions != nil {
+		if awssdk.BoolValue(ruleRecord.rule.IsDefault) {
+			return nil, NewAPIError("OperationNotPermitted", "Conditions cannot be specified for the default rule")
+		}
+		if len(input.Conditions) == 0 {
+			return nil, NewAPIError("ValidationError", "A condition must be specified")
+		}
+		ruleRecord.rule.Conditions = copyOf(input.Conditions).([]*elbv2sdk.RuleCondition)
+	}
+	if input.Actions != nil {
+		lsRecord := c.backend.listeners[ruleRecord.listenerARN]
+		lbRecord := c.backend.loadBalancers[awssdk.StringValue(lsRecord.listener.LoadBalancerArn)]
+		if err := c.backend.validateActions(lbRecord, input.Actions); err != nil {
+			return nil, err
+		}
+		ruleRecord.rule.Actions = copyOf(input.Actions).([]*elbv2sdk.Action)
+		normalizeActions(ruleRecord.rule.Actions)
+		if awssdk.BoolValue(ruleRecord.rule.IsDefault) {
+			lsRecord.listener.DefaultActions = copyOf(ruleRecord.rule.Actions).([]*elbv2sdk.Action)
+		}
+	}
+	return &elbv2sdk.ModifyRuleOutput{Rules: []*elbv2sdk.Rule{copyOf(ruleRecord.rule).(*elbv2sdk.Rule)}}, nil
+}
+
+func (c *fakeELBV2) DeleteRuleWithContext(_ context.Context, input *elbv2sdk.DeleteRuleInput, _ ...request.Option) (*elbv2sdk.DeleteRuleOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DeleteRule")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	ruleARN := awssdk.StringValue(input.RuleArn)
+	ruleRecord, err := c.backend.findRule(ruleARN)
+	if err != nil {
+		return nil, err
+	}
+	if awssdk.BoolValue(ruleRecord.rule.IsDefault) {
+		return nil, NewAPIError("OperationNotPermitted", "The default rule cannot be deleted")
+	}
+	delete(c.backend.rules, ruleARN)
+	return &elbv2sdk.DeleteRuleOutput{}, nil
+}
+
+func (c *fakeELBV2) SetRulePrioritiesWithContext(_ context.Context, input *elbv2sdk.SetRulePrioritiesInput, _ ...request.Option) (*elbv2sdk.SetRulePrioritiesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "SetRulePriorities")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	newPriorities := make(map[string]int64, len(input.RulePriorities))
+	for _, rulePriority := range input.RulePriorities {
+		ruleARN := awssdk.StringValue(rulePriority.RuleArn)
+		ruleRecord, err := c.backend.findRule(ruleARN)
+		if err != nil {
+			return nil, err
+		}
+		if awssdk.BoolValue(ruleRecord.rule.IsDefault) {
+			return nil, NewAPIError("OperationNotPermitted", "The priority of the default rule cannot be changed")
+		}
+		newPriorities[ruleARN] = awssdk.Int64Value(rulePriority.Priority)
+	}
+	for ruleARN, priority := range newPriorities {
+		if err := c.backend.validateRulePriority(c.backend.rules[ruleARN].listenerARN, priority, newPriorities); err != nil {
+			return nil, err
+		}
+	}
+	var rules []*elbv2sdk.Rule
+	for _, rulePriority := range input.RulePriorities {
+		ruleRecord := c.backend.rules[awssdk.StringValue(rulePriority.RuleArn)]
+		ruleRecord.rule.Priority = awssdk.String(strconv.FormatInt(awssdk.Int64Value(rulePriority.Priority), 10))
+		rules = append(rules, copyOf(ruleRecord.rule).(*elbv2sdk.Rule))
+	}
+	return &elbv2sdk.SetRulePrioritiesOutput{Rules: rules}, nil
+}
+
+func (c *fakeELBV2) DescribeRulesWithContext(_ context.Context, input *elbv2sdk.DescribeRulesInput, _ ...request.Option) (*elbv2sdk.DescribeRulesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeRules")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	rules, err := c.backend.describeRules(input)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeRulesOutput{Rules: rules}, nil
+}
+
+func (c *fakeELBV2) DescribeRulesAsList(_ context.Context, input *elbv2sdk.DescribeRulesInput) ([]*elbv2sdk.Rule, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeRules")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeRules(input)
+}
+
+func (c *fakeELBV2) CreateTargetGroupWithContext(_ context.Context, input *elbv2sdk.CreateTargetGroupInput, _ ...request.Option) (*elbv2sdk.CreateTargetGroupOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "CreateTargetGroup")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	name := awssdk.StringValue(input.Name)
+	if !elbv2NamePattern.MatchString(name) {
+		return nil, newAPIErrorf("ValidationError", "The target group name '%v' is invalid", name)
+	}
+	for _, record := range c.backend.targetGroups {
+		if awssdk.StringValue(record.tg.TargetGroupName) == name {
+			return nil, NewAPIError("DuplicateTargetGroupName", "A target group with the same name exists, but with different settings")
+		}
+	}
+	targetType := stringValueOrDefault(input.TargetType, elbv2sdk.TargetTypeEnumInstance)
+	if targetType != elbv2sdk.TargetTypeEnumLambda {
+		if _, ok := c.backend.vpcs[awssdk.StringValue(input.VpcId)]; !ok {
+			return nil, newAPIErrorf("ValidationError", "The VPC ID '%v' is not found", awssdk.StringValue(input.VpcId))
+		}
+	}
+	if err := c.backend.validateTags(nil, input.Tags); err != nil {
+		return nil, err
+	}
+	protocol := awssdk.StringValue(input.Protocol)
+	tgARN := fmt.Sprintf("arn:aws:elasticloadbalancing:%v:%v:targetgroup/%v/%v", c.backend.region, c.backend.accountID, name, c.backend.nextID(16))
+	tg := &elbv2sdk.TargetGroup{
+		TargetGroupArn:             awssdk.String(tgARN),
+		TargetGroupName:            awssdk.String(name),
+		TargetType:                 awssdk.String(targetType),
+		Protocol:                   input.Protocol,
+		ProtocolVersion:            input.ProtocolVersion,
+		Port:                       input.Port,
+		VpcId:                      input.VpcId,
+		IpAddressType:              input.IpAddressType,
+		HealthCheckEnabled:         awssdk.Bool(boolValueOrDefault(input.HealthCheckEnabled, true)),
+		HealthCheckProtocol:        awssdk.String(stringValueOrDefault(input.HealthCheckProtocol, defaultHealthCheckProtocol(protocol))),
+		HealthCheckPort:            awssdk.String(stringValueOrDefault(input.HealthCheckPort, "traffic-port")),
+		HealthCheckIntervalSeconds: awssdk.Int64(int64ValueOrDefault(input.HealthCheckIntervalSeconds, 30)),
+		HealthCheckTimeoutSeconds:  awssdk.Int64(int64ValueOrDefault(input.HealthCheckTimeoutSeconds, 5)),
+		HealthyThresholdCount:      awssdk.Int64(int64ValueOrDefault(input.HealthyThresholdCount, 5)),
+		UnhealthyThresholdCount:    awssdk.Int64(int64ValueOrDefault(input.UnhealthyThresholdCount, 2)),
+		HealthCheckPath:            input.HealthCheckPath,
+		Matcher:                    input.Matcher,
+	}
+	if isHTTPProtocol(awssdk.StringValue(tg.HealthCheckProtocol)) {
+		if tg.HealthCheckPath == nil {
+			tg.HealthCheckPath = awssdk.String("/")
+		}
+		if tg.Matcher == nil {
+			tg.Matcher = &elbv2sdk.Matcher{HttpCode: awssdk.String("200")}
+		}
+	}
+	c.backend.targetGroups[tgARN] = &targetGroupRecord{
+		tg:         tg,
+		attributes: defaultTargetGroupAttributes(protocol, targetType),
+		tags:       elbv2TagsAsMap(input.Tags),
+	}
+	return &elbv2sdk.CreateTargetGroupOutput{TargetGroups: []*elbv2sdk.TargetGroup{c.backend.buildTargetGroup(tg)}}, nil
+}
+
+func (c *fakeELBV2) ModifyTargetGroupWithContext(_ context.Context, input *elbv2sdk.ModifyTargetGroupInput, _ ...request.Option) (*elbv2sdk.ModifyTargetGroupOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "ModifyTargetGroup")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	tg := record.tg
+	if input.HealthCheckEnabled != nil {
+		tg.HealthCheckEnabled = input.HealthCheckEnabled
+	}
+	if input.HealthCheckProtocol != nil {
+		tg.HealthCheckProtocol = input.HealthCheckProtocol
+	}
+	if input.HealthCheckPort != nil {
+		tg.HealthCheckPort = input.HealthCheckPort
+	}
+	if input.HealthCheckPath != nil {
+		tg.HealthCheckPath = input.HealthCheckPath
+	}
+	if input.HealthCheckIntervalSeconds != nil {
+		tg.HealthCheckIntervalSeconds = input.HealthCheckIntervalSeconds
+	}
+	if input.HealthCheckTimeoutSeconds != nil {
+		tg.HealthCheckTimeoutSeconds = input.HealthCheckTimeoutSeconds
+	}
+	if input.HealthyThresholdCount != nil {
+		tg.HealthyThresholdCount = input.HealthyThresholdCount
+	}
+	if input.UnhealthyThresholdCount != nil {
+		tg.UnhealthyThresholdCount = input.UnhealthyThresholdCount
+	}
+	if input.Matcher != nil {
+		tg.Matcher = copyOf(input.Matcher).(*elbv2sdk.Matcher)
+	}
+	return &elbv2sdk.ModifyTargetGroupOutput{TargetGroups: []*elbv2sdk.TargetGroup{c.backend.buildTargetGroup(tg)}}, nil
+}
+
+func (c *fakeELBV2) DeleteTargetGroupWithContext(_ context.Context, input *elbv2sdk.DeleteTargetGroupInput, _ ...request.Option) (*elbv2sdk.DeleteTargetGroupOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DeleteTargetGroup")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	tgARN := awssdk.StringValue(input.TargetGroupArn)
+	// deleting a target group that doesn't exist succeeds, same as AWS.
+	if _, ok := c.backend.targetGroups[tgARN]; !ok {
+		return &elbv2sdk.DeleteTargetGroupOutput{}, nil
+	}
+	if len(c.backend.targetGroupLoadBalancerARNs(tgARN)) != 0 {
+		return nil, newAPIErrorf("ResourceInUse", "Target group '%v' is currently in use by a listener or a rule", tgARN)
+	}
+	delete(c.backend.targetGroups, tgARN)
+	return &elbv2sdk.DeleteTargetGroupOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTargetGroupsWithContext(_ context.Context, input *elbv2sdk.DescribeTargetGroupsInput, _ ...request.Option) (*elbv2sdk.DescribeTargetGroupsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeTargetGroups")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	tgs, err := c.backend.describeTargetGroups(input)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeTargetGroupsOutput{TargetGroups: tgs}, nil
+}
+
+func (c *fakeELBV2) DescribeTargetGroupsAsList(_ context.Context, input *elbv2sdk.DescribeTargetGroupsInput) ([]*elbv2sdk.TargetGroup, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeTargetGroups")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeTargetGroups(input)
+}
+
+func (c *fakeELBV2) DescribeTargetGroupAttributesWithContext(_ context.Context, input *elbv2sdk.DescribeTargetGroupAttributesInput, _ ...request.Option) (*elbv2sdk.DescribeTargetGroupAttributesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeTargetGroupAttributes")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeTargetGroupAttributesOutput{Attributes: buildTargetGroupAttributes(record.attributes)}, nil
+}
+
+func (c *fakeELBV2) ModifyTargetGroupAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyTargetGroupAttributesInput, _ ...request.Option) (*elbv2sdk.ModifyTargetGroupAttributesOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "ModifyTargetGroupAttributes")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range input.Attributes {
+		record.attributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+	}
+	return &elbv2sdk.ModifyTargetGroupAttributesOutput{Attributes: buildTargetGroupAttributes(record.attributes)}, nil
+}
+
+func (c *fakeELBV2) RegisterTargetsWithContext(_ context.Context, input *elbv2sdk.RegisterTargetsInput, _ ...request.Option) (*elbv2sdk.RegisterTargetsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "RegisterTargets")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range input.Targets {
+		if err := c.backend.validateTarget(record.tg, target); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateALBTargetLimit(record, input.Targets); err != nil {
+		return nil, err
+	}
+	for _, target := range input.Targets {
+		target = copyOf(target).(*elbv2sdk.TargetDescription)
+		if target.Port == nil {
+			target.Port = record.tg.Port
+		}
+		if findTarget(record.targets, target) == -1 {
+			record.targets = append(record.targets, &targetRecord{target: target})
+		}
+	}
+	return &elbv2sdk.RegisterTargetsOutput{}, nil
+}
+
+func (c *fakeELBV2) DeregisterTargetsWithContext(_ context.Context, input *elbv2sdk.DeregisterTargetsInput, _ ...request.Option) (*elbv2sdk.DeregisterTargetsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DeregisterTargets")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range input.Targets {
+		target = copyOf(target).(*elbv2sdk.TargetDescription)
+		if target.Port == nil {
+			target.Port = record.tg.Port
+		}
+		if idx := findTarget(record.targets, target); idx != -1 {
+			record.targets = append(record.targets[:idx], record.targets[idx+1:]...)
+		}
+	}
+	return &elbv2sdk.DeregisterTargetsOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTargetHealthWithContext(_ context.Context, input *elbv2sdk.DescribeTargetHealthInput, _ ...request.Option) (*elbv2sdk.DescribeTargetHealthOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeTargetHealth")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	tgARN := awssdk.StringValue(input.TargetGroupArn)
+	record, err := c.backend.findTargetGroup(tgARN)
+	if err != nil {
+		return nil, err
+	}
+	inUse := len(c.backend.targetGroupLoadBalancerARNs(tgARN)) != 0
+	var descriptions []*elbv2sdk.TargetHealthDescription
+	if len(input.Targets) == 0 {
+		for _, target := range record.targets {
+			descriptions = append(descriptions, buildTargetHealthDescription(record.tg, target, inUse))
+		}
+	} else {
+		for _, target := range input.Targets {
+			target = copyOf(target).(*elbv2sdk.TargetDescription)
+			if target.Port == nil {
+				target.Port = record.tg.Port
+			}
+			if idx := findTarget(record.targets, target); idx != -1 {
+				descriptions = append(descriptions, buildTargetHealthDescription(record.tg, record.targets[idx], inUse))
+				continue
+			}
+			descriptions = append(descriptions, &elbv2sdk.TargetHealthDescription{
+				Target: target,
+				TargetHealth: &elbv2sdk.TargetHealth{
+					State:       awssdk.String(elbv2sdk.TargetHealthStateEnumUnused),
+					Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetNotRegistered),
+					Description: awssdk.String("Target is not registered to the target group"),
+				},
+			})
+		}
+	}
+	return &elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: descriptions}, nil
+}
+
+func (c *fakeELBV2) CreateTrustStoreWithContext(_ context.Context, input *elbv2sdk.CreateTrustStoreInput, _ ...request.Option) (*elbv2sdk.CreateTrustStoreOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "CreateTrustStore")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	name := awssdk.StringValue(input.Name)
+	for _, record := range c.backend.trustStores {
+		if awssdk.StringValue(record.trustStore.Name) == name {
+			return nil, NewAPIError("DuplicateTrustStoreName", "A trust store with the specified name already exists")
+		}
+	}
+	if err := c.backend.validateCACertificatesBundle(input.CaCertificatesBundleS3Bucket, input.CaCertificatesBundleS3Key); err != nil {
+		return nil, err
+	}
+	if err := c.backend.validateTags(nil, input.Tags); err != nil {
+		return nil, err
+	}
+	tsARN := fmt.Sprintf("arn:aws:elasticloadbalancing:%v:%v:truststore/%v/%v", c.backend.region, c.backend.accountID, name, c.backend.nextID(16))
+	trustStore := &elbv2sdk.TrustStore{
+		TrustStoreArn:          awssdk.String(tsARN),
+		Name:                   awssdk.String(name),
+		Status:                 awssdk.String(elbv2sdk.TrustStoreStatusActive),
+		NumberOfCaCertificates: awssdk.Int64(1),
+		TotalRevokedEntries:    awssdk.Int64(0),
+	}
+	c.backend.trustStores[tsARN] = &trustStoreRecord{
+		trustStore: trustStore,
+		tags:       elbv2TagsAsMap(input.Tags),
+	}
+	return &elbv2sdk.CreateTrustStoreOutput{TrustStores: []*elbv2sdk.TrustStore{copyOf(trustStore).(*elbv2sdk.TrustStore)}}, nil
+}
+
+func (c *fakeELBV2) ModifyTrustStoreWithContext(_ context.Context, input *elbv2sdk.ModifyTrustStoreInput, _ ...request.Option) (*elbv2sdk.ModifyTrustStoreOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "ModifyTrustStore")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	record, err := c.backend.findTrustStore(awssdk.StringValue(input.TrustStoreArn))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.backend.validateCACertificatesBundle(input.CaCertificatesBundleS3Bucket, input.CaCertificatesBundleS3Key); err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.ModifyTrustStoreOutput{TrustStores: []*elbv2sdk.TrustStore{copyOf(record.trustStore).(*elbv2sdk.TrustStore)}}, nil
+}
+
+func (c *fakeELBV2) DeleteTrustStoreWithContext(_ context.Context, input *elbv2sdk.DeleteTrustStoreInput, _ ...request.Option) (*elbv2sdk.DeleteTrustStoreOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DeleteTrustStore")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	tsARN := awssdk.StringValue(input.TrustStoreArn)
+	if _, err := c.backend.findTrustStore(tsARN); err != nil {
+		return nil, err
+	}
+	for _, lsRecord := range c.backend.listeners {
+		if mutualAuth := lsRecord.listener.MutualAuthentication; mutualAuth != nil && awssdk.StringValue(mutualAuth.TrustStoreArn) == tsARN {
+			return nil, newAPIErrorf("TrustStoreInUse", "Trust store '%v' is currently in use by a listener", tsARN)
+		}
+	}
+	delete(c.backend.trustStores, tsARN)
+	return &elbv2sdk.DeleteTrustStoreOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTrustStoresWithContext(_ context.Context, input *elbv2sdk.DescribeTrustStoresInput, _ ...request.Option) (*elbv2sdk.DescribeTrustStoresOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeTrustStores")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	trustStores, err := c.backend.describeTrustStores(input)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeTrustStoresOutput{TrustStores: trustStores}, nil
+}
+
+func (c *fakeELBV2) DescribeTrustStoresAsList(_ context.Context, input *elbv2sdk.DescribeTrustStoresInput) ([]*elbv2sdk.TrustStore, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeTrustStores")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return c.backend.describeTrustStores(input)
+}
+
+func (c *fakeELBV2) AddTagsWithContext(_ context.Context, input *elbv2sdk.AddTagsInput, _ ...request.Option) (*elbv2sdk.AddTagsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "AddTags")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	var tagMaps []map[string]string
+	for _, resARN := range awssdk.StringValueSlice(input.ResourceArns) {
+		tags, err := c.backend.elbv2ResourceTags(resARN)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.backend.validateTags(tags, input.Tags); err != nil {
+			return nil, err
+		}
+		tagMaps = append(tagMaps, tags)
+	}
+	for _, tags := range tagMaps {
+		for _, tag := range input.Tags {
+			tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		}
+	}
+	return &elbv2sdk.AddTagsOutput{}, nil
+}
+
+func (c *fakeELBV2) RemoveTagsWithContext(_ context.Context, input *elbv2sdk.RemoveTagsInput, _ ...request.Option) (*elbv2sdk.RemoveTagsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "RemoveTags")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	var tagMaps []map[string]string
+	for _, resARN := range awssdk.StringValueSlice(input.ResourceArns) {
+		tags, err := c.backend.elbv2ResourceTags(resARN)
+		if err != nil {
+			return nil, err
+		}
+		tagMaps = append(tagMaps, tags)
+	}
+	for _, tags := range tagMaps {
+		for _, tagKey := range awssdk.StringValueSlice(input.TagKeys) {
+			delete(tags, tagKey)
+		}
+	}
+	return &elbv2sdk.RemoveTagsOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTagsWithContext(_ context.Context, input *elbv2sdk.DescribeTagsInput, _ ...request.Option) (*elbv2sdk.DescribeTagsOutput, error) {
+	release, err := c.backend.begin(ServiceELBV2, "DescribeTags")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	if len(input.ResourceArns) > describeTagsMaxResources {
+		return nil, newAPIErrorf("ValidationError", "A maximum of %v resources can be described", describeTagsMaxResources)
+	}
+	var tagDescriptions []*elbv2sdk.TagDescription
+	for _, resARN := range awssdk.StringValueSlice(input.ResourceArns) {
+		tags, err := c.backend.elbv2ResourceTags(resARN)
+		if err != nil {
+			return nil, err
+		}
+		tagDescriptions = append(tagDescriptions, &elbv2sdk.TagDescription{
+			ResourceArn: awssdk.String(resARN),
+			Tags:        elbv2TagsFromMap(tags),
+		})
+	}
+	return &elbv2sdk.DescribeTagsOutput{TagDescriptions: tagDescriptions}, nil
+}
+
+func (b *backend) findLoadBalancer(lbARN string) (*loadBalancerRecord, error) {
+	record, ok := b.loadBalancers[lbARN]
+	if !ok {
+		return nil, newAPIErrorf("LoadBalancerNotFound", "Load balancer '%v' not found", lbARN)
+	}
+	return record, nil
+}
+
+func (b *backend) findListener(lsARN string) (*listenerRecord, error) {
+	record, ok := b.listeners[lsARN]
+	if !ok {
+		return nil, newAPIErrorf("ListenerNotFound", "Listener '%v' not found", lsARN)
+	}
+	return record, nil
+}
+
+func (b *backend) findRule(ruleARN string) (*ruleRecord, error) {
+	record, ok := b.rules[ruleARN]
+	if !ok {
+		return nil, newAPIErrorf("RuleNotFound", "One or more rules not found")
+	}
+	return record, nil
+}
+
+func (b *backend) findTargetGroup(tgARN string) (*targetGroupRecord, error) {
+	record, ok := b.targetGroups[tgARN]
+	if !ok {
+		return nil, newAPIErrorf("TargetGroupNotFound", "Target groups '%v' not found", tgARN)
+	}
+	return record, nil
+}
+
+func (b *backend) findTrustStore(tsARN string) (*trustStoreRecord, error) {
+	record, ok := b.trustStores[tsARN]
+	if !ok {
+		return nil, newAPIErrorf("TrustStoreNotFound", "Trust store '%v' not found", tsARN)
+	}
+	return record, nil
+}
+
+// elbv2ResourceTags returns the tags of ELBV2 resource by ARN.
+func (b *backend) elbv2ResourceTags(resARN string) (map[string]string, error) {
+	switch arnResourceType(resARN) {
+	case "elasticloadbalancing:loadbalancer":
+		record, err := b.findLoadBalancer(resARN)
+		if err != nil {
+			return nil, err
+		}
+		return record.tags, nil
+	case "elasticloadbalancing:listener":
+		record, err := b.findListener(resARN)
+		if err != nil {
+			return nil, err
+		}
+		return record.tags, nil
+	case "elasticloadbalancing:listener-rule":
+		record, err := b.findRule(resARN)
+		if err != nil {
+			return nil, err
+		}
+		return record.tags, nil
+	case "elasticloadbalancing:targetgroup":
+		record, err := b.findTargetGroup(resARN)
+		if err != nil {
+			return nil, err
+		}
+		return record.tags, nil
+	case "elasticloadbalancing:truststore":
+		record, err := b.findTrustStore(resARN)
+		if err != nil {
+			return nil, err
+		}
+		return record.tags, nil
+	}
+	return nil, newAPIErrorf("ValidationError", "'%v' is not a valid load balancer, target group, listener, rule or trust store ARN", resARN)
+}
+
+// validateTags checks whether newTags can be added to a resource with existing tags.
+func (b *backend) validateTags(existingTags map[string]string, newTags []*elbv2sdk.Tag) error {
+	tagKeys := sets.StringKeySet(existingTags)
+	for _, tag := range newTags {
+		tagKey := awssdk.StringValue(tag.Key)
+		if strings.HasPrefix(tagKey, "aws:") {
+			return newAPIErrorf("ValidationError", "Tag key '%v' cannot start with 'aws:'", tagKey)
+		}
+		tagKeys.Insert(tagKey)
+	}
+	if len(tagKeys) > elbv2MaxTagsPerResource {
+		return NewAPIError("TooManyTags", "The quota for the number of tags that can be assigned to a resource has been reached")
+	}
+	return nil
+}
+
+// buildLoadBalancerAZs builds the availability zones of load balancer from subnets.
+func (b *backend) buildLoadBalancerAZs(lbType string, subnetIDs []*string, subnetMappings []*elbv2sdk.SubnetMapping) (string, []*elbv2sdk.AvailabilityZone, error) {
+	if len(subnetMappings) == 0 {
+		for _, subnetID := range subnetIDs {
+			subnetMappings = append(subnetMappings, &elbv2sdk.SubnetMapping{SubnetId: subnetID})
+		}
+	}
+	if len(subnetMappings) == 0 {
+		return "", nil, NewAPIError("ValidationError", "At least one subnet must be specified")
+	}
+	vpcID := ""
+	azNames := sets.NewString()
+	var azs []*elbv2sdk.AvailabilityZone
+	for _, mapping := range subnetMappings {
+		subnetID := awssdk.StringValue(mapping.SubnetId)
+		record, ok := b.subnets[subnetID]
+		if !ok {
+			return "", nil, newAPIErrorf("SubnetNotFound", "The subnet ID '%v' is not valid", subnetID)
+		}
+		subnet := record.subnet
+		if len(vpcID) == 0 {
+			vpcID = awssdk.StringValue(subnet.VpcId)
+		} else if vpcID != awssdk.StringValue(subnet.VpcId) {
+			return "", nil, NewAPIError("InvalidConfigurationRequest", "The subnets must belong to the same VPC")
+		}
+		azName := awssdk.StringValue(subnet.AvailabilityZone)
+		if azNames.Has(azName) {
+			return "", nil, newAPIErrorf("InvalidConfigurationRequest", "A load balancer cannot be attached to multiple subnets in the same Availability Zone '%v'", azName)
+		}
+		azNames.Insert(azName)
+		az := &elbv2sdk.AvailabilityZone{
+			ZoneName: subnet.AvailabilityZone,
+			SubnetId: subnet.SubnetId,
+		}
+		if mapping.AllocationId != nil || mapping.PrivateIPv4Address != nil || mapping.IPv6Address != nil {
+			az.LoadBalancerAddresses = []*elbv2sdk.LoadBalancerAddress{
+				{
+					AllocationId:       mapping.AllocationId,
+					PrivateIPv4Address: mapping.PrivateIPv4Address,
+					IPv6Address:        mapping.IPv6Address,
+				},
+			}
+		}
+		azs = append(azs, az)
+	}
+	if lbType == elbv2sdk.LoadBalancerTypeEnumApplication && len(azNames) < 2 {
+		return "", nil, NewAPIError("ValidationError", "At least two subnets in two different Availability Zones must be specified")
+	}
+	sort.Slice(azs, func(i, j int) bool {
+		return awssdk.StringValue(azs[i].ZoneName) < awssdk.StringValue(azs[j].ZoneName)
+	})
+	return vpcID, azs, nil
+}
+
+// validateLoadBalancerSecurityGroups checks whether securityGroups exist in VPC.
+func (b *backend) validateLoadBalancerSecurityGroups(vpcID string, sgIDs []string) error {
+	for _, sgID := range sgIDs {
+		sg, ok := b.securityGroups[sgID]
+		if !ok || awssdk.StringValue(sg.VpcId) != vpcID {
+			return newAPIErrorf("InvalidSecurityGroup", "Security group '%v' does not exist in VPC '%v'", sgID, vpcID)
+		}
+	}
+	return nil
+}
+
+// validateListener checks whether listener settings are valid for load balancer.
+func (b *backend) validateListener(lbRecord *loadBalancerRecord, listener *elbv2sdk.Listener) error {
+	protocol := awssdk.StringValue(listener.Protocol)
+	lbType := awssdk.StringValue(lbRecord.lb.Type)
+	var supportedProtocols []string
+	switch lbType {
+	case elbv2sdk.LoadBalancerTypeEnumApplication:
+		supportedProtocols = []string{elbv2sdk.ProtocolEnumHttp, elbv2sdk.ProtocolEnumHttps}
+	case elbv2sdk.LoadBalancerTypeEnumNetwork:
+		supportedProtocols = []string{elbv2sdk.ProtocolEnumTcp, elbv2sdk.ProtocolEnumTls, elbv2sdk.ProtocolEnumUdp, elbv2sdk.ProtocolEnumTcpUdp}
+	}
+	if !sets.NewString(supportedProtocols...).Has(protocol) {
+		return newAPIErrorf("UnsupportedProtocol", "Protocol '%v' is not supported for load balancer type '%v'", protocol, lbType)
+	}
+	port := awssdk.Int64Value(listener.Port)
+	if port < 1 || port > 65535 {
+		return newAPIErrorf("ValidationError", "Listener port '%v' must be between 1 and 65535", port)
+	}
+	if isSecureProtocol(protocol) {
+		if len(listener.Certificates) != 1 {
+			return NewAPIError("ValidationError", "A certificate must be specified for HTTPS and TLS listeners")
+		}
+		if err := b.validateCertificates(listener.Certificates); err != nil {
+			return err
+		}
+	}
+	if mutualAuth := listener.MutualAuthentication; mutualAuth != nil && mutualAuth.TrustStoreArn != nil {
+		if _, err := b.findTrustStore(awssdk.StringValue(mutualAuth.TrustStoreArn)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCertificates checks whether ACM certificates exist.
+// certificates managed by other services, e.g. IAM server certificates, are not validated.
+func (b *backend) validateCertificates(certs []*elbv2sdk.Certificate) error {
+	for _, cert := range certs {
+		certARN := awssdk.StringValue(cert.CertificateArn)
+		if arnResourceType(certARN) != "acm:certificate" {
+			continue
+		}
+		if _, ok := b.certificates[certARN]; !ok {
+			return newAPIErrorf("CertificateNotFound", "Certificate '%v' not found", certARN)
+		}
+	}
+	return nil
+}
+
+// validateActions checks whether actions are valid for load balancer.
+func (b *backend) validateActions(lbRecord *loadBalancerRecord, actions []*elbv2sdk.Action) error {
+	if len(actions) == 0 {
+		return NewAPIError("ValidationError", "An action must be specified")
+	}
+	lbARN := awssdk.StringValue(lbRecord.lb.LoadBalancerArn)
+	lbType := awssdk.StringValue(lbRecord.lb.Type)
+	for _, tgARN := range actionsTargetGroupARNs(actions) {
+		tgRecord, err := b.findTargetGroup(tgARN)
+		if err != nil {
+			return err
+		}
+		if awssdk.StringValue(tgRecord.tg.TargetType) != elbv2sdk.TargetTypeEnumLambda &&
+			awssdk.StringValue(tgRecord.tg.VpcId) != awssdk.StringValue(lbRecord.lb.VpcId) {
+			return newAPIErrorf("InvalidConfigurationRequest", "Target group '%v' is not in the same VPC as load balancer", tgARN)
+		}
+		tgProtocol := awssdk.StringValue(tgRecord.tg.Protocol)
+		if (lbType == elbv2sdk.LoadBalancerTypeEnumApplication) != isHTTPProtocol(tgProtocol) &&
+			awssdk.StringValue(tgRecord.tg.TargetType) != elbv2sdk.TargetTypeEnumLambda {
+			return newAPIErrorf("IncompatibleProtocols", "The protocol '%v' of target group '%v' is not supported by load balancer type '%v'", tgProtocol, tgARN, lbType)
+		}
+		for _, otherLBARN := range b.targetGroupLoadBalancerARNs(tgARN) {
+			if otherLBARN != lbARN {
+				return newAPIErrorf("TargetGroupAssociationLimit", "The following target groups cannot be associated with more than one load balancer: %v", tgARN)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRulePriority checks whether priority is available for a rule of listener.
+// overridePriorities contains rule priorities that are being changed.
+func (b *backend) validateRulePriority(lsARN string, priority int64, overridePriorities map[string]int64) error {
+	if priority < 1 || priority > 50000 {
+		return newAPIErrorf("ValidationError", "Priority '%v' must be between 1 and 50000", priority)
+	}
+	count := 0
+	for ruleARN, record := range b.rules {
+		if record.listenerARN != lsARN || awssdk.BoolValue(record.rule.IsDefault) {
+			continue
+		}
+		rulePriority, _ := strconv.ParseInt(awssdk.StringValue(record.rule.Priority), 10, 64)
+		if override, ok := overridePriorities[ruleARN]; ok {
+			rulePriority = override
+		}
+		if rulePriority == priority {
+			count++
+		}
+	}
+	// when validating an override, the rule itself has the priority.
+	if (overridePriorities == nil && count > 0) || count > 1 {
+		return newAPIErrorf("PriorityInUse", "Priority '%v' is currently in use", priority)
+	}
+	return nil
+}
+
+// validateTarget checks whether target can be registered into targetGroup.
+func (b *backend) validateTarget(tg *elbv2sdk.TargetGroup, target *elbv2sdk.TargetDescription) error {
+	targetID := awssdk.StringValue(target.Id)
+	switch awssdk.StringValue(tg.TargetType) {
+	case elbv2sdk.TargetTypeEnumInstance:
+		instance, ok := b.instances[targetID]
+		if !ok || awssdk.StringValue(instance.VpcId) != awssdk.StringValue(tg.VpcId) {
+			return newAPIErrorf("InvalidTarget", "The following targets are not in the target group VPC '%v': '%v'", awssdk.StringValue(tg.VpcId), targetID)
+		}
+	case elbv2sdk.TargetTypeEnumIp:
+		ip := net.ParseIP(targetID)
+		if ip == nil {
+			return newAPIErrorf("ValidationError", "'%v' is not a valid IP address", targetID)
+		}
+		if (ip.To4() == nil) != (awssdk.StringValue(tg.IpAddressType) == elbv2sdk.TargetGroupIpAddressTypeEnumIpv6) {
+			return newAPIErrorf("ValidationError", "The IP address type of target '%v' does not match the IP address type of target group", targetID)
+		}
+		if awssdk.StringValue(target.AvailabilityZone) == "all" || ip.To4() == nil {
+			return nil
+		}
+		vpc, ok := b.vpcs[awssdk.StringValue(tg.VpcId)]
+		if !ok {
+			return nil
+		}
+		for _, cidrAssociation := range vpc.CidrBlockAssociationSet {
+			if _, ipNet, err := net.ParseCIDR(awssdk.StringValue(cidrAssociation.CidrBlock)); err == nil && ipNet.Contains(ip) {
+				return nil
+			}
+		}
+		return newAPIErrorf("ValidationError", "The IP address '%v' is not within the VPC CIDR and the Availability Zone is not 'all'", targetID)
+	case elbv2sdk.TargetTypeEnumAlb:
+		record, ok := b.loadBalancers[targetID]
+		if !ok || awssdk.StringValue(record.lb.Type) != elbv2sdk.LoadBalancerTypeEnumApplication {
+			return newAPIErrorf("InvalidTarget", "The target '%v' is not a valid application load balancer", targetID)
+		}
+	}
+	return nil
+}
+
+// validateALBTargetLimit checks a targetGroup with TargetType alb will have at most one Application LoadBalancer registered.
+func validateALBTargetLimit(record *targetGroupRecord, targets []*elbv2sdk.TargetDescription) error {
+	if awssdk.StringValue(record.tg.TargetType) != elbv2sdk.TargetTypeEnumAlb {
+		return nil
+	}
+	lbARNs := sets.NewString()
+	for _, targetRecord := range record.targets {
+		lbARNs.Insert(awssdk.StringValue(targetRecord.target.Id))
+	}
+	for _, target := range targets {
+		lbARNs.Insert(awssdk.StringValue(target.Id))
+	}
+	if lbARNs.Len() > 1 {
+		return newAPIErrorf("TooManyTargets", "You've reached the limit on the number of targets registered to target group '%v'", awssdk.StringValue(record.tg.TargetGroupArn))
+	}
+	return nil
+}
+
+// validateCACertificatesBundle checks whether the CA certificates bundle exists in S3.
+func (b *backend) validateCACertificatesBundle(bucket *string, key *string) error {
+	objects, ok := b.buckets[awssdk.StringValue(bucket)]
+	if !ok {
+		return newAPIErrorf("CaCertificatesBundleNotFound", "The specified ca certificate bundle does not exist: s3://%v/%v", awssdk.StringValue(bucket), awssdk.StringValue(key))
+	}
+	if _, ok := objects[awssdk.StringValue(key)]; !ok {
+		return newAPIErrorf("CaCertificatesBundleNotFound", "The specified ca certificate bundle does not exist: s3://%v/%v", awssdk.StringValue(bucket), awssdk.StringValue(key))
+	}
+	return nil
+}
+
+// deleteListener deletes listener along with its rules.
+func (b *backend) deleteListener(lsARN string) {
+	for ruleARN, record := range b.rules {
+		if record.listenerARN == lsARN {
+			delete(b.rules, ruleARN)
+		}
+	}
+	delete(b.listeners, lsARN)
+}
+
+// targetGroupLoadBalancerARNs computes the ARNs of load balancers that route traffic to targetGroup.
+func (b *backend) targetGroupLoadBalancerARNs(tgARN string) []string {
+	lbARNs := sets.NewString()
+	for _, record := range b.rules {
+		if sets.NewString(actionsTargetGroupARNs(record.rule.Actions)...).Has(tgARN) {
+			lsRecord := b.listeners[record.listenerARN]
+			lbARNs.Insert(awssdk.StringValue(lsRecord.listener.LoadBalancerArn))
+		}
+	}
+	return lbARNs.List()
+}
+
+func (b *backend) describeLoadBalancers(input *elbv2sdk.DescribeLoadBalancersInput) ([]*elbv2sdk.LoadBalancer, error) {
+	lbARNs := sets.NewString(awssdk.StringValueSlice(input.LoadBalancerArns)...)
+	names := sets.NewString(awssdk.StringValueSlice(input.Names)...)
+	var lbs []*elbv2sdk.LoadBalancer
+	for _, lbARN := range sets.StringKeySet(b.loadBalancers).List() {
+		lb := b.loadBalancers[lbARN].lb
+		if len(lbARNs) != 0 && !lbARNs.Has(lbARN) {
+			continue
+		}
+		if len(names) != 0 && !names.Has(awssdk.StringValue(lb.LoadBalancerName)) {
+			continue
+		}
+		lbs = append(lbs, copyOf(lb).(*elbv2sdk.LoadBalancer))
+	}
+	if len(lbs) < len(lbARNs)+len(names) {
+		return nil, NewAPIError("LoadBalancerNotFound", "One or more load balancers not found")
+	}
+	return lbs, nil
+}
+
+func (b *backend) describeListeners(input *elbv2sdk.DescribeListenersInput) ([]*elbv2sdk.Listener, error) {
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	if len(lbARN) != 0 {
+		if _, err := b.findLoadBalancer(lbARN); err != nil {
+			return nil, err
+		}
+	}
+	lsARNs := sets.NewString(awssdk.StringValueSlice(input.ListenerArns)...)
+	var listeners []*elbv2sdk.Listener
+	for _, lsARN := range sets.StringKeySet(b.listeners).List() {
+		listener := b.listeners[lsARN].listener
+		if len(lbARN) != 0 && awssdk.StringValue(listener.LoadBalancerArn) != lbARN {
+			continue
+		}
+		if len(lsARNs) != 0 && !lsARNs.Has(lsARN) {
+			continue
+		}
+		listeners = append(listeners, copyOf(listener).(*elbv2sdk.Listener))
+	}
+	if len(listeners) < len(lsARNs) {
+		return nil, NewAPIError("ListenerNotFound", "One or more listeners not found")
+	}
+	return listeners, nil
+}
+
+func (b *backend) describeListenerCertificates(input *elbv2sdk.DescribeListenerCertificatesInput) ([]*elbv2sdk.Certificate, error) {
+	record, err := b.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	var certs []*elbv2sdk.Certificate
+	for _, cert := range record.listener.Certificates {
+		certs = append(certs, &elbv2sdk.Certificate{CertificateArn: cert.CertificateArn, IsDefault: awssdk.Bool(true)})
+	}
+	for _, cert := range record.extraCertificates {
+		certs = append(certs, &elbv2sdk.Certificate{CertificateArn: cert.CertificateArn, IsDefault: awssdk.Bool(false)})
+	}
+	return copyOf(certs).([]*elbv2sdk.Certificate), nil
+}
+
+func (b *backend) describeRules(input *elbv2sdk.DescribeRulesInput) ([]*elbv2sdk.Rule, error) {
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	if len(lsARN) != 0 {
+		if _, err := b.findListener(lsARN); err != nil {
+			return nil, err
+		}
+	}
+	ruleARNs := sets.NewString(awssdk.StringValueSlice(input.RuleArns)...)
+	var records []*ruleRecord
+	for _, record := range b.rules {
+		if len(lsARN) != 0 && record.listenerARN != lsARN {
+			continue
+		}
+		if len(ruleARNs) != 0 && !ruleARNs.Has(awssdk.StringValue(record.rule.RuleArn)) {
+			continue
+		}
+		records = append(records, record)
+	}
+	if len(records) < len(ruleARNs) {
+		return nil, NewAPIError("RuleNotFound", "One or more rules not found")
+	}
+	// rules are ordered by listener and priority, with the default rule evaluated last.
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].listenerARN != records[j].listenerARN {
+			return records[i].listenerARN < records[j].listenerARN
+		}
+		if awssdk.BoolValue(records[i].rule.IsDefault) != awssdk.BoolValue(records[j].rule.IsDefault) {
+			return awssdk.BoolValue(records[j].rule.IsDefault)
+		}
+		iPriority, _ := strconv.ParseInt(awssdk.StringValue(records[i].rule.Priority), 10, 64)
+		jPriority, _ := strconv.ParseInt(awssdk.StringValue(records[j].rule.Priority), 10, 64)
+		return iPriority < jPriority
+	})
+	var rules []*elbv2sdk.Rule
+	for _, record := range records {
+		rules = append(rules, copyOf(record.rule).(*elbv2sdk.Rule))
+	}
+	return rules, nil
+}
+
+func (b *backend) describeTargetGroups(input *elbv2sdk.DescribeTargetGroupsInput) ([]*elbv2sdk.TargetGroup, error) {
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	if len(lbARN) != 0 {
+		if _, err := b.findLoadBalancer(lbARN); err != nil {
+			return nil, err
+		}
+	}
+	tgARNs := sets.NewString(awssdk.StringValueSlice(input.TargetGroupArns)...)
+	names := sets.NewString(awssdk.StringValueSlice(input.Names)...)
+	var tgs []*elbv2sdk.TargetGroup
+	for _, tgARN := range sets.StringKeySet(b.targetGroups).List() {
+		tg := b.targetGroups[tgARN].tg
+		if len(tgARNs) != 0 && !tgARNs.Has(tgARN) {
+			continue
+		}
+		if len(names) != 0 && !names.Has(awssdk.StringValue(tg.TargetGroupName)) {
+			continue
+		}
+		sdkTG := b.buildTargetGroup(tg)
+		if len(lbARN) != 0 && !sets.NewString(awssdk.StringValueSlice(sdkTG.LoadBalancerArns)...).Has(lbARN) {
+			continue
+		}
+		tgs = append(tgs, sdkTG)
+	}
+	if len(tgs) < len(tgARNs)+len(names) {
+		return nil, NewAPIError("TargetGroupNotFound", "One or more target groups not found")
+	}
+	return tgs, nil
+}
+
+func (b *backend) describeTrustStores(input *elbv2sdk.DescribeTrustStoresInput) ([]*elbv2sdk.TrustStore, error) {
+	tsARNs := sets.NewString(awssdk.StringValueSlice(input.TrustStoreArns)...)
+	names := sets.NewString(awssdk.StringValueSlice(input.Names)...)
+	var trustStores []*elbv2sdk.TrustStore
+	for _, tsARN := range sets.StringKeySet(b.trustStores).List() {
+		trustStore := b.trustStores[tsARN].trustStore
+		if len(tsARNs) != 0 && !tsARNs.Has(tsARN) {
+			continue
+		}
+		if len(names) != 0 && !names.Has(awssdk.StringValue(trustStore.Name)) {
+			continue
+		}
+		trustStores = append(trustStores, copyOf(trustStore).(*elbv2sdk.TrustStore))
+	}
+	if len(trustStores) < len(tsARNs)+len(names) {
+		return nil, NewAPIError("TrustStoreNotFound", "One or more trust stores not found")
+	}
+	return trustStores, nil
+}
+
+// buildTargetGroup builds a copy of targetGroup, with the load balancers that route traffic to it.
+func (b *backend) buildTargetGroup(tg *elbv2sdk.TargetGroup) *elbv2sdk.TargetGroup {
+	tgCopy := copyOf(tg).(*elbv2sdk.TargetGroup)
+	tgCopy.LoadBalancerArns = awssdk.StringSlice(b.targetGroupLoadBalancerARNs(awssdk.StringValue(tg.TargetGroupArn)))
+	return tgCopy
+}
+
+// buildTargetHealthDescription builds the health description for a registered target.
+// targets are unused if the targetGroup isn't used by any load balancer, otherwise they are healthy unless overridden.
+func buildTargetHealthDescription(tg *elbv2sdk.TargetGroup, target *targetRecord, inUse bool) *elbv2sdk.TargetHealthDescription {
+	healthCheckPort := awssdk.StringValue(tg.HealthCheckPort)
+	if healthCheckPort == "traffic-port" {
+		healthCheckPort = strconv.FormatInt(awssdk.Int64Value(target.target.Port), 10)
+	}
+	health := &elbv2sdk.TargetHealth{State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy)}
+	switch {
+	case !inUse:
+		health = &elbv2sdk.TargetHealth{
+			State:       awssdk.String(elbv2sdk.TargetHealthStateEnumUnused),
+			Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetNotInUse),
+			Description: awssdk.String("Target group is not configured to receive traffic from the load balancer"),
+		}
+	case target.health != nil:
+		health = target.health
+	}
+	return &elbv2sdk.TargetHealthDescription{
+		Target:          copyOf(target.target).(*elbv2sdk.TargetDescription),
+		HealthCheckPort: awssdk.String(healthCheckPort),
+		TargetHealth:    copyOf(health).(*elbv2sdk.TargetHealth),
+	}
+}
+
+// findTarget returns the index of target in targets, or -1 if not found.
+func findTarget(targets []*targetRecord, target *elbv2sdk.TargetDescription) int {
+	for idx, candidate := range targets {
+		if awssdk.StringValue(candidate.target.Id) == awssdk.StringValue(target.Id) &&
+			awssdk.Int64Value(candidate.target.Port) == awssdk.Int64Value(target.Port) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// findCertificate returns the index of certificate in certs, or -1 if not found.
+func findCertificate(certs []*elbv2sdk.Certificate, certARN string) int {
+	for idx, cert := range certs {
+		if awssdk.StringValue(cert.CertificateArn) == certARN {
+			return idx
+		}
+	}
+	return -1
+}
+
+// actionsTargetGroupARNs returns the ARNs of targetGroups referenced by actions.
+func actionsTargetGroupARNs(actions []*elbv2sdk.Action) []string {
+	tgARNs := sets.NewString()
+	for _, action := range actions {
+		if action.TargetGroupArn != nil {
+			tgARNs.Insert(awssdk.StringValue(action.TargetGroupArn))
+		}
+		if action.ForwardConfig != nil {
+			for _, tgTuple := range action.ForwardConfig.TargetGroups {
+				tgARNs.Insert(awssdk.StringValue(tgTuple.TargetGroupArn))
+			}
+		}
+	}
+	return tgARNs.List()
+}
+
+// normalizeActions fills in the action fields that AWS computes, i.e. the order of actions, and both the targetGroup
+// ARN and forward config for forward actions with a single targetGroup.
+func normalizeActions(actions []*elbv2sdk.Action) {
+	for idx, action := range actions {
+		if action.Order == nil {
+			action.Order = awssdk.Int64(int64(idx + 1))
+		}
+		if awssdk.StringValue(action.Type) != elbv2sdk.ActionTypeEnumForward {
+			continue
+		}
+		if action.TargetGroupArn == nil && action.ForwardConfig != nil && len(action.ForwardConfig.TargetGroups) == 1 {
+			action.TargetGroupArn = action.ForwardConfig.TargetGroups[0].TargetGroupArn
+		}
+		if action.TargetGroupArn != nil && action.ForwardConfig == nil {
+			action.ForwardConfig = &elbv2sdk.ForwardActionConfig{
+				TargetGroups: []*elbv2sdk.TargetGroupTuple{
+					{TargetGroupArn: action.TargetGroupArn, Weight: awssdk.Int64(1)},
+				},
+				TargetGroupStickinessConfig: &elbv2sdk.TargetGroupStickinessConfig{Enabled: awssdk.Bool(false)},
+			}
+		}
+	}
+}
+
+func defaultLoadBalancerAttributes(lbType string) map[string]string {
+	attributes := map[string]string{
+		"access_logs.s3.enabled":      "false",
+		"access_logs.s3.bucket":       "",
+		"access_logs.s3.prefix":       "",
+		"deletion_protection.enabled": "false",
+	}
+	switch lbType {
+	case elbv2sdk.LoadBalancerTypeEnumApplication:
+		attributes["idle_timeout.timeout_seconds"] = "60"
+		attributes["routing.http2.enabled"] = "true"
+		attributes["routing.http.drop_invalid_header_fields.enabled"] = "false"
+	case elbv2sdk.LoadBalancerTypeEnumNetwork:
+		attributes["load_balancing.cross_zone.enabled"] = "false"
+	}
+	return attributes
+}
+
+func defaultTargetGroupAttributes(protocol string, targetType string) map[string]string {
+	attributes := map[string]string{
+		"deregistration_delay.timeout_seconds": "300",
+		"stickiness.enabled":                   "false",
+	}
+	if isHTTPProtocol(protocol) {
+		attributes["stickiness.type"] = "lb_cookie"
+		attributes["stickiness.lb_cookie.duration_seconds"] = "86400"
+		attributes["slow_start.duration_seconds"] = "0"
+		attributes["load_balancing.algorithm.type"] = "round_robin"
+	} else if targetType != elbv2sdk.TargetTypeEnumLambda {
+		attributes["stickiness.type"] = "source_ip"
+		attributes["proxy_protocol_v2.enabled"] = "false"
+		attributes["preserve_client_ip.enabled"] = strconv.FormatBool(targetType == elbv2sdk.TargetTypeEnumInstance)
+	}
+	return attributes
+}
+
+func buildLoadBalancerAttributes(attributes map[string]string) []*elbv2sdk.LoadBalancerAttribute {
+	var sdkAttrs []*elbv2sdk.LoadBalancerAttribute
+	for _, key := range sets.StringKeySet(attributes).List() {
+		sdkAttrs = append(sdkAttrs, &elbv2sdk.LoadBalancerAttribute{Key: awssdk.String(key), Value: awssdk.String(attributes[key])})
+	}
+	return sdkAttrs
+}
+
+func buildTargetGroupAttributes(attributes map[string]string) []*elbv2sdk.TargetGroupAttribute {
+	var sdkAttrs []*elbv2sdk.TargetGroupAttribute
+	for _, key := range sets.StringKeySet(attributes).List() {
+		sdkAttrs = append(sdkAttrs, &elbv2sdk.TargetGroupAttribute{Key: awssdk.String(key), Value: awssdk.String(attributes[key])})
+	}
+	return sdkAttrs
+}
+
+func defaultHealthCheckProtocol(protocol string) string {
+	if isHTTPProtocol(protocol) {
+		return protocol
+	}
+	return elbv2sdk.ProtocolEnumTcp
+}
+
+func isHTTPProtocol(protocol string) bool {
+	return protocol == elbv2sdk.ProtocolEnumHttp || protocol == elbv2sdk.ProtocolEnumHttps
+}
+
+func isSecureProtocol(protocol string) bool {
+	return protocol == elbv2sdk.ProtocolEnumHttps || protocol == elbv2sdk.ProtocolEnumTls
+}
+
+func lbTypeShortName(lbType string) string {
+	switch lbType {
+	case elbv2sdk.LoadBalancerTypeEnumNetwork:
+		return "net"
+	case elbv2sdk.LoadBalancerTypeEnumGateway:
+		return "gwy"
+	default:
+		return "app"
+	}
+}
+
+func elbv2TagsAsMap(tags []*elbv2sdk.Tag) map[string]string {
+	tagMap := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		tagMap[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+func elbv2TagsFromMap(tagMap map[string]string) []*elbv2sdk.Tag {
+	tags := make([]*elbv2sdk.Tag, 0, len(tagMap))
+	for _, key := range sets.StringKeySet(tagMap).List() {
+		tags = append(tags, &elbv2sdk.Tag{Key: awssdk.String(key), Value: awssdk.String(tagMap[key])})
+	}
+	return tags
+}
@@ -0,0 +1,207 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fakeELBV2_CreateLoadBalancerWithContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       func(subnetIDs []string) *elbv2sdk.CreateLoadBalancerInput
+		wantErrCode string
+	}{
+		{
+			name: "application load balancer in two availability zones",
+			input: func(subnetIDs []string) *elbv2sdk.CreateLoadBalancerInput {
+				return &elbv2sdk.CreateLoadBalancerInput{
+					Name:    awssdk.String("my-alb"),
+					Subnets: awssdk.StringSlice(subnetIDs[0:2]),
+				}
+			},
+		},
+		{
+			name: "application load balancer in single availability zone",
+			input: func(subnetIDs []string) *elbv2sdk.CreateLoadBalancerInput {
+				return &elbv2sdk.CreateLoadBalancerInput{
+					Name:    awssdk.String("my-alb"),
+					Subnets: awssdk.StringSlice(subnetIDs[0:1]),
+				}
+			},
+			wantErrCode: "ValidationError",
+		},
+		{
+			name: "network load balancer in single availability zone",
+			input: func(subnetIDs []string) *elbv2sdk.CreateLoadBalancerInput {
+				return &elbv2sdk.CreateLoadBalancerInput{
+					Name:    awssdk.String("my-nlb"),
+					Type:    awssdk.String(elbv2sdk.LoadBalancerTypeEnumNetwork),
+					Subnets: awssdk.StringSlice(subnetIDs[0:1]),
+				}
+			},
+		},
+		{
+			name: "duplicate load balancer name",
+			input: func(subnetIDs []string) *elbv2sdk.CreateLoadBalancerInput {
+				return &elbv2sdk.CreateLoadBalancerInput{
+					Name:    awssdk.String("existing-lb"),
+					Subnets: awssdk.StringSlice(subnetIDs[0:2]),
+				}
+			},
+			wantErrCode: "DuplicateLoadBalancerName",
+		},
+		{
+			name: "unknown subnet",
+			input: func(subnetIDs []string) *elbv2sdk.CreateLoadBalancerInput {
+				return &elbv2sdk.CreateLoadBalancerInput{
+					Name:    awssdk.String("my-alb"),
+					Subnets: awssdk.StringSlice([]string{subnetIDs[0], "subnet-unknown"}),
+				}
+			},
+			wantErrCode: "SubnetNotFound",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := NewCloud("us-west-2")
+			subnetIDs := createSubnets(t, cloud)
+			_, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+				Name:    awssdk.String("existing-lb"),
+				Subnets: awssdk.StringSlice(subnetIDs[1:3]),
+			})
+			require.NoError(t, err)
+
+			resp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, tt.input(subnetIDs))
+			if tt.wantErrCode != "" {
+				assertAPIErrorCode(t, tt.wantErrCode, err)
+				return
+			}
+			require.NoError(t, err)
+			lbs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+				LoadBalancerArns: []*string{resp.LoadBalancers[0].LoadBalancerArn},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, resp.LoadBalancers, lbs)
+			assert.Equal(t, cloud.VpcID(), awssdk.StringValue(lbs[0].VpcId))
+		})
+	}
+}
+
+func Test_fakeELBV2_TargetGroupLifecycle(t *testing.T) {
+	ctx := context.Background()
+	cloud := NewCloud("us-west-2")
+	subnetIDs := createSubnets(t, cloud)
+	lbResp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+		Name:    awssdk.String("my-alb"),
+		Subnets: awssdk.StringSlice(subnetIDs),
+	})
+	require.NoError(t, err)
+	lbARN := lbResp.LoadBalancers[0].LoadBalancerArn
+	tgResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("my-tg"),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		Port:       awssdk.Int64(8080),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumIp),
+		VpcId:      awssdk.String(cloud.VpcID()),
+	})
+	require.NoError(t, err)
+	tgARN := tgResp.TargetGroups[0].TargetGroupArn
+	_, err = cloud.ELBV2().RegisterTargetsWithContext(ctx, &elbv2sdk.RegisterTargetsInput{
+		TargetGroupArn: tgARN,
+		Targets:        []*elbv2sdk.TargetDescription{{Id: awssdk.String("192.168.0.10"), Port: awssdk.Int64(8080)}},
+	})
+	require.NoError(t, err)
+
+	// targets are unused until target group is referenced by a listener.
+	assertTargetHealthState(t, cloud, tgARN, elbv2sdk.TargetHealthStateEnumUnused)
+	lsResp, err := cloud.ELBV2().CreateListenerWithContext(ctx, &elbv2sdk.CreateListenerInput{
+		LoadBalancerArn: lbARN,
+		Protocol:        awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		Port:            awssdk.Int64(80),
+		DefaultActions: []*elbv2sdk.Action{
+			{Type: awssdk.String(elbv2sdk.ActionTypeEnumForward), TargetGroupArn: tgARN},
+		},
+	})
+	require.NoError(t, err)
+	assertTargetHealthState(t, cloud, tgARN, elbv2sdk.TargetHealthStateEnumHealthy)
+	require.NoError(t, cloud.SetTargetHealth(awssdk.StringValue(tgARN), "192.168.0.10", 8080, &elbv2sdk.TargetHealth{
+		State:  awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
+		Reason: awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetFailedHealthChecks),
+	}))
+	assertTargetHealthState(t, cloud, tgARN, elbv2sdk.TargetHealthStateEnumUnhealthy)
+
+	_, err = cloud.ELBV2().DeleteTargetGroupWithContext(ctx, &elbv2sdk.DeleteTargetGroupInput{TargetGroupArn: tgARN})
+	assertAPIErrorCode(t, "ResourceInUse", err)
+	_, err = cloud.ELBV2().DeleteListenerWithContext(ctx, &elbv2sdk.DeleteListenerInput{ListenerArn: lsResp.Listeners[0].ListenerArn})
+	require.NoError(t, err)
+	_, err = cloud.ELBV2().DeleteTargetGroupWithContext(ctx, &elbv2sdk.DeleteTargetGroupInput{TargetGroupArn: tgARN})
+	require.NoError(t, err)
+	_, err = cloud.ELBV2().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{TargetGroupArns: []*string{tgARN}})
+	assertAPIErrorCode(t, "TargetGroupNotFound", err)
+}
+
+func Test_fakeELBV2_DescribeTagsWithContext(t *testing.T) {
+	ctx := context.Background()
+	cloud := NewCloud("us-west-2")
+	tgResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("my-tg"),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumTcp),
+		Port:       awssdk.Int64(80),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumInstance),
+		VpcId:      awssdk.String(cloud.VpcID()),
+		Tags:       []*elbv2sdk.Tag{{Key: awssdk.String("k1"), Value: awssdk.String("v1")}},
+	})
+	require.NoError(t, err)
+	tgARN := tgResp.TargetGroups[0].TargetGroupArn
+	_, err = cloud.ELBV2().AddTagsWithContext(ctx, &elbv2sdk.AddTagsInput{
+		ResourceArns: []*string{tgARN},
+		Tags:         []*elbv2sdk.Tag{{Key: awssdk.String("k2"), Value: awssdk.String("v2")}},
+	})
+	require.NoError(t, err)
+	_, err = cloud.ELBV2().RemoveTagsWithContext(ctx, &elbv2sdk.RemoveTagsInput{
+		ResourceArns: []*string{tgARN},
+		TagKeys:      awssdk.StringSlice([]string{"k1"}),
+	})
+	require.NoError(t, err)
+
+	resp, err := cloud.ELBV2().DescribeTagsWithContext(ctx, &elbv2sdk.DescribeTagsInput{ResourceArns: []*string{tgARN}})
+	require.NoError(t, err)
+	assert.Equal(t, []*elbv2sdk.TagDescription{
+		{
+			ResourceArn: tgARN,
+			Tags:        []*elbv2sdk.Tag{{Key: awssdk.String("k2"), Value: awssdk.String("v2")}},
+		},
+	}, resp.TagDescriptions)
+}
+
+// createSubnets creates a subnet in each availability zone of cloud.
+func createSubnets(t *testing.T, cloud *Cloud) []string {
+	var subnetIDs []string
+	for idx, az := range []string{"us-west-2a", "us-west-2b", "us-west-2c"} {
+		resp, err := cloud.EC2().CreateSubnetWithContext(context.Background(), &ec2sdk.CreateSubnetInput{
+			VpcId:            awssdk.String(cloud.VpcID()),
+			CidrBlock:        awssdk.String(fmt.Sprintf("192.168.%d.0/24", idx)),
+			AvailabilityZone: awssdk.String(az),
+		})
+		require.NoError(t, err)
+		subnetIDs = append(subnetIDs, awssdk.StringValue(resp.Subnet.SubnetId))
+	}
+	return subnetIDs
+}
+
+func assertTargetHealthState(t *testing.T, cloud *Cloud, tgARN *string, wantState string) {
+	resp, err := cloud.ELBV2().DescribeTargetHealthWithContext(context.Background(), &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: tgARN,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.TargetHealthDescriptions, 1)
+	assert.Equal(t, wantState, awssdk.StringValue(resp.TargetHealthDescriptions[0].TargetHealth.State))
+}
@@ -0,0 +1,184 @@
+//go:build ignore
+// +build ignore
+
+// gen_unimplemented generates zz_generated.unimplemented.go, which implements the AWS SDK service interfaces with APIs
+// that return NotImplemented error, so that fake services only need to implement the APIs they model.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/shield/shieldiface"
+	"github.com/aws/aws-sdk-go/service/wafregional/wafregionaliface"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+)
+
+const outputFile = "zz_generated.unimplemented.go"
+
+type service struct {
+	// the name of generated type.
+	typeName string
+	// the service name constant used for errors.
+	serviceConst string
+	iface        reflect.Type
+}
+
+var services = []service{
+	{"unimplementedACM", "ServiceACM", reflect.TypeOf((*acmiface.ACMAPI)(nil)).Elem()},
+	{"unimplementedCloudWatch", "ServiceCloudWatch", reflect.TypeOf((*cloudwatchiface.CloudWatchAPI)(nil)).Elem()},
+	{"unimplementedEC2", "ServiceEC2", reflect.TypeOf((*ec2iface.EC2API)(nil)).Elem()},
+	{"unimplementedELBV2", "ServiceELBV2", reflect.TypeOf((*elbv2iface.ELBV2API)(nil)).Elem()},
+	{"unimplementedRGT", "ServiceRGT", reflect.TypeOf((*resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI)(nil)).Elem()},
+	{"unimplementedS3", "ServiceS3", reflect.TypeOf((*s3iface.S3API)(nil)).Elem()},
+	{"unimplementedShield", "ServiceShield", reflect.TypeOf((*shieldiface.ShieldAPI)(nil)).Elem()},
+	{"unimplementedWAFRegional", "ServiceWAFRegional", reflect.TypeOf((*wafregionaliface.WAFRegionalAPI)(nil)).Elem()},
+	{"unimplementedWAFv2", "ServiceWAFv2", reflect.TypeOf((*wafv2iface.WAFV2API)(nil)).Elem()},
+}
+
+// the import aliases of packages, which matches the ones used by fake services.
+var packageAliases = map[string]string{
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi": "rgtsdk",
+}
+
+// the suffixes of API variants, which are stripped to get the operation name.
+var operationSuffixes = []string{"PagesWithContext", "Pages", "WithContext", "Request"}
+
+func main() {
+	imports := make(map[string]string)
+	body := &bytes.Buffer{}
+	for _, svc := range services {
+		ifaceName := svc.iface.PkgPath()[strings.LastIndex(svc.iface.PkgPath(), "/")+1:] + "." + svc.iface.Name()
+		fmt.Fprintf(body, "\n// %v implements %v with APIs that return NotImplemented error.\n", svc.typeName, ifaceName)
+		fmt.Fprintf(body, "type %v struct{}\n\n", svc.typeName)
+		for i := 0; i < svc.iface.NumMethod(); i++ {
+			method := svc.iface.Method(i)
+			fmt.Fprintf(body, "func (%v) %v%v { return %v }\n", svc.typeName, method.Name,
+				signatureString(method.Type, imports), returnString(method.Type, svc.serviceConst, operationName(method.Name), imports))
+		}
+	}
+
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "// Code generated by gen_unimplemented.go. DO NOT EDIT.\n\npackage fake\n\nimport (\n")
+	var paths []string
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if alias := imports[path]; alias != path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(src, "\t%v %q\n", alias, path)
+		} else {
+			fmt.Fprintf(src, "\t%q\n", path)
+		}
+	}
+	fmt.Fprintf(src, ")\n")
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	if err := ioutil.WriteFile(outputFile, formatted, 0644); err != nil {
+		panic(err)
+	}
+}
+
+func operationName(methodName string) string {
+	for _, suffix := range operationSuffixes {
+		if strings.HasSuffix(methodName, suffix) {
+			return strings.TrimSuffix(methodName, suffix)
+		}
+	}
+	return methodName
+}
+
+func signatureString(t reflect.Type, imports map[string]string) string {
+	var params []string
+	for i := 0; i < t.NumIn(); i++ {
+		if t.IsVariadic() && i == t.NumIn()-1 {
+			params = append(params, "_ ..."+typeString(t.In(i).Elem(), imports))
+		} else {
+			params = append(params, "_ "+typeString(t.In(i), imports))
+		}
+	}
+	var results []string
+	for i := 0; i < t.NumOut(); i++ {
+		results = append(results, typeString(t.Out(i), imports))
+	}
+	switch len(results) {
+	case 0:
+		return fmt.Sprintf("(%v)", strings.Join(params, ", "))
+	case 1:
+		return fmt.Sprintf("(%v) %v", strings.Join(params, ", "), results[0])
+	default:
+		return fmt.Sprintf("(%v) (%v)", strings.Join(params, ", "), strings.Join(results, ", "))
+	}
+}
+
+func returnString(t reflect.Type, serviceConst string, operation string, imports map[string]string) string {
+	var values []string
+	for i := 0; i < t.NumOut(); i++ {
+		out := t.Out(i)
+		switch {
+		case out == reflect.TypeOf((*error)(nil)).Elem():
+			values = append(values, fmt.Sprintf("newNotImplementedError(%v, %q)", serviceConst, operation))
+		case out.Kind() == reflect.Ptr && out.Elem().Name() == "Request" && out.Elem().PkgPath() == "github.com/aws/aws-sdk-go/aws/request":
+			values = append(values, fmt.Sprintf("newNotImplementedRequest(%v, %q)", serviceConst, operation))
+		case out.Kind() == reflect.Ptr || out.Kind() == reflect.Interface || out.Kind() == reflect.Slice || out.Kind() == reflect.Map:
+			values = append(values, "nil")
+		default:
+			panic(fmt.Sprintf("unsupported result type %v of %v", out, operation))
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+func typeString(t reflect.Type, imports map[string]string) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+		return packageAlias(t.PkgPath(), imports) + "." + t.Name()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + typeString(t.Elem(), imports)
+	case reflect.Slice:
+		return "[]" + typeString(t.Elem(), imports)
+	case reflect.Map:
+		return fmt.Sprintf("map[%v]%v", typeString(t.Key(), imports), typeString(t.Elem(), imports))
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "interface{}"
+		}
+	case reflect.Func:
+		return "func" + strings.Replace(signatureString(t, imports), "_ ", "", -1)
+	}
+	panic(fmt.Sprintf("unsupported type %v", t))
+}
+
+func packageAlias(path string, imports map[string]string) string {
+	alias, ok := packageAliases[path]
+	if !ok {
+		name := path[strings.LastIndex(path, "/")+1:]
+		if strings.HasPrefix(path, "github.com/aws/aws-sdk-go/service/") {
+			alias = name + "sdk"
+		} else {
+			alias = name
+		}
+	}
+	imports[path] = alias
+	return alias
+}
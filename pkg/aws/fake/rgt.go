@@ -0,0 +1,261 @@
+package fake
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	// the maximum number of resources per TagResources or UntagResources call.
+	rgtMaxResourcesPerCall = 20
+)
+
+var _ services.RGT = &fakeRGT{}
+
+// fakeRGT is an in-memory implementation of services.RGT, which covers resources of all fake services.
+type fakeRGT struct {
+	unimplementedRGT
+	backend *backend
+}
+
+func (c *fakeRGT) GetResourcesWithContext(_ context.Context, input *rgtsdk.GetResourcesInput, _ ...request.Option) (*rgtsdk.GetResourcesOutput, error) {
+	release, err := c.backend.begin(ServiceRGT, "GetResources")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	return &rgtsdk.GetResourcesOutput{
+		ResourceTagMappingList: c.backend.getResources(input),
+		PaginationToken:        awssdk.String(""),
+	}, nil
+}
+
+func (c *fakeRGT) GetResourcesPagesWithContext(_ context.Context, input *rgtsdk.GetResourcesInput, fn func(*rgtsdk.GetResourcesOutput, bool) bool, _ ...request.Option) error {
+	release, err := c.backend.begin(ServiceRGT, "GetResources")
+	if err != nil {
+		release()
+		return err
+	}
+	output := &rgtsdk.GetResourcesOutput{
+		ResourceTagMappingList: c.backend.getResources(input),
+		PaginationToken:        awssdk.String(""),
+	}
+	// the callback is invoked without lock, so that it can call other APIs.
+	release()
+	fn(output, true)
+	return nil
+}
+
+func (c *fakeRGT) TagResourcesWithContext(_ context.Context, input *rgtsdk.TagResourcesInput, _ ...request.Option) (*rgtsdk.TagResourcesOutput, error) {
+	release, err := c.backend.begin(ServiceRGT, "TagResources")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	if len(input.ResourceARNList) > rgtMaxResourcesPerCall {
+		return nil, NewAPIError(rgtsdk.ErrCodeInvalidParameterException, "ResourceARNList must contain at most 20 resources")
+	}
+	failedResources := make(map[string]*rgtsdk.FailureInfo)
+	for _, resARN := range awssdk.StringValueSlice(input.ResourceARNList) {
+		tags, setTags, ok := c.backend.resourceTags(resARN)
+		if !ok {
+			failedResources[resARN] = buildResourceNotFoundFailure(resARN)
+			continue
+		}
+		for key, value := range input.Tags {
+			tags[key] = awssdk.StringValue(value)
+		}
+		setTags(tags)
+	}
+	return &rgtsdk.TagResourcesOutput{FailedResourcesMap: failedResources}, nil
+}
+
+func (c *fakeRGT) UntagResourcesWithContext(_ context.Context, input *rgtsdk.UntagResourcesInput, _ ...request.Option) (*rgtsdk.UntagResourcesOutput, error) {
+	release, err := c.backend.begin(ServiceRGT, "UntagResources")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	if len(input.ResourceARNList) > rgtMaxResourcesPerCall {
+		return nil, NewAPIError(rgtsdk.ErrCodeInvalidParameterException, "ResourceARNList must contain at most 20 resources")
+	}
+	failedResources := make(map[string]*rgtsdk.FailureInfo)
+	for _, resARN := range awssdk.StringValueSlice(input.ResourceARNList) {
+		tags, setTags, ok := c.backend.resourceTags(resARN)
+		if !ok {
+			failedResources[resARN] = buildResourceNotFoundFailure(resARN)
+			continue
+		}
+		for _, key := range awssdk.StringValueSlice(input.TagKeys) {
+			delete(tags, key)
+		}
+		setTags(tags)
+	}
+	return &rgtsdk.UntagResourcesOutput{FailedResourcesMap: failedResources}, nil
+}
+
+// getResources lists the tagged resources that matches input.
+func (b *backend) getResources(input *rgtsdk.GetResourcesInput) []*rgtsdk.ResourceTagMapping {
+	resARNs := sets.NewString(awssdk.StringValueSlice(input.ResourceARNList)...)
+	var mappings []*rgtsdk.ResourceTagMapping
+	for _, resARN := range b.taggedResourceARNs() {
+		if len(resARNs) != 0 && !resARNs.Has(resARN) {
+			continue
+		}
+		if !matchesResourceTypeFilters(input.ResourceTypeFilters, resARN) {
+			continue
+		}
+		tags, _, _ := b.resourceTags(resARN)
+		if !matchesTagFilters(input.TagFilters, tags) {
+			continue
+		}
+		mapping := &rgtsdk.ResourceTagMapping{ResourceARN: awssdk.String(resARN)}
+		for _, key := range sets.StringKeySet(tags).List() {
+			mapping.Tags = append(mapping.Tags, &rgtsdk.Tag{Key: awssdk.String(key), Value: awssdk.String(tags[key])})
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
+
+// taggedResourceARNs returns the ARNs of all resources with tags.
+func (b *backend) taggedResourceARNs() []string {
+	resARNs := sets.NewString()
+	for _, record := range b.loadBalancers {
+		if len(record.tags) != 0 {
+			resARNs.Insert(awssdk.StringValue(record.lb.LoadBalancerArn))
+		}
+	}
+	for resARN, record := range b.listeners {
+		if len(record.tags) != 0 {
+			resARNs.Insert(resARN)
+		}
+	}
+	for resARN, record := range b.rules {
+		if len(record.tags) != 0 {
+			resARNs.Insert(resARN)
+		}
+	}
+	for resARN, record := range b.targetGroups {
+		if len(record.tags) != 0 {
+			resARNs.Insert(resARN)
+		}
+	}
+	for resARN, record := range b.trustStores {
+		if len(record.tags) != 0 {
+			resARNs.Insert(resARN)
+		}
+	}
+	for resARN, record := range b.certificates {
+		if len(record.tags) != 0 {
+			resARNs.Insert(resARN)
+		}
+	}
+	for sgID, sg := range b.securityGroups {
+		if len(sg.Tags) != 0 {
+			resARNs.Insert(b.ec2ARN("security-group", sgID))
+		}
+	}
+	for subnetID, record := range b.subnets {
+		if len(record.subnet.Tags) != 0 {
+			resARNs.Insert(b.ec2ARN("subnet", subnetID))
+		}
+	}
+	for vpcID, vpc := range b.vpcs {
+		if len(vpc.Tags) != 0 {
+			resARNs.Insert(b.ec2ARN("vpc", vpcID))
+		}
+	}
+	return resARNs.List()
+}
+
+// resourceTags returns a copy of tags for resource by ARN, along with a function to update the tags.
+func (b *backend) resourceTags(resARN string) (map[string]string, func(map[string]string), bool) {
+	resourceType := arnResourceType(resARN)
+	switch {
+	case strings.HasPrefix(resourceType, "elasticloadbalancing:"):
+		tags, err := b.elbv2ResourceTags(resARN)
+		if err != nil {
+			return nil, nil, false
+		}
+		return copyTags(tags), func(newTags map[string]string) {
+			for key := range tags {
+				delete(tags, key)
+			}
+			for key, value := range newTags {
+				tags[key] = value
+			}
+		}, true
+	case resourceType == "acm:certificate":
+		record, err := b.findCertificate(resARN)
+		if err != nil {
+			return nil, nil, false
+		}
+		return copyTags(record.tags), func(newTags map[string]string) {
+			record.tags = newTags
+		}, true
+	case strings.HasPrefix(resourceType, "ec2:"):
+		resID := resARN[strings.LastIndex(resARN, "/")+1:]
+		tags, err := b.ec2ResourceTags(resID)
+		if err != nil {
+			return nil, nil, false
+		}
+		return ec2TagsAsMap(*tags), func(newTags map[string]string) {
+			*tags = ec2TagsFromMap(newTags)
+		}, true
+	}
+	return nil, nil, false
+}
+
+// matchesResourceTypeFilters checks whether resource matches any of the resource type filters,
+// which are in form of "service" or "service:resource-type".
+func matchesResourceTypeFilters(filters []*string, resARN string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	resourceType := arnResourceType(resARN)
+	service := strings.SplitN(resourceType, ":", 2)[0]
+	for _, filter := range awssdk.StringValueSlice(filters) {
+		if filter == resourceType || filter == service {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTagFilters checks whether tags matches all tag filters.
+// a filter without values matches any value of the tag key.
+func matchesTagFilters(filters []*rgtsdk.TagFilter, tags map[string]string) bool {
+	for _, filter := range filters {
+		value, ok := tags[awssdk.StringValue(filter.Key)]
+		if !ok {
+			return false
+		}
+		if len(filter.Values) != 0 && !sets.NewString(awssdk.StringValueSlice(filter.Values)...).Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func buildResourceNotFoundFailure(resARN string) *rgtsdk.FailureInfo {
+	return &rgtsdk.FailureInfo{
+		StatusCode:   awssdk.Int64(400),
+		ErrorCode:    awssdk.String(rgtsdk.ErrorCodeInvalidParameterException),
+		ErrorMessage: awssdk.String("Resource " + resARN + " does not exist"),
+	}
+}
+
+func copyTags(tags map[string]string) map[string]string {
+	tagsCopy := make(map[string]string, len(tags))
+	for key, value := range tags {
+		tagsCopy[key] = value
+	}
+	return tagsCopy
+}
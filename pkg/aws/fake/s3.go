@@ -0,0 +1,96 @@
+package fake
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	s3sdk "github.com/aws/aws-sdk-go/service/s3"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+var _ services.S3 = &fakeS3{}
+
+// fakeS3 is an in-memory implementation of services.S3.
+type fakeS3 struct {
+	unimplementedS3
+	backend *backend
+}
+
+func (c *fakeS3) CreateBucketWithContext(_ context.Context, input *s3sdk.CreateBucketInput, _ ...request.Option) (*s3sdk.CreateBucketOutput, error) {
+	release, err := c.backend.begin(ServiceS3, "CreateBucket")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	bucket := awssdk.StringValue(input.Bucket)
+	if _, ok := c.backend.buckets[bucket]; ok {
+		return nil, NewAPIError(s3sdk.ErrCodeBucketAlreadyOwnedByYou, "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+	c.backend.buckets[bucket] = make(map[string][]byte)
+	return &s3sdk.CreateBucketOutput{Location: awssdk.String("/" + bucket)}, nil
+}
+
+func (c *fakeS3) PutObjectWithContext(_ context.Context, input *s3sdk.PutObjectInput, _ ...request.Option) (*s3sdk.PutObjectOutput, error) {
+	release, err := c.backend.begin(ServiceS3, "PutObject")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := c.backend.findBucket(awssdk.StringValue(input.Bucket))
+	if err != nil {
+		return nil, err
+	}
+	var content []byte
+	if input.Body != nil {
+		if content, err = ioutil.ReadAll(input.Body); err != nil {
+			return nil, err
+		}
+	}
+	objects[awssdk.StringValue(input.Key)] = content
+	return &s3sdk.PutObjectOutput{}, nil
+}
+
+func (c *fakeS3) GetObjectWithContext(_ context.Context, input *s3sdk.GetObjectInput, _ ...request.Option) (*s3sdk.GetObjectOutput, error) {
+	release, err := c.backend.begin(ServiceS3, "GetObject")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := c.backend.findBucket(awssdk.StringValue(input.Bucket))
+	if err != nil {
+		return nil, err
+	}
+	content, ok := objects[awssdk.StringValue(input.Key)]
+	if !ok {
+		return nil, NewAPIError(s3sdk.ErrCodeNoSuchKey, "The specified key does not exist.")
+	}
+	return &s3sdk.GetObjectOutput{
+		Body:          ioutil.NopCloser(bytes.NewReader(content)),
+		ContentLength: awssdk.Int64(int64(len(content))),
+	}, nil
+}
+
+func (c *fakeS3) DeleteObjectWithContext(_ context.Context, input *s3sdk.DeleteObjectInput, _ ...request.Option) (*s3sdk.DeleteObjectOutput, error) {
+	release, err := c.backend.begin(ServiceS3, "DeleteObject")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := c.backend.findBucket(awssdk.StringValue(input.Bucket))
+	if err != nil {
+		return nil, err
+	}
+	delete(objects, awssdk.StringValue(input.Key))
+	return &s3sdk.DeleteObjectOutput{}, nil
+}
+
+func (b *backend) findBucket(bucket string) (map[string][]byte, error) {
+	objects, ok := b.buckets[bucket]
+	if !ok {
+		return nil, NewAPIError(s3sdk.ErrCodeNoSuchBucket, "The specified bucket does not exist")
+	}
+	return objects, nil
+}
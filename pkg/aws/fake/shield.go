@@ -0,0 +1,110 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	shieldsdk "github.com/aws/aws-sdk-go/service/shield"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+var _ services.Shield = &fakeShield{}
+
+// fakeShield is an in-memory implementation of services.Shield.
+type fakeShield struct {
+	unimplementedShield
+	backend *backend
+}
+
+func (c *fakeShield) Available() (bool, error) {
+	resp, err := c.GetSubscriptionStateWithContext(context.Background(), &shieldsdk.GetSubscriptionStateInput{})
+	if err != nil {
+		return false, err
+	}
+	return awssdk.StringValue(resp.SubscriptionState) == shieldsdk.SubscriptionStateActive, nil
+}
+
+func (c *fakeShield) GetSubscriptionStateWithContext(_ context.Context, _ *shieldsdk.GetSubscriptionStateInput, _ ...request.Option) (*shieldsdk.GetSubscriptionStateOutput, error) {
+	release, err := c.backend.begin(ServiceShield, "GetSubscriptionState")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	state := shieldsdk.SubscriptionStateInactive
+	if c.backend.shieldSubscribed {
+		state = shieldsdk.SubscriptionStateActive
+	}
+	return &shieldsdk.GetSubscriptionStateOutput{SubscriptionState: awssdk.String(state)}, nil
+}
+
+func (c *fakeShield) CreateSubscriptionWithContext(_ context.Context, _ *shieldsdk.CreateSubscriptionInput, _ ...request.Option) (*shieldsdk.CreateSubscriptionOutput, error) {
+	release, err := c.backend.begin(ServiceShield, "CreateSubscription")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	if c.backend.shieldSubscribed {
+		return nil, NewAPIError(shieldsdk.ErrCodeResourceAlreadyExistsException, "The subscription already exists.")
+	}
+	c.backend.shieldSubscribed = true
+	return &shieldsdk.CreateSubscriptionOutput{}, nil
+}
+
+func (c *fakeShield) CreateProtectionWithContext(_ context.Context, input *shieldsdk.CreateProtectionInput, _ ...request.Option) (*shieldsdk.CreateProtectionOutput, error) {
+	release, err := c.backend.begin(ServiceShield, "CreateProtection")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	if !c.backend.shieldSubscribed {
+		return nil, NewAPIError(shieldsdk.ErrCodeResourceNotFoundException, "The subscription does not exist.")
+	}
+	resARN := awssdk.StringValue(input.ResourceArn)
+	if _, ok := c.backend.loadBalancers[resARN]; !ok {
+		return nil, newAPIErrorf(shieldsdk.ErrCodeInvalidResourceException, "Resource %v does not exist.", resARN)
+	}
+	for _, protection := range c.backend.protections {
+		if awssdk.StringValue(protection.ResourceArn) == resARN {
+			return nil, NewAPIError(shieldsdk.ErrCodeResourceAlreadyExistsException, "The referenced protection already exists.")
+		}
+	}
+	protectionID := c.backend.nextUUID()
+	c.backend.protections[protectionID] = &shieldsdk.Protection{
+		Id:            awssdk.String(protectionID),
+		Name:          input.Name,
+		ResourceArn:   awssdk.String(resARN),
+		ProtectionArn: awssdk.String(fmt.Sprintf("arn:aws:shield::%v:protection/%v", c.backend.accountID, protectionID)),
+	}
+	return &shieldsdk.CreateProtectionOutput{ProtectionId: awssdk.String(protectionID)}, nil
+}
+
+func (c *fakeShield) DescribeProtectionWithContext(_ context.Context, input *shieldsdk.DescribeProtectionInput, _ ...request.Option) (*shieldsdk.DescribeProtectionOutput, error) {
+	release, err := c.backend.begin(ServiceShield, "DescribeProtection")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	for _, protection := range c.backend.protections {
+		if (input.ProtectionId != nil && awssdk.StringValue(protection.Id) == awssdk.StringValue(input.ProtectionId)) ||
+			(input.ResourceArn != nil && awssdk.StringValue(protection.ResourceArn) == awssdk.StringValue(input.ResourceArn)) {
+			return &shieldsdk.DescribeProtectionOutput{Protection: copyOf(protection).(*shieldsdk.Protection)}, nil
+		}
+	}
+	return nil, NewAPIError(shieldsdk.ErrCodeResourceNotFoundException, "The referenced protection does not exist.")
+}
+
+func (c *fakeShield) DeleteProtectionWithContext(_ context.Context, input *shieldsdk.DeleteProtectionInput, _ ...request.Option) (*shieldsdk.DeleteProtectionOutput, error) {
+	release, err := c.backend.begin(ServiceShield, "DeleteProtection")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	protectionID := awssdk.StringValue(input.ProtectionId)
+	if _, ok := c.backend.protections[protectionID]; !ok {
+		return nil, NewAPIError(shieldsdk.ErrCodeResourceNotFoundException, "The referenced protection does not exist.")
+	}
+	delete(c.backend.protections, protectionID)
+	return &shieldsdk.DeleteProtectionOutput{}, nil
+}
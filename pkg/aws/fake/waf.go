@@ -0,0 +1,226 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	wafsdk "github.com/aws/aws-sdk-go/service/waf"
+	wafregionalsdk "github.com/aws/aws-sdk-go/service/wafregional"
+	wafv2sdk "github.com/aws/aws-sdk-go/service/wafv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+type webACLRecord struct {
+	arn  string
+	id   string
+	name string
+}
+
+var _ services.WAFv2 = &fakeWAFv2{}
+
+// fakeWAFv2 is an in-memory implementation of services.WAFv2.
+type fakeWAFv2 struct {
+	unimplementedWAFv2
+	backend *backend
+}
+
+func (c *fakeWAFv2) CreateWebACLWithContext(_ context.Context, input *wafv2sdk.CreateWebACLInput, _ ...request.Option) (*wafv2sdk.CreateWebACLOutput, error) {
+	release, err := c.backend.begin(ServiceWAFv2, "CreateWebACL")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	name := awssdk.StringValue(input.Name)
+	if awssdk.StringValue(input.Scope) != wafv2sdk.ScopeRegional {
+		return nil, NewAPIError("WAFInvalidParameterException", "Only REGIONAL scope is supported")
+	}
+	for _, record := range c.backend.webACLs {
+		if record.name == name {
+			return nil, NewAPIError("WAFDuplicateItemException", "AWS WAF could not perform the operation because some resource in your request is a duplicate of an existing one.")
+		}
+	}
+	id := c.backend.nextUUID()
+	record := &webACLRecord{
+		arn:  fmt.Sprintf("arn:aws:wafv2:%v:%v:regional/webacl/%v/%v", c.backend.region, c.backend.accountID, name, id),
+		id:   id,
+		name: name,
+	}
+	c.backend.webACLs[record.arn] = record
+	return &wafv2sdk.CreateWebACLOutput{
+		Summary: &wafv2sdk.WebACLSummary{
+			ARN:       awssdk.String(record.arn),
+			Id:        awssdk.String(record.id),
+			Name:      awssdk.String(record.name),
+			LockToken: awssdk.String(c.backend.nextUUID()),
+		},
+	}, nil
+}
+
+func (c *fakeWAFv2) GetWebACLForResourceWithContext(_ context.Context, input *wafv2sdk.GetWebACLForResourceInput, _ ...request.Option) (*wafv2sdk.GetWebACLForResourceOutput, error) {
+	release, err := c.backend.begin(ServiceWAFv2, "GetWebACLForResource")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	resARN := awssdk.StringValue(input.ResourceArn)
+	if err := c.backend.validateWebACLResource(resARN); err != nil {
+		return nil, err
+	}
+	webACLARN, ok := c.backend.webACLByResource[resARN]
+	if !ok {
+		return &wafv2sdk.GetWebACLForResourceOutput{}, nil
+	}
+	record := c.backend.webACLs[webACLARN]
+	return &wafv2sdk.GetWebACLForResourceOutput{
+		WebACL: &wafv2sdk.WebACL{
+			ARN:  awssdk.String(record.arn),
+			Id:   awssdk.String(record.id),
+			Name: awssdk.String(record.name),
+		},
+	}, nil
+}
+
+func (c *fakeWAFv2) AssociateWebACLWithContext(_ context.Context, input *wafv2sdk.AssociateWebACLInput, _ ...request.Option) (*wafv2sdk.AssociateWebACLOutput, error) {
+	release, err := c.backend.begin(ServiceWAFv2, "AssociateWebACL")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	resARN := awssdk.StringValue(input.ResourceArn)
+	if err := c.backend.validateWebACLResource(resARN); err != nil {
+		return nil, err
+	}
+	webACLARN := awssdk.StringValue(input.WebACLArn)
+	if _, ok := c.backend.webACLs[webACLARN]; !ok {
+		return nil, NewAPIError("WAFNonexistentItemException", "AWS WAF could not perform the operation because your resource does not exist.")
+	}
+	c.backend.webACLByResource[resARN] = webACLARN
+	return &wafv2sdk.AssociateWebACLOutput{}, nil
+}
+
+func (c *fakeWAFv2) DisassociateWebACLWithContext(_ context.Context, input *wafv2sdk.DisassociateWebACLInput, _ ...request.Option) (*wafv2sdk.DisassociateWebACLOutput, error) {
+	release, err := c.backend.begin(ServiceWAFv2, "DisassociateWebACL")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	resARN := awssdk.StringValue(input.ResourceArn)
+	if err := c.backend.validateWebACLResource(resARN); err != nil {
+		return nil, err
+	}
+	delete(c.backend.webACLByResource, resARN)
+	return &wafv2sdk.DisassociateWebACLOutput{}, nil
+}
+
+var _ services.WAFRegional = &fakeWAFRegional{}
+
+// fakeWAFRegional is an in-memory implementation of services.WAFRegional.
+type fakeWAFRegional struct {
+	unimplementedWAFRegional
+	backend *backend
+}
+
+func (c *fakeWAFRegional) Available() bool {
+	return true
+}
+
+func (c *fakeWAFRegional) CreateWebACLWithContext(_ context.Context, input *wafsdk.CreateWebACLInput, _ ...request.Option) (*wafsdk.CreateWebACLOutput, error) {
+	release, err := c.backend.begin(ServiceWAFRegional, "CreateWebACL")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	name := awssdk.StringValue(input.Name)
+	for _, record := range c.backend.regionalWebACLs {
+		if record.name == name {
+			return nil, NewAPIError(wafregionalsdk.ErrCodeWAFDisallowedNameException, "The name specified is invalid.")
+		}
+	}
+	id := c.backend.nextUUID()
+	record := &webACLRecord{
+		arn:  fmt.Sprintf("arn:aws:waf-regional:%v:%v:webacl/%v", c.backend.region, c.backend.accountID, id),
+		id:   id,
+		name: name,
+	}
+	c.backend.regionalWebACLs[id] = record
+	return &wafsdk.CreateWebACLOutput{
+		ChangeToken: input.ChangeToken,
+		WebACL: &wafsdk.WebACL{
+			WebACLId:      awssdk.String(record.id),
+			WebACLArn:     awssdk.String(record.arn),
+			Name:          awssdk.String(record.name),
+			MetricName:    input.MetricName,
+			DefaultAction: input.DefaultAction,
+		},
+	}, nil
+}
+
+func (c *fakeWAFRegional) GetWebACLForResourceWithContext(_ context.Context, input *wafregionalsdk.GetWebACLForResourceInput, _ ...request.Option) (*wafregionalsdk.GetWebACLForResourceOutput, error) {
+	release, err := c.backend.begin(ServiceWAFRegional, "GetWebACLForResource")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	resARN := awssdk.StringValue(input.ResourceArn)
+	if err := c.backend.validateWebACLResource(resARN); err != nil {
+		return nil, err
+	}
+	webACLID, ok := c.backend.regionalWebACLByResource[resARN]
+	if !ok {
+		return &wafregionalsdk.GetWebACLForResourceOutput{}, nil
+	}
+	record := c.backend.regionalWebACLs[webACLID]
+	return &wafregionalsdk.GetWebACLForResourceOutput{
+		WebACLSummary: &wafsdk.WebACLSummary{
+			WebACLId: awssdk.String(record.id),
+			Name:     awssdk.String(record.name),
+		},
+	}, nil
+}
+
+func (c *fakeWAFRegional) AssociateWebACLWithContext(_ context.Context, input *wafregionalsdk.AssociateWebACLInput, _ ...request.Option) (*wafregionalsdk.AssociateWebACLOutput, error) {
+	release, err := c.backend.begin(ServiceWAFRegional, "AssociateWebACL")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	resARN := awssdk.StringValue(input.ResourceArn)
+	if err := c.backend.validateWebACLResource(resARN); err != nil {
+		return nil, err
+	}
+	webACLID := awssdk.StringValue(input.WebACLId)
+	if _, ok := c.backend.regionalWebACLs[webACLID]; !ok {
+		return nil, NewAPIError("WAFNonexistentItemException", "The referenced item does not exist.")
+	}
+	c.backend.regionalWebACLByResource[resARN] = webACLID
+	return &wafregionalsdk.AssociateWebACLOutput{}, nil
+}
+
+func (c *fakeWAFRegional) DisassociateWebACLWithContext(_ context.Context, input *wafregionalsdk.DisassociateWebACLInput, _ ...request.Option) (*wafregionalsdk.DisassociateWebACLOutput, error) {
+	release, err := c.backend.begin(ServiceWAFRegional, "DisassociateWebACL")
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+	resARN := awssdk.StringValue(input.ResourceArn)
+	if err := c.backend.validateWebACLResource(resARN); err != nil {
+		return nil, err
+	}
+	delete(c.backend.regionalWebACLByResource, resARN)
+	return &wafregionalsdk.DisassociateWebACLOutput{}, nil
+}
+
+// validateWebACLResource checks whether resource can be protected by webACL, only application load balancers are supported.
+func (b *backend) validateWebACLResource(resARN string) error {
+	record, ok := b.loadBalancers[resARN]
+	if !ok {
+		return NewAPIError("WAFNonexistentItemException", "AWS WAF could not perform the operation because your resource does not exist.")
+	}
+	if awssdk.StringValue(record.lb.Type) != elbv2sdk.LoadBalancerTypeEnumApplication {
+		return NewAPIError("WAFInvalidParameterException", "The resource type is not supported.")
+	}
+	return nil
+}
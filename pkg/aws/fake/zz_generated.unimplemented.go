@@ -0,0 +1,10233 @@
+// Code generated by gen_unimplemented.go. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/request"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	cloudwatchsdk "github.com/aws/aws-sdk-go/service/cloudwatch"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	s3sdk "github.com/aws/aws-sdk-go/service/s3"
+	shieldsdk "github.com/aws/aws-sdk-go/service/shield"
+	wafsdk "github.com/aws/aws-sdk-go/service/waf"
+	wafregionalsdk "github.com/aws/aws-sdk-go/service/wafregional"
+	wafv2sdk "github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+// unimplementedACM implements acmiface.ACMAPI with APIs that return NotImplemented error.
+type unimplementedACM struct{}
+
+func (unimplementedACM) AddTagsToCertificate(_ *acmsdk.AddTagsToCertificateInput) (*acmsdk.AddTagsToCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "AddTagsToCertificate")
+}
+func (unimplementedACM) AddTagsToCertificateRequest(_ *acmsdk.AddTagsToCertificateInput) (*request.Request, *acmsdk.AddTagsToCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "AddTagsToCertificate"), nil
+}
+func (unimplementedACM) AddTagsToCertificateWithContext(_ context.Context, _ *acmsdk.AddTagsToCertificateInput, _ ...request.Option) (*acmsdk.AddTagsToCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "AddTagsToCertificate")
+}
+func (unimplementedACM) DeleteCertificate(_ *acmsdk.DeleteCertificateInput) (*acmsdk.DeleteCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "DeleteCertificate")
+}
+func (unimplementedACM) DeleteCertificateRequest(_ *acmsdk.DeleteCertificateInput) (*request.Request, *acmsdk.DeleteCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "DeleteCertificate"), nil
+}
+func (unimplementedACM) DeleteCertificateWithContext(_ context.Context, _ *acmsdk.DeleteCertificateInput, _ ...request.Option) (*acmsdk.DeleteCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "DeleteCertificate")
+}
+func (unimplementedACM) DescribeCertificate(_ *acmsdk.DescribeCertificateInput) (*acmsdk.DescribeCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "DescribeCertificate")
+}
+func (unimplementedACM) DescribeCertificateRequest(_ *acmsdk.DescribeCertificateInput) (*request.Request, *acmsdk.DescribeCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "DescribeCertificate"), nil
+}
+func (unimplementedACM) DescribeCertificateWithContext(_ context.Context, _ *acmsdk.DescribeCertificateInput, _ ...request.Option) (*acmsdk.DescribeCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "DescribeCertificate")
+}
+func (unimplementedACM) ExportCertificate(_ *acmsdk.ExportCertificateInput) (*acmsdk.ExportCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ExportCertificate")
+}
+func (unimplementedACM) ExportCertificateRequest(_ *acmsdk.ExportCertificateInput) (*request.Request, *acmsdk.ExportCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "ExportCertificate"), nil
+}
+func (unimplementedACM) ExportCertificateWithContext(_ context.Context, _ *acmsdk.ExportCertificateInput, _ ...request.Option) (*acmsdk.ExportCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ExportCertificate")
+}
+func (unimplementedACM) GetAccountConfiguration(_ *acmsdk.GetAccountConfigurationInput) (*acmsdk.GetAccountConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "GetAccountConfiguration")
+}
+func (unimplementedACM) GetAccountConfigurationRequest(_ *acmsdk.GetAccountConfigurationInput) (*request.Request, *acmsdk.GetAccountConfigurationOutput) {
+	return newNotImplementedRequest(ServiceACM, "GetAccountConfiguration"), nil
+}
+func (unimplementedACM) GetAccountConfigurationWithContext(_ context.Context, _ *acmsdk.GetAccountConfigurationInput, _ ...request.Option) (*acmsdk.GetAccountConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "GetAccountConfiguration")
+}
+func (unimplementedACM) GetCertificate(_ *acmsdk.GetCertificateInput) (*acmsdk.GetCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "GetCertificate")
+}
+func (unimplementedACM) GetCertificateRequest(_ *acmsdk.GetCertificateInput) (*request.Request, *acmsdk.GetCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "GetCertificate"), nil
+}
+func (unimplementedACM) GetCertificateWithContext(_ context.Context, _ *acmsdk.GetCertificateInput, _ ...request.Option) (*acmsdk.GetCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "GetCertificate")
+}
+func (unimplementedACM) ImportCertificate(_ *acmsdk.ImportCertificateInput) (*acmsdk.ImportCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ImportCertificate")
+}
+func (unimplementedACM) ImportCertificateRequest(_ *acmsdk.ImportCertificateInput) (*request.Request, *acmsdk.ImportCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "ImportCertificate"), nil
+}
+func (unimplementedACM) ImportCertificateWithContext(_ context.Context, _ *acmsdk.ImportCertificateInput, _ ...request.Option) (*acmsdk.ImportCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ImportCertificate")
+}
+func (unimplementedACM) ListCertificates(_ *acmsdk.ListCertificatesInput) (*acmsdk.ListCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ListCertificates")
+}
+func (unimplementedACM) ListCertificatesPages(_ *acmsdk.ListCertificatesInput, _ func(*acmsdk.ListCertificatesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceACM, "ListCertificates")
+}
+func (unimplementedACM) ListCertificatesPagesWithContext(_ context.Context, _ *acmsdk.ListCertificatesInput, _ func(*acmsdk.ListCertificatesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceACM, "ListCertificates")
+}
+func (unimplementedACM) ListCertificatesRequest(_ *acmsdk.ListCertificatesInput) (*request.Request, *acmsdk.ListCertificatesOutput) {
+	return newNotImplementedRequest(ServiceACM, "ListCertificates"), nil
+}
+func (unimplementedACM) ListCertificatesWithContext(_ context.Context, _ *acmsdk.ListCertificatesInput, _ ...request.Option) (*acmsdk.ListCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ListCertificates")
+}
+func (unimplementedACM) ListTagsForCertificate(_ *acmsdk.ListTagsForCertificateInput) (*acmsdk.ListTagsForCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ListTagsForCertificate")
+}
+func (unimplementedACM) ListTagsForCertificateRequest(_ *acmsdk.ListTagsForCertificateInput) (*request.Request, *acmsdk.ListTagsForCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "ListTagsForCertificate"), nil
+}
+func (unimplementedACM) ListTagsForCertificateWithContext(_ context.Context, _ *acmsdk.ListTagsForCertificateInput, _ ...request.Option) (*acmsdk.ListTagsForCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ListTagsForCertificate")
+}
+func (unimplementedACM) PutAccountConfiguration(_ *acmsdk.PutAccountConfigurationInput) (*acmsdk.PutAccountConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "PutAccountConfiguration")
+}
+func (unimplementedACM) PutAccountConfigurationRequest(_ *acmsdk.PutAccountConfigurationInput) (*request.Request, *acmsdk.PutAccountConfigurationOutput) {
+	return newNotImplementedRequest(ServiceACM, "PutAccountConfiguration"), nil
+}
+func (unimplementedACM) PutAccountConfigurationWithContext(_ context.Context, _ *acmsdk.PutAccountConfigurationInput, _ ...request.Option) (*acmsdk.PutAccountConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "PutAccountConfiguration")
+}
+func (unimplementedACM) RemoveTagsFromCertificate(_ *acmsdk.RemoveTagsFromCertificateInput) (*acmsdk.RemoveTagsFromCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "RemoveTagsFromCertificate")
+}
+func (unimplementedACM) RemoveTagsFromCertificateRequest(_ *acmsdk.RemoveTagsFromCertificateInput) (*request.Request, *acmsdk.RemoveTagsFromCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "RemoveTagsFromCertificate"), nil
+}
+func (unimplementedACM) RemoveTagsFromCertificateWithContext(_ context.Context, _ *acmsdk.RemoveTagsFromCertificateInput, _ ...request.Option) (*acmsdk.RemoveTagsFromCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "RemoveTagsFromCertificate")
+}
+func (unimplementedACM) RenewCertificate(_ *acmsdk.RenewCertificateInput) (*acmsdk.RenewCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "RenewCertificate")
+}
+func (unimplementedACM) RenewCertificateRequest(_ *acmsdk.RenewCertificateInput) (*request.Request, *acmsdk.RenewCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "RenewCertificate"), nil
+}
+func (unimplementedACM) RenewCertificateWithContext(_ context.Context, _ *acmsdk.RenewCertificateInput, _ ...request.Option) (*acmsdk.RenewCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "RenewCertificate")
+}
+func (unimplementedACM) RequestCertificate(_ *acmsdk.RequestCertificateInput) (*acmsdk.RequestCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "RequestCertificate")
+}
+func (unimplementedACM) RequestCertificateRequest(_ *acmsdk.RequestCertificateInput) (*request.Request, *acmsdk.RequestCertificateOutput) {
+	return newNotImplementedRequest(ServiceACM, "RequestCertificate"), nil
+}
+func (unimplementedACM) RequestCertificateWithContext(_ context.Context, _ *acmsdk.RequestCertificateInput, _ ...request.Option) (*acmsdk.RequestCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "RequestCertificate")
+}
+func (unimplementedACM) ResendValidationEmail(_ *acmsdk.ResendValidationEmailInput) (*acmsdk.ResendValidationEmailOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ResendValidationEmail")
+}
+func (unimplementedACM) ResendValidationEmailRequest(_ *acmsdk.ResendValidationEmailInput) (*request.Request, *acmsdk.ResendValidationEmailOutput) {
+	return newNotImplementedRequest(ServiceACM, "ResendValidationEmail"), nil
+}
+func (unimplementedACM) ResendValidationEmailWithContext(_ context.Context, _ *acmsdk.ResendValidationEmailInput, _ ...request.Option) (*acmsdk.ResendValidationEmailOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "ResendValidationEmail")
+}
+func (unimplementedACM) UpdateCertificateOptions(_ *acmsdk.UpdateCertificateOptionsInput) (*acmsdk.UpdateCertificateOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "UpdateCertificateOptions")
+}
+func (unimplementedACM) UpdateCertificateOptionsRequest(_ *acmsdk.UpdateCertificateOptionsInput) (*request.Request, *acmsdk.UpdateCertificateOptionsOutput) {
+	return newNotImplementedRequest(ServiceACM, "UpdateCertificateOptions"), nil
+}
+func (unimplementedACM) UpdateCertificateOptionsWithContext(_ context.Context, _ *acmsdk.UpdateCertificateOptionsInput, _ ...request.Option) (*acmsdk.UpdateCertificateOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceACM, "UpdateCertificateOptions")
+}
+func (unimplementedACM) WaitUntilCertificateValidated(_ *acmsdk.DescribeCertificateInput) error {
+	return newNotImplementedError(ServiceACM, "WaitUntilCertificateValidated")
+}
+func (unimplementedACM) WaitUntilCertificateValidatedWithContext(_ context.Context, _ *acmsdk.DescribeCertificateInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceACM, "WaitUntilCertificateValidated")
+}
+
+// unimplementedCloudWatch implements cloudwatchiface.CloudWatchAPI with APIs that return NotImplemented error.
+type unimplementedCloudWatch struct{}
+
+func (unimplementedCloudWatch) DeleteAlarms(_ *cloudwatchsdk.DeleteAlarmsInput) (*cloudwatchsdk.DeleteAlarmsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteAlarms")
+}
+func (unimplementedCloudWatch) DeleteAlarmsRequest(_ *cloudwatchsdk.DeleteAlarmsInput) (*request.Request, *cloudwatchsdk.DeleteAlarmsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DeleteAlarms"), nil
+}
+func (unimplementedCloudWatch) DeleteAlarmsWithContext(_ context.Context, _ *cloudwatchsdk.DeleteAlarmsInput, _ ...request.Option) (*cloudwatchsdk.DeleteAlarmsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteAlarms")
+}
+func (unimplementedCloudWatch) DeleteAnomalyDetector(_ *cloudwatchsdk.DeleteAnomalyDetectorInput) (*cloudwatchsdk.DeleteAnomalyDetectorOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteAnomalyDetector")
+}
+func (unimplementedCloudWatch) DeleteAnomalyDetectorRequest(_ *cloudwatchsdk.DeleteAnomalyDetectorInput) (*request.Request, *cloudwatchsdk.DeleteAnomalyDetectorOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DeleteAnomalyDetector"), nil
+}
+func (unimplementedCloudWatch) DeleteAnomalyDetectorWithContext(_ context.Context, _ *cloudwatchsdk.DeleteAnomalyDetectorInput, _ ...request.Option) (*cloudwatchsdk.DeleteAnomalyDetectorOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteAnomalyDetector")
+}
+func (unimplementedCloudWatch) DeleteDashboards(_ *cloudwatchsdk.DeleteDashboardsInput) (*cloudwatchsdk.DeleteDashboardsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteDashboards")
+}
+func (unimplementedCloudWatch) DeleteDashboardsRequest(_ *cloudwatchsdk.DeleteDashboardsInput) (*request.Request, *cloudwatchsdk.DeleteDashboardsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DeleteDashboards"), nil
+}
+func (unimplementedCloudWatch) DeleteDashboardsWithContext(_ context.Context, _ *cloudwatchsdk.DeleteDashboardsInput, _ ...request.Option) (*cloudwatchsdk.DeleteDashboardsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteDashboards")
+}
+func (unimplementedCloudWatch) DeleteInsightRules(_ *cloudwatchsdk.DeleteInsightRulesInput) (*cloudwatchsdk.DeleteInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteInsightRules")
+}
+func (unimplementedCloudWatch) DeleteInsightRulesRequest(_ *cloudwatchsdk.DeleteInsightRulesInput) (*request.Request, *cloudwatchsdk.DeleteInsightRulesOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DeleteInsightRules"), nil
+}
+func (unimplementedCloudWatch) DeleteInsightRulesWithContext(_ context.Context, _ *cloudwatchsdk.DeleteInsightRulesInput, _ ...request.Option) (*cloudwatchsdk.DeleteInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteInsightRules")
+}
+func (unimplementedCloudWatch) DeleteMetricStream(_ *cloudwatchsdk.DeleteMetricStreamInput) (*cloudwatchsdk.DeleteMetricStreamOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteMetricStream")
+}
+func (unimplementedCloudWatch) DeleteMetricStreamRequest(_ *cloudwatchsdk.DeleteMetricStreamInput) (*request.Request, *cloudwatchsdk.DeleteMetricStreamOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DeleteMetricStream"), nil
+}
+func (unimplementedCloudWatch) DeleteMetricStreamWithContext(_ context.Context, _ *cloudwatchsdk.DeleteMetricStreamInput, _ ...request.Option) (*cloudwatchsdk.DeleteMetricStreamOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DeleteMetricStream")
+}
+func (unimplementedCloudWatch) DescribeAlarmHistory(_ *cloudwatchsdk.DescribeAlarmHistoryInput) (*cloudwatchsdk.DescribeAlarmHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAlarmHistory")
+}
+func (unimplementedCloudWatch) DescribeAlarmHistoryPages(_ *cloudwatchsdk.DescribeAlarmHistoryInput, _ func(*cloudwatchsdk.DescribeAlarmHistoryOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeAlarmHistory")
+}
+func (unimplementedCloudWatch) DescribeAlarmHistoryPagesWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAlarmHistoryInput, _ func(*cloudwatchsdk.DescribeAlarmHistoryOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeAlarmHistory")
+}
+func (unimplementedCloudWatch) DescribeAlarmHistoryRequest(_ *cloudwatchsdk.DescribeAlarmHistoryInput) (*request.Request, *cloudwatchsdk.DescribeAlarmHistoryOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DescribeAlarmHistory"), nil
+}
+func (unimplementedCloudWatch) DescribeAlarmHistoryWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAlarmHistoryInput, _ ...request.Option) (*cloudwatchsdk.DescribeAlarmHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAlarmHistory")
+}
+func (unimplementedCloudWatch) DescribeAlarms(_ *cloudwatchsdk.DescribeAlarmsInput) (*cloudwatchsdk.DescribeAlarmsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAlarms")
+}
+func (unimplementedCloudWatch) DescribeAlarmsForMetric(_ *cloudwatchsdk.DescribeAlarmsForMetricInput) (*cloudwatchsdk.DescribeAlarmsForMetricOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAlarmsForMetric")
+}
+func (unimplementedCloudWatch) DescribeAlarmsForMetricRequest(_ *cloudwatchsdk.DescribeAlarmsForMetricInput) (*request.Request, *cloudwatchsdk.DescribeAlarmsForMetricOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DescribeAlarmsForMetric"), nil
+}
+func (unimplementedCloudWatch) DescribeAlarmsForMetricWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAlarmsForMetricInput, _ ...request.Option) (*cloudwatchsdk.DescribeAlarmsForMetricOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAlarmsForMetric")
+}
+func (unimplementedCloudWatch) DescribeAlarmsPages(_ *cloudwatchsdk.DescribeAlarmsInput, _ func(*cloudwatchsdk.DescribeAlarmsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeAlarms")
+}
+func (unimplementedCloudWatch) DescribeAlarmsPagesWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAlarmsInput, _ func(*cloudwatchsdk.DescribeAlarmsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeAlarms")
+}
+func (unimplementedCloudWatch) DescribeAlarmsRequest(_ *cloudwatchsdk.DescribeAlarmsInput) (*request.Request, *cloudwatchsdk.DescribeAlarmsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DescribeAlarms"), nil
+}
+func (unimplementedCloudWatch) DescribeAlarmsWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAlarmsInput, _ ...request.Option) (*cloudwatchsdk.DescribeAlarmsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAlarms")
+}
+func (unimplementedCloudWatch) DescribeAnomalyDetectors(_ *cloudwatchsdk.DescribeAnomalyDetectorsInput) (*cloudwatchsdk.DescribeAnomalyDetectorsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAnomalyDetectors")
+}
+func (unimplementedCloudWatch) DescribeAnomalyDetectorsPages(_ *cloudwatchsdk.DescribeAnomalyDetectorsInput, _ func(*cloudwatchsdk.DescribeAnomalyDetectorsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeAnomalyDetectors")
+}
+func (unimplementedCloudWatch) DescribeAnomalyDetectorsPagesWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAnomalyDetectorsInput, _ func(*cloudwatchsdk.DescribeAnomalyDetectorsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeAnomalyDetectors")
+}
+func (unimplementedCloudWatch) DescribeAnomalyDetectorsRequest(_ *cloudwatchsdk.DescribeAnomalyDetectorsInput) (*request.Request, *cloudwatchsdk.DescribeAnomalyDetectorsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DescribeAnomalyDetectors"), nil
+}
+func (unimplementedCloudWatch) DescribeAnomalyDetectorsWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAnomalyDetectorsInput, _ ...request.Option) (*cloudwatchsdk.DescribeAnomalyDetectorsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeAnomalyDetectors")
+}
+func (unimplementedCloudWatch) DescribeInsightRules(_ *cloudwatchsdk.DescribeInsightRulesInput) (*cloudwatchsdk.DescribeInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeInsightRules")
+}
+func (unimplementedCloudWatch) DescribeInsightRulesPages(_ *cloudwatchsdk.DescribeInsightRulesInput, _ func(*cloudwatchsdk.DescribeInsightRulesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeInsightRules")
+}
+func (unimplementedCloudWatch) DescribeInsightRulesPagesWithContext(_ context.Context, _ *cloudwatchsdk.DescribeInsightRulesInput, _ func(*cloudwatchsdk.DescribeInsightRulesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "DescribeInsightRules")
+}
+func (unimplementedCloudWatch) DescribeInsightRulesRequest(_ *cloudwatchsdk.DescribeInsightRulesInput) (*request.Request, *cloudwatchsdk.DescribeInsightRulesOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DescribeInsightRules"), nil
+}
+func (unimplementedCloudWatch) DescribeInsightRulesWithContext(_ context.Context, _ *cloudwatchsdk.DescribeInsightRulesInput, _ ...request.Option) (*cloudwatchsdk.DescribeInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DescribeInsightRules")
+}
+func (unimplementedCloudWatch) DisableAlarmActions(_ *cloudwatchsdk.DisableAlarmActionsInput) (*cloudwatchsdk.DisableAlarmActionsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DisableAlarmActions")
+}
+func (unimplementedCloudWatch) DisableAlarmActionsRequest(_ *cloudwatchsdk.DisableAlarmActionsInput) (*request.Request, *cloudwatchsdk.DisableAlarmActionsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DisableAlarmActions"), nil
+}
+func (unimplementedCloudWatch) DisableAlarmActionsWithContext(_ context.Context, _ *cloudwatchsdk.DisableAlarmActionsInput, _ ...request.Option) (*cloudwatchsdk.DisableAlarmActionsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DisableAlarmActions")
+}
+func (unimplementedCloudWatch) DisableInsightRules(_ *cloudwatchsdk.DisableInsightRulesInput) (*cloudwatchsdk.DisableInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DisableInsightRules")
+}
+func (unimplementedCloudWatch) DisableInsightRulesRequest(_ *cloudwatchsdk.DisableInsightRulesInput) (*request.Request, *cloudwatchsdk.DisableInsightRulesOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "DisableInsightRules"), nil
+}
+func (unimplementedCloudWatch) DisableInsightRulesWithContext(_ context.Context, _ *cloudwatchsdk.DisableInsightRulesInput, _ ...request.Option) (*cloudwatchsdk.DisableInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "DisableInsightRules")
+}
+func (unimplementedCloudWatch) EnableAlarmActions(_ *cloudwatchsdk.EnableAlarmActionsInput) (*cloudwatchsdk.EnableAlarmActionsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "EnableAlarmActions")
+}
+func (unimplementedCloudWatch) EnableAlarmActionsRequest(_ *cloudwatchsdk.EnableAlarmActionsInput) (*request.Request, *cloudwatchsdk.EnableAlarmActionsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "EnableAlarmActions"), nil
+}
+func (unimplementedCloudWatch) EnableAlarmActionsWithContext(_ context.Context, _ *cloudwatchsdk.EnableAlarmActionsInput, _ ...request.Option) (*cloudwatchsdk.EnableAlarmActionsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "EnableAlarmActions")
+}
+func (unimplementedCloudWatch) EnableInsightRules(_ *cloudwatchsdk.EnableInsightRulesInput) (*cloudwatchsdk.EnableInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "EnableInsightRules")
+}
+func (unimplementedCloudWatch) EnableInsightRulesRequest(_ *cloudwatchsdk.EnableInsightRulesInput) (*request.Request, *cloudwatchsdk.EnableInsightRulesOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "EnableInsightRules"), nil
+}
+func (unimplementedCloudWatch) EnableInsightRulesWithContext(_ context.Context, _ *cloudwatchsdk.EnableInsightRulesInput, _ ...request.Option) (*cloudwatchsdk.EnableInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "EnableInsightRules")
+}
+func (unimplementedCloudWatch) GetDashboard(_ *cloudwatchsdk.GetDashboardInput) (*cloudwatchsdk.GetDashboardOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetDashboard")
+}
+func (unimplementedCloudWatch) GetDashboardRequest(_ *cloudwatchsdk.GetDashboardInput) (*request.Request, *cloudwatchsdk.GetDashboardOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "GetDashboard"), nil
+}
+func (unimplementedCloudWatch) GetDashboardWithContext(_ context.Context, _ *cloudwatchsdk.GetDashboardInput, _ ...request.Option) (*cloudwatchsdk.GetDashboardOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetDashboard")
+}
+func (unimplementedCloudWatch) GetInsightRuleReport(_ *cloudwatchsdk.GetInsightRuleReportInput) (*cloudwatchsdk.GetInsightRuleReportOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetInsightRuleReport")
+}
+func (unimplementedCloudWatch) GetInsightRuleReportRequest(_ *cloudwatchsdk.GetInsightRuleReportInput) (*request.Request, *cloudwatchsdk.GetInsightRuleReportOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "GetInsightRuleReport"), nil
+}
+func (unimplementedCloudWatch) GetInsightRuleReportWithContext(_ context.Context, _ *cloudwatchsdk.GetInsightRuleReportInput, _ ...request.Option) (*cloudwatchsdk.GetInsightRuleReportOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetInsightRuleReport")
+}
+func (unimplementedCloudWatch) GetMetricData(_ *cloudwatchsdk.GetMetricDataInput) (*cloudwatchsdk.GetMetricDataOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricData")
+}
+func (unimplementedCloudWatch) GetMetricDataPages(_ *cloudwatchsdk.GetMetricDataInput, _ func(*cloudwatchsdk.GetMetricDataOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "GetMetricData")
+}
+func (unimplementedCloudWatch) GetMetricDataPagesWithContext(_ context.Context, _ *cloudwatchsdk.GetMetricDataInput, _ func(*cloudwatchsdk.GetMetricDataOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "GetMetricData")
+}
+func (unimplementedCloudWatch) GetMetricDataRequest(_ *cloudwatchsdk.GetMetricDataInput) (*request.Request, *cloudwatchsdk.GetMetricDataOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "GetMetricData"), nil
+}
+func (unimplementedCloudWatch) GetMetricDataWithContext(_ context.Context, _ *cloudwatchsdk.GetMetricDataInput, _ ...request.Option) (*cloudwatchsdk.GetMetricDataOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricData")
+}
+func (unimplementedCloudWatch) GetMetricStatistics(_ *cloudwatchsdk.GetMetricStatisticsInput) (*cloudwatchsdk.GetMetricStatisticsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricStatistics")
+}
+func (unimplementedCloudWatch) GetMetricStatisticsRequest(_ *cloudwatchsdk.GetMetricStatisticsInput) (*request.Request, *cloudwatchsdk.GetMetricStatisticsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "GetMetricStatistics"), nil
+}
+func (unimplementedCloudWatch) GetMetricStatisticsWithContext(_ context.Context, _ *cloudwatchsdk.GetMetricStatisticsInput, _ ...request.Option) (*cloudwatchsdk.GetMetricStatisticsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricStatistics")
+}
+func (unimplementedCloudWatch) GetMetricStream(_ *cloudwatchsdk.GetMetricStreamInput) (*cloudwatchsdk.GetMetricStreamOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricStream")
+}
+func (unimplementedCloudWatch) GetMetricStreamRequest(_ *cloudwatchsdk.GetMetricStreamInput) (*request.Request, *cloudwatchsdk.GetMetricStreamOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "GetMetricStream"), nil
+}
+func (unimplementedCloudWatch) GetMetricStreamWithContext(_ context.Context, _ *cloudwatchsdk.GetMetricStreamInput, _ ...request.Option) (*cloudwatchsdk.GetMetricStreamOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricStream")
+}
+func (unimplementedCloudWatch) GetMetricWidgetImage(_ *cloudwatchsdk.GetMetricWidgetImageInput) (*cloudwatchsdk.GetMetricWidgetImageOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricWidgetImage")
+}
+func (unimplementedCloudWatch) GetMetricWidgetImageRequest(_ *cloudwatchsdk.GetMetricWidgetImageInput) (*request.Request, *cloudwatchsdk.GetMetricWidgetImageOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "GetMetricWidgetImage"), nil
+}
+func (unimplementedCloudWatch) GetMetricWidgetImageWithContext(_ context.Context, _ *cloudwatchsdk.GetMetricWidgetImageInput, _ ...request.Option) (*cloudwatchsdk.GetMetricWidgetImageOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "GetMetricWidgetImage")
+}
+func (unimplementedCloudWatch) ListDashboards(_ *cloudwatchsdk.ListDashboardsInput) (*cloudwatchsdk.ListDashboardsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListDashboards")
+}
+func (unimplementedCloudWatch) ListDashboardsPages(_ *cloudwatchsdk.ListDashboardsInput, _ func(*cloudwatchsdk.ListDashboardsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListDashboards")
+}
+func (unimplementedCloudWatch) ListDashboardsPagesWithContext(_ context.Context, _ *cloudwatchsdk.ListDashboardsInput, _ func(*cloudwatchsdk.ListDashboardsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListDashboards")
+}
+func (unimplementedCloudWatch) ListDashboardsRequest(_ *cloudwatchsdk.ListDashboardsInput) (*request.Request, *cloudwatchsdk.ListDashboardsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "ListDashboards"), nil
+}
+func (unimplementedCloudWatch) ListDashboardsWithContext(_ context.Context, _ *cloudwatchsdk.ListDashboardsInput, _ ...request.Option) (*cloudwatchsdk.ListDashboardsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListDashboards")
+}
+func (unimplementedCloudWatch) ListManagedInsightRules(_ *cloudwatchsdk.ListManagedInsightRulesInput) (*cloudwatchsdk.ListManagedInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListManagedInsightRules")
+}
+func (unimplementedCloudWatch) ListManagedInsightRulesPages(_ *cloudwatchsdk.ListManagedInsightRulesInput, _ func(*cloudwatchsdk.ListManagedInsightRulesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListManagedInsightRules")
+}
+func (unimplementedCloudWatch) ListManagedInsightRulesPagesWithContext(_ context.Context, _ *cloudwatchsdk.ListManagedInsightRulesInput, _ func(*cloudwatchsdk.ListManagedInsightRulesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListManagedInsightRules")
+}
+func (unimplementedCloudWatch) ListManagedInsightRulesRequest(_ *cloudwatchsdk.ListManagedInsightRulesInput) (*request.Request, *cloudwatchsdk.ListManagedInsightRulesOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "ListManagedInsightRules"), nil
+}
+func (unimplementedCloudWatch) ListManagedInsightRulesWithContext(_ context.Context, _ *cloudwatchsdk.ListManagedInsightRulesInput, _ ...request.Option) (*cloudwatchsdk.ListManagedInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListManagedInsightRules")
+}
+func (unimplementedCloudWatch) ListMetricStreams(_ *cloudwatchsdk.ListMetricStreamsInput) (*cloudwatchsdk.ListMetricStreamsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListMetricStreams")
+}
+func (unimplementedCloudWatch) ListMetricStreamsPages(_ *cloudwatchsdk.ListMetricStreamsInput, _ func(*cloudwatchsdk.ListMetricStreamsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListMetricStreams")
+}
+func (unimplementedCloudWatch) ListMetricStreamsPagesWithContext(_ context.Context, _ *cloudwatchsdk.ListMetricStreamsInput, _ func(*cloudwatchsdk.ListMetricStreamsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListMetricStreams")
+}
+func (unimplementedCloudWatch) ListMetricStreamsRequest(_ *cloudwatchsdk.ListMetricStreamsInput) (*request.Request, *cloudwatchsdk.ListMetricStreamsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "ListMetricStreams"), nil
+}
+func (unimplementedCloudWatch) ListMetricStreamsWithContext(_ context.Context, _ *cloudwatchsdk.ListMetricStreamsInput, _ ...request.Option) (*cloudwatchsdk.ListMetricStreamsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListMetricStreams")
+}
+func (unimplementedCloudWatch) ListMetrics(_ *cloudwatchsdk.ListMetricsInput) (*cloudwatchsdk.ListMetricsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListMetrics")
+}
+func (unimplementedCloudWatch) ListMetricsPages(_ *cloudwatchsdk.ListMetricsInput, _ func(*cloudwatchsdk.ListMetricsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListMetrics")
+}
+func (unimplementedCloudWatch) ListMetricsPagesWithContext(_ context.Context, _ *cloudwatchsdk.ListMetricsInput, _ func(*cloudwatchsdk.ListMetricsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceCloudWatch, "ListMetrics")
+}
+func (unimplementedCloudWatch) ListMetricsRequest(_ *cloudwatchsdk.ListMetricsInput) (*request.Request, *cloudwatchsdk.ListMetricsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "ListMetrics"), nil
+}
+func (unimplementedCloudWatch) ListMetricsWithContext(_ context.Context, _ *cloudwatchsdk.ListMetricsInput, _ ...request.Option) (*cloudwatchsdk.ListMetricsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListMetrics")
+}
+func (unimplementedCloudWatch) ListTagsForResource(_ *cloudwatchsdk.ListTagsForResourceInput) (*cloudwatchsdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListTagsForResource")
+}
+func (unimplementedCloudWatch) ListTagsForResourceRequest(_ *cloudwatchsdk.ListTagsForResourceInput) (*request.Request, *cloudwatchsdk.ListTagsForResourceOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "ListTagsForResource"), nil
+}
+func (unimplementedCloudWatch) ListTagsForResourceWithContext(_ context.Context, _ *cloudwatchsdk.ListTagsForResourceInput, _ ...request.Option) (*cloudwatchsdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "ListTagsForResource")
+}
+func (unimplementedCloudWatch) PutAnomalyDetector(_ *cloudwatchsdk.PutAnomalyDetectorInput) (*cloudwatchsdk.PutAnomalyDetectorOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutAnomalyDetector")
+}
+func (unimplementedCloudWatch) PutAnomalyDetectorRequest(_ *cloudwatchsdk.PutAnomalyDetectorInput) (*request.Request, *cloudwatchsdk.PutAnomalyDetectorOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutAnomalyDetector"), nil
+}
+func (unimplementedCloudWatch) PutAnomalyDetectorWithContext(_ context.Context, _ *cloudwatchsdk.PutAnomalyDetectorInput, _ ...request.Option) (*cloudwatchsdk.PutAnomalyDetectorOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutAnomalyDetector")
+}
+func (unimplementedCloudWatch) PutCompositeAlarm(_ *cloudwatchsdk.PutCompositeAlarmInput) (*cloudwatchsdk.PutCompositeAlarmOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutCompositeAlarm")
+}
+func (unimplementedCloudWatch) PutCompositeAlarmRequest(_ *cloudwatchsdk.PutCompositeAlarmInput) (*request.Request, *cloudwatchsdk.PutCompositeAlarmOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutCompositeAlarm"), nil
+}
+func (unimplementedCloudWatch) PutCompositeAlarmWithContext(_ context.Context, _ *cloudwatchsdk.PutCompositeAlarmInput, _ ...request.Option) (*cloudwatchsdk.PutCompositeAlarmOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutCompositeAlarm")
+}
+func (unimplementedCloudWatch) PutDashboard(_ *cloudwatchsdk.PutDashboardInput) (*cloudwatchsdk.PutDashboardOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutDashboard")
+}
+func (unimplementedCloudWatch) PutDashboardRequest(_ *cloudwatchsdk.PutDashboardInput) (*request.Request, *cloudwatchsdk.PutDashboardOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutDashboard"), nil
+}
+func (unimplementedCloudWatch) PutDashboardWithContext(_ context.Context, _ *cloudwatchsdk.PutDashboardInput, _ ...request.Option) (*cloudwatchsdk.PutDashboardOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutDashboard")
+}
+func (unimplementedCloudWatch) PutInsightRule(_ *cloudwatchsdk.PutInsightRuleInput) (*cloudwatchsdk.PutInsightRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutInsightRule")
+}
+func (unimplementedCloudWatch) PutInsightRuleRequest(_ *cloudwatchsdk.PutInsightRuleInput) (*request.Request, *cloudwatchsdk.PutInsightRuleOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutInsightRule"), nil
+}
+func (unimplementedCloudWatch) PutInsightRuleWithContext(_ context.Context, _ *cloudwatchsdk.PutInsightRuleInput, _ ...request.Option) (*cloudwatchsdk.PutInsightRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutInsightRule")
+}
+func (unimplementedCloudWatch) PutManagedInsightRules(_ *cloudwatchsdk.PutManagedInsightRulesInput) (*cloudwatchsdk.PutManagedInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutManagedInsightRules")
+}
+func (unimplementedCloudWatch) PutManagedInsightRulesRequest(_ *cloudwatchsdk.PutManagedInsightRulesInput) (*request.Request, *cloudwatchsdk.PutManagedInsightRulesOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutManagedInsightRules"), nil
+}
+func (unimplementedCloudWatch) PutManagedInsightRulesWithContext(_ context.Context, _ *cloudwatchsdk.PutManagedInsightRulesInput, _ ...request.Option) (*cloudwatchsdk.PutManagedInsightRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutManagedInsightRules")
+}
+func (unimplementedCloudWatch) PutMetricAlarm(_ *cloudwatchsdk.PutMetricAlarmInput) (*cloudwatchsdk.PutMetricAlarmOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutMetricAlarm")
+}
+func (unimplementedCloudWatch) PutMetricAlarmRequest(_ *cloudwatchsdk.PutMetricAlarmInput) (*request.Request, *cloudwatchsdk.PutMetricAlarmOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutMetricAlarm"), nil
+}
+func (unimplementedCloudWatch) PutMetricAlarmWithContext(_ context.Context, _ *cloudwatchsdk.PutMetricAlarmInput, _ ...request.Option) (*cloudwatchsdk.PutMetricAlarmOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutMetricAlarm")
+}
+func (unimplementedCloudWatch) PutMetricData(_ *cloudwatchsdk.PutMetricDataInput) (*cloudwatchsdk.PutMetricDataOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutMetricData")
+}
+func (unimplementedCloudWatch) PutMetricDataRequest(_ *cloudwatchsdk.PutMetricDataInput) (*request.Request, *cloudwatchsdk.PutMetricDataOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutMetricData"), nil
+}
+func (unimplementedCloudWatch) PutMetricDataWithContext(_ context.Context, _ *cloudwatchsdk.PutMetricDataInput, _ ...request.Option) (*cloudwatchsdk.PutMetricDataOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutMetricData")
+}
+func (unimplementedCloudWatch) PutMetricStream(_ *cloudwatchsdk.PutMetricStreamInput) (*cloudwatchsdk.PutMetricStreamOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutMetricStream")
+}
+func (unimplementedCloudWatch) PutMetricStreamRequest(_ *cloudwatchsdk.PutMetricStreamInput) (*request.Request, *cloudwatchsdk.PutMetricStreamOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "PutMetricStream"), nil
+}
+func (unimplementedCloudWatch) PutMetricStreamWithContext(_ context.Context, _ *cloudwatchsdk.PutMetricStreamInput, _ ...request.Option) (*cloudwatchsdk.PutMetricStreamOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "PutMetricStream")
+}
+func (unimplementedCloudWatch) SetAlarmState(_ *cloudwatchsdk.SetAlarmStateInput) (*cloudwatchsdk.SetAlarmStateOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "SetAlarmState")
+}
+func (unimplementedCloudWatch) SetAlarmStateRequest(_ *cloudwatchsdk.SetAlarmStateInput) (*request.Request, *cloudwatchsdk.SetAlarmStateOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "SetAlarmState"), nil
+}
+func (unimplementedCloudWatch) SetAlarmStateWithContext(_ context.Context, _ *cloudwatchsdk.SetAlarmStateInput, _ ...request.Option) (*cloudwatchsdk.SetAlarmStateOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "SetAlarmState")
+}
+func (unimplementedCloudWatch) StartMetricStreams(_ *cloudwatchsdk.StartMetricStreamsInput) (*cloudwatchsdk.StartMetricStreamsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "StartMetricStreams")
+}
+func (unimplementedCloudWatch) StartMetricStreamsRequest(_ *cloudwatchsdk.StartMetricStreamsInput) (*request.Request, *cloudwatchsdk.StartMetricStreamsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "StartMetricStreams"), nil
+}
+func (unimplementedCloudWatch) StartMetricStreamsWithContext(_ context.Context, _ *cloudwatchsdk.StartMetricStreamsInput, _ ...request.Option) (*cloudwatchsdk.StartMetricStreamsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "StartMetricStreams")
+}
+func (unimplementedCloudWatch) StopMetricStreams(_ *cloudwatchsdk.StopMetricStreamsInput) (*cloudwatchsdk.StopMetricStreamsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "StopMetricStreams")
+}
+func (unimplementedCloudWatch) StopMetricStreamsRequest(_ *cloudwatchsdk.StopMetricStreamsInput) (*request.Request, *cloudwatchsdk.StopMetricStreamsOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "StopMetricStreams"), nil
+}
+func (unimplementedCloudWatch) StopMetricStreamsWithContext(_ context.Context, _ *cloudwatchsdk.StopMetricStreamsInput, _ ...request.Option) (*cloudwatchsdk.StopMetricStreamsOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "StopMetricStreams")
+}
+func (unimplementedCloudWatch) TagResource(_ *cloudwatchsdk.TagResourceInput) (*cloudwatchsdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "TagResource")
+}
+func (unimplementedCloudWatch) TagResourceRequest(_ *cloudwatchsdk.TagResourceInput) (*request.Request, *cloudwatchsdk.TagResourceOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "TagResource"), nil
+}
+func (unimplementedCloudWatch) TagResourceWithContext(_ context.Context, _ *cloudwatchsdk.TagResourceInput, _ ...request.Option) (*cloudwatchsdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "TagResource")
+}
+func (unimplementedCloudWatch) UntagResource(_ *cloudwatchsdk.UntagResourceInput) (*cloudwatchsdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "UntagResource")
+}
+func (unimplementedCloudWatch) UntagResourceRequest(_ *cloudwatchsdk.UntagResourceInput) (*request.Request, *cloudwatchsdk.UntagResourceOutput) {
+	return newNotImplementedRequest(ServiceCloudWatch, "UntagResource"), nil
+}
+func (unimplementedCloudWatch) UntagResourceWithContext(_ context.Context, _ *cloudwatchsdk.UntagResourceInput, _ ...request.Option) (*cloudwatchsdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceCloudWatch, "UntagResource")
+}
+func (unimplementedCloudWatch) WaitUntilAlarmExists(_ *cloudwatchsdk.DescribeAlarmsInput) error {
+	return newNotImplementedError(ServiceCloudWatch, "WaitUntilAlarmExists")
+}
+func (unimplementedCloudWatch) WaitUntilAlarmExistsWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAlarmsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceCloudWatch, "WaitUntilAlarmExists")
+}
+func (unimplementedCloudWatch) WaitUntilCompositeAlarmExists(_ *cloudwatchsdk.DescribeAlarmsInput) error {
+	return newNotImplementedError(ServiceCloudWatch, "WaitUntilCompositeAlarmExists")
+}
+func (unimplementedCloudWatch) WaitUntilCompositeAlarmExistsWithContext(_ context.Context, _ *cloudwatchsdk.DescribeAlarmsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceCloudWatch, "WaitUntilCompositeAlarmExists")
+}
+
+// unimplementedEC2 implements ec2iface.EC2API with APIs that return NotImplemented error.
+type unimplementedEC2 struct{}
+
+func (unimplementedEC2) AcceptAddressTransfer(_ *ec2sdk.AcceptAddressTransferInput) (*ec2sdk.AcceptAddressTransferOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptAddressTransfer")
+}
+func (unimplementedEC2) AcceptAddressTransferRequest(_ *ec2sdk.AcceptAddressTransferInput) (*request.Request, *ec2sdk.AcceptAddressTransferOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AcceptAddressTransfer"), nil
+}
+func (unimplementedEC2) AcceptAddressTransferWithContext(_ context.Context, _ *ec2sdk.AcceptAddressTransferInput, _ ...request.Option) (*ec2sdk.AcceptAddressTransferOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptAddressTransfer")
+}
+func (unimplementedEC2) AcceptReservedInstancesExchangeQuote(_ *ec2sdk.AcceptReservedInstancesExchangeQuoteInput) (*ec2sdk.AcceptReservedInstancesExchangeQuoteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptReservedInstancesExchangeQuote")
+}
+func (unimplementedEC2) AcceptReservedInstancesExchangeQuoteRequest(_ *ec2sdk.AcceptReservedInstancesExchangeQuoteInput) (*request.Request, *ec2sdk.AcceptReservedInstancesExchangeQuoteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AcceptReservedInstancesExchangeQuote"), nil
+}
+func (unimplementedEC2) AcceptReservedInstancesExchangeQuoteWithContext(_ context.Context, _ *ec2sdk.AcceptReservedInstancesExchangeQuoteInput, _ ...request.Option) (*ec2sdk.AcceptReservedInstancesExchangeQuoteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptReservedInstancesExchangeQuote")
+}
+func (unimplementedEC2) AcceptTransitGatewayMulticastDomainAssociations(_ *ec2sdk.AcceptTransitGatewayMulticastDomainAssociationsInput) (*ec2sdk.AcceptTransitGatewayMulticastDomainAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) AcceptTransitGatewayMulticastDomainAssociationsRequest(_ *ec2sdk.AcceptTransitGatewayMulticastDomainAssociationsInput) (*request.Request, *ec2sdk.AcceptTransitGatewayMulticastDomainAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AcceptTransitGatewayMulticastDomainAssociations"), nil
+}
+func (unimplementedEC2) AcceptTransitGatewayMulticastDomainAssociationsWithContext(_ context.Context, _ *ec2sdk.AcceptTransitGatewayMulticastDomainAssociationsInput, _ ...request.Option) (*ec2sdk.AcceptTransitGatewayMulticastDomainAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) AcceptTransitGatewayPeeringAttachment(_ *ec2sdk.AcceptTransitGatewayPeeringAttachmentInput) (*ec2sdk.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) AcceptTransitGatewayPeeringAttachmentRequest(_ *ec2sdk.AcceptTransitGatewayPeeringAttachmentInput) (*request.Request, *ec2sdk.AcceptTransitGatewayPeeringAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AcceptTransitGatewayPeeringAttachment"), nil
+}
+func (unimplementedEC2) AcceptTransitGatewayPeeringAttachmentWithContext(_ context.Context, _ *ec2sdk.AcceptTransitGatewayPeeringAttachmentInput, _ ...request.Option) (*ec2sdk.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) AcceptTransitGatewayVpcAttachment(_ *ec2sdk.AcceptTransitGatewayVpcAttachmentInput) (*ec2sdk.AcceptTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) AcceptTransitGatewayVpcAttachmentRequest(_ *ec2sdk.AcceptTransitGatewayVpcAttachmentInput) (*request.Request, *ec2sdk.AcceptTransitGatewayVpcAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AcceptTransitGatewayVpcAttachment"), nil
+}
+func (unimplementedEC2) AcceptTransitGatewayVpcAttachmentWithContext(_ context.Context, _ *ec2sdk.AcceptTransitGatewayVpcAttachmentInput, _ ...request.Option) (*ec2sdk.AcceptTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) AcceptVpcEndpointConnections(_ *ec2sdk.AcceptVpcEndpointConnectionsInput) (*ec2sdk.AcceptVpcEndpointConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptVpcEndpointConnections")
+}
+func (unimplementedEC2) AcceptVpcEndpointConnectionsRequest(_ *ec2sdk.AcceptVpcEndpointConnectionsInput) (*request.Request, *ec2sdk.AcceptVpcEndpointConnectionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AcceptVpcEndpointConnections"), nil
+}
+func (unimplementedEC2) AcceptVpcEndpointConnectionsWithContext(_ context.Context, _ *ec2sdk.AcceptVpcEndpointConnectionsInput, _ ...request.Option) (*ec2sdk.AcceptVpcEndpointConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptVpcEndpointConnections")
+}
+func (unimplementedEC2) AcceptVpcPeeringConnection(_ *ec2sdk.AcceptVpcPeeringConnectionInput) (*ec2sdk.AcceptVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptVpcPeeringConnection")
+}
+func (unimplementedEC2) AcceptVpcPeeringConnectionRequest(_ *ec2sdk.AcceptVpcPeeringConnectionInput) (*request.Request, *ec2sdk.AcceptVpcPeeringConnectionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AcceptVpcPeeringConnection"), nil
+}
+func (unimplementedEC2) AcceptVpcPeeringConnectionWithContext(_ context.Context, _ *ec2sdk.AcceptVpcPeeringConnectionInput, _ ...request.Option) (*ec2sdk.AcceptVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AcceptVpcPeeringConnection")
+}
+func (unimplementedEC2) AdvertiseByoipCidr(_ *ec2sdk.AdvertiseByoipCidrInput) (*ec2sdk.AdvertiseByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AdvertiseByoipCidr")
+}
+func (unimplementedEC2) AdvertiseByoipCidrRequest(_ *ec2sdk.AdvertiseByoipCidrInput) (*request.Request, *ec2sdk.AdvertiseByoipCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AdvertiseByoipCidr"), nil
+}
+func (unimplementedEC2) AdvertiseByoipCidrWithContext(_ context.Context, _ *ec2sdk.AdvertiseByoipCidrInput, _ ...request.Option) (*ec2sdk.AdvertiseByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AdvertiseByoipCidr")
+}
+func (unimplementedEC2) AllocateAddress(_ *ec2sdk.AllocateAddressInput) (*ec2sdk.AllocateAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AllocateAddress")
+}
+func (unimplementedEC2) AllocateAddressRequest(_ *ec2sdk.AllocateAddressInput) (*request.Request, *ec2sdk.AllocateAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AllocateAddress"), nil
+}
+func (unimplementedEC2) AllocateAddressWithContext(_ context.Context, _ *ec2sdk.AllocateAddressInput, _ ...request.Option) (*ec2sdk.AllocateAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AllocateAddress")
+}
+func (unimplementedEC2) AllocateHosts(_ *ec2sdk.AllocateHostsInput) (*ec2sdk.AllocateHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AllocateHosts")
+}
+func (unimplementedEC2) AllocateHostsRequest(_ *ec2sdk.AllocateHostsInput) (*request.Request, *ec2sdk.AllocateHostsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AllocateHosts"), nil
+}
+func (unimplementedEC2) AllocateHostsWithContext(_ context.Context, _ *ec2sdk.AllocateHostsInput, _ ...request.Option) (*ec2sdk.AllocateHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AllocateHosts")
+}
+func (unimplementedEC2) AllocateIpamPoolCidr(_ *ec2sdk.AllocateIpamPoolCidrInput) (*ec2sdk.AllocateIpamPoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AllocateIpamPoolCidr")
+}
+func (unimplementedEC2) AllocateIpamPoolCidrRequest(_ *ec2sdk.AllocateIpamPoolCidrInput) (*request.Request, *ec2sdk.AllocateIpamPoolCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AllocateIpamPoolCidr"), nil
+}
+func (unimplementedEC2) AllocateIpamPoolCidrWithContext(_ context.Context, _ *ec2sdk.AllocateIpamPoolCidrInput, _ ...request.Option) (*ec2sdk.AllocateIpamPoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AllocateIpamPoolCidr")
+}
+func (unimplementedEC2) ApplySecurityGroupsToClientVpnTargetNetwork(_ *ec2sdk.ApplySecurityGroupsToClientVpnTargetNetworkInput) (*ec2sdk.ApplySecurityGroupsToClientVpnTargetNetworkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ApplySecurityGroupsToClientVpnTargetNetwork")
+}
+func (unimplementedEC2) ApplySecurityGroupsToClientVpnTargetNetworkRequest(_ *ec2sdk.ApplySecurityGroupsToClientVpnTargetNetworkInput) (*request.Request, *ec2sdk.ApplySecurityGroupsToClientVpnTargetNetworkOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ApplySecurityGroupsToClientVpnTargetNetwork"), nil
+}
+func (unimplementedEC2) ApplySecurityGroupsToClientVpnTargetNetworkWithContext(_ context.Context, _ *ec2sdk.ApplySecurityGroupsToClientVpnTargetNetworkInput, _ ...request.Option) (*ec2sdk.ApplySecurityGroupsToClientVpnTargetNetworkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ApplySecurityGroupsToClientVpnTargetNetwork")
+}
+func (unimplementedEC2) AssignIpv6Addresses(_ *ec2sdk.AssignIpv6AddressesInput) (*ec2sdk.AssignIpv6AddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssignIpv6Addresses")
+}
+func (unimplementedEC2) AssignIpv6AddressesRequest(_ *ec2sdk.AssignIpv6AddressesInput) (*request.Request, *ec2sdk.AssignIpv6AddressesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssignIpv6Addresses"), nil
+}
+func (unimplementedEC2) AssignIpv6AddressesWithContext(_ context.Context, _ *ec2sdk.AssignIpv6AddressesInput, _ ...request.Option) (*ec2sdk.AssignIpv6AddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssignIpv6Addresses")
+}
+func (unimplementedEC2) AssignPrivateIpAddresses(_ *ec2sdk.AssignPrivateIpAddressesInput) (*ec2sdk.AssignPrivateIpAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssignPrivateIpAddresses")
+}
+func (unimplementedEC2) AssignPrivateIpAddressesRequest(_ *ec2sdk.AssignPrivateIpAddressesInput) (*request.Request, *ec2sdk.AssignPrivateIpAddressesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssignPrivateIpAddresses"), nil
+}
+func (unimplementedEC2) AssignPrivateIpAddressesWithContext(_ context.Context, _ *ec2sdk.AssignPrivateIpAddressesInput, _ ...request.Option) (*ec2sdk.AssignPrivateIpAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssignPrivateIpAddresses")
+}
+func (unimplementedEC2) AssignPrivateNatGatewayAddress(_ *ec2sdk.AssignPrivateNatGatewayAddressInput) (*ec2sdk.AssignPrivateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssignPrivateNatGatewayAddress")
+}
+func (unimplementedEC2) AssignPrivateNatGatewayAddressRequest(_ *ec2sdk.AssignPrivateNatGatewayAddressInput) (*request.Request, *ec2sdk.AssignPrivateNatGatewayAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssignPrivateNatGatewayAddress"), nil
+}
+func (unimplementedEC2) AssignPrivateNatGatewayAddressWithContext(_ context.Context, _ *ec2sdk.AssignPrivateNatGatewayAddressInput, _ ...request.Option) (*ec2sdk.AssignPrivateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssignPrivateNatGatewayAddress")
+}
+func (unimplementedEC2) AssociateAddress(_ *ec2sdk.AssociateAddressInput) (*ec2sdk.AssociateAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateAddress")
+}
+func (unimplementedEC2) AssociateAddressRequest(_ *ec2sdk.AssociateAddressInput) (*request.Request, *ec2sdk.AssociateAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateAddress"), nil
+}
+func (unimplementedEC2) AssociateAddressWithContext(_ context.Context, _ *ec2sdk.AssociateAddressInput, _ ...request.Option) (*ec2sdk.AssociateAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateAddress")
+}
+func (unimplementedEC2) AssociateClientVpnTargetNetwork(_ *ec2sdk.AssociateClientVpnTargetNetworkInput) (*ec2sdk.AssociateClientVpnTargetNetworkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateClientVpnTargetNetwork")
+}
+func (unimplementedEC2) AssociateClientVpnTargetNetworkRequest(_ *ec2sdk.AssociateClientVpnTargetNetworkInput) (*request.Request, *ec2sdk.AssociateClientVpnTargetNetworkOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateClientVpnTargetNetwork"), nil
+}
+func (unimplementedEC2) AssociateClientVpnTargetNetworkWithContext(_ context.Context, _ *ec2sdk.AssociateClientVpnTargetNetworkInput, _ ...request.Option) (*ec2sdk.AssociateClientVpnTargetNetworkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateClientVpnTargetNetwork")
+}
+func (unimplementedEC2) AssociateDhcpOptions(_ *ec2sdk.AssociateDhcpOptionsInput) (*ec2sdk.AssociateDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateDhcpOptions")
+}
+func (unimplementedEC2) AssociateDhcpOptionsRequest(_ *ec2sdk.AssociateDhcpOptionsInput) (*request.Request, *ec2sdk.AssociateDhcpOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateDhcpOptions"), nil
+}
+func (unimplementedEC2) AssociateDhcpOptionsWithContext(_ context.Context, _ *ec2sdk.AssociateDhcpOptionsInput, _ ...request.Option) (*ec2sdk.AssociateDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateDhcpOptions")
+}
+func (unimplementedEC2) AssociateEnclaveCertificateIamRole(_ *ec2sdk.AssociateEnclaveCertificateIamRoleInput) (*ec2sdk.AssociateEnclaveCertificateIamRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateEnclaveCertificateIamRole")
+}
+func (unimplementedEC2) AssociateEnclaveCertificateIamRoleRequest(_ *ec2sdk.AssociateEnclaveCertificateIamRoleInput) (*request.Request, *ec2sdk.AssociateEnclaveCertificateIamRoleOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateEnclaveCertificateIamRole"), nil
+}
+func (unimplementedEC2) AssociateEnclaveCertificateIamRoleWithContext(_ context.Context, _ *ec2sdk.AssociateEnclaveCertificateIamRoleInput, _ ...request.Option) (*ec2sdk.AssociateEnclaveCertificateIamRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateEnclaveCertificateIamRole")
+}
+func (unimplementedEC2) AssociateIamInstanceProfile(_ *ec2sdk.AssociateIamInstanceProfileInput) (*ec2sdk.AssociateIamInstanceProfileOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateIamInstanceProfile")
+}
+func (unimplementedEC2) AssociateIamInstanceProfileRequest(_ *ec2sdk.AssociateIamInstanceProfileInput) (*request.Request, *ec2sdk.AssociateIamInstanceProfileOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateIamInstanceProfile"), nil
+}
+func (unimplementedEC2) AssociateIamInstanceProfileWithContext(_ context.Context, _ *ec2sdk.AssociateIamInstanceProfileInput, _ ...request.Option) (*ec2sdk.AssociateIamInstanceProfileOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateIamInstanceProfile")
+}
+func (unimplementedEC2) AssociateInstanceEventWindow(_ *ec2sdk.AssociateInstanceEventWindowInput) (*ec2sdk.AssociateInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateInstanceEventWindow")
+}
+func (unimplementedEC2) AssociateInstanceEventWindowRequest(_ *ec2sdk.AssociateInstanceEventWindowInput) (*request.Request, *ec2sdk.AssociateInstanceEventWindowOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateInstanceEventWindow"), nil
+}
+func (unimplementedEC2) AssociateInstanceEventWindowWithContext(_ context.Context, _ *ec2sdk.AssociateInstanceEventWindowInput, _ ...request.Option) (*ec2sdk.AssociateInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateInstanceEventWindow")
+}
+func (unimplementedEC2) AssociateIpamByoasn(_ *ec2sdk.AssociateIpamByoasnInput) (*ec2sdk.AssociateIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateIpamByoasn")
+}
+func (unimplementedEC2) AssociateIpamByoasnRequest(_ *ec2sdk.AssociateIpamByoasnInput) (*request.Request, *ec2sdk.AssociateIpamByoasnOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateIpamByoasn"), nil
+}
+func (unimplementedEC2) AssociateIpamByoasnWithContext(_ context.Context, _ *ec2sdk.AssociateIpamByoasnInput, _ ...request.Option) (*ec2sdk.AssociateIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateIpamByoasn")
+}
+func (unimplementedEC2) AssociateIpamResourceDiscovery(_ *ec2sdk.AssociateIpamResourceDiscoveryInput) (*ec2sdk.AssociateIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateIpamResourceDiscovery")
+}
+func (unimplementedEC2) AssociateIpamResourceDiscoveryRequest(_ *ec2sdk.AssociateIpamResourceDiscoveryInput) (*request.Request, *ec2sdk.AssociateIpamResourceDiscoveryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateIpamResourceDiscovery"), nil
+}
+func (unimplementedEC2) AssociateIpamResourceDiscoveryWithContext(_ context.Context, _ *ec2sdk.AssociateIpamResourceDiscoveryInput, _ ...request.Option) (*ec2sdk.AssociateIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateIpamResourceDiscovery")
+}
+func (unimplementedEC2) AssociateNatGatewayAddress(_ *ec2sdk.AssociateNatGatewayAddressInput) (*ec2sdk.AssociateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateNatGatewayAddress")
+}
+func (unimplementedEC2) AssociateNatGatewayAddressRequest(_ *ec2sdk.AssociateNatGatewayAddressInput) (*request.Request, *ec2sdk.AssociateNatGatewayAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateNatGatewayAddress"), nil
+}
+func (unimplementedEC2) AssociateNatGatewayAddressWithContext(_ context.Context, _ *ec2sdk.AssociateNatGatewayAddressInput, _ ...request.Option) (*ec2sdk.AssociateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateNatGatewayAddress")
+}
+func (unimplementedEC2) AssociateRouteTable(_ *ec2sdk.AssociateRouteTableInput) (*ec2sdk.AssociateRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateRouteTable")
+}
+func (unimplementedEC2) AssociateRouteTableRequest(_ *ec2sdk.AssociateRouteTableInput) (*request.Request, *ec2sdk.AssociateRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateRouteTable"), nil
+}
+func (unimplementedEC2) AssociateRouteTableWithContext(_ context.Context, _ *ec2sdk.AssociateRouteTableInput, _ ...request.Option) (*ec2sdk.AssociateRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateRouteTable")
+}
+func (unimplementedEC2) AssociateSubnetCidrBlock(_ *ec2sdk.AssociateSubnetCidrBlockInput) (*ec2sdk.AssociateSubnetCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateSubnetCidrBlock")
+}
+func (unimplementedEC2) AssociateSubnetCidrBlockRequest(_ *ec2sdk.AssociateSubnetCidrBlockInput) (*request.Request, *ec2sdk.AssociateSubnetCidrBlockOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateSubnetCidrBlock"), nil
+}
+func (unimplementedEC2) AssociateSubnetCidrBlockWithContext(_ context.Context, _ *ec2sdk.AssociateSubnetCidrBlockInput, _ ...request.Option) (*ec2sdk.AssociateSubnetCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateSubnetCidrBlock")
+}
+func (unimplementedEC2) AssociateTransitGatewayMulticastDomain(_ *ec2sdk.AssociateTransitGatewayMulticastDomainInput) (*ec2sdk.AssociateTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) AssociateTransitGatewayMulticastDomainRequest(_ *ec2sdk.AssociateTransitGatewayMulticastDomainInput) (*request.Request, *ec2sdk.AssociateTransitGatewayMulticastDomainOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateTransitGatewayMulticastDomain"), nil
+}
+func (unimplementedEC2) AssociateTransitGatewayMulticastDomainWithContext(_ context.Context, _ *ec2sdk.AssociateTransitGatewayMulticastDomainInput, _ ...request.Option) (*ec2sdk.AssociateTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) AssociateTransitGatewayPolicyTable(_ *ec2sdk.AssociateTransitGatewayPolicyTableInput) (*ec2sdk.AssociateTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) AssociateTransitGatewayPolicyTableRequest(_ *ec2sdk.AssociateTransitGatewayPolicyTableInput) (*request.Request, *ec2sdk.AssociateTransitGatewayPolicyTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateTransitGatewayPolicyTable"), nil
+}
+func (unimplementedEC2) AssociateTransitGatewayPolicyTableWithContext(_ context.Context, _ *ec2sdk.AssociateTransitGatewayPolicyTableInput, _ ...request.Option) (*ec2sdk.AssociateTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) AssociateTransitGatewayRouteTable(_ *ec2sdk.AssociateTransitGatewayRouteTableInput) (*ec2sdk.AssociateTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTransitGatewayRouteTable")
+}
+func (unimplementedEC2) AssociateTransitGatewayRouteTableRequest(_ *ec2sdk.AssociateTransitGatewayRouteTableInput) (*request.Request, *ec2sdk.AssociateTransitGatewayRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateTransitGatewayRouteTable"), nil
+}
+func (unimplementedEC2) AssociateTransitGatewayRouteTableWithContext(_ context.Context, _ *ec2sdk.AssociateTransitGatewayRouteTableInput, _ ...request.Option) (*ec2sdk.AssociateTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTransitGatewayRouteTable")
+}
+func (unimplementedEC2) AssociateTrunkInterface(_ *ec2sdk.AssociateTrunkInterfaceInput) (*ec2sdk.AssociateTrunkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTrunkInterface")
+}
+func (unimplementedEC2) AssociateTrunkInterfaceRequest(_ *ec2sdk.AssociateTrunkInterfaceInput) (*request.Request, *ec2sdk.AssociateTrunkInterfaceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateTrunkInterface"), nil
+}
+func (unimplementedEC2) AssociateTrunkInterfaceWithContext(_ context.Context, _ *ec2sdk.AssociateTrunkInterfaceInput, _ ...request.Option) (*ec2sdk.AssociateTrunkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateTrunkInterface")
+}
+func (unimplementedEC2) AssociateVpcCidrBlock(_ *ec2sdk.AssociateVpcCidrBlockInput) (*ec2sdk.AssociateVpcCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateVpcCidrBlock")
+}
+func (unimplementedEC2) AssociateVpcCidrBlockRequest(_ *ec2sdk.AssociateVpcCidrBlockInput) (*request.Request, *ec2sdk.AssociateVpcCidrBlockOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AssociateVpcCidrBlock"), nil
+}
+func (unimplementedEC2) AssociateVpcCidrBlockWithContext(_ context.Context, _ *ec2sdk.AssociateVpcCidrBlockInput, _ ...request.Option) (*ec2sdk.AssociateVpcCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AssociateVpcCidrBlock")
+}
+func (unimplementedEC2) AttachClassicLinkVpc(_ *ec2sdk.AttachClassicLinkVpcInput) (*ec2sdk.AttachClassicLinkVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachClassicLinkVpc")
+}
+func (unimplementedEC2) AttachClassicLinkVpcRequest(_ *ec2sdk.AttachClassicLinkVpcInput) (*request.Request, *ec2sdk.AttachClassicLinkVpcOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AttachClassicLinkVpc"), nil
+}
+func (unimplementedEC2) AttachClassicLinkVpcWithContext(_ context.Context, _ *ec2sdk.AttachClassicLinkVpcInput, _ ...request.Option) (*ec2sdk.AttachClassicLinkVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachClassicLinkVpc")
+}
+func (unimplementedEC2) AttachInternetGateway(_ *ec2sdk.AttachInternetGatewayInput) (*ec2sdk.AttachInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachInternetGateway")
+}
+func (unimplementedEC2) AttachInternetGatewayRequest(_ *ec2sdk.AttachInternetGatewayInput) (*request.Request, *ec2sdk.AttachInternetGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AttachInternetGateway"), nil
+}
+func (unimplementedEC2) AttachInternetGatewayWithContext(_ context.Context, _ *ec2sdk.AttachInternetGatewayInput, _ ...request.Option) (*ec2sdk.AttachInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachInternetGateway")
+}
+func (unimplementedEC2) AttachNetworkInterface(_ *ec2sdk.AttachNetworkInterfaceInput) (*ec2sdk.AttachNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachNetworkInterface")
+}
+func (unimplementedEC2) AttachNetworkInterfaceRequest(_ *ec2sdk.AttachNetworkInterfaceInput) (*request.Request, *ec2sdk.AttachNetworkInterfaceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AttachNetworkInterface"), nil
+}
+func (unimplementedEC2) AttachNetworkInterfaceWithContext(_ context.Context, _ *ec2sdk.AttachNetworkInterfaceInput, _ ...request.Option) (*ec2sdk.AttachNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachNetworkInterface")
+}
+func (unimplementedEC2) AttachVerifiedAccessTrustProvider(_ *ec2sdk.AttachVerifiedAccessTrustProviderInput) (*ec2sdk.AttachVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) AttachVerifiedAccessTrustProviderRequest(_ *ec2sdk.AttachVerifiedAccessTrustProviderInput) (*request.Request, *ec2sdk.AttachVerifiedAccessTrustProviderOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AttachVerifiedAccessTrustProvider"), nil
+}
+func (unimplementedEC2) AttachVerifiedAccessTrustProviderWithContext(_ context.Context, _ *ec2sdk.AttachVerifiedAccessTrustProviderInput, _ ...request.Option) (*ec2sdk.AttachVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) AttachVolume(_ *ec2sdk.AttachVolumeInput) (*ec2sdk.VolumeAttachment, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachVolume")
+}
+func (unimplementedEC2) AttachVolumeRequest(_ *ec2sdk.AttachVolumeInput) (*request.Request, *ec2sdk.VolumeAttachment) {
+	return newNotImplementedRequest(ServiceEC2, "AttachVolume"), nil
+}
+func (unimplementedEC2) AttachVolumeWithContext(_ context.Context, _ *ec2sdk.AttachVolumeInput, _ ...request.Option) (*ec2sdk.VolumeAttachment, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachVolume")
+}
+func (unimplementedEC2) AttachVpnGateway(_ *ec2sdk.AttachVpnGatewayInput) (*ec2sdk.AttachVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachVpnGateway")
+}
+func (unimplementedEC2) AttachVpnGatewayRequest(_ *ec2sdk.AttachVpnGatewayInput) (*request.Request, *ec2sdk.AttachVpnGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AttachVpnGateway"), nil
+}
+func (unimplementedEC2) AttachVpnGatewayWithContext(_ context.Context, _ *ec2sdk.AttachVpnGatewayInput, _ ...request.Option) (*ec2sdk.AttachVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AttachVpnGateway")
+}
+func (unimplementedEC2) AuthorizeClientVpnIngress(_ *ec2sdk.AuthorizeClientVpnIngressInput) (*ec2sdk.AuthorizeClientVpnIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AuthorizeClientVpnIngress")
+}
+func (unimplementedEC2) AuthorizeClientVpnIngressRequest(_ *ec2sdk.AuthorizeClientVpnIngressInput) (*request.Request, *ec2sdk.AuthorizeClientVpnIngressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AuthorizeClientVpnIngress"), nil
+}
+func (unimplementedEC2) AuthorizeClientVpnIngressWithContext(_ context.Context, _ *ec2sdk.AuthorizeClientVpnIngressInput, _ ...request.Option) (*ec2sdk.AuthorizeClientVpnIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AuthorizeClientVpnIngress")
+}
+func (unimplementedEC2) AuthorizeSecurityGroupEgress(_ *ec2sdk.AuthorizeSecurityGroupEgressInput) (*ec2sdk.AuthorizeSecurityGroupEgressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AuthorizeSecurityGroupEgress")
+}
+func (unimplementedEC2) AuthorizeSecurityGroupEgressRequest(_ *ec2sdk.AuthorizeSecurityGroupEgressInput) (*request.Request, *ec2sdk.AuthorizeSecurityGroupEgressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AuthorizeSecurityGroupEgress"), nil
+}
+func (unimplementedEC2) AuthorizeSecurityGroupEgressWithContext(_ context.Context, _ *ec2sdk.AuthorizeSecurityGroupEgressInput, _ ...request.Option) (*ec2sdk.AuthorizeSecurityGroupEgressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AuthorizeSecurityGroupEgress")
+}
+func (unimplementedEC2) AuthorizeSecurityGroupIngress(_ *ec2sdk.AuthorizeSecurityGroupIngressInput) (*ec2sdk.AuthorizeSecurityGroupIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AuthorizeSecurityGroupIngress")
+}
+func (unimplementedEC2) AuthorizeSecurityGroupIngressRequest(_ *ec2sdk.AuthorizeSecurityGroupIngressInput) (*request.Request, *ec2sdk.AuthorizeSecurityGroupIngressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "AuthorizeSecurityGroupIngress"), nil
+}
+func (unimplementedEC2) AuthorizeSecurityGroupIngressWithContext(_ context.Context, _ *ec2sdk.AuthorizeSecurityGroupIngressInput, _ ...request.Option) (*ec2sdk.AuthorizeSecurityGroupIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "AuthorizeSecurityGroupIngress")
+}
+func (unimplementedEC2) BundleInstance(_ *ec2sdk.BundleInstanceInput) (*ec2sdk.BundleInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "BundleInstance")
+}
+func (unimplementedEC2) BundleInstanceRequest(_ *ec2sdk.BundleInstanceInput) (*request.Request, *ec2sdk.BundleInstanceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "BundleInstance"), nil
+}
+func (unimplementedEC2) BundleInstanceWithContext(_ context.Context, _ *ec2sdk.BundleInstanceInput, _ ...request.Option) (*ec2sdk.BundleInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "BundleInstance")
+}
+func (unimplementedEC2) CancelBundleTask(_ *ec2sdk.CancelBundleTaskInput) (*ec2sdk.CancelBundleTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelBundleTask")
+}
+func (unimplementedEC2) CancelBundleTaskRequest(_ *ec2sdk.CancelBundleTaskInput) (*request.Request, *ec2sdk.CancelBundleTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelBundleTask"), nil
+}
+func (unimplementedEC2) CancelBundleTaskWithContext(_ context.Context, _ *ec2sdk.CancelBundleTaskInput, _ ...request.Option) (*ec2sdk.CancelBundleTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelBundleTask")
+}
+func (unimplementedEC2) CancelCapacityReservation(_ *ec2sdk.CancelCapacityReservationInput) (*ec2sdk.CancelCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelCapacityReservation")
+}
+func (unimplementedEC2) CancelCapacityReservationFleets(_ *ec2sdk.CancelCapacityReservationFleetsInput) (*ec2sdk.CancelCapacityReservationFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelCapacityReservationFleets")
+}
+func (unimplementedEC2) CancelCapacityReservationFleetsRequest(_ *ec2sdk.CancelCapacityReservationFleetsInput) (*request.Request, *ec2sdk.CancelCapacityReservationFleetsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelCapacityReservationFleets"), nil
+}
+func (unimplementedEC2) CancelCapacityReservationFleetsWithContext(_ context.Context, _ *ec2sdk.CancelCapacityReservationFleetsInput, _ ...request.Option) (*ec2sdk.CancelCapacityReservationFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelCapacityReservationFleets")
+}
+func (unimplementedEC2) CancelCapacityReservationRequest(_ *ec2sdk.CancelCapacityReservationInput) (*request.Request, *ec2sdk.CancelCapacityReservationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelCapacityReservation"), nil
+}
+func (unimplementedEC2) CancelCapacityReservationWithContext(_ context.Context, _ *ec2sdk.CancelCapacityReservationInput, _ ...request.Option) (*ec2sdk.CancelCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelCapacityReservation")
+}
+func (unimplementedEC2) CancelConversionTask(_ *ec2sdk.CancelConversionTaskInput) (*ec2sdk.CancelConversionTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelConversionTask")
+}
+func (unimplementedEC2) CancelConversionTaskRequest(_ *ec2sdk.CancelConversionTaskInput) (*request.Request, *ec2sdk.CancelConversionTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelConversionTask"), nil
+}
+func (unimplementedEC2) CancelConversionTaskWithContext(_ context.Context, _ *ec2sdk.CancelConversionTaskInput, _ ...request.Option) (*ec2sdk.CancelConversionTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelConversionTask")
+}
+func (unimplementedEC2) CancelExportTask(_ *ec2sdk.CancelExportTaskInput) (*ec2sdk.CancelExportTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelExportTask")
+}
+func (unimplementedEC2) CancelExportTaskRequest(_ *ec2sdk.CancelExportTaskInput) (*request.Request, *ec2sdk.CancelExportTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelExportTask"), nil
+}
+func (unimplementedEC2) CancelExportTaskWithContext(_ context.Context, _ *ec2sdk.CancelExportTaskInput, _ ...request.Option) (*ec2sdk.CancelExportTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelExportTask")
+}
+func (unimplementedEC2) CancelImageLaunchPermission(_ *ec2sdk.CancelImageLaunchPermissionInput) (*ec2sdk.CancelImageLaunchPermissionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelImageLaunchPermission")
+}
+func (unimplementedEC2) CancelImageLaunchPermissionRequest(_ *ec2sdk.CancelImageLaunchPermissionInput) (*request.Request, *ec2sdk.CancelImageLaunchPermissionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelImageLaunchPermission"), nil
+}
+func (unimplementedEC2) CancelImageLaunchPermissionWithContext(_ context.Context, _ *ec2sdk.CancelImageLaunchPermissionInput, _ ...request.Option) (*ec2sdk.CancelImageLaunchPermissionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelImageLaunchPermission")
+}
+func (unimplementedEC2) CancelImportTask(_ *ec2sdk.CancelImportTaskInput) (*ec2sdk.CancelImportTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelImportTask")
+}
+func (unimplementedEC2) CancelImportTaskRequest(_ *ec2sdk.CancelImportTaskInput) (*request.Request, *ec2sdk.CancelImportTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelImportTask"), nil
+}
+func (unimplementedEC2) CancelImportTaskWithContext(_ context.Context, _ *ec2sdk.CancelImportTaskInput, _ ...request.Option) (*ec2sdk.CancelImportTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelImportTask")
+}
+func (unimplementedEC2) CancelReservedInstancesListing(_ *ec2sdk.CancelReservedInstancesListingInput) (*ec2sdk.CancelReservedInstancesListingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelReservedInstancesListing")
+}
+func (unimplementedEC2) CancelReservedInstancesListingRequest(_ *ec2sdk.CancelReservedInstancesListingInput) (*request.Request, *ec2sdk.CancelReservedInstancesListingOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelReservedInstancesListing"), nil
+}
+func (unimplementedEC2) CancelReservedInstancesListingWithContext(_ context.Context, _ *ec2sdk.CancelReservedInstancesListingInput, _ ...request.Option) (*ec2sdk.CancelReservedInstancesListingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelReservedInstancesListing")
+}
+func (unimplementedEC2) CancelSpotFleetRequests(_ *ec2sdk.CancelSpotFleetRequestsInput) (*ec2sdk.CancelSpotFleetRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelSpotFleetRequests")
+}
+func (unimplementedEC2) CancelSpotFleetRequestsRequest(_ *ec2sdk.CancelSpotFleetRequestsInput) (*request.Request, *ec2sdk.CancelSpotFleetRequestsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelSpotFleetRequests"), nil
+}
+func (unimplementedEC2) CancelSpotFleetRequestsWithContext(_ context.Context, _ *ec2sdk.CancelSpotFleetRequestsInput, _ ...request.Option) (*ec2sdk.CancelSpotFleetRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelSpotFleetRequests")
+}
+func (unimplementedEC2) CancelSpotInstanceRequests(_ *ec2sdk.CancelSpotInstanceRequestsInput) (*ec2sdk.CancelSpotInstanceRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelSpotInstanceRequests")
+}
+func (unimplementedEC2) CancelSpotInstanceRequestsRequest(_ *ec2sdk.CancelSpotInstanceRequestsInput) (*request.Request, *ec2sdk.CancelSpotInstanceRequestsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CancelSpotInstanceRequests"), nil
+}
+func (unimplementedEC2) CancelSpotInstanceRequestsWithContext(_ context.Context, _ *ec2sdk.CancelSpotInstanceRequestsInput, _ ...request.Option) (*ec2sdk.CancelSpotInstanceRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CancelSpotInstanceRequests")
+}
+func (unimplementedEC2) ConfirmProductInstance(_ *ec2sdk.ConfirmProductInstanceInput) (*ec2sdk.ConfirmProductInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ConfirmProductInstance")
+}
+func (unimplementedEC2) ConfirmProductInstanceRequest(_ *ec2sdk.ConfirmProductInstanceInput) (*request.Request, *ec2sdk.ConfirmProductInstanceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ConfirmProductInstance"), nil
+}
+func (unimplementedEC2) ConfirmProductInstanceWithContext(_ context.Context, _ *ec2sdk.ConfirmProductInstanceInput, _ ...request.Option) (*ec2sdk.ConfirmProductInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ConfirmProductInstance")
+}
+func (unimplementedEC2) CopyFpgaImage(_ *ec2sdk.CopyFpgaImageInput) (*ec2sdk.CopyFpgaImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CopyFpgaImage")
+}
+func (unimplementedEC2) CopyFpgaImageRequest(_ *ec2sdk.CopyFpgaImageInput) (*request.Request, *ec2sdk.CopyFpgaImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CopyFpgaImage"), nil
+}
+func (unimplementedEC2) CopyFpgaImageWithContext(_ context.Context, _ *ec2sdk.CopyFpgaImageInput, _ ...request.Option) (*ec2sdk.CopyFpgaImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CopyFpgaImage")
+}
+func (unimplementedEC2) CopyImage(_ *ec2sdk.CopyImageInput) (*ec2sdk.CopyImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CopyImage")
+}
+func (unimplementedEC2) CopyImageRequest(_ *ec2sdk.CopyImageInput) (*request.Request, *ec2sdk.CopyImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CopyImage"), nil
+}
+func (unimplementedEC2) CopyImageWithContext(_ context.Context, _ *ec2sdk.CopyImageInput, _ ...request.Option) (*ec2sdk.CopyImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CopyImage")
+}
+func (unimplementedEC2) CopySnapshot(_ *ec2sdk.CopySnapshotInput) (*ec2sdk.CopySnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CopySnapshot")
+}
+func (unimplementedEC2) CopySnapshotRequest(_ *ec2sdk.CopySnapshotInput) (*request.Request, *ec2sdk.CopySnapshotOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CopySnapshot"), nil
+}
+func (unimplementedEC2) CopySnapshotWithContext(_ context.Context, _ *ec2sdk.CopySnapshotInput, _ ...request.Option) (*ec2sdk.CopySnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CopySnapshot")
+}
+func (unimplementedEC2) CreateCapacityReservation(_ *ec2sdk.CreateCapacityReservationInput) (*ec2sdk.CreateCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCapacityReservation")
+}
+func (unimplementedEC2) CreateCapacityReservationFleet(_ *ec2sdk.CreateCapacityReservationFleetInput) (*ec2sdk.CreateCapacityReservationFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCapacityReservationFleet")
+}
+func (unimplementedEC2) CreateCapacityReservationFleetRequest(_ *ec2sdk.CreateCapacityReservationFleetInput) (*request.Request, *ec2sdk.CreateCapacityReservationFleetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateCapacityReservationFleet"), nil
+}
+func (unimplementedEC2) CreateCapacityReservationFleetWithContext(_ context.Context, _ *ec2sdk.CreateCapacityReservationFleetInput, _ ...request.Option) (*ec2sdk.CreateCapacityReservationFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCapacityReservationFleet")
+}
+func (unimplementedEC2) CreateCapacityReservationRequest(_ *ec2sdk.CreateCapacityReservationInput) (*request.Request, *ec2sdk.CreateCapacityReservationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateCapacityReservation"), nil
+}
+func (unimplementedEC2) CreateCapacityReservationWithContext(_ context.Context, _ *ec2sdk.CreateCapacityReservationInput, _ ...request.Option) (*ec2sdk.CreateCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCapacityReservation")
+}
+func (unimplementedEC2) CreateCarrierGateway(_ *ec2sdk.CreateCarrierGatewayInput) (*ec2sdk.CreateCarrierGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCarrierGateway")
+}
+func (unimplementedEC2) CreateCarrierGatewayRequest(_ *ec2sdk.CreateCarrierGatewayInput) (*request.Request, *ec2sdk.CreateCarrierGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateCarrierGateway"), nil
+}
+func (unimplementedEC2) CreateCarrierGatewayWithContext(_ context.Context, _ *ec2sdk.CreateCarrierGatewayInput, _ ...request.Option) (*ec2sdk.CreateCarrierGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCarrierGateway")
+}
+func (unimplementedEC2) CreateClientVpnEndpoint(_ *ec2sdk.CreateClientVpnEndpointInput) (*ec2sdk.CreateClientVpnEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateClientVpnEndpoint")
+}
+func (unimplementedEC2) CreateClientVpnEndpointRequest(_ *ec2sdk.CreateClientVpnEndpointInput) (*request.Request, *ec2sdk.CreateClientVpnEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateClientVpnEndpoint"), nil
+}
+func (unimplementedEC2) CreateClientVpnEndpointWithContext(_ context.Context, _ *ec2sdk.CreateClientVpnEndpointInput, _ ...request.Option) (*ec2sdk.CreateClientVpnEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateClientVpnEndpoint")
+}
+func (unimplementedEC2) CreateClientVpnRoute(_ *ec2sdk.CreateClientVpnRouteInput) (*ec2sdk.CreateClientVpnRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateClientVpnRoute")
+}
+func (unimplementedEC2) CreateClientVpnRouteRequest(_ *ec2sdk.CreateClientVpnRouteInput) (*request.Request, *ec2sdk.CreateClientVpnRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateClientVpnRoute"), nil
+}
+func (unimplementedEC2) CreateClientVpnRouteWithContext(_ context.Context, _ *ec2sdk.CreateClientVpnRouteInput, _ ...request.Option) (*ec2sdk.CreateClientVpnRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateClientVpnRoute")
+}
+func (unimplementedEC2) CreateCoipCidr(_ *ec2sdk.CreateCoipCidrInput) (*ec2sdk.CreateCoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCoipCidr")
+}
+func (unimplementedEC2) CreateCoipCidrRequest(_ *ec2sdk.CreateCoipCidrInput) (*request.Request, *ec2sdk.CreateCoipCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateCoipCidr"), nil
+}
+func (unimplementedEC2) CreateCoipCidrWithContext(_ context.Context, _ *ec2sdk.CreateCoipCidrInput, _ ...request.Option) (*ec2sdk.CreateCoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCoipCidr")
+}
+func (unimplementedEC2) CreateCoipPool(_ *ec2sdk.CreateCoipPoolInput) (*ec2sdk.CreateCoipPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCoipPool")
+}
+func (unimplementedEC2) CreateCoipPoolRequest(_ *ec2sdk.CreateCoipPoolInput) (*request.Request, *ec2sdk.CreateCoipPoolOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateCoipPool"), nil
+}
+func (unimplementedEC2) CreateCoipPoolWithContext(_ context.Context, _ *ec2sdk.CreateCoipPoolInput, _ ...request.Option) (*ec2sdk.CreateCoipPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCoipPool")
+}
+func (unimplementedEC2) CreateCustomerGateway(_ *ec2sdk.CreateCustomerGatewayInput) (*ec2sdk.CreateCustomerGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCustomerGateway")
+}
+func (unimplementedEC2) CreateCustomerGatewayRequest(_ *ec2sdk.CreateCustomerGatewayInput) (*request.Request, *ec2sdk.CreateCustomerGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateCustomerGateway"), nil
+}
+func (unimplementedEC2) CreateCustomerGatewayWithContext(_ context.Context, _ *ec2sdk.CreateCustomerGatewayInput, _ ...request.Option) (*ec2sdk.CreateCustomerGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateCustomerGateway")
+}
+func (unimplementedEC2) CreateDefaultSubnet(_ *ec2sdk.CreateDefaultSubnetInput) (*ec2sdk.CreateDefaultSubnetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateDefaultSubnet")
+}
+func (unimplementedEC2) CreateDefaultSubnetRequest(_ *ec2sdk.CreateDefaultSubnetInput) (*request.Request, *ec2sdk.CreateDefaultSubnetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateDefaultSubnet"), nil
+}
+func (unimplementedEC2) CreateDefaultSubnetWithContext(_ context.Context, _ *ec2sdk.CreateDefaultSubnetInput, _ ...request.Option) (*ec2sdk.CreateDefaultSubnetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateDefaultSubnet")
+}
+func (unimplementedEC2) CreateDefaultVpc(_ *ec2sdk.CreateDefaultVpcInput) (*ec2sdk.CreateDefaultVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateDefaultVpc")
+}
+func (unimplementedEC2) CreateDefaultVpcRequest(_ *ec2sdk.CreateDefaultVpcInput) (*request.Request, *ec2sdk.CreateDefaultVpcOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateDefaultVpc"), nil
+}
+func (unimplementedEC2) CreateDefaultVpcWithContext(_ context.Context, _ *ec2sdk.CreateDefaultVpcInput, _ ...request.Option) (*ec2sdk.CreateDefaultVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateDefaultVpc")
+}
+func (unimplementedEC2) CreateDhcpOptions(_ *ec2sdk.CreateDhcpOptionsInput) (*ec2sdk.CreateDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateDhcpOptions")
+}
+func (unimplementedEC2) CreateDhcpOptionsRequest(_ *ec2sdk.CreateDhcpOptionsInput) (*request.Request, *ec2sdk.CreateDhcpOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateDhcpOptions"), nil
+}
+func (unimplementedEC2) CreateDhcpOptionsWithContext(_ context.Context, _ *ec2sdk.CreateDhcpOptionsInput, _ ...request.Option) (*ec2sdk.CreateDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateDhcpOptions")
+}
+func (unimplementedEC2) CreateEgressOnlyInternetGateway(_ *ec2sdk.CreateEgressOnlyInternetGatewayInput) (*ec2sdk.CreateEgressOnlyInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateEgressOnlyInternetGateway")
+}
+func (unimplementedEC2) CreateEgressOnlyInternetGatewayRequest(_ *ec2sdk.CreateEgressOnlyInternetGatewayInput) (*request.Request, *ec2sdk.CreateEgressOnlyInternetGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateEgressOnlyInternetGateway"), nil
+}
+func (unimplementedEC2) CreateEgressOnlyInternetGatewayWithContext(_ context.Context, _ *ec2sdk.CreateEgressOnlyInternetGatewayInput, _ ...request.Option) (*ec2sdk.CreateEgressOnlyInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateEgressOnlyInternetGateway")
+}
+func (unimplementedEC2) CreateFleet(_ *ec2sdk.CreateFleetInput) (*ec2sdk.CreateFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateFleet")
+}
+func (unimplementedEC2) CreateFleetRequest(_ *ec2sdk.CreateFleetInput) (*request.Request, *ec2sdk.CreateFleetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateFleet"), nil
+}
+func (unimplementedEC2) CreateFleetWithContext(_ context.Context, _ *ec2sdk.CreateFleetInput, _ ...request.Option) (*ec2sdk.CreateFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateFleet")
+}
+func (unimplementedEC2) CreateFlowLogs(_ *ec2sdk.CreateFlowLogsInput) (*ec2sdk.CreateFlowLogsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateFlowLogs")
+}
+func (unimplementedEC2) CreateFlowLogsRequest(_ *ec2sdk.CreateFlowLogsInput) (*request.Request, *ec2sdk.CreateFlowLogsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateFlowLogs"), nil
+}
+func (unimplementedEC2) CreateFlowLogsWithContext(_ context.Context, _ *ec2sdk.CreateFlowLogsInput, _ ...request.Option) (*ec2sdk.CreateFlowLogsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateFlowLogs")
+}
+func (unimplementedEC2) CreateFpgaImage(_ *ec2sdk.CreateFpgaImageInput) (*ec2sdk.CreateFpgaImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateFpgaImage")
+}
+func (unimplementedEC2) CreateFpgaImageRequest(_ *ec2sdk.CreateFpgaImageInput) (*request.Request, *ec2sdk.CreateFpgaImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateFpgaImage"), nil
+}
+func (unimplementedEC2) CreateFpgaImageWithContext(_ context.Context, _ *ec2sdk.CreateFpgaImageInput, _ ...request.Option) (*ec2sdk.CreateFpgaImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateFpgaImage")
+}
+func (unimplementedEC2) CreateImage(_ *ec2sdk.CreateImageInput) (*ec2sdk.CreateImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateImage")
+}
+func (unimplementedEC2) CreateImageRequest(_ *ec2sdk.CreateImageInput) (*request.Request, *ec2sdk.CreateImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateImage"), nil
+}
+func (unimplementedEC2) CreateImageWithContext(_ context.Context, _ *ec2sdk.CreateImageInput, _ ...request.Option) (*ec2sdk.CreateImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateImage")
+}
+func (unimplementedEC2) CreateInstanceConnectEndpoint(_ *ec2sdk.CreateInstanceConnectEndpointInput) (*ec2sdk.CreateInstanceConnectEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInstanceConnectEndpoint")
+}
+func (unimplementedEC2) CreateInstanceConnectEndpointRequest(_ *ec2sdk.CreateInstanceConnectEndpointInput) (*request.Request, *ec2sdk.CreateInstanceConnectEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateInstanceConnectEndpoint"), nil
+}
+func (unimplementedEC2) CreateInstanceConnectEndpointWithContext(_ context.Context, _ *ec2sdk.CreateInstanceConnectEndpointInput, _ ...request.Option) (*ec2sdk.CreateInstanceConnectEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInstanceConnectEndpoint")
+}
+func (unimplementedEC2) CreateInstanceEventWindow(_ *ec2sdk.CreateInstanceEventWindowInput) (*ec2sdk.CreateInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInstanceEventWindow")
+}
+func (unimplementedEC2) CreateInstanceEventWindowRequest(_ *ec2sdk.CreateInstanceEventWindowInput) (*request.Request, *ec2sdk.CreateInstanceEventWindowOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateInstanceEventWindow"), nil
+}
+func (unimplementedEC2) CreateInstanceEventWindowWithContext(_ context.Context, _ *ec2sdk.CreateInstanceEventWindowInput, _ ...request.Option) (*ec2sdk.CreateInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInstanceEventWindow")
+}
+func (unimplementedEC2) CreateInstanceExportTask(_ *ec2sdk.CreateInstanceExportTaskInput) (*ec2sdk.CreateInstanceExportTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInstanceExportTask")
+}
+func (unimplementedEC2) CreateInstanceExportTaskRequest(_ *ec2sdk.CreateInstanceExportTaskInput) (*request.Request, *ec2sdk.CreateInstanceExportTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateInstanceExportTask"), nil
+}
+func (unimplementedEC2) CreateInstanceExportTaskWithContext(_ context.Context, _ *ec2sdk.CreateInstanceExportTaskInput, _ ...request.Option) (*ec2sdk.CreateInstanceExportTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInstanceExportTask")
+}
+func (unimplementedEC2) CreateInternetGateway(_ *ec2sdk.CreateInternetGatewayInput) (*ec2sdk.CreateInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInternetGateway")
+}
+func (unimplementedEC2) CreateInternetGatewayRequest(_ *ec2sdk.CreateInternetGatewayInput) (*request.Request, *ec2sdk.CreateInternetGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateInternetGateway"), nil
+}
+func (unimplementedEC2) CreateInternetGatewayWithContext(_ context.Context, _ *ec2sdk.CreateInternetGatewayInput, _ ...request.Option) (*ec2sdk.CreateInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateInternetGateway")
+}
+func (unimplementedEC2) CreateIpam(_ *ec2sdk.CreateIpamInput) (*ec2sdk.CreateIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpam")
+}
+func (unimplementedEC2) CreateIpamPool(_ *ec2sdk.CreateIpamPoolInput) (*ec2sdk.CreateIpamPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpamPool")
+}
+func (unimplementedEC2) CreateIpamPoolRequest(_ *ec2sdk.CreateIpamPoolInput) (*request.Request, *ec2sdk.CreateIpamPoolOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateIpamPool"), nil
+}
+func (unimplementedEC2) CreateIpamPoolWithContext(_ context.Context, _ *ec2sdk.CreateIpamPoolInput, _ ...request.Option) (*ec2sdk.CreateIpamPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpamPool")
+}
+func (unimplementedEC2) CreateIpamRequest(_ *ec2sdk.CreateIpamInput) (*request.Request, *ec2sdk.CreateIpamOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateIpam"), nil
+}
+func (unimplementedEC2) CreateIpamResourceDiscovery(_ *ec2sdk.CreateIpamResourceDiscoveryInput) (*ec2sdk.CreateIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpamResourceDiscovery")
+}
+func (unimplementedEC2) CreateIpamResourceDiscoveryRequest(_ *ec2sdk.CreateIpamResourceDiscoveryInput) (*request.Request, *ec2sdk.CreateIpamResourceDiscoveryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateIpamResourceDiscovery"), nil
+}
+func (unimplementedEC2) CreateIpamResourceDiscoveryWithContext(_ context.Context, _ *ec2sdk.CreateIpamResourceDiscoveryInput, _ ...request.Option) (*ec2sdk.CreateIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpamResourceDiscovery")
+}
+func (unimplementedEC2) CreateIpamScope(_ *ec2sdk.CreateIpamScopeInput) (*ec2sdk.CreateIpamScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpamScope")
+}
+func (unimplementedEC2) CreateIpamScopeRequest(_ *ec2sdk.CreateIpamScopeInput) (*request.Request, *ec2sdk.CreateIpamScopeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateIpamScope"), nil
+}
+func (unimplementedEC2) CreateIpamScopeWithContext(_ context.Context, _ *ec2sdk.CreateIpamScopeInput, _ ...request.Option) (*ec2sdk.CreateIpamScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpamScope")
+}
+func (unimplementedEC2) CreateIpamWithContext(_ context.Context, _ *ec2sdk.CreateIpamInput, _ ...request.Option) (*ec2sdk.CreateIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateIpam")
+}
+func (unimplementedEC2) CreateKeyPair(_ *ec2sdk.CreateKeyPairInput) (*ec2sdk.CreateKeyPairOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateKeyPair")
+}
+func (unimplementedEC2) CreateKeyPairRequest(_ *ec2sdk.CreateKeyPairInput) (*request.Request, *ec2sdk.CreateKeyPairOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateKeyPair"), nil
+}
+func (unimplementedEC2) CreateKeyPairWithContext(_ context.Context, _ *ec2sdk.CreateKeyPairInput, _ ...request.Option) (*ec2sdk.CreateKeyPairOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateKeyPair")
+}
+func (unimplementedEC2) CreateLaunchTemplate(_ *ec2sdk.CreateLaunchTemplateInput) (*ec2sdk.CreateLaunchTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLaunchTemplate")
+}
+func (unimplementedEC2) CreateLaunchTemplateRequest(_ *ec2sdk.CreateLaunchTemplateInput) (*request.Request, *ec2sdk.CreateLaunchTemplateOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateLaunchTemplate"), nil
+}
+func (unimplementedEC2) CreateLaunchTemplateVersion(_ *ec2sdk.CreateLaunchTemplateVersionInput) (*ec2sdk.CreateLaunchTemplateVersionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLaunchTemplateVersion")
+}
+func (unimplementedEC2) CreateLaunchTemplateVersionRequest(_ *ec2sdk.CreateLaunchTemplateVersionInput) (*request.Request, *ec2sdk.CreateLaunchTemplateVersionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateLaunchTemplateVersion"), nil
+}
+func (unimplementedEC2) CreateLaunchTemplateVersionWithContext(_ context.Context, _ *ec2sdk.CreateLaunchTemplateVersionInput, _ ...request.Option) (*ec2sdk.CreateLaunchTemplateVersionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLaunchTemplateVersion")
+}
+func (unimplementedEC2) CreateLaunchTemplateWithContext(_ context.Context, _ *ec2sdk.CreateLaunchTemplateInput, _ ...request.Option) (*ec2sdk.CreateLaunchTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLaunchTemplate")
+}
+func (unimplementedEC2) CreateLocalGatewayRoute(_ *ec2sdk.CreateLocalGatewayRouteInput) (*ec2sdk.CreateLocalGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRoute")
+}
+func (unimplementedEC2) CreateLocalGatewayRouteRequest(_ *ec2sdk.CreateLocalGatewayRouteInput) (*request.Request, *ec2sdk.CreateLocalGatewayRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateLocalGatewayRoute"), nil
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTable(_ *ec2sdk.CreateLocalGatewayRouteTableInput) (*ec2sdk.CreateLocalGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRouteTable")
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableRequest(_ *ec2sdk.CreateLocalGatewayRouteTableInput) (*request.Request, *ec2sdk.CreateLocalGatewayRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateLocalGatewayRouteTable"), nil
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation(_ *ec2sdk.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput) (*ec2sdk.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation")
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationRequest(_ *ec2sdk.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput) (*request.Request, *ec2sdk.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation"), nil
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationWithContext(_ context.Context, _ *ec2sdk.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, _ ...request.Option) (*ec2sdk.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation")
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableVpcAssociation(_ *ec2sdk.CreateLocalGatewayRouteTableVpcAssociationInput) (*ec2sdk.CreateLocalGatewayRouteTableVpcAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRouteTableVpcAssociation")
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableVpcAssociationRequest(_ *ec2sdk.CreateLocalGatewayRouteTableVpcAssociationInput) (*request.Request, *ec2sdk.CreateLocalGatewayRouteTableVpcAssociationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateLocalGatewayRouteTableVpcAssociation"), nil
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableVpcAssociationWithContext(_ context.Context, _ *ec2sdk.CreateLocalGatewayRouteTableVpcAssociationInput, _ ...request.Option) (*ec2sdk.CreateLocalGatewayRouteTableVpcAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRouteTableVpcAssociation")
+}
+func (unimplementedEC2) CreateLocalGatewayRouteTableWithContext(_ context.Context, _ *ec2sdk.CreateLocalGatewayRouteTableInput, _ ...request.Option) (*ec2sdk.CreateLocalGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRouteTable")
+}
+func (unimplementedEC2) CreateLocalGatewayRouteWithContext(_ context.Context, _ *ec2sdk.CreateLocalGatewayRouteInput, _ ...request.Option) (*ec2sdk.CreateLocalGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateLocalGatewayRoute")
+}
+func (unimplementedEC2) CreateManagedPrefixList(_ *ec2sdk.CreateManagedPrefixListInput) (*ec2sdk.CreateManagedPrefixListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateManagedPrefixList")
+}
+func (unimplementedEC2) CreateManagedPrefixListRequest(_ *ec2sdk.CreateManagedPrefixListInput) (*request.Request, *ec2sdk.CreateManagedPrefixListOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateManagedPrefixList"), nil
+}
+func (unimplementedEC2) CreateManagedPrefixListWithContext(_ context.Context, _ *ec2sdk.CreateManagedPrefixListInput, _ ...request.Option) (*ec2sdk.CreateManagedPrefixListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateManagedPrefixList")
+}
+func (unimplementedEC2) CreateNatGateway(_ *ec2sdk.CreateNatGatewayInput) (*ec2sdk.CreateNatGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNatGateway")
+}
+func (unimplementedEC2) CreateNatGatewayRequest(_ *ec2sdk.CreateNatGatewayInput) (*request.Request, *ec2sdk.CreateNatGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateNatGateway"), nil
+}
+func (unimplementedEC2) CreateNatGatewayWithContext(_ context.Context, _ *ec2sdk.CreateNatGatewayInput, _ ...request.Option) (*ec2sdk.CreateNatGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNatGateway")
+}
+func (unimplementedEC2) CreateNetworkAcl(_ *ec2sdk.CreateNetworkAclInput) (*ec2sdk.CreateNetworkAclOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkAcl")
+}
+func (unimplementedEC2) CreateNetworkAclEntry(_ *ec2sdk.CreateNetworkAclEntryInput) (*ec2sdk.CreateNetworkAclEntryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkAclEntry")
+}
+func (unimplementedEC2) CreateNetworkAclEntryRequest(_ *ec2sdk.CreateNetworkAclEntryInput) (*request.Request, *ec2sdk.CreateNetworkAclEntryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateNetworkAclEntry"), nil
+}
+func (unimplementedEC2) CreateNetworkAclEntryWithContext(_ context.Context, _ *ec2sdk.CreateNetworkAclEntryInput, _ ...request.Option) (*ec2sdk.CreateNetworkAclEntryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkAclEntry")
+}
+func (unimplementedEC2) CreateNetworkAclRequest(_ *ec2sdk.CreateNetworkAclInput) (*request.Request, *ec2sdk.CreateNetworkAclOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateNetworkAcl"), nil
+}
+func (unimplementedEC2) CreateNetworkAclWithContext(_ context.Context, _ *ec2sdk.CreateNetworkAclInput, _ ...request.Option) (*ec2sdk.CreateNetworkAclOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkAcl")
+}
+func (unimplementedEC2) CreateNetworkInsightsAccessScope(_ *ec2sdk.CreateNetworkInsightsAccessScopeInput) (*ec2sdk.CreateNetworkInsightsAccessScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInsightsAccessScope")
+}
+func (unimplementedEC2) CreateNetworkInsightsAccessScopeRequest(_ *ec2sdk.CreateNetworkInsightsAccessScopeInput) (*request.Request, *ec2sdk.CreateNetworkInsightsAccessScopeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateNetworkInsightsAccessScope"), nil
+}
+func (unimplementedEC2) CreateNetworkInsightsAccessScopeWithContext(_ context.Context, _ *ec2sdk.CreateNetworkInsightsAccessScopeInput, _ ...request.Option) (*ec2sdk.CreateNetworkInsightsAccessScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInsightsAccessScope")
+}
+func (unimplementedEC2) CreateNetworkInsightsPath(_ *ec2sdk.CreateNetworkInsightsPathInput) (*ec2sdk.CreateNetworkInsightsPathOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInsightsPath")
+}
+func (unimplementedEC2) CreateNetworkInsightsPathRequest(_ *ec2sdk.CreateNetworkInsightsPathInput) (*request.Request, *ec2sdk.CreateNetworkInsightsPathOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateNetworkInsightsPath"), nil
+}
+func (unimplementedEC2) CreateNetworkInsightsPathWithContext(_ context.Context, _ *ec2sdk.CreateNetworkInsightsPathInput, _ ...request.Option) (*ec2sdk.CreateNetworkInsightsPathOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInsightsPath")
+}
+func (unimplementedEC2) CreateNetworkInterface(_ *ec2sdk.CreateNetworkInterfaceInput) (*ec2sdk.CreateNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInterface")
+}
+func (unimplementedEC2) CreateNetworkInterfacePermission(_ *ec2sdk.CreateNetworkInterfacePermissionInput) (*ec2sdk.CreateNetworkInterfacePermissionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInterfacePermission")
+}
+func (unimplementedEC2) CreateNetworkInterfacePermissionRequest(_ *ec2sdk.CreateNetworkInterfacePermissionInput) (*request.Request, *ec2sdk.CreateNetworkInterfacePermissionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateNetworkInterfacePermission"), nil
+}
+func (unimplementedEC2) CreateNetworkInterfacePermissionWithContext(_ context.Context, _ *ec2sdk.CreateNetworkInterfacePermissionInput, _ ...request.Option) (*ec2sdk.CreateNetworkInterfacePermissionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInterfacePermission")
+}
+func (unimplementedEC2) CreateNetworkInterfaceRequest(_ *ec2sdk.CreateNetworkInterfaceInput) (*request.Request, *ec2sdk.CreateNetworkInterfaceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateNetworkInterface"), nil
+}
+func (unimplementedEC2) CreateNetworkInterfaceWithContext(_ context.Context, _ *ec2sdk.CreateNetworkInterfaceInput, _ ...request.Option) (*ec2sdk.CreateNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateNetworkInterface")
+}
+func (unimplementedEC2) CreatePlacementGroup(_ *ec2sdk.CreatePlacementGroupInput) (*ec2sdk.CreatePlacementGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreatePlacementGroup")
+}
+func (unimplementedEC2) CreatePlacementGroupRequest(_ *ec2sdk.CreatePlacementGroupInput) (*request.Request, *ec2sdk.CreatePlacementGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreatePlacementGroup"), nil
+}
+func (unimplementedEC2) CreatePlacementGroupWithContext(_ context.Context, _ *ec2sdk.CreatePlacementGroupInput, _ ...request.Option) (*ec2sdk.CreatePlacementGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreatePlacementGroup")
+}
+func (unimplementedEC2) CreatePublicIpv4Pool(_ *ec2sdk.CreatePublicIpv4PoolInput) (*ec2sdk.CreatePublicIpv4PoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreatePublicIpv4Pool")
+}
+func (unimplementedEC2) CreatePublicIpv4PoolRequest(_ *ec2sdk.CreatePublicIpv4PoolInput) (*request.Request, *ec2sdk.CreatePublicIpv4PoolOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreatePublicIpv4Pool"), nil
+}
+func (unimplementedEC2) CreatePublicIpv4PoolWithContext(_ context.Context, _ *ec2sdk.CreatePublicIpv4PoolInput, _ ...request.Option) (*ec2sdk.CreatePublicIpv4PoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreatePublicIpv4Pool")
+}
+func (unimplementedEC2) CreateReplaceRootVolumeTask(_ *ec2sdk.CreateReplaceRootVolumeTaskInput) (*ec2sdk.CreateReplaceRootVolumeTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateReplaceRootVolumeTask")
+}
+func (unimplementedEC2) CreateReplaceRootVolumeTaskRequest(_ *ec2sdk.CreateReplaceRootVolumeTaskInput) (*request.Request, *ec2sdk.CreateReplaceRootVolumeTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateReplaceRootVolumeTask"), nil
+}
+func (unimplementedEC2) CreateReplaceRootVolumeTaskWithContext(_ context.Context, _ *ec2sdk.CreateReplaceRootVolumeTaskInput, _ ...request.Option) (*ec2sdk.CreateReplaceRootVolumeTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateReplaceRootVolumeTask")
+}
+func (unimplementedEC2) CreateReservedInstancesListing(_ *ec2sdk.CreateReservedInstancesListingInput) (*ec2sdk.CreateReservedInstancesListingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateReservedInstancesListing")
+}
+func (unimplementedEC2) CreateReservedInstancesListingRequest(_ *ec2sdk.CreateReservedInstancesListingInput) (*request.Request, *ec2sdk.CreateReservedInstancesListingOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateReservedInstancesListing"), nil
+}
+func (unimplementedEC2) CreateReservedInstancesListingWithContext(_ context.Context, _ *ec2sdk.CreateReservedInstancesListingInput, _ ...request.Option) (*ec2sdk.CreateReservedInstancesListingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateReservedInstancesListing")
+}
+func (unimplementedEC2) CreateRestoreImageTask(_ *ec2sdk.CreateRestoreImageTaskInput) (*ec2sdk.CreateRestoreImageTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateRestoreImageTask")
+}
+func (unimplementedEC2) CreateRestoreImageTaskRequest(_ *ec2sdk.CreateRestoreImageTaskInput) (*request.Request, *ec2sdk.CreateRestoreImageTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateRestoreImageTask"), nil
+}
+func (unimplementedEC2) CreateRestoreImageTaskWithContext(_ context.Context, _ *ec2sdk.CreateRestoreImageTaskInput, _ ...request.Option) (*ec2sdk.CreateRestoreImageTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateRestoreImageTask")
+}
+func (unimplementedEC2) CreateRoute(_ *ec2sdk.CreateRouteInput) (*ec2sdk.CreateRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateRoute")
+}
+func (unimplementedEC2) CreateRouteRequest(_ *ec2sdk.CreateRouteInput) (*request.Request, *ec2sdk.CreateRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateRoute"), nil
+}
+func (unimplementedEC2) CreateRouteTable(_ *ec2sdk.CreateRouteTableInput) (*ec2sdk.CreateRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateRouteTable")
+}
+func (unimplementedEC2) CreateRouteTableRequest(_ *ec2sdk.CreateRouteTableInput) (*request.Request, *ec2sdk.CreateRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateRouteTable"), nil
+}
+func (unimplementedEC2) CreateRouteTableWithContext(_ context.Context, _ *ec2sdk.CreateRouteTableInput, _ ...request.Option) (*ec2sdk.CreateRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateRouteTable")
+}
+func (unimplementedEC2) CreateRouteWithContext(_ context.Context, _ *ec2sdk.CreateRouteInput, _ ...request.Option) (*ec2sdk.CreateRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateRoute")
+}
+func (unimplementedEC2) CreateSecurityGroup(_ *ec2sdk.CreateSecurityGroupInput) (*ec2sdk.CreateSecurityGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSecurityGroup")
+}
+func (unimplementedEC2) CreateSecurityGroupRequest(_ *ec2sdk.CreateSecurityGroupInput) (*request.Request, *ec2sdk.CreateSecurityGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateSecurityGroup"), nil
+}
+func (unimplementedEC2) CreateSecurityGroupWithContext(_ context.Context, _ *ec2sdk.CreateSecurityGroupInput, _ ...request.Option) (*ec2sdk.CreateSecurityGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSecurityGroup")
+}
+func (unimplementedEC2) CreateSnapshot(_ *ec2sdk.CreateSnapshotInput) (*ec2sdk.Snapshot, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSnapshot")
+}
+func (unimplementedEC2) CreateSnapshotRequest(_ *ec2sdk.CreateSnapshotInput) (*request.Request, *ec2sdk.Snapshot) {
+	return newNotImplementedRequest(ServiceEC2, "CreateSnapshot"), nil
+}
+func (unimplementedEC2) CreateSnapshotWithContext(_ context.Context, _ *ec2sdk.CreateSnapshotInput, _ ...request.Option) (*ec2sdk.Snapshot, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSnapshot")
+}
+func (unimplementedEC2) CreateSnapshots(_ *ec2sdk.CreateSnapshotsInput) (*ec2sdk.CreateSnapshotsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSnapshots")
+}
+func (unimplementedEC2) CreateSnapshotsRequest(_ *ec2sdk.CreateSnapshotsInput) (*request.Request, *ec2sdk.CreateSnapshotsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateSnapshots"), nil
+}
+func (unimplementedEC2) CreateSnapshotsWithContext(_ context.Context, _ *ec2sdk.CreateSnapshotsInput, _ ...request.Option) (*ec2sdk.CreateSnapshotsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSnapshots")
+}
+func (unimplementedEC2) CreateSpotDatafeedSubscription(_ *ec2sdk.CreateSpotDatafeedSubscriptionInput) (*ec2sdk.CreateSpotDatafeedSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSpotDatafeedSubscription")
+}
+func (unimplementedEC2) CreateSpotDatafeedSubscriptionRequest(_ *ec2sdk.CreateSpotDatafeedSubscriptionInput) (*request.Request, *ec2sdk.CreateSpotDatafeedSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateSpotDatafeedSubscription"), nil
+}
+func (unimplementedEC2) CreateSpotDatafeedSubscriptionWithContext(_ context.Context, _ *ec2sdk.CreateSpotDatafeedSubscriptionInput, _ ...request.Option) (*ec2sdk.CreateSpotDatafeedSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSpotDatafeedSubscription")
+}
+func (unimplementedEC2) CreateStoreImageTask(_ *ec2sdk.CreateStoreImageTaskInput) (*ec2sdk.CreateStoreImageTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateStoreImageTask")
+}
+func (unimplementedEC2) CreateStoreImageTaskRequest(_ *ec2sdk.CreateStoreImageTaskInput) (*request.Request, *ec2sdk.CreateStoreImageTaskOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateStoreImageTask"), nil
+}
+func (unimplementedEC2) CreateStoreImageTaskWithContext(_ context.Context, _ *ec2sdk.CreateStoreImageTaskInput, _ ...request.Option) (*ec2sdk.CreateStoreImageTaskOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateStoreImageTask")
+}
+func (unimplementedEC2) CreateSubnet(_ *ec2sdk.CreateSubnetInput) (*ec2sdk.CreateSubnetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSubnet")
+}
+func (unimplementedEC2) CreateSubnetCidrReservation(_ *ec2sdk.CreateSubnetCidrReservationInput) (*ec2sdk.CreateSubnetCidrReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSubnetCidrReservation")
+}
+func (unimplementedEC2) CreateSubnetCidrReservationRequest(_ *ec2sdk.CreateSubnetCidrReservationInput) (*request.Request, *ec2sdk.CreateSubnetCidrReservationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateSubnetCidrReservation"), nil
+}
+func (unimplementedEC2) CreateSubnetCidrReservationWithContext(_ context.Context, _ *ec2sdk.CreateSubnetCidrReservationInput, _ ...request.Option) (*ec2sdk.CreateSubnetCidrReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSubnetCidrReservation")
+}
+func (unimplementedEC2) CreateSubnetRequest(_ *ec2sdk.CreateSubnetInput) (*request.Request, *ec2sdk.CreateSubnetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateSubnet"), nil
+}
+func (unimplementedEC2) CreateSubnetWithContext(_ context.Context, _ *ec2sdk.CreateSubnetInput, _ ...request.Option) (*ec2sdk.CreateSubnetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateSubnet")
+}
+func (unimplementedEC2) CreateTags(_ *ec2sdk.CreateTagsInput) (*ec2sdk.CreateTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTags")
+}
+func (unimplementedEC2) CreateTagsRequest(_ *ec2sdk.CreateTagsInput) (*request.Request, *ec2sdk.CreateTagsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTags"), nil
+}
+func (unimplementedEC2) CreateTagsWithContext(_ context.Context, _ *ec2sdk.CreateTagsInput, _ ...request.Option) (*ec2sdk.CreateTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTags")
+}
+func (unimplementedEC2) CreateTrafficMirrorFilter(_ *ec2sdk.CreateTrafficMirrorFilterInput) (*ec2sdk.CreateTrafficMirrorFilterOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorFilter")
+}
+func (unimplementedEC2) CreateTrafficMirrorFilterRequest(_ *ec2sdk.CreateTrafficMirrorFilterInput) (*request.Request, *ec2sdk.CreateTrafficMirrorFilterOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTrafficMirrorFilter"), nil
+}
+func (unimplementedEC2) CreateTrafficMirrorFilterRule(_ *ec2sdk.CreateTrafficMirrorFilterRuleInput) (*ec2sdk.CreateTrafficMirrorFilterRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorFilterRule")
+}
+func (unimplementedEC2) CreateTrafficMirrorFilterRuleRequest(_ *ec2sdk.CreateTrafficMirrorFilterRuleInput) (*request.Request, *ec2sdk.CreateTrafficMirrorFilterRuleOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTrafficMirrorFilterRule"), nil
+}
+func (unimplementedEC2) CreateTrafficMirrorFilterRuleWithContext(_ context.Context, _ *ec2sdk.CreateTrafficMirrorFilterRuleInput, _ ...request.Option) (*ec2sdk.CreateTrafficMirrorFilterRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorFilterRule")
+}
+func (unimplementedEC2) CreateTrafficMirrorFilterWithContext(_ context.Context, _ *ec2sdk.CreateTrafficMirrorFilterInput, _ ...request.Option) (*ec2sdk.CreateTrafficMirrorFilterOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorFilter")
+}
+func (unimplementedEC2) CreateTrafficMirrorSession(_ *ec2sdk.CreateTrafficMirrorSessionInput) (*ec2sdk.CreateTrafficMirrorSessionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorSession")
+}
+func (unimplementedEC2) CreateTrafficMirrorSessionRequest(_ *ec2sdk.CreateTrafficMirrorSessionInput) (*request.Request, *ec2sdk.CreateTrafficMirrorSessionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTrafficMirrorSession"), nil
+}
+func (unimplementedEC2) CreateTrafficMirrorSessionWithContext(_ context.Context, _ *ec2sdk.CreateTrafficMirrorSessionInput, _ ...request.Option) (*ec2sdk.CreateTrafficMirrorSessionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorSession")
+}
+func (unimplementedEC2) CreateTrafficMirrorTarget(_ *ec2sdk.CreateTrafficMirrorTargetInput) (*ec2sdk.CreateTrafficMirrorTargetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorTarget")
+}
+func (unimplementedEC2) CreateTrafficMirrorTargetRequest(_ *ec2sdk.CreateTrafficMirrorTargetInput) (*request.Request, *ec2sdk.CreateTrafficMirrorTargetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTrafficMirrorTarget"), nil
+}
+func (unimplementedEC2) CreateTrafficMirrorTargetWithContext(_ context.Context, _ *ec2sdk.CreateTrafficMirrorTargetInput, _ ...request.Option) (*ec2sdk.CreateTrafficMirrorTargetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTrafficMirrorTarget")
+}
+func (unimplementedEC2) CreateTransitGateway(_ *ec2sdk.CreateTransitGatewayInput) (*ec2sdk.CreateTransitGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGateway")
+}
+func (unimplementedEC2) CreateTransitGatewayConnect(_ *ec2sdk.CreateTransitGatewayConnectInput) (*ec2sdk.CreateTransitGatewayConnectOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayConnect")
+}
+func (unimplementedEC2) CreateTransitGatewayConnectPeer(_ *ec2sdk.CreateTransitGatewayConnectPeerInput) (*ec2sdk.CreateTransitGatewayConnectPeerOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayConnectPeer")
+}
+func (unimplementedEC2) CreateTransitGatewayConnectPeerRequest(_ *ec2sdk.CreateTransitGatewayConnectPeerInput) (*request.Request, *ec2sdk.CreateTransitGatewayConnectPeerOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayConnectPeer"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayConnectPeerWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayConnectPeerInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayConnectPeerOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayConnectPeer")
+}
+func (unimplementedEC2) CreateTransitGatewayConnectRequest(_ *ec2sdk.CreateTransitGatewayConnectInput) (*request.Request, *ec2sdk.CreateTransitGatewayConnectOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayConnect"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayConnectWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayConnectInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayConnectOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayConnect")
+}
+func (unimplementedEC2) CreateTransitGatewayMulticastDomain(_ *ec2sdk.CreateTransitGatewayMulticastDomainInput) (*ec2sdk.CreateTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) CreateTransitGatewayMulticastDomainRequest(_ *ec2sdk.CreateTransitGatewayMulticastDomainInput) (*request.Request, *ec2sdk.CreateTransitGatewayMulticastDomainOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayMulticastDomain"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayMulticastDomainWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayMulticastDomainInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) CreateTransitGatewayPeeringAttachment(_ *ec2sdk.CreateTransitGatewayPeeringAttachmentInput) (*ec2sdk.CreateTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) CreateTransitGatewayPeeringAttachmentRequest(_ *ec2sdk.CreateTransitGatewayPeeringAttachmentInput) (*request.Request, *ec2sdk.CreateTransitGatewayPeeringAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayPeeringAttachment"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayPeeringAttachmentWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayPeeringAttachmentInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) CreateTransitGatewayPolicyTable(_ *ec2sdk.CreateTransitGatewayPolicyTableInput) (*ec2sdk.CreateTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) CreateTransitGatewayPolicyTableRequest(_ *ec2sdk.CreateTransitGatewayPolicyTableInput) (*request.Request, *ec2sdk.CreateTransitGatewayPolicyTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayPolicyTable"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayPolicyTableWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayPolicyTableInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) CreateTransitGatewayPrefixListReference(_ *ec2sdk.CreateTransitGatewayPrefixListReferenceInput) (*ec2sdk.CreateTransitGatewayPrefixListReferenceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayPrefixListReference")
+}
+func (unimplementedEC2) CreateTransitGatewayPrefixListReferenceRequest(_ *ec2sdk.CreateTransitGatewayPrefixListReferenceInput) (*request.Request, *ec2sdk.CreateTransitGatewayPrefixListReferenceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayPrefixListReference"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayPrefixListReferenceWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayPrefixListReferenceInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayPrefixListReferenceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayPrefixListReference")
+}
+func (unimplementedEC2) CreateTransitGatewayRequest(_ *ec2sdk.CreateTransitGatewayInput) (*request.Request, *ec2sdk.CreateTransitGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGateway"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayRoute(_ *ec2sdk.CreateTransitGatewayRouteInput) (*ec2sdk.CreateTransitGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayRoute")
+}
+func (unimplementedEC2) CreateTransitGatewayRouteRequest(_ *ec2sdk.CreateTransitGatewayRouteInput) (*request.Request, *ec2sdk.CreateTransitGatewayRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayRoute"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayRouteTable(_ *ec2sdk.CreateTransitGatewayRouteTableInput) (*ec2sdk.CreateTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayRouteTable")
+}
+func (unimplementedEC2) CreateTransitGatewayRouteTableAnnouncement(_ *ec2sdk.CreateTransitGatewayRouteTableAnnouncementInput) (*ec2sdk.CreateTransitGatewayRouteTableAnnouncementOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayRouteTableAnnouncement")
+}
+func (unimplementedEC2) CreateTransitGatewayRouteTableAnnouncementRequest(_ *ec2sdk.CreateTransitGatewayRouteTableAnnouncementInput) (*request.Request, *ec2sdk.CreateTransitGatewayRouteTableAnnouncementOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayRouteTableAnnouncement"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayRouteTableAnnouncementWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayRouteTableAnnouncementInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayRouteTableAnnouncementOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayRouteTableAnnouncement")
+}
+func (unimplementedEC2) CreateTransitGatewayRouteTableRequest(_ *ec2sdk.CreateTransitGatewayRouteTableInput) (*request.Request, *ec2sdk.CreateTransitGatewayRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayRouteTable"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayRouteTableWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayRouteTableInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayRouteTable")
+}
+func (unimplementedEC2) CreateTransitGatewayRouteWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayRouteInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayRoute")
+}
+func (unimplementedEC2) CreateTransitGatewayVpcAttachment(_ *ec2sdk.CreateTransitGatewayVpcAttachmentInput) (*ec2sdk.CreateTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) CreateTransitGatewayVpcAttachmentRequest(_ *ec2sdk.CreateTransitGatewayVpcAttachmentInput) (*request.Request, *ec2sdk.CreateTransitGatewayVpcAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateTransitGatewayVpcAttachment"), nil
+}
+func (unimplementedEC2) CreateTransitGatewayVpcAttachmentWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayVpcAttachmentInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) CreateTransitGatewayWithContext(_ context.Context, _ *ec2sdk.CreateTransitGatewayInput, _ ...request.Option) (*ec2sdk.CreateTransitGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateTransitGateway")
+}
+func (unimplementedEC2) CreateVerifiedAccessEndpoint(_ *ec2sdk.CreateVerifiedAccessEndpointInput) (*ec2sdk.CreateVerifiedAccessEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessEndpoint")
+}
+func (unimplementedEC2) CreateVerifiedAccessEndpointRequest(_ *ec2sdk.CreateVerifiedAccessEndpointInput) (*request.Request, *ec2sdk.CreateVerifiedAccessEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVerifiedAccessEndpoint"), nil
+}
+func (unimplementedEC2) CreateVerifiedAccessEndpointWithContext(_ context.Context, _ *ec2sdk.CreateVerifiedAccessEndpointInput, _ ...request.Option) (*ec2sdk.CreateVerifiedAccessEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessEndpoint")
+}
+func (unimplementedEC2) CreateVerifiedAccessGroup(_ *ec2sdk.CreateVerifiedAccessGroupInput) (*ec2sdk.CreateVerifiedAccessGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessGroup")
+}
+func (unimplementedEC2) CreateVerifiedAccessGroupRequest(_ *ec2sdk.CreateVerifiedAccessGroupInput) (*request.Request, *ec2sdk.CreateVerifiedAccessGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVerifiedAccessGroup"), nil
+}
+func (unimplementedEC2) CreateVerifiedAccessGroupWithContext(_ context.Context, _ *ec2sdk.CreateVerifiedAccessGroupInput, _ ...request.Option) (*ec2sdk.CreateVerifiedAccessGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessGroup")
+}
+func (unimplementedEC2) CreateVerifiedAccessInstance(_ *ec2sdk.CreateVerifiedAccessInstanceInput) (*ec2sdk.CreateVerifiedAccessInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessInstance")
+}
+func (unimplementedEC2) CreateVerifiedAccessInstanceRequest(_ *ec2sdk.CreateVerifiedAccessInstanceInput) (*request.Request, *ec2sdk.CreateVerifiedAccessInstanceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVerifiedAccessInstance"), nil
+}
+func (unimplementedEC2) CreateVerifiedAccessInstanceWithContext(_ context.Context, _ *ec2sdk.CreateVerifiedAccessInstanceInput, _ ...request.Option) (*ec2sdk.CreateVerifiedAccessInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessInstance")
+}
+func (unimplementedEC2) CreateVerifiedAccessTrustProvider(_ *ec2sdk.CreateVerifiedAccessTrustProviderInput) (*ec2sdk.CreateVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) CreateVerifiedAccessTrustProviderRequest(_ *ec2sdk.CreateVerifiedAccessTrustProviderInput) (*request.Request, *ec2sdk.CreateVerifiedAccessTrustProviderOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVerifiedAccessTrustProvider"), nil
+}
+func (unimplementedEC2) CreateVerifiedAccessTrustProviderWithContext(_ context.Context, _ *ec2sdk.CreateVerifiedAccessTrustProviderInput, _ ...request.Option) (*ec2sdk.CreateVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) CreateVolume(_ *ec2sdk.CreateVolumeInput) (*ec2sdk.Volume, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVolume")
+}
+func (unimplementedEC2) CreateVolumeRequest(_ *ec2sdk.CreateVolumeInput) (*request.Request, *ec2sdk.Volume) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVolume"), nil
+}
+func (unimplementedEC2) CreateVolumeWithContext(_ context.Context, _ *ec2sdk.CreateVolumeInput, _ ...request.Option) (*ec2sdk.Volume, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVolume")
+}
+func (unimplementedEC2) CreateVpc(_ *ec2sdk.CreateVpcInput) (*ec2sdk.CreateVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpc")
+}
+func (unimplementedEC2) CreateVpcEndpoint(_ *ec2sdk.CreateVpcEndpointInput) (*ec2sdk.CreateVpcEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcEndpoint")
+}
+func (unimplementedEC2) CreateVpcEndpointConnectionNotification(_ *ec2sdk.CreateVpcEndpointConnectionNotificationInput) (*ec2sdk.CreateVpcEndpointConnectionNotificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcEndpointConnectionNotification")
+}
+func (unimplementedEC2) CreateVpcEndpointConnectionNotificationRequest(_ *ec2sdk.CreateVpcEndpointConnectionNotificationInput) (*request.Request, *ec2sdk.CreateVpcEndpointConnectionNotificationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpcEndpointConnectionNotification"), nil
+}
+func (unimplementedEC2) CreateVpcEndpointConnectionNotificationWithContext(_ context.Context, _ *ec2sdk.CreateVpcEndpointConnectionNotificationInput, _ ...request.Option) (*ec2sdk.CreateVpcEndpointConnectionNotificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcEndpointConnectionNotification")
+}
+func (unimplementedEC2) CreateVpcEndpointRequest(_ *ec2sdk.CreateVpcEndpointInput) (*request.Request, *ec2sdk.CreateVpcEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpcEndpoint"), nil
+}
+func (unimplementedEC2) CreateVpcEndpointServiceConfiguration(_ *ec2sdk.CreateVpcEndpointServiceConfigurationInput) (*ec2sdk.CreateVpcEndpointServiceConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcEndpointServiceConfiguration")
+}
+func (unimplementedEC2) CreateVpcEndpointServiceConfigurationRequest(_ *ec2sdk.CreateVpcEndpointServiceConfigurationInput) (*request.Request, *ec2sdk.CreateVpcEndpointServiceConfigurationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpcEndpointServiceConfiguration"), nil
+}
+func (unimplementedEC2) CreateVpcEndpointServiceConfigurationWithContext(_ context.Context, _ *ec2sdk.CreateVpcEndpointServiceConfigurationInput, _ ...request.Option) (*ec2sdk.CreateVpcEndpointServiceConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcEndpointServiceConfiguration")
+}
+func (unimplementedEC2) CreateVpcEndpointWithContext(_ context.Context, _ *ec2sdk.CreateVpcEndpointInput, _ ...request.Option) (*ec2sdk.CreateVpcEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcEndpoint")
+}
+func (unimplementedEC2) CreateVpcPeeringConnection(_ *ec2sdk.CreateVpcPeeringConnectionInput) (*ec2sdk.CreateVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcPeeringConnection")
+}
+func (unimplementedEC2) CreateVpcPeeringConnectionRequest(_ *ec2sdk.CreateVpcPeeringConnectionInput) (*request.Request, *ec2sdk.CreateVpcPeeringConnectionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpcPeeringConnection"), nil
+}
+func (unimplementedEC2) CreateVpcPeeringConnectionWithContext(_ context.Context, _ *ec2sdk.CreateVpcPeeringConnectionInput, _ ...request.Option) (*ec2sdk.CreateVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpcPeeringConnection")
+}
+func (unimplementedEC2) CreateVpcRequest(_ *ec2sdk.CreateVpcInput) (*request.Request, *ec2sdk.CreateVpcOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpc"), nil
+}
+func (unimplementedEC2) CreateVpcWithContext(_ context.Context, _ *ec2sdk.CreateVpcInput, _ ...request.Option) (*ec2sdk.CreateVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpc")
+}
+func (unimplementedEC2) CreateVpnConnection(_ *ec2sdk.CreateVpnConnectionInput) (*ec2sdk.CreateVpnConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpnConnection")
+}
+func (unimplementedEC2) CreateVpnConnectionRequest(_ *ec2sdk.CreateVpnConnectionInput) (*request.Request, *ec2sdk.CreateVpnConnectionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpnConnection"), nil
+}
+func (unimplementedEC2) CreateVpnConnectionRoute(_ *ec2sdk.CreateVpnConnectionRouteInput) (*ec2sdk.CreateVpnConnectionRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpnConnectionRoute")
+}
+func (unimplementedEC2) CreateVpnConnectionRouteRequest(_ *ec2sdk.CreateVpnConnectionRouteInput) (*request.Request, *ec2sdk.CreateVpnConnectionRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpnConnectionRoute"), nil
+}
+func (unimplementedEC2) CreateVpnConnectionRouteWithContext(_ context.Context, _ *ec2sdk.CreateVpnConnectionRouteInput, _ ...request.Option) (*ec2sdk.CreateVpnConnectionRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpnConnectionRoute")
+}
+func (unimplementedEC2) CreateVpnConnectionWithContext(_ context.Context, _ *ec2sdk.CreateVpnConnectionInput, _ ...request.Option) (*ec2sdk.CreateVpnConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpnConnection")
+}
+func (unimplementedEC2) CreateVpnGateway(_ *ec2sdk.CreateVpnGatewayInput) (*ec2sdk.CreateVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpnGateway")
+}
+func (unimplementedEC2) CreateVpnGatewayRequest(_ *ec2sdk.CreateVpnGatewayInput) (*request.Request, *ec2sdk.CreateVpnGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "CreateVpnGateway"), nil
+}
+func (unimplementedEC2) CreateVpnGatewayWithContext(_ context.Context, _ *ec2sdk.CreateVpnGatewayInput, _ ...request.Option) (*ec2sdk.CreateVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "CreateVpnGateway")
+}
+func (unimplementedEC2) DeleteCarrierGateway(_ *ec2sdk.DeleteCarrierGatewayInput) (*ec2sdk.DeleteCarrierGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCarrierGateway")
+}
+func (unimplementedEC2) DeleteCarrierGatewayRequest(_ *ec2sdk.DeleteCarrierGatewayInput) (*request.Request, *ec2sdk.DeleteCarrierGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteCarrierGateway"), nil
+}
+func (unimplementedEC2) DeleteCarrierGatewayWithContext(_ context.Context, _ *ec2sdk.DeleteCarrierGatewayInput, _ ...request.Option) (*ec2sdk.DeleteCarrierGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCarrierGateway")
+}
+func (unimplementedEC2) DeleteClientVpnEndpoint(_ *ec2sdk.DeleteClientVpnEndpointInput) (*ec2sdk.DeleteClientVpnEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteClientVpnEndpoint")
+}
+func (unimplementedEC2) DeleteClientVpnEndpointRequest(_ *ec2sdk.DeleteClientVpnEndpointInput) (*request.Request, *ec2sdk.DeleteClientVpnEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteClientVpnEndpoint"), nil
+}
+func (unimplementedEC2) DeleteClientVpnEndpointWithContext(_ context.Context, _ *ec2sdk.DeleteClientVpnEndpointInput, _ ...request.Option) (*ec2sdk.DeleteClientVpnEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteClientVpnEndpoint")
+}
+func (unimplementedEC2) DeleteClientVpnRoute(_ *ec2sdk.DeleteClientVpnRouteInput) (*ec2sdk.DeleteClientVpnRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteClientVpnRoute")
+}
+func (unimplementedEC2) DeleteClientVpnRouteRequest(_ *ec2sdk.DeleteClientVpnRouteInput) (*request.Request, *ec2sdk.DeleteClientVpnRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteClientVpnRoute"), nil
+}
+func (unimplementedEC2) DeleteClientVpnRouteWithContext(_ context.Context, _ *ec2sdk.DeleteClientVpnRouteInput, _ ...request.Option) (*ec2sdk.DeleteClientVpnRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteClientVpnRoute")
+}
+func (unimplementedEC2) DeleteCoipCidr(_ *ec2sdk.DeleteCoipCidrInput) (*ec2sdk.DeleteCoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCoipCidr")
+}
+func (unimplementedEC2) DeleteCoipCidrRequest(_ *ec2sdk.DeleteCoipCidrInput) (*request.Request, *ec2sdk.DeleteCoipCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteCoipCidr"), nil
+}
+func (unimplementedEC2) DeleteCoipCidrWithContext(_ context.Context, _ *ec2sdk.DeleteCoipCidrInput, _ ...request.Option) (*ec2sdk.DeleteCoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCoipCidr")
+}
+func (unimplementedEC2) DeleteCoipPool(_ *ec2sdk.DeleteCoipPoolInput) (*ec2sdk.DeleteCoipPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCoipPool")
+}
+func (unimplementedEC2) DeleteCoipPoolRequest(_ *ec2sdk.DeleteCoipPoolInput) (*request.Request, *ec2sdk.DeleteCoipPoolOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteCoipPool"), nil
+}
+func (unimplementedEC2) DeleteCoipPoolWithContext(_ context.Context, _ *ec2sdk.DeleteCoipPoolInput, _ ...request.Option) (*ec2sdk.DeleteCoipPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCoipPool")
+}
+func (unimplementedEC2) DeleteCustomerGateway(_ *ec2sdk.DeleteCustomerGatewayInput) (*ec2sdk.DeleteCustomerGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCustomerGateway")
+}
+func (unimplementedEC2) DeleteCustomerGatewayRequest(_ *ec2sdk.DeleteCustomerGatewayInput) (*request.Request, *ec2sdk.DeleteCustomerGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteCustomerGateway"), nil
+}
+func (unimplementedEC2) DeleteCustomerGatewayWithContext(_ context.Context, _ *ec2sdk.DeleteCustomerGatewayInput, _ ...request.Option) (*ec2sdk.DeleteCustomerGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteCustomerGateway")
+}
+func (unimplementedEC2) DeleteDhcpOptions(_ *ec2sdk.DeleteDhcpOptionsInput) (*ec2sdk.DeleteDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteDhcpOptions")
+}
+func (unimplementedEC2) DeleteDhcpOptionsRequest(_ *ec2sdk.DeleteDhcpOptionsInput) (*request.Request, *ec2sdk.DeleteDhcpOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteDhcpOptions"), nil
+}
+func (unimplementedEC2) DeleteDhcpOptionsWithContext(_ context.Context, _ *ec2sdk.DeleteDhcpOptionsInput, _ ...request.Option) (*ec2sdk.DeleteDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteDhcpOptions")
+}
+func (unimplementedEC2) DeleteEgressOnlyInternetGateway(_ *ec2sdk.DeleteEgressOnlyInternetGatewayInput) (*ec2sdk.DeleteEgressOnlyInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteEgressOnlyInternetGateway")
+}
+func (unimplementedEC2) DeleteEgressOnlyInternetGatewayRequest(_ *ec2sdk.DeleteEgressOnlyInternetGatewayInput) (*request.Request, *ec2sdk.DeleteEgressOnlyInternetGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteEgressOnlyInternetGateway"), nil
+}
+func (unimplementedEC2) DeleteEgressOnlyInternetGatewayWithContext(_ context.Context, _ *ec2sdk.DeleteEgressOnlyInternetGatewayInput, _ ...request.Option) (*ec2sdk.DeleteEgressOnlyInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteEgressOnlyInternetGateway")
+}
+func (unimplementedEC2) DeleteFleets(_ *ec2sdk.DeleteFleetsInput) (*ec2sdk.DeleteFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteFleets")
+}
+func (unimplementedEC2) DeleteFleetsRequest(_ *ec2sdk.DeleteFleetsInput) (*request.Request, *ec2sdk.DeleteFleetsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteFleets"), nil
+}
+func (unimplementedEC2) DeleteFleetsWithContext(_ context.Context, _ *ec2sdk.DeleteFleetsInput, _ ...request.Option) (*ec2sdk.DeleteFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteFleets")
+}
+func (unimplementedEC2) DeleteFlowLogs(_ *ec2sdk.DeleteFlowLogsInput) (*ec2sdk.DeleteFlowLogsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteFlowLogs")
+}
+func (unimplementedEC2) DeleteFlowLogsRequest(_ *ec2sdk.DeleteFlowLogsInput) (*request.Request, *ec2sdk.DeleteFlowLogsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteFlowLogs"), nil
+}
+func (unimplementedEC2) DeleteFlowLogsWithContext(_ context.Context, _ *ec2sdk.DeleteFlowLogsInput, _ ...request.Option) (*ec2sdk.DeleteFlowLogsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteFlowLogs")
+}
+func (unimplementedEC2) DeleteFpgaImage(_ *ec2sdk.DeleteFpgaImageInput) (*ec2sdk.DeleteFpgaImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteFpgaImage")
+}
+func (unimplementedEC2) DeleteFpgaImageRequest(_ *ec2sdk.DeleteFpgaImageInput) (*request.Request, *ec2sdk.DeleteFpgaImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteFpgaImage"), nil
+}
+func (unimplementedEC2) DeleteFpgaImageWithContext(_ context.Context, _ *ec2sdk.DeleteFpgaImageInput, _ ...request.Option) (*ec2sdk.DeleteFpgaImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteFpgaImage")
+}
+func (unimplementedEC2) DeleteInstanceConnectEndpoint(_ *ec2sdk.DeleteInstanceConnectEndpointInput) (*ec2sdk.DeleteInstanceConnectEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteInstanceConnectEndpoint")
+}
+func (unimplementedEC2) DeleteInstanceConnectEndpointRequest(_ *ec2sdk.DeleteInstanceConnectEndpointInput) (*request.Request, *ec2sdk.DeleteInstanceConnectEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteInstanceConnectEndpoint"), nil
+}
+func (unimplementedEC2) DeleteInstanceConnectEndpointWithContext(_ context.Context, _ *ec2sdk.DeleteInstanceConnectEndpointInput, _ ...request.Option) (*ec2sdk.DeleteInstanceConnectEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteInstanceConnectEndpoint")
+}
+func (unimplementedEC2) DeleteInstanceEventWindow(_ *ec2sdk.DeleteInstanceEventWindowInput) (*ec2sdk.DeleteInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteInstanceEventWindow")
+}
+func (unimplementedEC2) DeleteInstanceEventWindowRequest(_ *ec2sdk.DeleteInstanceEventWindowInput) (*request.Request, *ec2sdk.DeleteInstanceEventWindowOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteInstanceEventWindow"), nil
+}
+func (unimplementedEC2) DeleteInstanceEventWindowWithContext(_ context.Context, _ *ec2sdk.DeleteInstanceEventWindowInput, _ ...request.Option) (*ec2sdk.DeleteInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteInstanceEventWindow")
+}
+func (unimplementedEC2) DeleteInternetGateway(_ *ec2sdk.DeleteInternetGatewayInput) (*ec2sdk.DeleteInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteInternetGateway")
+}
+func (unimplementedEC2) DeleteInternetGatewayRequest(_ *ec2sdk.DeleteInternetGatewayInput) (*request.Request, *ec2sdk.DeleteInternetGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteInternetGateway"), nil
+}
+func (unimplementedEC2) DeleteInternetGatewayWithContext(_ context.Context, _ *ec2sdk.DeleteInternetGatewayInput, _ ...request.Option) (*ec2sdk.DeleteInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteInternetGateway")
+}
+func (unimplementedEC2) DeleteIpam(_ *ec2sdk.DeleteIpamInput) (*ec2sdk.DeleteIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpam")
+}
+func (unimplementedEC2) DeleteIpamPool(_ *ec2sdk.DeleteIpamPoolInput) (*ec2sdk.DeleteIpamPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpamPool")
+}
+func (unimplementedEC2) DeleteIpamPoolRequest(_ *ec2sdk.DeleteIpamPoolInput) (*request.Request, *ec2sdk.DeleteIpamPoolOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteIpamPool"), nil
+}
+func (unimplementedEC2) DeleteIpamPoolWithContext(_ context.Context, _ *ec2sdk.DeleteIpamPoolInput, _ ...request.Option) (*ec2sdk.DeleteIpamPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpamPool")
+}
+func (unimplementedEC2) DeleteIpamRequest(_ *ec2sdk.DeleteIpamInput) (*request.Request, *ec2sdk.DeleteIpamOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteIpam"), nil
+}
+func (unimplementedEC2) DeleteIpamResourceDiscovery(_ *ec2sdk.DeleteIpamResourceDiscoveryInput) (*ec2sdk.DeleteIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpamResourceDiscovery")
+}
+func (unimplementedEC2) DeleteIpamResourceDiscoveryRequest(_ *ec2sdk.DeleteIpamResourceDiscoveryInput) (*request.Request, *ec2sdk.DeleteIpamResourceDiscoveryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteIpamResourceDiscovery"), nil
+}
+func (unimplementedEC2) DeleteIpamResourceDiscoveryWithContext(_ context.Context, _ *ec2sdk.DeleteIpamResourceDiscoveryInput, _ ...request.Option) (*ec2sdk.DeleteIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpamResourceDiscovery")
+}
+func (unimplementedEC2) DeleteIpamScope(_ *ec2sdk.DeleteIpamScopeInput) (*ec2sdk.DeleteIpamScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpamScope")
+}
+func (unimplementedEC2) DeleteIpamScopeRequest(_ *ec2sdk.DeleteIpamScopeInput) (*request.Request, *ec2sdk.DeleteIpamScopeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteIpamScope"), nil
+}
+func (unimplementedEC2) DeleteIpamScopeWithContext(_ context.Context, _ *ec2sdk.DeleteIpamScopeInput, _ ...request.Option) (*ec2sdk.DeleteIpamScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpamScope")
+}
+func (unimplementedEC2) DeleteIpamWithContext(_ context.Context, _ *ec2sdk.DeleteIpamInput, _ ...request.Option) (*ec2sdk.DeleteIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteIpam")
+}
+func (unimplementedEC2) DeleteKeyPair(_ *ec2sdk.DeleteKeyPairInput) (*ec2sdk.DeleteKeyPairOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteKeyPair")
+}
+func (unimplementedEC2) DeleteKeyPairRequest(_ *ec2sdk.DeleteKeyPairInput) (*request.Request, *ec2sdk.DeleteKeyPairOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteKeyPair"), nil
+}
+func (unimplementedEC2) DeleteKeyPairWithContext(_ context.Context, _ *ec2sdk.DeleteKeyPairInput, _ ...request.Option) (*ec2sdk.DeleteKeyPairOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteKeyPair")
+}
+func (unimplementedEC2) DeleteLaunchTemplate(_ *ec2sdk.DeleteLaunchTemplateInput) (*ec2sdk.DeleteLaunchTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLaunchTemplate")
+}
+func (unimplementedEC2) DeleteLaunchTemplateRequest(_ *ec2sdk.DeleteLaunchTemplateInput) (*request.Request, *ec2sdk.DeleteLaunchTemplateOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteLaunchTemplate"), nil
+}
+func (unimplementedEC2) DeleteLaunchTemplateVersions(_ *ec2sdk.DeleteLaunchTemplateVersionsInput) (*ec2sdk.DeleteLaunchTemplateVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLaunchTemplateVersions")
+}
+func (unimplementedEC2) DeleteLaunchTemplateVersionsRequest(_ *ec2sdk.DeleteLaunchTemplateVersionsInput) (*request.Request, *ec2sdk.DeleteLaunchTemplateVersionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteLaunchTemplateVersions"), nil
+}
+func (unimplementedEC2) DeleteLaunchTemplateVersionsWithContext(_ context.Context, _ *ec2sdk.DeleteLaunchTemplateVersionsInput, _ ...request.Option) (*ec2sdk.DeleteLaunchTemplateVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLaunchTemplateVersions")
+}
+func (unimplementedEC2) DeleteLaunchTemplateWithContext(_ context.Context, _ *ec2sdk.DeleteLaunchTemplateInput, _ ...request.Option) (*ec2sdk.DeleteLaunchTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLaunchTemplate")
+}
+func (unimplementedEC2) DeleteLocalGatewayRoute(_ *ec2sdk.DeleteLocalGatewayRouteInput) (*ec2sdk.DeleteLocalGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRoute")
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteRequest(_ *ec2sdk.DeleteLocalGatewayRouteInput) (*request.Request, *ec2sdk.DeleteLocalGatewayRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteLocalGatewayRoute"), nil
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTable(_ *ec2sdk.DeleteLocalGatewayRouteTableInput) (*ec2sdk.DeleteLocalGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRouteTable")
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableRequest(_ *ec2sdk.DeleteLocalGatewayRouteTableInput) (*request.Request, *ec2sdk.DeleteLocalGatewayRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteLocalGatewayRouteTable"), nil
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation(_ *ec2sdk.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput) (*ec2sdk.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation")
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationRequest(_ *ec2sdk.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput) (*request.Request, *ec2sdk.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation"), nil
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationWithContext(_ context.Context, _ *ec2sdk.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, _ ...request.Option) (*ec2sdk.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation")
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableVpcAssociation(_ *ec2sdk.DeleteLocalGatewayRouteTableVpcAssociationInput) (*ec2sdk.DeleteLocalGatewayRouteTableVpcAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRouteTableVpcAssociation")
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableVpcAssociationRequest(_ *ec2sdk.DeleteLocalGatewayRouteTableVpcAssociationInput) (*request.Request, *ec2sdk.DeleteLocalGatewayRouteTableVpcAssociationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteLocalGatewayRouteTableVpcAssociation"), nil
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableVpcAssociationWithContext(_ context.Context, _ *ec2sdk.DeleteLocalGatewayRouteTableVpcAssociationInput, _ ...request.Option) (*ec2sdk.DeleteLocalGatewayRouteTableVpcAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRouteTableVpcAssociation")
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteTableWithContext(_ context.Context, _ *ec2sdk.DeleteLocalGatewayRouteTableInput, _ ...request.Option) (*ec2sdk.DeleteLocalGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRouteTable")
+}
+func (unimplementedEC2) DeleteLocalGatewayRouteWithContext(_ context.Context, _ *ec2sdk.DeleteLocalGatewayRouteInput, _ ...request.Option) (*ec2sdk.DeleteLocalGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteLocalGatewayRoute")
+}
+func (unimplementedEC2) DeleteManagedPrefixList(_ *ec2sdk.DeleteManagedPrefixListInput) (*ec2sdk.DeleteManagedPrefixListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteManagedPrefixList")
+}
+func (unimplementedEC2) DeleteManagedPrefixListRequest(_ *ec2sdk.DeleteManagedPrefixListInput) (*request.Request, *ec2sdk.DeleteManagedPrefixListOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteManagedPrefixList"), nil
+}
+func (unimplementedEC2) DeleteManagedPrefixListWithContext(_ context.Context, _ *ec2sdk.DeleteManagedPrefixListInput, _ ...request.Option) (*ec2sdk.DeleteManagedPrefixListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteManagedPrefixList")
+}
+func (unimplementedEC2) DeleteNatGateway(_ *ec2sdk.DeleteNatGatewayInput) (*ec2sdk.DeleteNatGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNatGateway")
+}
+func (unimplementedEC2) DeleteNatGatewayRequest(_ *ec2sdk.DeleteNatGatewayInput) (*request.Request, *ec2sdk.DeleteNatGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNatGateway"), nil
+}
+func (unimplementedEC2) DeleteNatGatewayWithContext(_ context.Context, _ *ec2sdk.DeleteNatGatewayInput, _ ...request.Option) (*ec2sdk.DeleteNatGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNatGateway")
+}
+func (unimplementedEC2) DeleteNetworkAcl(_ *ec2sdk.DeleteNetworkAclInput) (*ec2sdk.DeleteNetworkAclOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkAcl")
+}
+func (unimplementedEC2) DeleteNetworkAclEntry(_ *ec2sdk.DeleteNetworkAclEntryInput) (*ec2sdk.DeleteNetworkAclEntryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkAclEntry")
+}
+func (unimplementedEC2) DeleteNetworkAclEntryRequest(_ *ec2sdk.DeleteNetworkAclEntryInput) (*request.Request, *ec2sdk.DeleteNetworkAclEntryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkAclEntry"), nil
+}
+func (unimplementedEC2) DeleteNetworkAclEntryWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkAclEntryInput, _ ...request.Option) (*ec2sdk.DeleteNetworkAclEntryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkAclEntry")
+}
+func (unimplementedEC2) DeleteNetworkAclRequest(_ *ec2sdk.DeleteNetworkAclInput) (*request.Request, *ec2sdk.DeleteNetworkAclOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkAcl"), nil
+}
+func (unimplementedEC2) DeleteNetworkAclWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkAclInput, _ ...request.Option) (*ec2sdk.DeleteNetworkAclOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkAcl")
+}
+func (unimplementedEC2) DeleteNetworkInsightsAccessScope(_ *ec2sdk.DeleteNetworkInsightsAccessScopeInput) (*ec2sdk.DeleteNetworkInsightsAccessScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsAccessScope")
+}
+func (unimplementedEC2) DeleteNetworkInsightsAccessScopeAnalysis(_ *ec2sdk.DeleteNetworkInsightsAccessScopeAnalysisInput) (*ec2sdk.DeleteNetworkInsightsAccessScopeAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsAccessScopeAnalysis")
+}
+func (unimplementedEC2) DeleteNetworkInsightsAccessScopeAnalysisRequest(_ *ec2sdk.DeleteNetworkInsightsAccessScopeAnalysisInput) (*request.Request, *ec2sdk.DeleteNetworkInsightsAccessScopeAnalysisOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkInsightsAccessScopeAnalysis"), nil
+}
+func (unimplementedEC2) DeleteNetworkInsightsAccessScopeAnalysisWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkInsightsAccessScopeAnalysisInput, _ ...request.Option) (*ec2sdk.DeleteNetworkInsightsAccessScopeAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsAccessScopeAnalysis")
+}
+func (unimplementedEC2) DeleteNetworkInsightsAccessScopeRequest(_ *ec2sdk.DeleteNetworkInsightsAccessScopeInput) (*request.Request, *ec2sdk.DeleteNetworkInsightsAccessScopeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkInsightsAccessScope"), nil
+}
+func (unimplementedEC2) DeleteNetworkInsightsAccessScopeWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkInsightsAccessScopeInput, _ ...request.Option) (*ec2sdk.DeleteNetworkInsightsAccessScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsAccessScope")
+}
+func (unimplementedEC2) DeleteNetworkInsightsAnalysis(_ *ec2sdk.DeleteNetworkInsightsAnalysisInput) (*ec2sdk.DeleteNetworkInsightsAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsAnalysis")
+}
+func (unimplementedEC2) DeleteNetworkInsightsAnalysisRequest(_ *ec2sdk.DeleteNetworkInsightsAnalysisInput) (*request.Request, *ec2sdk.DeleteNetworkInsightsAnalysisOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkInsightsAnalysis"), nil
+}
+func (unimplementedEC2) DeleteNetworkInsightsAnalysisWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkInsightsAnalysisInput, _ ...request.Option) (*ec2sdk.DeleteNetworkInsightsAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsAnalysis")
+}
+func (unimplementedEC2) DeleteNetworkInsightsPath(_ *ec2sdk.DeleteNetworkInsightsPathInput) (*ec2sdk.DeleteNetworkInsightsPathOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsPath")
+}
+func (unimplementedEC2) DeleteNetworkInsightsPathRequest(_ *ec2sdk.DeleteNetworkInsightsPathInput) (*request.Request, *ec2sdk.DeleteNetworkInsightsPathOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkInsightsPath"), nil
+}
+func (unimplementedEC2) DeleteNetworkInsightsPathWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkInsightsPathInput, _ ...request.Option) (*ec2sdk.DeleteNetworkInsightsPathOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInsightsPath")
+}
+func (unimplementedEC2) DeleteNetworkInterface(_ *ec2sdk.DeleteNetworkInterfaceInput) (*ec2sdk.DeleteNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInterface")
+}
+func (unimplementedEC2) DeleteNetworkInterfacePermission(_ *ec2sdk.DeleteNetworkInterfacePermissionInput) (*ec2sdk.DeleteNetworkInterfacePermissionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInterfacePermission")
+}
+func (unimplementedEC2) DeleteNetworkInterfacePermissionRequest(_ *ec2sdk.DeleteNetworkInterfacePermissionInput) (*request.Request, *ec2sdk.DeleteNetworkInterfacePermissionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkInterfacePermission"), nil
+}
+func (unimplementedEC2) DeleteNetworkInterfacePermissionWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkInterfacePermissionInput, _ ...request.Option) (*ec2sdk.DeleteNetworkInterfacePermissionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInterfacePermission")
+}
+func (unimplementedEC2) DeleteNetworkInterfaceRequest(_ *ec2sdk.DeleteNetworkInterfaceInput) (*request.Request, *ec2sdk.DeleteNetworkInterfaceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteNetworkInterface"), nil
+}
+func (unimplementedEC2) DeleteNetworkInterfaceWithContext(_ context.Context, _ *ec2sdk.DeleteNetworkInterfaceInput, _ ...request.Option) (*ec2sdk.DeleteNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteNetworkInterface")
+}
+func (unimplementedEC2) DeletePlacementGroup(_ *ec2sdk.DeletePlacementGroupInput) (*ec2sdk.DeletePlacementGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeletePlacementGroup")
+}
+func (unimplementedEC2) DeletePlacementGroupRequest(_ *ec2sdk.DeletePlacementGroupInput) (*request.Request, *ec2sdk.DeletePlacementGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeletePlacementGroup"), nil
+}
+func (unimplementedEC2) DeletePlacementGroupWithContext(_ context.Context, _ *ec2sdk.DeletePlacementGroupInput, _ ...request.Option) (*ec2sdk.DeletePlacementGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeletePlacementGroup")
+}
+func (unimplementedEC2) DeletePublicIpv4Pool(_ *ec2sdk.DeletePublicIpv4PoolInput) (*ec2sdk.DeletePublicIpv4PoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeletePublicIpv4Pool")
+}
+func (unimplementedEC2) DeletePublicIpv4PoolRequest(_ *ec2sdk.DeletePublicIpv4PoolInput) (*request.Request, *ec2sdk.DeletePublicIpv4PoolOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeletePublicIpv4Pool"), nil
+}
+func (unimplementedEC2) DeletePublicIpv4PoolWithContext(_ context.Context, _ *ec2sdk.DeletePublicIpv4PoolInput, _ ...request.Option) (*ec2sdk.DeletePublicIpv4PoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeletePublicIpv4Pool")
+}
+func (unimplementedEC2) DeleteQueuedReservedInstances(_ *ec2sdk.DeleteQueuedReservedInstancesInput) (*ec2sdk.DeleteQueuedReservedInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteQueuedReservedInstances")
+}
+func (unimplementedEC2) DeleteQueuedReservedInstancesRequest(_ *ec2sdk.DeleteQueuedReservedInstancesInput) (*request.Request, *ec2sdk.DeleteQueuedReservedInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteQueuedReservedInstances"), nil
+}
+func (unimplementedEC2) DeleteQueuedReservedInstancesWithContext(_ context.Context, _ *ec2sdk.DeleteQueuedReservedInstancesInput, _ ...request.Option) (*ec2sdk.DeleteQueuedReservedInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteQueuedReservedInstances")
+}
+func (unimplementedEC2) DeleteRoute(_ *ec2sdk.DeleteRouteInput) (*ec2sdk.DeleteRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteRoute")
+}
+func (unimplementedEC2) DeleteRouteRequest(_ *ec2sdk.DeleteRouteInput) (*request.Request, *ec2sdk.DeleteRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteRoute"), nil
+}
+func (unimplementedEC2) DeleteRouteTable(_ *ec2sdk.DeleteRouteTableInput) (*ec2sdk.DeleteRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteRouteTable")
+}
+func (unimplementedEC2) DeleteRouteTableRequest(_ *ec2sdk.DeleteRouteTableInput) (*request.Request, *ec2sdk.DeleteRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteRouteTable"), nil
+}
+func (unimplementedEC2) DeleteRouteTableWithContext(_ context.Context, _ *ec2sdk.DeleteRouteTableInput, _ ...request.Option) (*ec2sdk.DeleteRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteRouteTable")
+}
+func (unimplementedEC2) DeleteRouteWithContext(_ context.Context, _ *ec2sdk.DeleteRouteInput, _ ...request.Option) (*ec2sdk.DeleteRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteRoute")
+}
+func (unimplementedEC2) DeleteSecurityGroup(_ *ec2sdk.DeleteSecurityGroupInput) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSecurityGroup")
+}
+func (unimplementedEC2) DeleteSecurityGroupRequest(_ *ec2sdk.DeleteSecurityGroupInput) (*request.Request, *ec2sdk.DeleteSecurityGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteSecurityGroup"), nil
+}
+func (unimplementedEC2) DeleteSecurityGroupWithContext(_ context.Context, _ *ec2sdk.DeleteSecurityGroupInput, _ ...request.Option) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSecurityGroup")
+}
+func (unimplementedEC2) DeleteSnapshot(_ *ec2sdk.DeleteSnapshotInput) (*ec2sdk.DeleteSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSnapshot")
+}
+func (unimplementedEC2) DeleteSnapshotRequest(_ *ec2sdk.DeleteSnapshotInput) (*request.Request, *ec2sdk.DeleteSnapshotOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteSnapshot"), nil
+}
+func (unimplementedEC2) DeleteSnapshotWithContext(_ context.Context, _ *ec2sdk.DeleteSnapshotInput, _ ...request.Option) (*ec2sdk.DeleteSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSnapshot")
+}
+func (unimplementedEC2) DeleteSpotDatafeedSubscription(_ *ec2sdk.DeleteSpotDatafeedSubscriptionInput) (*ec2sdk.DeleteSpotDatafeedSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSpotDatafeedSubscription")
+}
+func (unimplementedEC2) DeleteSpotDatafeedSubscriptionRequest(_ *ec2sdk.DeleteSpotDatafeedSubscriptionInput) (*request.Request, *ec2sdk.DeleteSpotDatafeedSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteSpotDatafeedSubscription"), nil
+}
+func (unimplementedEC2) DeleteSpotDatafeedSubscriptionWithContext(_ context.Context, _ *ec2sdk.DeleteSpotDatafeedSubscriptionInput, _ ...request.Option) (*ec2sdk.DeleteSpotDatafeedSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSpotDatafeedSubscription")
+}
+func (unimplementedEC2) DeleteSubnet(_ *ec2sdk.DeleteSubnetInput) (*ec2sdk.DeleteSubnetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSubnet")
+}
+func (unimplementedEC2) DeleteSubnetCidrReservation(_ *ec2sdk.DeleteSubnetCidrReservationInput) (*ec2sdk.DeleteSubnetCidrReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSubnetCidrReservation")
+}
+func (unimplementedEC2) DeleteSubnetCidrReservationRequest(_ *ec2sdk.DeleteSubnetCidrReservationInput) (*request.Request, *ec2sdk.DeleteSubnetCidrReservationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteSubnetCidrReservation"), nil
+}
+func (unimplementedEC2) DeleteSubnetCidrReservationWithContext(_ context.Context, _ *ec2sdk.DeleteSubnetCidrReservationInput, _ ...request.Option) (*ec2sdk.DeleteSubnetCidrReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSubnetCidrReservation")
+}
+func (unimplementedEC2) DeleteSubnetRequest(_ *ec2sdk.DeleteSubnetInput) (*request.Request, *ec2sdk.DeleteSubnetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteSubnet"), nil
+}
+func (unimplementedEC2) DeleteSubnetWithContext(_ context.Context, _ *ec2sdk.DeleteSubnetInput, _ ...request.Option) (*ec2sdk.DeleteSubnetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteSubnet")
+}
+func (unimplementedEC2) DeleteTags(_ *ec2sdk.DeleteTagsInput) (*ec2sdk.DeleteTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTags")
+}
+func (unimplementedEC2) DeleteTagsRequest(_ *ec2sdk.DeleteTagsInput) (*request.Request, *ec2sdk.DeleteTagsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTags"), nil
+}
+func (unimplementedEC2) DeleteTagsWithContext(_ context.Context, _ *ec2sdk.DeleteTagsInput, _ ...request.Option) (*ec2sdk.DeleteTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTags")
+}
+func (unimplementedEC2) DeleteTrafficMirrorFilter(_ *ec2sdk.DeleteTrafficMirrorFilterInput) (*ec2sdk.DeleteTrafficMirrorFilterOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorFilter")
+}
+func (unimplementedEC2) DeleteTrafficMirrorFilterRequest(_ *ec2sdk.DeleteTrafficMirrorFilterInput) (*request.Request, *ec2sdk.DeleteTrafficMirrorFilterOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTrafficMirrorFilter"), nil
+}
+func (unimplementedEC2) DeleteTrafficMirrorFilterRule(_ *ec2sdk.DeleteTrafficMirrorFilterRuleInput) (*ec2sdk.DeleteTrafficMirrorFilterRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorFilterRule")
+}
+func (unimplementedEC2) DeleteTrafficMirrorFilterRuleRequest(_ *ec2sdk.DeleteTrafficMirrorFilterRuleInput) (*request.Request, *ec2sdk.DeleteTrafficMirrorFilterRuleOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTrafficMirrorFilterRule"), nil
+}
+func (unimplementedEC2) DeleteTrafficMirrorFilterRuleWithContext(_ context.Context, _ *ec2sdk.DeleteTrafficMirrorFilterRuleInput, _ ...request.Option) (*ec2sdk.DeleteTrafficMirrorFilterRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorFilterRule")
+}
+func (unimplementedEC2) DeleteTrafficMirrorFilterWithContext(_ context.Context, _ *ec2sdk.DeleteTrafficMirrorFilterInput, _ ...request.Option) (*ec2sdk.DeleteTrafficMirrorFilterOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorFilter")
+}
+func (unimplementedEC2) DeleteTrafficMirrorSession(_ *ec2sdk.DeleteTrafficMirrorSessionInput) (*ec2sdk.DeleteTrafficMirrorSessionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorSession")
+}
+func (unimplementedEC2) DeleteTrafficMirrorSessionRequest(_ *ec2sdk.DeleteTrafficMirrorSessionInput) (*request.Request, *ec2sdk.DeleteTrafficMirrorSessionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTrafficMirrorSession"), nil
+}
+func (unimplementedEC2) DeleteTrafficMirrorSessionWithContext(_ context.Context, _ *ec2sdk.DeleteTrafficMirrorSessionInput, _ ...request.Option) (*ec2sdk.DeleteTrafficMirrorSessionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorSession")
+}
+func (unimplementedEC2) DeleteTrafficMirrorTarget(_ *ec2sdk.DeleteTrafficMirrorTargetInput) (*ec2sdk.DeleteTrafficMirrorTargetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorTarget")
+}
+func (unimplementedEC2) DeleteTrafficMirrorTargetRequest(_ *ec2sdk.DeleteTrafficMirrorTargetInput) (*request.Request, *ec2sdk.DeleteTrafficMirrorTargetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTrafficMirrorTarget"), nil
+}
+func (unimplementedEC2) DeleteTrafficMirrorTargetWithContext(_ context.Context, _ *ec2sdk.DeleteTrafficMirrorTargetInput, _ ...request.Option) (*ec2sdk.DeleteTrafficMirrorTargetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTrafficMirrorTarget")
+}
+func (unimplementedEC2) DeleteTransitGateway(_ *ec2sdk.DeleteTransitGatewayInput) (*ec2sdk.DeleteTransitGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGateway")
+}
+func (unimplementedEC2) DeleteTransitGatewayConnect(_ *ec2sdk.DeleteTransitGatewayConnectInput) (*ec2sdk.DeleteTransitGatewayConnectOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayConnect")
+}
+func (unimplementedEC2) DeleteTransitGatewayConnectPeer(_ *ec2sdk.DeleteTransitGatewayConnectPeerInput) (*ec2sdk.DeleteTransitGatewayConnectPeerOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayConnectPeer")
+}
+func (unimplementedEC2) DeleteTransitGatewayConnectPeerRequest(_ *ec2sdk.DeleteTransitGatewayConnectPeerInput) (*request.Request, *ec2sdk.DeleteTransitGatewayConnectPeerOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayConnectPeer"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayConnectPeerWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayConnectPeerInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayConnectPeerOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayConnectPeer")
+}
+func (unimplementedEC2) DeleteTransitGatewayConnectRequest(_ *ec2sdk.DeleteTransitGatewayConnectInput) (*request.Request, *ec2sdk.DeleteTransitGatewayConnectOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayConnect"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayConnectWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayConnectInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayConnectOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayConnect")
+}
+func (unimplementedEC2) DeleteTransitGatewayMulticastDomain(_ *ec2sdk.DeleteTransitGatewayMulticastDomainInput) (*ec2sdk.DeleteTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) DeleteTransitGatewayMulticastDomainRequest(_ *ec2sdk.DeleteTransitGatewayMulticastDomainInput) (*request.Request, *ec2sdk.DeleteTransitGatewayMulticastDomainOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayMulticastDomain"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayMulticastDomainWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayMulticastDomainInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) DeleteTransitGatewayPeeringAttachment(_ *ec2sdk.DeleteTransitGatewayPeeringAttachmentInput) (*ec2sdk.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) DeleteTransitGatewayPeeringAttachmentRequest(_ *ec2sdk.DeleteTransitGatewayPeeringAttachmentInput) (*request.Request, *ec2sdk.DeleteTransitGatewayPeeringAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayPeeringAttachment"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayPeeringAttachmentWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayPeeringAttachmentInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) DeleteTransitGatewayPolicyTable(_ *ec2sdk.DeleteTransitGatewayPolicyTableInput) (*ec2sdk.DeleteTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) DeleteTransitGatewayPolicyTableRequest(_ *ec2sdk.DeleteTransitGatewayPolicyTableInput) (*request.Request, *ec2sdk.DeleteTransitGatewayPolicyTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayPolicyTable"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayPolicyTableWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayPolicyTableInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) DeleteTransitGatewayPrefixListReference(_ *ec2sdk.DeleteTransitGatewayPrefixListReferenceInput) (*ec2sdk.DeleteTransitGatewayPrefixListReferenceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayPrefixListReference")
+}
+func (unimplementedEC2) DeleteTransitGatewayPrefixListReferenceRequest(_ *ec2sdk.DeleteTransitGatewayPrefixListReferenceInput) (*request.Request, *ec2sdk.DeleteTransitGatewayPrefixListReferenceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayPrefixListReference"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayPrefixListReferenceWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayPrefixListReferenceInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayPrefixListReferenceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayPrefixListReference")
+}
+func (unimplementedEC2) DeleteTransitGatewayRequest(_ *ec2sdk.DeleteTransitGatewayInput) (*request.Request, *ec2sdk.DeleteTransitGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGateway"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayRoute(_ *ec2sdk.DeleteTransitGatewayRouteInput) (*ec2sdk.DeleteTransitGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayRoute")
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteRequest(_ *ec2sdk.DeleteTransitGatewayRouteInput) (*request.Request, *ec2sdk.DeleteTransitGatewayRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayRoute"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteTable(_ *ec2sdk.DeleteTransitGatewayRouteTableInput) (*ec2sdk.DeleteTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayRouteTable")
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteTableAnnouncement(_ *ec2sdk.DeleteTransitGatewayRouteTableAnnouncementInput) (*ec2sdk.DeleteTransitGatewayRouteTableAnnouncementOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayRouteTableAnnouncement")
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteTableAnnouncementRequest(_ *ec2sdk.DeleteTransitGatewayRouteTableAnnouncementInput) (*request.Request, *ec2sdk.DeleteTransitGatewayRouteTableAnnouncementOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayRouteTableAnnouncement"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteTableAnnouncementWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayRouteTableAnnouncementInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayRouteTableAnnouncementOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayRouteTableAnnouncement")
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteTableRequest(_ *ec2sdk.DeleteTransitGatewayRouteTableInput) (*request.Request, *ec2sdk.DeleteTransitGatewayRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayRouteTable"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteTableWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayRouteTableInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayRouteTable")
+}
+func (unimplementedEC2) DeleteTransitGatewayRouteWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayRouteInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayRoute")
+}
+func (unimplementedEC2) DeleteTransitGatewayVpcAttachment(_ *ec2sdk.DeleteTransitGatewayVpcAttachmentInput) (*ec2sdk.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) DeleteTransitGatewayVpcAttachmentRequest(_ *ec2sdk.DeleteTransitGatewayVpcAttachmentInput) (*request.Request, *ec2sdk.DeleteTransitGatewayVpcAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteTransitGatewayVpcAttachment"), nil
+}
+func (unimplementedEC2) DeleteTransitGatewayVpcAttachmentWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayVpcAttachmentInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) DeleteTransitGatewayWithContext(_ context.Context, _ *ec2sdk.DeleteTransitGatewayInput, _ ...request.Option) (*ec2sdk.DeleteTransitGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteTransitGateway")
+}
+func (unimplementedEC2) DeleteVerifiedAccessEndpoint(_ *ec2sdk.DeleteVerifiedAccessEndpointInput) (*ec2sdk.DeleteVerifiedAccessEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessEndpoint")
+}
+func (unimplementedEC2) DeleteVerifiedAccessEndpointRequest(_ *ec2sdk.DeleteVerifiedAccessEndpointInput) (*request.Request, *ec2sdk.DeleteVerifiedAccessEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVerifiedAccessEndpoint"), nil
+}
+func (unimplementedEC2) DeleteVerifiedAccessEndpointWithContext(_ context.Context, _ *ec2sdk.DeleteVerifiedAccessEndpointInput, _ ...request.Option) (*ec2sdk.DeleteVerifiedAccessEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessEndpoint")
+}
+func (unimplementedEC2) DeleteVerifiedAccessGroup(_ *ec2sdk.DeleteVerifiedAccessGroupInput) (*ec2sdk.DeleteVerifiedAccessGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessGroup")
+}
+func (unimplementedEC2) DeleteVerifiedAccessGroupRequest(_ *ec2sdk.DeleteVerifiedAccessGroupInput) (*request.Request, *ec2sdk.DeleteVerifiedAccessGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVerifiedAccessGroup"), nil
+}
+func (unimplementedEC2) DeleteVerifiedAccessGroupWithContext(_ context.Context, _ *ec2sdk.DeleteVerifiedAccessGroupInput, _ ...request.Option) (*ec2sdk.DeleteVerifiedAccessGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessGroup")
+}
+func (unimplementedEC2) DeleteVerifiedAccessInstance(_ *ec2sdk.DeleteVerifiedAccessInstanceInput) (*ec2sdk.DeleteVerifiedAccessInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessInstance")
+}
+func (unimplementedEC2) DeleteVerifiedAccessInstanceRequest(_ *ec2sdk.DeleteVerifiedAccessInstanceInput) (*request.Request, *ec2sdk.DeleteVerifiedAccessInstanceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVerifiedAccessInstance"), nil
+}
+func (unimplementedEC2) DeleteVerifiedAccessInstanceWithContext(_ context.Context, _ *ec2sdk.DeleteVerifiedAccessInstanceInput, _ ...request.Option) (*ec2sdk.DeleteVerifiedAccessInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessInstance")
+}
+func (unimplementedEC2) DeleteVerifiedAccessTrustProvider(_ *ec2sdk.DeleteVerifiedAccessTrustProviderInput) (*ec2sdk.DeleteVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) DeleteVerifiedAccessTrustProviderRequest(_ *ec2sdk.DeleteVerifiedAccessTrustProviderInput) (*request.Request, *ec2sdk.DeleteVerifiedAccessTrustProviderOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVerifiedAccessTrustProvider"), nil
+}
+func (unimplementedEC2) DeleteVerifiedAccessTrustProviderWithContext(_ context.Context, _ *ec2sdk.DeleteVerifiedAccessTrustProviderInput, _ ...request.Option) (*ec2sdk.DeleteVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) DeleteVolume(_ *ec2sdk.DeleteVolumeInput) (*ec2sdk.DeleteVolumeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVolume")
+}
+func (unimplementedEC2) DeleteVolumeRequest(_ *ec2sdk.DeleteVolumeInput) (*request.Request, *ec2sdk.DeleteVolumeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVolume"), nil
+}
+func (unimplementedEC2) DeleteVolumeWithContext(_ context.Context, _ *ec2sdk.DeleteVolumeInput, _ ...request.Option) (*ec2sdk.DeleteVolumeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVolume")
+}
+func (unimplementedEC2) DeleteVpc(_ *ec2sdk.DeleteVpcInput) (*ec2sdk.DeleteVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpc")
+}
+func (unimplementedEC2) DeleteVpcEndpointConnectionNotifications(_ *ec2sdk.DeleteVpcEndpointConnectionNotificationsInput) (*ec2sdk.DeleteVpcEndpointConnectionNotificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcEndpointConnectionNotifications")
+}
+func (unimplementedEC2) DeleteVpcEndpointConnectionNotificationsRequest(_ *ec2sdk.DeleteVpcEndpointConnectionNotificationsInput) (*request.Request, *ec2sdk.DeleteVpcEndpointConnectionNotificationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpcEndpointConnectionNotifications"), nil
+}
+func (unimplementedEC2) DeleteVpcEndpointConnectionNotificationsWithContext(_ context.Context, _ *ec2sdk.DeleteVpcEndpointConnectionNotificationsInput, _ ...request.Option) (*ec2sdk.DeleteVpcEndpointConnectionNotificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcEndpointConnectionNotifications")
+}
+func (unimplementedEC2) DeleteVpcEndpointServiceConfigurations(_ *ec2sdk.DeleteVpcEndpointServiceConfigurationsInput) (*ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcEndpointServiceConfigurations")
+}
+func (unimplementedEC2) DeleteVpcEndpointServiceConfigurationsRequest(_ *ec2sdk.DeleteVpcEndpointServiceConfigurationsInput) (*request.Request, *ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpcEndpointServiceConfigurations"), nil
+}
+func (unimplementedEC2) DeleteVpcEndpointServiceConfigurationsWithContext(_ context.Context, _ *ec2sdk.DeleteVpcEndpointServiceConfigurationsInput, _ ...request.Option) (*ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcEndpointServiceConfigurations")
+}
+func (unimplementedEC2) DeleteVpcEndpoints(_ *ec2sdk.DeleteVpcEndpointsInput) (*ec2sdk.DeleteVpcEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcEndpoints")
+}
+func (unimplementedEC2) DeleteVpcEndpointsRequest(_ *ec2sdk.DeleteVpcEndpointsInput) (*request.Request, *ec2sdk.DeleteVpcEndpointsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpcEndpoints"), nil
+}
+func (unimplementedEC2) DeleteVpcEndpointsWithContext(_ context.Context, _ *ec2sdk.DeleteVpcEndpointsInput, _ ...request.Option) (*ec2sdk.DeleteVpcEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcEndpoints")
+}
+func (unimplementedEC2) DeleteVpcPeeringConnection(_ *ec2sdk.DeleteVpcPeeringConnectionInput) (*ec2sdk.DeleteVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcPeeringConnection")
+}
+func (unimplementedEC2) DeleteVpcPeeringConnectionRequest(_ *ec2sdk.DeleteVpcPeeringConnectionInput) (*request.Request, *ec2sdk.DeleteVpcPeeringConnectionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpcPeeringConnection"), nil
+}
+func (unimplementedEC2) DeleteVpcPeeringConnectionWithContext(_ context.Context, _ *ec2sdk.DeleteVpcPeeringConnectionInput, _ ...request.Option) (*ec2sdk.DeleteVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpcPeeringConnection")
+}
+func (unimplementedEC2) DeleteVpcRequest(_ *ec2sdk.DeleteVpcInput) (*request.Request, *ec2sdk.DeleteVpcOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpc"), nil
+}
+func (unimplementedEC2) DeleteVpcWithContext(_ context.Context, _ *ec2sdk.DeleteVpcInput, _ ...request.Option) (*ec2sdk.DeleteVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpc")
+}
+func (unimplementedEC2) DeleteVpnConnection(_ *ec2sdk.DeleteVpnConnectionInput) (*ec2sdk.DeleteVpnConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpnConnection")
+}
+func (unimplementedEC2) DeleteVpnConnectionRequest(_ *ec2sdk.DeleteVpnConnectionInput) (*request.Request, *ec2sdk.DeleteVpnConnectionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpnConnection"), nil
+}
+func (unimplementedEC2) DeleteVpnConnectionRoute(_ *ec2sdk.DeleteVpnConnectionRouteInput) (*ec2sdk.DeleteVpnConnectionRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpnConnectionRoute")
+}
+func (unimplementedEC2) DeleteVpnConnectionRouteRequest(_ *ec2sdk.DeleteVpnConnectionRouteInput) (*request.Request, *ec2sdk.DeleteVpnConnectionRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpnConnectionRoute"), nil
+}
+func (unimplementedEC2) DeleteVpnConnectionRouteWithContext(_ context.Context, _ *ec2sdk.DeleteVpnConnectionRouteInput, _ ...request.Option) (*ec2sdk.DeleteVpnConnectionRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpnConnectionRoute")
+}
+func (unimplementedEC2) DeleteVpnConnectionWithContext(_ context.Context, _ *ec2sdk.DeleteVpnConnectionInput, _ ...request.Option) (*ec2sdk.DeleteVpnConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpnConnection")
+}
+func (unimplementedEC2) DeleteVpnGateway(_ *ec2sdk.DeleteVpnGatewayInput) (*ec2sdk.DeleteVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpnGateway")
+}
+func (unimplementedEC2) DeleteVpnGatewayRequest(_ *ec2sdk.DeleteVpnGatewayInput) (*request.Request, *ec2sdk.DeleteVpnGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeleteVpnGateway"), nil
+}
+func (unimplementedEC2) DeleteVpnGatewayWithContext(_ context.Context, _ *ec2sdk.DeleteVpnGatewayInput, _ ...request.Option) (*ec2sdk.DeleteVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeleteVpnGateway")
+}
+func (unimplementedEC2) DeprovisionByoipCidr(_ *ec2sdk.DeprovisionByoipCidrInput) (*ec2sdk.DeprovisionByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionByoipCidr")
+}
+func (unimplementedEC2) DeprovisionByoipCidrRequest(_ *ec2sdk.DeprovisionByoipCidrInput) (*request.Request, *ec2sdk.DeprovisionByoipCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeprovisionByoipCidr"), nil
+}
+func (unimplementedEC2) DeprovisionByoipCidrWithContext(_ context.Context, _ *ec2sdk.DeprovisionByoipCidrInput, _ ...request.Option) (*ec2sdk.DeprovisionByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionByoipCidr")
+}
+func (unimplementedEC2) DeprovisionIpamByoasn(_ *ec2sdk.DeprovisionIpamByoasnInput) (*ec2sdk.DeprovisionIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionIpamByoasn")
+}
+func (unimplementedEC2) DeprovisionIpamByoasnRequest(_ *ec2sdk.DeprovisionIpamByoasnInput) (*request.Request, *ec2sdk.DeprovisionIpamByoasnOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeprovisionIpamByoasn"), nil
+}
+func (unimplementedEC2) DeprovisionIpamByoasnWithContext(_ context.Context, _ *ec2sdk.DeprovisionIpamByoasnInput, _ ...request.Option) (*ec2sdk.DeprovisionIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionIpamByoasn")
+}
+func (unimplementedEC2) DeprovisionIpamPoolCidr(_ *ec2sdk.DeprovisionIpamPoolCidrInput) (*ec2sdk.DeprovisionIpamPoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionIpamPoolCidr")
+}
+func (unimplementedEC2) DeprovisionIpamPoolCidrRequest(_ *ec2sdk.DeprovisionIpamPoolCidrInput) (*request.Request, *ec2sdk.DeprovisionIpamPoolCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeprovisionIpamPoolCidr"), nil
+}
+func (unimplementedEC2) DeprovisionIpamPoolCidrWithContext(_ context.Context, _ *ec2sdk.DeprovisionIpamPoolCidrInput, _ ...request.Option) (*ec2sdk.DeprovisionIpamPoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionIpamPoolCidr")
+}
+func (unimplementedEC2) DeprovisionPublicIpv4PoolCidr(_ *ec2sdk.DeprovisionPublicIpv4PoolCidrInput) (*ec2sdk.DeprovisionPublicIpv4PoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionPublicIpv4PoolCidr")
+}
+func (unimplementedEC2) DeprovisionPublicIpv4PoolCidrRequest(_ *ec2sdk.DeprovisionPublicIpv4PoolCidrInput) (*request.Request, *ec2sdk.DeprovisionPublicIpv4PoolCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeprovisionPublicIpv4PoolCidr"), nil
+}
+func (unimplementedEC2) DeprovisionPublicIpv4PoolCidrWithContext(_ context.Context, _ *ec2sdk.DeprovisionPublicIpv4PoolCidrInput, _ ...request.Option) (*ec2sdk.DeprovisionPublicIpv4PoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeprovisionPublicIpv4PoolCidr")
+}
+func (unimplementedEC2) DeregisterImage(_ *ec2sdk.DeregisterImageInput) (*ec2sdk.DeregisterImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterImage")
+}
+func (unimplementedEC2) DeregisterImageRequest(_ *ec2sdk.DeregisterImageInput) (*request.Request, *ec2sdk.DeregisterImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeregisterImage"), nil
+}
+func (unimplementedEC2) DeregisterImageWithContext(_ context.Context, _ *ec2sdk.DeregisterImageInput, _ ...request.Option) (*ec2sdk.DeregisterImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterImage")
+}
+func (unimplementedEC2) DeregisterInstanceEventNotificationAttributes(_ *ec2sdk.DeregisterInstanceEventNotificationAttributesInput) (*ec2sdk.DeregisterInstanceEventNotificationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterInstanceEventNotificationAttributes")
+}
+func (unimplementedEC2) DeregisterInstanceEventNotificationAttributesRequest(_ *ec2sdk.DeregisterInstanceEventNotificationAttributesInput) (*request.Request, *ec2sdk.DeregisterInstanceEventNotificationAttributesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeregisterInstanceEventNotificationAttributes"), nil
+}
+func (unimplementedEC2) DeregisterInstanceEventNotificationAttributesWithContext(_ context.Context, _ *ec2sdk.DeregisterInstanceEventNotificationAttributesInput, _ ...request.Option) (*ec2sdk.DeregisterInstanceEventNotificationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterInstanceEventNotificationAttributes")
+}
+func (unimplementedEC2) DeregisterTransitGatewayMulticastGroupMembers(_ *ec2sdk.DeregisterTransitGatewayMulticastGroupMembersInput) (*ec2sdk.DeregisterTransitGatewayMulticastGroupMembersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterTransitGatewayMulticastGroupMembers")
+}
+func (unimplementedEC2) DeregisterTransitGatewayMulticastGroupMembersRequest(_ *ec2sdk.DeregisterTransitGatewayMulticastGroupMembersInput) (*request.Request, *ec2sdk.DeregisterTransitGatewayMulticastGroupMembersOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeregisterTransitGatewayMulticastGroupMembers"), nil
+}
+func (unimplementedEC2) DeregisterTransitGatewayMulticastGroupMembersWithContext(_ context.Context, _ *ec2sdk.DeregisterTransitGatewayMulticastGroupMembersInput, _ ...request.Option) (*ec2sdk.DeregisterTransitGatewayMulticastGroupMembersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterTransitGatewayMulticastGroupMembers")
+}
+func (unimplementedEC2) DeregisterTransitGatewayMulticastGroupSources(_ *ec2sdk.DeregisterTransitGatewayMulticastGroupSourcesInput) (*ec2sdk.DeregisterTransitGatewayMulticastGroupSourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterTransitGatewayMulticastGroupSources")
+}
+func (unimplementedEC2) DeregisterTransitGatewayMulticastGroupSourcesRequest(_ *ec2sdk.DeregisterTransitGatewayMulticastGroupSourcesInput) (*request.Request, *ec2sdk.DeregisterTransitGatewayMulticastGroupSourcesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DeregisterTransitGatewayMulticastGroupSources"), nil
+}
+func (unimplementedEC2) DeregisterTransitGatewayMulticastGroupSourcesWithContext(_ context.Context, _ *ec2sdk.DeregisterTransitGatewayMulticastGroupSourcesInput, _ ...request.Option) (*ec2sdk.DeregisterTransitGatewayMulticastGroupSourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DeregisterTransitGatewayMulticastGroupSources")
+}
+func (unimplementedEC2) DescribeAccountAttributes(_ *ec2sdk.DescribeAccountAttributesInput) (*ec2sdk.DescribeAccountAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAccountAttributes")
+}
+func (unimplementedEC2) DescribeAccountAttributesRequest(_ *ec2sdk.DescribeAccountAttributesInput) (*request.Request, *ec2sdk.DescribeAccountAttributesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeAccountAttributes"), nil
+}
+func (unimplementedEC2) DescribeAccountAttributesWithContext(_ context.Context, _ *ec2sdk.DescribeAccountAttributesInput, _ ...request.Option) (*ec2sdk.DescribeAccountAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAccountAttributes")
+}
+func (unimplementedEC2) DescribeAddressTransfers(_ *ec2sdk.DescribeAddressTransfersInput) (*ec2sdk.DescribeAddressTransfersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAddressTransfers")
+}
+func (unimplementedEC2) DescribeAddressTransfersPages(_ *ec2sdk.DescribeAddressTransfersInput, _ func(*ec2sdk.DescribeAddressTransfersOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeAddressTransfers")
+}
+func (unimplementedEC2) DescribeAddressTransfersPagesWithContext(_ context.Context, _ *ec2sdk.DescribeAddressTransfersInput, _ func(*ec2sdk.DescribeAddressTransfersOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeAddressTransfers")
+}
+func (unimplementedEC2) DescribeAddressTransfersRequest(_ *ec2sdk.DescribeAddressTransfersInput) (*request.Request, *ec2sdk.DescribeAddressTransfersOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeAddressTransfers"), nil
+}
+func (unimplementedEC2) DescribeAddressTransfersWithContext(_ context.Context, _ *ec2sdk.DescribeAddressTransfersInput, _ ...request.Option) (*ec2sdk.DescribeAddressTransfersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAddressTransfers")
+}
+func (unimplementedEC2) DescribeAddresses(_ *ec2sdk.DescribeAddressesInput) (*ec2sdk.DescribeAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAddresses")
+}
+func (unimplementedEC2) DescribeAddressesAttribute(_ *ec2sdk.DescribeAddressesAttributeInput) (*ec2sdk.DescribeAddressesAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAddressesAttribute")
+}
+func (unimplementedEC2) DescribeAddressesAttributePages(_ *ec2sdk.DescribeAddressesAttributeInput, _ func(*ec2sdk.DescribeAddressesAttributeOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeAddressesAttribute")
+}
+func (unimplementedEC2) DescribeAddressesAttributePagesWithContext(_ context.Context, _ *ec2sdk.DescribeAddressesAttributeInput, _ func(*ec2sdk.DescribeAddressesAttributeOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeAddressesAttribute")
+}
+func (unimplementedEC2) DescribeAddressesAttributeRequest(_ *ec2sdk.DescribeAddressesAttributeInput) (*request.Request, *ec2sdk.DescribeAddressesAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeAddressesAttribute"), nil
+}
+func (unimplementedEC2) DescribeAddressesAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeAddressesAttributeInput, _ ...request.Option) (*ec2sdk.DescribeAddressesAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAddressesAttribute")
+}
+func (unimplementedEC2) DescribeAddressesRequest(_ *ec2sdk.DescribeAddressesInput) (*request.Request, *ec2sdk.DescribeAddressesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeAddresses"), nil
+}
+func (unimplementedEC2) DescribeAddressesWithContext(_ context.Context, _ *ec2sdk.DescribeAddressesInput, _ ...request.Option) (*ec2sdk.DescribeAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAddresses")
+}
+func (unimplementedEC2) DescribeAggregateIdFormat(_ *ec2sdk.DescribeAggregateIdFormatInput) (*ec2sdk.DescribeAggregateIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAggregateIdFormat")
+}
+func (unimplementedEC2) DescribeAggregateIdFormatRequest(_ *ec2sdk.DescribeAggregateIdFormatInput) (*request.Request, *ec2sdk.DescribeAggregateIdFormatOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeAggregateIdFormat"), nil
+}
+func (unimplementedEC2) DescribeAggregateIdFormatWithContext(_ context.Context, _ *ec2sdk.DescribeAggregateIdFormatInput, _ ...request.Option) (*ec2sdk.DescribeAggregateIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAggregateIdFormat")
+}
+func (unimplementedEC2) DescribeAvailabilityZones(_ *ec2sdk.DescribeAvailabilityZonesInput) (*ec2sdk.DescribeAvailabilityZonesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAvailabilityZones")
+}
+func (unimplementedEC2) DescribeAvailabilityZonesRequest(_ *ec2sdk.DescribeAvailabilityZonesInput) (*request.Request, *ec2sdk.DescribeAvailabilityZonesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeAvailabilityZones"), nil
+}
+func (unimplementedEC2) DescribeAvailabilityZonesWithContext(_ context.Context, _ *ec2sdk.DescribeAvailabilityZonesInput, _ ...request.Option) (*ec2sdk.DescribeAvailabilityZonesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAvailabilityZones")
+}
+func (unimplementedEC2) DescribeAwsNetworkPerformanceMetricSubscriptions(_ *ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsInput) (*ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAwsNetworkPerformanceMetricSubscriptions")
+}
+func (unimplementedEC2) DescribeAwsNetworkPerformanceMetricSubscriptionsPages(_ *ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsInput, _ func(*ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeAwsNetworkPerformanceMetricSubscriptions")
+}
+func (unimplementedEC2) DescribeAwsNetworkPerformanceMetricSubscriptionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsInput, _ func(*ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeAwsNetworkPerformanceMetricSubscriptions")
+}
+func (unimplementedEC2) DescribeAwsNetworkPerformanceMetricSubscriptionsRequest(_ *ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsInput) (*request.Request, *ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeAwsNetworkPerformanceMetricSubscriptions"), nil
+}
+func (unimplementedEC2) DescribeAwsNetworkPerformanceMetricSubscriptionsWithContext(_ context.Context, _ *ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsInput, _ ...request.Option) (*ec2sdk.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeAwsNetworkPerformanceMetricSubscriptions")
+}
+func (unimplementedEC2) DescribeBundleTasks(_ *ec2sdk.DescribeBundleTasksInput) (*ec2sdk.DescribeBundleTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeBundleTasks")
+}
+func (unimplementedEC2) DescribeBundleTasksRequest(_ *ec2sdk.DescribeBundleTasksInput) (*request.Request, *ec2sdk.DescribeBundleTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeBundleTasks"), nil
+}
+func (unimplementedEC2) DescribeBundleTasksWithContext(_ context.Context, _ *ec2sdk.DescribeBundleTasksInput, _ ...request.Option) (*ec2sdk.DescribeBundleTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeBundleTasks")
+}
+func (unimplementedEC2) DescribeByoipCidrs(_ *ec2sdk.DescribeByoipCidrsInput) (*ec2sdk.DescribeByoipCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeByoipCidrs")
+}
+func (unimplementedEC2) DescribeByoipCidrsPages(_ *ec2sdk.DescribeByoipCidrsInput, _ func(*ec2sdk.DescribeByoipCidrsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeByoipCidrs")
+}
+func (unimplementedEC2) DescribeByoipCidrsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeByoipCidrsInput, _ func(*ec2sdk.DescribeByoipCidrsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeByoipCidrs")
+}
+func (unimplementedEC2) DescribeByoipCidrsRequest(_ *ec2sdk.DescribeByoipCidrsInput) (*request.Request, *ec2sdk.DescribeByoipCidrsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeByoipCidrs"), nil
+}
+func (unimplementedEC2) DescribeByoipCidrsWithContext(_ context.Context, _ *ec2sdk.DescribeByoipCidrsInput, _ ...request.Option) (*ec2sdk.DescribeByoipCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeByoipCidrs")
+}
+func (unimplementedEC2) DescribeCapacityBlockOfferings(_ *ec2sdk.DescribeCapacityBlockOfferingsInput) (*ec2sdk.DescribeCapacityBlockOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCapacityBlockOfferings")
+}
+func (unimplementedEC2) DescribeCapacityBlockOfferingsPages(_ *ec2sdk.DescribeCapacityBlockOfferingsInput, _ func(*ec2sdk.DescribeCapacityBlockOfferingsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCapacityBlockOfferings")
+}
+func (unimplementedEC2) DescribeCapacityBlockOfferingsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeCapacityBlockOfferingsInput, _ func(*ec2sdk.DescribeCapacityBlockOfferingsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCapacityBlockOfferings")
+}
+func (unimplementedEC2) DescribeCapacityBlockOfferingsRequest(_ *ec2sdk.DescribeCapacityBlockOfferingsInput) (*request.Request, *ec2sdk.DescribeCapacityBlockOfferingsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeCapacityBlockOfferings"), nil
+}
+func (unimplementedEC2) DescribeCapacityBlockOfferingsWithContext(_ context.Context, _ *ec2sdk.DescribeCapacityBlockOfferingsInput, _ ...request.Option) (*ec2sdk.DescribeCapacityBlockOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCapacityBlockOfferings")
+}
+func (unimplementedEC2) DescribeCapacityReservationFleets(_ *ec2sdk.DescribeCapacityReservationFleetsInput) (*ec2sdk.DescribeCapacityReservationFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCapacityReservationFleets")
+}
+func (unimplementedEC2) DescribeCapacityReservationFleetsPages(_ *ec2sdk.DescribeCapacityReservationFleetsInput, _ func(*ec2sdk.DescribeCapacityReservationFleetsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCapacityReservationFleets")
+}
+func (unimplementedEC2) DescribeCapacityReservationFleetsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeCapacityReservationFleetsInput, _ func(*ec2sdk.DescribeCapacityReservationFleetsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCapacityReservationFleets")
+}
+func (unimplementedEC2) DescribeCapacityReservationFleetsRequest(_ *ec2sdk.DescribeCapacityReservationFleetsInput) (*request.Request, *ec2sdk.DescribeCapacityReservationFleetsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeCapacityReservationFleets"), nil
+}
+func (unimplementedEC2) DescribeCapacityReservationFleetsWithContext(_ context.Context, _ *ec2sdk.DescribeCapacityReservationFleetsInput, _ ...request.Option) (*ec2sdk.DescribeCapacityReservationFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCapacityReservationFleets")
+}
+func (unimplementedEC2) DescribeCapacityReservations(_ *ec2sdk.DescribeCapacityReservationsInput) (*ec2sdk.DescribeCapacityReservationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCapacityReservations")
+}
+func (unimplementedEC2) DescribeCapacityReservationsPages(_ *ec2sdk.DescribeCapacityReservationsInput, _ func(*ec2sdk.DescribeCapacityReservationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCapacityReservations")
+}
+func (unimplementedEC2) DescribeCapacityReservationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeCapacityReservationsInput, _ func(*ec2sdk.DescribeCapacityReservationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCapacityReservations")
+}
+func (unimplementedEC2) DescribeCapacityReservationsRequest(_ *ec2sdk.DescribeCapacityReservationsInput) (*request.Request, *ec2sdk.DescribeCapacityReservationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeCapacityReservations"), nil
+}
+func (unimplementedEC2) DescribeCapacityReservationsWithContext(_ context.Context, _ *ec2sdk.DescribeCapacityReservationsInput, _ ...request.Option) (*ec2sdk.DescribeCapacityReservationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCapacityReservations")
+}
+func (unimplementedEC2) DescribeCarrierGateways(_ *ec2sdk.DescribeCarrierGatewaysInput) (*ec2sdk.DescribeCarrierGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCarrierGateways")
+}
+func (unimplementedEC2) DescribeCarrierGatewaysPages(_ *ec2sdk.DescribeCarrierGatewaysInput, _ func(*ec2sdk.DescribeCarrierGatewaysOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCarrierGateways")
+}
+func (unimplementedEC2) DescribeCarrierGatewaysPagesWithContext(_ context.Context, _ *ec2sdk.DescribeCarrierGatewaysInput, _ func(*ec2sdk.DescribeCarrierGatewaysOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCarrierGateways")
+}
+func (unimplementedEC2) DescribeCarrierGatewaysRequest(_ *ec2sdk.DescribeCarrierGatewaysInput) (*request.Request, *ec2sdk.DescribeCarrierGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeCarrierGateways"), nil
+}
+func (unimplementedEC2) DescribeCarrierGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeCarrierGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeCarrierGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCarrierGateways")
+}
+func (unimplementedEC2) DescribeClassicLinkInstances(_ *ec2sdk.DescribeClassicLinkInstancesInput) (*ec2sdk.DescribeClassicLinkInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClassicLinkInstances")
+}
+func (unimplementedEC2) DescribeClassicLinkInstancesPages(_ *ec2sdk.DescribeClassicLinkInstancesInput, _ func(*ec2sdk.DescribeClassicLinkInstancesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClassicLinkInstances")
+}
+func (unimplementedEC2) DescribeClassicLinkInstancesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeClassicLinkInstancesInput, _ func(*ec2sdk.DescribeClassicLinkInstancesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClassicLinkInstances")
+}
+func (unimplementedEC2) DescribeClassicLinkInstancesRequest(_ *ec2sdk.DescribeClassicLinkInstancesInput) (*request.Request, *ec2sdk.DescribeClassicLinkInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeClassicLinkInstances"), nil
+}
+func (unimplementedEC2) DescribeClassicLinkInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeClassicLinkInstancesInput, _ ...request.Option) (*ec2sdk.DescribeClassicLinkInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClassicLinkInstances")
+}
+func (unimplementedEC2) DescribeClientVpnAuthorizationRules(_ *ec2sdk.DescribeClientVpnAuthorizationRulesInput) (*ec2sdk.DescribeClientVpnAuthorizationRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnAuthorizationRules")
+}
+func (unimplementedEC2) DescribeClientVpnAuthorizationRulesPages(_ *ec2sdk.DescribeClientVpnAuthorizationRulesInput, _ func(*ec2sdk.DescribeClientVpnAuthorizationRulesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnAuthorizationRules")
+}
+func (unimplementedEC2) DescribeClientVpnAuthorizationRulesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnAuthorizationRulesInput, _ func(*ec2sdk.DescribeClientVpnAuthorizationRulesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnAuthorizationRules")
+}
+func (unimplementedEC2) DescribeClientVpnAuthorizationRulesRequest(_ *ec2sdk.DescribeClientVpnAuthorizationRulesInput) (*request.Request, *ec2sdk.DescribeClientVpnAuthorizationRulesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeClientVpnAuthorizationRules"), nil
+}
+func (unimplementedEC2) DescribeClientVpnAuthorizationRulesWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnAuthorizationRulesInput, _ ...request.Option) (*ec2sdk.DescribeClientVpnAuthorizationRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnAuthorizationRules")
+}
+func (unimplementedEC2) DescribeClientVpnConnections(_ *ec2sdk.DescribeClientVpnConnectionsInput) (*ec2sdk.DescribeClientVpnConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnConnections")
+}
+func (unimplementedEC2) DescribeClientVpnConnectionsPages(_ *ec2sdk.DescribeClientVpnConnectionsInput, _ func(*ec2sdk.DescribeClientVpnConnectionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnConnections")
+}
+func (unimplementedEC2) DescribeClientVpnConnectionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnConnectionsInput, _ func(*ec2sdk.DescribeClientVpnConnectionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnConnections")
+}
+func (unimplementedEC2) DescribeClientVpnConnectionsRequest(_ *ec2sdk.DescribeClientVpnConnectionsInput) (*request.Request, *ec2sdk.DescribeClientVpnConnectionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeClientVpnConnections"), nil
+}
+func (unimplementedEC2) DescribeClientVpnConnectionsWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnConnectionsInput, _ ...request.Option) (*ec2sdk.DescribeClientVpnConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnConnections")
+}
+func (unimplementedEC2) DescribeClientVpnEndpoints(_ *ec2sdk.DescribeClientVpnEndpointsInput) (*ec2sdk.DescribeClientVpnEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnEndpoints")
+}
+func (unimplementedEC2) DescribeClientVpnEndpointsPages(_ *ec2sdk.DescribeClientVpnEndpointsInput, _ func(*ec2sdk.DescribeClientVpnEndpointsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnEndpoints")
+}
+func (unimplementedEC2) DescribeClientVpnEndpointsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnEndpointsInput, _ func(*ec2sdk.DescribeClientVpnEndpointsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnEndpoints")
+}
+func (unimplementedEC2) DescribeClientVpnEndpointsRequest(_ *ec2sdk.DescribeClientVpnEndpointsInput) (*request.Request, *ec2sdk.DescribeClientVpnEndpointsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeClientVpnEndpoints"), nil
+}
+func (unimplementedEC2) DescribeClientVpnEndpointsWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnEndpointsInput, _ ...request.Option) (*ec2sdk.DescribeClientVpnEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnEndpoints")
+}
+func (unimplementedEC2) DescribeClientVpnRoutes(_ *ec2sdk.DescribeClientVpnRoutesInput) (*ec2sdk.DescribeClientVpnRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnRoutes")
+}
+func (unimplementedEC2) DescribeClientVpnRoutesPages(_ *ec2sdk.DescribeClientVpnRoutesInput, _ func(*ec2sdk.DescribeClientVpnRoutesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnRoutes")
+}
+func (unimplementedEC2) DescribeClientVpnRoutesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnRoutesInput, _ func(*ec2sdk.DescribeClientVpnRoutesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnRoutes")
+}
+func (unimplementedEC2) DescribeClientVpnRoutesRequest(_ *ec2sdk.DescribeClientVpnRoutesInput) (*request.Request, *ec2sdk.DescribeClientVpnRoutesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeClientVpnRoutes"), nil
+}
+func (unimplementedEC2) DescribeClientVpnRoutesWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnRoutesInput, _ ...request.Option) (*ec2sdk.DescribeClientVpnRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnRoutes")
+}
+func (unimplementedEC2) DescribeClientVpnTargetNetworks(_ *ec2sdk.DescribeClientVpnTargetNetworksInput) (*ec2sdk.DescribeClientVpnTargetNetworksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnTargetNetworks")
+}
+func (unimplementedEC2) DescribeClientVpnTargetNetworksPages(_ *ec2sdk.DescribeClientVpnTargetNetworksInput, _ func(*ec2sdk.DescribeClientVpnTargetNetworksOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnTargetNetworks")
+}
+func (unimplementedEC2) DescribeClientVpnTargetNetworksPagesWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnTargetNetworksInput, _ func(*ec2sdk.DescribeClientVpnTargetNetworksOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeClientVpnTargetNetworks")
+}
+func (unimplementedEC2) DescribeClientVpnTargetNetworksRequest(_ *ec2sdk.DescribeClientVpnTargetNetworksInput) (*request.Request, *ec2sdk.DescribeClientVpnTargetNetworksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeClientVpnTargetNetworks"), nil
+}
+func (unimplementedEC2) DescribeClientVpnTargetNetworksWithContext(_ context.Context, _ *ec2sdk.DescribeClientVpnTargetNetworksInput, _ ...request.Option) (*ec2sdk.DescribeClientVpnTargetNetworksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeClientVpnTargetNetworks")
+}
+func (unimplementedEC2) DescribeCoipPools(_ *ec2sdk.DescribeCoipPoolsInput) (*ec2sdk.DescribeCoipPoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCoipPools")
+}
+func (unimplementedEC2) DescribeCoipPoolsPages(_ *ec2sdk.DescribeCoipPoolsInput, _ func(*ec2sdk.DescribeCoipPoolsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCoipPools")
+}
+func (unimplementedEC2) DescribeCoipPoolsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeCoipPoolsInput, _ func(*ec2sdk.DescribeCoipPoolsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeCoipPools")
+}
+func (unimplementedEC2) DescribeCoipPoolsRequest(_ *ec2sdk.DescribeCoipPoolsInput) (*request.Request, *ec2sdk.DescribeCoipPoolsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeCoipPools"), nil
+}
+func (unimplementedEC2) DescribeCoipPoolsWithContext(_ context.Context, _ *ec2sdk.DescribeCoipPoolsInput, _ ...request.Option) (*ec2sdk.DescribeCoipPoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCoipPools")
+}
+func (unimplementedEC2) DescribeConversionTasks(_ *ec2sdk.DescribeConversionTasksInput) (*ec2sdk.DescribeConversionTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeConversionTasks")
+}
+func (unimplementedEC2) DescribeConversionTasksRequest(_ *ec2sdk.DescribeConversionTasksInput) (*request.Request, *ec2sdk.DescribeConversionTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeConversionTasks"), nil
+}
+func (unimplementedEC2) DescribeConversionTasksWithContext(_ context.Context, _ *ec2sdk.DescribeConversionTasksInput, _ ...request.Option) (*ec2sdk.DescribeConversionTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeConversionTasks")
+}
+func (unimplementedEC2) DescribeCustomerGateways(_ *ec2sdk.DescribeCustomerGatewaysInput) (*ec2sdk.DescribeCustomerGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCustomerGateways")
+}
+func (unimplementedEC2) DescribeCustomerGatewaysRequest(_ *ec2sdk.DescribeCustomerGatewaysInput) (*request.Request, *ec2sdk.DescribeCustomerGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeCustomerGateways"), nil
+}
+func (unimplementedEC2) DescribeCustomerGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeCustomerGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeCustomerGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeCustomerGateways")
+}
+func (unimplementedEC2) DescribeDhcpOptions(_ *ec2sdk.DescribeDhcpOptionsInput) (*ec2sdk.DescribeDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeDhcpOptions")
+}
+func (unimplementedEC2) DescribeDhcpOptionsPages(_ *ec2sdk.DescribeDhcpOptionsInput, _ func(*ec2sdk.DescribeDhcpOptionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeDhcpOptions")
+}
+func (unimplementedEC2) DescribeDhcpOptionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeDhcpOptionsInput, _ func(*ec2sdk.DescribeDhcpOptionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeDhcpOptions")
+}
+func (unimplementedEC2) DescribeDhcpOptionsRequest(_ *ec2sdk.DescribeDhcpOptionsInput) (*request.Request, *ec2sdk.DescribeDhcpOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeDhcpOptions"), nil
+}
+func (unimplementedEC2) DescribeDhcpOptionsWithContext(_ context.Context, _ *ec2sdk.DescribeDhcpOptionsInput, _ ...request.Option) (*ec2sdk.DescribeDhcpOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeDhcpOptions")
+}
+func (unimplementedEC2) DescribeEgressOnlyInternetGateways(_ *ec2sdk.DescribeEgressOnlyInternetGatewaysInput) (*ec2sdk.DescribeEgressOnlyInternetGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeEgressOnlyInternetGateways")
+}
+func (unimplementedEC2) DescribeEgressOnlyInternetGatewaysPages(_ *ec2sdk.DescribeEgressOnlyInternetGatewaysInput, _ func(*ec2sdk.DescribeEgressOnlyInternetGatewaysOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeEgressOnlyInternetGateways")
+}
+func (unimplementedEC2) DescribeEgressOnlyInternetGatewaysPagesWithContext(_ context.Context, _ *ec2sdk.DescribeEgressOnlyInternetGatewaysInput, _ func(*ec2sdk.DescribeEgressOnlyInternetGatewaysOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeEgressOnlyInternetGateways")
+}
+func (unimplementedEC2) DescribeEgressOnlyInternetGatewaysRequest(_ *ec2sdk.DescribeEgressOnlyInternetGatewaysInput) (*request.Request, *ec2sdk.DescribeEgressOnlyInternetGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeEgressOnlyInternetGateways"), nil
+}
+func (unimplementedEC2) DescribeEgressOnlyInternetGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeEgressOnlyInternetGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeEgressOnlyInternetGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeEgressOnlyInternetGateways")
+}
+func (unimplementedEC2) DescribeElasticGpus(_ *ec2sdk.DescribeElasticGpusInput) (*ec2sdk.DescribeElasticGpusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeElasticGpus")
+}
+func (unimplementedEC2) DescribeElasticGpusRequest(_ *ec2sdk.DescribeElasticGpusInput) (*request.Request, *ec2sdk.DescribeElasticGpusOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeElasticGpus"), nil
+}
+func (unimplementedEC2) DescribeElasticGpusWithContext(_ context.Context, _ *ec2sdk.DescribeElasticGpusInput, _ ...request.Option) (*ec2sdk.DescribeElasticGpusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeElasticGpus")
+}
+func (unimplementedEC2) DescribeExportImageTasks(_ *ec2sdk.DescribeExportImageTasksInput) (*ec2sdk.DescribeExportImageTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeExportImageTasks")
+}
+func (unimplementedEC2) DescribeExportImageTasksPages(_ *ec2sdk.DescribeExportImageTasksInput, _ func(*ec2sdk.DescribeExportImageTasksOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeExportImageTasks")
+}
+func (unimplementedEC2) DescribeExportImageTasksPagesWithContext(_ context.Context, _ *ec2sdk.DescribeExportImageTasksInput, _ func(*ec2sdk.DescribeExportImageTasksOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeExportImageTasks")
+}
+func (unimplementedEC2) DescribeExportImageTasksRequest(_ *ec2sdk.DescribeExportImageTasksInput) (*request.Request, *ec2sdk.DescribeExportImageTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeExportImageTasks"), nil
+}
+func (unimplementedEC2) DescribeExportImageTasksWithContext(_ context.Context, _ *ec2sdk.DescribeExportImageTasksInput, _ ...request.Option) (*ec2sdk.DescribeExportImageTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeExportImageTasks")
+}
+func (unimplementedEC2) DescribeExportTasks(_ *ec2sdk.DescribeExportTasksInput) (*ec2sdk.DescribeExportTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeExportTasks")
+}
+func (unimplementedEC2) DescribeExportTasksRequest(_ *ec2sdk.DescribeExportTasksInput) (*request.Request, *ec2sdk.DescribeExportTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeExportTasks"), nil
+}
+func (unimplementedEC2) DescribeExportTasksWithContext(_ context.Context, _ *ec2sdk.DescribeExportTasksInput, _ ...request.Option) (*ec2sdk.DescribeExportTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeExportTasks")
+}
+func (unimplementedEC2) DescribeFastLaunchImages(_ *ec2sdk.DescribeFastLaunchImagesInput) (*ec2sdk.DescribeFastLaunchImagesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFastLaunchImages")
+}
+func (unimplementedEC2) DescribeFastLaunchImagesPages(_ *ec2sdk.DescribeFastLaunchImagesInput, _ func(*ec2sdk.DescribeFastLaunchImagesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFastLaunchImages")
+}
+func (unimplementedEC2) DescribeFastLaunchImagesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeFastLaunchImagesInput, _ func(*ec2sdk.DescribeFastLaunchImagesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFastLaunchImages")
+}
+func (unimplementedEC2) DescribeFastLaunchImagesRequest(_ *ec2sdk.DescribeFastLaunchImagesInput) (*request.Request, *ec2sdk.DescribeFastLaunchImagesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFastLaunchImages"), nil
+}
+func (unimplementedEC2) DescribeFastLaunchImagesWithContext(_ context.Context, _ *ec2sdk.DescribeFastLaunchImagesInput, _ ...request.Option) (*ec2sdk.DescribeFastLaunchImagesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFastLaunchImages")
+}
+func (unimplementedEC2) DescribeFastSnapshotRestores(_ *ec2sdk.DescribeFastSnapshotRestoresInput) (*ec2sdk.DescribeFastSnapshotRestoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFastSnapshotRestores")
+}
+func (unimplementedEC2) DescribeFastSnapshotRestoresPages(_ *ec2sdk.DescribeFastSnapshotRestoresInput, _ func(*ec2sdk.DescribeFastSnapshotRestoresOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFastSnapshotRestores")
+}
+func (unimplementedEC2) DescribeFastSnapshotRestoresPagesWithContext(_ context.Context, _ *ec2sdk.DescribeFastSnapshotRestoresInput, _ func(*ec2sdk.DescribeFastSnapshotRestoresOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFastSnapshotRestores")
+}
+func (unimplementedEC2) DescribeFastSnapshotRestoresRequest(_ *ec2sdk.DescribeFastSnapshotRestoresInput) (*request.Request, *ec2sdk.DescribeFastSnapshotRestoresOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFastSnapshotRestores"), nil
+}
+func (unimplementedEC2) DescribeFastSnapshotRestoresWithContext(_ context.Context, _ *ec2sdk.DescribeFastSnapshotRestoresInput, _ ...request.Option) (*ec2sdk.DescribeFastSnapshotRestoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFastSnapshotRestores")
+}
+func (unimplementedEC2) DescribeFleetHistory(_ *ec2sdk.DescribeFleetHistoryInput) (*ec2sdk.DescribeFleetHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFleetHistory")
+}
+func (unimplementedEC2) DescribeFleetHistoryRequest(_ *ec2sdk.DescribeFleetHistoryInput) (*request.Request, *ec2sdk.DescribeFleetHistoryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFleetHistory"), nil
+}
+func (unimplementedEC2) DescribeFleetHistoryWithContext(_ context.Context, _ *ec2sdk.DescribeFleetHistoryInput, _ ...request.Option) (*ec2sdk.DescribeFleetHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFleetHistory")
+}
+func (unimplementedEC2) DescribeFleetInstances(_ *ec2sdk.DescribeFleetInstancesInput) (*ec2sdk.DescribeFleetInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFleetInstances")
+}
+func (unimplementedEC2) DescribeFleetInstancesRequest(_ *ec2sdk.DescribeFleetInstancesInput) (*request.Request, *ec2sdk.DescribeFleetInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFleetInstances"), nil
+}
+func (unimplementedEC2) DescribeFleetInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeFleetInstancesInput, _ ...request.Option) (*ec2sdk.DescribeFleetInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFleetInstances")
+}
+func (unimplementedEC2) DescribeFleets(_ *ec2sdk.DescribeFleetsInput) (*ec2sdk.DescribeFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFleets")
+}
+func (unimplementedEC2) DescribeFleetsPages(_ *ec2sdk.DescribeFleetsInput, _ func(*ec2sdk.DescribeFleetsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFleets")
+}
+func (unimplementedEC2) DescribeFleetsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeFleetsInput, _ func(*ec2sdk.DescribeFleetsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFleets")
+}
+func (unimplementedEC2) DescribeFleetsRequest(_ *ec2sdk.DescribeFleetsInput) (*request.Request, *ec2sdk.DescribeFleetsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFleets"), nil
+}
+func (unimplementedEC2) DescribeFleetsWithContext(_ context.Context, _ *ec2sdk.DescribeFleetsInput, _ ...request.Option) (*ec2sdk.DescribeFleetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFleets")
+}
+func (unimplementedEC2) DescribeFlowLogs(_ *ec2sdk.DescribeFlowLogsInput) (*ec2sdk.DescribeFlowLogsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFlowLogs")
+}
+func (unimplementedEC2) DescribeFlowLogsPages(_ *ec2sdk.DescribeFlowLogsInput, _ func(*ec2sdk.DescribeFlowLogsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFlowLogs")
+}
+func (unimplementedEC2) DescribeFlowLogsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeFlowLogsInput, _ func(*ec2sdk.DescribeFlowLogsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFlowLogs")
+}
+func (unimplementedEC2) DescribeFlowLogsRequest(_ *ec2sdk.DescribeFlowLogsInput) (*request.Request, *ec2sdk.DescribeFlowLogsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFlowLogs"), nil
+}
+func (unimplementedEC2) DescribeFlowLogsWithContext(_ context.Context, _ *ec2sdk.DescribeFlowLogsInput, _ ...request.Option) (*ec2sdk.DescribeFlowLogsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFlowLogs")
+}
+func (unimplementedEC2) DescribeFpgaImageAttribute(_ *ec2sdk.DescribeFpgaImageAttributeInput) (*ec2sdk.DescribeFpgaImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFpgaImageAttribute")
+}
+func (unimplementedEC2) DescribeFpgaImageAttributeRequest(_ *ec2sdk.DescribeFpgaImageAttributeInput) (*request.Request, *ec2sdk.DescribeFpgaImageAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFpgaImageAttribute"), nil
+}
+func (unimplementedEC2) DescribeFpgaImageAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeFpgaImageAttributeInput, _ ...request.Option) (*ec2sdk.DescribeFpgaImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFpgaImageAttribute")
+}
+func (unimplementedEC2) DescribeFpgaImages(_ *ec2sdk.DescribeFpgaImagesInput) (*ec2sdk.DescribeFpgaImagesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFpgaImages")
+}
+func (unimplementedEC2) DescribeFpgaImagesPages(_ *ec2sdk.DescribeFpgaImagesInput, _ func(*ec2sdk.DescribeFpgaImagesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFpgaImages")
+}
+func (unimplementedEC2) DescribeFpgaImagesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeFpgaImagesInput, _ func(*ec2sdk.DescribeFpgaImagesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeFpgaImages")
+}
+func (unimplementedEC2) DescribeFpgaImagesRequest(_ *ec2sdk.DescribeFpgaImagesInput) (*request.Request, *ec2sdk.DescribeFpgaImagesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeFpgaImages"), nil
+}
+func (unimplementedEC2) DescribeFpgaImagesWithContext(_ context.Context, _ *ec2sdk.DescribeFpgaImagesInput, _ ...request.Option) (*ec2sdk.DescribeFpgaImagesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeFpgaImages")
+}
+func (unimplementedEC2) DescribeHostReservationOfferings(_ *ec2sdk.DescribeHostReservationOfferingsInput) (*ec2sdk.DescribeHostReservationOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeHostReservationOfferings")
+}
+func (unimplementedEC2) DescribeHostReservationOfferingsPages(_ *ec2sdk.DescribeHostReservationOfferingsInput, _ func(*ec2sdk.DescribeHostReservationOfferingsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeHostReservationOfferings")
+}
+func (unimplementedEC2) DescribeHostReservationOfferingsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeHostReservationOfferingsInput, _ func(*ec2sdk.DescribeHostReservationOfferingsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeHostReservationOfferings")
+}
+func (unimplementedEC2) DescribeHostReservationOfferingsRequest(_ *ec2sdk.DescribeHostReservationOfferingsInput) (*request.Request, *ec2sdk.DescribeHostReservationOfferingsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeHostReservationOfferings"), nil
+}
+func (unimplementedEC2) DescribeHostReservationOfferingsWithContext(_ context.Context, _ *ec2sdk.DescribeHostReservationOfferingsInput, _ ...request.Option) (*ec2sdk.DescribeHostReservationOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeHostReservationOfferings")
+}
+func (unimplementedEC2) DescribeHostReservations(_ *ec2sdk.DescribeHostReservationsInput) (*ec2sdk.DescribeHostReservationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeHostReservations")
+}
+func (unimplementedEC2) DescribeHostReservationsPages(_ *ec2sdk.DescribeHostReservationsInput, _ func(*ec2sdk.DescribeHostReservationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeHostReservations")
+}
+func (unimplementedEC2) DescribeHostReservationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeHostReservationsInput, _ func(*ec2sdk.DescribeHostReservationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeHostReservations")
+}
+func (unimplementedEC2) DescribeHostReservationsRequest(_ *ec2sdk.DescribeHostReservationsInput) (*request.Request, *ec2sdk.DescribeHostReservationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeHostReservations"), nil
+}
+func (unimplementedEC2) DescribeHostReservationsWithContext(_ context.Context, _ *ec2sdk.DescribeHostReservationsInput, _ ...request.Option) (*ec2sdk.DescribeHostReservationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeHostReservations")
+}
+func (unimplementedEC2) DescribeHosts(_ *ec2sdk.DescribeHostsInput) (*ec2sdk.DescribeHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeHosts")
+}
+func (unimplementedEC2) DescribeHostsPages(_ *ec2sdk.DescribeHostsInput, _ func(*ec2sdk.DescribeHostsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeHosts")
+}
+func (unimplementedEC2) DescribeHostsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeHostsInput, _ func(*ec2sdk.DescribeHostsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeHosts")
+}
+func (unimplementedEC2) DescribeHostsRequest(_ *ec2sdk.DescribeHostsInput) (*request.Request, *ec2sdk.DescribeHostsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeHosts"), nil
+}
+func (unimplementedEC2) DescribeHostsWithContext(_ context.Context, _ *ec2sdk.DescribeHostsInput, _ ...request.Option) (*ec2sdk.DescribeHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeHosts")
+}
+func (unimplementedEC2) DescribeIamInstanceProfileAssociations(_ *ec2sdk.DescribeIamInstanceProfileAssociationsInput) (*ec2sdk.DescribeIamInstanceProfileAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIamInstanceProfileAssociations")
+}
+func (unimplementedEC2) DescribeIamInstanceProfileAssociationsPages(_ *ec2sdk.DescribeIamInstanceProfileAssociationsInput, _ func(*ec2sdk.DescribeIamInstanceProfileAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIamInstanceProfileAssociations")
+}
+func (unimplementedEC2) DescribeIamInstanceProfileAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeIamInstanceProfileAssociationsInput, _ func(*ec2sdk.DescribeIamInstanceProfileAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIamInstanceProfileAssociations")
+}
+func (unimplementedEC2) DescribeIamInstanceProfileAssociationsRequest(_ *ec2sdk.DescribeIamInstanceProfileAssociationsInput) (*request.Request, *ec2sdk.DescribeIamInstanceProfileAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIamInstanceProfileAssociations"), nil
+}
+func (unimplementedEC2) DescribeIamInstanceProfileAssociationsWithContext(_ context.Context, _ *ec2sdk.DescribeIamInstanceProfileAssociationsInput, _ ...request.Option) (*ec2sdk.DescribeIamInstanceProfileAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIamInstanceProfileAssociations")
+}
+func (unimplementedEC2) DescribeIdFormat(_ *ec2sdk.DescribeIdFormatInput) (*ec2sdk.DescribeIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIdFormat")
+}
+func (unimplementedEC2) DescribeIdFormatRequest(_ *ec2sdk.DescribeIdFormatInput) (*request.Request, *ec2sdk.DescribeIdFormatOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIdFormat"), nil
+}
+func (unimplementedEC2) DescribeIdFormatWithContext(_ context.Context, _ *ec2sdk.DescribeIdFormatInput, _ ...request.Option) (*ec2sdk.DescribeIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIdFormat")
+}
+func (unimplementedEC2) DescribeIdentityIdFormat(_ *ec2sdk.DescribeIdentityIdFormatInput) (*ec2sdk.DescribeIdentityIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIdentityIdFormat")
+}
+func (unimplementedEC2) DescribeIdentityIdFormatRequest(_ *ec2sdk.DescribeIdentityIdFormatInput) (*request.Request, *ec2sdk.DescribeIdentityIdFormatOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIdentityIdFormat"), nil
+}
+func (unimplementedEC2) DescribeIdentityIdFormatWithContext(_ context.Context, _ *ec2sdk.DescribeIdentityIdFormatInput, _ ...request.Option) (*ec2sdk.DescribeIdentityIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIdentityIdFormat")
+}
+func (unimplementedEC2) DescribeImageAttribute(_ *ec2sdk.DescribeImageAttributeInput) (*ec2sdk.DescribeImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImageAttribute")
+}
+func (unimplementedEC2) DescribeImageAttributeRequest(_ *ec2sdk.DescribeImageAttributeInput) (*request.Request, *ec2sdk.DescribeImageAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeImageAttribute"), nil
+}
+func (unimplementedEC2) DescribeImageAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeImageAttributeInput, _ ...request.Option) (*ec2sdk.DescribeImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImageAttribute")
+}
+func (unimplementedEC2) DescribeImages(_ *ec2sdk.DescribeImagesInput) (*ec2sdk.DescribeImagesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImages")
+}
+func (unimplementedEC2) DescribeImagesPages(_ *ec2sdk.DescribeImagesInput, _ func(*ec2sdk.DescribeImagesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeImages")
+}
+func (unimplementedEC2) DescribeImagesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeImagesInput, _ func(*ec2sdk.DescribeImagesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeImages")
+}
+func (unimplementedEC2) DescribeImagesRequest(_ *ec2sdk.DescribeImagesInput) (*request.Request, *ec2sdk.DescribeImagesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeImages"), nil
+}
+func (unimplementedEC2) DescribeImagesWithContext(_ context.Context, _ *ec2sdk.DescribeImagesInput, _ ...request.Option) (*ec2sdk.DescribeImagesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImages")
+}
+func (unimplementedEC2) DescribeImportImageTasks(_ *ec2sdk.DescribeImportImageTasksInput) (*ec2sdk.DescribeImportImageTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImportImageTasks")
+}
+func (unimplementedEC2) DescribeImportImageTasksPages(_ *ec2sdk.DescribeImportImageTasksInput, _ func(*ec2sdk.DescribeImportImageTasksOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeImportImageTasks")
+}
+func (unimplementedEC2) DescribeImportImageTasksPagesWithContext(_ context.Context, _ *ec2sdk.DescribeImportImageTasksInput, _ func(*ec2sdk.DescribeImportImageTasksOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeImportImageTasks")
+}
+func (unimplementedEC2) DescribeImportImageTasksRequest(_ *ec2sdk.DescribeImportImageTasksInput) (*request.Request, *ec2sdk.DescribeImportImageTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeImportImageTasks"), nil
+}
+func (unimplementedEC2) DescribeImportImageTasksWithContext(_ context.Context, _ *ec2sdk.DescribeImportImageTasksInput, _ ...request.Option) (*ec2sdk.DescribeImportImageTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImportImageTasks")
+}
+func (unimplementedEC2) DescribeImportSnapshotTasks(_ *ec2sdk.DescribeImportSnapshotTasksInput) (*ec2sdk.DescribeImportSnapshotTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImportSnapshotTasks")
+}
+func (unimplementedEC2) DescribeImportSnapshotTasksPages(_ *ec2sdk.DescribeImportSnapshotTasksInput, _ func(*ec2sdk.DescribeImportSnapshotTasksOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeImportSnapshotTasks")
+}
+func (unimplementedEC2) DescribeImportSnapshotTasksPagesWithContext(_ context.Context, _ *ec2sdk.DescribeImportSnapshotTasksInput, _ func(*ec2sdk.DescribeImportSnapshotTasksOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeImportSnapshotTasks")
+}
+func (unimplementedEC2) DescribeImportSnapshotTasksRequest(_ *ec2sdk.DescribeImportSnapshotTasksInput) (*request.Request, *ec2sdk.DescribeImportSnapshotTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeImportSnapshotTasks"), nil
+}
+func (unimplementedEC2) DescribeImportSnapshotTasksWithContext(_ context.Context, _ *ec2sdk.DescribeImportSnapshotTasksInput, _ ...request.Option) (*ec2sdk.DescribeImportSnapshotTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeImportSnapshotTasks")
+}
+func (unimplementedEC2) DescribeInstanceAttribute(_ *ec2sdk.DescribeInstanceAttributeInput) (*ec2sdk.DescribeInstanceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceAttribute")
+}
+func (unimplementedEC2) DescribeInstanceAttributeRequest(_ *ec2sdk.DescribeInstanceAttributeInput) (*request.Request, *ec2sdk.DescribeInstanceAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceAttribute"), nil
+}
+func (unimplementedEC2) DescribeInstanceAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceAttributeInput, _ ...request.Option) (*ec2sdk.DescribeInstanceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceAttribute")
+}
+func (unimplementedEC2) DescribeInstanceConnectEndpoints(_ *ec2sdk.DescribeInstanceConnectEndpointsInput) (*ec2sdk.DescribeInstanceConnectEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceConnectEndpoints")
+}
+func (unimplementedEC2) DescribeInstanceConnectEndpointsPages(_ *ec2sdk.DescribeInstanceConnectEndpointsInput, _ func(*ec2sdk.DescribeInstanceConnectEndpointsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceConnectEndpoints")
+}
+func (unimplementedEC2) DescribeInstanceConnectEndpointsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceConnectEndpointsInput, _ func(*ec2sdk.DescribeInstanceConnectEndpointsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceConnectEndpoints")
+}
+func (unimplementedEC2) DescribeInstanceConnectEndpointsRequest(_ *ec2sdk.DescribeInstanceConnectEndpointsInput) (*request.Request, *ec2sdk.DescribeInstanceConnectEndpointsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceConnectEndpoints"), nil
+}
+func (unimplementedEC2) DescribeInstanceConnectEndpointsWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceConnectEndpointsInput, _ ...request.Option) (*ec2sdk.DescribeInstanceConnectEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceConnectEndpoints")
+}
+func (unimplementedEC2) DescribeInstanceCreditSpecifications(_ *ec2sdk.DescribeInstanceCreditSpecificationsInput) (*ec2sdk.DescribeInstanceCreditSpecificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceCreditSpecifications")
+}
+func (unimplementedEC2) DescribeInstanceCreditSpecificationsPages(_ *ec2sdk.DescribeInstanceCreditSpecificationsInput, _ func(*ec2sdk.DescribeInstanceCreditSpecificationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceCreditSpecifications")
+}
+func (unimplementedEC2) DescribeInstanceCreditSpecificationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceCreditSpecificationsInput, _ func(*ec2sdk.DescribeInstanceCreditSpecificationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceCreditSpecifications")
+}
+func (unimplementedEC2) DescribeInstanceCreditSpecificationsRequest(_ *ec2sdk.DescribeInstanceCreditSpecificationsInput) (*request.Request, *ec2sdk.DescribeInstanceCreditSpecificationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceCreditSpecifications"), nil
+}
+func (unimplementedEC2) DescribeInstanceCreditSpecificationsWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceCreditSpecificationsInput, _ ...request.Option) (*ec2sdk.DescribeInstanceCreditSpecificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceCreditSpecifications")
+}
+func (unimplementedEC2) DescribeInstanceEventNotificationAttributes(_ *ec2sdk.DescribeInstanceEventNotificationAttributesInput) (*ec2sdk.DescribeInstanceEventNotificationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceEventNotificationAttributes")
+}
+func (unimplementedEC2) DescribeInstanceEventNotificationAttributesRequest(_ *ec2sdk.DescribeInstanceEventNotificationAttributesInput) (*request.Request, *ec2sdk.DescribeInstanceEventNotificationAttributesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceEventNotificationAttributes"), nil
+}
+func (unimplementedEC2) DescribeInstanceEventNotificationAttributesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceEventNotificationAttributesInput, _ ...request.Option) (*ec2sdk.DescribeInstanceEventNotificationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceEventNotificationAttributes")
+}
+func (unimplementedEC2) DescribeInstanceEventWindows(_ *ec2sdk.DescribeInstanceEventWindowsInput) (*ec2sdk.DescribeInstanceEventWindowsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceEventWindows")
+}
+func (unimplementedEC2) DescribeInstanceEventWindowsPages(_ *ec2sdk.DescribeInstanceEventWindowsInput, _ func(*ec2sdk.DescribeInstanceEventWindowsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceEventWindows")
+}
+func (unimplementedEC2) DescribeInstanceEventWindowsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceEventWindowsInput, _ func(*ec2sdk.DescribeInstanceEventWindowsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceEventWindows")
+}
+func (unimplementedEC2) DescribeInstanceEventWindowsRequest(_ *ec2sdk.DescribeInstanceEventWindowsInput) (*request.Request, *ec2sdk.DescribeInstanceEventWindowsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceEventWindows"), nil
+}
+func (unimplementedEC2) DescribeInstanceEventWindowsWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceEventWindowsInput, _ ...request.Option) (*ec2sdk.DescribeInstanceEventWindowsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceEventWindows")
+}
+func (unimplementedEC2) DescribeInstanceStatus(_ *ec2sdk.DescribeInstanceStatusInput) (*ec2sdk.DescribeInstanceStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceStatus")
+}
+func (unimplementedEC2) DescribeInstanceStatusPages(_ *ec2sdk.DescribeInstanceStatusInput, _ func(*ec2sdk.DescribeInstanceStatusOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceStatus")
+}
+func (unimplementedEC2) DescribeInstanceStatusPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceStatusInput, _ func(*ec2sdk.DescribeInstanceStatusOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceStatus")
+}
+func (unimplementedEC2) DescribeInstanceStatusRequest(_ *ec2sdk.DescribeInstanceStatusInput) (*request.Request, *ec2sdk.DescribeInstanceStatusOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceStatus"), nil
+}
+func (unimplementedEC2) DescribeInstanceStatusWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceStatusInput, _ ...request.Option) (*ec2sdk.DescribeInstanceStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceStatus")
+}
+func (unimplementedEC2) DescribeInstanceTopology(_ *ec2sdk.DescribeInstanceTopologyInput) (*ec2sdk.DescribeInstanceTopologyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceTopology")
+}
+func (unimplementedEC2) DescribeInstanceTopologyPages(_ *ec2sdk.DescribeInstanceTopologyInput, _ func(*ec2sdk.DescribeInstanceTopologyOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceTopology")
+}
+func (unimplementedEC2) DescribeInstanceTopologyPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceTopologyInput, _ func(*ec2sdk.DescribeInstanceTopologyOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceTopology")
+}
+func (unimplementedEC2) DescribeInstanceTopologyRequest(_ *ec2sdk.DescribeInstanceTopologyInput) (*request.Request, *ec2sdk.DescribeInstanceTopologyOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceTopology"), nil
+}
+func (unimplementedEC2) DescribeInstanceTopologyWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceTopologyInput, _ ...request.Option) (*ec2sdk.DescribeInstanceTopologyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceTopology")
+}
+func (unimplementedEC2) DescribeInstanceTypeOfferings(_ *ec2sdk.DescribeInstanceTypeOfferingsInput) (*ec2sdk.DescribeInstanceTypeOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceTypeOfferings")
+}
+func (unimplementedEC2) DescribeInstanceTypeOfferingsPages(_ *ec2sdk.DescribeInstanceTypeOfferingsInput, _ func(*ec2sdk.DescribeInstanceTypeOfferingsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceTypeOfferings")
+}
+func (unimplementedEC2) DescribeInstanceTypeOfferingsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceTypeOfferingsInput, _ func(*ec2sdk.DescribeInstanceTypeOfferingsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceTypeOfferings")
+}
+func (unimplementedEC2) DescribeInstanceTypeOfferingsRequest(_ *ec2sdk.DescribeInstanceTypeOfferingsInput) (*request.Request, *ec2sdk.DescribeInstanceTypeOfferingsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceTypeOfferings"), nil
+}
+func (unimplementedEC2) DescribeInstanceTypeOfferingsWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceTypeOfferingsInput, _ ...request.Option) (*ec2sdk.DescribeInstanceTypeOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceTypeOfferings")
+}
+func (unimplementedEC2) DescribeInstanceTypes(_ *ec2sdk.DescribeInstanceTypesInput) (*ec2sdk.DescribeInstanceTypesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceTypes")
+}
+func (unimplementedEC2) DescribeInstanceTypesPages(_ *ec2sdk.DescribeInstanceTypesInput, _ func(*ec2sdk.DescribeInstanceTypesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceTypes")
+}
+func (unimplementedEC2) DescribeInstanceTypesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceTypesInput, _ func(*ec2sdk.DescribeInstanceTypesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstanceTypes")
+}
+func (unimplementedEC2) DescribeInstanceTypesRequest(_ *ec2sdk.DescribeInstanceTypesInput) (*request.Request, *ec2sdk.DescribeInstanceTypesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstanceTypes"), nil
+}
+func (unimplementedEC2) DescribeInstanceTypesWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceTypesInput, _ ...request.Option) (*ec2sdk.DescribeInstanceTypesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstanceTypes")
+}
+func (unimplementedEC2) DescribeInstances(_ *ec2sdk.DescribeInstancesInput) (*ec2sdk.DescribeInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstances")
+}
+func (unimplementedEC2) DescribeInstancesPages(_ *ec2sdk.DescribeInstancesInput, _ func(*ec2sdk.DescribeInstancesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstances")
+}
+func (unimplementedEC2) DescribeInstancesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInstancesInput, _ func(*ec2sdk.DescribeInstancesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInstances")
+}
+func (unimplementedEC2) DescribeInstancesRequest(_ *ec2sdk.DescribeInstancesInput) (*request.Request, *ec2sdk.DescribeInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInstances"), nil
+}
+func (unimplementedEC2) DescribeInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeInstancesInput, _ ...request.Option) (*ec2sdk.DescribeInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInstances")
+}
+func (unimplementedEC2) DescribeInternetGateways(_ *ec2sdk.DescribeInternetGatewaysInput) (*ec2sdk.DescribeInternetGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInternetGateways")
+}
+func (unimplementedEC2) DescribeInternetGatewaysPages(_ *ec2sdk.DescribeInternetGatewaysInput, _ func(*ec2sdk.DescribeInternetGatewaysOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInternetGateways")
+}
+func (unimplementedEC2) DescribeInternetGatewaysPagesWithContext(_ context.Context, _ *ec2sdk.DescribeInternetGatewaysInput, _ func(*ec2sdk.DescribeInternetGatewaysOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeInternetGateways")
+}
+func (unimplementedEC2) DescribeInternetGatewaysRequest(_ *ec2sdk.DescribeInternetGatewaysInput) (*request.Request, *ec2sdk.DescribeInternetGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeInternetGateways"), nil
+}
+func (unimplementedEC2) DescribeInternetGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeInternetGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeInternetGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeInternetGateways")
+}
+func (unimplementedEC2) DescribeIpamByoasn(_ *ec2sdk.DescribeIpamByoasnInput) (*ec2sdk.DescribeIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamByoasn")
+}
+func (unimplementedEC2) DescribeIpamByoasnRequest(_ *ec2sdk.DescribeIpamByoasnInput) (*request.Request, *ec2sdk.DescribeIpamByoasnOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIpamByoasn"), nil
+}
+func (unimplementedEC2) DescribeIpamByoasnWithContext(_ context.Context, _ *ec2sdk.DescribeIpamByoasnInput, _ ...request.Option) (*ec2sdk.DescribeIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamByoasn")
+}
+func (unimplementedEC2) DescribeIpamPools(_ *ec2sdk.DescribeIpamPoolsInput) (*ec2sdk.DescribeIpamPoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamPools")
+}
+func (unimplementedEC2) DescribeIpamPoolsPages(_ *ec2sdk.DescribeIpamPoolsInput, _ func(*ec2sdk.DescribeIpamPoolsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamPools")
+}
+func (unimplementedEC2) DescribeIpamPoolsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeIpamPoolsInput, _ func(*ec2sdk.DescribeIpamPoolsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamPools")
+}
+func (unimplementedEC2) DescribeIpamPoolsRequest(_ *ec2sdk.DescribeIpamPoolsInput) (*request.Request, *ec2sdk.DescribeIpamPoolsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIpamPools"), nil
+}
+func (unimplementedEC2) DescribeIpamPoolsWithContext(_ context.Context, _ *ec2sdk.DescribeIpamPoolsInput, _ ...request.Option) (*ec2sdk.DescribeIpamPoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamPools")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveries(_ *ec2sdk.DescribeIpamResourceDiscoveriesInput) (*ec2sdk.DescribeIpamResourceDiscoveriesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveries")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveriesPages(_ *ec2sdk.DescribeIpamResourceDiscoveriesInput, _ func(*ec2sdk.DescribeIpamResourceDiscoveriesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveries")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveriesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeIpamResourceDiscoveriesInput, _ func(*ec2sdk.DescribeIpamResourceDiscoveriesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveries")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveriesRequest(_ *ec2sdk.DescribeIpamResourceDiscoveriesInput) (*request.Request, *ec2sdk.DescribeIpamResourceDiscoveriesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIpamResourceDiscoveries"), nil
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveriesWithContext(_ context.Context, _ *ec2sdk.DescribeIpamResourceDiscoveriesInput, _ ...request.Option) (*ec2sdk.DescribeIpamResourceDiscoveriesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveries")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveryAssociations(_ *ec2sdk.DescribeIpamResourceDiscoveryAssociationsInput) (*ec2sdk.DescribeIpamResourceDiscoveryAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveryAssociations")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveryAssociationsPages(_ *ec2sdk.DescribeIpamResourceDiscoveryAssociationsInput, _ func(*ec2sdk.DescribeIpamResourceDiscoveryAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveryAssociations")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveryAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeIpamResourceDiscoveryAssociationsInput, _ func(*ec2sdk.DescribeIpamResourceDiscoveryAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveryAssociations")
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveryAssociationsRequest(_ *ec2sdk.DescribeIpamResourceDiscoveryAssociationsInput) (*request.Request, *ec2sdk.DescribeIpamResourceDiscoveryAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIpamResourceDiscoveryAssociations"), nil
+}
+func (unimplementedEC2) DescribeIpamResourceDiscoveryAssociationsWithContext(_ context.Context, _ *ec2sdk.DescribeIpamResourceDiscoveryAssociationsInput, _ ...request.Option) (*ec2sdk.DescribeIpamResourceDiscoveryAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamResourceDiscoveryAssociations")
+}
+func (unimplementedEC2) DescribeIpamScopes(_ *ec2sdk.DescribeIpamScopesInput) (*ec2sdk.DescribeIpamScopesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamScopes")
+}
+func (unimplementedEC2) DescribeIpamScopesPages(_ *ec2sdk.DescribeIpamScopesInput, _ func(*ec2sdk.DescribeIpamScopesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamScopes")
+}
+func (unimplementedEC2) DescribeIpamScopesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeIpamScopesInput, _ func(*ec2sdk.DescribeIpamScopesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpamScopes")
+}
+func (unimplementedEC2) DescribeIpamScopesRequest(_ *ec2sdk.DescribeIpamScopesInput) (*request.Request, *ec2sdk.DescribeIpamScopesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIpamScopes"), nil
+}
+func (unimplementedEC2) DescribeIpamScopesWithContext(_ context.Context, _ *ec2sdk.DescribeIpamScopesInput, _ ...request.Option) (*ec2sdk.DescribeIpamScopesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpamScopes")
+}
+func (unimplementedEC2) DescribeIpams(_ *ec2sdk.DescribeIpamsInput) (*ec2sdk.DescribeIpamsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpams")
+}
+func (unimplementedEC2) DescribeIpamsPages(_ *ec2sdk.DescribeIpamsInput, _ func(*ec2sdk.DescribeIpamsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpams")
+}
+func (unimplementedEC2) DescribeIpamsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeIpamsInput, _ func(*ec2sdk.DescribeIpamsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpams")
+}
+func (unimplementedEC2) DescribeIpamsRequest(_ *ec2sdk.DescribeIpamsInput) (*request.Request, *ec2sdk.DescribeIpamsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIpams"), nil
+}
+func (unimplementedEC2) DescribeIpamsWithContext(_ context.Context, _ *ec2sdk.DescribeIpamsInput, _ ...request.Option) (*ec2sdk.DescribeIpamsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpams")
+}
+func (unimplementedEC2) DescribeIpv6Pools(_ *ec2sdk.DescribeIpv6PoolsInput) (*ec2sdk.DescribeIpv6PoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpv6Pools")
+}
+func (unimplementedEC2) DescribeIpv6PoolsPages(_ *ec2sdk.DescribeIpv6PoolsInput, _ func(*ec2sdk.DescribeIpv6PoolsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpv6Pools")
+}
+func (unimplementedEC2) DescribeIpv6PoolsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeIpv6PoolsInput, _ func(*ec2sdk.DescribeIpv6PoolsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeIpv6Pools")
+}
+func (unimplementedEC2) DescribeIpv6PoolsRequest(_ *ec2sdk.DescribeIpv6PoolsInput) (*request.Request, *ec2sdk.DescribeIpv6PoolsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeIpv6Pools"), nil
+}
+func (unimplementedEC2) DescribeIpv6PoolsWithContext(_ context.Context, _ *ec2sdk.DescribeIpv6PoolsInput, _ ...request.Option) (*ec2sdk.DescribeIpv6PoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeIpv6Pools")
+}
+func (unimplementedEC2) DescribeKeyPairs(_ *ec2sdk.DescribeKeyPairsInput) (*ec2sdk.DescribeKeyPairsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeKeyPairs")
+}
+func (unimplementedEC2) DescribeKeyPairsRequest(_ *ec2sdk.DescribeKeyPairsInput) (*request.Request, *ec2sdk.DescribeKeyPairsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeKeyPairs"), nil
+}
+func (unimplementedEC2) DescribeKeyPairsWithContext(_ context.Context, _ *ec2sdk.DescribeKeyPairsInput, _ ...request.Option) (*ec2sdk.DescribeKeyPairsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeKeyPairs")
+}
+func (unimplementedEC2) DescribeLaunchTemplateVersions(_ *ec2sdk.DescribeLaunchTemplateVersionsInput) (*ec2sdk.DescribeLaunchTemplateVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLaunchTemplateVersions")
+}
+func (unimplementedEC2) DescribeLaunchTemplateVersionsPages(_ *ec2sdk.DescribeLaunchTemplateVersionsInput, _ func(*ec2sdk.DescribeLaunchTemplateVersionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLaunchTemplateVersions")
+}
+func (unimplementedEC2) DescribeLaunchTemplateVersionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLaunchTemplateVersionsInput, _ func(*ec2sdk.DescribeLaunchTemplateVersionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLaunchTemplateVersions")
+}
+func (unimplementedEC2) DescribeLaunchTemplateVersionsRequest(_ *ec2sdk.DescribeLaunchTemplateVersionsInput) (*request.Request, *ec2sdk.DescribeLaunchTemplateVersionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLaunchTemplateVersions"), nil
+}
+func (unimplementedEC2) DescribeLaunchTemplateVersionsWithContext(_ context.Context, _ *ec2sdk.DescribeLaunchTemplateVersionsInput, _ ...request.Option) (*ec2sdk.DescribeLaunchTemplateVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLaunchTemplateVersions")
+}
+func (unimplementedEC2) DescribeLaunchTemplates(_ *ec2sdk.DescribeLaunchTemplatesInput) (*ec2sdk.DescribeLaunchTemplatesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLaunchTemplates")
+}
+func (unimplementedEC2) DescribeLaunchTemplatesPages(_ *ec2sdk.DescribeLaunchTemplatesInput, _ func(*ec2sdk.DescribeLaunchTemplatesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLaunchTemplates")
+}
+func (unimplementedEC2) DescribeLaunchTemplatesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLaunchTemplatesInput, _ func(*ec2sdk.DescribeLaunchTemplatesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLaunchTemplates")
+}
+func (unimplementedEC2) DescribeLaunchTemplatesRequest(_ *ec2sdk.DescribeLaunchTemplatesInput) (*request.Request, *ec2sdk.DescribeLaunchTemplatesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLaunchTemplates"), nil
+}
+func (unimplementedEC2) DescribeLaunchTemplatesWithContext(_ context.Context, _ *ec2sdk.DescribeLaunchTemplatesInput, _ ...request.Option) (*ec2sdk.DescribeLaunchTemplatesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLaunchTemplates")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations(_ *ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput) (*ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsPages(_ *ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput, _ func(*ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput, _ func(*ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsRequest(_ *ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput) (*request.Request, *ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations"), nil
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput, _ ...request.Option) (*ec2sdk.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVpcAssociations(_ *ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsInput) (*ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVpcAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVpcAssociationsPages(_ *ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsInput, _ func(*ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVpcAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVpcAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsInput, _ func(*ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVpcAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVpcAssociationsRequest(_ *ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsInput) (*request.Request, *ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLocalGatewayRouteTableVpcAssociations"), nil
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTableVpcAssociationsWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsInput, _ ...request.Option) (*ec2sdk.DescribeLocalGatewayRouteTableVpcAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTableVpcAssociations")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTables(_ *ec2sdk.DescribeLocalGatewayRouteTablesInput) (*ec2sdk.DescribeLocalGatewayRouteTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTablesPages(_ *ec2sdk.DescribeLocalGatewayRouteTablesInput, _ func(*ec2sdk.DescribeLocalGatewayRouteTablesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTablesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayRouteTablesInput, _ func(*ec2sdk.DescribeLocalGatewayRouteTablesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTablesRequest(_ *ec2sdk.DescribeLocalGatewayRouteTablesInput) (*request.Request, *ec2sdk.DescribeLocalGatewayRouteTablesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLocalGatewayRouteTables"), nil
+}
+func (unimplementedEC2) DescribeLocalGatewayRouteTablesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayRouteTablesInput, _ ...request.Option) (*ec2sdk.DescribeLocalGatewayRouteTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfaceGroups(_ *ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsInput) (*ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaceGroups")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfaceGroupsPages(_ *ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsInput, _ func(*ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaceGroups")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfaceGroupsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsInput, _ func(*ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaceGroups")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfaceGroupsRequest(_ *ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsInput) (*request.Request, *ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLocalGatewayVirtualInterfaceGroups"), nil
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfaceGroupsWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsInput, _ ...request.Option) (*ec2sdk.DescribeLocalGatewayVirtualInterfaceGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaceGroups")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfaces(_ *ec2sdk.DescribeLocalGatewayVirtualInterfacesInput) (*ec2sdk.DescribeLocalGatewayVirtualInterfacesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaces")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfacesPages(_ *ec2sdk.DescribeLocalGatewayVirtualInterfacesInput, _ func(*ec2sdk.DescribeLocalGatewayVirtualInterfacesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaces")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfacesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayVirtualInterfacesInput, _ func(*ec2sdk.DescribeLocalGatewayVirtualInterfacesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaces")
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfacesRequest(_ *ec2sdk.DescribeLocalGatewayVirtualInterfacesInput) (*request.Request, *ec2sdk.DescribeLocalGatewayVirtualInterfacesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLocalGatewayVirtualInterfaces"), nil
+}
+func (unimplementedEC2) DescribeLocalGatewayVirtualInterfacesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewayVirtualInterfacesInput, _ ...request.Option) (*ec2sdk.DescribeLocalGatewayVirtualInterfacesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGatewayVirtualInterfaces")
+}
+func (unimplementedEC2) DescribeLocalGateways(_ *ec2sdk.DescribeLocalGatewaysInput) (*ec2sdk.DescribeLocalGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGateways")
+}
+func (unimplementedEC2) DescribeLocalGatewaysPages(_ *ec2sdk.DescribeLocalGatewaysInput, _ func(*ec2sdk.DescribeLocalGatewaysOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGateways")
+}
+func (unimplementedEC2) DescribeLocalGatewaysPagesWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewaysInput, _ func(*ec2sdk.DescribeLocalGatewaysOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeLocalGateways")
+}
+func (unimplementedEC2) DescribeLocalGatewaysRequest(_ *ec2sdk.DescribeLocalGatewaysInput) (*request.Request, *ec2sdk.DescribeLocalGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLocalGateways"), nil
+}
+func (unimplementedEC2) DescribeLocalGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeLocalGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeLocalGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLocalGateways")
+}
+func (unimplementedEC2) DescribeLockedSnapshots(_ *ec2sdk.DescribeLockedSnapshotsInput) (*ec2sdk.DescribeLockedSnapshotsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLockedSnapshots")
+}
+func (unimplementedEC2) DescribeLockedSnapshotsRequest(_ *ec2sdk.DescribeLockedSnapshotsInput) (*request.Request, *ec2sdk.DescribeLockedSnapshotsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeLockedSnapshots"), nil
+}
+func (unimplementedEC2) DescribeLockedSnapshotsWithContext(_ context.Context, _ *ec2sdk.DescribeLockedSnapshotsInput, _ ...request.Option) (*ec2sdk.DescribeLockedSnapshotsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeLockedSnapshots")
+}
+func (unimplementedEC2) DescribeManagedPrefixLists(_ *ec2sdk.DescribeManagedPrefixListsInput) (*ec2sdk.DescribeManagedPrefixListsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeManagedPrefixLists")
+}
+func (unimplementedEC2) DescribeManagedPrefixListsPages(_ *ec2sdk.DescribeManagedPrefixListsInput, _ func(*ec2sdk.DescribeManagedPrefixListsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeManagedPrefixLists")
+}
+func (unimplementedEC2) DescribeManagedPrefixListsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeManagedPrefixListsInput, _ func(*ec2sdk.DescribeManagedPrefixListsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeManagedPrefixLists")
+}
+func (unimplementedEC2) DescribeManagedPrefixListsRequest(_ *ec2sdk.DescribeManagedPrefixListsInput) (*request.Request, *ec2sdk.DescribeManagedPrefixListsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeManagedPrefixLists"), nil
+}
+func (unimplementedEC2) DescribeManagedPrefixListsWithContext(_ context.Context, _ *ec2sdk.DescribeManagedPrefixListsInput, _ ...request.Option) (*ec2sdk.DescribeManagedPrefixListsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeManagedPrefixLists")
+}
+func (unimplementedEC2) DescribeMovingAddresses(_ *ec2sdk.DescribeMovingAddressesInput) (*ec2sdk.DescribeMovingAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeMovingAddresses")
+}
+func (unimplementedEC2) DescribeMovingAddressesPages(_ *ec2sdk.DescribeMovingAddressesInput, _ func(*ec2sdk.DescribeMovingAddressesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeMovingAddresses")
+}
+func (unimplementedEC2) DescribeMovingAddressesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeMovingAddressesInput, _ func(*ec2sdk.DescribeMovingAddressesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeMovingAddresses")
+}
+func (unimplementedEC2) DescribeMovingAddressesRequest(_ *ec2sdk.DescribeMovingAddressesInput) (*request.Request, *ec2sdk.DescribeMovingAddressesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeMovingAddresses"), nil
+}
+func (unimplementedEC2) DescribeMovingAddressesWithContext(_ context.Context, _ *ec2sdk.DescribeMovingAddressesInput, _ ...request.Option) (*ec2sdk.DescribeMovingAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeMovingAddresses")
+}
+func (unimplementedEC2) DescribeNatGateways(_ *ec2sdk.DescribeNatGatewaysInput) (*ec2sdk.DescribeNatGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNatGateways")
+}
+func (unimplementedEC2) DescribeNatGatewaysPages(_ *ec2sdk.DescribeNatGatewaysInput, _ func(*ec2sdk.DescribeNatGatewaysOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNatGateways")
+}
+func (unimplementedEC2) DescribeNatGatewaysPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNatGatewaysInput, _ func(*ec2sdk.DescribeNatGatewaysOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNatGateways")
+}
+func (unimplementedEC2) DescribeNatGatewaysRequest(_ *ec2sdk.DescribeNatGatewaysInput) (*request.Request, *ec2sdk.DescribeNatGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNatGateways"), nil
+}
+func (unimplementedEC2) DescribeNatGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeNatGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeNatGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNatGateways")
+}
+func (unimplementedEC2) DescribeNetworkAcls(_ *ec2sdk.DescribeNetworkAclsInput) (*ec2sdk.DescribeNetworkAclsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkAcls")
+}
+func (unimplementedEC2) DescribeNetworkAclsPages(_ *ec2sdk.DescribeNetworkAclsInput, _ func(*ec2sdk.DescribeNetworkAclsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkAcls")
+}
+func (unimplementedEC2) DescribeNetworkAclsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkAclsInput, _ func(*ec2sdk.DescribeNetworkAclsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkAcls")
+}
+func (unimplementedEC2) DescribeNetworkAclsRequest(_ *ec2sdk.DescribeNetworkAclsInput) (*request.Request, *ec2sdk.DescribeNetworkAclsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkAcls"), nil
+}
+func (unimplementedEC2) DescribeNetworkAclsWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkAclsInput, _ ...request.Option) (*ec2sdk.DescribeNetworkAclsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkAcls")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopeAnalyses(_ *ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesInput) (*ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopeAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopeAnalysesPages(_ *ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesInput, _ func(*ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopeAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopeAnalysesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesInput, _ func(*ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopeAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopeAnalysesRequest(_ *ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesInput) (*request.Request, *ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkInsightsAccessScopeAnalyses"), nil
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopeAnalysesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInsightsAccessScopeAnalysesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopeAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopes(_ *ec2sdk.DescribeNetworkInsightsAccessScopesInput) (*ec2sdk.DescribeNetworkInsightsAccessScopesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopes")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopesPages(_ *ec2sdk.DescribeNetworkInsightsAccessScopesInput, _ func(*ec2sdk.DescribeNetworkInsightsAccessScopesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopes")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsAccessScopesInput, _ func(*ec2sdk.DescribeNetworkInsightsAccessScopesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopes")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopesRequest(_ *ec2sdk.DescribeNetworkInsightsAccessScopesInput) (*request.Request, *ec2sdk.DescribeNetworkInsightsAccessScopesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkInsightsAccessScopes"), nil
+}
+func (unimplementedEC2) DescribeNetworkInsightsAccessScopesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsAccessScopesInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInsightsAccessScopesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAccessScopes")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAnalyses(_ *ec2sdk.DescribeNetworkInsightsAnalysesInput) (*ec2sdk.DescribeNetworkInsightsAnalysesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAnalysesPages(_ *ec2sdk.DescribeNetworkInsightsAnalysesInput, _ func(*ec2sdk.DescribeNetworkInsightsAnalysesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAnalysesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsAnalysesInput, _ func(*ec2sdk.DescribeNetworkInsightsAnalysesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsAnalysesRequest(_ *ec2sdk.DescribeNetworkInsightsAnalysesInput) (*request.Request, *ec2sdk.DescribeNetworkInsightsAnalysesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkInsightsAnalyses"), nil
+}
+func (unimplementedEC2) DescribeNetworkInsightsAnalysesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsAnalysesInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInsightsAnalysesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsAnalyses")
+}
+func (unimplementedEC2) DescribeNetworkInsightsPaths(_ *ec2sdk.DescribeNetworkInsightsPathsInput) (*ec2sdk.DescribeNetworkInsightsPathsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsPaths")
+}
+func (unimplementedEC2) DescribeNetworkInsightsPathsPages(_ *ec2sdk.DescribeNetworkInsightsPathsInput, _ func(*ec2sdk.DescribeNetworkInsightsPathsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsPaths")
+}
+func (unimplementedEC2) DescribeNetworkInsightsPathsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsPathsInput, _ func(*ec2sdk.DescribeNetworkInsightsPathsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsPaths")
+}
+func (unimplementedEC2) DescribeNetworkInsightsPathsRequest(_ *ec2sdk.DescribeNetworkInsightsPathsInput) (*request.Request, *ec2sdk.DescribeNetworkInsightsPathsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkInsightsPaths"), nil
+}
+func (unimplementedEC2) DescribeNetworkInsightsPathsWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInsightsPathsInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInsightsPathsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInsightsPaths")
+}
+func (unimplementedEC2) DescribeNetworkInterfaceAttribute(_ *ec2sdk.DescribeNetworkInterfaceAttributeInput) (*ec2sdk.DescribeNetworkInterfaceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInterfaceAttribute")
+}
+func (unimplementedEC2) DescribeNetworkInterfaceAttributeRequest(_ *ec2sdk.DescribeNetworkInterfaceAttributeInput) (*request.Request, *ec2sdk.DescribeNetworkInterfaceAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkInterfaceAttribute"), nil
+}
+func (unimplementedEC2) DescribeNetworkInterfaceAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInterfaceAttributeInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInterfaceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInterfaceAttribute")
+}
+func (unimplementedEC2) DescribeNetworkInterfacePermissions(_ *ec2sdk.DescribeNetworkInterfacePermissionsInput) (*ec2sdk.DescribeNetworkInterfacePermissionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInterfacePermissions")
+}
+func (unimplementedEC2) DescribeNetworkInterfacePermissionsPages(_ *ec2sdk.DescribeNetworkInterfacePermissionsInput, _ func(*ec2sdk.DescribeNetworkInterfacePermissionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInterfacePermissions")
+}
+func (unimplementedEC2) DescribeNetworkInterfacePermissionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInterfacePermissionsInput, _ func(*ec2sdk.DescribeNetworkInterfacePermissionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInterfacePermissions")
+}
+func (unimplementedEC2) DescribeNetworkInterfacePermissionsRequest(_ *ec2sdk.DescribeNetworkInterfacePermissionsInput) (*request.Request, *ec2sdk.DescribeNetworkInterfacePermissionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkInterfacePermissions"), nil
+}
+func (unimplementedEC2) DescribeNetworkInterfacePermissionsWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInterfacePermissionsInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInterfacePermissionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInterfacePermissions")
+}
+func (unimplementedEC2) DescribeNetworkInterfaces(_ *ec2sdk.DescribeNetworkInterfacesInput) (*ec2sdk.DescribeNetworkInterfacesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInterfaces")
+}
+func (unimplementedEC2) DescribeNetworkInterfacesPages(_ *ec2sdk.DescribeNetworkInterfacesInput, _ func(*ec2sdk.DescribeNetworkInterfacesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInterfaces")
+}
+func (unimplementedEC2) DescribeNetworkInterfacesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInterfacesInput, _ func(*ec2sdk.DescribeNetworkInterfacesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeNetworkInterfaces")
+}
+func (unimplementedEC2) DescribeNetworkInterfacesRequest(_ *ec2sdk.DescribeNetworkInterfacesInput) (*request.Request, *ec2sdk.DescribeNetworkInterfacesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeNetworkInterfaces"), nil
+}
+func (unimplementedEC2) DescribeNetworkInterfacesWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInterfacesInput, _ ...request.Option) (*ec2sdk.DescribeNetworkInterfacesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeNetworkInterfaces")
+}
+func (unimplementedEC2) DescribePlacementGroups(_ *ec2sdk.DescribePlacementGroupsInput) (*ec2sdk.DescribePlacementGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePlacementGroups")
+}
+func (unimplementedEC2) DescribePlacementGroupsRequest(_ *ec2sdk.DescribePlacementGroupsInput) (*request.Request, *ec2sdk.DescribePlacementGroupsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribePlacementGroups"), nil
+}
+func (unimplementedEC2) DescribePlacementGroupsWithContext(_ context.Context, _ *ec2sdk.DescribePlacementGroupsInput, _ ...request.Option) (*ec2sdk.DescribePlacementGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePlacementGroups")
+}
+func (unimplementedEC2) DescribePrefixLists(_ *ec2sdk.DescribePrefixListsInput) (*ec2sdk.DescribePrefixListsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePrefixLists")
+}
+func (unimplementedEC2) DescribePrefixListsPages(_ *ec2sdk.DescribePrefixListsInput, _ func(*ec2sdk.DescribePrefixListsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribePrefixLists")
+}
+func (unimplementedEC2) DescribePrefixListsPagesWithContext(_ context.Context, _ *ec2sdk.DescribePrefixListsInput, _ func(*ec2sdk.DescribePrefixListsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribePrefixLists")
+}
+func (unimplementedEC2) DescribePrefixListsRequest(_ *ec2sdk.DescribePrefixListsInput) (*request.Request, *ec2sdk.DescribePrefixListsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribePrefixLists"), nil
+}
+func (unimplementedEC2) DescribePrefixListsWithContext(_ context.Context, _ *ec2sdk.DescribePrefixListsInput, _ ...request.Option) (*ec2sdk.DescribePrefixListsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePrefixLists")
+}
+func (unimplementedEC2) DescribePrincipalIdFormat(_ *ec2sdk.DescribePrincipalIdFormatInput) (*ec2sdk.DescribePrincipalIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePrincipalIdFormat")
+}
+func (unimplementedEC2) DescribePrincipalIdFormatPages(_ *ec2sdk.DescribePrincipalIdFormatInput, _ func(*ec2sdk.DescribePrincipalIdFormatOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribePrincipalIdFormat")
+}
+func (unimplementedEC2) DescribePrincipalIdFormatPagesWithContext(_ context.Context, _ *ec2sdk.DescribePrincipalIdFormatInput, _ func(*ec2sdk.DescribePrincipalIdFormatOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribePrincipalIdFormat")
+}
+func (unimplementedEC2) DescribePrincipalIdFormatRequest(_ *ec2sdk.DescribePrincipalIdFormatInput) (*request.Request, *ec2sdk.DescribePrincipalIdFormatOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribePrincipalIdFormat"), nil
+}
+func (unimplementedEC2) DescribePrincipalIdFormatWithContext(_ context.Context, _ *ec2sdk.DescribePrincipalIdFormatInput, _ ...request.Option) (*ec2sdk.DescribePrincipalIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePrincipalIdFormat")
+}
+func (unimplementedEC2) DescribePublicIpv4Pools(_ *ec2sdk.DescribePublicIpv4PoolsInput) (*ec2sdk.DescribePublicIpv4PoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePublicIpv4Pools")
+}
+func (unimplementedEC2) DescribePublicIpv4PoolsPages(_ *ec2sdk.DescribePublicIpv4PoolsInput, _ func(*ec2sdk.DescribePublicIpv4PoolsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribePublicIpv4Pools")
+}
+func (unimplementedEC2) DescribePublicIpv4PoolsPagesWithContext(_ context.Context, _ *ec2sdk.DescribePublicIpv4PoolsInput, _ func(*ec2sdk.DescribePublicIpv4PoolsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribePublicIpv4Pools")
+}
+func (unimplementedEC2) DescribePublicIpv4PoolsRequest(_ *ec2sdk.DescribePublicIpv4PoolsInput) (*request.Request, *ec2sdk.DescribePublicIpv4PoolsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribePublicIpv4Pools"), nil
+}
+func (unimplementedEC2) DescribePublicIpv4PoolsWithContext(_ context.Context, _ *ec2sdk.DescribePublicIpv4PoolsInput, _ ...request.Option) (*ec2sdk.DescribePublicIpv4PoolsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribePublicIpv4Pools")
+}
+func (unimplementedEC2) DescribeRegions(_ *ec2sdk.DescribeRegionsInput) (*ec2sdk.DescribeRegionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeRegions")
+}
+func (unimplementedEC2) DescribeRegionsRequest(_ *ec2sdk.DescribeRegionsInput) (*request.Request, *ec2sdk.DescribeRegionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeRegions"), nil
+}
+func (unimplementedEC2) DescribeRegionsWithContext(_ context.Context, _ *ec2sdk.DescribeRegionsInput, _ ...request.Option) (*ec2sdk.DescribeRegionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeRegions")
+}
+func (unimplementedEC2) DescribeReplaceRootVolumeTasks(_ *ec2sdk.DescribeReplaceRootVolumeTasksInput) (*ec2sdk.DescribeReplaceRootVolumeTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReplaceRootVolumeTasks")
+}
+func (unimplementedEC2) DescribeReplaceRootVolumeTasksPages(_ *ec2sdk.DescribeReplaceRootVolumeTasksInput, _ func(*ec2sdk.DescribeReplaceRootVolumeTasksOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeReplaceRootVolumeTasks")
+}
+func (unimplementedEC2) DescribeReplaceRootVolumeTasksPagesWithContext(_ context.Context, _ *ec2sdk.DescribeReplaceRootVolumeTasksInput, _ func(*ec2sdk.DescribeReplaceRootVolumeTasksOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeReplaceRootVolumeTasks")
+}
+func (unimplementedEC2) DescribeReplaceRootVolumeTasksRequest(_ *ec2sdk.DescribeReplaceRootVolumeTasksInput) (*request.Request, *ec2sdk.DescribeReplaceRootVolumeTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeReplaceRootVolumeTasks"), nil
+}
+func (unimplementedEC2) DescribeReplaceRootVolumeTasksWithContext(_ context.Context, _ *ec2sdk.DescribeReplaceRootVolumeTasksInput, _ ...request.Option) (*ec2sdk.DescribeReplaceRootVolumeTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReplaceRootVolumeTasks")
+}
+func (unimplementedEC2) DescribeReservedInstances(_ *ec2sdk.DescribeReservedInstancesInput) (*ec2sdk.DescribeReservedInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstances")
+}
+func (unimplementedEC2) DescribeReservedInstancesListings(_ *ec2sdk.DescribeReservedInstancesListingsInput) (*ec2sdk.DescribeReservedInstancesListingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstancesListings")
+}
+func (unimplementedEC2) DescribeReservedInstancesListingsRequest(_ *ec2sdk.DescribeReservedInstancesListingsInput) (*request.Request, *ec2sdk.DescribeReservedInstancesListingsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeReservedInstancesListings"), nil
+}
+func (unimplementedEC2) DescribeReservedInstancesListingsWithContext(_ context.Context, _ *ec2sdk.DescribeReservedInstancesListingsInput, _ ...request.Option) (*ec2sdk.DescribeReservedInstancesListingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstancesListings")
+}
+func (unimplementedEC2) DescribeReservedInstancesModifications(_ *ec2sdk.DescribeReservedInstancesModificationsInput) (*ec2sdk.DescribeReservedInstancesModificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstancesModifications")
+}
+func (unimplementedEC2) DescribeReservedInstancesModificationsPages(_ *ec2sdk.DescribeReservedInstancesModificationsInput, _ func(*ec2sdk.DescribeReservedInstancesModificationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeReservedInstancesModifications")
+}
+func (unimplementedEC2) DescribeReservedInstancesModificationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeReservedInstancesModificationsInput, _ func(*ec2sdk.DescribeReservedInstancesModificationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeReservedInstancesModifications")
+}
+func (unimplementedEC2) DescribeReservedInstancesModificationsRequest(_ *ec2sdk.DescribeReservedInstancesModificationsInput) (*request.Request, *ec2sdk.DescribeReservedInstancesModificationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeReservedInstancesModifications"), nil
+}
+func (unimplementedEC2) DescribeReservedInstancesModificationsWithContext(_ context.Context, _ *ec2sdk.DescribeReservedInstancesModificationsInput, _ ...request.Option) (*ec2sdk.DescribeReservedInstancesModificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstancesModifications")
+}
+func (unimplementedEC2) DescribeReservedInstancesOfferings(_ *ec2sdk.DescribeReservedInstancesOfferingsInput) (*ec2sdk.DescribeReservedInstancesOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstancesOfferings")
+}
+func (unimplementedEC2) DescribeReservedInstancesOfferingsPages(_ *ec2sdk.DescribeReservedInstancesOfferingsInput, _ func(*ec2sdk.DescribeReservedInstancesOfferingsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeReservedInstancesOfferings")
+}
+func (unimplementedEC2) DescribeReservedInstancesOfferingsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeReservedInstancesOfferingsInput, _ func(*ec2sdk.DescribeReservedInstancesOfferingsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeReservedInstancesOfferings")
+}
+func (unimplementedEC2) DescribeReservedInstancesOfferingsRequest(_ *ec2sdk.DescribeReservedInstancesOfferingsInput) (*request.Request, *ec2sdk.DescribeReservedInstancesOfferingsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeReservedInstancesOfferings"), nil
+}
+func (unimplementedEC2) DescribeReservedInstancesOfferingsWithContext(_ context.Context, _ *ec2sdk.DescribeReservedInstancesOfferingsInput, _ ...request.Option) (*ec2sdk.DescribeReservedInstancesOfferingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstancesOfferings")
+}
+func (unimplementedEC2) DescribeReservedInstancesRequest(_ *ec2sdk.DescribeReservedInstancesInput) (*request.Request, *ec2sdk.DescribeReservedInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeReservedInstances"), nil
+}
+func (unimplementedEC2) DescribeReservedInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeReservedInstancesInput, _ ...request.Option) (*ec2sdk.DescribeReservedInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeReservedInstances")
+}
+func (unimplementedEC2) DescribeRouteTables(_ *ec2sdk.DescribeRouteTablesInput) (*ec2sdk.DescribeRouteTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeRouteTables")
+}
+func (unimplementedEC2) DescribeRouteTablesPages(_ *ec2sdk.DescribeRouteTablesInput, _ func(*ec2sdk.DescribeRouteTablesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeRouteTables")
+}
+func (unimplementedEC2) DescribeRouteTablesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeRouteTablesInput, _ func(*ec2sdk.DescribeRouteTablesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeRouteTables")
+}
+func (unimplementedEC2) DescribeRouteTablesRequest(_ *ec2sdk.DescribeRouteTablesInput) (*request.Request, *ec2sdk.DescribeRouteTablesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeRouteTables"), nil
+}
+func (unimplementedEC2) DescribeRouteTablesWithContext(_ context.Context, _ *ec2sdk.DescribeRouteTablesInput, _ ...request.Option) (*ec2sdk.DescribeRouteTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeRouteTables")
+}
+func (unimplementedEC2) DescribeScheduledInstanceAvailability(_ *ec2sdk.DescribeScheduledInstanceAvailabilityInput) (*ec2sdk.DescribeScheduledInstanceAvailabilityOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeScheduledInstanceAvailability")
+}
+func (unimplementedEC2) DescribeScheduledInstanceAvailabilityPages(_ *ec2sdk.DescribeScheduledInstanceAvailabilityInput, _ func(*ec2sdk.DescribeScheduledInstanceAvailabilityOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeScheduledInstanceAvailability")
+}
+func (unimplementedEC2) DescribeScheduledInstanceAvailabilityPagesWithContext(_ context.Context, _ *ec2sdk.DescribeScheduledInstanceAvailabilityInput, _ func(*ec2sdk.DescribeScheduledInstanceAvailabilityOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeScheduledInstanceAvailability")
+}
+func (unimplementedEC2) DescribeScheduledInstanceAvailabilityRequest(_ *ec2sdk.DescribeScheduledInstanceAvailabilityInput) (*request.Request, *ec2sdk.DescribeScheduledInstanceAvailabilityOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeScheduledInstanceAvailability"), nil
+}
+func (unimplementedEC2) DescribeScheduledInstanceAvailabilityWithContext(_ context.Context, _ *ec2sdk.DescribeScheduledInstanceAvailabilityInput, _ ...request.Option) (*ec2sdk.DescribeScheduledInstanceAvailabilityOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeScheduledInstanceAvailability")
+}
+func (unimplementedEC2) DescribeScheduledInstances(_ *ec2sdk.DescribeScheduledInstancesInput) (*ec2sdk.DescribeScheduledInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeScheduledInstances")
+}
+func (unimplementedEC2) DescribeScheduledInstancesPages(_ *ec2sdk.DescribeScheduledInstancesInput, _ func(*ec2sdk.DescribeScheduledInstancesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeScheduledInstances")
+}
+func (unimplementedEC2) DescribeScheduledInstancesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeScheduledInstancesInput, _ func(*ec2sdk.DescribeScheduledInstancesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeScheduledInstances")
+}
+func (unimplementedEC2) DescribeScheduledInstancesRequest(_ *ec2sdk.DescribeScheduledInstancesInput) (*request.Request, *ec2sdk.DescribeScheduledInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeScheduledInstances"), nil
+}
+func (unimplementedEC2) DescribeScheduledInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeScheduledInstancesInput, _ ...request.Option) (*ec2sdk.DescribeScheduledInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeScheduledInstances")
+}
+func (unimplementedEC2) DescribeSecurityGroupReferences(_ *ec2sdk.DescribeSecurityGroupReferencesInput) (*ec2sdk.DescribeSecurityGroupReferencesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSecurityGroupReferences")
+}
+func (unimplementedEC2) DescribeSecurityGroupReferencesRequest(_ *ec2sdk.DescribeSecurityGroupReferencesInput) (*request.Request, *ec2sdk.DescribeSecurityGroupReferencesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSecurityGroupReferences"), nil
+}
+func (unimplementedEC2) DescribeSecurityGroupReferencesWithContext(_ context.Context, _ *ec2sdk.DescribeSecurityGroupReferencesInput, _ ...request.Option) (*ec2sdk.DescribeSecurityGroupReferencesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSecurityGroupReferences")
+}
+func (unimplementedEC2) DescribeSecurityGroupRules(_ *ec2sdk.DescribeSecurityGroupRulesInput) (*ec2sdk.DescribeSecurityGroupRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSecurityGroupRules")
+}
+func (unimplementedEC2) DescribeSecurityGroupRulesPages(_ *ec2sdk.DescribeSecurityGroupRulesInput, _ func(*ec2sdk.DescribeSecurityGroupRulesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSecurityGroupRules")
+}
+func (unimplementedEC2) DescribeSecurityGroupRulesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSecurityGroupRulesInput, _ func(*ec2sdk.DescribeSecurityGroupRulesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSecurityGroupRules")
+}
+func (unimplementedEC2) DescribeSecurityGroupRulesRequest(_ *ec2sdk.DescribeSecurityGroupRulesInput) (*request.Request, *ec2sdk.DescribeSecurityGroupRulesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSecurityGroupRules"), nil
+}
+func (unimplementedEC2) DescribeSecurityGroupRulesWithContext(_ context.Context, _ *ec2sdk.DescribeSecurityGroupRulesInput, _ ...request.Option) (*ec2sdk.DescribeSecurityGroupRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSecurityGroupRules")
+}
+func (unimplementedEC2) DescribeSecurityGroups(_ *ec2sdk.DescribeSecurityGroupsInput) (*ec2sdk.DescribeSecurityGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSecurityGroups")
+}
+func (unimplementedEC2) DescribeSecurityGroupsPages(_ *ec2sdk.DescribeSecurityGroupsInput, _ func(*ec2sdk.DescribeSecurityGroupsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSecurityGroups")
+}
+func (unimplementedEC2) DescribeSecurityGroupsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSecurityGroupsInput, _ func(*ec2sdk.DescribeSecurityGroupsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSecurityGroups")
+}
+func (unimplementedEC2) DescribeSecurityGroupsRequest(_ *ec2sdk.DescribeSecurityGroupsInput) (*request.Request, *ec2sdk.DescribeSecurityGroupsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSecurityGroups"), nil
+}
+func (unimplementedEC2) DescribeSecurityGroupsWithContext(_ context.Context, _ *ec2sdk.DescribeSecurityGroupsInput, _ ...request.Option) (*ec2sdk.DescribeSecurityGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSecurityGroups")
+}
+func (unimplementedEC2) DescribeSnapshotAttribute(_ *ec2sdk.DescribeSnapshotAttributeInput) (*ec2sdk.DescribeSnapshotAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSnapshotAttribute")
+}
+func (unimplementedEC2) DescribeSnapshotAttributeRequest(_ *ec2sdk.DescribeSnapshotAttributeInput) (*request.Request, *ec2sdk.DescribeSnapshotAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSnapshotAttribute"), nil
+}
+func (unimplementedEC2) DescribeSnapshotAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeSnapshotAttributeInput, _ ...request.Option) (*ec2sdk.DescribeSnapshotAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSnapshotAttribute")
+}
+func (unimplementedEC2) DescribeSnapshotTierStatus(_ *ec2sdk.DescribeSnapshotTierStatusInput) (*ec2sdk.DescribeSnapshotTierStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSnapshotTierStatus")
+}
+func (unimplementedEC2) DescribeSnapshotTierStatusPages(_ *ec2sdk.DescribeSnapshotTierStatusInput, _ func(*ec2sdk.DescribeSnapshotTierStatusOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSnapshotTierStatus")
+}
+func (unimplementedEC2) DescribeSnapshotTierStatusPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSnapshotTierStatusInput, _ func(*ec2sdk.DescribeSnapshotTierStatusOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSnapshotTierStatus")
+}
+func (unimplementedEC2) DescribeSnapshotTierStatusRequest(_ *ec2sdk.DescribeSnapshotTierStatusInput) (*request.Request, *ec2sdk.DescribeSnapshotTierStatusOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSnapshotTierStatus"), nil
+}
+func (unimplementedEC2) DescribeSnapshotTierStatusWithContext(_ context.Context, _ *ec2sdk.DescribeSnapshotTierStatusInput, _ ...request.Option) (*ec2sdk.DescribeSnapshotTierStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSnapshotTierStatus")
+}
+func (unimplementedEC2) DescribeSnapshots(_ *ec2sdk.DescribeSnapshotsInput) (*ec2sdk.DescribeSnapshotsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSnapshots")
+}
+func (unimplementedEC2) DescribeSnapshotsPages(_ *ec2sdk.DescribeSnapshotsInput, _ func(*ec2sdk.DescribeSnapshotsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSnapshots")
+}
+func (unimplementedEC2) DescribeSnapshotsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSnapshotsInput, _ func(*ec2sdk.DescribeSnapshotsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSnapshots")
+}
+func (unimplementedEC2) DescribeSnapshotsRequest(_ *ec2sdk.DescribeSnapshotsInput) (*request.Request, *ec2sdk.DescribeSnapshotsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSnapshots"), nil
+}
+func (unimplementedEC2) DescribeSnapshotsWithContext(_ context.Context, _ *ec2sdk.DescribeSnapshotsInput, _ ...request.Option) (*ec2sdk.DescribeSnapshotsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSnapshots")
+}
+func (unimplementedEC2) DescribeSpotDatafeedSubscription(_ *ec2sdk.DescribeSpotDatafeedSubscriptionInput) (*ec2sdk.DescribeSpotDatafeedSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotDatafeedSubscription")
+}
+func (unimplementedEC2) DescribeSpotDatafeedSubscriptionRequest(_ *ec2sdk.DescribeSpotDatafeedSubscriptionInput) (*request.Request, *ec2sdk.DescribeSpotDatafeedSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSpotDatafeedSubscription"), nil
+}
+func (unimplementedEC2) DescribeSpotDatafeedSubscriptionWithContext(_ context.Context, _ *ec2sdk.DescribeSpotDatafeedSubscriptionInput, _ ...request.Option) (*ec2sdk.DescribeSpotDatafeedSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotDatafeedSubscription")
+}
+func (unimplementedEC2) DescribeSpotFleetInstances(_ *ec2sdk.DescribeSpotFleetInstancesInput) (*ec2sdk.DescribeSpotFleetInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotFleetInstances")
+}
+func (unimplementedEC2) DescribeSpotFleetInstancesRequest(_ *ec2sdk.DescribeSpotFleetInstancesInput) (*request.Request, *ec2sdk.DescribeSpotFleetInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSpotFleetInstances"), nil
+}
+func (unimplementedEC2) DescribeSpotFleetInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeSpotFleetInstancesInput, _ ...request.Option) (*ec2sdk.DescribeSpotFleetInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotFleetInstances")
+}
+func (unimplementedEC2) DescribeSpotFleetRequestHistory(_ *ec2sdk.DescribeSpotFleetRequestHistoryInput) (*ec2sdk.DescribeSpotFleetRequestHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotFleetRequestHistory")
+}
+func (unimplementedEC2) DescribeSpotFleetRequestHistoryRequest(_ *ec2sdk.DescribeSpotFleetRequestHistoryInput) (*request.Request, *ec2sdk.DescribeSpotFleetRequestHistoryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSpotFleetRequestHistory"), nil
+}
+func (unimplementedEC2) DescribeSpotFleetRequestHistoryWithContext(_ context.Context, _ *ec2sdk.DescribeSpotFleetRequestHistoryInput, _ ...request.Option) (*ec2sdk.DescribeSpotFleetRequestHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotFleetRequestHistory")
+}
+func (unimplementedEC2) DescribeSpotFleetRequests(_ *ec2sdk.DescribeSpotFleetRequestsInput) (*ec2sdk.DescribeSpotFleetRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotFleetRequests")
+}
+func (unimplementedEC2) DescribeSpotFleetRequestsPages(_ *ec2sdk.DescribeSpotFleetRequestsInput, _ func(*ec2sdk.DescribeSpotFleetRequestsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSpotFleetRequests")
+}
+func (unimplementedEC2) DescribeSpotFleetRequestsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSpotFleetRequestsInput, _ func(*ec2sdk.DescribeSpotFleetRequestsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSpotFleetRequests")
+}
+func (unimplementedEC2) DescribeSpotFleetRequestsRequest(_ *ec2sdk.DescribeSpotFleetRequestsInput) (*request.Request, *ec2sdk.DescribeSpotFleetRequestsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSpotFleetRequests"), nil
+}
+func (unimplementedEC2) DescribeSpotFleetRequestsWithContext(_ context.Context, _ *ec2sdk.DescribeSpotFleetRequestsInput, _ ...request.Option) (*ec2sdk.DescribeSpotFleetRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotFleetRequests")
+}
+func (unimplementedEC2) DescribeSpotInstanceRequests(_ *ec2sdk.DescribeSpotInstanceRequestsInput) (*ec2sdk.DescribeSpotInstanceRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotInstanceRequests")
+}
+func (unimplementedEC2) DescribeSpotInstanceRequestsPages(_ *ec2sdk.DescribeSpotInstanceRequestsInput, _ func(*ec2sdk.DescribeSpotInstanceRequestsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSpotInstanceRequests")
+}
+func (unimplementedEC2) DescribeSpotInstanceRequestsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSpotInstanceRequestsInput, _ func(*ec2sdk.DescribeSpotInstanceRequestsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSpotInstanceRequests")
+}
+func (unimplementedEC2) DescribeSpotInstanceRequestsRequest(_ *ec2sdk.DescribeSpotInstanceRequestsInput) (*request.Request, *ec2sdk.DescribeSpotInstanceRequestsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSpotInstanceRequests"), nil
+}
+func (unimplementedEC2) DescribeSpotInstanceRequestsWithContext(_ context.Context, _ *ec2sdk.DescribeSpotInstanceRequestsInput, _ ...request.Option) (*ec2sdk.DescribeSpotInstanceRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotInstanceRequests")
+}
+func (unimplementedEC2) DescribeSpotPriceHistory(_ *ec2sdk.DescribeSpotPriceHistoryInput) (*ec2sdk.DescribeSpotPriceHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotPriceHistory")
+}
+func (unimplementedEC2) DescribeSpotPriceHistoryPages(_ *ec2sdk.DescribeSpotPriceHistoryInput, _ func(*ec2sdk.DescribeSpotPriceHistoryOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSpotPriceHistory")
+}
+func (unimplementedEC2) DescribeSpotPriceHistoryPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSpotPriceHistoryInput, _ func(*ec2sdk.DescribeSpotPriceHistoryOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSpotPriceHistory")
+}
+func (unimplementedEC2) DescribeSpotPriceHistoryRequest(_ *ec2sdk.DescribeSpotPriceHistoryInput) (*request.Request, *ec2sdk.DescribeSpotPriceHistoryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSpotPriceHistory"), nil
+}
+func (unimplementedEC2) DescribeSpotPriceHistoryWithContext(_ context.Context, _ *ec2sdk.DescribeSpotPriceHistoryInput, _ ...request.Option) (*ec2sdk.DescribeSpotPriceHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSpotPriceHistory")
+}
+func (unimplementedEC2) DescribeStaleSecurityGroups(_ *ec2sdk.DescribeStaleSecurityGroupsInput) (*ec2sdk.DescribeStaleSecurityGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeStaleSecurityGroups")
+}
+func (unimplementedEC2) DescribeStaleSecurityGroupsPages(_ *ec2sdk.DescribeStaleSecurityGroupsInput, _ func(*ec2sdk.DescribeStaleSecurityGroupsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeStaleSecurityGroups")
+}
+func (unimplementedEC2) DescribeStaleSecurityGroupsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeStaleSecurityGroupsInput, _ func(*ec2sdk.DescribeStaleSecurityGroupsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeStaleSecurityGroups")
+}
+func (unimplementedEC2) DescribeStaleSecurityGroupsRequest(_ *ec2sdk.DescribeStaleSecurityGroupsInput) (*request.Request, *ec2sdk.DescribeStaleSecurityGroupsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeStaleSecurityGroups"), nil
+}
+func (unimplementedEC2) DescribeStaleSecurityGroupsWithContext(_ context.Context, _ *ec2sdk.DescribeStaleSecurityGroupsInput, _ ...request.Option) (*ec2sdk.DescribeStaleSecurityGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeStaleSecurityGroups")
+}
+func (unimplementedEC2) DescribeStoreImageTasks(_ *ec2sdk.DescribeStoreImageTasksInput) (*ec2sdk.DescribeStoreImageTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeStoreImageTasks")
+}
+func (unimplementedEC2) DescribeStoreImageTasksPages(_ *ec2sdk.DescribeStoreImageTasksInput, _ func(*ec2sdk.DescribeStoreImageTasksOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeStoreImageTasks")
+}
+func (unimplementedEC2) DescribeStoreImageTasksPagesWithContext(_ context.Context, _ *ec2sdk.DescribeStoreImageTasksInput, _ func(*ec2sdk.DescribeStoreImageTasksOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeStoreImageTasks")
+}
+func (unimplementedEC2) DescribeStoreImageTasksRequest(_ *ec2sdk.DescribeStoreImageTasksInput) (*request.Request, *ec2sdk.DescribeStoreImageTasksOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeStoreImageTasks"), nil
+}
+func (unimplementedEC2) DescribeStoreImageTasksWithContext(_ context.Context, _ *ec2sdk.DescribeStoreImageTasksInput, _ ...request.Option) (*ec2sdk.DescribeStoreImageTasksOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeStoreImageTasks")
+}
+func (unimplementedEC2) DescribeSubnets(_ *ec2sdk.DescribeSubnetsInput) (*ec2sdk.DescribeSubnetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSubnets")
+}
+func (unimplementedEC2) DescribeSubnetsPages(_ *ec2sdk.DescribeSubnetsInput, _ func(*ec2sdk.DescribeSubnetsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSubnets")
+}
+func (unimplementedEC2) DescribeSubnetsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeSubnetsInput, _ func(*ec2sdk.DescribeSubnetsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeSubnets")
+}
+func (unimplementedEC2) DescribeSubnetsRequest(_ *ec2sdk.DescribeSubnetsInput) (*request.Request, *ec2sdk.DescribeSubnetsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeSubnets"), nil
+}
+func (unimplementedEC2) DescribeSubnetsWithContext(_ context.Context, _ *ec2sdk.DescribeSubnetsInput, _ ...request.Option) (*ec2sdk.DescribeSubnetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeSubnets")
+}
+func (unimplementedEC2) DescribeTags(_ *ec2sdk.DescribeTagsInput) (*ec2sdk.DescribeTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTags")
+}
+func (unimplementedEC2) DescribeTagsPages(_ *ec2sdk.DescribeTagsInput, _ func(*ec2sdk.DescribeTagsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTags")
+}
+func (unimplementedEC2) DescribeTagsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTagsInput, _ func(*ec2sdk.DescribeTagsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTags")
+}
+func (unimplementedEC2) DescribeTagsRequest(_ *ec2sdk.DescribeTagsInput) (*request.Request, *ec2sdk.DescribeTagsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTags"), nil
+}
+func (unimplementedEC2) DescribeTagsWithContext(_ context.Context, _ *ec2sdk.DescribeTagsInput, _ ...request.Option) (*ec2sdk.DescribeTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTags")
+}
+func (unimplementedEC2) DescribeTrafficMirrorFilters(_ *ec2sdk.DescribeTrafficMirrorFiltersInput) (*ec2sdk.DescribeTrafficMirrorFiltersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorFilters")
+}
+func (unimplementedEC2) DescribeTrafficMirrorFiltersPages(_ *ec2sdk.DescribeTrafficMirrorFiltersInput, _ func(*ec2sdk.DescribeTrafficMirrorFiltersOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorFilters")
+}
+func (unimplementedEC2) DescribeTrafficMirrorFiltersPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTrafficMirrorFiltersInput, _ func(*ec2sdk.DescribeTrafficMirrorFiltersOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorFilters")
+}
+func (unimplementedEC2) DescribeTrafficMirrorFiltersRequest(_ *ec2sdk.DescribeTrafficMirrorFiltersInput) (*request.Request, *ec2sdk.DescribeTrafficMirrorFiltersOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTrafficMirrorFilters"), nil
+}
+func (unimplementedEC2) DescribeTrafficMirrorFiltersWithContext(_ context.Context, _ *ec2sdk.DescribeTrafficMirrorFiltersInput, _ ...request.Option) (*ec2sdk.DescribeTrafficMirrorFiltersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorFilters")
+}
+func (unimplementedEC2) DescribeTrafficMirrorSessions(_ *ec2sdk.DescribeTrafficMirrorSessionsInput) (*ec2sdk.DescribeTrafficMirrorSessionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorSessions")
+}
+func (unimplementedEC2) DescribeTrafficMirrorSessionsPages(_ *ec2sdk.DescribeTrafficMirrorSessionsInput, _ func(*ec2sdk.DescribeTrafficMirrorSessionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorSessions")
+}
+func (unimplementedEC2) DescribeTrafficMirrorSessionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTrafficMirrorSessionsInput, _ func(*ec2sdk.DescribeTrafficMirrorSessionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorSessions")
+}
+func (unimplementedEC2) DescribeTrafficMirrorSessionsRequest(_ *ec2sdk.DescribeTrafficMirrorSessionsInput) (*request.Request, *ec2sdk.DescribeTrafficMirrorSessionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTrafficMirrorSessions"), nil
+}
+func (unimplementedEC2) DescribeTrafficMirrorSessionsWithContext(_ context.Context, _ *ec2sdk.DescribeTrafficMirrorSessionsInput, _ ...request.Option) (*ec2sdk.DescribeTrafficMirrorSessionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorSessions")
+}
+func (unimplementedEC2) DescribeTrafficMirrorTargets(_ *ec2sdk.DescribeTrafficMirrorTargetsInput) (*ec2sdk.DescribeTrafficMirrorTargetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorTargets")
+}
+func (unimplementedEC2) DescribeTrafficMirrorTargetsPages(_ *ec2sdk.DescribeTrafficMirrorTargetsInput, _ func(*ec2sdk.DescribeTrafficMirrorTargetsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorTargets")
+}
+func (unimplementedEC2) DescribeTrafficMirrorTargetsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTrafficMirrorTargetsInput, _ func(*ec2sdk.DescribeTrafficMirrorTargetsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorTargets")
+}
+func (unimplementedEC2) DescribeTrafficMirrorTargetsRequest(_ *ec2sdk.DescribeTrafficMirrorTargetsInput) (*request.Request, *ec2sdk.DescribeTrafficMirrorTargetsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTrafficMirrorTargets"), nil
+}
+func (unimplementedEC2) DescribeTrafficMirrorTargetsWithContext(_ context.Context, _ *ec2sdk.DescribeTrafficMirrorTargetsInput, _ ...request.Option) (*ec2sdk.DescribeTrafficMirrorTargetsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrafficMirrorTargets")
+}
+func (unimplementedEC2) DescribeTransitGatewayAttachments(_ *ec2sdk.DescribeTransitGatewayAttachmentsInput) (*ec2sdk.DescribeTransitGatewayAttachmentsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayAttachmentsPages(_ *ec2sdk.DescribeTransitGatewayAttachmentsInput, _ func(*ec2sdk.DescribeTransitGatewayAttachmentsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayAttachmentsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayAttachmentsInput, _ func(*ec2sdk.DescribeTransitGatewayAttachmentsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayAttachmentsRequest(_ *ec2sdk.DescribeTransitGatewayAttachmentsInput) (*request.Request, *ec2sdk.DescribeTransitGatewayAttachmentsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayAttachments"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayAttachmentsWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayAttachmentsInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayAttachmentsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectPeers(_ *ec2sdk.DescribeTransitGatewayConnectPeersInput) (*ec2sdk.DescribeTransitGatewayConnectPeersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnectPeers")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectPeersPages(_ *ec2sdk.DescribeTransitGatewayConnectPeersInput, _ func(*ec2sdk.DescribeTransitGatewayConnectPeersOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnectPeers")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectPeersPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayConnectPeersInput, _ func(*ec2sdk.DescribeTransitGatewayConnectPeersOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnectPeers")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectPeersRequest(_ *ec2sdk.DescribeTransitGatewayConnectPeersInput) (*request.Request, *ec2sdk.DescribeTransitGatewayConnectPeersOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayConnectPeers"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectPeersWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayConnectPeersInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayConnectPeersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnectPeers")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnects(_ *ec2sdk.DescribeTransitGatewayConnectsInput) (*ec2sdk.DescribeTransitGatewayConnectsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnects")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectsPages(_ *ec2sdk.DescribeTransitGatewayConnectsInput, _ func(*ec2sdk.DescribeTransitGatewayConnectsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnects")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayConnectsInput, _ func(*ec2sdk.DescribeTransitGatewayConnectsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnects")
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectsRequest(_ *ec2sdk.DescribeTransitGatewayConnectsInput) (*request.Request, *ec2sdk.DescribeTransitGatewayConnectsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayConnects"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayConnectsWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayConnectsInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayConnectsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayConnects")
+}
+func (unimplementedEC2) DescribeTransitGatewayMulticastDomains(_ *ec2sdk.DescribeTransitGatewayMulticastDomainsInput) (*ec2sdk.DescribeTransitGatewayMulticastDomainsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayMulticastDomains")
+}
+func (unimplementedEC2) DescribeTransitGatewayMulticastDomainsPages(_ *ec2sdk.DescribeTransitGatewayMulticastDomainsInput, _ func(*ec2sdk.DescribeTransitGatewayMulticastDomainsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayMulticastDomains")
+}
+func (unimplementedEC2) DescribeTransitGatewayMulticastDomainsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayMulticastDomainsInput, _ func(*ec2sdk.DescribeTransitGatewayMulticastDomainsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayMulticastDomains")
+}
+func (unimplementedEC2) DescribeTransitGatewayMulticastDomainsRequest(_ *ec2sdk.DescribeTransitGatewayMulticastDomainsInput) (*request.Request, *ec2sdk.DescribeTransitGatewayMulticastDomainsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayMulticastDomains"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayMulticastDomainsWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayMulticastDomainsInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayMulticastDomainsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayMulticastDomains")
+}
+func (unimplementedEC2) DescribeTransitGatewayPeeringAttachments(_ *ec2sdk.DescribeTransitGatewayPeeringAttachmentsInput) (*ec2sdk.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPeeringAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayPeeringAttachmentsPages(_ *ec2sdk.DescribeTransitGatewayPeeringAttachmentsInput, _ func(*ec2sdk.DescribeTransitGatewayPeeringAttachmentsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPeeringAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayPeeringAttachmentsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayPeeringAttachmentsInput, _ func(*ec2sdk.DescribeTransitGatewayPeeringAttachmentsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPeeringAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayPeeringAttachmentsRequest(_ *ec2sdk.DescribeTransitGatewayPeeringAttachmentsInput) (*request.Request, *ec2sdk.DescribeTransitGatewayPeeringAttachmentsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayPeeringAttachments"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayPeeringAttachmentsWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayPeeringAttachmentsInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPeeringAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayPolicyTables(_ *ec2sdk.DescribeTransitGatewayPolicyTablesInput) (*ec2sdk.DescribeTransitGatewayPolicyTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPolicyTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayPolicyTablesPages(_ *ec2sdk.DescribeTransitGatewayPolicyTablesInput, _ func(*ec2sdk.DescribeTransitGatewayPolicyTablesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPolicyTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayPolicyTablesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayPolicyTablesInput, _ func(*ec2sdk.DescribeTransitGatewayPolicyTablesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPolicyTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayPolicyTablesRequest(_ *ec2sdk.DescribeTransitGatewayPolicyTablesInput) (*request.Request, *ec2sdk.DescribeTransitGatewayPolicyTablesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayPolicyTables"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayPolicyTablesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayPolicyTablesInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayPolicyTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayPolicyTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTableAnnouncements(_ *ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsInput) (*ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTableAnnouncements")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTableAnnouncementsPages(_ *ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsInput, _ func(*ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTableAnnouncements")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTableAnnouncementsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsInput, _ func(*ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTableAnnouncements")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTableAnnouncementsRequest(_ *ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsInput) (*request.Request, *ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayRouteTableAnnouncements"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTableAnnouncementsWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayRouteTableAnnouncementsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTableAnnouncements")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTables(_ *ec2sdk.DescribeTransitGatewayRouteTablesInput) (*ec2sdk.DescribeTransitGatewayRouteTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTablesPages(_ *ec2sdk.DescribeTransitGatewayRouteTablesInput, _ func(*ec2sdk.DescribeTransitGatewayRouteTablesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTablesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayRouteTablesInput, _ func(*ec2sdk.DescribeTransitGatewayRouteTablesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTablesRequest(_ *ec2sdk.DescribeTransitGatewayRouteTablesInput) (*request.Request, *ec2sdk.DescribeTransitGatewayRouteTablesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayRouteTables"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayRouteTablesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayRouteTablesInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayRouteTablesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayRouteTables")
+}
+func (unimplementedEC2) DescribeTransitGatewayVpcAttachments(_ *ec2sdk.DescribeTransitGatewayVpcAttachmentsInput) (*ec2sdk.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayVpcAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayVpcAttachmentsPages(_ *ec2sdk.DescribeTransitGatewayVpcAttachmentsInput, _ func(*ec2sdk.DescribeTransitGatewayVpcAttachmentsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayVpcAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayVpcAttachmentsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayVpcAttachmentsInput, _ func(*ec2sdk.DescribeTransitGatewayVpcAttachmentsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGatewayVpcAttachments")
+}
+func (unimplementedEC2) DescribeTransitGatewayVpcAttachmentsRequest(_ *ec2sdk.DescribeTransitGatewayVpcAttachmentsInput) (*request.Request, *ec2sdk.DescribeTransitGatewayVpcAttachmentsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGatewayVpcAttachments"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewayVpcAttachmentsWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewayVpcAttachmentsInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGatewayVpcAttachments")
+}
+func (unimplementedEC2) DescribeTransitGateways(_ *ec2sdk.DescribeTransitGatewaysInput) (*ec2sdk.DescribeTransitGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGateways")
+}
+func (unimplementedEC2) DescribeTransitGatewaysPages(_ *ec2sdk.DescribeTransitGatewaysInput, _ func(*ec2sdk.DescribeTransitGatewaysOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGateways")
+}
+func (unimplementedEC2) DescribeTransitGatewaysPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewaysInput, _ func(*ec2sdk.DescribeTransitGatewaysOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTransitGateways")
+}
+func (unimplementedEC2) DescribeTransitGatewaysRequest(_ *ec2sdk.DescribeTransitGatewaysInput) (*request.Request, *ec2sdk.DescribeTransitGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTransitGateways"), nil
+}
+func (unimplementedEC2) DescribeTransitGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeTransitGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeTransitGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTransitGateways")
+}
+func (unimplementedEC2) DescribeTrunkInterfaceAssociations(_ *ec2sdk.DescribeTrunkInterfaceAssociationsInput) (*ec2sdk.DescribeTrunkInterfaceAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrunkInterfaceAssociations")
+}
+func (unimplementedEC2) DescribeTrunkInterfaceAssociationsPages(_ *ec2sdk.DescribeTrunkInterfaceAssociationsInput, _ func(*ec2sdk.DescribeTrunkInterfaceAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrunkInterfaceAssociations")
+}
+func (unimplementedEC2) DescribeTrunkInterfaceAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeTrunkInterfaceAssociationsInput, _ func(*ec2sdk.DescribeTrunkInterfaceAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeTrunkInterfaceAssociations")
+}
+func (unimplementedEC2) DescribeTrunkInterfaceAssociationsRequest(_ *ec2sdk.DescribeTrunkInterfaceAssociationsInput) (*request.Request, *ec2sdk.DescribeTrunkInterfaceAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeTrunkInterfaceAssociations"), nil
+}
+func (unimplementedEC2) DescribeTrunkInterfaceAssociationsWithContext(_ context.Context, _ *ec2sdk.DescribeTrunkInterfaceAssociationsInput, _ ...request.Option) (*ec2sdk.DescribeTrunkInterfaceAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeTrunkInterfaceAssociations")
+}
+func (unimplementedEC2) DescribeVerifiedAccessEndpoints(_ *ec2sdk.DescribeVerifiedAccessEndpointsInput) (*ec2sdk.DescribeVerifiedAccessEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessEndpoints")
+}
+func (unimplementedEC2) DescribeVerifiedAccessEndpointsPages(_ *ec2sdk.DescribeVerifiedAccessEndpointsInput, _ func(*ec2sdk.DescribeVerifiedAccessEndpointsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessEndpoints")
+}
+func (unimplementedEC2) DescribeVerifiedAccessEndpointsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessEndpointsInput, _ func(*ec2sdk.DescribeVerifiedAccessEndpointsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessEndpoints")
+}
+func (unimplementedEC2) DescribeVerifiedAccessEndpointsRequest(_ *ec2sdk.DescribeVerifiedAccessEndpointsInput) (*request.Request, *ec2sdk.DescribeVerifiedAccessEndpointsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVerifiedAccessEndpoints"), nil
+}
+func (unimplementedEC2) DescribeVerifiedAccessEndpointsWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessEndpointsInput, _ ...request.Option) (*ec2sdk.DescribeVerifiedAccessEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessEndpoints")
+}
+func (unimplementedEC2) DescribeVerifiedAccessGroups(_ *ec2sdk.DescribeVerifiedAccessGroupsInput) (*ec2sdk.DescribeVerifiedAccessGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessGroups")
+}
+func (unimplementedEC2) DescribeVerifiedAccessGroupsPages(_ *ec2sdk.DescribeVerifiedAccessGroupsInput, _ func(*ec2sdk.DescribeVerifiedAccessGroupsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessGroups")
+}
+func (unimplementedEC2) DescribeVerifiedAccessGroupsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessGroupsInput, _ func(*ec2sdk.DescribeVerifiedAccessGroupsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessGroups")
+}
+func (unimplementedEC2) DescribeVerifiedAccessGroupsRequest(_ *ec2sdk.DescribeVerifiedAccessGroupsInput) (*request.Request, *ec2sdk.DescribeVerifiedAccessGroupsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVerifiedAccessGroups"), nil
+}
+func (unimplementedEC2) DescribeVerifiedAccessGroupsWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessGroupsInput, _ ...request.Option) (*ec2sdk.DescribeVerifiedAccessGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessGroups")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstanceLoggingConfigurations(_ *ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsInput) (*ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstanceLoggingConfigurations")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstanceLoggingConfigurationsPages(_ *ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsInput, _ func(*ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstanceLoggingConfigurations")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstanceLoggingConfigurationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsInput, _ func(*ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstanceLoggingConfigurations")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstanceLoggingConfigurationsRequest(_ *ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsInput) (*request.Request, *ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVerifiedAccessInstanceLoggingConfigurations"), nil
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstanceLoggingConfigurationsWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsInput, _ ...request.Option) (*ec2sdk.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstanceLoggingConfigurations")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstances(_ *ec2sdk.DescribeVerifiedAccessInstancesInput) (*ec2sdk.DescribeVerifiedAccessInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstances")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstancesPages(_ *ec2sdk.DescribeVerifiedAccessInstancesInput, _ func(*ec2sdk.DescribeVerifiedAccessInstancesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstances")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstancesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessInstancesInput, _ func(*ec2sdk.DescribeVerifiedAccessInstancesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstances")
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstancesRequest(_ *ec2sdk.DescribeVerifiedAccessInstancesInput) (*request.Request, *ec2sdk.DescribeVerifiedAccessInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVerifiedAccessInstances"), nil
+}
+func (unimplementedEC2) DescribeVerifiedAccessInstancesWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessInstancesInput, _ ...request.Option) (*ec2sdk.DescribeVerifiedAccessInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessInstances")
+}
+func (unimplementedEC2) DescribeVerifiedAccessTrustProviders(_ *ec2sdk.DescribeVerifiedAccessTrustProvidersInput) (*ec2sdk.DescribeVerifiedAccessTrustProvidersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessTrustProviders")
+}
+func (unimplementedEC2) DescribeVerifiedAccessTrustProvidersPages(_ *ec2sdk.DescribeVerifiedAccessTrustProvidersInput, _ func(*ec2sdk.DescribeVerifiedAccessTrustProvidersOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessTrustProviders")
+}
+func (unimplementedEC2) DescribeVerifiedAccessTrustProvidersPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessTrustProvidersInput, _ func(*ec2sdk.DescribeVerifiedAccessTrustProvidersOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessTrustProviders")
+}
+func (unimplementedEC2) DescribeVerifiedAccessTrustProvidersRequest(_ *ec2sdk.DescribeVerifiedAccessTrustProvidersInput) (*request.Request, *ec2sdk.DescribeVerifiedAccessTrustProvidersOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVerifiedAccessTrustProviders"), nil
+}
+func (unimplementedEC2) DescribeVerifiedAccessTrustProvidersWithContext(_ context.Context, _ *ec2sdk.DescribeVerifiedAccessTrustProvidersInput, _ ...request.Option) (*ec2sdk.DescribeVerifiedAccessTrustProvidersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVerifiedAccessTrustProviders")
+}
+func (unimplementedEC2) DescribeVolumeAttribute(_ *ec2sdk.DescribeVolumeAttributeInput) (*ec2sdk.DescribeVolumeAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumeAttribute")
+}
+func (unimplementedEC2) DescribeVolumeAttributeRequest(_ *ec2sdk.DescribeVolumeAttributeInput) (*request.Request, *ec2sdk.DescribeVolumeAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVolumeAttribute"), nil
+}
+func (unimplementedEC2) DescribeVolumeAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeVolumeAttributeInput, _ ...request.Option) (*ec2sdk.DescribeVolumeAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumeAttribute")
+}
+func (unimplementedEC2) DescribeVolumeStatus(_ *ec2sdk.DescribeVolumeStatusInput) (*ec2sdk.DescribeVolumeStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumeStatus")
+}
+func (unimplementedEC2) DescribeVolumeStatusPages(_ *ec2sdk.DescribeVolumeStatusInput, _ func(*ec2sdk.DescribeVolumeStatusOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVolumeStatus")
+}
+func (unimplementedEC2) DescribeVolumeStatusPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVolumeStatusInput, _ func(*ec2sdk.DescribeVolumeStatusOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVolumeStatus")
+}
+func (unimplementedEC2) DescribeVolumeStatusRequest(_ *ec2sdk.DescribeVolumeStatusInput) (*request.Request, *ec2sdk.DescribeVolumeStatusOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVolumeStatus"), nil
+}
+func (unimplementedEC2) DescribeVolumeStatusWithContext(_ context.Context, _ *ec2sdk.DescribeVolumeStatusInput, _ ...request.Option) (*ec2sdk.DescribeVolumeStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumeStatus")
+}
+func (unimplementedEC2) DescribeVolumes(_ *ec2sdk.DescribeVolumesInput) (*ec2sdk.DescribeVolumesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumes")
+}
+func (unimplementedEC2) DescribeVolumesModifications(_ *ec2sdk.DescribeVolumesModificationsInput) (*ec2sdk.DescribeVolumesModificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumesModifications")
+}
+func (unimplementedEC2) DescribeVolumesModificationsPages(_ *ec2sdk.DescribeVolumesModificationsInput, _ func(*ec2sdk.DescribeVolumesModificationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVolumesModifications")
+}
+func (unimplementedEC2) DescribeVolumesModificationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVolumesModificationsInput, _ func(*ec2sdk.DescribeVolumesModificationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVolumesModifications")
+}
+func (unimplementedEC2) DescribeVolumesModificationsRequest(_ *ec2sdk.DescribeVolumesModificationsInput) (*request.Request, *ec2sdk.DescribeVolumesModificationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVolumesModifications"), nil
+}
+func (unimplementedEC2) DescribeVolumesModificationsWithContext(_ context.Context, _ *ec2sdk.DescribeVolumesModificationsInput, _ ...request.Option) (*ec2sdk.DescribeVolumesModificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumesModifications")
+}
+func (unimplementedEC2) DescribeVolumesPages(_ *ec2sdk.DescribeVolumesInput, _ func(*ec2sdk.DescribeVolumesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVolumes")
+}
+func (unimplementedEC2) DescribeVolumesPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVolumesInput, _ func(*ec2sdk.DescribeVolumesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVolumes")
+}
+func (unimplementedEC2) DescribeVolumesRequest(_ *ec2sdk.DescribeVolumesInput) (*request.Request, *ec2sdk.DescribeVolumesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVolumes"), nil
+}
+func (unimplementedEC2) DescribeVolumesWithContext(_ context.Context, _ *ec2sdk.DescribeVolumesInput, _ ...request.Option) (*ec2sdk.DescribeVolumesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVolumes")
+}
+func (unimplementedEC2) DescribeVpcAttribute(_ *ec2sdk.DescribeVpcAttributeInput) (*ec2sdk.DescribeVpcAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcAttribute")
+}
+func (unimplementedEC2) DescribeVpcAttributeRequest(_ *ec2sdk.DescribeVpcAttributeInput) (*request.Request, *ec2sdk.DescribeVpcAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcAttribute"), nil
+}
+func (unimplementedEC2) DescribeVpcAttributeWithContext(_ context.Context, _ *ec2sdk.DescribeVpcAttributeInput, _ ...request.Option) (*ec2sdk.DescribeVpcAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcAttribute")
+}
+func (unimplementedEC2) DescribeVpcClassicLink(_ *ec2sdk.DescribeVpcClassicLinkInput) (*ec2sdk.DescribeVpcClassicLinkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcClassicLink")
+}
+func (unimplementedEC2) DescribeVpcClassicLinkDnsSupport(_ *ec2sdk.DescribeVpcClassicLinkDnsSupportInput) (*ec2sdk.DescribeVpcClassicLinkDnsSupportOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) DescribeVpcClassicLinkDnsSupportPages(_ *ec2sdk.DescribeVpcClassicLinkDnsSupportInput, _ func(*ec2sdk.DescribeVpcClassicLinkDnsSupportOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) DescribeVpcClassicLinkDnsSupportPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcClassicLinkDnsSupportInput, _ func(*ec2sdk.DescribeVpcClassicLinkDnsSupportOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) DescribeVpcClassicLinkDnsSupportRequest(_ *ec2sdk.DescribeVpcClassicLinkDnsSupportInput) (*request.Request, *ec2sdk.DescribeVpcClassicLinkDnsSupportOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcClassicLinkDnsSupport"), nil
+}
+func (unimplementedEC2) DescribeVpcClassicLinkDnsSupportWithContext(_ context.Context, _ *ec2sdk.DescribeVpcClassicLinkDnsSupportInput, _ ...request.Option) (*ec2sdk.DescribeVpcClassicLinkDnsSupportOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) DescribeVpcClassicLinkRequest(_ *ec2sdk.DescribeVpcClassicLinkInput) (*request.Request, *ec2sdk.DescribeVpcClassicLinkOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcClassicLink"), nil
+}
+func (unimplementedEC2) DescribeVpcClassicLinkWithContext(_ context.Context, _ *ec2sdk.DescribeVpcClassicLinkInput, _ ...request.Option) (*ec2sdk.DescribeVpcClassicLinkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcClassicLink")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionNotifications(_ *ec2sdk.DescribeVpcEndpointConnectionNotificationsInput) (*ec2sdk.DescribeVpcEndpointConnectionNotificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnectionNotifications")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionNotificationsPages(_ *ec2sdk.DescribeVpcEndpointConnectionNotificationsInput, _ func(*ec2sdk.DescribeVpcEndpointConnectionNotificationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnectionNotifications")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionNotificationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointConnectionNotificationsInput, _ func(*ec2sdk.DescribeVpcEndpointConnectionNotificationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnectionNotifications")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionNotificationsRequest(_ *ec2sdk.DescribeVpcEndpointConnectionNotificationsInput) (*request.Request, *ec2sdk.DescribeVpcEndpointConnectionNotificationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcEndpointConnectionNotifications"), nil
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionNotificationsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointConnectionNotificationsInput, _ ...request.Option) (*ec2sdk.DescribeVpcEndpointConnectionNotificationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnectionNotifications")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnections(_ *ec2sdk.DescribeVpcEndpointConnectionsInput) (*ec2sdk.DescribeVpcEndpointConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnections")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionsPages(_ *ec2sdk.DescribeVpcEndpointConnectionsInput, _ func(*ec2sdk.DescribeVpcEndpointConnectionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnections")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointConnectionsInput, _ func(*ec2sdk.DescribeVpcEndpointConnectionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnections")
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionsRequest(_ *ec2sdk.DescribeVpcEndpointConnectionsInput) (*request.Request, *ec2sdk.DescribeVpcEndpointConnectionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcEndpointConnections"), nil
+}
+func (unimplementedEC2) DescribeVpcEndpointConnectionsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointConnectionsInput, _ ...request.Option) (*ec2sdk.DescribeVpcEndpointConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointConnections")
+}
+func (unimplementedEC2) DescribeVpcEndpointServiceConfigurations(_ *ec2sdk.DescribeVpcEndpointServiceConfigurationsInput) (*ec2sdk.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServiceConfigurations")
+}
+func (unimplementedEC2) DescribeVpcEndpointServiceConfigurationsPages(_ *ec2sdk.DescribeVpcEndpointServiceConfigurationsInput, _ func(*ec2sdk.DescribeVpcEndpointServiceConfigurationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServiceConfigurations")
+}
+func (unimplementedEC2) DescribeVpcEndpointServiceConfigurationsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointServiceConfigurationsInput, _ func(*ec2sdk.DescribeVpcEndpointServiceConfigurationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServiceConfigurations")
+}
+func (unimplementedEC2) DescribeVpcEndpointServiceConfigurationsRequest(_ *ec2sdk.DescribeVpcEndpointServiceConfigurationsInput) (*request.Request, *ec2sdk.DescribeVpcEndpointServiceConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcEndpointServiceConfigurations"), nil
+}
+func (unimplementedEC2) DescribeVpcEndpointServiceConfigurationsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointServiceConfigurationsInput, _ ...request.Option) (*ec2sdk.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServiceConfigurations")
+}
+func (unimplementedEC2) DescribeVpcEndpointServicePermissions(_ *ec2sdk.DescribeVpcEndpointServicePermissionsInput) (*ec2sdk.DescribeVpcEndpointServicePermissionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServicePermissions")
+}
+func (unimplementedEC2) DescribeVpcEndpointServicePermissionsPages(_ *ec2sdk.DescribeVpcEndpointServicePermissionsInput, _ func(*ec2sdk.DescribeVpcEndpointServicePermissionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServicePermissions")
+}
+func (unimplementedEC2) DescribeVpcEndpointServicePermissionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointServicePermissionsInput, _ func(*ec2sdk.DescribeVpcEndpointServicePermissionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServicePermissions")
+}
+func (unimplementedEC2) DescribeVpcEndpointServicePermissionsRequest(_ *ec2sdk.DescribeVpcEndpointServicePermissionsInput) (*request.Request, *ec2sdk.DescribeVpcEndpointServicePermissionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcEndpointServicePermissions"), nil
+}
+func (unimplementedEC2) DescribeVpcEndpointServicePermissionsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointServicePermissionsInput, _ ...request.Option) (*ec2sdk.DescribeVpcEndpointServicePermissionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServicePermissions")
+}
+func (unimplementedEC2) DescribeVpcEndpointServices(_ *ec2sdk.DescribeVpcEndpointServicesInput) (*ec2sdk.DescribeVpcEndpointServicesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServices")
+}
+func (unimplementedEC2) DescribeVpcEndpointServicesRequest(_ *ec2sdk.DescribeVpcEndpointServicesInput) (*request.Request, *ec2sdk.DescribeVpcEndpointServicesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcEndpointServices"), nil
+}
+func (unimplementedEC2) DescribeVpcEndpointServicesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointServicesInput, _ ...request.Option) (*ec2sdk.DescribeVpcEndpointServicesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpointServices")
+}
+func (unimplementedEC2) DescribeVpcEndpoints(_ *ec2sdk.DescribeVpcEndpointsInput) (*ec2sdk.DescribeVpcEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpoints")
+}
+func (unimplementedEC2) DescribeVpcEndpointsPages(_ *ec2sdk.DescribeVpcEndpointsInput, _ func(*ec2sdk.DescribeVpcEndpointsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpoints")
+}
+func (unimplementedEC2) DescribeVpcEndpointsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointsInput, _ func(*ec2sdk.DescribeVpcEndpointsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcEndpoints")
+}
+func (unimplementedEC2) DescribeVpcEndpointsRequest(_ *ec2sdk.DescribeVpcEndpointsInput) (*request.Request, *ec2sdk.DescribeVpcEndpointsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcEndpoints"), nil
+}
+func (unimplementedEC2) DescribeVpcEndpointsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcEndpointsInput, _ ...request.Option) (*ec2sdk.DescribeVpcEndpointsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcEndpoints")
+}
+func (unimplementedEC2) DescribeVpcPeeringConnections(_ *ec2sdk.DescribeVpcPeeringConnectionsInput) (*ec2sdk.DescribeVpcPeeringConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcPeeringConnections")
+}
+func (unimplementedEC2) DescribeVpcPeeringConnectionsPages(_ *ec2sdk.DescribeVpcPeeringConnectionsInput, _ func(*ec2sdk.DescribeVpcPeeringConnectionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcPeeringConnections")
+}
+func (unimplementedEC2) DescribeVpcPeeringConnectionsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcPeeringConnectionsInput, _ func(*ec2sdk.DescribeVpcPeeringConnectionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcPeeringConnections")
+}
+func (unimplementedEC2) DescribeVpcPeeringConnectionsRequest(_ *ec2sdk.DescribeVpcPeeringConnectionsInput) (*request.Request, *ec2sdk.DescribeVpcPeeringConnectionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcPeeringConnections"), nil
+}
+func (unimplementedEC2) DescribeVpcPeeringConnectionsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcPeeringConnectionsInput, _ ...request.Option) (*ec2sdk.DescribeVpcPeeringConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcPeeringConnections")
+}
+func (unimplementedEC2) DescribeVpcs(_ *ec2sdk.DescribeVpcsInput) (*ec2sdk.DescribeVpcsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcs")
+}
+func (unimplementedEC2) DescribeVpcsPages(_ *ec2sdk.DescribeVpcsInput, _ func(*ec2sdk.DescribeVpcsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcs")
+}
+func (unimplementedEC2) DescribeVpcsPagesWithContext(_ context.Context, _ *ec2sdk.DescribeVpcsInput, _ func(*ec2sdk.DescribeVpcsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "DescribeVpcs")
+}
+func (unimplementedEC2) DescribeVpcsRequest(_ *ec2sdk.DescribeVpcsInput) (*request.Request, *ec2sdk.DescribeVpcsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpcs"), nil
+}
+func (unimplementedEC2) DescribeVpcsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcsInput, _ ...request.Option) (*ec2sdk.DescribeVpcsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpcs")
+}
+func (unimplementedEC2) DescribeVpnConnections(_ *ec2sdk.DescribeVpnConnectionsInput) (*ec2sdk.DescribeVpnConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpnConnections")
+}
+func (unimplementedEC2) DescribeVpnConnectionsRequest(_ *ec2sdk.DescribeVpnConnectionsInput) (*request.Request, *ec2sdk.DescribeVpnConnectionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpnConnections"), nil
+}
+func (unimplementedEC2) DescribeVpnConnectionsWithContext(_ context.Context, _ *ec2sdk.DescribeVpnConnectionsInput, _ ...request.Option) (*ec2sdk.DescribeVpnConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpnConnections")
+}
+func (unimplementedEC2) DescribeVpnGateways(_ *ec2sdk.DescribeVpnGatewaysInput) (*ec2sdk.DescribeVpnGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpnGateways")
+}
+func (unimplementedEC2) DescribeVpnGatewaysRequest(_ *ec2sdk.DescribeVpnGatewaysInput) (*request.Request, *ec2sdk.DescribeVpnGatewaysOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DescribeVpnGateways"), nil
+}
+func (unimplementedEC2) DescribeVpnGatewaysWithContext(_ context.Context, _ *ec2sdk.DescribeVpnGatewaysInput, _ ...request.Option) (*ec2sdk.DescribeVpnGatewaysOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DescribeVpnGateways")
+}
+func (unimplementedEC2) DetachClassicLinkVpc(_ *ec2sdk.DetachClassicLinkVpcInput) (*ec2sdk.DetachClassicLinkVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachClassicLinkVpc")
+}
+func (unimplementedEC2) DetachClassicLinkVpcRequest(_ *ec2sdk.DetachClassicLinkVpcInput) (*request.Request, *ec2sdk.DetachClassicLinkVpcOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DetachClassicLinkVpc"), nil
+}
+func (unimplementedEC2) DetachClassicLinkVpcWithContext(_ context.Context, _ *ec2sdk.DetachClassicLinkVpcInput, _ ...request.Option) (*ec2sdk.DetachClassicLinkVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachClassicLinkVpc")
+}
+func (unimplementedEC2) DetachInternetGateway(_ *ec2sdk.DetachInternetGatewayInput) (*ec2sdk.DetachInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachInternetGateway")
+}
+func (unimplementedEC2) DetachInternetGatewayRequest(_ *ec2sdk.DetachInternetGatewayInput) (*request.Request, *ec2sdk.DetachInternetGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DetachInternetGateway"), nil
+}
+func (unimplementedEC2) DetachInternetGatewayWithContext(_ context.Context, _ *ec2sdk.DetachInternetGatewayInput, _ ...request.Option) (*ec2sdk.DetachInternetGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachInternetGateway")
+}
+func (unimplementedEC2) DetachNetworkInterface(_ *ec2sdk.DetachNetworkInterfaceInput) (*ec2sdk.DetachNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachNetworkInterface")
+}
+func (unimplementedEC2) DetachNetworkInterfaceRequest(_ *ec2sdk.DetachNetworkInterfaceInput) (*request.Request, *ec2sdk.DetachNetworkInterfaceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DetachNetworkInterface"), nil
+}
+func (unimplementedEC2) DetachNetworkInterfaceWithContext(_ context.Context, _ *ec2sdk.DetachNetworkInterfaceInput, _ ...request.Option) (*ec2sdk.DetachNetworkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachNetworkInterface")
+}
+func (unimplementedEC2) DetachVerifiedAccessTrustProvider(_ *ec2sdk.DetachVerifiedAccessTrustProviderInput) (*ec2sdk.DetachVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) DetachVerifiedAccessTrustProviderRequest(_ *ec2sdk.DetachVerifiedAccessTrustProviderInput) (*request.Request, *ec2sdk.DetachVerifiedAccessTrustProviderOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DetachVerifiedAccessTrustProvider"), nil
+}
+func (unimplementedEC2) DetachVerifiedAccessTrustProviderWithContext(_ context.Context, _ *ec2sdk.DetachVerifiedAccessTrustProviderInput, _ ...request.Option) (*ec2sdk.DetachVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) DetachVolume(_ *ec2sdk.DetachVolumeInput) (*ec2sdk.VolumeAttachment, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachVolume")
+}
+func (unimplementedEC2) DetachVolumeRequest(_ *ec2sdk.DetachVolumeInput) (*request.Request, *ec2sdk.VolumeAttachment) {
+	return newNotImplementedRequest(ServiceEC2, "DetachVolume"), nil
+}
+func (unimplementedEC2) DetachVolumeWithContext(_ context.Context, _ *ec2sdk.DetachVolumeInput, _ ...request.Option) (*ec2sdk.VolumeAttachment, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachVolume")
+}
+func (unimplementedEC2) DetachVpnGateway(_ *ec2sdk.DetachVpnGatewayInput) (*ec2sdk.DetachVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachVpnGateway")
+}
+func (unimplementedEC2) DetachVpnGatewayRequest(_ *ec2sdk.DetachVpnGatewayInput) (*request.Request, *ec2sdk.DetachVpnGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DetachVpnGateway"), nil
+}
+func (unimplementedEC2) DetachVpnGatewayWithContext(_ context.Context, _ *ec2sdk.DetachVpnGatewayInput, _ ...request.Option) (*ec2sdk.DetachVpnGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DetachVpnGateway")
+}
+func (unimplementedEC2) DisableAddressTransfer(_ *ec2sdk.DisableAddressTransferInput) (*ec2sdk.DisableAddressTransferOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableAddressTransfer")
+}
+func (unimplementedEC2) DisableAddressTransferRequest(_ *ec2sdk.DisableAddressTransferInput) (*request.Request, *ec2sdk.DisableAddressTransferOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableAddressTransfer"), nil
+}
+func (unimplementedEC2) DisableAddressTransferWithContext(_ context.Context, _ *ec2sdk.DisableAddressTransferInput, _ ...request.Option) (*ec2sdk.DisableAddressTransferOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableAddressTransfer")
+}
+func (unimplementedEC2) DisableAwsNetworkPerformanceMetricSubscription(_ *ec2sdk.DisableAwsNetworkPerformanceMetricSubscriptionInput) (*ec2sdk.DisableAwsNetworkPerformanceMetricSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableAwsNetworkPerformanceMetricSubscription")
+}
+func (unimplementedEC2) DisableAwsNetworkPerformanceMetricSubscriptionRequest(_ *ec2sdk.DisableAwsNetworkPerformanceMetricSubscriptionInput) (*request.Request, *ec2sdk.DisableAwsNetworkPerformanceMetricSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableAwsNetworkPerformanceMetricSubscription"), nil
+}
+func (unimplementedEC2) DisableAwsNetworkPerformanceMetricSubscriptionWithContext(_ context.Context, _ *ec2sdk.DisableAwsNetworkPerformanceMetricSubscriptionInput, _ ...request.Option) (*ec2sdk.DisableAwsNetworkPerformanceMetricSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableAwsNetworkPerformanceMetricSubscription")
+}
+func (unimplementedEC2) DisableEbsEncryptionByDefault(_ *ec2sdk.DisableEbsEncryptionByDefaultInput) (*ec2sdk.DisableEbsEncryptionByDefaultOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableEbsEncryptionByDefault")
+}
+func (unimplementedEC2) DisableEbsEncryptionByDefaultRequest(_ *ec2sdk.DisableEbsEncryptionByDefaultInput) (*request.Request, *ec2sdk.DisableEbsEncryptionByDefaultOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableEbsEncryptionByDefault"), nil
+}
+func (unimplementedEC2) DisableEbsEncryptionByDefaultWithContext(_ context.Context, _ *ec2sdk.DisableEbsEncryptionByDefaultInput, _ ...request.Option) (*ec2sdk.DisableEbsEncryptionByDefaultOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableEbsEncryptionByDefault")
+}
+func (unimplementedEC2) DisableFastLaunch(_ *ec2sdk.DisableFastLaunchInput) (*ec2sdk.DisableFastLaunchOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableFastLaunch")
+}
+func (unimplementedEC2) DisableFastLaunchRequest(_ *ec2sdk.DisableFastLaunchInput) (*request.Request, *ec2sdk.DisableFastLaunchOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableFastLaunch"), nil
+}
+func (unimplementedEC2) DisableFastLaunchWithContext(_ context.Context, _ *ec2sdk.DisableFastLaunchInput, _ ...request.Option) (*ec2sdk.DisableFastLaunchOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableFastLaunch")
+}
+func (unimplementedEC2) DisableFastSnapshotRestores(_ *ec2sdk.DisableFastSnapshotRestoresInput) (*ec2sdk.DisableFastSnapshotRestoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableFastSnapshotRestores")
+}
+func (unimplementedEC2) DisableFastSnapshotRestoresRequest(_ *ec2sdk.DisableFastSnapshotRestoresInput) (*request.Request, *ec2sdk.DisableFastSnapshotRestoresOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableFastSnapshotRestores"), nil
+}
+func (unimplementedEC2) DisableFastSnapshotRestoresWithContext(_ context.Context, _ *ec2sdk.DisableFastSnapshotRestoresInput, _ ...request.Option) (*ec2sdk.DisableFastSnapshotRestoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableFastSnapshotRestores")
+}
+func (unimplementedEC2) DisableImage(_ *ec2sdk.DisableImageInput) (*ec2sdk.DisableImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableImage")
+}
+func (unimplementedEC2) DisableImageBlockPublicAccess(_ *ec2sdk.DisableImageBlockPublicAccessInput) (*ec2sdk.DisableImageBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableImageBlockPublicAccess")
+}
+func (unimplementedEC2) DisableImageBlockPublicAccessRequest(_ *ec2sdk.DisableImageBlockPublicAccessInput) (*request.Request, *ec2sdk.DisableImageBlockPublicAccessOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableImageBlockPublicAccess"), nil
+}
+func (unimplementedEC2) DisableImageBlockPublicAccessWithContext(_ context.Context, _ *ec2sdk.DisableImageBlockPublicAccessInput, _ ...request.Option) (*ec2sdk.DisableImageBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableImageBlockPublicAccess")
+}
+func (unimplementedEC2) DisableImageDeprecation(_ *ec2sdk.DisableImageDeprecationInput) (*ec2sdk.DisableImageDeprecationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableImageDeprecation")
+}
+func (unimplementedEC2) DisableImageDeprecationRequest(_ *ec2sdk.DisableImageDeprecationInput) (*request.Request, *ec2sdk.DisableImageDeprecationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableImageDeprecation"), nil
+}
+func (unimplementedEC2) DisableImageDeprecationWithContext(_ context.Context, _ *ec2sdk.DisableImageDeprecationInput, _ ...request.Option) (*ec2sdk.DisableImageDeprecationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableImageDeprecation")
+}
+func (unimplementedEC2) DisableImageRequest(_ *ec2sdk.DisableImageInput) (*request.Request, *ec2sdk.DisableImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableImage"), nil
+}
+func (unimplementedEC2) DisableImageWithContext(_ context.Context, _ *ec2sdk.DisableImageInput, _ ...request.Option) (*ec2sdk.DisableImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableImage")
+}
+func (unimplementedEC2) DisableIpamOrganizationAdminAccount(_ *ec2sdk.DisableIpamOrganizationAdminAccountInput) (*ec2sdk.DisableIpamOrganizationAdminAccountOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableIpamOrganizationAdminAccount")
+}
+func (unimplementedEC2) DisableIpamOrganizationAdminAccountRequest(_ *ec2sdk.DisableIpamOrganizationAdminAccountInput) (*request.Request, *ec2sdk.DisableIpamOrganizationAdminAccountOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableIpamOrganizationAdminAccount"), nil
+}
+func (unimplementedEC2) DisableIpamOrganizationAdminAccountWithContext(_ context.Context, _ *ec2sdk.DisableIpamOrganizationAdminAccountInput, _ ...request.Option) (*ec2sdk.DisableIpamOrganizationAdminAccountOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableIpamOrganizationAdminAccount")
+}
+func (unimplementedEC2) DisableSerialConsoleAccess(_ *ec2sdk.DisableSerialConsoleAccessInput) (*ec2sdk.DisableSerialConsoleAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableSerialConsoleAccess")
+}
+func (unimplementedEC2) DisableSerialConsoleAccessRequest(_ *ec2sdk.DisableSerialConsoleAccessInput) (*request.Request, *ec2sdk.DisableSerialConsoleAccessOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableSerialConsoleAccess"), nil
+}
+func (unimplementedEC2) DisableSerialConsoleAccessWithContext(_ context.Context, _ *ec2sdk.DisableSerialConsoleAccessInput, _ ...request.Option) (*ec2sdk.DisableSerialConsoleAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableSerialConsoleAccess")
+}
+func (unimplementedEC2) DisableSnapshotBlockPublicAccess(_ *ec2sdk.DisableSnapshotBlockPublicAccessInput) (*ec2sdk.DisableSnapshotBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableSnapshotBlockPublicAccess")
+}
+func (unimplementedEC2) DisableSnapshotBlockPublicAccessRequest(_ *ec2sdk.DisableSnapshotBlockPublicAccessInput) (*request.Request, *ec2sdk.DisableSnapshotBlockPublicAccessOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableSnapshotBlockPublicAccess"), nil
+}
+func (unimplementedEC2) DisableSnapshotBlockPublicAccessWithContext(_ context.Context, _ *ec2sdk.DisableSnapshotBlockPublicAccessInput, _ ...request.Option) (*ec2sdk.DisableSnapshotBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableSnapshotBlockPublicAccess")
+}
+func (unimplementedEC2) DisableTransitGatewayRouteTablePropagation(_ *ec2sdk.DisableTransitGatewayRouteTablePropagationInput) (*ec2sdk.DisableTransitGatewayRouteTablePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableTransitGatewayRouteTablePropagation")
+}
+func (unimplementedEC2) DisableTransitGatewayRouteTablePropagationRequest(_ *ec2sdk.DisableTransitGatewayRouteTablePropagationInput) (*request.Request, *ec2sdk.DisableTransitGatewayRouteTablePropagationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableTransitGatewayRouteTablePropagation"), nil
+}
+func (unimplementedEC2) DisableTransitGatewayRouteTablePropagationWithContext(_ context.Context, _ *ec2sdk.DisableTransitGatewayRouteTablePropagationInput, _ ...request.Option) (*ec2sdk.DisableTransitGatewayRouteTablePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableTransitGatewayRouteTablePropagation")
+}
+func (unimplementedEC2) DisableVgwRoutePropagation(_ *ec2sdk.DisableVgwRoutePropagationInput) (*ec2sdk.DisableVgwRoutePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableVgwRoutePropagation")
+}
+func (unimplementedEC2) DisableVgwRoutePropagationRequest(_ *ec2sdk.DisableVgwRoutePropagationInput) (*request.Request, *ec2sdk.DisableVgwRoutePropagationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableVgwRoutePropagation"), nil
+}
+func (unimplementedEC2) DisableVgwRoutePropagationWithContext(_ context.Context, _ *ec2sdk.DisableVgwRoutePropagationInput, _ ...request.Option) (*ec2sdk.DisableVgwRoutePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableVgwRoutePropagation")
+}
+func (unimplementedEC2) DisableVpcClassicLink(_ *ec2sdk.DisableVpcClassicLinkInput) (*ec2sdk.DisableVpcClassicLinkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableVpcClassicLink")
+}
+func (unimplementedEC2) DisableVpcClassicLinkDnsSupport(_ *ec2sdk.DisableVpcClassicLinkDnsSupportInput) (*ec2sdk.DisableVpcClassicLinkDnsSupportOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) DisableVpcClassicLinkDnsSupportRequest(_ *ec2sdk.DisableVpcClassicLinkDnsSupportInput) (*request.Request, *ec2sdk.DisableVpcClassicLinkDnsSupportOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableVpcClassicLinkDnsSupport"), nil
+}
+func (unimplementedEC2) DisableVpcClassicLinkDnsSupportWithContext(_ context.Context, _ *ec2sdk.DisableVpcClassicLinkDnsSupportInput, _ ...request.Option) (*ec2sdk.DisableVpcClassicLinkDnsSupportOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) DisableVpcClassicLinkRequest(_ *ec2sdk.DisableVpcClassicLinkInput) (*request.Request, *ec2sdk.DisableVpcClassicLinkOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisableVpcClassicLink"), nil
+}
+func (unimplementedEC2) DisableVpcClassicLinkWithContext(_ context.Context, _ *ec2sdk.DisableVpcClassicLinkInput, _ ...request.Option) (*ec2sdk.DisableVpcClassicLinkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisableVpcClassicLink")
+}
+func (unimplementedEC2) DisassociateAddress(_ *ec2sdk.DisassociateAddressInput) (*ec2sdk.DisassociateAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateAddress")
+}
+func (unimplementedEC2) DisassociateAddressRequest(_ *ec2sdk.DisassociateAddressInput) (*request.Request, *ec2sdk.DisassociateAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateAddress"), nil
+}
+func (unimplementedEC2) DisassociateAddressWithContext(_ context.Context, _ *ec2sdk.DisassociateAddressInput, _ ...request.Option) (*ec2sdk.DisassociateAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateAddress")
+}
+func (unimplementedEC2) DisassociateClientVpnTargetNetwork(_ *ec2sdk.DisassociateClientVpnTargetNetworkInput) (*ec2sdk.DisassociateClientVpnTargetNetworkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateClientVpnTargetNetwork")
+}
+func (unimplementedEC2) DisassociateClientVpnTargetNetworkRequest(_ *ec2sdk.DisassociateClientVpnTargetNetworkInput) (*request.Request, *ec2sdk.DisassociateClientVpnTargetNetworkOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateClientVpnTargetNetwork"), nil
+}
+func (unimplementedEC2) DisassociateClientVpnTargetNetworkWithContext(_ context.Context, _ *ec2sdk.DisassociateClientVpnTargetNetworkInput, _ ...request.Option) (*ec2sdk.DisassociateClientVpnTargetNetworkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateClientVpnTargetNetwork")
+}
+func (unimplementedEC2) DisassociateEnclaveCertificateIamRole(_ *ec2sdk.DisassociateEnclaveCertificateIamRoleInput) (*ec2sdk.DisassociateEnclaveCertificateIamRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateEnclaveCertificateIamRole")
+}
+func (unimplementedEC2) DisassociateEnclaveCertificateIamRoleRequest(_ *ec2sdk.DisassociateEnclaveCertificateIamRoleInput) (*request.Request, *ec2sdk.DisassociateEnclaveCertificateIamRoleOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateEnclaveCertificateIamRole"), nil
+}
+func (unimplementedEC2) DisassociateEnclaveCertificateIamRoleWithContext(_ context.Context, _ *ec2sdk.DisassociateEnclaveCertificateIamRoleInput, _ ...request.Option) (*ec2sdk.DisassociateEnclaveCertificateIamRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateEnclaveCertificateIamRole")
+}
+func (unimplementedEC2) DisassociateIamInstanceProfile(_ *ec2sdk.DisassociateIamInstanceProfileInput) (*ec2sdk.DisassociateIamInstanceProfileOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateIamInstanceProfile")
+}
+func (unimplementedEC2) DisassociateIamInstanceProfileRequest(_ *ec2sdk.DisassociateIamInstanceProfileInput) (*request.Request, *ec2sdk.DisassociateIamInstanceProfileOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateIamInstanceProfile"), nil
+}
+func (unimplementedEC2) DisassociateIamInstanceProfileWithContext(_ context.Context, _ *ec2sdk.DisassociateIamInstanceProfileInput, _ ...request.Option) (*ec2sdk.DisassociateIamInstanceProfileOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateIamInstanceProfile")
+}
+func (unimplementedEC2) DisassociateInstanceEventWindow(_ *ec2sdk.DisassociateInstanceEventWindowInput) (*ec2sdk.DisassociateInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateInstanceEventWindow")
+}
+func (unimplementedEC2) DisassociateInstanceEventWindowRequest(_ *ec2sdk.DisassociateInstanceEventWindowInput) (*request.Request, *ec2sdk.DisassociateInstanceEventWindowOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateInstanceEventWindow"), nil
+}
+func (unimplementedEC2) DisassociateInstanceEventWindowWithContext(_ context.Context, _ *ec2sdk.DisassociateInstanceEventWindowInput, _ ...request.Option) (*ec2sdk.DisassociateInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateInstanceEventWindow")
+}
+func (unimplementedEC2) DisassociateIpamByoasn(_ *ec2sdk.DisassociateIpamByoasnInput) (*ec2sdk.DisassociateIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateIpamByoasn")
+}
+func (unimplementedEC2) DisassociateIpamByoasnRequest(_ *ec2sdk.DisassociateIpamByoasnInput) (*request.Request, *ec2sdk.DisassociateIpamByoasnOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateIpamByoasn"), nil
+}
+func (unimplementedEC2) DisassociateIpamByoasnWithContext(_ context.Context, _ *ec2sdk.DisassociateIpamByoasnInput, _ ...request.Option) (*ec2sdk.DisassociateIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateIpamByoasn")
+}
+func (unimplementedEC2) DisassociateIpamResourceDiscovery(_ *ec2sdk.DisassociateIpamResourceDiscoveryInput) (*ec2sdk.DisassociateIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateIpamResourceDiscovery")
+}
+func (unimplementedEC2) DisassociateIpamResourceDiscoveryRequest(_ *ec2sdk.DisassociateIpamResourceDiscoveryInput) (*request.Request, *ec2sdk.DisassociateIpamResourceDiscoveryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateIpamResourceDiscovery"), nil
+}
+func (unimplementedEC2) DisassociateIpamResourceDiscoveryWithContext(_ context.Context, _ *ec2sdk.DisassociateIpamResourceDiscoveryInput, _ ...request.Option) (*ec2sdk.DisassociateIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateIpamResourceDiscovery")
+}
+func (unimplementedEC2) DisassociateNatGatewayAddress(_ *ec2sdk.DisassociateNatGatewayAddressInput) (*ec2sdk.DisassociateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateNatGatewayAddress")
+}
+func (unimplementedEC2) DisassociateNatGatewayAddressRequest(_ *ec2sdk.DisassociateNatGatewayAddressInput) (*request.Request, *ec2sdk.DisassociateNatGatewayAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateNatGatewayAddress"), nil
+}
+func (unimplementedEC2) DisassociateNatGatewayAddressWithContext(_ context.Context, _ *ec2sdk.DisassociateNatGatewayAddressInput, _ ...request.Option) (*ec2sdk.DisassociateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateNatGatewayAddress")
+}
+func (unimplementedEC2) DisassociateRouteTable(_ *ec2sdk.DisassociateRouteTableInput) (*ec2sdk.DisassociateRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateRouteTable")
+}
+func (unimplementedEC2) DisassociateRouteTableRequest(_ *ec2sdk.DisassociateRouteTableInput) (*request.Request, *ec2sdk.DisassociateRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateRouteTable"), nil
+}
+func (unimplementedEC2) DisassociateRouteTableWithContext(_ context.Context, _ *ec2sdk.DisassociateRouteTableInput, _ ...request.Option) (*ec2sdk.DisassociateRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateRouteTable")
+}
+func (unimplementedEC2) DisassociateSubnetCidrBlock(_ *ec2sdk.DisassociateSubnetCidrBlockInput) (*ec2sdk.DisassociateSubnetCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateSubnetCidrBlock")
+}
+func (unimplementedEC2) DisassociateSubnetCidrBlockRequest(_ *ec2sdk.DisassociateSubnetCidrBlockInput) (*request.Request, *ec2sdk.DisassociateSubnetCidrBlockOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateSubnetCidrBlock"), nil
+}
+func (unimplementedEC2) DisassociateSubnetCidrBlockWithContext(_ context.Context, _ *ec2sdk.DisassociateSubnetCidrBlockInput, _ ...request.Option) (*ec2sdk.DisassociateSubnetCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateSubnetCidrBlock")
+}
+func (unimplementedEC2) DisassociateTransitGatewayMulticastDomain(_ *ec2sdk.DisassociateTransitGatewayMulticastDomainInput) (*ec2sdk.DisassociateTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) DisassociateTransitGatewayMulticastDomainRequest(_ *ec2sdk.DisassociateTransitGatewayMulticastDomainInput) (*request.Request, *ec2sdk.DisassociateTransitGatewayMulticastDomainOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateTransitGatewayMulticastDomain"), nil
+}
+func (unimplementedEC2) DisassociateTransitGatewayMulticastDomainWithContext(_ context.Context, _ *ec2sdk.DisassociateTransitGatewayMulticastDomainInput, _ ...request.Option) (*ec2sdk.DisassociateTransitGatewayMulticastDomainOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTransitGatewayMulticastDomain")
+}
+func (unimplementedEC2) DisassociateTransitGatewayPolicyTable(_ *ec2sdk.DisassociateTransitGatewayPolicyTableInput) (*ec2sdk.DisassociateTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) DisassociateTransitGatewayPolicyTableRequest(_ *ec2sdk.DisassociateTransitGatewayPolicyTableInput) (*request.Request, *ec2sdk.DisassociateTransitGatewayPolicyTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateTransitGatewayPolicyTable"), nil
+}
+func (unimplementedEC2) DisassociateTransitGatewayPolicyTableWithContext(_ context.Context, _ *ec2sdk.DisassociateTransitGatewayPolicyTableInput, _ ...request.Option) (*ec2sdk.DisassociateTransitGatewayPolicyTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTransitGatewayPolicyTable")
+}
+func (unimplementedEC2) DisassociateTransitGatewayRouteTable(_ *ec2sdk.DisassociateTransitGatewayRouteTableInput) (*ec2sdk.DisassociateTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTransitGatewayRouteTable")
+}
+func (unimplementedEC2) DisassociateTransitGatewayRouteTableRequest(_ *ec2sdk.DisassociateTransitGatewayRouteTableInput) (*request.Request, *ec2sdk.DisassociateTransitGatewayRouteTableOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateTransitGatewayRouteTable"), nil
+}
+func (unimplementedEC2) DisassociateTransitGatewayRouteTableWithContext(_ context.Context, _ *ec2sdk.DisassociateTransitGatewayRouteTableInput, _ ...request.Option) (*ec2sdk.DisassociateTransitGatewayRouteTableOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTransitGatewayRouteTable")
+}
+func (unimplementedEC2) DisassociateTrunkInterface(_ *ec2sdk.DisassociateTrunkInterfaceInput) (*ec2sdk.DisassociateTrunkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTrunkInterface")
+}
+func (unimplementedEC2) DisassociateTrunkInterfaceRequest(_ *ec2sdk.DisassociateTrunkInterfaceInput) (*request.Request, *ec2sdk.DisassociateTrunkInterfaceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateTrunkInterface"), nil
+}
+func (unimplementedEC2) DisassociateTrunkInterfaceWithContext(_ context.Context, _ *ec2sdk.DisassociateTrunkInterfaceInput, _ ...request.Option) (*ec2sdk.DisassociateTrunkInterfaceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateTrunkInterface")
+}
+func (unimplementedEC2) DisassociateVpcCidrBlock(_ *ec2sdk.DisassociateVpcCidrBlockInput) (*ec2sdk.DisassociateVpcCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateVpcCidrBlock")
+}
+func (unimplementedEC2) DisassociateVpcCidrBlockRequest(_ *ec2sdk.DisassociateVpcCidrBlockInput) (*request.Request, *ec2sdk.DisassociateVpcCidrBlockOutput) {
+	return newNotImplementedRequest(ServiceEC2, "DisassociateVpcCidrBlock"), nil
+}
+func (unimplementedEC2) DisassociateVpcCidrBlockWithContext(_ context.Context, _ *ec2sdk.DisassociateVpcCidrBlockInput, _ ...request.Option) (*ec2sdk.DisassociateVpcCidrBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "DisassociateVpcCidrBlock")
+}
+func (unimplementedEC2) EnableAddressTransfer(_ *ec2sdk.EnableAddressTransferInput) (*ec2sdk.EnableAddressTransferOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableAddressTransfer")
+}
+func (unimplementedEC2) EnableAddressTransferRequest(_ *ec2sdk.EnableAddressTransferInput) (*request.Request, *ec2sdk.EnableAddressTransferOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableAddressTransfer"), nil
+}
+func (unimplementedEC2) EnableAddressTransferWithContext(_ context.Context, _ *ec2sdk.EnableAddressTransferInput, _ ...request.Option) (*ec2sdk.EnableAddressTransferOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableAddressTransfer")
+}
+func (unimplementedEC2) EnableAwsNetworkPerformanceMetricSubscription(_ *ec2sdk.EnableAwsNetworkPerformanceMetricSubscriptionInput) (*ec2sdk.EnableAwsNetworkPerformanceMetricSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableAwsNetworkPerformanceMetricSubscription")
+}
+func (unimplementedEC2) EnableAwsNetworkPerformanceMetricSubscriptionRequest(_ *ec2sdk.EnableAwsNetworkPerformanceMetricSubscriptionInput) (*request.Request, *ec2sdk.EnableAwsNetworkPerformanceMetricSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableAwsNetworkPerformanceMetricSubscription"), nil
+}
+func (unimplementedEC2) EnableAwsNetworkPerformanceMetricSubscriptionWithContext(_ context.Context, _ *ec2sdk.EnableAwsNetworkPerformanceMetricSubscriptionInput, _ ...request.Option) (*ec2sdk.EnableAwsNetworkPerformanceMetricSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableAwsNetworkPerformanceMetricSubscription")
+}
+func (unimplementedEC2) EnableEbsEncryptionByDefault(_ *ec2sdk.EnableEbsEncryptionByDefaultInput) (*ec2sdk.EnableEbsEncryptionByDefaultOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableEbsEncryptionByDefault")
+}
+func (unimplementedEC2) EnableEbsEncryptionByDefaultRequest(_ *ec2sdk.EnableEbsEncryptionByDefaultInput) (*request.Request, *ec2sdk.EnableEbsEncryptionByDefaultOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableEbsEncryptionByDefault"), nil
+}
+func (unimplementedEC2) EnableEbsEncryptionByDefaultWithContext(_ context.Context, _ *ec2sdk.EnableEbsEncryptionByDefaultInput, _ ...request.Option) (*ec2sdk.EnableEbsEncryptionByDefaultOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableEbsEncryptionByDefault")
+}
+func (unimplementedEC2) EnableFastLaunch(_ *ec2sdk.EnableFastLaunchInput) (*ec2sdk.EnableFastLaunchOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableFastLaunch")
+}
+func (unimplementedEC2) EnableFastLaunchRequest(_ *ec2sdk.EnableFastLaunchInput) (*request.Request, *ec2sdk.EnableFastLaunchOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableFastLaunch"), nil
+}
+func (unimplementedEC2) EnableFastLaunchWithContext(_ context.Context, _ *ec2sdk.EnableFastLaunchInput, _ ...request.Option) (*ec2sdk.EnableFastLaunchOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableFastLaunch")
+}
+func (unimplementedEC2) EnableFastSnapshotRestores(_ *ec2sdk.EnableFastSnapshotRestoresInput) (*ec2sdk.EnableFastSnapshotRestoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableFastSnapshotRestores")
+}
+func (unimplementedEC2) EnableFastSnapshotRestoresRequest(_ *ec2sdk.EnableFastSnapshotRestoresInput) (*request.Request, *ec2sdk.EnableFastSnapshotRestoresOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableFastSnapshotRestores"), nil
+}
+func (unimplementedEC2) EnableFastSnapshotRestoresWithContext(_ context.Context, _ *ec2sdk.EnableFastSnapshotRestoresInput, _ ...request.Option) (*ec2sdk.EnableFastSnapshotRestoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableFastSnapshotRestores")
+}
+func (unimplementedEC2) EnableImage(_ *ec2sdk.EnableImageInput) (*ec2sdk.EnableImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableImage")
+}
+func (unimplementedEC2) EnableImageBlockPublicAccess(_ *ec2sdk.EnableImageBlockPublicAccessInput) (*ec2sdk.EnableImageBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableImageBlockPublicAccess")
+}
+func (unimplementedEC2) EnableImageBlockPublicAccessRequest(_ *ec2sdk.EnableImageBlockPublicAccessInput) (*request.Request, *ec2sdk.EnableImageBlockPublicAccessOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableImageBlockPublicAccess"), nil
+}
+func (unimplementedEC2) EnableImageBlockPublicAccessWithContext(_ context.Context, _ *ec2sdk.EnableImageBlockPublicAccessInput, _ ...request.Option) (*ec2sdk.EnableImageBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableImageBlockPublicAccess")
+}
+func (unimplementedEC2) EnableImageDeprecation(_ *ec2sdk.EnableImageDeprecationInput) (*ec2sdk.EnableImageDeprecationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableImageDeprecation")
+}
+func (unimplementedEC2) EnableImageDeprecationRequest(_ *ec2sdk.EnableImageDeprecationInput) (*request.Request, *ec2sdk.EnableImageDeprecationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableImageDeprecation"), nil
+}
+func (unimplementedEC2) EnableImageDeprecationWithContext(_ context.Context, _ *ec2sdk.EnableImageDeprecationInput, _ ...request.Option) (*ec2sdk.EnableImageDeprecationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableImageDeprecation")
+}
+func (unimplementedEC2) EnableImageRequest(_ *ec2sdk.EnableImageInput) (*request.Request, *ec2sdk.EnableImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableImage"), nil
+}
+func (unimplementedEC2) EnableImageWithContext(_ context.Context, _ *ec2sdk.EnableImageInput, _ ...request.Option) (*ec2sdk.EnableImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableImage")
+}
+func (unimplementedEC2) EnableIpamOrganizationAdminAccount(_ *ec2sdk.EnableIpamOrganizationAdminAccountInput) (*ec2sdk.EnableIpamOrganizationAdminAccountOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableIpamOrganizationAdminAccount")
+}
+func (unimplementedEC2) EnableIpamOrganizationAdminAccountRequest(_ *ec2sdk.EnableIpamOrganizationAdminAccountInput) (*request.Request, *ec2sdk.EnableIpamOrganizationAdminAccountOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableIpamOrganizationAdminAccount"), nil
+}
+func (unimplementedEC2) EnableIpamOrganizationAdminAccountWithContext(_ context.Context, _ *ec2sdk.EnableIpamOrganizationAdminAccountInput, _ ...request.Option) (*ec2sdk.EnableIpamOrganizationAdminAccountOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableIpamOrganizationAdminAccount")
+}
+func (unimplementedEC2) EnableReachabilityAnalyzerOrganizationSharing(_ *ec2sdk.EnableReachabilityAnalyzerOrganizationSharingInput) (*ec2sdk.EnableReachabilityAnalyzerOrganizationSharingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableReachabilityAnalyzerOrganizationSharing")
+}
+func (unimplementedEC2) EnableReachabilityAnalyzerOrganizationSharingRequest(_ *ec2sdk.EnableReachabilityAnalyzerOrganizationSharingInput) (*request.Request, *ec2sdk.EnableReachabilityAnalyzerOrganizationSharingOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableReachabilityAnalyzerOrganizationSharing"), nil
+}
+func (unimplementedEC2) EnableReachabilityAnalyzerOrganizationSharingWithContext(_ context.Context, _ *ec2sdk.EnableReachabilityAnalyzerOrganizationSharingInput, _ ...request.Option) (*ec2sdk.EnableReachabilityAnalyzerOrganizationSharingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableReachabilityAnalyzerOrganizationSharing")
+}
+func (unimplementedEC2) EnableSerialConsoleAccess(_ *ec2sdk.EnableSerialConsoleAccessInput) (*ec2sdk.EnableSerialConsoleAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableSerialConsoleAccess")
+}
+func (unimplementedEC2) EnableSerialConsoleAccessRequest(_ *ec2sdk.EnableSerialConsoleAccessInput) (*request.Request, *ec2sdk.EnableSerialConsoleAccessOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableSerialConsoleAccess"), nil
+}
+func (unimplementedEC2) EnableSerialConsoleAccessWithContext(_ context.Context, _ *ec2sdk.EnableSerialConsoleAccessInput, _ ...request.Option) (*ec2sdk.EnableSerialConsoleAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableSerialConsoleAccess")
+}
+func (unimplementedEC2) EnableSnapshotBlockPublicAccess(_ *ec2sdk.EnableSnapshotBlockPublicAccessInput) (*ec2sdk.EnableSnapshotBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableSnapshotBlockPublicAccess")
+}
+func (unimplementedEC2) EnableSnapshotBlockPublicAccessRequest(_ *ec2sdk.EnableSnapshotBlockPublicAccessInput) (*request.Request, *ec2sdk.EnableSnapshotBlockPublicAccessOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableSnapshotBlockPublicAccess"), nil
+}
+func (unimplementedEC2) EnableSnapshotBlockPublicAccessWithContext(_ context.Context, _ *ec2sdk.EnableSnapshotBlockPublicAccessInput, _ ...request.Option) (*ec2sdk.EnableSnapshotBlockPublicAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableSnapshotBlockPublicAccess")
+}
+func (unimplementedEC2) EnableTransitGatewayRouteTablePropagation(_ *ec2sdk.EnableTransitGatewayRouteTablePropagationInput) (*ec2sdk.EnableTransitGatewayRouteTablePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableTransitGatewayRouteTablePropagation")
+}
+func (unimplementedEC2) EnableTransitGatewayRouteTablePropagationRequest(_ *ec2sdk.EnableTransitGatewayRouteTablePropagationInput) (*request.Request, *ec2sdk.EnableTransitGatewayRouteTablePropagationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableTransitGatewayRouteTablePropagation"), nil
+}
+func (unimplementedEC2) EnableTransitGatewayRouteTablePropagationWithContext(_ context.Context, _ *ec2sdk.EnableTransitGatewayRouteTablePropagationInput, _ ...request.Option) (*ec2sdk.EnableTransitGatewayRouteTablePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableTransitGatewayRouteTablePropagation")
+}
+func (unimplementedEC2) EnableVgwRoutePropagation(_ *ec2sdk.EnableVgwRoutePropagationInput) (*ec2sdk.EnableVgwRoutePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVgwRoutePropagation")
+}
+func (unimplementedEC2) EnableVgwRoutePropagationRequest(_ *ec2sdk.EnableVgwRoutePropagationInput) (*request.Request, *ec2sdk.EnableVgwRoutePropagationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableVgwRoutePropagation"), nil
+}
+func (unimplementedEC2) EnableVgwRoutePropagationWithContext(_ context.Context, _ *ec2sdk.EnableVgwRoutePropagationInput, _ ...request.Option) (*ec2sdk.EnableVgwRoutePropagationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVgwRoutePropagation")
+}
+func (unimplementedEC2) EnableVolumeIO(_ *ec2sdk.EnableVolumeIOInput) (*ec2sdk.EnableVolumeIOOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVolumeIO")
+}
+func (unimplementedEC2) EnableVolumeIORequest(_ *ec2sdk.EnableVolumeIOInput) (*request.Request, *ec2sdk.EnableVolumeIOOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableVolumeIO"), nil
+}
+func (unimplementedEC2) EnableVolumeIOWithContext(_ context.Context, _ *ec2sdk.EnableVolumeIOInput, _ ...request.Option) (*ec2sdk.EnableVolumeIOOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVolumeIO")
+}
+func (unimplementedEC2) EnableVpcClassicLink(_ *ec2sdk.EnableVpcClassicLinkInput) (*ec2sdk.EnableVpcClassicLinkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVpcClassicLink")
+}
+func (unimplementedEC2) EnableVpcClassicLinkDnsSupport(_ *ec2sdk.EnableVpcClassicLinkDnsSupportInput) (*ec2sdk.EnableVpcClassicLinkDnsSupportOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) EnableVpcClassicLinkDnsSupportRequest(_ *ec2sdk.EnableVpcClassicLinkDnsSupportInput) (*request.Request, *ec2sdk.EnableVpcClassicLinkDnsSupportOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableVpcClassicLinkDnsSupport"), nil
+}
+func (unimplementedEC2) EnableVpcClassicLinkDnsSupportWithContext(_ context.Context, _ *ec2sdk.EnableVpcClassicLinkDnsSupportInput, _ ...request.Option) (*ec2sdk.EnableVpcClassicLinkDnsSupportOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVpcClassicLinkDnsSupport")
+}
+func (unimplementedEC2) EnableVpcClassicLinkRequest(_ *ec2sdk.EnableVpcClassicLinkInput) (*request.Request, *ec2sdk.EnableVpcClassicLinkOutput) {
+	return newNotImplementedRequest(ServiceEC2, "EnableVpcClassicLink"), nil
+}
+func (unimplementedEC2) EnableVpcClassicLinkWithContext(_ context.Context, _ *ec2sdk.EnableVpcClassicLinkInput, _ ...request.Option) (*ec2sdk.EnableVpcClassicLinkOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "EnableVpcClassicLink")
+}
+func (unimplementedEC2) ExportClientVpnClientCertificateRevocationList(_ *ec2sdk.ExportClientVpnClientCertificateRevocationListInput) (*ec2sdk.ExportClientVpnClientCertificateRevocationListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportClientVpnClientCertificateRevocationList")
+}
+func (unimplementedEC2) ExportClientVpnClientCertificateRevocationListRequest(_ *ec2sdk.ExportClientVpnClientCertificateRevocationListInput) (*request.Request, *ec2sdk.ExportClientVpnClientCertificateRevocationListOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ExportClientVpnClientCertificateRevocationList"), nil
+}
+func (unimplementedEC2) ExportClientVpnClientCertificateRevocationListWithContext(_ context.Context, _ *ec2sdk.ExportClientVpnClientCertificateRevocationListInput, _ ...request.Option) (*ec2sdk.ExportClientVpnClientCertificateRevocationListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportClientVpnClientCertificateRevocationList")
+}
+func (unimplementedEC2) ExportClientVpnClientConfiguration(_ *ec2sdk.ExportClientVpnClientConfigurationInput) (*ec2sdk.ExportClientVpnClientConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportClientVpnClientConfiguration")
+}
+func (unimplementedEC2) ExportClientVpnClientConfigurationRequest(_ *ec2sdk.ExportClientVpnClientConfigurationInput) (*request.Request, *ec2sdk.ExportClientVpnClientConfigurationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ExportClientVpnClientConfiguration"), nil
+}
+func (unimplementedEC2) ExportClientVpnClientConfigurationWithContext(_ context.Context, _ *ec2sdk.ExportClientVpnClientConfigurationInput, _ ...request.Option) (*ec2sdk.ExportClientVpnClientConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportClientVpnClientConfiguration")
+}
+func (unimplementedEC2) ExportImage(_ *ec2sdk.ExportImageInput) (*ec2sdk.ExportImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportImage")
+}
+func (unimplementedEC2) ExportImageRequest(_ *ec2sdk.ExportImageInput) (*request.Request, *ec2sdk.ExportImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ExportImage"), nil
+}
+func (unimplementedEC2) ExportImageWithContext(_ context.Context, _ *ec2sdk.ExportImageInput, _ ...request.Option) (*ec2sdk.ExportImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportImage")
+}
+func (unimplementedEC2) ExportTransitGatewayRoutes(_ *ec2sdk.ExportTransitGatewayRoutesInput) (*ec2sdk.ExportTransitGatewayRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportTransitGatewayRoutes")
+}
+func (unimplementedEC2) ExportTransitGatewayRoutesRequest(_ *ec2sdk.ExportTransitGatewayRoutesInput) (*request.Request, *ec2sdk.ExportTransitGatewayRoutesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ExportTransitGatewayRoutes"), nil
+}
+func (unimplementedEC2) ExportTransitGatewayRoutesWithContext(_ context.Context, _ *ec2sdk.ExportTransitGatewayRoutesInput, _ ...request.Option) (*ec2sdk.ExportTransitGatewayRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ExportTransitGatewayRoutes")
+}
+func (unimplementedEC2) GetAssociatedEnclaveCertificateIamRoles(_ *ec2sdk.GetAssociatedEnclaveCertificateIamRolesInput) (*ec2sdk.GetAssociatedEnclaveCertificateIamRolesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetAssociatedEnclaveCertificateIamRoles")
+}
+func (unimplementedEC2) GetAssociatedEnclaveCertificateIamRolesRequest(_ *ec2sdk.GetAssociatedEnclaveCertificateIamRolesInput) (*request.Request, *ec2sdk.GetAssociatedEnclaveCertificateIamRolesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetAssociatedEnclaveCertificateIamRoles"), nil
+}
+func (unimplementedEC2) GetAssociatedEnclaveCertificateIamRolesWithContext(_ context.Context, _ *ec2sdk.GetAssociatedEnclaveCertificateIamRolesInput, _ ...request.Option) (*ec2sdk.GetAssociatedEnclaveCertificateIamRolesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetAssociatedEnclaveCertificateIamRoles")
+}
+func (unimplementedEC2) GetAssociatedIpv6PoolCidrs(_ *ec2sdk.GetAssociatedIpv6PoolCidrsInput) (*ec2sdk.GetAssociatedIpv6PoolCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetAssociatedIpv6PoolCidrs")
+}
+func (unimplementedEC2) GetAssociatedIpv6PoolCidrsPages(_ *ec2sdk.GetAssociatedIpv6PoolCidrsInput, _ func(*ec2sdk.GetAssociatedIpv6PoolCidrsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetAssociatedIpv6PoolCidrs")
+}
+func (unimplementedEC2) GetAssociatedIpv6PoolCidrsPagesWithContext(_ context.Context, _ *ec2sdk.GetAssociatedIpv6PoolCidrsInput, _ func(*ec2sdk.GetAssociatedIpv6PoolCidrsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetAssociatedIpv6PoolCidrs")
+}
+func (unimplementedEC2) GetAssociatedIpv6PoolCidrsRequest(_ *ec2sdk.GetAssociatedIpv6PoolCidrsInput) (*request.Request, *ec2sdk.GetAssociatedIpv6PoolCidrsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetAssociatedIpv6PoolCidrs"), nil
+}
+func (unimplementedEC2) GetAssociatedIpv6PoolCidrsWithContext(_ context.Context, _ *ec2sdk.GetAssociatedIpv6PoolCidrsInput, _ ...request.Option) (*ec2sdk.GetAssociatedIpv6PoolCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetAssociatedIpv6PoolCidrs")
+}
+func (unimplementedEC2) GetAwsNetworkPerformanceData(_ *ec2sdk.GetAwsNetworkPerformanceDataInput) (*ec2sdk.GetAwsNetworkPerformanceDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetAwsNetworkPerformanceData")
+}
+func (unimplementedEC2) GetAwsNetworkPerformanceDataPages(_ *ec2sdk.GetAwsNetworkPerformanceDataInput, _ func(*ec2sdk.GetAwsNetworkPerformanceDataOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetAwsNetworkPerformanceData")
+}
+func (unimplementedEC2) GetAwsNetworkPerformanceDataPagesWithContext(_ context.Context, _ *ec2sdk.GetAwsNetworkPerformanceDataInput, _ func(*ec2sdk.GetAwsNetworkPerformanceDataOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetAwsNetworkPerformanceData")
+}
+func (unimplementedEC2) GetAwsNetworkPerformanceDataRequest(_ *ec2sdk.GetAwsNetworkPerformanceDataInput) (*request.Request, *ec2sdk.GetAwsNetworkPerformanceDataOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetAwsNetworkPerformanceData"), nil
+}
+func (unimplementedEC2) GetAwsNetworkPerformanceDataWithContext(_ context.Context, _ *ec2sdk.GetAwsNetworkPerformanceDataInput, _ ...request.Option) (*ec2sdk.GetAwsNetworkPerformanceDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetAwsNetworkPerformanceData")
+}
+func (unimplementedEC2) GetCapacityReservationUsage(_ *ec2sdk.GetCapacityReservationUsageInput) (*ec2sdk.GetCapacityReservationUsageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetCapacityReservationUsage")
+}
+func (unimplementedEC2) GetCapacityReservationUsageRequest(_ *ec2sdk.GetCapacityReservationUsageInput) (*request.Request, *ec2sdk.GetCapacityReservationUsageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetCapacityReservationUsage"), nil
+}
+func (unimplementedEC2) GetCapacityReservationUsageWithContext(_ context.Context, _ *ec2sdk.GetCapacityReservationUsageInput, _ ...request.Option) (*ec2sdk.GetCapacityReservationUsageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetCapacityReservationUsage")
+}
+func (unimplementedEC2) GetCoipPoolUsage(_ *ec2sdk.GetCoipPoolUsageInput) (*ec2sdk.GetCoipPoolUsageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetCoipPoolUsage")
+}
+func (unimplementedEC2) GetCoipPoolUsageRequest(_ *ec2sdk.GetCoipPoolUsageInput) (*request.Request, *ec2sdk.GetCoipPoolUsageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetCoipPoolUsage"), nil
+}
+func (unimplementedEC2) GetCoipPoolUsageWithContext(_ context.Context, _ *ec2sdk.GetCoipPoolUsageInput, _ ...request.Option) (*ec2sdk.GetCoipPoolUsageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetCoipPoolUsage")
+}
+func (unimplementedEC2) GetConsoleOutput(_ *ec2sdk.GetConsoleOutputInput) (*ec2sdk.GetConsoleOutputOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetConsoleOutput")
+}
+func (unimplementedEC2) GetConsoleOutputRequest(_ *ec2sdk.GetConsoleOutputInput) (*request.Request, *ec2sdk.GetConsoleOutputOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetConsoleOutput"), nil
+}
+func (unimplementedEC2) GetConsoleOutputWithContext(_ context.Context, _ *ec2sdk.GetConsoleOutputInput, _ ...request.Option) (*ec2sdk.GetConsoleOutputOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetConsoleOutput")
+}
+func (unimplementedEC2) GetConsoleScreenshot(_ *ec2sdk.GetConsoleScreenshotInput) (*ec2sdk.GetConsoleScreenshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetConsoleScreenshot")
+}
+func (unimplementedEC2) GetConsoleScreenshotRequest(_ *ec2sdk.GetConsoleScreenshotInput) (*request.Request, *ec2sdk.GetConsoleScreenshotOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetConsoleScreenshot"), nil
+}
+func (unimplementedEC2) GetConsoleScreenshotWithContext(_ context.Context, _ *ec2sdk.GetConsoleScreenshotInput, _ ...request.Option) (*ec2sdk.GetConsoleScreenshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetConsoleScreenshot")
+}
+func (unimplementedEC2) GetDefaultCreditSpecification(_ *ec2sdk.GetDefaultCreditSpecificationInput) (*ec2sdk.GetDefaultCreditSpecificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetDefaultCreditSpecification")
+}
+func (unimplementedEC2) GetDefaultCreditSpecificationRequest(_ *ec2sdk.GetDefaultCreditSpecificationInput) (*request.Request, *ec2sdk.GetDefaultCreditSpecificationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetDefaultCreditSpecification"), nil
+}
+func (unimplementedEC2) GetDefaultCreditSpecificationWithContext(_ context.Context, _ *ec2sdk.GetDefaultCreditSpecificationInput, _ ...request.Option) (*ec2sdk.GetDefaultCreditSpecificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetDefaultCreditSpecification")
+}
+func (unimplementedEC2) GetEbsDefaultKmsKeyId(_ *ec2sdk.GetEbsDefaultKmsKeyIdInput) (*ec2sdk.GetEbsDefaultKmsKeyIdOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetEbsDefaultKmsKeyId")
+}
+func (unimplementedEC2) GetEbsDefaultKmsKeyIdRequest(_ *ec2sdk.GetEbsDefaultKmsKeyIdInput) (*request.Request, *ec2sdk.GetEbsDefaultKmsKeyIdOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetEbsDefaultKmsKeyId"), nil
+}
+func (unimplementedEC2) GetEbsDefaultKmsKeyIdWithContext(_ context.Context, _ *ec2sdk.GetEbsDefaultKmsKeyIdInput, _ ...request.Option) (*ec2sdk.GetEbsDefaultKmsKeyIdOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetEbsDefaultKmsKeyId")
+}
+func (unimplementedEC2) GetEbsEncryptionByDefault(_ *ec2sdk.GetEbsEncryptionByDefaultInput) (*ec2sdk.GetEbsEncryptionByDefaultOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetEbsEncryptionByDefault")
+}
+func (unimplementedEC2) GetEbsEncryptionByDefaultRequest(_ *ec2sdk.GetEbsEncryptionByDefaultInput) (*request.Request, *ec2sdk.GetEbsEncryptionByDefaultOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetEbsEncryptionByDefault"), nil
+}
+func (unimplementedEC2) GetEbsEncryptionByDefaultWithContext(_ context.Context, _ *ec2sdk.GetEbsEncryptionByDefaultInput, _ ...request.Option) (*ec2sdk.GetEbsEncryptionByDefaultOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetEbsEncryptionByDefault")
+}
+func (unimplementedEC2) GetFlowLogsIntegrationTemplate(_ *ec2sdk.GetFlowLogsIntegrationTemplateInput) (*ec2sdk.GetFlowLogsIntegrationTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetFlowLogsIntegrationTemplate")
+}
+func (unimplementedEC2) GetFlowLogsIntegrationTemplateRequest(_ *ec2sdk.GetFlowLogsIntegrationTemplateInput) (*request.Request, *ec2sdk.GetFlowLogsIntegrationTemplateOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetFlowLogsIntegrationTemplate"), nil
+}
+func (unimplementedEC2) GetFlowLogsIntegrationTemplateWithContext(_ context.Context, _ *ec2sdk.GetFlowLogsIntegrationTemplateInput, _ ...request.Option) (*ec2sdk.GetFlowLogsIntegrationTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetFlowLogsIntegrationTemplate")
+}
+func (unimplementedEC2) GetGroupsForCapacityReservation(_ *ec2sdk.GetGroupsForCapacityReservationInput) (*ec2sdk.GetGroupsForCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetGroupsForCapacityReservation")
+}
+func (unimplementedEC2) GetGroupsForCapacityReservationPages(_ *ec2sdk.GetGroupsForCapacityReservationInput, _ func(*ec2sdk.GetGroupsForCapacityReservationOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetGroupsForCapacityReservation")
+}
+func (unimplementedEC2) GetGroupsForCapacityReservationPagesWithContext(_ context.Context, _ *ec2sdk.GetGroupsForCapacityReservationInput, _ func(*ec2sdk.GetGroupsForCapacityReservationOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetGroupsForCapacityReservation")
+}
+func (unimplementedEC2) GetGroupsForCapacityReservationRequest(_ *ec2sdk.GetGroupsForCapacityReservationInput) (*request.Request, *ec2sdk.GetGroupsForCapacityReservationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetGroupsForCapacityReservation"), nil
+}
+func (unimplementedEC2) GetGroupsForCapacityReservationWithContext(_ context.Context, _ *ec2sdk.GetGroupsForCapacityReservationInput, _ ...request.Option) (*ec2sdk.GetGroupsForCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetGroupsForCapacityReservation")
+}
+func (unimplementedEC2) GetHostReservationPurchasePreview(_ *ec2sdk.GetHostReservationPurchasePreviewInput) (*ec2sdk.GetHostReservationPurchasePreviewOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetHostReservationPurchasePreview")
+}
+func (unimplementedEC2) GetHostReservationPurchasePreviewRequest(_ *ec2sdk.GetHostReservationPurchasePreviewInput) (*request.Request, *ec2sdk.GetHostReservationPurchasePreviewOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetHostReservationPurchasePreview"), nil
+}
+func (unimplementedEC2) GetHostReservationPurchasePreviewWithContext(_ context.Context, _ *ec2sdk.GetHostReservationPurchasePreviewInput, _ ...request.Option) (*ec2sdk.GetHostReservationPurchasePreviewOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetHostReservationPurchasePreview")
+}
+func (unimplementedEC2) GetImageBlockPublicAccessState(_ *ec2sdk.GetImageBlockPublicAccessStateInput) (*ec2sdk.GetImageBlockPublicAccessStateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetImageBlockPublicAccessState")
+}
+func (unimplementedEC2) GetImageBlockPublicAccessStateRequest(_ *ec2sdk.GetImageBlockPublicAccessStateInput) (*request.Request, *ec2sdk.GetImageBlockPublicAccessStateOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetImageBlockPublicAccessState"), nil
+}
+func (unimplementedEC2) GetImageBlockPublicAccessStateWithContext(_ context.Context, _ *ec2sdk.GetImageBlockPublicAccessStateInput, _ ...request.Option) (*ec2sdk.GetImageBlockPublicAccessStateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetImageBlockPublicAccessState")
+}
+func (unimplementedEC2) GetInstanceTypesFromInstanceRequirements(_ *ec2sdk.GetInstanceTypesFromInstanceRequirementsInput) (*ec2sdk.GetInstanceTypesFromInstanceRequirementsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetInstanceTypesFromInstanceRequirements")
+}
+func (unimplementedEC2) GetInstanceTypesFromInstanceRequirementsPages(_ *ec2sdk.GetInstanceTypesFromInstanceRequirementsInput, _ func(*ec2sdk.GetInstanceTypesFromInstanceRequirementsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetInstanceTypesFromInstanceRequirements")
+}
+func (unimplementedEC2) GetInstanceTypesFromInstanceRequirementsPagesWithContext(_ context.Context, _ *ec2sdk.GetInstanceTypesFromInstanceRequirementsInput, _ func(*ec2sdk.GetInstanceTypesFromInstanceRequirementsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetInstanceTypesFromInstanceRequirements")
+}
+func (unimplementedEC2) GetInstanceTypesFromInstanceRequirementsRequest(_ *ec2sdk.GetInstanceTypesFromInstanceRequirementsInput) (*request.Request, *ec2sdk.GetInstanceTypesFromInstanceRequirementsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetInstanceTypesFromInstanceRequirements"), nil
+}
+func (unimplementedEC2) GetInstanceTypesFromInstanceRequirementsWithContext(_ context.Context, _ *ec2sdk.GetInstanceTypesFromInstanceRequirementsInput, _ ...request.Option) (*ec2sdk.GetInstanceTypesFromInstanceRequirementsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetInstanceTypesFromInstanceRequirements")
+}
+func (unimplementedEC2) GetInstanceUefiData(_ *ec2sdk.GetInstanceUefiDataInput) (*ec2sdk.GetInstanceUefiDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetInstanceUefiData")
+}
+func (unimplementedEC2) GetInstanceUefiDataRequest(_ *ec2sdk.GetInstanceUefiDataInput) (*request.Request, *ec2sdk.GetInstanceUefiDataOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetInstanceUefiData"), nil
+}
+func (unimplementedEC2) GetInstanceUefiDataWithContext(_ context.Context, _ *ec2sdk.GetInstanceUefiDataInput, _ ...request.Option) (*ec2sdk.GetInstanceUefiDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetInstanceUefiData")
+}
+func (unimplementedEC2) GetIpamAddressHistory(_ *ec2sdk.GetIpamAddressHistoryInput) (*ec2sdk.GetIpamAddressHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamAddressHistory")
+}
+func (unimplementedEC2) GetIpamAddressHistoryPages(_ *ec2sdk.GetIpamAddressHistoryInput, _ func(*ec2sdk.GetIpamAddressHistoryOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamAddressHistory")
+}
+func (unimplementedEC2) GetIpamAddressHistoryPagesWithContext(_ context.Context, _ *ec2sdk.GetIpamAddressHistoryInput, _ func(*ec2sdk.GetIpamAddressHistoryOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamAddressHistory")
+}
+func (unimplementedEC2) GetIpamAddressHistoryRequest(_ *ec2sdk.GetIpamAddressHistoryInput) (*request.Request, *ec2sdk.GetIpamAddressHistoryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetIpamAddressHistory"), nil
+}
+func (unimplementedEC2) GetIpamAddressHistoryWithContext(_ context.Context, _ *ec2sdk.GetIpamAddressHistoryInput, _ ...request.Option) (*ec2sdk.GetIpamAddressHistoryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamAddressHistory")
+}
+func (unimplementedEC2) GetIpamDiscoveredAccounts(_ *ec2sdk.GetIpamDiscoveredAccountsInput) (*ec2sdk.GetIpamDiscoveredAccountsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamDiscoveredAccounts")
+}
+func (unimplementedEC2) GetIpamDiscoveredAccountsPages(_ *ec2sdk.GetIpamDiscoveredAccountsInput, _ func(*ec2sdk.GetIpamDiscoveredAccountsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamDiscoveredAccounts")
+}
+func (unimplementedEC2) GetIpamDiscoveredAccountsPagesWithContext(_ context.Context, _ *ec2sdk.GetIpamDiscoveredAccountsInput, _ func(*ec2sdk.GetIpamDiscoveredAccountsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamDiscoveredAccounts")
+}
+func (unimplementedEC2) GetIpamDiscoveredAccountsRequest(_ *ec2sdk.GetIpamDiscoveredAccountsInput) (*request.Request, *ec2sdk.GetIpamDiscoveredAccountsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetIpamDiscoveredAccounts"), nil
+}
+func (unimplementedEC2) GetIpamDiscoveredAccountsWithContext(_ context.Context, _ *ec2sdk.GetIpamDiscoveredAccountsInput, _ ...request.Option) (*ec2sdk.GetIpamDiscoveredAccountsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamDiscoveredAccounts")
+}
+func (unimplementedEC2) GetIpamDiscoveredPublicAddresses(_ *ec2sdk.GetIpamDiscoveredPublicAddressesInput) (*ec2sdk.GetIpamDiscoveredPublicAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamDiscoveredPublicAddresses")
+}
+func (unimplementedEC2) GetIpamDiscoveredPublicAddressesRequest(_ *ec2sdk.GetIpamDiscoveredPublicAddressesInput) (*request.Request, *ec2sdk.GetIpamDiscoveredPublicAddressesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetIpamDiscoveredPublicAddresses"), nil
+}
+func (unimplementedEC2) GetIpamDiscoveredPublicAddressesWithContext(_ context.Context, _ *ec2sdk.GetIpamDiscoveredPublicAddressesInput, _ ...request.Option) (*ec2sdk.GetIpamDiscoveredPublicAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamDiscoveredPublicAddresses")
+}
+func (unimplementedEC2) GetIpamDiscoveredResourceCidrs(_ *ec2sdk.GetIpamDiscoveredResourceCidrsInput) (*ec2sdk.GetIpamDiscoveredResourceCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamDiscoveredResourceCidrs")
+}
+func (unimplementedEC2) GetIpamDiscoveredResourceCidrsPages(_ *ec2sdk.GetIpamDiscoveredResourceCidrsInput, _ func(*ec2sdk.GetIpamDiscoveredResourceCidrsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamDiscoveredResourceCidrs")
+}
+func (unimplementedEC2) GetIpamDiscoveredResourceCidrsPagesWithContext(_ context.Context, _ *ec2sdk.GetIpamDiscoveredResourceCidrsInput, _ func(*ec2sdk.GetIpamDiscoveredResourceCidrsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamDiscoveredResourceCidrs")
+}
+func (unimplementedEC2) GetIpamDiscoveredResourceCidrsRequest(_ *ec2sdk.GetIpamDiscoveredResourceCidrsInput) (*request.Request, *ec2sdk.GetIpamDiscoveredResourceCidrsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetIpamDiscoveredResourceCidrs"), nil
+}
+func (unimplementedEC2) GetIpamDiscoveredResourceCidrsWithContext(_ context.Context, _ *ec2sdk.GetIpamDiscoveredResourceCidrsInput, _ ...request.Option) (*ec2sdk.GetIpamDiscoveredResourceCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamDiscoveredResourceCidrs")
+}
+func (unimplementedEC2) GetIpamPoolAllocations(_ *ec2sdk.GetIpamPoolAllocationsInput) (*ec2sdk.GetIpamPoolAllocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamPoolAllocations")
+}
+func (unimplementedEC2) GetIpamPoolAllocationsPages(_ *ec2sdk.GetIpamPoolAllocationsInput, _ func(*ec2sdk.GetIpamPoolAllocationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamPoolAllocations")
+}
+func (unimplementedEC2) GetIpamPoolAllocationsPagesWithContext(_ context.Context, _ *ec2sdk.GetIpamPoolAllocationsInput, _ func(*ec2sdk.GetIpamPoolAllocationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamPoolAllocations")
+}
+func (unimplementedEC2) GetIpamPoolAllocationsRequest(_ *ec2sdk.GetIpamPoolAllocationsInput) (*request.Request, *ec2sdk.GetIpamPoolAllocationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetIpamPoolAllocations"), nil
+}
+func (unimplementedEC2) GetIpamPoolAllocationsWithContext(_ context.Context, _ *ec2sdk.GetIpamPoolAllocationsInput, _ ...request.Option) (*ec2sdk.GetIpamPoolAllocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamPoolAllocations")
+}
+func (unimplementedEC2) GetIpamPoolCidrs(_ *ec2sdk.GetIpamPoolCidrsInput) (*ec2sdk.GetIpamPoolCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamPoolCidrs")
+}
+func (unimplementedEC2) GetIpamPoolCidrsPages(_ *ec2sdk.GetIpamPoolCidrsInput, _ func(*ec2sdk.GetIpamPoolCidrsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamPoolCidrs")
+}
+func (unimplementedEC2) GetIpamPoolCidrsPagesWithContext(_ context.Context, _ *ec2sdk.GetIpamPoolCidrsInput, _ func(*ec2sdk.GetIpamPoolCidrsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamPoolCidrs")
+}
+func (unimplementedEC2) GetIpamPoolCidrsRequest(_ *ec2sdk.GetIpamPoolCidrsInput) (*request.Request, *ec2sdk.GetIpamPoolCidrsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetIpamPoolCidrs"), nil
+}
+func (unimplementedEC2) GetIpamPoolCidrsWithContext(_ context.Context, _ *ec2sdk.GetIpamPoolCidrsInput, _ ...request.Option) (*ec2sdk.GetIpamPoolCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamPoolCidrs")
+}
+func (unimplementedEC2) GetIpamResourceCidrs(_ *ec2sdk.GetIpamResourceCidrsInput) (*ec2sdk.GetIpamResourceCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamResourceCidrs")
+}
+func (unimplementedEC2) GetIpamResourceCidrsPages(_ *ec2sdk.GetIpamResourceCidrsInput, _ func(*ec2sdk.GetIpamResourceCidrsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamResourceCidrs")
+}
+func (unimplementedEC2) GetIpamResourceCidrsPagesWithContext(_ context.Context, _ *ec2sdk.GetIpamResourceCidrsInput, _ func(*ec2sdk.GetIpamResourceCidrsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetIpamResourceCidrs")
+}
+func (unimplementedEC2) GetIpamResourceCidrsRequest(_ *ec2sdk.GetIpamResourceCidrsInput) (*request.Request, *ec2sdk.GetIpamResourceCidrsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetIpamResourceCidrs"), nil
+}
+func (unimplementedEC2) GetIpamResourceCidrsWithContext(_ context.Context, _ *ec2sdk.GetIpamResourceCidrsInput, _ ...request.Option) (*ec2sdk.GetIpamResourceCidrsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetIpamResourceCidrs")
+}
+func (unimplementedEC2) GetLaunchTemplateData(_ *ec2sdk.GetLaunchTemplateDataInput) (*ec2sdk.GetLaunchTemplateDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetLaunchTemplateData")
+}
+func (unimplementedEC2) GetLaunchTemplateDataRequest(_ *ec2sdk.GetLaunchTemplateDataInput) (*request.Request, *ec2sdk.GetLaunchTemplateDataOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetLaunchTemplateData"), nil
+}
+func (unimplementedEC2) GetLaunchTemplateDataWithContext(_ context.Context, _ *ec2sdk.GetLaunchTemplateDataInput, _ ...request.Option) (*ec2sdk.GetLaunchTemplateDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetLaunchTemplateData")
+}
+func (unimplementedEC2) GetManagedPrefixListAssociations(_ *ec2sdk.GetManagedPrefixListAssociationsInput) (*ec2sdk.GetManagedPrefixListAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetManagedPrefixListAssociations")
+}
+func (unimplementedEC2) GetManagedPrefixListAssociationsPages(_ *ec2sdk.GetManagedPrefixListAssociationsInput, _ func(*ec2sdk.GetManagedPrefixListAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetManagedPrefixListAssociations")
+}
+func (unimplementedEC2) GetManagedPrefixListAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.GetManagedPrefixListAssociationsInput, _ func(*ec2sdk.GetManagedPrefixListAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetManagedPrefixListAssociations")
+}
+func (unimplementedEC2) GetManagedPrefixListAssociationsRequest(_ *ec2sdk.GetManagedPrefixListAssociationsInput) (*request.Request, *ec2sdk.GetManagedPrefixListAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetManagedPrefixListAssociations"), nil
+}
+func (unimplementedEC2) GetManagedPrefixListAssociationsWithContext(_ context.Context, _ *ec2sdk.GetManagedPrefixListAssociationsInput, _ ...request.Option) (*ec2sdk.GetManagedPrefixListAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetManagedPrefixListAssociations")
+}
+func (unimplementedEC2) GetManagedPrefixListEntries(_ *ec2sdk.GetManagedPrefixListEntriesInput) (*ec2sdk.GetManagedPrefixListEntriesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetManagedPrefixListEntries")
+}
+func (unimplementedEC2) GetManagedPrefixListEntriesPages(_ *ec2sdk.GetManagedPrefixListEntriesInput, _ func(*ec2sdk.GetManagedPrefixListEntriesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetManagedPrefixListEntries")
+}
+func (unimplementedEC2) GetManagedPrefixListEntriesPagesWithContext(_ context.Context, _ *ec2sdk.GetManagedPrefixListEntriesInput, _ func(*ec2sdk.GetManagedPrefixListEntriesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetManagedPrefixListEntries")
+}
+func (unimplementedEC2) GetManagedPrefixListEntriesRequest(_ *ec2sdk.GetManagedPrefixListEntriesInput) (*request.Request, *ec2sdk.GetManagedPrefixListEntriesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetManagedPrefixListEntries"), nil
+}
+func (unimplementedEC2) GetManagedPrefixListEntriesWithContext(_ context.Context, _ *ec2sdk.GetManagedPrefixListEntriesInput, _ ...request.Option) (*ec2sdk.GetManagedPrefixListEntriesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetManagedPrefixListEntries")
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeAnalysisFindings(_ *ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsInput) (*ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetNetworkInsightsAccessScopeAnalysisFindings")
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeAnalysisFindingsPages(_ *ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsInput, _ func(*ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetNetworkInsightsAccessScopeAnalysisFindings")
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeAnalysisFindingsPagesWithContext(_ context.Context, _ *ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsInput, _ func(*ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetNetworkInsightsAccessScopeAnalysisFindings")
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeAnalysisFindingsRequest(_ *ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsInput) (*request.Request, *ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetNetworkInsightsAccessScopeAnalysisFindings"), nil
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeAnalysisFindingsWithContext(_ context.Context, _ *ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsInput, _ ...request.Option) (*ec2sdk.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetNetworkInsightsAccessScopeAnalysisFindings")
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeContent(_ *ec2sdk.GetNetworkInsightsAccessScopeContentInput) (*ec2sdk.GetNetworkInsightsAccessScopeContentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetNetworkInsightsAccessScopeContent")
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeContentRequest(_ *ec2sdk.GetNetworkInsightsAccessScopeContentInput) (*request.Request, *ec2sdk.GetNetworkInsightsAccessScopeContentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetNetworkInsightsAccessScopeContent"), nil
+}
+func (unimplementedEC2) GetNetworkInsightsAccessScopeContentWithContext(_ context.Context, _ *ec2sdk.GetNetworkInsightsAccessScopeContentInput, _ ...request.Option) (*ec2sdk.GetNetworkInsightsAccessScopeContentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetNetworkInsightsAccessScopeContent")
+}
+func (unimplementedEC2) GetPasswordData(_ *ec2sdk.GetPasswordDataInput) (*ec2sdk.GetPasswordDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetPasswordData")
+}
+func (unimplementedEC2) GetPasswordDataRequest(_ *ec2sdk.GetPasswordDataInput) (*request.Request, *ec2sdk.GetPasswordDataOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetPasswordData"), nil
+}
+func (unimplementedEC2) GetPasswordDataWithContext(_ context.Context, _ *ec2sdk.GetPasswordDataInput, _ ...request.Option) (*ec2sdk.GetPasswordDataOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetPasswordData")
+}
+func (unimplementedEC2) GetReservedInstancesExchangeQuote(_ *ec2sdk.GetReservedInstancesExchangeQuoteInput) (*ec2sdk.GetReservedInstancesExchangeQuoteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetReservedInstancesExchangeQuote")
+}
+func (unimplementedEC2) GetReservedInstancesExchangeQuoteRequest(_ *ec2sdk.GetReservedInstancesExchangeQuoteInput) (*request.Request, *ec2sdk.GetReservedInstancesExchangeQuoteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetReservedInstancesExchangeQuote"), nil
+}
+func (unimplementedEC2) GetReservedInstancesExchangeQuoteWithContext(_ context.Context, _ *ec2sdk.GetReservedInstancesExchangeQuoteInput, _ ...request.Option) (*ec2sdk.GetReservedInstancesExchangeQuoteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetReservedInstancesExchangeQuote")
+}
+func (unimplementedEC2) GetSecurityGroupsForVpc(_ *ec2sdk.GetSecurityGroupsForVpcInput) (*ec2sdk.GetSecurityGroupsForVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSecurityGroupsForVpc")
+}
+func (unimplementedEC2) GetSecurityGroupsForVpcPages(_ *ec2sdk.GetSecurityGroupsForVpcInput, _ func(*ec2sdk.GetSecurityGroupsForVpcOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetSecurityGroupsForVpc")
+}
+func (unimplementedEC2) GetSecurityGroupsForVpcPagesWithContext(_ context.Context, _ *ec2sdk.GetSecurityGroupsForVpcInput, _ func(*ec2sdk.GetSecurityGroupsForVpcOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetSecurityGroupsForVpc")
+}
+func (unimplementedEC2) GetSecurityGroupsForVpcRequest(_ *ec2sdk.GetSecurityGroupsForVpcInput) (*request.Request, *ec2sdk.GetSecurityGroupsForVpcOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetSecurityGroupsForVpc"), nil
+}
+func (unimplementedEC2) GetSecurityGroupsForVpcWithContext(_ context.Context, _ *ec2sdk.GetSecurityGroupsForVpcInput, _ ...request.Option) (*ec2sdk.GetSecurityGroupsForVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSecurityGroupsForVpc")
+}
+func (unimplementedEC2) GetSerialConsoleAccessStatus(_ *ec2sdk.GetSerialConsoleAccessStatusInput) (*ec2sdk.GetSerialConsoleAccessStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSerialConsoleAccessStatus")
+}
+func (unimplementedEC2) GetSerialConsoleAccessStatusRequest(_ *ec2sdk.GetSerialConsoleAccessStatusInput) (*request.Request, *ec2sdk.GetSerialConsoleAccessStatusOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetSerialConsoleAccessStatus"), nil
+}
+func (unimplementedEC2) GetSerialConsoleAccessStatusWithContext(_ context.Context, _ *ec2sdk.GetSerialConsoleAccessStatusInput, _ ...request.Option) (*ec2sdk.GetSerialConsoleAccessStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSerialConsoleAccessStatus")
+}
+func (unimplementedEC2) GetSnapshotBlockPublicAccessState(_ *ec2sdk.GetSnapshotBlockPublicAccessStateInput) (*ec2sdk.GetSnapshotBlockPublicAccessStateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSnapshotBlockPublicAccessState")
+}
+func (unimplementedEC2) GetSnapshotBlockPublicAccessStateRequest(_ *ec2sdk.GetSnapshotBlockPublicAccessStateInput) (*request.Request, *ec2sdk.GetSnapshotBlockPublicAccessStateOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetSnapshotBlockPublicAccessState"), nil
+}
+func (unimplementedEC2) GetSnapshotBlockPublicAccessStateWithContext(_ context.Context, _ *ec2sdk.GetSnapshotBlockPublicAccessStateInput, _ ...request.Option) (*ec2sdk.GetSnapshotBlockPublicAccessStateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSnapshotBlockPublicAccessState")
+}
+func (unimplementedEC2) GetSpotPlacementScores(_ *ec2sdk.GetSpotPlacementScoresInput) (*ec2sdk.GetSpotPlacementScoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSpotPlacementScores")
+}
+func (unimplementedEC2) GetSpotPlacementScoresPages(_ *ec2sdk.GetSpotPlacementScoresInput, _ func(*ec2sdk.GetSpotPlacementScoresOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetSpotPlacementScores")
+}
+func (unimplementedEC2) GetSpotPlacementScoresPagesWithContext(_ context.Context, _ *ec2sdk.GetSpotPlacementScoresInput, _ func(*ec2sdk.GetSpotPlacementScoresOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetSpotPlacementScores")
+}
+func (unimplementedEC2) GetSpotPlacementScoresRequest(_ *ec2sdk.GetSpotPlacementScoresInput) (*request.Request, *ec2sdk.GetSpotPlacementScoresOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetSpotPlacementScores"), nil
+}
+func (unimplementedEC2) GetSpotPlacementScoresWithContext(_ context.Context, _ *ec2sdk.GetSpotPlacementScoresInput, _ ...request.Option) (*ec2sdk.GetSpotPlacementScoresOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSpotPlacementScores")
+}
+func (unimplementedEC2) GetSubnetCidrReservations(_ *ec2sdk.GetSubnetCidrReservationsInput) (*ec2sdk.GetSubnetCidrReservationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSubnetCidrReservations")
+}
+func (unimplementedEC2) GetSubnetCidrReservationsRequest(_ *ec2sdk.GetSubnetCidrReservationsInput) (*request.Request, *ec2sdk.GetSubnetCidrReservationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetSubnetCidrReservations"), nil
+}
+func (unimplementedEC2) GetSubnetCidrReservationsWithContext(_ context.Context, _ *ec2sdk.GetSubnetCidrReservationsInput, _ ...request.Option) (*ec2sdk.GetSubnetCidrReservationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetSubnetCidrReservations")
+}
+func (unimplementedEC2) GetTransitGatewayAttachmentPropagations(_ *ec2sdk.GetTransitGatewayAttachmentPropagationsInput) (*ec2sdk.GetTransitGatewayAttachmentPropagationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayAttachmentPropagations")
+}
+func (unimplementedEC2) GetTransitGatewayAttachmentPropagationsPages(_ *ec2sdk.GetTransitGatewayAttachmentPropagationsInput, _ func(*ec2sdk.GetTransitGatewayAttachmentPropagationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayAttachmentPropagations")
+}
+func (unimplementedEC2) GetTransitGatewayAttachmentPropagationsPagesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayAttachmentPropagationsInput, _ func(*ec2sdk.GetTransitGatewayAttachmentPropagationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayAttachmentPropagations")
+}
+func (unimplementedEC2) GetTransitGatewayAttachmentPropagationsRequest(_ *ec2sdk.GetTransitGatewayAttachmentPropagationsInput) (*request.Request, *ec2sdk.GetTransitGatewayAttachmentPropagationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetTransitGatewayAttachmentPropagations"), nil
+}
+func (unimplementedEC2) GetTransitGatewayAttachmentPropagationsWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayAttachmentPropagationsInput, _ ...request.Option) (*ec2sdk.GetTransitGatewayAttachmentPropagationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayAttachmentPropagations")
+}
+func (unimplementedEC2) GetTransitGatewayMulticastDomainAssociations(_ *ec2sdk.GetTransitGatewayMulticastDomainAssociationsInput) (*ec2sdk.GetTransitGatewayMulticastDomainAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayMulticastDomainAssociationsPages(_ *ec2sdk.GetTransitGatewayMulticastDomainAssociationsInput, _ func(*ec2sdk.GetTransitGatewayMulticastDomainAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayMulticastDomainAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayMulticastDomainAssociationsInput, _ func(*ec2sdk.GetTransitGatewayMulticastDomainAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayMulticastDomainAssociationsRequest(_ *ec2sdk.GetTransitGatewayMulticastDomainAssociationsInput) (*request.Request, *ec2sdk.GetTransitGatewayMulticastDomainAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetTransitGatewayMulticastDomainAssociations"), nil
+}
+func (unimplementedEC2) GetTransitGatewayMulticastDomainAssociationsWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayMulticastDomainAssociationsInput, _ ...request.Option) (*ec2sdk.GetTransitGatewayMulticastDomainAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableAssociations(_ *ec2sdk.GetTransitGatewayPolicyTableAssociationsInput) (*ec2sdk.GetTransitGatewayPolicyTableAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayPolicyTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableAssociationsPages(_ *ec2sdk.GetTransitGatewayPolicyTableAssociationsInput, _ func(*ec2sdk.GetTransitGatewayPolicyTableAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayPolicyTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayPolicyTableAssociationsInput, _ func(*ec2sdk.GetTransitGatewayPolicyTableAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayPolicyTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableAssociationsRequest(_ *ec2sdk.GetTransitGatewayPolicyTableAssociationsInput) (*request.Request, *ec2sdk.GetTransitGatewayPolicyTableAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetTransitGatewayPolicyTableAssociations"), nil
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableAssociationsWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayPolicyTableAssociationsInput, _ ...request.Option) (*ec2sdk.GetTransitGatewayPolicyTableAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayPolicyTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableEntries(_ *ec2sdk.GetTransitGatewayPolicyTableEntriesInput) (*ec2sdk.GetTransitGatewayPolicyTableEntriesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayPolicyTableEntries")
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableEntriesRequest(_ *ec2sdk.GetTransitGatewayPolicyTableEntriesInput) (*request.Request, *ec2sdk.GetTransitGatewayPolicyTableEntriesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetTransitGatewayPolicyTableEntries"), nil
+}
+func (unimplementedEC2) GetTransitGatewayPolicyTableEntriesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayPolicyTableEntriesInput, _ ...request.Option) (*ec2sdk.GetTransitGatewayPolicyTableEntriesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayPolicyTableEntries")
+}
+func (unimplementedEC2) GetTransitGatewayPrefixListReferences(_ *ec2sdk.GetTransitGatewayPrefixListReferencesInput) (*ec2sdk.GetTransitGatewayPrefixListReferencesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayPrefixListReferences")
+}
+func (unimplementedEC2) GetTransitGatewayPrefixListReferencesPages(_ *ec2sdk.GetTransitGatewayPrefixListReferencesInput, _ func(*ec2sdk.GetTransitGatewayPrefixListReferencesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayPrefixListReferences")
+}
+func (unimplementedEC2) GetTransitGatewayPrefixListReferencesPagesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayPrefixListReferencesInput, _ func(*ec2sdk.GetTransitGatewayPrefixListReferencesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayPrefixListReferences")
+}
+func (unimplementedEC2) GetTransitGatewayPrefixListReferencesRequest(_ *ec2sdk.GetTransitGatewayPrefixListReferencesInput) (*request.Request, *ec2sdk.GetTransitGatewayPrefixListReferencesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetTransitGatewayPrefixListReferences"), nil
+}
+func (unimplementedEC2) GetTransitGatewayPrefixListReferencesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayPrefixListReferencesInput, _ ...request.Option) (*ec2sdk.GetTransitGatewayPrefixListReferencesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayPrefixListReferences")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTableAssociations(_ *ec2sdk.GetTransitGatewayRouteTableAssociationsInput) (*ec2sdk.GetTransitGatewayRouteTableAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTableAssociationsPages(_ *ec2sdk.GetTransitGatewayRouteTableAssociationsInput, _ func(*ec2sdk.GetTransitGatewayRouteTableAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTableAssociationsPagesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayRouteTableAssociationsInput, _ func(*ec2sdk.GetTransitGatewayRouteTableAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTableAssociationsRequest(_ *ec2sdk.GetTransitGatewayRouteTableAssociationsInput) (*request.Request, *ec2sdk.GetTransitGatewayRouteTableAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetTransitGatewayRouteTableAssociations"), nil
+}
+func (unimplementedEC2) GetTransitGatewayRouteTableAssociationsWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayRouteTableAssociationsInput, _ ...request.Option) (*ec2sdk.GetTransitGatewayRouteTableAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTableAssociations")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTablePropagations(_ *ec2sdk.GetTransitGatewayRouteTablePropagationsInput) (*ec2sdk.GetTransitGatewayRouteTablePropagationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTablePropagations")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTablePropagationsPages(_ *ec2sdk.GetTransitGatewayRouteTablePropagationsInput, _ func(*ec2sdk.GetTransitGatewayRouteTablePropagationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTablePropagations")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTablePropagationsPagesWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayRouteTablePropagationsInput, _ func(*ec2sdk.GetTransitGatewayRouteTablePropagationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTablePropagations")
+}
+func (unimplementedEC2) GetTransitGatewayRouteTablePropagationsRequest(_ *ec2sdk.GetTransitGatewayRouteTablePropagationsInput) (*request.Request, *ec2sdk.GetTransitGatewayRouteTablePropagationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetTransitGatewayRouteTablePropagations"), nil
+}
+func (unimplementedEC2) GetTransitGatewayRouteTablePropagationsWithContext(_ context.Context, _ *ec2sdk.GetTransitGatewayRouteTablePropagationsInput, _ ...request.Option) (*ec2sdk.GetTransitGatewayRouteTablePropagationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetTransitGatewayRouteTablePropagations")
+}
+func (unimplementedEC2) GetVerifiedAccessEndpointPolicy(_ *ec2sdk.GetVerifiedAccessEndpointPolicyInput) (*ec2sdk.GetVerifiedAccessEndpointPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVerifiedAccessEndpointPolicy")
+}
+func (unimplementedEC2) GetVerifiedAccessEndpointPolicyRequest(_ *ec2sdk.GetVerifiedAccessEndpointPolicyInput) (*request.Request, *ec2sdk.GetVerifiedAccessEndpointPolicyOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetVerifiedAccessEndpointPolicy"), nil
+}
+func (unimplementedEC2) GetVerifiedAccessEndpointPolicyWithContext(_ context.Context, _ *ec2sdk.GetVerifiedAccessEndpointPolicyInput, _ ...request.Option) (*ec2sdk.GetVerifiedAccessEndpointPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVerifiedAccessEndpointPolicy")
+}
+func (unimplementedEC2) GetVerifiedAccessGroupPolicy(_ *ec2sdk.GetVerifiedAccessGroupPolicyInput) (*ec2sdk.GetVerifiedAccessGroupPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVerifiedAccessGroupPolicy")
+}
+func (unimplementedEC2) GetVerifiedAccessGroupPolicyRequest(_ *ec2sdk.GetVerifiedAccessGroupPolicyInput) (*request.Request, *ec2sdk.GetVerifiedAccessGroupPolicyOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetVerifiedAccessGroupPolicy"), nil
+}
+func (unimplementedEC2) GetVerifiedAccessGroupPolicyWithContext(_ context.Context, _ *ec2sdk.GetVerifiedAccessGroupPolicyInput, _ ...request.Option) (*ec2sdk.GetVerifiedAccessGroupPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVerifiedAccessGroupPolicy")
+}
+func (unimplementedEC2) GetVpnConnectionDeviceSampleConfiguration(_ *ec2sdk.GetVpnConnectionDeviceSampleConfigurationInput) (*ec2sdk.GetVpnConnectionDeviceSampleConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVpnConnectionDeviceSampleConfiguration")
+}
+func (unimplementedEC2) GetVpnConnectionDeviceSampleConfigurationRequest(_ *ec2sdk.GetVpnConnectionDeviceSampleConfigurationInput) (*request.Request, *ec2sdk.GetVpnConnectionDeviceSampleConfigurationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetVpnConnectionDeviceSampleConfiguration"), nil
+}
+func (unimplementedEC2) GetVpnConnectionDeviceSampleConfigurationWithContext(_ context.Context, _ *ec2sdk.GetVpnConnectionDeviceSampleConfigurationInput, _ ...request.Option) (*ec2sdk.GetVpnConnectionDeviceSampleConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVpnConnectionDeviceSampleConfiguration")
+}
+func (unimplementedEC2) GetVpnConnectionDeviceTypes(_ *ec2sdk.GetVpnConnectionDeviceTypesInput) (*ec2sdk.GetVpnConnectionDeviceTypesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVpnConnectionDeviceTypes")
+}
+func (unimplementedEC2) GetVpnConnectionDeviceTypesPages(_ *ec2sdk.GetVpnConnectionDeviceTypesInput, _ func(*ec2sdk.GetVpnConnectionDeviceTypesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "GetVpnConnectionDeviceTypes")
+}
+func (unimplementedEC2) GetVpnConnectionDeviceTypesPagesWithContext(_ context.Context, _ *ec2sdk.GetVpnConnectionDeviceTypesInput, _ func(*ec2sdk.GetVpnConnectionDeviceTypesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "GetVpnConnectionDeviceTypes")
+}
+func (unimplementedEC2) GetVpnConnectionDeviceTypesRequest(_ *ec2sdk.GetVpnConnectionDeviceTypesInput) (*request.Request, *ec2sdk.GetVpnConnectionDeviceTypesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetVpnConnectionDeviceTypes"), nil
+}
+func (unimplementedEC2) GetVpnConnectionDeviceTypesWithContext(_ context.Context, _ *ec2sdk.GetVpnConnectionDeviceTypesInput, _ ...request.Option) (*ec2sdk.GetVpnConnectionDeviceTypesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVpnConnectionDeviceTypes")
+}
+func (unimplementedEC2) GetVpnTunnelReplacementStatus(_ *ec2sdk.GetVpnTunnelReplacementStatusInput) (*ec2sdk.GetVpnTunnelReplacementStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVpnTunnelReplacementStatus")
+}
+func (unimplementedEC2) GetVpnTunnelReplacementStatusRequest(_ *ec2sdk.GetVpnTunnelReplacementStatusInput) (*request.Request, *ec2sdk.GetVpnTunnelReplacementStatusOutput) {
+	return newNotImplementedRequest(ServiceEC2, "GetVpnTunnelReplacementStatus"), nil
+}
+func (unimplementedEC2) GetVpnTunnelReplacementStatusWithContext(_ context.Context, _ *ec2sdk.GetVpnTunnelReplacementStatusInput, _ ...request.Option) (*ec2sdk.GetVpnTunnelReplacementStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "GetVpnTunnelReplacementStatus")
+}
+func (unimplementedEC2) ImportClientVpnClientCertificateRevocationList(_ *ec2sdk.ImportClientVpnClientCertificateRevocationListInput) (*ec2sdk.ImportClientVpnClientCertificateRevocationListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportClientVpnClientCertificateRevocationList")
+}
+func (unimplementedEC2) ImportClientVpnClientCertificateRevocationListRequest(_ *ec2sdk.ImportClientVpnClientCertificateRevocationListInput) (*request.Request, *ec2sdk.ImportClientVpnClientCertificateRevocationListOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ImportClientVpnClientCertificateRevocationList"), nil
+}
+func (unimplementedEC2) ImportClientVpnClientCertificateRevocationListWithContext(_ context.Context, _ *ec2sdk.ImportClientVpnClientCertificateRevocationListInput, _ ...request.Option) (*ec2sdk.ImportClientVpnClientCertificateRevocationListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportClientVpnClientCertificateRevocationList")
+}
+func (unimplementedEC2) ImportImage(_ *ec2sdk.ImportImageInput) (*ec2sdk.ImportImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportImage")
+}
+func (unimplementedEC2) ImportImageRequest(_ *ec2sdk.ImportImageInput) (*request.Request, *ec2sdk.ImportImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ImportImage"), nil
+}
+func (unimplementedEC2) ImportImageWithContext(_ context.Context, _ *ec2sdk.ImportImageInput, _ ...request.Option) (*ec2sdk.ImportImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportImage")
+}
+func (unimplementedEC2) ImportInstance(_ *ec2sdk.ImportInstanceInput) (*ec2sdk.ImportInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportInstance")
+}
+func (unimplementedEC2) ImportInstanceRequest(_ *ec2sdk.ImportInstanceInput) (*request.Request, *ec2sdk.ImportInstanceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ImportInstance"), nil
+}
+func (unimplementedEC2) ImportInstanceWithContext(_ context.Context, _ *ec2sdk.ImportInstanceInput, _ ...request.Option) (*ec2sdk.ImportInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportInstance")
+}
+func (unimplementedEC2) ImportKeyPair(_ *ec2sdk.ImportKeyPairInput) (*ec2sdk.ImportKeyPairOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportKeyPair")
+}
+func (unimplementedEC2) ImportKeyPairRequest(_ *ec2sdk.ImportKeyPairInput) (*request.Request, *ec2sdk.ImportKeyPairOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ImportKeyPair"), nil
+}
+func (unimplementedEC2) ImportKeyPairWithContext(_ context.Context, _ *ec2sdk.ImportKeyPairInput, _ ...request.Option) (*ec2sdk.ImportKeyPairOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportKeyPair")
+}
+func (unimplementedEC2) ImportSnapshot(_ *ec2sdk.ImportSnapshotInput) (*ec2sdk.ImportSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportSnapshot")
+}
+func (unimplementedEC2) ImportSnapshotRequest(_ *ec2sdk.ImportSnapshotInput) (*request.Request, *ec2sdk.ImportSnapshotOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ImportSnapshot"), nil
+}
+func (unimplementedEC2) ImportSnapshotWithContext(_ context.Context, _ *ec2sdk.ImportSnapshotInput, _ ...request.Option) (*ec2sdk.ImportSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportSnapshot")
+}
+func (unimplementedEC2) ImportVolume(_ *ec2sdk.ImportVolumeInput) (*ec2sdk.ImportVolumeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportVolume")
+}
+func (unimplementedEC2) ImportVolumeRequest(_ *ec2sdk.ImportVolumeInput) (*request.Request, *ec2sdk.ImportVolumeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ImportVolume"), nil
+}
+func (unimplementedEC2) ImportVolumeWithContext(_ context.Context, _ *ec2sdk.ImportVolumeInput, _ ...request.Option) (*ec2sdk.ImportVolumeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ImportVolume")
+}
+func (unimplementedEC2) ListImagesInRecycleBin(_ *ec2sdk.ListImagesInRecycleBinInput) (*ec2sdk.ListImagesInRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ListImagesInRecycleBin")
+}
+func (unimplementedEC2) ListImagesInRecycleBinPages(_ *ec2sdk.ListImagesInRecycleBinInput, _ func(*ec2sdk.ListImagesInRecycleBinOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "ListImagesInRecycleBin")
+}
+func (unimplementedEC2) ListImagesInRecycleBinPagesWithContext(_ context.Context, _ *ec2sdk.ListImagesInRecycleBinInput, _ func(*ec2sdk.ListImagesInRecycleBinOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "ListImagesInRecycleBin")
+}
+func (unimplementedEC2) ListImagesInRecycleBinRequest(_ *ec2sdk.ListImagesInRecycleBinInput) (*request.Request, *ec2sdk.ListImagesInRecycleBinOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ListImagesInRecycleBin"), nil
+}
+func (unimplementedEC2) ListImagesInRecycleBinWithContext(_ context.Context, _ *ec2sdk.ListImagesInRecycleBinInput, _ ...request.Option) (*ec2sdk.ListImagesInRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ListImagesInRecycleBin")
+}
+func (unimplementedEC2) ListSnapshotsInRecycleBin(_ *ec2sdk.ListSnapshotsInRecycleBinInput) (*ec2sdk.ListSnapshotsInRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ListSnapshotsInRecycleBin")
+}
+func (unimplementedEC2) ListSnapshotsInRecycleBinPages(_ *ec2sdk.ListSnapshotsInRecycleBinInput, _ func(*ec2sdk.ListSnapshotsInRecycleBinOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "ListSnapshotsInRecycleBin")
+}
+func (unimplementedEC2) ListSnapshotsInRecycleBinPagesWithContext(_ context.Context, _ *ec2sdk.ListSnapshotsInRecycleBinInput, _ func(*ec2sdk.ListSnapshotsInRecycleBinOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "ListSnapshotsInRecycleBin")
+}
+func (unimplementedEC2) ListSnapshotsInRecycleBinRequest(_ *ec2sdk.ListSnapshotsInRecycleBinInput) (*request.Request, *ec2sdk.ListSnapshotsInRecycleBinOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ListSnapshotsInRecycleBin"), nil
+}
+func (unimplementedEC2) ListSnapshotsInRecycleBinWithContext(_ context.Context, _ *ec2sdk.ListSnapshotsInRecycleBinInput, _ ...request.Option) (*ec2sdk.ListSnapshotsInRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ListSnapshotsInRecycleBin")
+}
+func (unimplementedEC2) LockSnapshot(_ *ec2sdk.LockSnapshotInput) (*ec2sdk.LockSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "LockSnapshot")
+}
+func (unimplementedEC2) LockSnapshotRequest(_ *ec2sdk.LockSnapshotInput) (*request.Request, *ec2sdk.LockSnapshotOutput) {
+	return newNotImplementedRequest(ServiceEC2, "LockSnapshot"), nil
+}
+func (unimplementedEC2) LockSnapshotWithContext(_ context.Context, _ *ec2sdk.LockSnapshotInput, _ ...request.Option) (*ec2sdk.LockSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "LockSnapshot")
+}
+func (unimplementedEC2) ModifyAddressAttribute(_ *ec2sdk.ModifyAddressAttributeInput) (*ec2sdk.ModifyAddressAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyAddressAttribute")
+}
+func (unimplementedEC2) ModifyAddressAttributeRequest(_ *ec2sdk.ModifyAddressAttributeInput) (*request.Request, *ec2sdk.ModifyAddressAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyAddressAttribute"), nil
+}
+func (unimplementedEC2) ModifyAddressAttributeWithContext(_ context.Context, _ *ec2sdk.ModifyAddressAttributeInput, _ ...request.Option) (*ec2sdk.ModifyAddressAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyAddressAttribute")
+}
+func (unimplementedEC2) ModifyAvailabilityZoneGroup(_ *ec2sdk.ModifyAvailabilityZoneGroupInput) (*ec2sdk.ModifyAvailabilityZoneGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyAvailabilityZoneGroup")
+}
+func (unimplementedEC2) ModifyAvailabilityZoneGroupRequest(_ *ec2sdk.ModifyAvailabilityZoneGroupInput) (*request.Request, *ec2sdk.ModifyAvailabilityZoneGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyAvailabilityZoneGroup"), nil
+}
+func (unimplementedEC2) ModifyAvailabilityZoneGroupWithContext(_ context.Context, _ *ec2sdk.ModifyAvailabilityZoneGroupInput, _ ...request.Option) (*ec2sdk.ModifyAvailabilityZoneGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyAvailabilityZoneGroup")
+}
+func (unimplementedEC2) ModifyCapacityReservation(_ *ec2sdk.ModifyCapacityReservationInput) (*ec2sdk.ModifyCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyCapacityReservation")
+}
+func (unimplementedEC2) ModifyCapacityReservationFleet(_ *ec2sdk.ModifyCapacityReservationFleetInput) (*ec2sdk.ModifyCapacityReservationFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyCapacityReservationFleet")
+}
+func (unimplementedEC2) ModifyCapacityReservationFleetRequest(_ *ec2sdk.ModifyCapacityReservationFleetInput) (*request.Request, *ec2sdk.ModifyCapacityReservationFleetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyCapacityReservationFleet"), nil
+}
+func (unimplementedEC2) ModifyCapacityReservationFleetWithContext(_ context.Context, _ *ec2sdk.ModifyCapacityReservationFleetInput, _ ...request.Option) (*ec2sdk.ModifyCapacityReservationFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyCapacityReservationFleet")
+}
+func (unimplementedEC2) ModifyCapacityReservationRequest(_ *ec2sdk.ModifyCapacityReservationInput) (*request.Request, *ec2sdk.ModifyCapacityReservationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyCapacityReservation"), nil
+}
+func (unimplementedEC2) ModifyCapacityReservationWithContext(_ context.Context, _ *ec2sdk.ModifyCapacityReservationInput, _ ...request.Option) (*ec2sdk.ModifyCapacityReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyCapacityReservation")
+}
+func (unimplementedEC2) ModifyClientVpnEndpoint(_ *ec2sdk.ModifyClientVpnEndpointInput) (*ec2sdk.ModifyClientVpnEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyClientVpnEndpoint")
+}
+func (unimplementedEC2) ModifyClientVpnEndpointRequest(_ *ec2sdk.ModifyClientVpnEndpointInput) (*request.Request, *ec2sdk.ModifyClientVpnEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyClientVpnEndpoint"), nil
+}
+func (unimplementedEC2) ModifyClientVpnEndpointWithContext(_ context.Context, _ *ec2sdk.ModifyClientVpnEndpointInput, _ ...request.Option) (*ec2sdk.ModifyClientVpnEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyClientVpnEndpoint")
+}
+func (unimplementedEC2) ModifyDefaultCreditSpecification(_ *ec2sdk.ModifyDefaultCreditSpecificationInput) (*ec2sdk.ModifyDefaultCreditSpecificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyDefaultCreditSpecification")
+}
+func (unimplementedEC2) ModifyDefaultCreditSpecificationRequest(_ *ec2sdk.ModifyDefaultCreditSpecificationInput) (*request.Request, *ec2sdk.ModifyDefaultCreditSpecificationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyDefaultCreditSpecification"), nil
+}
+func (unimplementedEC2) ModifyDefaultCreditSpecificationWithContext(_ context.Context, _ *ec2sdk.ModifyDefaultCreditSpecificationInput, _ ...request.Option) (*ec2sdk.ModifyDefaultCreditSpecificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyDefaultCreditSpecification")
+}
+func (unimplementedEC2) ModifyEbsDefaultKmsKeyId(_ *ec2sdk.ModifyEbsDefaultKmsKeyIdInput) (*ec2sdk.ModifyEbsDefaultKmsKeyIdOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyEbsDefaultKmsKeyId")
+}
+func (unimplementedEC2) ModifyEbsDefaultKmsKeyIdRequest(_ *ec2sdk.ModifyEbsDefaultKmsKeyIdInput) (*request.Request, *ec2sdk.ModifyEbsDefaultKmsKeyIdOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyEbsDefaultKmsKeyId"), nil
+}
+func (unimplementedEC2) ModifyEbsDefaultKmsKeyIdWithContext(_ context.Context, _ *ec2sdk.ModifyEbsDefaultKmsKeyIdInput, _ ...request.Option) (*ec2sdk.ModifyEbsDefaultKmsKeyIdOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyEbsDefaultKmsKeyId")
+}
+func (unimplementedEC2) ModifyFleet(_ *ec2sdk.ModifyFleetInput) (*ec2sdk.ModifyFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyFleet")
+}
+func (unimplementedEC2) ModifyFleetRequest(_ *ec2sdk.ModifyFleetInput) (*request.Request, *ec2sdk.ModifyFleetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyFleet"), nil
+}
+func (unimplementedEC2) ModifyFleetWithContext(_ context.Context, _ *ec2sdk.ModifyFleetInput, _ ...request.Option) (*ec2sdk.ModifyFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyFleet")
+}
+func (unimplementedEC2) ModifyFpgaImageAttribute(_ *ec2sdk.ModifyFpgaImageAttributeInput) (*ec2sdk.ModifyFpgaImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyFpgaImageAttribute")
+}
+func (unimplementedEC2) ModifyFpgaImageAttributeRequest(_ *ec2sdk.ModifyFpgaImageAttributeInput) (*request.Request, *ec2sdk.ModifyFpgaImageAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyFpgaImageAttribute"), nil
+}
+func (unimplementedEC2) ModifyFpgaImageAttributeWithContext(_ context.Context, _ *ec2sdk.ModifyFpgaImageAttributeInput, _ ...request.Option) (*ec2sdk.ModifyFpgaImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyFpgaImageAttribute")
+}
+func (unimplementedEC2) ModifyHosts(_ *ec2sdk.ModifyHostsInput) (*ec2sdk.ModifyHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyHosts")
+}
+func (unimplementedEC2) ModifyHostsRequest(_ *ec2sdk.ModifyHostsInput) (*request.Request, *ec2sdk.ModifyHostsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyHosts"), nil
+}
+func (unimplementedEC2) ModifyHostsWithContext(_ context.Context, _ *ec2sdk.ModifyHostsInput, _ ...request.Option) (*ec2sdk.ModifyHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyHosts")
+}
+func (unimplementedEC2) ModifyIdFormat(_ *ec2sdk.ModifyIdFormatInput) (*ec2sdk.ModifyIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIdFormat")
+}
+func (unimplementedEC2) ModifyIdFormatRequest(_ *ec2sdk.ModifyIdFormatInput) (*request.Request, *ec2sdk.ModifyIdFormatOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyIdFormat"), nil
+}
+func (unimplementedEC2) ModifyIdFormatWithContext(_ context.Context, _ *ec2sdk.ModifyIdFormatInput, _ ...request.Option) (*ec2sdk.ModifyIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIdFormat")
+}
+func (unimplementedEC2) ModifyIdentityIdFormat(_ *ec2sdk.ModifyIdentityIdFormatInput) (*ec2sdk.ModifyIdentityIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIdentityIdFormat")
+}
+func (unimplementedEC2) ModifyIdentityIdFormatRequest(_ *ec2sdk.ModifyIdentityIdFormatInput) (*request.Request, *ec2sdk.ModifyIdentityIdFormatOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyIdentityIdFormat"), nil
+}
+func (unimplementedEC2) ModifyIdentityIdFormatWithContext(_ context.Context, _ *ec2sdk.ModifyIdentityIdFormatInput, _ ...request.Option) (*ec2sdk.ModifyIdentityIdFormatOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIdentityIdFormat")
+}
+func (unimplementedEC2) ModifyImageAttribute(_ *ec2sdk.ModifyImageAttributeInput) (*ec2sdk.ModifyImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyImageAttribute")
+}
+func (unimplementedEC2) ModifyImageAttributeRequest(_ *ec2sdk.ModifyImageAttributeInput) (*request.Request, *ec2sdk.ModifyImageAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyImageAttribute"), nil
+}
+func (unimplementedEC2) ModifyImageAttributeWithContext(_ context.Context, _ *ec2sdk.ModifyImageAttributeInput, _ ...request.Option) (*ec2sdk.ModifyImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyImageAttribute")
+}
+func (unimplementedEC2) ModifyInstanceAttribute(_ *ec2sdk.ModifyInstanceAttributeInput) (*ec2sdk.ModifyInstanceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceAttribute")
+}
+func (unimplementedEC2) ModifyInstanceAttributeRequest(_ *ec2sdk.ModifyInstanceAttributeInput) (*request.Request, *ec2sdk.ModifyInstanceAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstanceAttribute"), nil
+}
+func (unimplementedEC2) ModifyInstanceAttributeWithContext(_ context.Context, _ *ec2sdk.ModifyInstanceAttributeInput, _ ...request.Option) (*ec2sdk.ModifyInstanceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceAttribute")
+}
+func (unimplementedEC2) ModifyInstanceCapacityReservationAttributes(_ *ec2sdk.ModifyInstanceCapacityReservationAttributesInput) (*ec2sdk.ModifyInstanceCapacityReservationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceCapacityReservationAttributes")
+}
+func (unimplementedEC2) ModifyInstanceCapacityReservationAttributesRequest(_ *ec2sdk.ModifyInstanceCapacityReservationAttributesInput) (*request.Request, *ec2sdk.ModifyInstanceCapacityReservationAttributesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstanceCapacityReservationAttributes"), nil
+}
+func (unimplementedEC2) ModifyInstanceCapacityReservationAttributesWithContext(_ context.Context, _ *ec2sdk.ModifyInstanceCapacityReservationAttributesInput, _ ...request.Option) (*ec2sdk.ModifyInstanceCapacityReservationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceCapacityReservationAttributes")
+}
+func (unimplementedEC2) ModifyInstanceCreditSpecification(_ *ec2sdk.ModifyInstanceCreditSpecificationInput) (*ec2sdk.ModifyInstanceCreditSpecificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceCreditSpecification")
+}
+func (unimplementedEC2) ModifyInstanceCreditSpecificationRequest(_ *ec2sdk.ModifyInstanceCreditSpecificationInput) (*request.Request, *ec2sdk.ModifyInstanceCreditSpecificationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstanceCreditSpecification"), nil
+}
+func (unimplementedEC2) ModifyInstanceCreditSpecificationWithContext(_ context.Context, _ *ec2sdk.ModifyInstanceCreditSpecificationInput, _ ...request.Option) (*ec2sdk.ModifyInstanceCreditSpecificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceCreditSpecification")
+}
+func (unimplementedEC2) ModifyInstanceEventStartTime(_ *ec2sdk.ModifyInstanceEventStartTimeInput) (*ec2sdk.ModifyInstanceEventStartTimeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceEventStartTime")
+}
+func (unimplementedEC2) ModifyInstanceEventStartTimeRequest(_ *ec2sdk.ModifyInstanceEventStartTimeInput) (*request.Request, *ec2sdk.ModifyInstanceEventStartTimeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstanceEventStartTime"), nil
+}
+func (unimplementedEC2) ModifyInstanceEventStartTimeWithContext(_ context.Context, _ *ec2sdk.ModifyInstanceEventStartTimeInput, _ ...request.Option) (*ec2sdk.ModifyInstanceEventStartTimeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceEventStartTime")
+}
+func (unimplementedEC2) ModifyInstanceEventWindow(_ *ec2sdk.ModifyInstanceEventWindowInput) (*ec2sdk.ModifyInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceEventWindow")
+}
+func (unimplementedEC2) ModifyInstanceEventWindowRequest(_ *ec2sdk.ModifyInstanceEventWindowInput) (*request.Request, *ec2sdk.ModifyInstanceEventWindowOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstanceEventWindow"), nil
+}
+func (unimplementedEC2) ModifyInstanceEventWindowWithContext(_ context.Context, _ *ec2sdk.ModifyInstanceEventWindowInput, _ ...request.Option) (*ec2sdk.ModifyInstanceEventWindowOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceEventWindow")
+}
+func (unimplementedEC2) ModifyInstanceMaintenanceOptions(_ *ec2sdk.ModifyInstanceMaintenanceOptionsInput) (*ec2sdk.ModifyInstanceMaintenanceOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceMaintenanceOptions")
+}
+func (unimplementedEC2) ModifyInstanceMaintenanceOptionsRequest(_ *ec2sdk.ModifyInstanceMaintenanceOptionsInput) (*request.Request, *ec2sdk.ModifyInstanceMaintenanceOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstanceMaintenanceOptions"), nil
+}
+func (unimplementedEC2) ModifyInstanceMaintenanceOptionsWithContext(_ context.Context, _ *ec2sdk.ModifyInstanceMaintenanceOptionsInput, _ ...request.Option) (*ec2sdk.ModifyInstanceMaintenanceOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceMaintenanceOptions")
+}
+func (unimplementedEC2) ModifyInstanceMetadataOptions(_ *ec2sdk.ModifyInstanceMetadataOptionsInput) (*ec2sdk.ModifyInstanceMetadataOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceMetadataOptions")
+}
+func (unimplementedEC2) ModifyInstanceMetadataOptionsRequest(_ *ec2sdk.ModifyInstanceMetadataOptionsInput) (*request.Request, *ec2sdk.ModifyInstanceMetadataOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstanceMetadataOptions"), nil
+}
+func (unimplementedEC2) ModifyInstanceMetadataOptionsWithContext(_ context.Context, _ *ec2sdk.ModifyInstanceMetadataOptionsInput, _ ...request.Option) (*ec2sdk.ModifyInstanceMetadataOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstanceMetadataOptions")
+}
+func (unimplementedEC2) ModifyInstancePlacement(_ *ec2sdk.ModifyInstancePlacementInput) (*ec2sdk.ModifyInstancePlacementOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstancePlacement")
+}
+func (unimplementedEC2) ModifyInstancePlacementRequest(_ *ec2sdk.ModifyInstancePlacementInput) (*request.Request, *ec2sdk.ModifyInstancePlacementOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyInstancePlacement"), nil
+}
+func (unimplementedEC2) ModifyInstancePlacementWithContext(_ context.Context, _ *ec2sdk.ModifyInstancePlacementInput, _ ...request.Option) (*ec2sdk.ModifyInstancePlacementOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyInstancePlacement")
+}
+func (unimplementedEC2) ModifyIpam(_ *ec2sdk.ModifyIpamInput) (*ec2sdk.ModifyIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpam")
+}
+func (unimplementedEC2) ModifyIpamPool(_ *ec2sdk.ModifyIpamPoolInput) (*ec2sdk.ModifyIpamPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamPool")
+}
+func (unimplementedEC2) ModifyIpamPoolRequest(_ *ec2sdk.ModifyIpamPoolInput) (*request.Request, *ec2sdk.ModifyIpamPoolOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyIpamPool"), nil
+}
+func (unimplementedEC2) ModifyIpamPoolWithContext(_ context.Context, _ *ec2sdk.ModifyIpamPoolInput, _ ...request.Option) (*ec2sdk.ModifyIpamPoolOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamPool")
+}
+func (unimplementedEC2) ModifyIpamRequest(_ *ec2sdk.ModifyIpamInput) (*request.Request, *ec2sdk.ModifyIpamOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyIpam"), nil
+}
+func (unimplementedEC2) ModifyIpamResourceCidr(_ *ec2sdk.ModifyIpamResourceCidrInput) (*ec2sdk.ModifyIpamResourceCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamResourceCidr")
+}
+func (unimplementedEC2) ModifyIpamResourceCidrRequest(_ *ec2sdk.ModifyIpamResourceCidrInput) (*request.Request, *ec2sdk.ModifyIpamResourceCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyIpamResourceCidr"), nil
+}
+func (unimplementedEC2) ModifyIpamResourceCidrWithContext(_ context.Context, _ *ec2sdk.ModifyIpamResourceCidrInput, _ ...request.Option) (*ec2sdk.ModifyIpamResourceCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamResourceCidr")
+}
+func (unimplementedEC2) ModifyIpamResourceDiscovery(_ *ec2sdk.ModifyIpamResourceDiscoveryInput) (*ec2sdk.ModifyIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamResourceDiscovery")
+}
+func (unimplementedEC2) ModifyIpamResourceDiscoveryRequest(_ *ec2sdk.ModifyIpamResourceDiscoveryInput) (*request.Request, *ec2sdk.ModifyIpamResourceDiscoveryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyIpamResourceDiscovery"), nil
+}
+func (unimplementedEC2) ModifyIpamResourceDiscoveryWithContext(_ context.Context, _ *ec2sdk.ModifyIpamResourceDiscoveryInput, _ ...request.Option) (*ec2sdk.ModifyIpamResourceDiscoveryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamResourceDiscovery")
+}
+func (unimplementedEC2) ModifyIpamScope(_ *ec2sdk.ModifyIpamScopeInput) (*ec2sdk.ModifyIpamScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamScope")
+}
+func (unimplementedEC2) ModifyIpamScopeRequest(_ *ec2sdk.ModifyIpamScopeInput) (*request.Request, *ec2sdk.ModifyIpamScopeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyIpamScope"), nil
+}
+func (unimplementedEC2) ModifyIpamScopeWithContext(_ context.Context, _ *ec2sdk.ModifyIpamScopeInput, _ ...request.Option) (*ec2sdk.ModifyIpamScopeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpamScope")
+}
+func (unimplementedEC2) ModifyIpamWithContext(_ context.Context, _ *ec2sdk.ModifyIpamInput, _ ...request.Option) (*ec2sdk.ModifyIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyIpam")
+}
+func (unimplementedEC2) ModifyLaunchTemplate(_ *ec2sdk.ModifyLaunchTemplateInput) (*ec2sdk.ModifyLaunchTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyLaunchTemplate")
+}
+func (unimplementedEC2) ModifyLaunchTemplateRequest(_ *ec2sdk.ModifyLaunchTemplateInput) (*request.Request, *ec2sdk.ModifyLaunchTemplateOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyLaunchTemplate"), nil
+}
+func (unimplementedEC2) ModifyLaunchTemplateWithContext(_ context.Context, _ *ec2sdk.ModifyLaunchTemplateInput, _ ...request.Option) (*ec2sdk.ModifyLaunchTemplateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyLaunchTemplate")
+}
+func (unimplementedEC2) ModifyLocalGatewayRoute(_ *ec2sdk.ModifyLocalGatewayRouteInput) (*ec2sdk.ModifyLocalGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyLocalGatewayRoute")
+}
+func (unimplementedEC2) ModifyLocalGatewayRouteRequest(_ *ec2sdk.ModifyLocalGatewayRouteInput) (*request.Request, *ec2sdk.ModifyLocalGatewayRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyLocalGatewayRoute"), nil
+}
+func (unimplementedEC2) ModifyLocalGatewayRouteWithContext(_ context.Context, _ *ec2sdk.ModifyLocalGatewayRouteInput, _ ...request.Option) (*ec2sdk.ModifyLocalGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyLocalGatewayRoute")
+}
+func (unimplementedEC2) ModifyManagedPrefixList(_ *ec2sdk.ModifyManagedPrefixListInput) (*ec2sdk.ModifyManagedPrefixListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyManagedPrefixList")
+}
+func (unimplementedEC2) ModifyManagedPrefixListRequest(_ *ec2sdk.ModifyManagedPrefixListInput) (*request.Request, *ec2sdk.ModifyManagedPrefixListOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyManagedPrefixList"), nil
+}
+func (unimplementedEC2) ModifyManagedPrefixListWithContext(_ context.Context, _ *ec2sdk.ModifyManagedPrefixListInput, _ ...request.Option) (*ec2sdk.ModifyManagedPrefixListOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyManagedPrefixList")
+}
+func (unimplementedEC2) ModifyNetworkInterfaceAttribute(_ *ec2sdk.ModifyNetworkInterfaceAttributeInput) (*ec2sdk.ModifyNetworkInterfaceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyNetworkInterfaceAttribute")
+}
+func (unimplementedEC2) ModifyNetworkInterfaceAttributeRequest(_ *ec2sdk.ModifyNetworkInterfaceAttributeInput) (*request.Request, *ec2sdk.ModifyNetworkInterfaceAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyNetworkInterfaceAttribute"), nil
+}
+func (unimplementedEC2) ModifyNetworkInterfaceAttributeWithContext(_ context.Context, _ *ec2sdk.ModifyNetworkInterfaceAttributeInput, _ ...request.Option) (*ec2sdk.ModifyNetworkInterfaceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyNetworkInterfaceAttribute")
+}
+func (unimplementedEC2) ModifyPrivateDnsNameOptions(_ *ec2sdk.ModifyPrivateDnsNameOptionsInput) (*ec2sdk.ModifyPrivateDnsNameOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyPrivateDnsNameOptions")
+}
+func (unimplementedEC2) ModifyPrivateDnsNameOptionsRequest(_ *ec2sdk.ModifyPrivateDnsNameOptionsInput) (*request.Request, *ec2sdk.ModifyPrivateDnsNameOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyPrivateDnsNameOptions"), nil
+}
+func (unimplementedEC2) ModifyPrivateDnsNameOptionsWithContext(_ context.Context, _ *ec2sdk.ModifyPrivateDnsNameOptionsInput, _ ...request.Option) (*ec2sdk.ModifyPrivateDnsNameOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyPrivateDnsNameOptions")
+}
+func (unimplementedEC2) ModifyReservedInstances(_ *ec2sdk.ModifyReservedInstancesInput) (*ec2sdk.ModifyReservedInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyReservedInstances")
+}
+func (unimplementedEC2) ModifyReservedInstancesRequest(_ *ec2sdk.ModifyReservedInstancesInput) (*request.Request, *ec2sdk.ModifyReservedInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyReservedInstances"), nil
+}
+func (unimplementedEC2) ModifyReservedInstancesWithContext(_ context.Context, _ *ec2sdk.ModifyReservedInstancesInput, _ ...request.Option) (*ec2sdk.ModifyReservedInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyReservedInstances")
+}
+func (unimplementedEC2) ModifySecurityGroupRules(_ *ec2sdk.ModifySecurityGroupRulesInput) (*ec2sdk.ModifySecurityGroupRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySecurityGroupRules")
+}
+func (unimplementedEC2) ModifySecurityGroupRulesRequest(_ *ec2sdk.ModifySecurityGroupRulesInput) (*request.Request, *ec2sdk.ModifySecurityGroupRulesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifySecurityGroupRules"), nil
+}
+func (unimplementedEC2) ModifySecurityGroupRulesWithContext(_ context.Context, _ *ec2sdk.ModifySecurityGroupRulesInput, _ ...request.Option) (*ec2sdk.ModifySecurityGroupRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySecurityGroupRules")
+}
+func (unimplementedEC2) ModifySnapshotAttribute(_ *ec2sdk.ModifySnapshotAttributeInput) (*ec2sdk.ModifySnapshotAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySnapshotAttribute")
+}
+func (unimplementedEC2) ModifySnapshotAttributeRequest(_ *ec2sdk.ModifySnapshotAttributeInput) (*request.Request, *ec2sdk.ModifySnapshotAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifySnapshotAttribute"), nil
+}
+func (unimplementedEC2) ModifySnapshotAttributeWithContext(_ context.Context, _ *ec2sdk.ModifySnapshotAttributeInput, _ ...request.Option) (*ec2sdk.ModifySnapshotAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySnapshotAttribute")
+}
+func (unimplementedEC2) ModifySnapshotTier(_ *ec2sdk.ModifySnapshotTierInput) (*ec2sdk.ModifySnapshotTierOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySnapshotTier")
+}
+func (unimplementedEC2) ModifySnapshotTierRequest(_ *ec2sdk.ModifySnapshotTierInput) (*request.Request, *ec2sdk.ModifySnapshotTierOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifySnapshotTier"), nil
+}
+func (unimplementedEC2) ModifySnapshotTierWithContext(_ context.Context, _ *ec2sdk.ModifySnapshotTierInput, _ ...request.Option) (*ec2sdk.ModifySnapshotTierOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySnapshotTier")
+}
+func (unimplementedEC2) ModifySpotFleetRequest(_ *ec2sdk.ModifySpotFleetRequestInput) (*ec2sdk.ModifySpotFleetRequestOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySpotFleet")
+}
+func (unimplementedEC2) ModifySpotFleetRequestRequest(_ *ec2sdk.ModifySpotFleetRequestInput) (*request.Request, *ec2sdk.ModifySpotFleetRequestOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifySpotFleetRequest"), nil
+}
+func (unimplementedEC2) ModifySpotFleetRequestWithContext(_ context.Context, _ *ec2sdk.ModifySpotFleetRequestInput, _ ...request.Option) (*ec2sdk.ModifySpotFleetRequestOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySpotFleetRequest")
+}
+func (unimplementedEC2) ModifySubnetAttribute(_ *ec2sdk.ModifySubnetAttributeInput) (*ec2sdk.ModifySubnetAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySubnetAttribute")
+}
+func (unimplementedEC2) ModifySubnetAttributeRequest(_ *ec2sdk.ModifySubnetAttributeInput) (*request.Request, *ec2sdk.ModifySubnetAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifySubnetAttribute"), nil
+}
+func (unimplementedEC2) ModifySubnetAttributeWithContext(_ context.Context, _ *ec2sdk.ModifySubnetAttributeInput, _ ...request.Option) (*ec2sdk.ModifySubnetAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifySubnetAttribute")
+}
+func (unimplementedEC2) ModifyTrafficMirrorFilterNetworkServices(_ *ec2sdk.ModifyTrafficMirrorFilterNetworkServicesInput) (*ec2sdk.ModifyTrafficMirrorFilterNetworkServicesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTrafficMirrorFilterNetworkServices")
+}
+func (unimplementedEC2) ModifyTrafficMirrorFilterNetworkServicesRequest(_ *ec2sdk.ModifyTrafficMirrorFilterNetworkServicesInput) (*request.Request, *ec2sdk.ModifyTrafficMirrorFilterNetworkServicesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyTrafficMirrorFilterNetworkServices"), nil
+}
+func (unimplementedEC2) ModifyTrafficMirrorFilterNetworkServicesWithContext(_ context.Context, _ *ec2sdk.ModifyTrafficMirrorFilterNetworkServicesInput, _ ...request.Option) (*ec2sdk.ModifyTrafficMirrorFilterNetworkServicesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTrafficMirrorFilterNetworkServices")
+}
+func (unimplementedEC2) ModifyTrafficMirrorFilterRule(_ *ec2sdk.ModifyTrafficMirrorFilterRuleInput) (*ec2sdk.ModifyTrafficMirrorFilterRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTrafficMirrorFilterRule")
+}
+func (unimplementedEC2) ModifyTrafficMirrorFilterRuleRequest(_ *ec2sdk.ModifyTrafficMirrorFilterRuleInput) (*request.Request, *ec2sdk.ModifyTrafficMirrorFilterRuleOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyTrafficMirrorFilterRule"), nil
+}
+func (unimplementedEC2) ModifyTrafficMirrorFilterRuleWithContext(_ context.Context, _ *ec2sdk.ModifyTrafficMirrorFilterRuleInput, _ ...request.Option) (*ec2sdk.ModifyTrafficMirrorFilterRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTrafficMirrorFilterRule")
+}
+func (unimplementedEC2) ModifyTrafficMirrorSession(_ *ec2sdk.ModifyTrafficMirrorSessionInput) (*ec2sdk.ModifyTrafficMirrorSessionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTrafficMirrorSession")
+}
+func (unimplementedEC2) ModifyTrafficMirrorSessionRequest(_ *ec2sdk.ModifyTrafficMirrorSessionInput) (*request.Request, *ec2sdk.ModifyTrafficMirrorSessionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyTrafficMirrorSession"), nil
+}
+func (unimplementedEC2) ModifyTrafficMirrorSessionWithContext(_ context.Context, _ *ec2sdk.ModifyTrafficMirrorSessionInput, _ ...request.Option) (*ec2sdk.ModifyTrafficMirrorSessionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTrafficMirrorSession")
+}
+func (unimplementedEC2) ModifyTransitGateway(_ *ec2sdk.ModifyTransitGatewayInput) (*ec2sdk.ModifyTransitGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTransitGateway")
+}
+func (unimplementedEC2) ModifyTransitGatewayPrefixListReference(_ *ec2sdk.ModifyTransitGatewayPrefixListReferenceInput) (*ec2sdk.ModifyTransitGatewayPrefixListReferenceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTransitGatewayPrefixListReference")
+}
+func (unimplementedEC2) ModifyTransitGatewayPrefixListReferenceRequest(_ *ec2sdk.ModifyTransitGatewayPrefixListReferenceInput) (*request.Request, *ec2sdk.ModifyTransitGatewayPrefixListReferenceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyTransitGatewayPrefixListReference"), nil
+}
+func (unimplementedEC2) ModifyTransitGatewayPrefixListReferenceWithContext(_ context.Context, _ *ec2sdk.ModifyTransitGatewayPrefixListReferenceInput, _ ...request.Option) (*ec2sdk.ModifyTransitGatewayPrefixListReferenceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTransitGatewayPrefixListReference")
+}
+func (unimplementedEC2) ModifyTransitGatewayRequest(_ *ec2sdk.ModifyTransitGatewayInput) (*request.Request, *ec2sdk.ModifyTransitGatewayOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyTransitGateway"), nil
+}
+func (unimplementedEC2) ModifyTransitGatewayVpcAttachment(_ *ec2sdk.ModifyTransitGatewayVpcAttachmentInput) (*ec2sdk.ModifyTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) ModifyTransitGatewayVpcAttachmentRequest(_ *ec2sdk.ModifyTransitGatewayVpcAttachmentInput) (*request.Request, *ec2sdk.ModifyTransitGatewayVpcAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyTransitGatewayVpcAttachment"), nil
+}
+func (unimplementedEC2) ModifyTransitGatewayVpcAttachmentWithContext(_ context.Context, _ *ec2sdk.ModifyTransitGatewayVpcAttachmentInput, _ ...request.Option) (*ec2sdk.ModifyTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) ModifyTransitGatewayWithContext(_ context.Context, _ *ec2sdk.ModifyTransitGatewayInput, _ ...request.Option) (*ec2sdk.ModifyTransitGatewayOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyTransitGateway")
+}
+func (unimplementedEC2) ModifyVerifiedAccessEndpoint(_ *ec2sdk.ModifyVerifiedAccessEndpointInput) (*ec2sdk.ModifyVerifiedAccessEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessEndpoint")
+}
+func (unimplementedEC2) ModifyVerifiedAccessEndpointPolicy(_ *ec2sdk.ModifyVerifiedAccessEndpointPolicyInput) (*ec2sdk.ModifyVerifiedAccessEndpointPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessEndpointPolicy")
+}
+func (unimplementedEC2) ModifyVerifiedAccessEndpointPolicyRequest(_ *ec2sdk.ModifyVerifiedAccessEndpointPolicyInput) (*request.Request, *ec2sdk.ModifyVerifiedAccessEndpointPolicyOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVerifiedAccessEndpointPolicy"), nil
+}
+func (unimplementedEC2) ModifyVerifiedAccessEndpointPolicyWithContext(_ context.Context, _ *ec2sdk.ModifyVerifiedAccessEndpointPolicyInput, _ ...request.Option) (*ec2sdk.ModifyVerifiedAccessEndpointPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessEndpointPolicy")
+}
+func (unimplementedEC2) ModifyVerifiedAccessEndpointRequest(_ *ec2sdk.ModifyVerifiedAccessEndpointInput) (*request.Request, *ec2sdk.ModifyVerifiedAccessEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVerifiedAccessEndpoint"), nil
+}
+func (unimplementedEC2) ModifyVerifiedAccessEndpointWithContext(_ context.Context, _ *ec2sdk.ModifyVerifiedAccessEndpointInput, _ ...request.Option) (*ec2sdk.ModifyVerifiedAccessEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessEndpoint")
+}
+func (unimplementedEC2) ModifyVerifiedAccessGroup(_ *ec2sdk.ModifyVerifiedAccessGroupInput) (*ec2sdk.ModifyVerifiedAccessGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessGroup")
+}
+func (unimplementedEC2) ModifyVerifiedAccessGroupPolicy(_ *ec2sdk.ModifyVerifiedAccessGroupPolicyInput) (*ec2sdk.ModifyVerifiedAccessGroupPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessGroupPolicy")
+}
+func (unimplementedEC2) ModifyVerifiedAccessGroupPolicyRequest(_ *ec2sdk.ModifyVerifiedAccessGroupPolicyInput) (*request.Request, *ec2sdk.ModifyVerifiedAccessGroupPolicyOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVerifiedAccessGroupPolicy"), nil
+}
+func (unimplementedEC2) ModifyVerifiedAccessGroupPolicyWithContext(_ context.Context, _ *ec2sdk.ModifyVerifiedAccessGroupPolicyInput, _ ...request.Option) (*ec2sdk.ModifyVerifiedAccessGroupPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessGroupPolicy")
+}
+func (unimplementedEC2) ModifyVerifiedAccessGroupRequest(_ *ec2sdk.ModifyVerifiedAccessGroupInput) (*request.Request, *ec2sdk.ModifyVerifiedAccessGroupOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVerifiedAccessGroup"), nil
+}
+func (unimplementedEC2) ModifyVerifiedAccessGroupWithContext(_ context.Context, _ *ec2sdk.ModifyVerifiedAccessGroupInput, _ ...request.Option) (*ec2sdk.ModifyVerifiedAccessGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessGroup")
+}
+func (unimplementedEC2) ModifyVerifiedAccessInstance(_ *ec2sdk.ModifyVerifiedAccessInstanceInput) (*ec2sdk.ModifyVerifiedAccessInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessInstance")
+}
+func (unimplementedEC2) ModifyVerifiedAccessInstanceLoggingConfiguration(_ *ec2sdk.ModifyVerifiedAccessInstanceLoggingConfigurationInput) (*ec2sdk.ModifyVerifiedAccessInstanceLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessInstanceLoggingConfiguration")
+}
+func (unimplementedEC2) ModifyVerifiedAccessInstanceLoggingConfigurationRequest(_ *ec2sdk.ModifyVerifiedAccessInstanceLoggingConfigurationInput) (*request.Request, *ec2sdk.ModifyVerifiedAccessInstanceLoggingConfigurationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVerifiedAccessInstanceLoggingConfiguration"), nil
+}
+func (unimplementedEC2) ModifyVerifiedAccessInstanceLoggingConfigurationWithContext(_ context.Context, _ *ec2sdk.ModifyVerifiedAccessInstanceLoggingConfigurationInput, _ ...request.Option) (*ec2sdk.ModifyVerifiedAccessInstanceLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessInstanceLoggingConfiguration")
+}
+func (unimplementedEC2) ModifyVerifiedAccessInstanceRequest(_ *ec2sdk.ModifyVerifiedAccessInstanceInput) (*request.Request, *ec2sdk.ModifyVerifiedAccessInstanceOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVerifiedAccessInstance"), nil
+}
+func (unimplementedEC2) ModifyVerifiedAccessInstanceWithContext(_ context.Context, _ *ec2sdk.ModifyVerifiedAccessInstanceInput, _ ...request.Option) (*ec2sdk.ModifyVerifiedAccessInstanceOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessInstance")
+}
+func (unimplementedEC2) ModifyVerifiedAccessTrustProvider(_ *ec2sdk.ModifyVerifiedAccessTrustProviderInput) (*ec2sdk.ModifyVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) ModifyVerifiedAccessTrustProviderRequest(_ *ec2sdk.ModifyVerifiedAccessTrustProviderInput) (*request.Request, *ec2sdk.ModifyVerifiedAccessTrustProviderOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVerifiedAccessTrustProvider"), nil
+}
+func (unimplementedEC2) ModifyVerifiedAccessTrustProviderWithContext(_ context.Context, _ *ec2sdk.ModifyVerifiedAccessTrustProviderInput, _ ...request.Option) (*ec2sdk.ModifyVerifiedAccessTrustProviderOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVerifiedAccessTrustProvider")
+}
+func (unimplementedEC2) ModifyVolume(_ *ec2sdk.ModifyVolumeInput) (*ec2sdk.ModifyVolumeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVolume")
+}
+func (unimplementedEC2) ModifyVolumeAttribute(_ *ec2sdk.ModifyVolumeAttributeInput) (*ec2sdk.ModifyVolumeAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVolumeAttribute")
+}
+func (unimplementedEC2) ModifyVolumeAttributeRequest(_ *ec2sdk.ModifyVolumeAttributeInput) (*request.Request, *ec2sdk.ModifyVolumeAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVolumeAttribute"), nil
+}
+func (unimplementedEC2) ModifyVolumeAttributeWithContext(_ context.Context, _ *ec2sdk.ModifyVolumeAttributeInput, _ ...request.Option) (*ec2sdk.ModifyVolumeAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVolumeAttribute")
+}
+func (unimplementedEC2) ModifyVolumeRequest(_ *ec2sdk.ModifyVolumeInput) (*request.Request, *ec2sdk.ModifyVolumeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVolume"), nil
+}
+func (unimplementedEC2) ModifyVolumeWithContext(_ context.Context, _ *ec2sdk.ModifyVolumeInput, _ ...request.Option) (*ec2sdk.ModifyVolumeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVolume")
+}
+func (unimplementedEC2) ModifyVpcAttribute(_ *ec2sdk.ModifyVpcAttributeInput) (*ec2sdk.ModifyVpcAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcAttribute")
+}
+func (unimplementedEC2) ModifyVpcAttributeRequest(_ *ec2sdk.ModifyVpcAttributeInput) (*request.Request, *ec2sdk.ModifyVpcAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcAttribute"), nil
+}
+func (unimplementedEC2) ModifyVpcAttributeWithContext(_ context.Context, _ *ec2sdk.ModifyVpcAttributeInput, _ ...request.Option) (*ec2sdk.ModifyVpcAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcAttribute")
+}
+func (unimplementedEC2) ModifyVpcEndpoint(_ *ec2sdk.ModifyVpcEndpointInput) (*ec2sdk.ModifyVpcEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpoint")
+}
+func (unimplementedEC2) ModifyVpcEndpointConnectionNotification(_ *ec2sdk.ModifyVpcEndpointConnectionNotificationInput) (*ec2sdk.ModifyVpcEndpointConnectionNotificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointConnectionNotification")
+}
+func (unimplementedEC2) ModifyVpcEndpointConnectionNotificationRequest(_ *ec2sdk.ModifyVpcEndpointConnectionNotificationInput) (*request.Request, *ec2sdk.ModifyVpcEndpointConnectionNotificationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcEndpointConnectionNotification"), nil
+}
+func (unimplementedEC2) ModifyVpcEndpointConnectionNotificationWithContext(_ context.Context, _ *ec2sdk.ModifyVpcEndpointConnectionNotificationInput, _ ...request.Option) (*ec2sdk.ModifyVpcEndpointConnectionNotificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointConnectionNotification")
+}
+func (unimplementedEC2) ModifyVpcEndpointRequest(_ *ec2sdk.ModifyVpcEndpointInput) (*request.Request, *ec2sdk.ModifyVpcEndpointOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcEndpoint"), nil
+}
+func (unimplementedEC2) ModifyVpcEndpointServiceConfiguration(_ *ec2sdk.ModifyVpcEndpointServiceConfigurationInput) (*ec2sdk.ModifyVpcEndpointServiceConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointServiceConfiguration")
+}
+func (unimplementedEC2) ModifyVpcEndpointServiceConfigurationRequest(_ *ec2sdk.ModifyVpcEndpointServiceConfigurationInput) (*request.Request, *ec2sdk.ModifyVpcEndpointServiceConfigurationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcEndpointServiceConfiguration"), nil
+}
+func (unimplementedEC2) ModifyVpcEndpointServiceConfigurationWithContext(_ context.Context, _ *ec2sdk.ModifyVpcEndpointServiceConfigurationInput, _ ...request.Option) (*ec2sdk.ModifyVpcEndpointServiceConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointServiceConfiguration")
+}
+func (unimplementedEC2) ModifyVpcEndpointServicePayerResponsibility(_ *ec2sdk.ModifyVpcEndpointServicePayerResponsibilityInput) (*ec2sdk.ModifyVpcEndpointServicePayerResponsibilityOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointServicePayerResponsibility")
+}
+func (unimplementedEC2) ModifyVpcEndpointServicePayerResponsibilityRequest(_ *ec2sdk.ModifyVpcEndpointServicePayerResponsibilityInput) (*request.Request, *ec2sdk.ModifyVpcEndpointServicePayerResponsibilityOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcEndpointServicePayerResponsibility"), nil
+}
+func (unimplementedEC2) ModifyVpcEndpointServicePayerResponsibilityWithContext(_ context.Context, _ *ec2sdk.ModifyVpcEndpointServicePayerResponsibilityInput, _ ...request.Option) (*ec2sdk.ModifyVpcEndpointServicePayerResponsibilityOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointServicePayerResponsibility")
+}
+func (unimplementedEC2) ModifyVpcEndpointServicePermissions(_ *ec2sdk.ModifyVpcEndpointServicePermissionsInput) (*ec2sdk.ModifyVpcEndpointServicePermissionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointServicePermissions")
+}
+func (unimplementedEC2) ModifyVpcEndpointServicePermissionsRequest(_ *ec2sdk.ModifyVpcEndpointServicePermissionsInput) (*request.Request, *ec2sdk.ModifyVpcEndpointServicePermissionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcEndpointServicePermissions"), nil
+}
+func (unimplementedEC2) ModifyVpcEndpointServicePermissionsWithContext(_ context.Context, _ *ec2sdk.ModifyVpcEndpointServicePermissionsInput, _ ...request.Option) (*ec2sdk.ModifyVpcEndpointServicePermissionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpointServicePermissions")
+}
+func (unimplementedEC2) ModifyVpcEndpointWithContext(_ context.Context, _ *ec2sdk.ModifyVpcEndpointInput, _ ...request.Option) (*ec2sdk.ModifyVpcEndpointOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcEndpoint")
+}
+func (unimplementedEC2) ModifyVpcPeeringConnectionOptions(_ *ec2sdk.ModifyVpcPeeringConnectionOptionsInput) (*ec2sdk.ModifyVpcPeeringConnectionOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcPeeringConnectionOptions")
+}
+func (unimplementedEC2) ModifyVpcPeeringConnectionOptionsRequest(_ *ec2sdk.ModifyVpcPeeringConnectionOptionsInput) (*request.Request, *ec2sdk.ModifyVpcPeeringConnectionOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcPeeringConnectionOptions"), nil
+}
+func (unimplementedEC2) ModifyVpcPeeringConnectionOptionsWithContext(_ context.Context, _ *ec2sdk.ModifyVpcPeeringConnectionOptionsInput, _ ...request.Option) (*ec2sdk.ModifyVpcPeeringConnectionOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcPeeringConnectionOptions")
+}
+func (unimplementedEC2) ModifyVpcTenancy(_ *ec2sdk.ModifyVpcTenancyInput) (*ec2sdk.ModifyVpcTenancyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcTenancy")
+}
+func (unimplementedEC2) ModifyVpcTenancyRequest(_ *ec2sdk.ModifyVpcTenancyInput) (*request.Request, *ec2sdk.ModifyVpcTenancyOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpcTenancy"), nil
+}
+func (unimplementedEC2) ModifyVpcTenancyWithContext(_ context.Context, _ *ec2sdk.ModifyVpcTenancyInput, _ ...request.Option) (*ec2sdk.ModifyVpcTenancyOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpcTenancy")
+}
+func (unimplementedEC2) ModifyVpnConnection(_ *ec2sdk.ModifyVpnConnectionInput) (*ec2sdk.ModifyVpnConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnConnection")
+}
+func (unimplementedEC2) ModifyVpnConnectionOptions(_ *ec2sdk.ModifyVpnConnectionOptionsInput) (*ec2sdk.ModifyVpnConnectionOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnConnectionOptions")
+}
+func (unimplementedEC2) ModifyVpnConnectionOptionsRequest(_ *ec2sdk.ModifyVpnConnectionOptionsInput) (*request.Request, *ec2sdk.ModifyVpnConnectionOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpnConnectionOptions"), nil
+}
+func (unimplementedEC2) ModifyVpnConnectionOptionsWithContext(_ context.Context, _ *ec2sdk.ModifyVpnConnectionOptionsInput, _ ...request.Option) (*ec2sdk.ModifyVpnConnectionOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnConnectionOptions")
+}
+func (unimplementedEC2) ModifyVpnConnectionRequest(_ *ec2sdk.ModifyVpnConnectionInput) (*request.Request, *ec2sdk.ModifyVpnConnectionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpnConnection"), nil
+}
+func (unimplementedEC2) ModifyVpnConnectionWithContext(_ context.Context, _ *ec2sdk.ModifyVpnConnectionInput, _ ...request.Option) (*ec2sdk.ModifyVpnConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnConnection")
+}
+func (unimplementedEC2) ModifyVpnTunnelCertificate(_ *ec2sdk.ModifyVpnTunnelCertificateInput) (*ec2sdk.ModifyVpnTunnelCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnTunnelCertificate")
+}
+func (unimplementedEC2) ModifyVpnTunnelCertificateRequest(_ *ec2sdk.ModifyVpnTunnelCertificateInput) (*request.Request, *ec2sdk.ModifyVpnTunnelCertificateOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpnTunnelCertificate"), nil
+}
+func (unimplementedEC2) ModifyVpnTunnelCertificateWithContext(_ context.Context, _ *ec2sdk.ModifyVpnTunnelCertificateInput, _ ...request.Option) (*ec2sdk.ModifyVpnTunnelCertificateOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnTunnelCertificate")
+}
+func (unimplementedEC2) ModifyVpnTunnelOptions(_ *ec2sdk.ModifyVpnTunnelOptionsInput) (*ec2sdk.ModifyVpnTunnelOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnTunnelOptions")
+}
+func (unimplementedEC2) ModifyVpnTunnelOptionsRequest(_ *ec2sdk.ModifyVpnTunnelOptionsInput) (*request.Request, *ec2sdk.ModifyVpnTunnelOptionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ModifyVpnTunnelOptions"), nil
+}
+func (unimplementedEC2) ModifyVpnTunnelOptionsWithContext(_ context.Context, _ *ec2sdk.ModifyVpnTunnelOptionsInput, _ ...request.Option) (*ec2sdk.ModifyVpnTunnelOptionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ModifyVpnTunnelOptions")
+}
+func (unimplementedEC2) MonitorInstances(_ *ec2sdk.MonitorInstancesInput) (*ec2sdk.MonitorInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "MonitorInstances")
+}
+func (unimplementedEC2) MonitorInstancesRequest(_ *ec2sdk.MonitorInstancesInput) (*request.Request, *ec2sdk.MonitorInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "MonitorInstances"), nil
+}
+func (unimplementedEC2) MonitorInstancesWithContext(_ context.Context, _ *ec2sdk.MonitorInstancesInput, _ ...request.Option) (*ec2sdk.MonitorInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "MonitorInstances")
+}
+func (unimplementedEC2) MoveAddressToVpc(_ *ec2sdk.MoveAddressToVpcInput) (*ec2sdk.MoveAddressToVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "MoveAddressToVpc")
+}
+func (unimplementedEC2) MoveAddressToVpcRequest(_ *ec2sdk.MoveAddressToVpcInput) (*request.Request, *ec2sdk.MoveAddressToVpcOutput) {
+	return newNotImplementedRequest(ServiceEC2, "MoveAddressToVpc"), nil
+}
+func (unimplementedEC2) MoveAddressToVpcWithContext(_ context.Context, _ *ec2sdk.MoveAddressToVpcInput, _ ...request.Option) (*ec2sdk.MoveAddressToVpcOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "MoveAddressToVpc")
+}
+func (unimplementedEC2) MoveByoipCidrToIpam(_ *ec2sdk.MoveByoipCidrToIpamInput) (*ec2sdk.MoveByoipCidrToIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "MoveByoipCidrToIpam")
+}
+func (unimplementedEC2) MoveByoipCidrToIpamRequest(_ *ec2sdk.MoveByoipCidrToIpamInput) (*request.Request, *ec2sdk.MoveByoipCidrToIpamOutput) {
+	return newNotImplementedRequest(ServiceEC2, "MoveByoipCidrToIpam"), nil
+}
+func (unimplementedEC2) MoveByoipCidrToIpamWithContext(_ context.Context, _ *ec2sdk.MoveByoipCidrToIpamInput, _ ...request.Option) (*ec2sdk.MoveByoipCidrToIpamOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "MoveByoipCidrToIpam")
+}
+func (unimplementedEC2) ProvisionByoipCidr(_ *ec2sdk.ProvisionByoipCidrInput) (*ec2sdk.ProvisionByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionByoipCidr")
+}
+func (unimplementedEC2) ProvisionByoipCidrRequest(_ *ec2sdk.ProvisionByoipCidrInput) (*request.Request, *ec2sdk.ProvisionByoipCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ProvisionByoipCidr"), nil
+}
+func (unimplementedEC2) ProvisionByoipCidrWithContext(_ context.Context, _ *ec2sdk.ProvisionByoipCidrInput, _ ...request.Option) (*ec2sdk.ProvisionByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionByoipCidr")
+}
+func (unimplementedEC2) ProvisionIpamByoasn(_ *ec2sdk.ProvisionIpamByoasnInput) (*ec2sdk.ProvisionIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionIpamByoasn")
+}
+func (unimplementedEC2) ProvisionIpamByoasnRequest(_ *ec2sdk.ProvisionIpamByoasnInput) (*request.Request, *ec2sdk.ProvisionIpamByoasnOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ProvisionIpamByoasn"), nil
+}
+func (unimplementedEC2) ProvisionIpamByoasnWithContext(_ context.Context, _ *ec2sdk.ProvisionIpamByoasnInput, _ ...request.Option) (*ec2sdk.ProvisionIpamByoasnOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionIpamByoasn")
+}
+func (unimplementedEC2) ProvisionIpamPoolCidr(_ *ec2sdk.ProvisionIpamPoolCidrInput) (*ec2sdk.ProvisionIpamPoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionIpamPoolCidr")
+}
+func (unimplementedEC2) ProvisionIpamPoolCidrRequest(_ *ec2sdk.ProvisionIpamPoolCidrInput) (*request.Request, *ec2sdk.ProvisionIpamPoolCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ProvisionIpamPoolCidr"), nil
+}
+func (unimplementedEC2) ProvisionIpamPoolCidrWithContext(_ context.Context, _ *ec2sdk.ProvisionIpamPoolCidrInput, _ ...request.Option) (*ec2sdk.ProvisionIpamPoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionIpamPoolCidr")
+}
+func (unimplementedEC2) ProvisionPublicIpv4PoolCidr(_ *ec2sdk.ProvisionPublicIpv4PoolCidrInput) (*ec2sdk.ProvisionPublicIpv4PoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionPublicIpv4PoolCidr")
+}
+func (unimplementedEC2) ProvisionPublicIpv4PoolCidrRequest(_ *ec2sdk.ProvisionPublicIpv4PoolCidrInput) (*request.Request, *ec2sdk.ProvisionPublicIpv4PoolCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ProvisionPublicIpv4PoolCidr"), nil
+}
+func (unimplementedEC2) ProvisionPublicIpv4PoolCidrWithContext(_ context.Context, _ *ec2sdk.ProvisionPublicIpv4PoolCidrInput, _ ...request.Option) (*ec2sdk.ProvisionPublicIpv4PoolCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ProvisionPublicIpv4PoolCidr")
+}
+func (unimplementedEC2) PurchaseCapacityBlock(_ *ec2sdk.PurchaseCapacityBlockInput) (*ec2sdk.PurchaseCapacityBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseCapacityBlock")
+}
+func (unimplementedEC2) PurchaseCapacityBlockRequest(_ *ec2sdk.PurchaseCapacityBlockInput) (*request.Request, *ec2sdk.PurchaseCapacityBlockOutput) {
+	return newNotImplementedRequest(ServiceEC2, "PurchaseCapacityBlock"), nil
+}
+func (unimplementedEC2) PurchaseCapacityBlockWithContext(_ context.Context, _ *ec2sdk.PurchaseCapacityBlockInput, _ ...request.Option) (*ec2sdk.PurchaseCapacityBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseCapacityBlock")
+}
+func (unimplementedEC2) PurchaseHostReservation(_ *ec2sdk.PurchaseHostReservationInput) (*ec2sdk.PurchaseHostReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseHostReservation")
+}
+func (unimplementedEC2) PurchaseHostReservationRequest(_ *ec2sdk.PurchaseHostReservationInput) (*request.Request, *ec2sdk.PurchaseHostReservationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "PurchaseHostReservation"), nil
+}
+func (unimplementedEC2) PurchaseHostReservationWithContext(_ context.Context, _ *ec2sdk.PurchaseHostReservationInput, _ ...request.Option) (*ec2sdk.PurchaseHostReservationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseHostReservation")
+}
+func (unimplementedEC2) PurchaseReservedInstancesOffering(_ *ec2sdk.PurchaseReservedInstancesOfferingInput) (*ec2sdk.PurchaseReservedInstancesOfferingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseReservedInstancesOffering")
+}
+func (unimplementedEC2) PurchaseReservedInstancesOfferingRequest(_ *ec2sdk.PurchaseReservedInstancesOfferingInput) (*request.Request, *ec2sdk.PurchaseReservedInstancesOfferingOutput) {
+	return newNotImplementedRequest(ServiceEC2, "PurchaseReservedInstancesOffering"), nil
+}
+func (unimplementedEC2) PurchaseReservedInstancesOfferingWithContext(_ context.Context, _ *ec2sdk.PurchaseReservedInstancesOfferingInput, _ ...request.Option) (*ec2sdk.PurchaseReservedInstancesOfferingOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseReservedInstancesOffering")
+}
+func (unimplementedEC2) PurchaseScheduledInstances(_ *ec2sdk.PurchaseScheduledInstancesInput) (*ec2sdk.PurchaseScheduledInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseScheduledInstances")
+}
+func (unimplementedEC2) PurchaseScheduledInstancesRequest(_ *ec2sdk.PurchaseScheduledInstancesInput) (*request.Request, *ec2sdk.PurchaseScheduledInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "PurchaseScheduledInstances"), nil
+}
+func (unimplementedEC2) PurchaseScheduledInstancesWithContext(_ context.Context, _ *ec2sdk.PurchaseScheduledInstancesInput, _ ...request.Option) (*ec2sdk.PurchaseScheduledInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "PurchaseScheduledInstances")
+}
+func (unimplementedEC2) RebootInstances(_ *ec2sdk.RebootInstancesInput) (*ec2sdk.RebootInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RebootInstances")
+}
+func (unimplementedEC2) RebootInstancesRequest(_ *ec2sdk.RebootInstancesInput) (*request.Request, *ec2sdk.RebootInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RebootInstances"), nil
+}
+func (unimplementedEC2) RebootInstancesWithContext(_ context.Context, _ *ec2sdk.RebootInstancesInput, _ ...request.Option) (*ec2sdk.RebootInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RebootInstances")
+}
+func (unimplementedEC2) RegisterImage(_ *ec2sdk.RegisterImageInput) (*ec2sdk.RegisterImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterImage")
+}
+func (unimplementedEC2) RegisterImageRequest(_ *ec2sdk.RegisterImageInput) (*request.Request, *ec2sdk.RegisterImageOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RegisterImage"), nil
+}
+func (unimplementedEC2) RegisterImageWithContext(_ context.Context, _ *ec2sdk.RegisterImageInput, _ ...request.Option) (*ec2sdk.RegisterImageOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterImage")
+}
+func (unimplementedEC2) RegisterInstanceEventNotificationAttributes(_ *ec2sdk.RegisterInstanceEventNotificationAttributesInput) (*ec2sdk.RegisterInstanceEventNotificationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterInstanceEventNotificationAttributes")
+}
+func (unimplementedEC2) RegisterInstanceEventNotificationAttributesRequest(_ *ec2sdk.RegisterInstanceEventNotificationAttributesInput) (*request.Request, *ec2sdk.RegisterInstanceEventNotificationAttributesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RegisterInstanceEventNotificationAttributes"), nil
+}
+func (unimplementedEC2) RegisterInstanceEventNotificationAttributesWithContext(_ context.Context, _ *ec2sdk.RegisterInstanceEventNotificationAttributesInput, _ ...request.Option) (*ec2sdk.RegisterInstanceEventNotificationAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterInstanceEventNotificationAttributes")
+}
+func (unimplementedEC2) RegisterTransitGatewayMulticastGroupMembers(_ *ec2sdk.RegisterTransitGatewayMulticastGroupMembersInput) (*ec2sdk.RegisterTransitGatewayMulticastGroupMembersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterTransitGatewayMulticastGroupMembers")
+}
+func (unimplementedEC2) RegisterTransitGatewayMulticastGroupMembersRequest(_ *ec2sdk.RegisterTransitGatewayMulticastGroupMembersInput) (*request.Request, *ec2sdk.RegisterTransitGatewayMulticastGroupMembersOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RegisterTransitGatewayMulticastGroupMembers"), nil
+}
+func (unimplementedEC2) RegisterTransitGatewayMulticastGroupMembersWithContext(_ context.Context, _ *ec2sdk.RegisterTransitGatewayMulticastGroupMembersInput, _ ...request.Option) (*ec2sdk.RegisterTransitGatewayMulticastGroupMembersOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterTransitGatewayMulticastGroupMembers")
+}
+func (unimplementedEC2) RegisterTransitGatewayMulticastGroupSources(_ *ec2sdk.RegisterTransitGatewayMulticastGroupSourcesInput) (*ec2sdk.RegisterTransitGatewayMulticastGroupSourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterTransitGatewayMulticastGroupSources")
+}
+func (unimplementedEC2) RegisterTransitGatewayMulticastGroupSourcesRequest(_ *ec2sdk.RegisterTransitGatewayMulticastGroupSourcesInput) (*request.Request, *ec2sdk.RegisterTransitGatewayMulticastGroupSourcesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RegisterTransitGatewayMulticastGroupSources"), nil
+}
+func (unimplementedEC2) RegisterTransitGatewayMulticastGroupSourcesWithContext(_ context.Context, _ *ec2sdk.RegisterTransitGatewayMulticastGroupSourcesInput, _ ...request.Option) (*ec2sdk.RegisterTransitGatewayMulticastGroupSourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RegisterTransitGatewayMulticastGroupSources")
+}
+func (unimplementedEC2) RejectTransitGatewayMulticastDomainAssociations(_ *ec2sdk.RejectTransitGatewayMulticastDomainAssociationsInput) (*ec2sdk.RejectTransitGatewayMulticastDomainAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) RejectTransitGatewayMulticastDomainAssociationsRequest(_ *ec2sdk.RejectTransitGatewayMulticastDomainAssociationsInput) (*request.Request, *ec2sdk.RejectTransitGatewayMulticastDomainAssociationsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RejectTransitGatewayMulticastDomainAssociations"), nil
+}
+func (unimplementedEC2) RejectTransitGatewayMulticastDomainAssociationsWithContext(_ context.Context, _ *ec2sdk.RejectTransitGatewayMulticastDomainAssociationsInput, _ ...request.Option) (*ec2sdk.RejectTransitGatewayMulticastDomainAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectTransitGatewayMulticastDomainAssociations")
+}
+func (unimplementedEC2) RejectTransitGatewayPeeringAttachment(_ *ec2sdk.RejectTransitGatewayPeeringAttachmentInput) (*ec2sdk.RejectTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) RejectTransitGatewayPeeringAttachmentRequest(_ *ec2sdk.RejectTransitGatewayPeeringAttachmentInput) (*request.Request, *ec2sdk.RejectTransitGatewayPeeringAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RejectTransitGatewayPeeringAttachment"), nil
+}
+func (unimplementedEC2) RejectTransitGatewayPeeringAttachmentWithContext(_ context.Context, _ *ec2sdk.RejectTransitGatewayPeeringAttachmentInput, _ ...request.Option) (*ec2sdk.RejectTransitGatewayPeeringAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectTransitGatewayPeeringAttachment")
+}
+func (unimplementedEC2) RejectTransitGatewayVpcAttachment(_ *ec2sdk.RejectTransitGatewayVpcAttachmentInput) (*ec2sdk.RejectTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) RejectTransitGatewayVpcAttachmentRequest(_ *ec2sdk.RejectTransitGatewayVpcAttachmentInput) (*request.Request, *ec2sdk.RejectTransitGatewayVpcAttachmentOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RejectTransitGatewayVpcAttachment"), nil
+}
+func (unimplementedEC2) RejectTransitGatewayVpcAttachmentWithContext(_ context.Context, _ *ec2sdk.RejectTransitGatewayVpcAttachmentInput, _ ...request.Option) (*ec2sdk.RejectTransitGatewayVpcAttachmentOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectTransitGatewayVpcAttachment")
+}
+func (unimplementedEC2) RejectVpcEndpointConnections(_ *ec2sdk.RejectVpcEndpointConnectionsInput) (*ec2sdk.RejectVpcEndpointConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectVpcEndpointConnections")
+}
+func (unimplementedEC2) RejectVpcEndpointConnectionsRequest(_ *ec2sdk.RejectVpcEndpointConnectionsInput) (*request.Request, *ec2sdk.RejectVpcEndpointConnectionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RejectVpcEndpointConnections"), nil
+}
+func (unimplementedEC2) RejectVpcEndpointConnectionsWithContext(_ context.Context, _ *ec2sdk.RejectVpcEndpointConnectionsInput, _ ...request.Option) (*ec2sdk.RejectVpcEndpointConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectVpcEndpointConnections")
+}
+func (unimplementedEC2) RejectVpcPeeringConnection(_ *ec2sdk.RejectVpcPeeringConnectionInput) (*ec2sdk.RejectVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectVpcPeeringConnection")
+}
+func (unimplementedEC2) RejectVpcPeeringConnectionRequest(_ *ec2sdk.RejectVpcPeeringConnectionInput) (*request.Request, *ec2sdk.RejectVpcPeeringConnectionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RejectVpcPeeringConnection"), nil
+}
+func (unimplementedEC2) RejectVpcPeeringConnectionWithContext(_ context.Context, _ *ec2sdk.RejectVpcPeeringConnectionInput, _ ...request.Option) (*ec2sdk.RejectVpcPeeringConnectionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RejectVpcPeeringConnection")
+}
+func (unimplementedEC2) ReleaseAddress(_ *ec2sdk.ReleaseAddressInput) (*ec2sdk.ReleaseAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReleaseAddress")
+}
+func (unimplementedEC2) ReleaseAddressRequest(_ *ec2sdk.ReleaseAddressInput) (*request.Request, *ec2sdk.ReleaseAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReleaseAddress"), nil
+}
+func (unimplementedEC2) ReleaseAddressWithContext(_ context.Context, _ *ec2sdk.ReleaseAddressInput, _ ...request.Option) (*ec2sdk.ReleaseAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReleaseAddress")
+}
+func (unimplementedEC2) ReleaseHosts(_ *ec2sdk.ReleaseHostsInput) (*ec2sdk.ReleaseHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReleaseHosts")
+}
+func (unimplementedEC2) ReleaseHostsRequest(_ *ec2sdk.ReleaseHostsInput) (*request.Request, *ec2sdk.ReleaseHostsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReleaseHosts"), nil
+}
+func (unimplementedEC2) ReleaseHostsWithContext(_ context.Context, _ *ec2sdk.ReleaseHostsInput, _ ...request.Option) (*ec2sdk.ReleaseHostsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReleaseHosts")
+}
+func (unimplementedEC2) ReleaseIpamPoolAllocation(_ *ec2sdk.ReleaseIpamPoolAllocationInput) (*ec2sdk.ReleaseIpamPoolAllocationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReleaseIpamPoolAllocation")
+}
+func (unimplementedEC2) ReleaseIpamPoolAllocationRequest(_ *ec2sdk.ReleaseIpamPoolAllocationInput) (*request.Request, *ec2sdk.ReleaseIpamPoolAllocationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReleaseIpamPoolAllocation"), nil
+}
+func (unimplementedEC2) ReleaseIpamPoolAllocationWithContext(_ context.Context, _ *ec2sdk.ReleaseIpamPoolAllocationInput, _ ...request.Option) (*ec2sdk.ReleaseIpamPoolAllocationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReleaseIpamPoolAllocation")
+}
+func (unimplementedEC2) ReplaceIamInstanceProfileAssociation(_ *ec2sdk.ReplaceIamInstanceProfileAssociationInput) (*ec2sdk.ReplaceIamInstanceProfileAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceIamInstanceProfileAssociation")
+}
+func (unimplementedEC2) ReplaceIamInstanceProfileAssociationRequest(_ *ec2sdk.ReplaceIamInstanceProfileAssociationInput) (*request.Request, *ec2sdk.ReplaceIamInstanceProfileAssociationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReplaceIamInstanceProfileAssociation"), nil
+}
+func (unimplementedEC2) ReplaceIamInstanceProfileAssociationWithContext(_ context.Context, _ *ec2sdk.ReplaceIamInstanceProfileAssociationInput, _ ...request.Option) (*ec2sdk.ReplaceIamInstanceProfileAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceIamInstanceProfileAssociation")
+}
+func (unimplementedEC2) ReplaceNetworkAclAssociation(_ *ec2sdk.ReplaceNetworkAclAssociationInput) (*ec2sdk.ReplaceNetworkAclAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceNetworkAclAssociation")
+}
+func (unimplementedEC2) ReplaceNetworkAclAssociationRequest(_ *ec2sdk.ReplaceNetworkAclAssociationInput) (*request.Request, *ec2sdk.ReplaceNetworkAclAssociationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReplaceNetworkAclAssociation"), nil
+}
+func (unimplementedEC2) ReplaceNetworkAclAssociationWithContext(_ context.Context, _ *ec2sdk.ReplaceNetworkAclAssociationInput, _ ...request.Option) (*ec2sdk.ReplaceNetworkAclAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceNetworkAclAssociation")
+}
+func (unimplementedEC2) ReplaceNetworkAclEntry(_ *ec2sdk.ReplaceNetworkAclEntryInput) (*ec2sdk.ReplaceNetworkAclEntryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceNetworkAclEntry")
+}
+func (unimplementedEC2) ReplaceNetworkAclEntryRequest(_ *ec2sdk.ReplaceNetworkAclEntryInput) (*request.Request, *ec2sdk.ReplaceNetworkAclEntryOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReplaceNetworkAclEntry"), nil
+}
+func (unimplementedEC2) ReplaceNetworkAclEntryWithContext(_ context.Context, _ *ec2sdk.ReplaceNetworkAclEntryInput, _ ...request.Option) (*ec2sdk.ReplaceNetworkAclEntryOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceNetworkAclEntry")
+}
+func (unimplementedEC2) ReplaceRoute(_ *ec2sdk.ReplaceRouteInput) (*ec2sdk.ReplaceRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceRoute")
+}
+func (unimplementedEC2) ReplaceRouteRequest(_ *ec2sdk.ReplaceRouteInput) (*request.Request, *ec2sdk.ReplaceRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReplaceRoute"), nil
+}
+func (unimplementedEC2) ReplaceRouteTableAssociation(_ *ec2sdk.ReplaceRouteTableAssociationInput) (*ec2sdk.ReplaceRouteTableAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceRouteTableAssociation")
+}
+func (unimplementedEC2) ReplaceRouteTableAssociationRequest(_ *ec2sdk.ReplaceRouteTableAssociationInput) (*request.Request, *ec2sdk.ReplaceRouteTableAssociationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReplaceRouteTableAssociation"), nil
+}
+func (unimplementedEC2) ReplaceRouteTableAssociationWithContext(_ context.Context, _ *ec2sdk.ReplaceRouteTableAssociationInput, _ ...request.Option) (*ec2sdk.ReplaceRouteTableAssociationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceRouteTableAssociation")
+}
+func (unimplementedEC2) ReplaceRouteWithContext(_ context.Context, _ *ec2sdk.ReplaceRouteInput, _ ...request.Option) (*ec2sdk.ReplaceRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceRoute")
+}
+func (unimplementedEC2) ReplaceTransitGatewayRoute(_ *ec2sdk.ReplaceTransitGatewayRouteInput) (*ec2sdk.ReplaceTransitGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceTransitGatewayRoute")
+}
+func (unimplementedEC2) ReplaceTransitGatewayRouteRequest(_ *ec2sdk.ReplaceTransitGatewayRouteInput) (*request.Request, *ec2sdk.ReplaceTransitGatewayRouteOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReplaceTransitGatewayRoute"), nil
+}
+func (unimplementedEC2) ReplaceTransitGatewayRouteWithContext(_ context.Context, _ *ec2sdk.ReplaceTransitGatewayRouteInput, _ ...request.Option) (*ec2sdk.ReplaceTransitGatewayRouteOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceTransitGatewayRoute")
+}
+func (unimplementedEC2) ReplaceVpnTunnel(_ *ec2sdk.ReplaceVpnTunnelInput) (*ec2sdk.ReplaceVpnTunnelOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceVpnTunnel")
+}
+func (unimplementedEC2) ReplaceVpnTunnelRequest(_ *ec2sdk.ReplaceVpnTunnelInput) (*request.Request, *ec2sdk.ReplaceVpnTunnelOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReplaceVpnTunnel"), nil
+}
+func (unimplementedEC2) ReplaceVpnTunnelWithContext(_ context.Context, _ *ec2sdk.ReplaceVpnTunnelInput, _ ...request.Option) (*ec2sdk.ReplaceVpnTunnelOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReplaceVpnTunnel")
+}
+func (unimplementedEC2) ReportInstanceStatus(_ *ec2sdk.ReportInstanceStatusInput) (*ec2sdk.ReportInstanceStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReportInstanceStatus")
+}
+func (unimplementedEC2) ReportInstanceStatusRequest(_ *ec2sdk.ReportInstanceStatusInput) (*request.Request, *ec2sdk.ReportInstanceStatusOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ReportInstanceStatus"), nil
+}
+func (unimplementedEC2) ReportInstanceStatusWithContext(_ context.Context, _ *ec2sdk.ReportInstanceStatusInput, _ ...request.Option) (*ec2sdk.ReportInstanceStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ReportInstanceStatus")
+}
+func (unimplementedEC2) RequestSpotFleet(_ *ec2sdk.RequestSpotFleetInput) (*ec2sdk.RequestSpotFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RequestSpotFleet")
+}
+func (unimplementedEC2) RequestSpotFleetRequest(_ *ec2sdk.RequestSpotFleetInput) (*request.Request, *ec2sdk.RequestSpotFleetOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RequestSpotFleet"), nil
+}
+func (unimplementedEC2) RequestSpotFleetWithContext(_ context.Context, _ *ec2sdk.RequestSpotFleetInput, _ ...request.Option) (*ec2sdk.RequestSpotFleetOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RequestSpotFleet")
+}
+func (unimplementedEC2) RequestSpotInstances(_ *ec2sdk.RequestSpotInstancesInput) (*ec2sdk.RequestSpotInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RequestSpotInstances")
+}
+func (unimplementedEC2) RequestSpotInstancesRequest(_ *ec2sdk.RequestSpotInstancesInput) (*request.Request, *ec2sdk.RequestSpotInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RequestSpotInstances"), nil
+}
+func (unimplementedEC2) RequestSpotInstancesWithContext(_ context.Context, _ *ec2sdk.RequestSpotInstancesInput, _ ...request.Option) (*ec2sdk.RequestSpotInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RequestSpotInstances")
+}
+func (unimplementedEC2) ResetAddressAttribute(_ *ec2sdk.ResetAddressAttributeInput) (*ec2sdk.ResetAddressAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetAddressAttribute")
+}
+func (unimplementedEC2) ResetAddressAttributeRequest(_ *ec2sdk.ResetAddressAttributeInput) (*request.Request, *ec2sdk.ResetAddressAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ResetAddressAttribute"), nil
+}
+func (unimplementedEC2) ResetAddressAttributeWithContext(_ context.Context, _ *ec2sdk.ResetAddressAttributeInput, _ ...request.Option) (*ec2sdk.ResetAddressAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetAddressAttribute")
+}
+func (unimplementedEC2) ResetEbsDefaultKmsKeyId(_ *ec2sdk.ResetEbsDefaultKmsKeyIdInput) (*ec2sdk.ResetEbsDefaultKmsKeyIdOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetEbsDefaultKmsKeyId")
+}
+func (unimplementedEC2) ResetEbsDefaultKmsKeyIdRequest(_ *ec2sdk.ResetEbsDefaultKmsKeyIdInput) (*request.Request, *ec2sdk.ResetEbsDefaultKmsKeyIdOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ResetEbsDefaultKmsKeyId"), nil
+}
+func (unimplementedEC2) ResetEbsDefaultKmsKeyIdWithContext(_ context.Context, _ *ec2sdk.ResetEbsDefaultKmsKeyIdInput, _ ...request.Option) (*ec2sdk.ResetEbsDefaultKmsKeyIdOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetEbsDefaultKmsKeyId")
+}
+func (unimplementedEC2) ResetFpgaImageAttribute(_ *ec2sdk.ResetFpgaImageAttributeInput) (*ec2sdk.ResetFpgaImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetFpgaImageAttribute")
+}
+func (unimplementedEC2) ResetFpgaImageAttributeRequest(_ *ec2sdk.ResetFpgaImageAttributeInput) (*request.Request, *ec2sdk.ResetFpgaImageAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ResetFpgaImageAttribute"), nil
+}
+func (unimplementedEC2) ResetFpgaImageAttributeWithContext(_ context.Context, _ *ec2sdk.ResetFpgaImageAttributeInput, _ ...request.Option) (*ec2sdk.ResetFpgaImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetFpgaImageAttribute")
+}
+func (unimplementedEC2) ResetImageAttribute(_ *ec2sdk.ResetImageAttributeInput) (*ec2sdk.ResetImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetImageAttribute")
+}
+func (unimplementedEC2) ResetImageAttributeRequest(_ *ec2sdk.ResetImageAttributeInput) (*request.Request, *ec2sdk.ResetImageAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ResetImageAttribute"), nil
+}
+func (unimplementedEC2) ResetImageAttributeWithContext(_ context.Context, _ *ec2sdk.ResetImageAttributeInput, _ ...request.Option) (*ec2sdk.ResetImageAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetImageAttribute")
+}
+func (unimplementedEC2) ResetInstanceAttribute(_ *ec2sdk.ResetInstanceAttributeInput) (*ec2sdk.ResetInstanceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetInstanceAttribute")
+}
+func (unimplementedEC2) ResetInstanceAttributeRequest(_ *ec2sdk.ResetInstanceAttributeInput) (*request.Request, *ec2sdk.ResetInstanceAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ResetInstanceAttribute"), nil
+}
+func (unimplementedEC2) ResetInstanceAttributeWithContext(_ context.Context, _ *ec2sdk.ResetInstanceAttributeInput, _ ...request.Option) (*ec2sdk.ResetInstanceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetInstanceAttribute")
+}
+func (unimplementedEC2) ResetNetworkInterfaceAttribute(_ *ec2sdk.ResetNetworkInterfaceAttributeInput) (*ec2sdk.ResetNetworkInterfaceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetNetworkInterfaceAttribute")
+}
+func (unimplementedEC2) ResetNetworkInterfaceAttributeRequest(_ *ec2sdk.ResetNetworkInterfaceAttributeInput) (*request.Request, *ec2sdk.ResetNetworkInterfaceAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ResetNetworkInterfaceAttribute"), nil
+}
+func (unimplementedEC2) ResetNetworkInterfaceAttributeWithContext(_ context.Context, _ *ec2sdk.ResetNetworkInterfaceAttributeInput, _ ...request.Option) (*ec2sdk.ResetNetworkInterfaceAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetNetworkInterfaceAttribute")
+}
+func (unimplementedEC2) ResetSnapshotAttribute(_ *ec2sdk.ResetSnapshotAttributeInput) (*ec2sdk.ResetSnapshotAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetSnapshotAttribute")
+}
+func (unimplementedEC2) ResetSnapshotAttributeRequest(_ *ec2sdk.ResetSnapshotAttributeInput) (*request.Request, *ec2sdk.ResetSnapshotAttributeOutput) {
+	return newNotImplementedRequest(ServiceEC2, "ResetSnapshotAttribute"), nil
+}
+func (unimplementedEC2) ResetSnapshotAttributeWithContext(_ context.Context, _ *ec2sdk.ResetSnapshotAttributeInput, _ ...request.Option) (*ec2sdk.ResetSnapshotAttributeOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "ResetSnapshotAttribute")
+}
+func (unimplementedEC2) RestoreAddressToClassic(_ *ec2sdk.RestoreAddressToClassicInput) (*ec2sdk.RestoreAddressToClassicOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreAddressToClassic")
+}
+func (unimplementedEC2) RestoreAddressToClassicRequest(_ *ec2sdk.RestoreAddressToClassicInput) (*request.Request, *ec2sdk.RestoreAddressToClassicOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RestoreAddressToClassic"), nil
+}
+func (unimplementedEC2) RestoreAddressToClassicWithContext(_ context.Context, _ *ec2sdk.RestoreAddressToClassicInput, _ ...request.Option) (*ec2sdk.RestoreAddressToClassicOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreAddressToClassic")
+}
+func (unimplementedEC2) RestoreImageFromRecycleBin(_ *ec2sdk.RestoreImageFromRecycleBinInput) (*ec2sdk.RestoreImageFromRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreImageFromRecycleBin")
+}
+func (unimplementedEC2) RestoreImageFromRecycleBinRequest(_ *ec2sdk.RestoreImageFromRecycleBinInput) (*request.Request, *ec2sdk.RestoreImageFromRecycleBinOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RestoreImageFromRecycleBin"), nil
+}
+func (unimplementedEC2) RestoreImageFromRecycleBinWithContext(_ context.Context, _ *ec2sdk.RestoreImageFromRecycleBinInput, _ ...request.Option) (*ec2sdk.RestoreImageFromRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreImageFromRecycleBin")
+}
+func (unimplementedEC2) RestoreManagedPrefixListVersion(_ *ec2sdk.RestoreManagedPrefixListVersionInput) (*ec2sdk.RestoreManagedPrefixListVersionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreManagedPrefixListVersion")
+}
+func (unimplementedEC2) RestoreManagedPrefixListVersionRequest(_ *ec2sdk.RestoreManagedPrefixListVersionInput) (*request.Request, *ec2sdk.RestoreManagedPrefixListVersionOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RestoreManagedPrefixListVersion"), nil
+}
+func (unimplementedEC2) RestoreManagedPrefixListVersionWithContext(_ context.Context, _ *ec2sdk.RestoreManagedPrefixListVersionInput, _ ...request.Option) (*ec2sdk.RestoreManagedPrefixListVersionOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreManagedPrefixListVersion")
+}
+func (unimplementedEC2) RestoreSnapshotFromRecycleBin(_ *ec2sdk.RestoreSnapshotFromRecycleBinInput) (*ec2sdk.RestoreSnapshotFromRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreSnapshotFromRecycleBin")
+}
+func (unimplementedEC2) RestoreSnapshotFromRecycleBinRequest(_ *ec2sdk.RestoreSnapshotFromRecycleBinInput) (*request.Request, *ec2sdk.RestoreSnapshotFromRecycleBinOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RestoreSnapshotFromRecycleBin"), nil
+}
+func (unimplementedEC2) RestoreSnapshotFromRecycleBinWithContext(_ context.Context, _ *ec2sdk.RestoreSnapshotFromRecycleBinInput, _ ...request.Option) (*ec2sdk.RestoreSnapshotFromRecycleBinOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreSnapshotFromRecycleBin")
+}
+func (unimplementedEC2) RestoreSnapshotTier(_ *ec2sdk.RestoreSnapshotTierInput) (*ec2sdk.RestoreSnapshotTierOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreSnapshotTier")
+}
+func (unimplementedEC2) RestoreSnapshotTierRequest(_ *ec2sdk.RestoreSnapshotTierInput) (*request.Request, *ec2sdk.RestoreSnapshotTierOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RestoreSnapshotTier"), nil
+}
+func (unimplementedEC2) RestoreSnapshotTierWithContext(_ context.Context, _ *ec2sdk.RestoreSnapshotTierInput, _ ...request.Option) (*ec2sdk.RestoreSnapshotTierOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RestoreSnapshotTier")
+}
+func (unimplementedEC2) RevokeClientVpnIngress(_ *ec2sdk.RevokeClientVpnIngressInput) (*ec2sdk.RevokeClientVpnIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RevokeClientVpnIngress")
+}
+func (unimplementedEC2) RevokeClientVpnIngressRequest(_ *ec2sdk.RevokeClientVpnIngressInput) (*request.Request, *ec2sdk.RevokeClientVpnIngressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RevokeClientVpnIngress"), nil
+}
+func (unimplementedEC2) RevokeClientVpnIngressWithContext(_ context.Context, _ *ec2sdk.RevokeClientVpnIngressInput, _ ...request.Option) (*ec2sdk.RevokeClientVpnIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RevokeClientVpnIngress")
+}
+func (unimplementedEC2) RevokeSecurityGroupEgress(_ *ec2sdk.RevokeSecurityGroupEgressInput) (*ec2sdk.RevokeSecurityGroupEgressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RevokeSecurityGroupEgress")
+}
+func (unimplementedEC2) RevokeSecurityGroupEgressRequest(_ *ec2sdk.RevokeSecurityGroupEgressInput) (*request.Request, *ec2sdk.RevokeSecurityGroupEgressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RevokeSecurityGroupEgress"), nil
+}
+func (unimplementedEC2) RevokeSecurityGroupEgressWithContext(_ context.Context, _ *ec2sdk.RevokeSecurityGroupEgressInput, _ ...request.Option) (*ec2sdk.RevokeSecurityGroupEgressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RevokeSecurityGroupEgress")
+}
+func (unimplementedEC2) RevokeSecurityGroupIngress(_ *ec2sdk.RevokeSecurityGroupIngressInput) (*ec2sdk.RevokeSecurityGroupIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RevokeSecurityGroupIngress")
+}
+func (unimplementedEC2) RevokeSecurityGroupIngressRequest(_ *ec2sdk.RevokeSecurityGroupIngressInput) (*request.Request, *ec2sdk.RevokeSecurityGroupIngressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RevokeSecurityGroupIngress"), nil
+}
+func (unimplementedEC2) RevokeSecurityGroupIngressWithContext(_ context.Context, _ *ec2sdk.RevokeSecurityGroupIngressInput, _ ...request.Option) (*ec2sdk.RevokeSecurityGroupIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RevokeSecurityGroupIngress")
+}
+func (unimplementedEC2) RunInstances(_ *ec2sdk.RunInstancesInput) (*ec2sdk.Reservation, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RunInstances")
+}
+func (unimplementedEC2) RunInstancesRequest(_ *ec2sdk.RunInstancesInput) (*request.Request, *ec2sdk.Reservation) {
+	return newNotImplementedRequest(ServiceEC2, "RunInstances"), nil
+}
+func (unimplementedEC2) RunInstancesWithContext(_ context.Context, _ *ec2sdk.RunInstancesInput, _ ...request.Option) (*ec2sdk.Reservation, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RunInstances")
+}
+func (unimplementedEC2) RunScheduledInstances(_ *ec2sdk.RunScheduledInstancesInput) (*ec2sdk.RunScheduledInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RunScheduledInstances")
+}
+func (unimplementedEC2) RunScheduledInstancesRequest(_ *ec2sdk.RunScheduledInstancesInput) (*request.Request, *ec2sdk.RunScheduledInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "RunScheduledInstances"), nil
+}
+func (unimplementedEC2) RunScheduledInstancesWithContext(_ context.Context, _ *ec2sdk.RunScheduledInstancesInput, _ ...request.Option) (*ec2sdk.RunScheduledInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "RunScheduledInstances")
+}
+func (unimplementedEC2) SearchLocalGatewayRoutes(_ *ec2sdk.SearchLocalGatewayRoutesInput) (*ec2sdk.SearchLocalGatewayRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SearchLocalGatewayRoutes")
+}
+func (unimplementedEC2) SearchLocalGatewayRoutesPages(_ *ec2sdk.SearchLocalGatewayRoutesInput, _ func(*ec2sdk.SearchLocalGatewayRoutesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "SearchLocalGatewayRoutes")
+}
+func (unimplementedEC2) SearchLocalGatewayRoutesPagesWithContext(_ context.Context, _ *ec2sdk.SearchLocalGatewayRoutesInput, _ func(*ec2sdk.SearchLocalGatewayRoutesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "SearchLocalGatewayRoutes")
+}
+func (unimplementedEC2) SearchLocalGatewayRoutesRequest(_ *ec2sdk.SearchLocalGatewayRoutesInput) (*request.Request, *ec2sdk.SearchLocalGatewayRoutesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "SearchLocalGatewayRoutes"), nil
+}
+func (unimplementedEC2) SearchLocalGatewayRoutesWithContext(_ context.Context, _ *ec2sdk.SearchLocalGatewayRoutesInput, _ ...request.Option) (*ec2sdk.SearchLocalGatewayRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SearchLocalGatewayRoutes")
+}
+func (unimplementedEC2) SearchTransitGatewayMulticastGroups(_ *ec2sdk.SearchTransitGatewayMulticastGroupsInput) (*ec2sdk.SearchTransitGatewayMulticastGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SearchTransitGatewayMulticastGroups")
+}
+func (unimplementedEC2) SearchTransitGatewayMulticastGroupsPages(_ *ec2sdk.SearchTransitGatewayMulticastGroupsInput, _ func(*ec2sdk.SearchTransitGatewayMulticastGroupsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceEC2, "SearchTransitGatewayMulticastGroups")
+}
+func (unimplementedEC2) SearchTransitGatewayMulticastGroupsPagesWithContext(_ context.Context, _ *ec2sdk.SearchTransitGatewayMulticastGroupsInput, _ func(*ec2sdk.SearchTransitGatewayMulticastGroupsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceEC2, "SearchTransitGatewayMulticastGroups")
+}
+func (unimplementedEC2) SearchTransitGatewayMulticastGroupsRequest(_ *ec2sdk.SearchTransitGatewayMulticastGroupsInput) (*request.Request, *ec2sdk.SearchTransitGatewayMulticastGroupsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "SearchTransitGatewayMulticastGroups"), nil
+}
+func (unimplementedEC2) SearchTransitGatewayMulticastGroupsWithContext(_ context.Context, _ *ec2sdk.SearchTransitGatewayMulticastGroupsInput, _ ...request.Option) (*ec2sdk.SearchTransitGatewayMulticastGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SearchTransitGatewayMulticastGroups")
+}
+func (unimplementedEC2) SearchTransitGatewayRoutes(_ *ec2sdk.SearchTransitGatewayRoutesInput) (*ec2sdk.SearchTransitGatewayRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SearchTransitGatewayRoutes")
+}
+func (unimplementedEC2) SearchTransitGatewayRoutesRequest(_ *ec2sdk.SearchTransitGatewayRoutesInput) (*request.Request, *ec2sdk.SearchTransitGatewayRoutesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "SearchTransitGatewayRoutes"), nil
+}
+func (unimplementedEC2) SearchTransitGatewayRoutesWithContext(_ context.Context, _ *ec2sdk.SearchTransitGatewayRoutesInput, _ ...request.Option) (*ec2sdk.SearchTransitGatewayRoutesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SearchTransitGatewayRoutes")
+}
+func (unimplementedEC2) SendDiagnosticInterrupt(_ *ec2sdk.SendDiagnosticInterruptInput) (*ec2sdk.SendDiagnosticInterruptOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SendDiagnosticInterrupt")
+}
+func (unimplementedEC2) SendDiagnosticInterruptRequest(_ *ec2sdk.SendDiagnosticInterruptInput) (*request.Request, *ec2sdk.SendDiagnosticInterruptOutput) {
+	return newNotImplementedRequest(ServiceEC2, "SendDiagnosticInterrupt"), nil
+}
+func (unimplementedEC2) SendDiagnosticInterruptWithContext(_ context.Context, _ *ec2sdk.SendDiagnosticInterruptInput, _ ...request.Option) (*ec2sdk.SendDiagnosticInterruptOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "SendDiagnosticInterrupt")
+}
+func (unimplementedEC2) StartInstances(_ *ec2sdk.StartInstancesInput) (*ec2sdk.StartInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartInstances")
+}
+func (unimplementedEC2) StartInstancesRequest(_ *ec2sdk.StartInstancesInput) (*request.Request, *ec2sdk.StartInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "StartInstances"), nil
+}
+func (unimplementedEC2) StartInstancesWithContext(_ context.Context, _ *ec2sdk.StartInstancesInput, _ ...request.Option) (*ec2sdk.StartInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartInstances")
+}
+func (unimplementedEC2) StartNetworkInsightsAccessScopeAnalysis(_ *ec2sdk.StartNetworkInsightsAccessScopeAnalysisInput) (*ec2sdk.StartNetworkInsightsAccessScopeAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartNetworkInsightsAccessScopeAnalysis")
+}
+func (unimplementedEC2) StartNetworkInsightsAccessScopeAnalysisRequest(_ *ec2sdk.StartNetworkInsightsAccessScopeAnalysisInput) (*request.Request, *ec2sdk.StartNetworkInsightsAccessScopeAnalysisOutput) {
+	return newNotImplementedRequest(ServiceEC2, "StartNetworkInsightsAccessScopeAnalysis"), nil
+}
+func (unimplementedEC2) StartNetworkInsightsAccessScopeAnalysisWithContext(_ context.Context, _ *ec2sdk.StartNetworkInsightsAccessScopeAnalysisInput, _ ...request.Option) (*ec2sdk.StartNetworkInsightsAccessScopeAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartNetworkInsightsAccessScopeAnalysis")
+}
+func (unimplementedEC2) StartNetworkInsightsAnalysis(_ *ec2sdk.StartNetworkInsightsAnalysisInput) (*ec2sdk.StartNetworkInsightsAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartNetworkInsightsAnalysis")
+}
+func (unimplementedEC2) StartNetworkInsightsAnalysisRequest(_ *ec2sdk.StartNetworkInsightsAnalysisInput) (*request.Request, *ec2sdk.StartNetworkInsightsAnalysisOutput) {
+	return newNotImplementedRequest(ServiceEC2, "StartNetworkInsightsAnalysis"), nil
+}
+func (unimplementedEC2) StartNetworkInsightsAnalysisWithContext(_ context.Context, _ *ec2sdk.StartNetworkInsightsAnalysisInput, _ ...request.Option) (*ec2sdk.StartNetworkInsightsAnalysisOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartNetworkInsightsAnalysis")
+}
+func (unimplementedEC2) StartVpcEndpointServicePrivateDnsVerification(_ *ec2sdk.StartVpcEndpointServicePrivateDnsVerificationInput) (*ec2sdk.StartVpcEndpointServicePrivateDnsVerificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartVpcEndpointServicePrivateDnsVerification")
+}
+func (unimplementedEC2) StartVpcEndpointServicePrivateDnsVerificationRequest(_ *ec2sdk.StartVpcEndpointServicePrivateDnsVerificationInput) (*request.Request, *ec2sdk.StartVpcEndpointServicePrivateDnsVerificationOutput) {
+	return newNotImplementedRequest(ServiceEC2, "StartVpcEndpointServicePrivateDnsVerification"), nil
+}
+func (unimplementedEC2) StartVpcEndpointServicePrivateDnsVerificationWithContext(_ context.Context, _ *ec2sdk.StartVpcEndpointServicePrivateDnsVerificationInput, _ ...request.Option) (*ec2sdk.StartVpcEndpointServicePrivateDnsVerificationOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StartVpcEndpointServicePrivateDnsVerification")
+}
+func (unimplementedEC2) StopInstances(_ *ec2sdk.StopInstancesInput) (*ec2sdk.StopInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StopInstances")
+}
+func (unimplementedEC2) StopInstancesRequest(_ *ec2sdk.StopInstancesInput) (*request.Request, *ec2sdk.StopInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "StopInstances"), nil
+}
+func (unimplementedEC2) StopInstancesWithContext(_ context.Context, _ *ec2sdk.StopInstancesInput, _ ...request.Option) (*ec2sdk.StopInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "StopInstances")
+}
+func (unimplementedEC2) TerminateClientVpnConnections(_ *ec2sdk.TerminateClientVpnConnectionsInput) (*ec2sdk.TerminateClientVpnConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "TerminateClientVpnConnections")
+}
+func (unimplementedEC2) TerminateClientVpnConnectionsRequest(_ *ec2sdk.TerminateClientVpnConnectionsInput) (*request.Request, *ec2sdk.TerminateClientVpnConnectionsOutput) {
+	return newNotImplementedRequest(ServiceEC2, "TerminateClientVpnConnections"), nil
+}
+func (unimplementedEC2) TerminateClientVpnConnectionsWithContext(_ context.Context, _ *ec2sdk.TerminateClientVpnConnectionsInput, _ ...request.Option) (*ec2sdk.TerminateClientVpnConnectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "TerminateClientVpnConnections")
+}
+func (unimplementedEC2) TerminateInstances(_ *ec2sdk.TerminateInstancesInput) (*ec2sdk.TerminateInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "TerminateInstances")
+}
+func (unimplementedEC2) TerminateInstancesRequest(_ *ec2sdk.TerminateInstancesInput) (*request.Request, *ec2sdk.TerminateInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "TerminateInstances"), nil
+}
+func (unimplementedEC2) TerminateInstancesWithContext(_ context.Context, _ *ec2sdk.TerminateInstancesInput, _ ...request.Option) (*ec2sdk.TerminateInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "TerminateInstances")
+}
+func (unimplementedEC2) UnassignIpv6Addresses(_ *ec2sdk.UnassignIpv6AddressesInput) (*ec2sdk.UnassignIpv6AddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnassignIpv6Addresses")
+}
+func (unimplementedEC2) UnassignIpv6AddressesRequest(_ *ec2sdk.UnassignIpv6AddressesInput) (*request.Request, *ec2sdk.UnassignIpv6AddressesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "UnassignIpv6Addresses"), nil
+}
+func (unimplementedEC2) UnassignIpv6AddressesWithContext(_ context.Context, _ *ec2sdk.UnassignIpv6AddressesInput, _ ...request.Option) (*ec2sdk.UnassignIpv6AddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnassignIpv6Addresses")
+}
+func (unimplementedEC2) UnassignPrivateIpAddresses(_ *ec2sdk.UnassignPrivateIpAddressesInput) (*ec2sdk.UnassignPrivateIpAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnassignPrivateIpAddresses")
+}
+func (unimplementedEC2) UnassignPrivateIpAddressesRequest(_ *ec2sdk.UnassignPrivateIpAddressesInput) (*request.Request, *ec2sdk.UnassignPrivateIpAddressesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "UnassignPrivateIpAddresses"), nil
+}
+func (unimplementedEC2) UnassignPrivateIpAddressesWithContext(_ context.Context, _ *ec2sdk.UnassignPrivateIpAddressesInput, _ ...request.Option) (*ec2sdk.UnassignPrivateIpAddressesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnassignPrivateIpAddresses")
+}
+func (unimplementedEC2) UnassignPrivateNatGatewayAddress(_ *ec2sdk.UnassignPrivateNatGatewayAddressInput) (*ec2sdk.UnassignPrivateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnassignPrivateNatGatewayAddress")
+}
+func (unimplementedEC2) UnassignPrivateNatGatewayAddressRequest(_ *ec2sdk.UnassignPrivateNatGatewayAddressInput) (*request.Request, *ec2sdk.UnassignPrivateNatGatewayAddressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "UnassignPrivateNatGatewayAddress"), nil
+}
+func (unimplementedEC2) UnassignPrivateNatGatewayAddressWithContext(_ context.Context, _ *ec2sdk.UnassignPrivateNatGatewayAddressInput, _ ...request.Option) (*ec2sdk.UnassignPrivateNatGatewayAddressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnassignPrivateNatGatewayAddress")
+}
+func (unimplementedEC2) UnlockSnapshot(_ *ec2sdk.UnlockSnapshotInput) (*ec2sdk.UnlockSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnlockSnapshot")
+}
+func (unimplementedEC2) UnlockSnapshotRequest(_ *ec2sdk.UnlockSnapshotInput) (*request.Request, *ec2sdk.UnlockSnapshotOutput) {
+	return newNotImplementedRequest(ServiceEC2, "UnlockSnapshot"), nil
+}
+func (unimplementedEC2) UnlockSnapshotWithContext(_ context.Context, _ *ec2sdk.UnlockSnapshotInput, _ ...request.Option) (*ec2sdk.UnlockSnapshotOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnlockSnapshot")
+}
+func (unimplementedEC2) UnmonitorInstances(_ *ec2sdk.UnmonitorInstancesInput) (*ec2sdk.UnmonitorInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnmonitorInstances")
+}
+func (unimplementedEC2) UnmonitorInstancesRequest(_ *ec2sdk.UnmonitorInstancesInput) (*request.Request, *ec2sdk.UnmonitorInstancesOutput) {
+	return newNotImplementedRequest(ServiceEC2, "UnmonitorInstances"), nil
+}
+func (unimplementedEC2) UnmonitorInstancesWithContext(_ context.Context, _ *ec2sdk.UnmonitorInstancesInput, _ ...request.Option) (*ec2sdk.UnmonitorInstancesOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UnmonitorInstances")
+}
+func (unimplementedEC2) UpdateSecurityGroupRuleDescriptionsEgress(_ *ec2sdk.UpdateSecurityGroupRuleDescriptionsEgressInput) (*ec2sdk.UpdateSecurityGroupRuleDescriptionsEgressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UpdateSecurityGroupRuleDescriptionsEgress")
+}
+func (unimplementedEC2) UpdateSecurityGroupRuleDescriptionsEgressRequest(_ *ec2sdk.UpdateSecurityGroupRuleDescriptionsEgressInput) (*request.Request, *ec2sdk.UpdateSecurityGroupRuleDescriptionsEgressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "UpdateSecurityGroupRuleDescriptionsEgress"), nil
+}
+func (unimplementedEC2) UpdateSecurityGroupRuleDescriptionsEgressWithContext(_ context.Context, _ *ec2sdk.UpdateSecurityGroupRuleDescriptionsEgressInput, _ ...request.Option) (*ec2sdk.UpdateSecurityGroupRuleDescriptionsEgressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UpdateSecurityGroupRuleDescriptionsEgress")
+}
+func (unimplementedEC2) UpdateSecurityGroupRuleDescriptionsIngress(_ *ec2sdk.UpdateSecurityGroupRuleDescriptionsIngressInput) (*ec2sdk.UpdateSecurityGroupRuleDescriptionsIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UpdateSecurityGroupRuleDescriptionsIngress")
+}
+func (unimplementedEC2) UpdateSecurityGroupRuleDescriptionsIngressRequest(_ *ec2sdk.UpdateSecurityGroupRuleDescriptionsIngressInput) (*request.Request, *ec2sdk.UpdateSecurityGroupRuleDescriptionsIngressOutput) {
+	return newNotImplementedRequest(ServiceEC2, "UpdateSecurityGroupRuleDescriptionsIngress"), nil
+}
+func (unimplementedEC2) UpdateSecurityGroupRuleDescriptionsIngressWithContext(_ context.Context, _ *ec2sdk.UpdateSecurityGroupRuleDescriptionsIngressInput, _ ...request.Option) (*ec2sdk.UpdateSecurityGroupRuleDescriptionsIngressOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "UpdateSecurityGroupRuleDescriptionsIngress")
+}
+func (unimplementedEC2) WaitUntilBundleTaskComplete(_ *ec2sdk.DescribeBundleTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilBundleTaskComplete")
+}
+func (unimplementedEC2) WaitUntilBundleTaskCompleteWithContext(_ context.Context, _ *ec2sdk.DescribeBundleTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilBundleTaskComplete")
+}
+func (unimplementedEC2) WaitUntilConversionTaskCancelled(_ *ec2sdk.DescribeConversionTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilConversionTaskCancelled")
+}
+func (unimplementedEC2) WaitUntilConversionTaskCancelledWithContext(_ context.Context, _ *ec2sdk.DescribeConversionTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilConversionTaskCancelled")
+}
+func (unimplementedEC2) WaitUntilConversionTaskCompleted(_ *ec2sdk.DescribeConversionTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilConversionTaskCompleted")
+}
+func (unimplementedEC2) WaitUntilConversionTaskCompletedWithContext(_ context.Context, _ *ec2sdk.DescribeConversionTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilConversionTaskCompleted")
+}
+func (unimplementedEC2) WaitUntilConversionTaskDeleted(_ *ec2sdk.DescribeConversionTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilConversionTaskDeleted")
+}
+func (unimplementedEC2) WaitUntilConversionTaskDeletedWithContext(_ context.Context, _ *ec2sdk.DescribeConversionTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilConversionTaskDeleted")
+}
+func (unimplementedEC2) WaitUntilCustomerGatewayAvailable(_ *ec2sdk.DescribeCustomerGatewaysInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilCustomerGatewayAvailable")
+}
+func (unimplementedEC2) WaitUntilCustomerGatewayAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeCustomerGatewaysInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilCustomerGatewayAvailable")
+}
+func (unimplementedEC2) WaitUntilExportTaskCancelled(_ *ec2sdk.DescribeExportTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilExportTaskCancelled")
+}
+func (unimplementedEC2) WaitUntilExportTaskCancelledWithContext(_ context.Context, _ *ec2sdk.DescribeExportTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilExportTaskCancelled")
+}
+func (unimplementedEC2) WaitUntilExportTaskCompleted(_ *ec2sdk.DescribeExportTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilExportTaskCompleted")
+}
+func (unimplementedEC2) WaitUntilExportTaskCompletedWithContext(_ context.Context, _ *ec2sdk.DescribeExportTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilExportTaskCompleted")
+}
+func (unimplementedEC2) WaitUntilImageAvailable(_ *ec2sdk.DescribeImagesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilImageAvailable")
+}
+func (unimplementedEC2) WaitUntilImageAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeImagesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilImageAvailable")
+}
+func (unimplementedEC2) WaitUntilImageExists(_ *ec2sdk.DescribeImagesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilImageExists")
+}
+func (unimplementedEC2) WaitUntilImageExistsWithContext(_ context.Context, _ *ec2sdk.DescribeImagesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilImageExists")
+}
+func (unimplementedEC2) WaitUntilInstanceExists(_ *ec2sdk.DescribeInstancesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceExists")
+}
+func (unimplementedEC2) WaitUntilInstanceExistsWithContext(_ context.Context, _ *ec2sdk.DescribeInstancesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceExists")
+}
+func (unimplementedEC2) WaitUntilInstanceRunning(_ *ec2sdk.DescribeInstancesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceRunning")
+}
+func (unimplementedEC2) WaitUntilInstanceRunningWithContext(_ context.Context, _ *ec2sdk.DescribeInstancesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceRunning")
+}
+func (unimplementedEC2) WaitUntilInstanceStatusOk(_ *ec2sdk.DescribeInstanceStatusInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceStatusOk")
+}
+func (unimplementedEC2) WaitUntilInstanceStatusOkWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceStatusInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceStatusOk")
+}
+func (unimplementedEC2) WaitUntilInstanceStopped(_ *ec2sdk.DescribeInstancesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceStopped")
+}
+func (unimplementedEC2) WaitUntilInstanceStoppedWithContext(_ context.Context, _ *ec2sdk.DescribeInstancesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceStopped")
+}
+func (unimplementedEC2) WaitUntilInstanceTerminated(_ *ec2sdk.DescribeInstancesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceTerminated")
+}
+func (unimplementedEC2) WaitUntilInstanceTerminatedWithContext(_ context.Context, _ *ec2sdk.DescribeInstancesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInstanceTerminated")
+}
+func (unimplementedEC2) WaitUntilInternetGatewayExists(_ *ec2sdk.DescribeInternetGatewaysInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInternetGatewayExists")
+}
+func (unimplementedEC2) WaitUntilInternetGatewayExistsWithContext(_ context.Context, _ *ec2sdk.DescribeInternetGatewaysInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilInternetGatewayExists")
+}
+func (unimplementedEC2) WaitUntilKeyPairExists(_ *ec2sdk.DescribeKeyPairsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilKeyPairExists")
+}
+func (unimplementedEC2) WaitUntilKeyPairExistsWithContext(_ context.Context, _ *ec2sdk.DescribeKeyPairsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilKeyPairExists")
+}
+func (unimplementedEC2) WaitUntilNatGatewayAvailable(_ *ec2sdk.DescribeNatGatewaysInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilNatGatewayAvailable")
+}
+func (unimplementedEC2) WaitUntilNatGatewayAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeNatGatewaysInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilNatGatewayAvailable")
+}
+func (unimplementedEC2) WaitUntilNatGatewayDeleted(_ *ec2sdk.DescribeNatGatewaysInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilNatGatewayDeleted")
+}
+func (unimplementedEC2) WaitUntilNatGatewayDeletedWithContext(_ context.Context, _ *ec2sdk.DescribeNatGatewaysInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilNatGatewayDeleted")
+}
+func (unimplementedEC2) WaitUntilNetworkInterfaceAvailable(_ *ec2sdk.DescribeNetworkInterfacesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilNetworkInterfaceAvailable")
+}
+func (unimplementedEC2) WaitUntilNetworkInterfaceAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeNetworkInterfacesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilNetworkInterfaceAvailable")
+}
+func (unimplementedEC2) WaitUntilPasswordDataAvailable(_ *ec2sdk.GetPasswordDataInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilPasswordDataAvailable")
+}
+func (unimplementedEC2) WaitUntilPasswordDataAvailableWithContext(_ context.Context, _ *ec2sdk.GetPasswordDataInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilPasswordDataAvailable")
+}
+func (unimplementedEC2) WaitUntilSecurityGroupExists(_ *ec2sdk.DescribeSecurityGroupsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSecurityGroupExists")
+}
+func (unimplementedEC2) WaitUntilSecurityGroupExistsWithContext(_ context.Context, _ *ec2sdk.DescribeSecurityGroupsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSecurityGroupExists")
+}
+func (unimplementedEC2) WaitUntilSnapshotCompleted(_ *ec2sdk.DescribeSnapshotsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSnapshotCompleted")
+}
+func (unimplementedEC2) WaitUntilSnapshotCompletedWithContext(_ context.Context, _ *ec2sdk.DescribeSnapshotsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSnapshotCompleted")
+}
+func (unimplementedEC2) WaitUntilSnapshotImported(_ *ec2sdk.DescribeImportSnapshotTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSnapshotImported")
+}
+func (unimplementedEC2) WaitUntilSnapshotImportedWithContext(_ context.Context, _ *ec2sdk.DescribeImportSnapshotTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSnapshotImported")
+}
+func (unimplementedEC2) WaitUntilSpotInstanceRequestFulfilled(_ *ec2sdk.DescribeSpotInstanceRequestsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSpotInstanceRequestFulfilled")
+}
+func (unimplementedEC2) WaitUntilSpotInstanceRequestFulfilledWithContext(_ context.Context, _ *ec2sdk.DescribeSpotInstanceRequestsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSpotInstanceRequestFulfilled")
+}
+func (unimplementedEC2) WaitUntilStoreImageTaskComplete(_ *ec2sdk.DescribeStoreImageTasksInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilStoreImageTaskComplete")
+}
+func (unimplementedEC2) WaitUntilStoreImageTaskCompleteWithContext(_ context.Context, _ *ec2sdk.DescribeStoreImageTasksInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilStoreImageTaskComplete")
+}
+func (unimplementedEC2) WaitUntilSubnetAvailable(_ *ec2sdk.DescribeSubnetsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSubnetAvailable")
+}
+func (unimplementedEC2) WaitUntilSubnetAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeSubnetsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSubnetAvailable")
+}
+func (unimplementedEC2) WaitUntilSystemStatusOk(_ *ec2sdk.DescribeInstanceStatusInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSystemStatusOk")
+}
+func (unimplementedEC2) WaitUntilSystemStatusOkWithContext(_ context.Context, _ *ec2sdk.DescribeInstanceStatusInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilSystemStatusOk")
+}
+func (unimplementedEC2) WaitUntilVolumeAvailable(_ *ec2sdk.DescribeVolumesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVolumeAvailable")
+}
+func (unimplementedEC2) WaitUntilVolumeAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeVolumesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVolumeAvailable")
+}
+func (unimplementedEC2) WaitUntilVolumeDeleted(_ *ec2sdk.DescribeVolumesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVolumeDeleted")
+}
+func (unimplementedEC2) WaitUntilVolumeDeletedWithContext(_ context.Context, _ *ec2sdk.DescribeVolumesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVolumeDeleted")
+}
+func (unimplementedEC2) WaitUntilVolumeInUse(_ *ec2sdk.DescribeVolumesInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVolumeInUse")
+}
+func (unimplementedEC2) WaitUntilVolumeInUseWithContext(_ context.Context, _ *ec2sdk.DescribeVolumesInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVolumeInUse")
+}
+func (unimplementedEC2) WaitUntilVpcAvailable(_ *ec2sdk.DescribeVpcsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcAvailable")
+}
+func (unimplementedEC2) WaitUntilVpcAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeVpcsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcAvailable")
+}
+func (unimplementedEC2) WaitUntilVpcExists(_ *ec2sdk.DescribeVpcsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcExists")
+}
+func (unimplementedEC2) WaitUntilVpcExistsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcExists")
+}
+func (unimplementedEC2) WaitUntilVpcPeeringConnectionDeleted(_ *ec2sdk.DescribeVpcPeeringConnectionsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcPeeringConnectionDeleted")
+}
+func (unimplementedEC2) WaitUntilVpcPeeringConnectionDeletedWithContext(_ context.Context, _ *ec2sdk.DescribeVpcPeeringConnectionsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcPeeringConnectionDeleted")
+}
+func (unimplementedEC2) WaitUntilVpcPeeringConnectionExists(_ *ec2sdk.DescribeVpcPeeringConnectionsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcPeeringConnectionExists")
+}
+func (unimplementedEC2) WaitUntilVpcPeeringConnectionExistsWithContext(_ context.Context, _ *ec2sdk.DescribeVpcPeeringConnectionsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpcPeeringConnectionExists")
+}
+func (unimplementedEC2) WaitUntilVpnConnectionAvailable(_ *ec2sdk.DescribeVpnConnectionsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpnConnectionAvailable")
+}
+func (unimplementedEC2) WaitUntilVpnConnectionAvailableWithContext(_ context.Context, _ *ec2sdk.DescribeVpnConnectionsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpnConnectionAvailable")
+}
+func (unimplementedEC2) WaitUntilVpnConnectionDeleted(_ *ec2sdk.DescribeVpnConnectionsInput) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpnConnectionDeleted")
+}
+func (unimplementedEC2) WaitUntilVpnConnectionDeletedWithContext(_ context.Context, _ *ec2sdk.DescribeVpnConnectionsInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceEC2, "WaitUntilVpnConnectionDeleted")
+}
+func (unimplementedEC2) WithdrawByoipCidr(_ *ec2sdk.WithdrawByoipCidrInput) (*ec2sdk.WithdrawByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "WithdrawByoipCidr")
+}
+func (unimplementedEC2) WithdrawByoipCidrRequest(_ *ec2sdk.WithdrawByoipCidrInput) (*request.Request, *ec2sdk.WithdrawByoipCidrOutput) {
+	return newNotImplementedRequest(ServiceEC2, "WithdrawByoipCidr"), nil
+}
+func (unimplementedEC2) WithdrawByoipCidrWithContext(_ context.Context, _ *ec2sdk.WithdrawByoipCidrInput, _ ...request.Option) (*ec2sdk.WithdrawByoipCidrOutput, error) {
+	return nil, newNotImplementedError(ServiceEC2, "WithdrawByoipCidr")
+}
+
+// unimplementedELBV2 implements elbv2iface.ELBV2API with APIs that return NotImplemented error.
+type unimplementedELBV2 struct{}
+
+func (unimplementedELBV2) AddListenerCertificates(_ *elbv2sdk.AddListenerCertificatesInput) (*elbv2sdk.AddListenerCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "AddListenerCertificates")
+}
+func (unimplementedELBV2) AddListenerCertificatesRequest(_ *elbv2sdk.AddListenerCertificatesInput) (*request.Request, *elbv2sdk.AddListenerCertificatesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "AddListenerCertificates"), nil
+}
+func (unimplementedELBV2) AddListenerCertificatesWithContext(_ context.Context, _ *elbv2sdk.AddListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.AddListenerCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "AddListenerCertificates")
+}
+func (unimplementedELBV2) AddTags(_ *elbv2sdk.AddTagsInput) (*elbv2sdk.AddTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "AddTags")
+}
+func (unimplementedELBV2) AddTagsRequest(_ *elbv2sdk.AddTagsInput) (*request.Request, *elbv2sdk.AddTagsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "AddTags"), nil
+}
+func (unimplementedELBV2) AddTagsWithContext(_ context.Context, _ *elbv2sdk.AddTagsInput, _ ...request.Option) (*elbv2sdk.AddTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "AddTags")
+}
+func (unimplementedELBV2) AddTrustStoreRevocations(_ *elbv2sdk.AddTrustStoreRevocationsInput) (*elbv2sdk.AddTrustStoreRevocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "AddTrustStoreRevocations")
+}
+func (unimplementedELBV2) AddTrustStoreRevocationsRequest(_ *elbv2sdk.AddTrustStoreRevocationsInput) (*request.Request, *elbv2sdk.AddTrustStoreRevocationsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "AddTrustStoreRevocations"), nil
+}
+func (unimplementedELBV2) AddTrustStoreRevocationsWithContext(_ context.Context, _ *elbv2sdk.AddTrustStoreRevocationsInput, _ ...request.Option) (*elbv2sdk.AddTrustStoreRevocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "AddTrustStoreRevocations")
+}
+func (unimplementedELBV2) CreateListener(_ *elbv2sdk.CreateListenerInput) (*elbv2sdk.CreateListenerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateListener")
+}
+func (unimplementedELBV2) CreateListenerRequest(_ *elbv2sdk.CreateListenerInput) (*request.Request, *elbv2sdk.CreateListenerOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "CreateListener"), nil
+}
+func (unimplementedELBV2) CreateListenerWithContext(_ context.Context, _ *elbv2sdk.CreateListenerInput, _ ...request.Option) (*elbv2sdk.CreateListenerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateListener")
+}
+func (unimplementedELBV2) CreateLoadBalancer(_ *elbv2sdk.CreateLoadBalancerInput) (*elbv2sdk.CreateLoadBalancerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateLoadBalancer")
+}
+func (unimplementedELBV2) CreateLoadBalancerRequest(_ *elbv2sdk.CreateLoadBalancerInput) (*request.Request, *elbv2sdk.CreateLoadBalancerOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "CreateLoadBalancer"), nil
+}
+func (unimplementedELBV2) CreateLoadBalancerWithContext(_ context.Context, _ *elbv2sdk.CreateLoadBalancerInput, _ ...request.Option) (*elbv2sdk.CreateLoadBalancerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateLoadBalancer")
+}
+func (unimplementedELBV2) CreateRule(_ *elbv2sdk.CreateRuleInput) (*elbv2sdk.CreateRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateRule")
+}
+func (unimplementedELBV2) CreateRuleRequest(_ *elbv2sdk.CreateRuleInput) (*request.Request, *elbv2sdk.CreateRuleOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "CreateRule"), nil
+}
+func (unimplementedELBV2) CreateRuleWithContext(_ context.Context, _ *elbv2sdk.CreateRuleInput, _ ...request.Option) (*elbv2sdk.CreateRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateRule")
+}
+func (unimplementedELBV2) CreateTargetGroup(_ *elbv2sdk.CreateTargetGroupInput) (*elbv2sdk.CreateTargetGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateTargetGroup")
+}
+func (unimplementedELBV2) CreateTargetGroupRequest(_ *elbv2sdk.CreateTargetGroupInput) (*request.Request, *elbv2sdk.CreateTargetGroupOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "CreateTargetGroup"), nil
+}
+func (unimplementedELBV2) CreateTargetGroupWithContext(_ context.Context, _ *elbv2sdk.CreateTargetGroupInput, _ ...request.Option) (*elbv2sdk.CreateTargetGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateTargetGroup")
+}
+func (unimplementedELBV2) CreateTrustStore(_ *elbv2sdk.CreateTrustStoreInput) (*elbv2sdk.CreateTrustStoreOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateTrustStore")
+}
+func (unimplementedELBV2) CreateTrustStoreRequest(_ *elbv2sdk.CreateTrustStoreInput) (*request.Request, *elbv2sdk.CreateTrustStoreOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "CreateTrustStore"), nil
+}
+func (unimplementedELBV2) CreateTrustStoreWithContext(_ context.Context, _ *elbv2sdk.CreateTrustStoreInput, _ ...request.Option) (*elbv2sdk.CreateTrustStoreOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "CreateTrustStore")
+}
+func (unimplementedELBV2) DeleteListener(_ *elbv2sdk.DeleteListenerInput) (*elbv2sdk.DeleteListenerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteListener")
+}
+func (unimplementedELBV2) DeleteListenerRequest(_ *elbv2sdk.DeleteListenerInput) (*request.Request, *elbv2sdk.DeleteListenerOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DeleteListener"), nil
+}
+func (unimplementedELBV2) DeleteListenerWithContext(_ context.Context, _ *elbv2sdk.DeleteListenerInput, _ ...request.Option) (*elbv2sdk.DeleteListenerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteListener")
+}
+func (unimplementedELBV2) DeleteLoadBalancer(_ *elbv2sdk.DeleteLoadBalancerInput) (*elbv2sdk.DeleteLoadBalancerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteLoadBalancer")
+}
+func (unimplementedELBV2) DeleteLoadBalancerRequest(_ *elbv2sdk.DeleteLoadBalancerInput) (*request.Request, *elbv2sdk.DeleteLoadBalancerOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DeleteLoadBalancer"), nil
+}
+func (unimplementedELBV2) DeleteLoadBalancerWithContext(_ context.Context, _ *elbv2sdk.DeleteLoadBalancerInput, _ ...request.Option) (*elbv2sdk.DeleteLoadBalancerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteLoadBalancer")
+}
+func (unimplementedELBV2) DeleteRule(_ *elbv2sdk.DeleteRuleInput) (*elbv2sdk.DeleteRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteRule")
+}
+func (unimplementedELBV2) DeleteRuleRequest(_ *elbv2sdk.DeleteRuleInput) (*request.Request, *elbv2sdk.DeleteRuleOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DeleteRule"), nil
+}
+func (unimplementedELBV2) DeleteRuleWithContext(_ context.Context, _ *elbv2sdk.DeleteRuleInput, _ ...request.Option) (*elbv2sdk.DeleteRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteRule")
+}
+func (unimplementedELBV2) DeleteTargetGroup(_ *elbv2sdk.DeleteTargetGroupInput) (*elbv2sdk.DeleteTargetGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteTargetGroup")
+}
+func (unimplementedELBV2) DeleteTargetGroupRequest(_ *elbv2sdk.DeleteTargetGroupInput) (*request.Request, *elbv2sdk.DeleteTargetGroupOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DeleteTargetGroup"), nil
+}
+func (unimplementedELBV2) DeleteTargetGroupWithContext(_ context.Context, _ *elbv2sdk.DeleteTargetGroupInput, _ ...request.Option) (*elbv2sdk.DeleteTargetGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteTargetGroup")
+}
+func (unimplementedELBV2) DeleteTrustStore(_ *elbv2sdk.DeleteTrustStoreInput) (*elbv2sdk.DeleteTrustStoreOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteTrustStore")
+}
+func (unimplementedELBV2) DeleteTrustStoreRequest(_ *elbv2sdk.DeleteTrustStoreInput) (*request.Request, *elbv2sdk.DeleteTrustStoreOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DeleteTrustStore"), nil
+}
+func (unimplementedELBV2) DeleteTrustStoreWithContext(_ context.Context, _ *elbv2sdk.DeleteTrustStoreInput, _ ...request.Option) (*elbv2sdk.DeleteTrustStoreOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeleteTrustStore")
+}
+func (unimplementedELBV2) DeregisterTargets(_ *elbv2sdk.DeregisterTargetsInput) (*elbv2sdk.DeregisterTargetsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeregisterTargets")
+}
+func (unimplementedELBV2) DeregisterTargetsRequest(_ *elbv2sdk.DeregisterTargetsInput) (*request.Request, *elbv2sdk.DeregisterTargetsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DeregisterTargets"), nil
+}
+func (unimplementedELBV2) DeregisterTargetsWithContext(_ context.Context, _ *elbv2sdk.DeregisterTargetsInput, _ ...request.Option) (*elbv2sdk.DeregisterTargetsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DeregisterTargets")
+}
+func (unimplementedELBV2) DescribeAccountLimits(_ *elbv2sdk.DescribeAccountLimitsInput) (*elbv2sdk.DescribeAccountLimitsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeAccountLimits")
+}
+func (unimplementedELBV2) DescribeAccountLimitsRequest(_ *elbv2sdk.DescribeAccountLimitsInput) (*request.Request, *elbv2sdk.DescribeAccountLimitsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeAccountLimits"), nil
+}
+func (unimplementedELBV2) DescribeAccountLimitsWithContext(_ context.Context, _ *elbv2sdk.DescribeAccountLimitsInput, _ ...request.Option) (*elbv2sdk.DescribeAccountLimitsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeAccountLimits")
+}
+func (unimplementedELBV2) DescribeListenerCertificates(_ *elbv2sdk.DescribeListenerCertificatesInput) (*elbv2sdk.DescribeListenerCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeListenerCertificates")
+}
+func (unimplementedELBV2) DescribeListenerCertificatesRequest(_ *elbv2sdk.DescribeListenerCertificatesInput) (*request.Request, *elbv2sdk.DescribeListenerCertificatesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeListenerCertificates"), nil
+}
+func (unimplementedELBV2) DescribeListenerCertificatesWithContext(_ context.Context, _ *elbv2sdk.DescribeListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.DescribeListenerCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeListenerCertificates")
+}
+func (unimplementedELBV2) DescribeListeners(_ *elbv2sdk.DescribeListenersInput) (*elbv2sdk.DescribeListenersOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeListeners")
+}
+func (unimplementedELBV2) DescribeListenersPages(_ *elbv2sdk.DescribeListenersInput, _ func(*elbv2sdk.DescribeListenersOutput, bool) bool) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeListeners")
+}
+func (unimplementedELBV2) DescribeListenersPagesWithContext(_ context.Context, _ *elbv2sdk.DescribeListenersInput, _ func(*elbv2sdk.DescribeListenersOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeListeners")
+}
+func (unimplementedELBV2) DescribeListenersRequest(_ *elbv2sdk.DescribeListenersInput) (*request.Request, *elbv2sdk.DescribeListenersOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeListeners"), nil
+}
+func (unimplementedELBV2) DescribeListenersWithContext(_ context.Context, _ *elbv2sdk.DescribeListenersInput, _ ...request.Option) (*elbv2sdk.DescribeListenersOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeListeners")
+}
+func (unimplementedELBV2) DescribeLoadBalancerAttributes(_ *elbv2sdk.DescribeLoadBalancerAttributesInput) (*elbv2sdk.DescribeLoadBalancerAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeLoadBalancerAttributes")
+}
+func (unimplementedELBV2) DescribeLoadBalancerAttributesRequest(_ *elbv2sdk.DescribeLoadBalancerAttributesInput) (*request.Request, *elbv2sdk.DescribeLoadBalancerAttributesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeLoadBalancerAttributes"), nil
+}
+func (unimplementedELBV2) DescribeLoadBalancerAttributesWithContext(_ context.Context, _ *elbv2sdk.DescribeLoadBalancerAttributesInput, _ ...request.Option) (*elbv2sdk.DescribeLoadBalancerAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeLoadBalancerAttributes")
+}
+func (unimplementedELBV2) DescribeLoadBalancers(_ *elbv2sdk.DescribeLoadBalancersInput) (*elbv2sdk.DescribeLoadBalancersOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeLoadBalancers")
+}
+func (unimplementedELBV2) DescribeLoadBalancersPages(_ *elbv2sdk.DescribeLoadBalancersInput, _ func(*elbv2sdk.DescribeLoadBalancersOutput, bool) bool) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeLoadBalancers")
+}
+func (unimplementedELBV2) DescribeLoadBalancersPagesWithContext(_ context.Context, _ *elbv2sdk.DescribeLoadBalancersInput, _ func(*elbv2sdk.DescribeLoadBalancersOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeLoadBalancers")
+}
+func (unimplementedELBV2) DescribeLoadBalancersRequest(_ *elbv2sdk.DescribeLoadBalancersInput) (*request.Request, *elbv2sdk.DescribeLoadBalancersOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeLoadBalancers"), nil
+}
+func (unimplementedELBV2) DescribeLoadBalancersWithContext(_ context.Context, _ *elbv2sdk.DescribeLoadBalancersInput, _ ...request.Option) (*elbv2sdk.DescribeLoadBalancersOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeLoadBalancers")
+}
+func (unimplementedELBV2) DescribeRules(_ *elbv2sdk.DescribeRulesInput) (*elbv2sdk.DescribeRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeRules")
+}
+func (unimplementedELBV2) DescribeRulesRequest(_ *elbv2sdk.DescribeRulesInput) (*request.Request, *elbv2sdk.DescribeRulesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeRules"), nil
+}
+func (unimplementedELBV2) DescribeRulesWithContext(_ context.Context, _ *elbv2sdk.DescribeRulesInput, _ ...request.Option) (*elbv2sdk.DescribeRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeRules")
+}
+func (unimplementedELBV2) DescribeSSLPolicies(_ *elbv2sdk.DescribeSSLPoliciesInput) (*elbv2sdk.DescribeSSLPoliciesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeSSLPolicies")
+}
+func (unimplementedELBV2) DescribeSSLPoliciesRequest(_ *elbv2sdk.DescribeSSLPoliciesInput) (*request.Request, *elbv2sdk.DescribeSSLPoliciesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeSSLPolicies"), nil
+}
+func (unimplementedELBV2) DescribeSSLPoliciesWithContext(_ context.Context, _ *elbv2sdk.DescribeSSLPoliciesInput, _ ...request.Option) (*elbv2sdk.DescribeSSLPoliciesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeSSLPolicies")
+}
+func (unimplementedELBV2) DescribeTags(_ *elbv2sdk.DescribeTagsInput) (*elbv2sdk.DescribeTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTags")
+}
+func (unimplementedELBV2) DescribeTagsRequest(_ *elbv2sdk.DescribeTagsInput) (*request.Request, *elbv2sdk.DescribeTagsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeTags"), nil
+}
+func (unimplementedELBV2) DescribeTagsWithContext(_ context.Context, _ *elbv2sdk.DescribeTagsInput, _ ...request.Option) (*elbv2sdk.DescribeTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTags")
+}
+func (unimplementedELBV2) DescribeTargetGroupAttributes(_ *elbv2sdk.DescribeTargetGroupAttributesInput) (*elbv2sdk.DescribeTargetGroupAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTargetGroupAttributes")
+}
+func (unimplementedELBV2) DescribeTargetGroupAttributesRequest(_ *elbv2sdk.DescribeTargetGroupAttributesInput) (*request.Request, *elbv2sdk.DescribeTargetGroupAttributesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeTargetGroupAttributes"), nil
+}
+func (unimplementedELBV2) DescribeTargetGroupAttributesWithContext(_ context.Context, _ *elbv2sdk.DescribeTargetGroupAttributesInput, _ ...request.Option) (*elbv2sdk.DescribeTargetGroupAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTargetGroupAttributes")
+}
+func (unimplementedELBV2) DescribeTargetGroups(_ *elbv2sdk.DescribeTargetGroupsInput) (*elbv2sdk.DescribeTargetGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTargetGroups")
+}
+func (unimplementedELBV2) DescribeTargetGroupsPages(_ *elbv2sdk.DescribeTargetGroupsInput, _ func(*elbv2sdk.DescribeTargetGroupsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTargetGroups")
+}
+func (unimplementedELBV2) DescribeTargetGroupsPagesWithContext(_ context.Context, _ *elbv2sdk.DescribeTargetGroupsInput, _ func(*elbv2sdk.DescribeTargetGroupsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTargetGroups")
+}
+func (unimplementedELBV2) DescribeTargetGroupsRequest(_ *elbv2sdk.DescribeTargetGroupsInput) (*request.Request, *elbv2sdk.DescribeTargetGroupsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeTargetGroups"), nil
+}
+func (unimplementedELBV2) DescribeTargetGroupsWithContext(_ context.Context, _ *elbv2sdk.DescribeTargetGroupsInput, _ ...request.Option) (*elbv2sdk.DescribeTargetGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTargetGroups")
+}
+func (unimplementedELBV2) DescribeTargetHealth(_ *elbv2sdk.DescribeTargetHealthInput) (*elbv2sdk.DescribeTargetHealthOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTargetHealth")
+}
+func (unimplementedELBV2) DescribeTargetHealthRequest(_ *elbv2sdk.DescribeTargetHealthInput) (*request.Request, *elbv2sdk.DescribeTargetHealthOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeTargetHealth"), nil
+}
+func (unimplementedELBV2) DescribeTargetHealthWithContext(_ context.Context, _ *elbv2sdk.DescribeTargetHealthInput, _ ...request.Option) (*elbv2sdk.DescribeTargetHealthOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTargetHealth")
+}
+func (unimplementedELBV2) DescribeTrustStoreAssociations(_ *elbv2sdk.DescribeTrustStoreAssociationsInput) (*elbv2sdk.DescribeTrustStoreAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTrustStoreAssociations")
+}
+func (unimplementedELBV2) DescribeTrustStoreAssociationsPages(_ *elbv2sdk.DescribeTrustStoreAssociationsInput, _ func(*elbv2sdk.DescribeTrustStoreAssociationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTrustStoreAssociations")
+}
+func (unimplementedELBV2) DescribeTrustStoreAssociationsPagesWithContext(_ context.Context, _ *elbv2sdk.DescribeTrustStoreAssociationsInput, _ func(*elbv2sdk.DescribeTrustStoreAssociationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTrustStoreAssociations")
+}
+func (unimplementedELBV2) DescribeTrustStoreAssociationsRequest(_ *elbv2sdk.DescribeTrustStoreAssociationsInput) (*request.Request, *elbv2sdk.DescribeTrustStoreAssociationsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeTrustStoreAssociations"), nil
+}
+func (unimplementedELBV2) DescribeTrustStoreAssociationsWithContext(_ context.Context, _ *elbv2sdk.DescribeTrustStoreAssociationsInput, _ ...request.Option) (*elbv2sdk.DescribeTrustStoreAssociationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTrustStoreAssociations")
+}
+func (unimplementedELBV2) DescribeTrustStoreRevocations(_ *elbv2sdk.DescribeTrustStoreRevocationsInput) (*elbv2sdk.DescribeTrustStoreRevocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTrustStoreRevocations")
+}
+func (unimplementedELBV2) DescribeTrustStoreRevocationsPages(_ *elbv2sdk.DescribeTrustStoreRevocationsInput, _ func(*elbv2sdk.DescribeTrustStoreRevocationsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTrustStoreRevocations")
+}
+func (unimplementedELBV2) DescribeTrustStoreRevocationsPagesWithContext(_ context.Context, _ *elbv2sdk.DescribeTrustStoreRevocationsInput, _ func(*elbv2sdk.DescribeTrustStoreRevocationsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTrustStoreRevocations")
+}
+func (unimplementedELBV2) DescribeTrustStoreRevocationsRequest(_ *elbv2sdk.DescribeTrustStoreRevocationsInput) (*request.Request, *elbv2sdk.DescribeTrustStoreRevocationsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeTrustStoreRevocations"), nil
+}
+func (unimplementedELBV2) DescribeTrustStoreRevocationsWithContext(_ context.Context, _ *elbv2sdk.DescribeTrustStoreRevocationsInput, _ ...request.Option) (*elbv2sdk.DescribeTrustStoreRevocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTrustStoreRevocations")
+}
+func (unimplementedELBV2) DescribeTrustStores(_ *elbv2sdk.DescribeTrustStoresInput) (*elbv2sdk.DescribeTrustStoresOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTrustStores")
+}
+func (unimplementedELBV2) DescribeTrustStoresPages(_ *elbv2sdk.DescribeTrustStoresInput, _ func(*elbv2sdk.DescribeTrustStoresOutput, bool) bool) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTrustStores")
+}
+func (unimplementedELBV2) DescribeTrustStoresPagesWithContext(_ context.Context, _ *elbv2sdk.DescribeTrustStoresInput, _ func(*elbv2sdk.DescribeTrustStoresOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceELBV2, "DescribeTrustStores")
+}
+func (unimplementedELBV2) DescribeTrustStoresRequest(_ *elbv2sdk.DescribeTrustStoresInput) (*request.Request, *elbv2sdk.DescribeTrustStoresOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "DescribeTrustStores"), nil
+}
+func (unimplementedELBV2) DescribeTrustStoresWithContext(_ context.Context, _ *elbv2sdk.DescribeTrustStoresInput, _ ...request.Option) (*elbv2sdk.DescribeTrustStoresOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "DescribeTrustStores")
+}
+func (unimplementedELBV2) GetTrustStoreCaCertificatesBundle(_ *elbv2sdk.GetTrustStoreCaCertificatesBundleInput) (*elbv2sdk.GetTrustStoreCaCertificatesBundleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "GetTrustStoreCaCertificatesBundle")
+}
+func (unimplementedELBV2) GetTrustStoreCaCertificatesBundleRequest(_ *elbv2sdk.GetTrustStoreCaCertificatesBundleInput) (*request.Request, *elbv2sdk.GetTrustStoreCaCertificatesBundleOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "GetTrustStoreCaCertificatesBundle"), nil
+}
+func (unimplementedELBV2) GetTrustStoreCaCertificatesBundleWithContext(_ context.Context, _ *elbv2sdk.GetTrustStoreCaCertificatesBundleInput, _ ...request.Option) (*elbv2sdk.GetTrustStoreCaCertificatesBundleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "GetTrustStoreCaCertificatesBundle")
+}
+func (unimplementedELBV2) GetTrustStoreRevocationContent(_ *elbv2sdk.GetTrustStoreRevocationContentInput) (*elbv2sdk.GetTrustStoreRevocationContentOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "GetTrustStoreRevocationContent")
+}
+func (unimplementedELBV2) GetTrustStoreRevocationContentRequest(_ *elbv2sdk.GetTrustStoreRevocationContentInput) (*request.Request, *elbv2sdk.GetTrustStoreRevocationContentOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "GetTrustStoreRevocationContent"), nil
+}
+func (unimplementedELBV2) GetTrustStoreRevocationContentWithContext(_ context.Context, _ *elbv2sdk.GetTrustStoreRevocationContentInput, _ ...request.Option) (*elbv2sdk.GetTrustStoreRevocationContentOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "GetTrustStoreRevocationContent")
+}
+func (unimplementedELBV2) ModifyListener(_ *elbv2sdk.ModifyListenerInput) (*elbv2sdk.ModifyListenerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyListener")
+}
+func (unimplementedELBV2) ModifyListenerRequest(_ *elbv2sdk.ModifyListenerInput) (*request.Request, *elbv2sdk.ModifyListenerOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "ModifyListener"), nil
+}
+func (unimplementedELBV2) ModifyListenerWithContext(_ context.Context, _ *elbv2sdk.ModifyListenerInput, _ ...request.Option) (*elbv2sdk.ModifyListenerOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyListener")
+}
+func (unimplementedELBV2) ModifyLoadBalancerAttributes(_ *elbv2sdk.ModifyLoadBalancerAttributesInput) (*elbv2sdk.ModifyLoadBalancerAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyLoadBalancerAttributes")
+}
+func (unimplementedELBV2) ModifyLoadBalancerAttributesRequest(_ *elbv2sdk.ModifyLoadBalancerAttributesInput) (*request.Request, *elbv2sdk.ModifyLoadBalancerAttributesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "ModifyLoadBalancerAttributes"), nil
+}
+func (unimplementedELBV2) ModifyLoadBalancerAttributesWithContext(_ context.Context, _ *elbv2sdk.ModifyLoadBalancerAttributesInput, _ ...request.Option) (*elbv2sdk.ModifyLoadBalancerAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyLoadBalancerAttributes")
+}
+func (unimplementedELBV2) ModifyRule(_ *elbv2sdk.ModifyRuleInput) (*elbv2sdk.ModifyRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyRule")
+}
+func (unimplementedELBV2) ModifyRuleRequest(_ *elbv2sdk.ModifyRuleInput) (*request.Request, *elbv2sdk.ModifyRuleOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "ModifyRule"), nil
+}
+func (unimplementedELBV2) ModifyRuleWithContext(_ context.Context, _ *elbv2sdk.ModifyRuleInput, _ ...request.Option) (*elbv2sdk.ModifyRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyRule")
+}
+func (unimplementedELBV2) ModifyTargetGroup(_ *elbv2sdk.ModifyTargetGroupInput) (*elbv2sdk.ModifyTargetGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyTargetGroup")
+}
+func (unimplementedELBV2) ModifyTargetGroupAttributes(_ *elbv2sdk.ModifyTargetGroupAttributesInput) (*elbv2sdk.ModifyTargetGroupAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyTargetGroupAttributes")
+}
+func (unimplementedELBV2) ModifyTargetGroupAttributesRequest(_ *elbv2sdk.ModifyTargetGroupAttributesInput) (*request.Request, *elbv2sdk.ModifyTargetGroupAttributesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "ModifyTargetGroupAttributes"), nil
+}
+func (unimplementedELBV2) ModifyTargetGroupAttributesWithContext(_ context.Context, _ *elbv2sdk.ModifyTargetGroupAttributesInput, _ ...request.Option) (*elbv2sdk.ModifyTargetGroupAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyTargetGroupAttributes")
+}
+func (unimplementedELBV2) ModifyTargetGroupRequest(_ *elbv2sdk.ModifyTargetGroupInput) (*request.Request, *elbv2sdk.ModifyTargetGroupOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "ModifyTargetGroup"), nil
+}
+func (unimplementedELBV2) ModifyTargetGroupWithContext(_ context.Context, _ *elbv2sdk.ModifyTargetGroupInput, _ ...request.Option) (*elbv2sdk.ModifyTargetGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyTargetGroup")
+}
+func (unimplementedELBV2) ModifyTrustStore(_ *elbv2sdk.ModifyTrustStoreInput) (*elbv2sdk.ModifyTrustStoreOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyTrustStore")
+}
+func (unimplementedELBV2) ModifyTrustStoreRequest(_ *elbv2sdk.ModifyTrustStoreInput) (*request.Request, *elbv2sdk.ModifyTrustStoreOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "ModifyTrustStore"), nil
+}
+func (unimplementedELBV2) ModifyTrustStoreWithContext(_ context.Context, _ *elbv2sdk.ModifyTrustStoreInput, _ ...request.Option) (*elbv2sdk.ModifyTrustStoreOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "ModifyTrustStore")
+}
+func (unimplementedELBV2) RegisterTargets(_ *elbv2sdk.RegisterTargetsInput) (*elbv2sdk.RegisterTargetsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RegisterTargets")
+}
+func (unimplementedELBV2) RegisterTargetsRequest(_ *elbv2sdk.RegisterTargetsInput) (*request.Request, *elbv2sdk.RegisterTargetsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "RegisterTargets"), nil
+}
+func (unimplementedELBV2) RegisterTargetsWithContext(_ context.Context, _ *elbv2sdk.RegisterTargetsInput, _ ...request.Option) (*elbv2sdk.RegisterTargetsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RegisterTargets")
+}
+func (unimplementedELBV2) RemoveListenerCertificates(_ *elbv2sdk.RemoveListenerCertificatesInput) (*elbv2sdk.RemoveListenerCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RemoveListenerCertificates")
+}
+func (unimplementedELBV2) RemoveListenerCertificatesRequest(_ *elbv2sdk.RemoveListenerCertificatesInput) (*request.Request, *elbv2sdk.RemoveListenerCertificatesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "RemoveListenerCertificates"), nil
+}
+func (unimplementedELBV2) RemoveListenerCertificatesWithContext(_ context.Context, _ *elbv2sdk.RemoveListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.RemoveListenerCertificatesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RemoveListenerCertificates")
+}
+func (unimplementedELBV2) RemoveTags(_ *elbv2sdk.RemoveTagsInput) (*elbv2sdk.RemoveTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RemoveTags")
+}
+func (unimplementedELBV2) RemoveTagsRequest(_ *elbv2sdk.RemoveTagsInput) (*request.Request, *elbv2sdk.RemoveTagsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "RemoveTags"), nil
+}
+func (unimplementedELBV2) RemoveTagsWithContext(_ context.Context, _ *elbv2sdk.RemoveTagsInput, _ ...request.Option) (*elbv2sdk.RemoveTagsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RemoveTags")
+}
+func (unimplementedELBV2) RemoveTrustStoreRevocations(_ *elbv2sdk.RemoveTrustStoreRevocationsInput) (*elbv2sdk.RemoveTrustStoreRevocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RemoveTrustStoreRevocations")
+}
+func (unimplementedELBV2) RemoveTrustStoreRevocationsRequest(_ *elbv2sdk.RemoveTrustStoreRevocationsInput) (*request.Request, *elbv2sdk.RemoveTrustStoreRevocationsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "RemoveTrustStoreRevocations"), nil
+}
+func (unimplementedELBV2) RemoveTrustStoreRevocationsWithContext(_ context.Context, _ *elbv2sdk.RemoveTrustStoreRevocationsInput, _ ...request.Option) (*elbv2sdk.RemoveTrustStoreRevocationsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "RemoveTrustStoreRevocations")
+}
+func (unimplementedELBV2) SetIpAddressType(_ *elbv2sdk.SetIpAddressTypeInput) (*elbv2sdk.SetIpAddressTypeOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetIpAddressType")
+}
+func (unimplementedELBV2) SetIpAddressTypeRequest(_ *elbv2sdk.SetIpAddressTypeInput) (*request.Request, *elbv2sdk.SetIpAddressTypeOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "SetIpAddressType"), nil
+}
+func (unimplementedELBV2) SetIpAddressTypeWithContext(_ context.Context, _ *elbv2sdk.SetIpAddressTypeInput, _ ...request.Option) (*elbv2sdk.SetIpAddressTypeOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetIpAddressType")
+}
+func (unimplementedELBV2) SetRulePriorities(_ *elbv2sdk.SetRulePrioritiesInput) (*elbv2sdk.SetRulePrioritiesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetRulePriorities")
+}
+func (unimplementedELBV2) SetRulePrioritiesRequest(_ *elbv2sdk.SetRulePrioritiesInput) (*request.Request, *elbv2sdk.SetRulePrioritiesOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "SetRulePriorities"), nil
+}
+func (unimplementedELBV2) SetRulePrioritiesWithContext(_ context.Context, _ *elbv2sdk.SetRulePrioritiesInput, _ ...request.Option) (*elbv2sdk.SetRulePrioritiesOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetRulePriorities")
+}
+func (unimplementedELBV2) SetSecurityGroups(_ *elbv2sdk.SetSecurityGroupsInput) (*elbv2sdk.SetSecurityGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetSecurityGroups")
+}
+func (unimplementedELBV2) SetSecurityGroupsRequest(_ *elbv2sdk.SetSecurityGroupsInput) (*request.Request, *elbv2sdk.SetSecurityGroupsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "SetSecurityGroups"), nil
+}
+func (unimplementedELBV2) SetSecurityGroupsWithContext(_ context.Context, _ *elbv2sdk.SetSecurityGroupsInput, _ ...request.Option) (*elbv2sdk.SetSecurityGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetSecurityGroups")
+}
+func (unimplementedELBV2) SetSubnets(_ *elbv2sdk.SetSubnetsInput) (*elbv2sdk.SetSubnetsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetSubnets")
+}
+func (unimplementedELBV2) SetSubnetsRequest(_ *elbv2sdk.SetSubnetsInput) (*request.Request, *elbv2sdk.SetSubnetsOutput) {
+	return newNotImplementedRequest(ServiceELBV2, "SetSubnets"), nil
+}
+func (unimplementedELBV2) SetSubnetsWithContext(_ context.Context, _ *elbv2sdk.SetSubnetsInput, _ ...request.Option) (*elbv2sdk.SetSubnetsOutput, error) {
+	return nil, newNotImplementedError(ServiceELBV2, "SetSubnets")
+}
+func (unimplementedELBV2) WaitUntilLoadBalancerAvailable(_ *elbv2sdk.DescribeLoadBalancersInput) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilLoadBalancerAvailable")
+}
+func (unimplementedELBV2) WaitUntilLoadBalancerAvailableWithContext(_ context.Context, _ *elbv2sdk.DescribeLoadBalancersInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilLoadBalancerAvailable")
+}
+func (unimplementedELBV2) WaitUntilLoadBalancerExists(_ *elbv2sdk.DescribeLoadBalancersInput) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilLoadBalancerExists")
+}
+func (unimplementedELBV2) WaitUntilLoadBalancerExistsWithContext(_ context.Context, _ *elbv2sdk.DescribeLoadBalancersInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilLoadBalancerExists")
+}
+func (unimplementedELBV2) WaitUntilLoadBalancersDeleted(_ *elbv2sdk.DescribeLoadBalancersInput) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilLoadBalancersDeleted")
+}
+func (unimplementedELBV2) WaitUntilLoadBalancersDeletedWithContext(_ context.Context, _ *elbv2sdk.DescribeLoadBalancersInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilLoadBalancersDeleted")
+}
+func (unimplementedELBV2) WaitUntilTargetDeregistered(_ *elbv2sdk.DescribeTargetHealthInput) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilTargetDeregistered")
+}
+func (unimplementedELBV2) WaitUntilTargetDeregisteredWithContext(_ context.Context, _ *elbv2sdk.DescribeTargetHealthInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilTargetDeregistered")
+}
+func (unimplementedELBV2) WaitUntilTargetInService(_ *elbv2sdk.DescribeTargetHealthInput) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilTargetInService")
+}
+func (unimplementedELBV2) WaitUntilTargetInServiceWithContext(_ context.Context, _ *elbv2sdk.DescribeTargetHealthInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceELBV2, "WaitUntilTargetInService")
+}
+
+// unimplementedRGT implements resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI with APIs that return NotImplemented error.
+type unimplementedRGT struct{}
+
+func (unimplementedRGT) DescribeReportCreation(_ *rgtsdk.DescribeReportCreationInput) (*rgtsdk.DescribeReportCreationOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "DescribeReportCreation")
+}
+func (unimplementedRGT) DescribeReportCreationRequest(_ *rgtsdk.DescribeReportCreationInput) (*request.Request, *rgtsdk.DescribeReportCreationOutput) {
+	return newNotImplementedRequest(ServiceRGT, "DescribeReportCreation"), nil
+}
+func (unimplementedRGT) DescribeReportCreationWithContext(_ context.Context, _ *rgtsdk.DescribeReportCreationInput, _ ...request.Option) (*rgtsdk.DescribeReportCreationOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "DescribeReportCreation")
+}
+func (unimplementedRGT) GetComplianceSummary(_ *rgtsdk.GetComplianceSummaryInput) (*rgtsdk.GetComplianceSummaryOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetComplianceSummary")
+}
+func (unimplementedRGT) GetComplianceSummaryPages(_ *rgtsdk.GetComplianceSummaryInput, _ func(*rgtsdk.GetComplianceSummaryOutput, bool) bool) error {
+	return newNotImplementedError(ServiceRGT, "GetComplianceSummary")
+}
+func (unimplementedRGT) GetComplianceSummaryPagesWithContext(_ context.Context, _ *rgtsdk.GetComplianceSummaryInput, _ func(*rgtsdk.GetComplianceSummaryOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceRGT, "GetComplianceSummary")
+}
+func (unimplementedRGT) GetComplianceSummaryRequest(_ *rgtsdk.GetComplianceSummaryInput) (*request.Request, *rgtsdk.GetComplianceSummaryOutput) {
+	return newNotImplementedRequest(ServiceRGT, "GetComplianceSummary"), nil
+}
+func (unimplementedRGT) GetComplianceSummaryWithContext(_ context.Context, _ *rgtsdk.GetComplianceSummaryInput, _ ...request.Option) (*rgtsdk.GetComplianceSummaryOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetComplianceSummary")
+}
+func (unimplementedRGT) GetResources(_ *rgtsdk.GetResourcesInput) (*rgtsdk.GetResourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetResources")
+}
+func (unimplementedRGT) GetResourcesPages(_ *rgtsdk.GetResourcesInput, _ func(*rgtsdk.GetResourcesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceRGT, "GetResources")
+}
+func (unimplementedRGT) GetResourcesPagesWithContext(_ context.Context, _ *rgtsdk.GetResourcesInput, _ func(*rgtsdk.GetResourcesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceRGT, "GetResources")
+}
+func (unimplementedRGT) GetResourcesRequest(_ *rgtsdk.GetResourcesInput) (*request.Request, *rgtsdk.GetResourcesOutput) {
+	return newNotImplementedRequest(ServiceRGT, "GetResources"), nil
+}
+func (unimplementedRGT) GetResourcesWithContext(_ context.Context, _ *rgtsdk.GetResourcesInput, _ ...request.Option) (*rgtsdk.GetResourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetResources")
+}
+func (unimplementedRGT) GetTagKeys(_ *rgtsdk.GetTagKeysInput) (*rgtsdk.GetTagKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetTagKeys")
+}
+func (unimplementedRGT) GetTagKeysPages(_ *rgtsdk.GetTagKeysInput, _ func(*rgtsdk.GetTagKeysOutput, bool) bool) error {
+	return newNotImplementedError(ServiceRGT, "GetTagKeys")
+}
+func (unimplementedRGT) GetTagKeysPagesWithContext(_ context.Context, _ *rgtsdk.GetTagKeysInput, _ func(*rgtsdk.GetTagKeysOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceRGT, "GetTagKeys")
+}
+func (unimplementedRGT) GetTagKeysRequest(_ *rgtsdk.GetTagKeysInput) (*request.Request, *rgtsdk.GetTagKeysOutput) {
+	return newNotImplementedRequest(ServiceRGT, "GetTagKeys"), nil
+}
+func (unimplementedRGT) GetTagKeysWithContext(_ context.Context, _ *rgtsdk.GetTagKeysInput, _ ...request.Option) (*rgtsdk.GetTagKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetTagKeys")
+}
+func (unimplementedRGT) GetTagValues(_ *rgtsdk.GetTagValuesInput) (*rgtsdk.GetTagValuesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetTagValues")
+}
+func (unimplementedRGT) GetTagValuesPages(_ *rgtsdk.GetTagValuesInput, _ func(*rgtsdk.GetTagValuesOutput, bool) bool) error {
+	return newNotImplementedError(ServiceRGT, "GetTagValues")
+}
+func (unimplementedRGT) GetTagValuesPagesWithContext(_ context.Context, _ *rgtsdk.GetTagValuesInput, _ func(*rgtsdk.GetTagValuesOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceRGT, "GetTagValues")
+}
+func (unimplementedRGT) GetTagValuesRequest(_ *rgtsdk.GetTagValuesInput) (*request.Request, *rgtsdk.GetTagValuesOutput) {
+	return newNotImplementedRequest(ServiceRGT, "GetTagValues"), nil
+}
+func (unimplementedRGT) GetTagValuesWithContext(_ context.Context, _ *rgtsdk.GetTagValuesInput, _ ...request.Option) (*rgtsdk.GetTagValuesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "GetTagValues")
+}
+func (unimplementedRGT) StartReportCreation(_ *rgtsdk.StartReportCreationInput) (*rgtsdk.StartReportCreationOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "StartReportCreation")
+}
+func (unimplementedRGT) StartReportCreationRequest(_ *rgtsdk.StartReportCreationInput) (*request.Request, *rgtsdk.StartReportCreationOutput) {
+	return newNotImplementedRequest(ServiceRGT, "StartReportCreation"), nil
+}
+func (unimplementedRGT) StartReportCreationWithContext(_ context.Context, _ *rgtsdk.StartReportCreationInput, _ ...request.Option) (*rgtsdk.StartReportCreationOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "StartReportCreation")
+}
+func (unimplementedRGT) TagResources(_ *rgtsdk.TagResourcesInput) (*rgtsdk.TagResourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "TagResources")
+}
+func (unimplementedRGT) TagResourcesRequest(_ *rgtsdk.TagResourcesInput) (*request.Request, *rgtsdk.TagResourcesOutput) {
+	return newNotImplementedRequest(ServiceRGT, "TagResources"), nil
+}
+func (unimplementedRGT) TagResourcesWithContext(_ context.Context, _ *rgtsdk.TagResourcesInput, _ ...request.Option) (*rgtsdk.TagResourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "TagResources")
+}
+func (unimplementedRGT) UntagResources(_ *rgtsdk.UntagResourcesInput) (*rgtsdk.UntagResourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "UntagResources")
+}
+func (unimplementedRGT) UntagResourcesRequest(_ *rgtsdk.UntagResourcesInput) (*request.Request, *rgtsdk.UntagResourcesOutput) {
+	return newNotImplementedRequest(ServiceRGT, "UntagResources"), nil
+}
+func (unimplementedRGT) UntagResourcesWithContext(_ context.Context, _ *rgtsdk.UntagResourcesInput, _ ...request.Option) (*rgtsdk.UntagResourcesOutput, error) {
+	return nil, newNotImplementedError(ServiceRGT, "UntagResources")
+}
+
+// unimplementedS3 implements s3iface.S3API with APIs that return NotImplemented error.
+type unimplementedS3 struct{}
+
+func (unimplementedS3) AbortMultipartUpload(_ *s3sdk.AbortMultipartUploadInput) (*s3sdk.AbortMultipartUploadOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "AbortMultipartUpload")
+}
+func (unimplementedS3) AbortMultipartUploadRequest(_ *s3sdk.AbortMultipartUploadInput) (*request.Request, *s3sdk.AbortMultipartUploadOutput) {
+	return newNotImplementedRequest(ServiceS3, "AbortMultipartUpload"), nil
+}
+func (unimplementedS3) AbortMultipartUploadWithContext(_ context.Context, _ *s3sdk.AbortMultipartUploadInput, _ ...request.Option) (*s3sdk.AbortMultipartUploadOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "AbortMultipartUpload")
+}
+func (unimplementedS3) CompleteMultipartUpload(_ *s3sdk.CompleteMultipartUploadInput) (*s3sdk.CompleteMultipartUploadOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CompleteMultipartUpload")
+}
+func (unimplementedS3) CompleteMultipartUploadRequest(_ *s3sdk.CompleteMultipartUploadInput) (*request.Request, *s3sdk.CompleteMultipartUploadOutput) {
+	return newNotImplementedRequest(ServiceS3, "CompleteMultipartUpload"), nil
+}
+func (unimplementedS3) CompleteMultipartUploadWithContext(_ context.Context, _ *s3sdk.CompleteMultipartUploadInput, _ ...request.Option) (*s3sdk.CompleteMultipartUploadOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CompleteMultipartUpload")
+}
+func (unimplementedS3) CopyObject(_ *s3sdk.CopyObjectInput) (*s3sdk.CopyObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CopyObject")
+}
+func (unimplementedS3) CopyObjectRequest(_ *s3sdk.CopyObjectInput) (*request.Request, *s3sdk.CopyObjectOutput) {
+	return newNotImplementedRequest(ServiceS3, "CopyObject"), nil
+}
+func (unimplementedS3) CopyObjectWithContext(_ context.Context, _ *s3sdk.CopyObjectInput, _ ...request.Option) (*s3sdk.CopyObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CopyObject")
+}
+func (unimplementedS3) CreateBucket(_ *s3sdk.CreateBucketInput) (*s3sdk.CreateBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CreateBucket")
+}
+func (unimplementedS3) CreateBucketRequest(_ *s3sdk.CreateBucketInput) (*request.Request, *s3sdk.CreateBucketOutput) {
+	return newNotImplementedRequest(ServiceS3, "CreateBucket"), nil
+}
+func (unimplementedS3) CreateBucketWithContext(_ context.Context, _ *s3sdk.CreateBucketInput, _ ...request.Option) (*s3sdk.CreateBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CreateBucket")
+}
+func (unimplementedS3) CreateMultipartUpload(_ *s3sdk.CreateMultipartUploadInput) (*s3sdk.CreateMultipartUploadOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CreateMultipartUpload")
+}
+func (unimplementedS3) CreateMultipartUploadRequest(_ *s3sdk.CreateMultipartUploadInput) (*request.Request, *s3sdk.CreateMultipartUploadOutput) {
+	return newNotImplementedRequest(ServiceS3, "CreateMultipartUpload"), nil
+}
+func (unimplementedS3) CreateMultipartUploadWithContext(_ context.Context, _ *s3sdk.CreateMultipartUploadInput, _ ...request.Option) (*s3sdk.CreateMultipartUploadOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "CreateMultipartUpload")
+}
+func (unimplementedS3) DeleteBucket(_ *s3sdk.DeleteBucketInput) (*s3sdk.DeleteBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucket")
+}
+func (unimplementedS3) DeleteBucketAnalyticsConfiguration(_ *s3sdk.DeleteBucketAnalyticsConfigurationInput) (*s3sdk.DeleteBucketAnalyticsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketAnalyticsConfiguration")
+}
+func (unimplementedS3) DeleteBucketAnalyticsConfigurationRequest(_ *s3sdk.DeleteBucketAnalyticsConfigurationInput) (*request.Request, *s3sdk.DeleteBucketAnalyticsConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketAnalyticsConfiguration"), nil
+}
+func (unimplementedS3) DeleteBucketAnalyticsConfigurationWithContext(_ context.Context, _ *s3sdk.DeleteBucketAnalyticsConfigurationInput, _ ...request.Option) (*s3sdk.DeleteBucketAnalyticsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketAnalyticsConfiguration")
+}
+func (unimplementedS3) DeleteBucketCors(_ *s3sdk.DeleteBucketCorsInput) (*s3sdk.DeleteBucketCorsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketCors")
+}
+func (unimplementedS3) DeleteBucketCorsRequest(_ *s3sdk.DeleteBucketCorsInput) (*request.Request, *s3sdk.DeleteBucketCorsOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketCors"), nil
+}
+func (unimplementedS3) DeleteBucketCorsWithContext(_ context.Context, _ *s3sdk.DeleteBucketCorsInput, _ ...request.Option) (*s3sdk.DeleteBucketCorsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketCors")
+}
+func (unimplementedS3) DeleteBucketEncryption(_ *s3sdk.DeleteBucketEncryptionInput) (*s3sdk.DeleteBucketEncryptionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketEncryption")
+}
+func (unimplementedS3) DeleteBucketEncryptionRequest(_ *s3sdk.DeleteBucketEncryptionInput) (*request.Request, *s3sdk.DeleteBucketEncryptionOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketEncryption"), nil
+}
+func (unimplementedS3) DeleteBucketEncryptionWithContext(_ context.Context, _ *s3sdk.DeleteBucketEncryptionInput, _ ...request.Option) (*s3sdk.DeleteBucketEncryptionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketEncryption")
+}
+func (unimplementedS3) DeleteBucketIntelligentTieringConfiguration(_ *s3sdk.DeleteBucketIntelligentTieringConfigurationInput) (*s3sdk.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketIntelligentTieringConfiguration")
+}
+func (unimplementedS3) DeleteBucketIntelligentTieringConfigurationRequest(_ *s3sdk.DeleteBucketIntelligentTieringConfigurationInput) (*request.Request, *s3sdk.DeleteBucketIntelligentTieringConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketIntelligentTieringConfiguration"), nil
+}
+func (unimplementedS3) DeleteBucketIntelligentTieringConfigurationWithContext(_ context.Context, _ *s3sdk.DeleteBucketIntelligentTieringConfigurationInput, _ ...request.Option) (*s3sdk.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketIntelligentTieringConfiguration")
+}
+func (unimplementedS3) DeleteBucketInventoryConfiguration(_ *s3sdk.DeleteBucketInventoryConfigurationInput) (*s3sdk.DeleteBucketInventoryConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketInventoryConfiguration")
+}
+func (unimplementedS3) DeleteBucketInventoryConfigurationRequest(_ *s3sdk.DeleteBucketInventoryConfigurationInput) (*request.Request, *s3sdk.DeleteBucketInventoryConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketInventoryConfiguration"), nil
+}
+func (unimplementedS3) DeleteBucketInventoryConfigurationWithContext(_ context.Context, _ *s3sdk.DeleteBucketInventoryConfigurationInput, _ ...request.Option) (*s3sdk.DeleteBucketInventoryConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketInventoryConfiguration")
+}
+func (unimplementedS3) DeleteBucketLifecycle(_ *s3sdk.DeleteBucketLifecycleInput) (*s3sdk.DeleteBucketLifecycleOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketLifecycle")
+}
+func (unimplementedS3) DeleteBucketLifecycleRequest(_ *s3sdk.DeleteBucketLifecycleInput) (*request.Request, *s3sdk.DeleteBucketLifecycleOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketLifecycle"), nil
+}
+func (unimplementedS3) DeleteBucketLifecycleWithContext(_ context.Context, _ *s3sdk.DeleteBucketLifecycleInput, _ ...request.Option) (*s3sdk.DeleteBucketLifecycleOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketLifecycle")
+}
+func (unimplementedS3) DeleteBucketMetricsConfiguration(_ *s3sdk.DeleteBucketMetricsConfigurationInput) (*s3sdk.DeleteBucketMetricsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketMetricsConfiguration")
+}
+func (unimplementedS3) DeleteBucketMetricsConfigurationRequest(_ *s3sdk.DeleteBucketMetricsConfigurationInput) (*request.Request, *s3sdk.DeleteBucketMetricsConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketMetricsConfiguration"), nil
+}
+func (unimplementedS3) DeleteBucketMetricsConfigurationWithContext(_ context.Context, _ *s3sdk.DeleteBucketMetricsConfigurationInput, _ ...request.Option) (*s3sdk.DeleteBucketMetricsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketMetricsConfiguration")
+}
+func (unimplementedS3) DeleteBucketOwnershipControls(_ *s3sdk.DeleteBucketOwnershipControlsInput) (*s3sdk.DeleteBucketOwnershipControlsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketOwnershipControls")
+}
+func (unimplementedS3) DeleteBucketOwnershipControlsRequest(_ *s3sdk.DeleteBucketOwnershipControlsInput) (*request.Request, *s3sdk.DeleteBucketOwnershipControlsOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketOwnershipControls"), nil
+}
+func (unimplementedS3) DeleteBucketOwnershipControlsWithContext(_ context.Context, _ *s3sdk.DeleteBucketOwnershipControlsInput, _ ...request.Option) (*s3sdk.DeleteBucketOwnershipControlsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketOwnershipControls")
+}
+func (unimplementedS3) DeleteBucketPolicy(_ *s3sdk.DeleteBucketPolicyInput) (*s3sdk.DeleteBucketPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketPolicy")
+}
+func (unimplementedS3) DeleteBucketPolicyRequest(_ *s3sdk.DeleteBucketPolicyInput) (*request.Request, *s3sdk.DeleteBucketPolicyOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketPolicy"), nil
+}
+func (unimplementedS3) DeleteBucketPolicyWithContext(_ context.Context, _ *s3sdk.DeleteBucketPolicyInput, _ ...request.Option) (*s3sdk.DeleteBucketPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketPolicy")
+}
+func (unimplementedS3) DeleteBucketReplication(_ *s3sdk.DeleteBucketReplicationInput) (*s3sdk.DeleteBucketReplicationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketReplication")
+}
+func (unimplementedS3) DeleteBucketReplicationRequest(_ *s3sdk.DeleteBucketReplicationInput) (*request.Request, *s3sdk.DeleteBucketReplicationOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketReplication"), nil
+}
+func (unimplementedS3) DeleteBucketReplicationWithContext(_ context.Context, _ *s3sdk.DeleteBucketReplicationInput, _ ...request.Option) (*s3sdk.DeleteBucketReplicationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketReplication")
+}
+func (unimplementedS3) DeleteBucketRequest(_ *s3sdk.DeleteBucketInput) (*request.Request, *s3sdk.DeleteBucketOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucket"), nil
+}
+func (unimplementedS3) DeleteBucketTagging(_ *s3sdk.DeleteBucketTaggingInput) (*s3sdk.DeleteBucketTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketTagging")
+}
+func (unimplementedS3) DeleteBucketTaggingRequest(_ *s3sdk.DeleteBucketTaggingInput) (*request.Request, *s3sdk.DeleteBucketTaggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketTagging"), nil
+}
+func (unimplementedS3) DeleteBucketTaggingWithContext(_ context.Context, _ *s3sdk.DeleteBucketTaggingInput, _ ...request.Option) (*s3sdk.DeleteBucketTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketTagging")
+}
+func (unimplementedS3) DeleteBucketWebsite(_ *s3sdk.DeleteBucketWebsiteInput) (*s3sdk.DeleteBucketWebsiteOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketWebsite")
+}
+func (unimplementedS3) DeleteBucketWebsiteRequest(_ *s3sdk.DeleteBucketWebsiteInput) (*request.Request, *s3sdk.DeleteBucketWebsiteOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteBucketWebsite"), nil
+}
+func (unimplementedS3) DeleteBucketWebsiteWithContext(_ context.Context, _ *s3sdk.DeleteBucketWebsiteInput, _ ...request.Option) (*s3sdk.DeleteBucketWebsiteOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucketWebsite")
+}
+func (unimplementedS3) DeleteBucketWithContext(_ context.Context, _ *s3sdk.DeleteBucketInput, _ ...request.Option) (*s3sdk.DeleteBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteBucket")
+}
+func (unimplementedS3) DeleteObject(_ *s3sdk.DeleteObjectInput) (*s3sdk.DeleteObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteObject")
+}
+func (unimplementedS3) DeleteObjectRequest(_ *s3sdk.DeleteObjectInput) (*request.Request, *s3sdk.DeleteObjectOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteObject"), nil
+}
+func (unimplementedS3) DeleteObjectTagging(_ *s3sdk.DeleteObjectTaggingInput) (*s3sdk.DeleteObjectTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteObjectTagging")
+}
+func (unimplementedS3) DeleteObjectTaggingRequest(_ *s3sdk.DeleteObjectTaggingInput) (*request.Request, *s3sdk.DeleteObjectTaggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteObjectTagging"), nil
+}
+func (unimplementedS3) DeleteObjectTaggingWithContext(_ context.Context, _ *s3sdk.DeleteObjectTaggingInput, _ ...request.Option) (*s3sdk.DeleteObjectTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteObjectTagging")
+}
+func (unimplementedS3) DeleteObjectWithContext(_ context.Context, _ *s3sdk.DeleteObjectInput, _ ...request.Option) (*s3sdk.DeleteObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteObject")
+}
+func (unimplementedS3) DeleteObjects(_ *s3sdk.DeleteObjectsInput) (*s3sdk.DeleteObjectsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteObjects")
+}
+func (unimplementedS3) DeleteObjectsRequest(_ *s3sdk.DeleteObjectsInput) (*request.Request, *s3sdk.DeleteObjectsOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeleteObjects"), nil
+}
+func (unimplementedS3) DeleteObjectsWithContext(_ context.Context, _ *s3sdk.DeleteObjectsInput, _ ...request.Option) (*s3sdk.DeleteObjectsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeleteObjects")
+}
+func (unimplementedS3) DeletePublicAccessBlock(_ *s3sdk.DeletePublicAccessBlockInput) (*s3sdk.DeletePublicAccessBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeletePublicAccessBlock")
+}
+func (unimplementedS3) DeletePublicAccessBlockRequest(_ *s3sdk.DeletePublicAccessBlockInput) (*request.Request, *s3sdk.DeletePublicAccessBlockOutput) {
+	return newNotImplementedRequest(ServiceS3, "DeletePublicAccessBlock"), nil
+}
+func (unimplementedS3) DeletePublicAccessBlockWithContext(_ context.Context, _ *s3sdk.DeletePublicAccessBlockInput, _ ...request.Option) (*s3sdk.DeletePublicAccessBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "DeletePublicAccessBlock")
+}
+func (unimplementedS3) GetBucketAccelerateConfiguration(_ *s3sdk.GetBucketAccelerateConfigurationInput) (*s3sdk.GetBucketAccelerateConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketAccelerateConfiguration")
+}
+func (unimplementedS3) GetBucketAccelerateConfigurationRequest(_ *s3sdk.GetBucketAccelerateConfigurationInput) (*request.Request, *s3sdk.GetBucketAccelerateConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketAccelerateConfiguration"), nil
+}
+func (unimplementedS3) GetBucketAccelerateConfigurationWithContext(_ context.Context, _ *s3sdk.GetBucketAccelerateConfigurationInput, _ ...request.Option) (*s3sdk.GetBucketAccelerateConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketAccelerateConfiguration")
+}
+func (unimplementedS3) GetBucketAcl(_ *s3sdk.GetBucketAclInput) (*s3sdk.GetBucketAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketAcl")
+}
+func (unimplementedS3) GetBucketAclRequest(_ *s3sdk.GetBucketAclInput) (*request.Request, *s3sdk.GetBucketAclOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketAcl"), nil
+}
+func (unimplementedS3) GetBucketAclWithContext(_ context.Context, _ *s3sdk.GetBucketAclInput, _ ...request.Option) (*s3sdk.GetBucketAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketAcl")
+}
+func (unimplementedS3) GetBucketAnalyticsConfiguration(_ *s3sdk.GetBucketAnalyticsConfigurationInput) (*s3sdk.GetBucketAnalyticsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketAnalyticsConfiguration")
+}
+func (unimplementedS3) GetBucketAnalyticsConfigurationRequest(_ *s3sdk.GetBucketAnalyticsConfigurationInput) (*request.Request, *s3sdk.GetBucketAnalyticsConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketAnalyticsConfiguration"), nil
+}
+func (unimplementedS3) GetBucketAnalyticsConfigurationWithContext(_ context.Context, _ *s3sdk.GetBucketAnalyticsConfigurationInput, _ ...request.Option) (*s3sdk.GetBucketAnalyticsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketAnalyticsConfiguration")
+}
+func (unimplementedS3) GetBucketCors(_ *s3sdk.GetBucketCorsInput) (*s3sdk.GetBucketCorsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketCors")
+}
+func (unimplementedS3) GetBucketCorsRequest(_ *s3sdk.GetBucketCorsInput) (*request.Request, *s3sdk.GetBucketCorsOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketCors"), nil
+}
+func (unimplementedS3) GetBucketCorsWithContext(_ context.Context, _ *s3sdk.GetBucketCorsInput, _ ...request.Option) (*s3sdk.GetBucketCorsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketCors")
+}
+func (unimplementedS3) GetBucketEncryption(_ *s3sdk.GetBucketEncryptionInput) (*s3sdk.GetBucketEncryptionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketEncryption")
+}
+func (unimplementedS3) GetBucketEncryptionRequest(_ *s3sdk.GetBucketEncryptionInput) (*request.Request, *s3sdk.GetBucketEncryptionOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketEncryption"), nil
+}
+func (unimplementedS3) GetBucketEncryptionWithContext(_ context.Context, _ *s3sdk.GetBucketEncryptionInput, _ ...request.Option) (*s3sdk.GetBucketEncryptionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketEncryption")
+}
+func (unimplementedS3) GetBucketIntelligentTieringConfiguration(_ *s3sdk.GetBucketIntelligentTieringConfigurationInput) (*s3sdk.GetBucketIntelligentTieringConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketIntelligentTieringConfiguration")
+}
+func (unimplementedS3) GetBucketIntelligentTieringConfigurationRequest(_ *s3sdk.GetBucketIntelligentTieringConfigurationInput) (*request.Request, *s3sdk.GetBucketIntelligentTieringConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketIntelligentTieringConfiguration"), nil
+}
+func (unimplementedS3) GetBucketIntelligentTieringConfigurationWithContext(_ context.Context, _ *s3sdk.GetBucketIntelligentTieringConfigurationInput, _ ...request.Option) (*s3sdk.GetBucketIntelligentTieringConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketIntelligentTieringConfiguration")
+}
+func (unimplementedS3) GetBucketInventoryConfiguration(_ *s3sdk.GetBucketInventoryConfigurationInput) (*s3sdk.GetBucketInventoryConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketInventoryConfiguration")
+}
+func (unimplementedS3) GetBucketInventoryConfigurationRequest(_ *s3sdk.GetBucketInventoryConfigurationInput) (*request.Request, *s3sdk.GetBucketInventoryConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketInventoryConfiguration"), nil
+}
+func (unimplementedS3) GetBucketInventoryConfigurationWithContext(_ context.Context, _ *s3sdk.GetBucketInventoryConfigurationInput, _ ...request.Option) (*s3sdk.GetBucketInventoryConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketInventoryConfiguration")
+}
+func (unimplementedS3) GetBucketLifecycle(_ *s3sdk.GetBucketLifecycleInput) (*s3sdk.GetBucketLifecycleOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLifecycle")
+}
+func (unimplementedS3) GetBucketLifecycleConfiguration(_ *s3sdk.GetBucketLifecycleConfigurationInput) (*s3sdk.GetBucketLifecycleConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLifecycleConfiguration")
+}
+func (unimplementedS3) GetBucketLifecycleConfigurationRequest(_ *s3sdk.GetBucketLifecycleConfigurationInput) (*request.Request, *s3sdk.GetBucketLifecycleConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketLifecycleConfiguration"), nil
+}
+func (unimplementedS3) GetBucketLifecycleConfigurationWithContext(_ context.Context, _ *s3sdk.GetBucketLifecycleConfigurationInput, _ ...request.Option) (*s3sdk.GetBucketLifecycleConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLifecycleConfiguration")
+}
+func (unimplementedS3) GetBucketLifecycleRequest(_ *s3sdk.GetBucketLifecycleInput) (*request.Request, *s3sdk.GetBucketLifecycleOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketLifecycle"), nil
+}
+func (unimplementedS3) GetBucketLifecycleWithContext(_ context.Context, _ *s3sdk.GetBucketLifecycleInput, _ ...request.Option) (*s3sdk.GetBucketLifecycleOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLifecycle")
+}
+func (unimplementedS3) GetBucketLocation(_ *s3sdk.GetBucketLocationInput) (*s3sdk.GetBucketLocationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLocation")
+}
+func (unimplementedS3) GetBucketLocationRequest(_ *s3sdk.GetBucketLocationInput) (*request.Request, *s3sdk.GetBucketLocationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketLocation"), nil
+}
+func (unimplementedS3) GetBucketLocationWithContext(_ context.Context, _ *s3sdk.GetBucketLocationInput, _ ...request.Option) (*s3sdk.GetBucketLocationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLocation")
+}
+func (unimplementedS3) GetBucketLogging(_ *s3sdk.GetBucketLoggingInput) (*s3sdk.GetBucketLoggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLogging")
+}
+func (unimplementedS3) GetBucketLoggingRequest(_ *s3sdk.GetBucketLoggingInput) (*request.Request, *s3sdk.GetBucketLoggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketLogging"), nil
+}
+func (unimplementedS3) GetBucketLoggingWithContext(_ context.Context, _ *s3sdk.GetBucketLoggingInput, _ ...request.Option) (*s3sdk.GetBucketLoggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketLogging")
+}
+func (unimplementedS3) GetBucketMetricsConfiguration(_ *s3sdk.GetBucketMetricsConfigurationInput) (*s3sdk.GetBucketMetricsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketMetricsConfiguration")
+}
+func (unimplementedS3) GetBucketMetricsConfigurationRequest(_ *s3sdk.GetBucketMetricsConfigurationInput) (*request.Request, *s3sdk.GetBucketMetricsConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketMetricsConfiguration"), nil
+}
+func (unimplementedS3) GetBucketMetricsConfigurationWithContext(_ context.Context, _ *s3sdk.GetBucketMetricsConfigurationInput, _ ...request.Option) (*s3sdk.GetBucketMetricsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketMetricsConfiguration")
+}
+func (unimplementedS3) GetBucketNotification(_ *s3sdk.GetBucketNotificationConfigurationRequest) (*s3sdk.NotificationConfigurationDeprecated, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketNotification")
+}
+func (unimplementedS3) GetBucketNotificationConfiguration(_ *s3sdk.GetBucketNotificationConfigurationRequest) (*s3sdk.NotificationConfiguration, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketNotificationConfiguration")
+}
+func (unimplementedS3) GetBucketNotificationConfigurationRequest(_ *s3sdk.GetBucketNotificationConfigurationRequest) (*request.Request, *s3sdk.NotificationConfiguration) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketNotificationConfiguration"), nil
+}
+func (unimplementedS3) GetBucketNotificationConfigurationWithContext(_ context.Context, _ *s3sdk.GetBucketNotificationConfigurationRequest, _ ...request.Option) (*s3sdk.NotificationConfiguration, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketNotificationConfiguration")
+}
+func (unimplementedS3) GetBucketNotificationRequest(_ *s3sdk.GetBucketNotificationConfigurationRequest) (*request.Request, *s3sdk.NotificationConfigurationDeprecated) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketNotification"), nil
+}
+func (unimplementedS3) GetBucketNotificationWithContext(_ context.Context, _ *s3sdk.GetBucketNotificationConfigurationRequest, _ ...request.Option) (*s3sdk.NotificationConfigurationDeprecated, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketNotification")
+}
+func (unimplementedS3) GetBucketOwnershipControls(_ *s3sdk.GetBucketOwnershipControlsInput) (*s3sdk.GetBucketOwnershipControlsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketOwnershipControls")
+}
+func (unimplementedS3) GetBucketOwnershipControlsRequest(_ *s3sdk.GetBucketOwnershipControlsInput) (*request.Request, *s3sdk.GetBucketOwnershipControlsOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketOwnershipControls"), nil
+}
+func (unimplementedS3) GetBucketOwnershipControlsWithContext(_ context.Context, _ *s3sdk.GetBucketOwnershipControlsInput, _ ...request.Option) (*s3sdk.GetBucketOwnershipControlsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketOwnershipControls")
+}
+func (unimplementedS3) GetBucketPolicy(_ *s3sdk.GetBucketPolicyInput) (*s3sdk.GetBucketPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketPolicy")
+}
+func (unimplementedS3) GetBucketPolicyRequest(_ *s3sdk.GetBucketPolicyInput) (*request.Request, *s3sdk.GetBucketPolicyOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketPolicy"), nil
+}
+func (unimplementedS3) GetBucketPolicyStatus(_ *s3sdk.GetBucketPolicyStatusInput) (*s3sdk.GetBucketPolicyStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketPolicyStatus")
+}
+func (unimplementedS3) GetBucketPolicyStatusRequest(_ *s3sdk.GetBucketPolicyStatusInput) (*request.Request, *s3sdk.GetBucketPolicyStatusOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketPolicyStatus"), nil
+}
+func (unimplementedS3) GetBucketPolicyStatusWithContext(_ context.Context, _ *s3sdk.GetBucketPolicyStatusInput, _ ...request.Option) (*s3sdk.GetBucketPolicyStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketPolicyStatus")
+}
+func (unimplementedS3) GetBucketPolicyWithContext(_ context.Context, _ *s3sdk.GetBucketPolicyInput, _ ...request.Option) (*s3sdk.GetBucketPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketPolicy")
+}
+func (unimplementedS3) GetBucketReplication(_ *s3sdk.GetBucketReplicationInput) (*s3sdk.GetBucketReplicationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketReplication")
+}
+func (unimplementedS3) GetBucketReplicationRequest(_ *s3sdk.GetBucketReplicationInput) (*request.Request, *s3sdk.GetBucketReplicationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketReplication"), nil
+}
+func (unimplementedS3) GetBucketReplicationWithContext(_ context.Context, _ *s3sdk.GetBucketReplicationInput, _ ...request.Option) (*s3sdk.GetBucketReplicationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketReplication")
+}
+func (unimplementedS3) GetBucketRequestPayment(_ *s3sdk.GetBucketRequestPaymentInput) (*s3sdk.GetBucketRequestPaymentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketRequestPayment")
+}
+func (unimplementedS3) GetBucketRequestPaymentRequest(_ *s3sdk.GetBucketRequestPaymentInput) (*request.Request, *s3sdk.GetBucketRequestPaymentOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketRequestPayment"), nil
+}
+func (unimplementedS3) GetBucketRequestPaymentWithContext(_ context.Context, _ *s3sdk.GetBucketRequestPaymentInput, _ ...request.Option) (*s3sdk.GetBucketRequestPaymentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketRequestPayment")
+}
+func (unimplementedS3) GetBucketTagging(_ *s3sdk.GetBucketTaggingInput) (*s3sdk.GetBucketTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketTagging")
+}
+func (unimplementedS3) GetBucketTaggingRequest(_ *s3sdk.GetBucketTaggingInput) (*request.Request, *s3sdk.GetBucketTaggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketTagging"), nil
+}
+func (unimplementedS3) GetBucketTaggingWithContext(_ context.Context, _ *s3sdk.GetBucketTaggingInput, _ ...request.Option) (*s3sdk.GetBucketTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketTagging")
+}
+func (unimplementedS3) GetBucketVersioning(_ *s3sdk.GetBucketVersioningInput) (*s3sdk.GetBucketVersioningOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketVersioning")
+}
+func (unimplementedS3) GetBucketVersioningRequest(_ *s3sdk.GetBucketVersioningInput) (*request.Request, *s3sdk.GetBucketVersioningOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketVersioning"), nil
+}
+func (unimplementedS3) GetBucketVersioningWithContext(_ context.Context, _ *s3sdk.GetBucketVersioningInput, _ ...request.Option) (*s3sdk.GetBucketVersioningOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketVersioning")
+}
+func (unimplementedS3) GetBucketWebsite(_ *s3sdk.GetBucketWebsiteInput) (*s3sdk.GetBucketWebsiteOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketWebsite")
+}
+func (unimplementedS3) GetBucketWebsiteRequest(_ *s3sdk.GetBucketWebsiteInput) (*request.Request, *s3sdk.GetBucketWebsiteOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetBucketWebsite"), nil
+}
+func (unimplementedS3) GetBucketWebsiteWithContext(_ context.Context, _ *s3sdk.GetBucketWebsiteInput, _ ...request.Option) (*s3sdk.GetBucketWebsiteOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetBucketWebsite")
+}
+func (unimplementedS3) GetObject(_ *s3sdk.GetObjectInput) (*s3sdk.GetObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObject")
+}
+func (unimplementedS3) GetObjectAcl(_ *s3sdk.GetObjectAclInput) (*s3sdk.GetObjectAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectAcl")
+}
+func (unimplementedS3) GetObjectAclRequest(_ *s3sdk.GetObjectAclInput) (*request.Request, *s3sdk.GetObjectAclOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObjectAcl"), nil
+}
+func (unimplementedS3) GetObjectAclWithContext(_ context.Context, _ *s3sdk.GetObjectAclInput, _ ...request.Option) (*s3sdk.GetObjectAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectAcl")
+}
+func (unimplementedS3) GetObjectAttributes(_ *s3sdk.GetObjectAttributesInput) (*s3sdk.GetObjectAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectAttributes")
+}
+func (unimplementedS3) GetObjectAttributesRequest(_ *s3sdk.GetObjectAttributesInput) (*request.Request, *s3sdk.GetObjectAttributesOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObjectAttributes"), nil
+}
+func (unimplementedS3) GetObjectAttributesWithContext(_ context.Context, _ *s3sdk.GetObjectAttributesInput, _ ...request.Option) (*s3sdk.GetObjectAttributesOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectAttributes")
+}
+func (unimplementedS3) GetObjectLegalHold(_ *s3sdk.GetObjectLegalHoldInput) (*s3sdk.GetObjectLegalHoldOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectLegalHold")
+}
+func (unimplementedS3) GetObjectLegalHoldRequest(_ *s3sdk.GetObjectLegalHoldInput) (*request.Request, *s3sdk.GetObjectLegalHoldOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObjectLegalHold"), nil
+}
+func (unimplementedS3) GetObjectLegalHoldWithContext(_ context.Context, _ *s3sdk.GetObjectLegalHoldInput, _ ...request.Option) (*s3sdk.GetObjectLegalHoldOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectLegalHold")
+}
+func (unimplementedS3) GetObjectLockConfiguration(_ *s3sdk.GetObjectLockConfigurationInput) (*s3sdk.GetObjectLockConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectLockConfiguration")
+}
+func (unimplementedS3) GetObjectLockConfigurationRequest(_ *s3sdk.GetObjectLockConfigurationInput) (*request.Request, *s3sdk.GetObjectLockConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObjectLockConfiguration"), nil
+}
+func (unimplementedS3) GetObjectLockConfigurationWithContext(_ context.Context, _ *s3sdk.GetObjectLockConfigurationInput, _ ...request.Option) (*s3sdk.GetObjectLockConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectLockConfiguration")
+}
+func (unimplementedS3) GetObjectRequest(_ *s3sdk.GetObjectInput) (*request.Request, *s3sdk.GetObjectOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObject"), nil
+}
+func (unimplementedS3) GetObjectRetention(_ *s3sdk.GetObjectRetentionInput) (*s3sdk.GetObjectRetentionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectRetention")
+}
+func (unimplementedS3) GetObjectRetentionRequest(_ *s3sdk.GetObjectRetentionInput) (*request.Request, *s3sdk.GetObjectRetentionOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObjectRetention"), nil
+}
+func (unimplementedS3) GetObjectRetentionWithContext(_ context.Context, _ *s3sdk.GetObjectRetentionInput, _ ...request.Option) (*s3sdk.GetObjectRetentionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectRetention")
+}
+func (unimplementedS3) GetObjectTagging(_ *s3sdk.GetObjectTaggingInput) (*s3sdk.GetObjectTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectTagging")
+}
+func (unimplementedS3) GetObjectTaggingRequest(_ *s3sdk.GetObjectTaggingInput) (*request.Request, *s3sdk.GetObjectTaggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObjectTagging"), nil
+}
+func (unimplementedS3) GetObjectTaggingWithContext(_ context.Context, _ *s3sdk.GetObjectTaggingInput, _ ...request.Option) (*s3sdk.GetObjectTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectTagging")
+}
+func (unimplementedS3) GetObjectTorrent(_ *s3sdk.GetObjectTorrentInput) (*s3sdk.GetObjectTorrentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectTorrent")
+}
+func (unimplementedS3) GetObjectTorrentRequest(_ *s3sdk.GetObjectTorrentInput) (*request.Request, *s3sdk.GetObjectTorrentOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetObjectTorrent"), nil
+}
+func (unimplementedS3) GetObjectTorrentWithContext(_ context.Context, _ *s3sdk.GetObjectTorrentInput, _ ...request.Option) (*s3sdk.GetObjectTorrentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObjectTorrent")
+}
+func (unimplementedS3) GetObjectWithContext(_ context.Context, _ *s3sdk.GetObjectInput, _ ...request.Option) (*s3sdk.GetObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetObject")
+}
+func (unimplementedS3) GetPublicAccessBlock(_ *s3sdk.GetPublicAccessBlockInput) (*s3sdk.GetPublicAccessBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetPublicAccessBlock")
+}
+func (unimplementedS3) GetPublicAccessBlockRequest(_ *s3sdk.GetPublicAccessBlockInput) (*request.Request, *s3sdk.GetPublicAccessBlockOutput) {
+	return newNotImplementedRequest(ServiceS3, "GetPublicAccessBlock"), nil
+}
+func (unimplementedS3) GetPublicAccessBlockWithContext(_ context.Context, _ *s3sdk.GetPublicAccessBlockInput, _ ...request.Option) (*s3sdk.GetPublicAccessBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "GetPublicAccessBlock")
+}
+func (unimplementedS3) HeadBucket(_ *s3sdk.HeadBucketInput) (*s3sdk.HeadBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "HeadBucket")
+}
+func (unimplementedS3) HeadBucketRequest(_ *s3sdk.HeadBucketInput) (*request.Request, *s3sdk.HeadBucketOutput) {
+	return newNotImplementedRequest(ServiceS3, "HeadBucket"), nil
+}
+func (unimplementedS3) HeadBucketWithContext(_ context.Context, _ *s3sdk.HeadBucketInput, _ ...request.Option) (*s3sdk.HeadBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "HeadBucket")
+}
+func (unimplementedS3) HeadObject(_ *s3sdk.HeadObjectInput) (*s3sdk.HeadObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "HeadObject")
+}
+func (unimplementedS3) HeadObjectRequest(_ *s3sdk.HeadObjectInput) (*request.Request, *s3sdk.HeadObjectOutput) {
+	return newNotImplementedRequest(ServiceS3, "HeadObject"), nil
+}
+func (unimplementedS3) HeadObjectWithContext(_ context.Context, _ *s3sdk.HeadObjectInput, _ ...request.Option) (*s3sdk.HeadObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "HeadObject")
+}
+func (unimplementedS3) ListBucketAnalyticsConfigurations(_ *s3sdk.ListBucketAnalyticsConfigurationsInput) (*s3sdk.ListBucketAnalyticsConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketAnalyticsConfigurations")
+}
+func (unimplementedS3) ListBucketAnalyticsConfigurationsRequest(_ *s3sdk.ListBucketAnalyticsConfigurationsInput) (*request.Request, *s3sdk.ListBucketAnalyticsConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListBucketAnalyticsConfigurations"), nil
+}
+func (unimplementedS3) ListBucketAnalyticsConfigurationsWithContext(_ context.Context, _ *s3sdk.ListBucketAnalyticsConfigurationsInput, _ ...request.Option) (*s3sdk.ListBucketAnalyticsConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketAnalyticsConfigurations")
+}
+func (unimplementedS3) ListBucketIntelligentTieringConfigurations(_ *s3sdk.ListBucketIntelligentTieringConfigurationsInput) (*s3sdk.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketIntelligentTieringConfigurations")
+}
+func (unimplementedS3) ListBucketIntelligentTieringConfigurationsRequest(_ *s3sdk.ListBucketIntelligentTieringConfigurationsInput) (*request.Request, *s3sdk.ListBucketIntelligentTieringConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListBucketIntelligentTieringConfigurations"), nil
+}
+func (unimplementedS3) ListBucketIntelligentTieringConfigurationsWithContext(_ context.Context, _ *s3sdk.ListBucketIntelligentTieringConfigurationsInput, _ ...request.Option) (*s3sdk.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketIntelligentTieringConfigurations")
+}
+func (unimplementedS3) ListBucketInventoryConfigurations(_ *s3sdk.ListBucketInventoryConfigurationsInput) (*s3sdk.ListBucketInventoryConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketInventoryConfigurations")
+}
+func (unimplementedS3) ListBucketInventoryConfigurationsRequest(_ *s3sdk.ListBucketInventoryConfigurationsInput) (*request.Request, *s3sdk.ListBucketInventoryConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListBucketInventoryConfigurations"), nil
+}
+func (unimplementedS3) ListBucketInventoryConfigurationsWithContext(_ context.Context, _ *s3sdk.ListBucketInventoryConfigurationsInput, _ ...request.Option) (*s3sdk.ListBucketInventoryConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketInventoryConfigurations")
+}
+func (unimplementedS3) ListBucketMetricsConfigurations(_ *s3sdk.ListBucketMetricsConfigurationsInput) (*s3sdk.ListBucketMetricsConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketMetricsConfigurations")
+}
+func (unimplementedS3) ListBucketMetricsConfigurationsRequest(_ *s3sdk.ListBucketMetricsConfigurationsInput) (*request.Request, *s3sdk.ListBucketMetricsConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListBucketMetricsConfigurations"), nil
+}
+func (unimplementedS3) ListBucketMetricsConfigurationsWithContext(_ context.Context, _ *s3sdk.ListBucketMetricsConfigurationsInput, _ ...request.Option) (*s3sdk.ListBucketMetricsConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBucketMetricsConfigurations")
+}
+func (unimplementedS3) ListBuckets(_ *s3sdk.ListBucketsInput) (*s3sdk.ListBucketsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBuckets")
+}
+func (unimplementedS3) ListBucketsRequest(_ *s3sdk.ListBucketsInput) (*request.Request, *s3sdk.ListBucketsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListBuckets"), nil
+}
+func (unimplementedS3) ListBucketsWithContext(_ context.Context, _ *s3sdk.ListBucketsInput, _ ...request.Option) (*s3sdk.ListBucketsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListBuckets")
+}
+func (unimplementedS3) ListMultipartUploads(_ *s3sdk.ListMultipartUploadsInput) (*s3sdk.ListMultipartUploadsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListMultipartUploads")
+}
+func (unimplementedS3) ListMultipartUploadsPages(_ *s3sdk.ListMultipartUploadsInput, _ func(*s3sdk.ListMultipartUploadsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceS3, "ListMultipartUploads")
+}
+func (unimplementedS3) ListMultipartUploadsPagesWithContext(_ context.Context, _ *s3sdk.ListMultipartUploadsInput, _ func(*s3sdk.ListMultipartUploadsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceS3, "ListMultipartUploads")
+}
+func (unimplementedS3) ListMultipartUploadsRequest(_ *s3sdk.ListMultipartUploadsInput) (*request.Request, *s3sdk.ListMultipartUploadsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListMultipartUploads"), nil
+}
+func (unimplementedS3) ListMultipartUploadsWithContext(_ context.Context, _ *s3sdk.ListMultipartUploadsInput, _ ...request.Option) (*s3sdk.ListMultipartUploadsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListMultipartUploads")
+}
+func (unimplementedS3) ListObjectVersions(_ *s3sdk.ListObjectVersionsInput) (*s3sdk.ListObjectVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListObjectVersions")
+}
+func (unimplementedS3) ListObjectVersionsPages(_ *s3sdk.ListObjectVersionsInput, _ func(*s3sdk.ListObjectVersionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceS3, "ListObjectVersions")
+}
+func (unimplementedS3) ListObjectVersionsPagesWithContext(_ context.Context, _ *s3sdk.ListObjectVersionsInput, _ func(*s3sdk.ListObjectVersionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceS3, "ListObjectVersions")
+}
+func (unimplementedS3) ListObjectVersionsRequest(_ *s3sdk.ListObjectVersionsInput) (*request.Request, *s3sdk.ListObjectVersionsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListObjectVersions"), nil
+}
+func (unimplementedS3) ListObjectVersionsWithContext(_ context.Context, _ *s3sdk.ListObjectVersionsInput, _ ...request.Option) (*s3sdk.ListObjectVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListObjectVersions")
+}
+func (unimplementedS3) ListObjects(_ *s3sdk.ListObjectsInput) (*s3sdk.ListObjectsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListObjects")
+}
+func (unimplementedS3) ListObjectsPages(_ *s3sdk.ListObjectsInput, _ func(*s3sdk.ListObjectsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceS3, "ListObjects")
+}
+func (unimplementedS3) ListObjectsPagesWithContext(_ context.Context, _ *s3sdk.ListObjectsInput, _ func(*s3sdk.ListObjectsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceS3, "ListObjects")
+}
+func (unimplementedS3) ListObjectsRequest(_ *s3sdk.ListObjectsInput) (*request.Request, *s3sdk.ListObjectsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListObjects"), nil
+}
+func (unimplementedS3) ListObjectsV2(_ *s3sdk.ListObjectsV2Input) (*s3sdk.ListObjectsV2Output, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListObjectsV2")
+}
+func (unimplementedS3) ListObjectsV2Pages(_ *s3sdk.ListObjectsV2Input, _ func(*s3sdk.ListObjectsV2Output, bool) bool) error {
+	return newNotImplementedError(ServiceS3, "ListObjectsV2")
+}
+func (unimplementedS3) ListObjectsV2PagesWithContext(_ context.Context, _ *s3sdk.ListObjectsV2Input, _ func(*s3sdk.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceS3, "ListObjectsV2")
+}
+func (unimplementedS3) ListObjectsV2Request(_ *s3sdk.ListObjectsV2Input) (*request.Request, *s3sdk.ListObjectsV2Output) {
+	return newNotImplementedRequest(ServiceS3, "ListObjectsV2"), nil
+}
+func (unimplementedS3) ListObjectsV2WithContext(_ context.Context, _ *s3sdk.ListObjectsV2Input, _ ...request.Option) (*s3sdk.ListObjectsV2Output, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListObjectsV2")
+}
+func (unimplementedS3) ListObjectsWithContext(_ context.Context, _ *s3sdk.ListObjectsInput, _ ...request.Option) (*s3sdk.ListObjectsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListObjects")
+}
+func (unimplementedS3) ListParts(_ *s3sdk.ListPartsInput) (*s3sdk.ListPartsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListParts")
+}
+func (unimplementedS3) ListPartsPages(_ *s3sdk.ListPartsInput, _ func(*s3sdk.ListPartsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceS3, "ListParts")
+}
+func (unimplementedS3) ListPartsPagesWithContext(_ context.Context, _ *s3sdk.ListPartsInput, _ func(*s3sdk.ListPartsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceS3, "ListParts")
+}
+func (unimplementedS3) ListPartsRequest(_ *s3sdk.ListPartsInput) (*request.Request, *s3sdk.ListPartsOutput) {
+	return newNotImplementedRequest(ServiceS3, "ListParts"), nil
+}
+func (unimplementedS3) ListPartsWithContext(_ context.Context, _ *s3sdk.ListPartsInput, _ ...request.Option) (*s3sdk.ListPartsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "ListParts")
+}
+func (unimplementedS3) PutBucketAccelerateConfiguration(_ *s3sdk.PutBucketAccelerateConfigurationInput) (*s3sdk.PutBucketAccelerateConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketAccelerateConfiguration")
+}
+func (unimplementedS3) PutBucketAccelerateConfigurationRequest(_ *s3sdk.PutBucketAccelerateConfigurationInput) (*request.Request, *s3sdk.PutBucketAccelerateConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketAccelerateConfiguration"), nil
+}
+func (unimplementedS3) PutBucketAccelerateConfigurationWithContext(_ context.Context, _ *s3sdk.PutBucketAccelerateConfigurationInput, _ ...request.Option) (*s3sdk.PutBucketAccelerateConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketAccelerateConfiguration")
+}
+func (unimplementedS3) PutBucketAcl(_ *s3sdk.PutBucketAclInput) (*s3sdk.PutBucketAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketAcl")
+}
+func (unimplementedS3) PutBucketAclRequest(_ *s3sdk.PutBucketAclInput) (*request.Request, *s3sdk.PutBucketAclOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketAcl"), nil
+}
+func (unimplementedS3) PutBucketAclWithContext(_ context.Context, _ *s3sdk.PutBucketAclInput, _ ...request.Option) (*s3sdk.PutBucketAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketAcl")
+}
+func (unimplementedS3) PutBucketAnalyticsConfiguration(_ *s3sdk.PutBucketAnalyticsConfigurationInput) (*s3sdk.PutBucketAnalyticsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketAnalyticsConfiguration")
+}
+func (unimplementedS3) PutBucketAnalyticsConfigurationRequest(_ *s3sdk.PutBucketAnalyticsConfigurationInput) (*request.Request, *s3sdk.PutBucketAnalyticsConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketAnalyticsConfiguration"), nil
+}
+func (unimplementedS3) PutBucketAnalyticsConfigurationWithContext(_ context.Context, _ *s3sdk.PutBucketAnalyticsConfigurationInput, _ ...request.Option) (*s3sdk.PutBucketAnalyticsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketAnalyticsConfiguration")
+}
+func (unimplementedS3) PutBucketCors(_ *s3sdk.PutBucketCorsInput) (*s3sdk.PutBucketCorsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketCors")
+}
+func (unimplementedS3) PutBucketCorsRequest(_ *s3sdk.PutBucketCorsInput) (*request.Request, *s3sdk.PutBucketCorsOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketCors"), nil
+}
+func (unimplementedS3) PutBucketCorsWithContext(_ context.Context, _ *s3sdk.PutBucketCorsInput, _ ...request.Option) (*s3sdk.PutBucketCorsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketCors")
+}
+func (unimplementedS3) PutBucketEncryption(_ *s3sdk.PutBucketEncryptionInput) (*s3sdk.PutBucketEncryptionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketEncryption")
+}
+func (unimplementedS3) PutBucketEncryptionRequest(_ *s3sdk.PutBucketEncryptionInput) (*request.Request, *s3sdk.PutBucketEncryptionOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketEncryption"), nil
+}
+func (unimplementedS3) PutBucketEncryptionWithContext(_ context.Context, _ *s3sdk.PutBucketEncryptionInput, _ ...request.Option) (*s3sdk.PutBucketEncryptionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketEncryption")
+}
+func (unimplementedS3) PutBucketIntelligentTieringConfiguration(_ *s3sdk.PutBucketIntelligentTieringConfigurationInput) (*s3sdk.PutBucketIntelligentTieringConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketIntelligentTieringConfiguration")
+}
+func (unimplementedS3) PutBucketIntelligentTieringConfigurationRequest(_ *s3sdk.PutBucketIntelligentTieringConfigurationInput) (*request.Request, *s3sdk.PutBucketIntelligentTieringConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketIntelligentTieringConfiguration"), nil
+}
+func (unimplementedS3) PutBucketIntelligentTieringConfigurationWithContext(_ context.Context, _ *s3sdk.PutBucketIntelligentTieringConfigurationInput, _ ...request.Option) (*s3sdk.PutBucketIntelligentTieringConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketIntelligentTieringConfiguration")
+}
+func (unimplementedS3) PutBucketInventoryConfiguration(_ *s3sdk.PutBucketInventoryConfigurationInput) (*s3sdk.PutBucketInventoryConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketInventoryConfiguration")
+}
+func (unimplementedS3) PutBucketInventoryConfigurationRequest(_ *s3sdk.PutBucketInventoryConfigurationInput) (*request.Request, *s3sdk.PutBucketInventoryConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketInventoryConfiguration"), nil
+}
+func (unimplementedS3) PutBucketInventoryConfigurationWithContext(_ context.Context, _ *s3sdk.PutBucketInventoryConfigurationInput, _ ...request.Option) (*s3sdk.PutBucketInventoryConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketInventoryConfiguration")
+}
+func (unimplementedS3) PutBucketLifecycle(_ *s3sdk.PutBucketLifecycleInput) (*s3sdk.PutBucketLifecycleOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketLifecycle")
+}
+func (unimplementedS3) PutBucketLifecycleConfiguration(_ *s3sdk.PutBucketLifecycleConfigurationInput) (*s3sdk.PutBucketLifecycleConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketLifecycleConfiguration")
+}
+func (unimplementedS3) PutBucketLifecycleConfigurationRequest(_ *s3sdk.PutBucketLifecycleConfigurationInput) (*request.Request, *s3sdk.PutBucketLifecycleConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketLifecycleConfiguration"), nil
+}
+func (unimplementedS3) PutBucketLifecycleConfigurationWithContext(_ context.Context, _ *s3sdk.PutBucketLifecycleConfigurationInput, _ ...request.Option) (*s3sdk.PutBucketLifecycleConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketLifecycleConfiguration")
+}
+func (unimplementedS3) PutBucketLifecycleRequest(_ *s3sdk.PutBucketLifecycleInput) (*request.Request, *s3sdk.PutBucketLifecycleOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketLifecycle"), nil
+}
+func (unimplementedS3) PutBucketLifecycleWithContext(_ context.Context, _ *s3sdk.PutBucketLifecycleInput, _ ...request.Option) (*s3sdk.PutBucketLifecycleOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketLifecycle")
+}
+func (unimplementedS3) PutBucketLogging(_ *s3sdk.PutBucketLoggingInput) (*s3sdk.PutBucketLoggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketLogging")
+}
+func (unimplementedS3) PutBucketLoggingRequest(_ *s3sdk.PutBucketLoggingInput) (*request.Request, *s3sdk.PutBucketLoggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketLogging"), nil
+}
+func (unimplementedS3) PutBucketLoggingWithContext(_ context.Context, _ *s3sdk.PutBucketLoggingInput, _ ...request.Option) (*s3sdk.PutBucketLoggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketLogging")
+}
+func (unimplementedS3) PutBucketMetricsConfiguration(_ *s3sdk.PutBucketMetricsConfigurationInput) (*s3sdk.PutBucketMetricsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketMetricsConfiguration")
+}
+func (unimplementedS3) PutBucketMetricsConfigurationRequest(_ *s3sdk.PutBucketMetricsConfigurationInput) (*request.Request, *s3sdk.PutBucketMetricsConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketMetricsConfiguration"), nil
+}
+func (unimplementedS3) PutBucketMetricsConfigurationWithContext(_ context.Context, _ *s3sdk.PutBucketMetricsConfigurationInput, _ ...request.Option) (*s3sdk.PutBucketMetricsConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketMetricsConfiguration")
+}
+func (unimplementedS3) PutBucketNotification(_ *s3sdk.PutBucketNotificationInput) (*s3sdk.PutBucketNotificationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketNotification")
+}
+func (unimplementedS3) PutBucketNotificationConfiguration(_ *s3sdk.PutBucketNotificationConfigurationInput) (*s3sdk.PutBucketNotificationConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketNotificationConfiguration")
+}
+func (unimplementedS3) PutBucketNotificationConfigurationRequest(_ *s3sdk.PutBucketNotificationConfigurationInput) (*request.Request, *s3sdk.PutBucketNotificationConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketNotificationConfiguration"), nil
+}
+func (unimplementedS3) PutBucketNotificationConfigurationWithContext(_ context.Context, _ *s3sdk.PutBucketNotificationConfigurationInput, _ ...request.Option) (*s3sdk.PutBucketNotificationConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketNotificationConfiguration")
+}
+func (unimplementedS3) PutBucketNotificationRequest(_ *s3sdk.PutBucketNotificationInput) (*request.Request, *s3sdk.PutBucketNotificationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketNotification"), nil
+}
+func (unimplementedS3) PutBucketNotificationWithContext(_ context.Context, _ *s3sdk.PutBucketNotificationInput, _ ...request.Option) (*s3sdk.PutBucketNotificationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketNotification")
+}
+func (unimplementedS3) PutBucketOwnershipControls(_ *s3sdk.PutBucketOwnershipControlsInput) (*s3sdk.PutBucketOwnershipControlsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketOwnershipControls")
+}
+func (unimplementedS3) PutBucketOwnershipControlsRequest(_ *s3sdk.PutBucketOwnershipControlsInput) (*request.Request, *s3sdk.PutBucketOwnershipControlsOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketOwnershipControls"), nil
+}
+func (unimplementedS3) PutBucketOwnershipControlsWithContext(_ context.Context, _ *s3sdk.PutBucketOwnershipControlsInput, _ ...request.Option) (*s3sdk.PutBucketOwnershipControlsOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketOwnershipControls")
+}
+func (unimplementedS3) PutBucketPolicy(_ *s3sdk.PutBucketPolicyInput) (*s3sdk.PutBucketPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketPolicy")
+}
+func (unimplementedS3) PutBucketPolicyRequest(_ *s3sdk.PutBucketPolicyInput) (*request.Request, *s3sdk.PutBucketPolicyOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketPolicy"), nil
+}
+func (unimplementedS3) PutBucketPolicyWithContext(_ context.Context, _ *s3sdk.PutBucketPolicyInput, _ ...request.Option) (*s3sdk.PutBucketPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketPolicy")
+}
+func (unimplementedS3) PutBucketReplication(_ *s3sdk.PutBucketReplicationInput) (*s3sdk.PutBucketReplicationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketReplication")
+}
+func (unimplementedS3) PutBucketReplicationRequest(_ *s3sdk.PutBucketReplicationInput) (*request.Request, *s3sdk.PutBucketReplicationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketReplication"), nil
+}
+func (unimplementedS3) PutBucketReplicationWithContext(_ context.Context, _ *s3sdk.PutBucketReplicationInput, _ ...request.Option) (*s3sdk.PutBucketReplicationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketReplication")
+}
+func (unimplementedS3) PutBucketRequestPayment(_ *s3sdk.PutBucketRequestPaymentInput) (*s3sdk.PutBucketRequestPaymentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketRequestPayment")
+}
+func (unimplementedS3) PutBucketRequestPaymentRequest(_ *s3sdk.PutBucketRequestPaymentInput) (*request.Request, *s3sdk.PutBucketRequestPaymentOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketRequestPayment"), nil
+}
+func (unimplementedS3) PutBucketRequestPaymentWithContext(_ context.Context, _ *s3sdk.PutBucketRequestPaymentInput, _ ...request.Option) (*s3sdk.PutBucketRequestPaymentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketRequestPayment")
+}
+func (unimplementedS3) PutBucketTagging(_ *s3sdk.PutBucketTaggingInput) (*s3sdk.PutBucketTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketTagging")
+}
+func (unimplementedS3) PutBucketTaggingRequest(_ *s3sdk.PutBucketTaggingInput) (*request.Request, *s3sdk.PutBucketTaggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketTagging"), nil
+}
+func (unimplementedS3) PutBucketTaggingWithContext(_ context.Context, _ *s3sdk.PutBucketTaggingInput, _ ...request.Option) (*s3sdk.PutBucketTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketTagging")
+}
+func (unimplementedS3) PutBucketVersioning(_ *s3sdk.PutBucketVersioningInput) (*s3sdk.PutBucketVersioningOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketVersioning")
+}
+func (unimplementedS3) PutBucketVersioningRequest(_ *s3sdk.PutBucketVersioningInput) (*request.Request, *s3sdk.PutBucketVersioningOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketVersioning"), nil
+}
+func (unimplementedS3) PutBucketVersioningWithContext(_ context.Context, _ *s3sdk.PutBucketVersioningInput, _ ...request.Option) (*s3sdk.PutBucketVersioningOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketVersioning")
+}
+func (unimplementedS3) PutBucketWebsite(_ *s3sdk.PutBucketWebsiteInput) (*s3sdk.PutBucketWebsiteOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketWebsite")
+}
+func (unimplementedS3) PutBucketWebsiteRequest(_ *s3sdk.PutBucketWebsiteInput) (*request.Request, *s3sdk.PutBucketWebsiteOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutBucketWebsite"), nil
+}
+func (unimplementedS3) PutBucketWebsiteWithContext(_ context.Context, _ *s3sdk.PutBucketWebsiteInput, _ ...request.Option) (*s3sdk.PutBucketWebsiteOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutBucketWebsite")
+}
+func (unimplementedS3) PutObject(_ *s3sdk.PutObjectInput) (*s3sdk.PutObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObject")
+}
+func (unimplementedS3) PutObjectAcl(_ *s3sdk.PutObjectAclInput) (*s3sdk.PutObjectAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectAcl")
+}
+func (unimplementedS3) PutObjectAclRequest(_ *s3sdk.PutObjectAclInput) (*request.Request, *s3sdk.PutObjectAclOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutObjectAcl"), nil
+}
+func (unimplementedS3) PutObjectAclWithContext(_ context.Context, _ *s3sdk.PutObjectAclInput, _ ...request.Option) (*s3sdk.PutObjectAclOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectAcl")
+}
+func (unimplementedS3) PutObjectLegalHold(_ *s3sdk.PutObjectLegalHoldInput) (*s3sdk.PutObjectLegalHoldOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectLegalHold")
+}
+func (unimplementedS3) PutObjectLegalHoldRequest(_ *s3sdk.PutObjectLegalHoldInput) (*request.Request, *s3sdk.PutObjectLegalHoldOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutObjectLegalHold"), nil
+}
+func (unimplementedS3) PutObjectLegalHoldWithContext(_ context.Context, _ *s3sdk.PutObjectLegalHoldInput, _ ...request.Option) (*s3sdk.PutObjectLegalHoldOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectLegalHold")
+}
+func (unimplementedS3) PutObjectLockConfiguration(_ *s3sdk.PutObjectLockConfigurationInput) (*s3sdk.PutObjectLockConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectLockConfiguration")
+}
+func (unimplementedS3) PutObjectLockConfigurationRequest(_ *s3sdk.PutObjectLockConfigurationInput) (*request.Request, *s3sdk.PutObjectLockConfigurationOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutObjectLockConfiguration"), nil
+}
+func (unimplementedS3) PutObjectLockConfigurationWithContext(_ context.Context, _ *s3sdk.PutObjectLockConfigurationInput, _ ...request.Option) (*s3sdk.PutObjectLockConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectLockConfiguration")
+}
+func (unimplementedS3) PutObjectRequest(_ *s3sdk.PutObjectInput) (*request.Request, *s3sdk.PutObjectOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutObject"), nil
+}
+func (unimplementedS3) PutObjectRetention(_ *s3sdk.PutObjectRetentionInput) (*s3sdk.PutObjectRetentionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectRetention")
+}
+func (unimplementedS3) PutObjectRetentionRequest(_ *s3sdk.PutObjectRetentionInput) (*request.Request, *s3sdk.PutObjectRetentionOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutObjectRetention"), nil
+}
+func (unimplementedS3) PutObjectRetentionWithContext(_ context.Context, _ *s3sdk.PutObjectRetentionInput, _ ...request.Option) (*s3sdk.PutObjectRetentionOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectRetention")
+}
+func (unimplementedS3) PutObjectTagging(_ *s3sdk.PutObjectTaggingInput) (*s3sdk.PutObjectTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectTagging")
+}
+func (unimplementedS3) PutObjectTaggingRequest(_ *s3sdk.PutObjectTaggingInput) (*request.Request, *s3sdk.PutObjectTaggingOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutObjectTagging"), nil
+}
+func (unimplementedS3) PutObjectTaggingWithContext(_ context.Context, _ *s3sdk.PutObjectTaggingInput, _ ...request.Option) (*s3sdk.PutObjectTaggingOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObjectTagging")
+}
+func (unimplementedS3) PutObjectWithContext(_ context.Context, _ *s3sdk.PutObjectInput, _ ...request.Option) (*s3sdk.PutObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutObject")
+}
+func (unimplementedS3) PutPublicAccessBlock(_ *s3sdk.PutPublicAccessBlockInput) (*s3sdk.PutPublicAccessBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutPublicAccessBlock")
+}
+func (unimplementedS3) PutPublicAccessBlockRequest(_ *s3sdk.PutPublicAccessBlockInput) (*request.Request, *s3sdk.PutPublicAccessBlockOutput) {
+	return newNotImplementedRequest(ServiceS3, "PutPublicAccessBlock"), nil
+}
+func (unimplementedS3) PutPublicAccessBlockWithContext(_ context.Context, _ *s3sdk.PutPublicAccessBlockInput, _ ...request.Option) (*s3sdk.PutPublicAccessBlockOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "PutPublicAccessBlock")
+}
+func (unimplementedS3) RestoreObject(_ *s3sdk.RestoreObjectInput) (*s3sdk.RestoreObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "RestoreObject")
+}
+func (unimplementedS3) RestoreObjectRequest(_ *s3sdk.RestoreObjectInput) (*request.Request, *s3sdk.RestoreObjectOutput) {
+	return newNotImplementedRequest(ServiceS3, "RestoreObject"), nil
+}
+func (unimplementedS3) RestoreObjectWithContext(_ context.Context, _ *s3sdk.RestoreObjectInput, _ ...request.Option) (*s3sdk.RestoreObjectOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "RestoreObject")
+}
+func (unimplementedS3) SelectObjectContent(_ *s3sdk.SelectObjectContentInput) (*s3sdk.SelectObjectContentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "SelectObjectContent")
+}
+func (unimplementedS3) SelectObjectContentRequest(_ *s3sdk.SelectObjectContentInput) (*request.Request, *s3sdk.SelectObjectContentOutput) {
+	return newNotImplementedRequest(ServiceS3, "SelectObjectContent"), nil
+}
+func (unimplementedS3) SelectObjectContentWithContext(_ context.Context, _ *s3sdk.SelectObjectContentInput, _ ...request.Option) (*s3sdk.SelectObjectContentOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "SelectObjectContent")
+}
+func (unimplementedS3) UploadPart(_ *s3sdk.UploadPartInput) (*s3sdk.UploadPartOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "UploadPart")
+}
+func (unimplementedS3) UploadPartCopy(_ *s3sdk.UploadPartCopyInput) (*s3sdk.UploadPartCopyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "UploadPartCopy")
+}
+func (unimplementedS3) UploadPartCopyRequest(_ *s3sdk.UploadPartCopyInput) (*request.Request, *s3sdk.UploadPartCopyOutput) {
+	return newNotImplementedRequest(ServiceS3, "UploadPartCopy"), nil
+}
+func (unimplementedS3) UploadPartCopyWithContext(_ context.Context, _ *s3sdk.UploadPartCopyInput, _ ...request.Option) (*s3sdk.UploadPartCopyOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "UploadPartCopy")
+}
+func (unimplementedS3) UploadPartRequest(_ *s3sdk.UploadPartInput) (*request.Request, *s3sdk.UploadPartOutput) {
+	return newNotImplementedRequest(ServiceS3, "UploadPart"), nil
+}
+func (unimplementedS3) UploadPartWithContext(_ context.Context, _ *s3sdk.UploadPartInput, _ ...request.Option) (*s3sdk.UploadPartOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "UploadPart")
+}
+func (unimplementedS3) WaitUntilBucketExists(_ *s3sdk.HeadBucketInput) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilBucketExists")
+}
+func (unimplementedS3) WaitUntilBucketExistsWithContext(_ context.Context, _ *s3sdk.HeadBucketInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilBucketExists")
+}
+func (unimplementedS3) WaitUntilBucketNotExists(_ *s3sdk.HeadBucketInput) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilBucketNotExists")
+}
+func (unimplementedS3) WaitUntilBucketNotExistsWithContext(_ context.Context, _ *s3sdk.HeadBucketInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilBucketNotExists")
+}
+func (unimplementedS3) WaitUntilObjectExists(_ *s3sdk.HeadObjectInput) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilObjectExists")
+}
+func (unimplementedS3) WaitUntilObjectExistsWithContext(_ context.Context, _ *s3sdk.HeadObjectInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilObjectExists")
+}
+func (unimplementedS3) WaitUntilObjectNotExists(_ *s3sdk.HeadObjectInput) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilObjectNotExists")
+}
+func (unimplementedS3) WaitUntilObjectNotExistsWithContext(_ context.Context, _ *s3sdk.HeadObjectInput, _ ...request.WaiterOption) error {
+	return newNotImplementedError(ServiceS3, "WaitUntilObjectNotExists")
+}
+func (unimplementedS3) WriteGetObjectResponse(_ *s3sdk.WriteGetObjectResponseInput) (*s3sdk.WriteGetObjectResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "WriteGetObjectResponse")
+}
+func (unimplementedS3) WriteGetObjectResponseRequest(_ *s3sdk.WriteGetObjectResponseInput) (*request.Request, *s3sdk.WriteGetObjectResponseOutput) {
+	return newNotImplementedRequest(ServiceS3, "WriteGetObjectResponse"), nil
+}
+func (unimplementedS3) WriteGetObjectResponseWithContext(_ context.Context, _ *s3sdk.WriteGetObjectResponseInput, _ ...request.Option) (*s3sdk.WriteGetObjectResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceS3, "WriteGetObjectResponse")
+}
+
+// unimplementedShield implements shieldiface.ShieldAPI with APIs that return NotImplemented error.
+type unimplementedShield struct{}
+
+func (unimplementedShield) AssociateDRTLogBucket(_ *shieldsdk.AssociateDRTLogBucketInput) (*shieldsdk.AssociateDRTLogBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateDRTLogBucket")
+}
+func (unimplementedShield) AssociateDRTLogBucketRequest(_ *shieldsdk.AssociateDRTLogBucketInput) (*request.Request, *shieldsdk.AssociateDRTLogBucketOutput) {
+	return newNotImplementedRequest(ServiceShield, "AssociateDRTLogBucket"), nil
+}
+func (unimplementedShield) AssociateDRTLogBucketWithContext(_ context.Context, _ *shieldsdk.AssociateDRTLogBucketInput, _ ...request.Option) (*shieldsdk.AssociateDRTLogBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateDRTLogBucket")
+}
+func (unimplementedShield) AssociateDRTRole(_ *shieldsdk.AssociateDRTRoleInput) (*shieldsdk.AssociateDRTRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateDRTRole")
+}
+func (unimplementedShield) AssociateDRTRoleRequest(_ *shieldsdk.AssociateDRTRoleInput) (*request.Request, *shieldsdk.AssociateDRTRoleOutput) {
+	return newNotImplementedRequest(ServiceShield, "AssociateDRTRole"), nil
+}
+func (unimplementedShield) AssociateDRTRoleWithContext(_ context.Context, _ *shieldsdk.AssociateDRTRoleInput, _ ...request.Option) (*shieldsdk.AssociateDRTRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateDRTRole")
+}
+func (unimplementedShield) AssociateHealthCheck(_ *shieldsdk.AssociateHealthCheckInput) (*shieldsdk.AssociateHealthCheckOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateHealthCheck")
+}
+func (unimplementedShield) AssociateHealthCheckRequest(_ *shieldsdk.AssociateHealthCheckInput) (*request.Request, *shieldsdk.AssociateHealthCheckOutput) {
+	return newNotImplementedRequest(ServiceShield, "AssociateHealthCheck"), nil
+}
+func (unimplementedShield) AssociateHealthCheckWithContext(_ context.Context, _ *shieldsdk.AssociateHealthCheckInput, _ ...request.Option) (*shieldsdk.AssociateHealthCheckOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateHealthCheck")
+}
+func (unimplementedShield) AssociateProactiveEngagementDetails(_ *shieldsdk.AssociateProactiveEngagementDetailsInput) (*shieldsdk.AssociateProactiveEngagementDetailsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateProactiveEngagementDetails")
+}
+func (unimplementedShield) AssociateProactiveEngagementDetailsRequest(_ *shieldsdk.AssociateProactiveEngagementDetailsInput) (*request.Request, *shieldsdk.AssociateProactiveEngagementDetailsOutput) {
+	return newNotImplementedRequest(ServiceShield, "AssociateProactiveEngagementDetails"), nil
+}
+func (unimplementedShield) AssociateProactiveEngagementDetailsWithContext(_ context.Context, _ *shieldsdk.AssociateProactiveEngagementDetailsInput, _ ...request.Option) (*shieldsdk.AssociateProactiveEngagementDetailsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "AssociateProactiveEngagementDetails")
+}
+func (unimplementedShield) CreateProtection(_ *shieldsdk.CreateProtectionInput) (*shieldsdk.CreateProtectionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "CreateProtection")
+}
+func (unimplementedShield) CreateProtectionGroup(_ *shieldsdk.CreateProtectionGroupInput) (*shieldsdk.CreateProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "CreateProtectionGroup")
+}
+func (unimplementedShield) CreateProtectionGroupRequest(_ *shieldsdk.CreateProtectionGroupInput) (*request.Request, *shieldsdk.CreateProtectionGroupOutput) {
+	return newNotImplementedRequest(ServiceShield, "CreateProtectionGroup"), nil
+}
+func (unimplementedShield) CreateProtectionGroupWithContext(_ context.Context, _ *shieldsdk.CreateProtectionGroupInput, _ ...request.Option) (*shieldsdk.CreateProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "CreateProtectionGroup")
+}
+func (unimplementedShield) CreateProtectionRequest(_ *shieldsdk.CreateProtectionInput) (*request.Request, *shieldsdk.CreateProtectionOutput) {
+	return newNotImplementedRequest(ServiceShield, "CreateProtection"), nil
+}
+func (unimplementedShield) CreateProtectionWithContext(_ context.Context, _ *shieldsdk.CreateProtectionInput, _ ...request.Option) (*shieldsdk.CreateProtectionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "CreateProtection")
+}
+func (unimplementedShield) CreateSubscription(_ *shieldsdk.CreateSubscriptionInput) (*shieldsdk.CreateSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "CreateSubscription")
+}
+func (unimplementedShield) CreateSubscriptionRequest(_ *shieldsdk.CreateSubscriptionInput) (*request.Request, *shieldsdk.CreateSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceShield, "CreateSubscription"), nil
+}
+func (unimplementedShield) CreateSubscriptionWithContext(_ context.Context, _ *shieldsdk.CreateSubscriptionInput, _ ...request.Option) (*shieldsdk.CreateSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "CreateSubscription")
+}
+func (unimplementedShield) DeleteProtection(_ *shieldsdk.DeleteProtectionInput) (*shieldsdk.DeleteProtectionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DeleteProtection")
+}
+func (unimplementedShield) DeleteProtectionGroup(_ *shieldsdk.DeleteProtectionGroupInput) (*shieldsdk.DeleteProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DeleteProtectionGroup")
+}
+func (unimplementedShield) DeleteProtectionGroupRequest(_ *shieldsdk.DeleteProtectionGroupInput) (*request.Request, *shieldsdk.DeleteProtectionGroupOutput) {
+	return newNotImplementedRequest(ServiceShield, "DeleteProtectionGroup"), nil
+}
+func (unimplementedShield) DeleteProtectionGroupWithContext(_ context.Context, _ *shieldsdk.DeleteProtectionGroupInput, _ ...request.Option) (*shieldsdk.DeleteProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DeleteProtectionGroup")
+}
+func (unimplementedShield) DeleteProtectionRequest(_ *shieldsdk.DeleteProtectionInput) (*request.Request, *shieldsdk.DeleteProtectionOutput) {
+	return newNotImplementedRequest(ServiceShield, "DeleteProtection"), nil
+}
+func (unimplementedShield) DeleteProtectionWithContext(_ context.Context, _ *shieldsdk.DeleteProtectionInput, _ ...request.Option) (*shieldsdk.DeleteProtectionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DeleteProtection")
+}
+func (unimplementedShield) DeleteSubscription(_ *shieldsdk.DeleteSubscriptionInput) (*shieldsdk.DeleteSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DeleteSubscription")
+}
+func (unimplementedShield) DeleteSubscriptionRequest(_ *shieldsdk.DeleteSubscriptionInput) (*request.Request, *shieldsdk.DeleteSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceShield, "DeleteSubscription"), nil
+}
+func (unimplementedShield) DeleteSubscriptionWithContext(_ context.Context, _ *shieldsdk.DeleteSubscriptionInput, _ ...request.Option) (*shieldsdk.DeleteSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DeleteSubscription")
+}
+func (unimplementedShield) DescribeAttack(_ *shieldsdk.DescribeAttackInput) (*shieldsdk.DescribeAttackOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeAttack")
+}
+func (unimplementedShield) DescribeAttackRequest(_ *shieldsdk.DescribeAttackInput) (*request.Request, *shieldsdk.DescribeAttackOutput) {
+	return newNotImplementedRequest(ServiceShield, "DescribeAttack"), nil
+}
+func (unimplementedShield) DescribeAttackStatistics(_ *shieldsdk.DescribeAttackStatisticsInput) (*shieldsdk.DescribeAttackStatisticsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeAttackStatistics")
+}
+func (unimplementedShield) DescribeAttackStatisticsRequest(_ *shieldsdk.DescribeAttackStatisticsInput) (*request.Request, *shieldsdk.DescribeAttackStatisticsOutput) {
+	return newNotImplementedRequest(ServiceShield, "DescribeAttackStatistics"), nil
+}
+func (unimplementedShield) DescribeAttackStatisticsWithContext(_ context.Context, _ *shieldsdk.DescribeAttackStatisticsInput, _ ...request.Option) (*shieldsdk.DescribeAttackStatisticsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeAttackStatistics")
+}
+func (unimplementedShield) DescribeAttackWithContext(_ context.Context, _ *shieldsdk.DescribeAttackInput, _ ...request.Option) (*shieldsdk.DescribeAttackOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeAttack")
+}
+func (unimplementedShield) DescribeDRTAccess(_ *shieldsdk.DescribeDRTAccessInput) (*shieldsdk.DescribeDRTAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeDRTAccess")
+}
+func (unimplementedShield) DescribeDRTAccessRequest(_ *shieldsdk.DescribeDRTAccessInput) (*request.Request, *shieldsdk.DescribeDRTAccessOutput) {
+	return newNotImplementedRequest(ServiceShield, "DescribeDRTAccess"), nil
+}
+func (unimplementedShield) DescribeDRTAccessWithContext(_ context.Context, _ *shieldsdk.DescribeDRTAccessInput, _ ...request.Option) (*shieldsdk.DescribeDRTAccessOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeDRTAccess")
+}
+func (unimplementedShield) DescribeEmergencyContactSettings(_ *shieldsdk.DescribeEmergencyContactSettingsInput) (*shieldsdk.DescribeEmergencyContactSettingsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeEmergencyContactSettings")
+}
+func (unimplementedShield) DescribeEmergencyContactSettingsRequest(_ *shieldsdk.DescribeEmergencyContactSettingsInput) (*request.Request, *shieldsdk.DescribeEmergencyContactSettingsOutput) {
+	return newNotImplementedRequest(ServiceShield, "DescribeEmergencyContactSettings"), nil
+}
+func (unimplementedShield) DescribeEmergencyContactSettingsWithContext(_ context.Context, _ *shieldsdk.DescribeEmergencyContactSettingsInput, _ ...request.Option) (*shieldsdk.DescribeEmergencyContactSettingsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeEmergencyContactSettings")
+}
+func (unimplementedShield) DescribeProtection(_ *shieldsdk.DescribeProtectionInput) (*shieldsdk.DescribeProtectionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeProtection")
+}
+func (unimplementedShield) DescribeProtectionGroup(_ *shieldsdk.DescribeProtectionGroupInput) (*shieldsdk.DescribeProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeProtectionGroup")
+}
+func (unimplementedShield) DescribeProtectionGroupRequest(_ *shieldsdk.DescribeProtectionGroupInput) (*request.Request, *shieldsdk.DescribeProtectionGroupOutput) {
+	return newNotImplementedRequest(ServiceShield, "DescribeProtectionGroup"), nil
+}
+func (unimplementedShield) DescribeProtectionGroupWithContext(_ context.Context, _ *shieldsdk.DescribeProtectionGroupInput, _ ...request.Option) (*shieldsdk.DescribeProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeProtectionGroup")
+}
+func (unimplementedShield) DescribeProtectionRequest(_ *shieldsdk.DescribeProtectionInput) (*request.Request, *shieldsdk.DescribeProtectionOutput) {
+	return newNotImplementedRequest(ServiceShield, "DescribeProtection"), nil
+}
+func (unimplementedShield) DescribeProtectionWithContext(_ context.Context, _ *shieldsdk.DescribeProtectionInput, _ ...request.Option) (*shieldsdk.DescribeProtectionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeProtection")
+}
+func (unimplementedShield) DescribeSubscription(_ *shieldsdk.DescribeSubscriptionInput) (*shieldsdk.DescribeSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeSubscription")
+}
+func (unimplementedShield) DescribeSubscriptionRequest(_ *shieldsdk.DescribeSubscriptionInput) (*request.Request, *shieldsdk.DescribeSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceShield, "DescribeSubscription"), nil
+}
+func (unimplementedShield) DescribeSubscriptionWithContext(_ context.Context, _ *shieldsdk.DescribeSubscriptionInput, _ ...request.Option) (*shieldsdk.DescribeSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DescribeSubscription")
+}
+func (unimplementedShield) DisableApplicationLayerAutomaticResponse(_ *shieldsdk.DisableApplicationLayerAutomaticResponseInput) (*shieldsdk.DisableApplicationLayerAutomaticResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisableApplicationLayerAutomaticResponse")
+}
+func (unimplementedShield) DisableApplicationLayerAutomaticResponseRequest(_ *shieldsdk.DisableApplicationLayerAutomaticResponseInput) (*request.Request, *shieldsdk.DisableApplicationLayerAutomaticResponseOutput) {
+	return newNotImplementedRequest(ServiceShield, "DisableApplicationLayerAutomaticResponse"), nil
+}
+func (unimplementedShield) DisableApplicationLayerAutomaticResponseWithContext(_ context.Context, _ *shieldsdk.DisableApplicationLayerAutomaticResponseInput, _ ...request.Option) (*shieldsdk.DisableApplicationLayerAutomaticResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisableApplicationLayerAutomaticResponse")
+}
+func (unimplementedShield) DisableProactiveEngagement(_ *shieldsdk.DisableProactiveEngagementInput) (*shieldsdk.DisableProactiveEngagementOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisableProactiveEngagement")
+}
+func (unimplementedShield) DisableProactiveEngagementRequest(_ *shieldsdk.DisableProactiveEngagementInput) (*request.Request, *shieldsdk.DisableProactiveEngagementOutput) {
+	return newNotImplementedRequest(ServiceShield, "DisableProactiveEngagement"), nil
+}
+func (unimplementedShield) DisableProactiveEngagementWithContext(_ context.Context, _ *shieldsdk.DisableProactiveEngagementInput, _ ...request.Option) (*shieldsdk.DisableProactiveEngagementOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisableProactiveEngagement")
+}
+func (unimplementedShield) DisassociateDRTLogBucket(_ *shieldsdk.DisassociateDRTLogBucketInput) (*shieldsdk.DisassociateDRTLogBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisassociateDRTLogBucket")
+}
+func (unimplementedShield) DisassociateDRTLogBucketRequest(_ *shieldsdk.DisassociateDRTLogBucketInput) (*request.Request, *shieldsdk.DisassociateDRTLogBucketOutput) {
+	return newNotImplementedRequest(ServiceShield, "DisassociateDRTLogBucket"), nil
+}
+func (unimplementedShield) DisassociateDRTLogBucketWithContext(_ context.Context, _ *shieldsdk.DisassociateDRTLogBucketInput, _ ...request.Option) (*shieldsdk.DisassociateDRTLogBucketOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisassociateDRTLogBucket")
+}
+func (unimplementedShield) DisassociateDRTRole(_ *shieldsdk.DisassociateDRTRoleInput) (*shieldsdk.DisassociateDRTRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisassociateDRTRole")
+}
+func (unimplementedShield) DisassociateDRTRoleRequest(_ *shieldsdk.DisassociateDRTRoleInput) (*request.Request, *shieldsdk.DisassociateDRTRoleOutput) {
+	return newNotImplementedRequest(ServiceShield, "DisassociateDRTRole"), nil
+}
+func (unimplementedShield) DisassociateDRTRoleWithContext(_ context.Context, _ *shieldsdk.DisassociateDRTRoleInput, _ ...request.Option) (*shieldsdk.DisassociateDRTRoleOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisassociateDRTRole")
+}
+func (unimplementedShield) DisassociateHealthCheck(_ *shieldsdk.DisassociateHealthCheckInput) (*shieldsdk.DisassociateHealthCheckOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisassociateHealthCheck")
+}
+func (unimplementedShield) DisassociateHealthCheckRequest(_ *shieldsdk.DisassociateHealthCheckInput) (*request.Request, *shieldsdk.DisassociateHealthCheckOutput) {
+	return newNotImplementedRequest(ServiceShield, "DisassociateHealthCheck"), nil
+}
+func (unimplementedShield) DisassociateHealthCheckWithContext(_ context.Context, _ *shieldsdk.DisassociateHealthCheckInput, _ ...request.Option) (*shieldsdk.DisassociateHealthCheckOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "DisassociateHealthCheck")
+}
+func (unimplementedShield) EnableApplicationLayerAutomaticResponse(_ *shieldsdk.EnableApplicationLayerAutomaticResponseInput) (*shieldsdk.EnableApplicationLayerAutomaticResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "EnableApplicationLayerAutomaticResponse")
+}
+func (unimplementedShield) EnableApplicationLayerAutomaticResponseRequest(_ *shieldsdk.EnableApplicationLayerAutomaticResponseInput) (*request.Request, *shieldsdk.EnableApplicationLayerAutomaticResponseOutput) {
+	return newNotImplementedRequest(ServiceShield, "EnableApplicationLayerAutomaticResponse"), nil
+}
+func (unimplementedShield) EnableApplicationLayerAutomaticResponseWithContext(_ context.Context, _ *shieldsdk.EnableApplicationLayerAutomaticResponseInput, _ ...request.Option) (*shieldsdk.EnableApplicationLayerAutomaticResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "EnableApplicationLayerAutomaticResponse")
+}
+func (unimplementedShield) EnableProactiveEngagement(_ *shieldsdk.EnableProactiveEngagementInput) (*shieldsdk.EnableProactiveEngagementOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "EnableProactiveEngagement")
+}
+func (unimplementedShield) EnableProactiveEngagementRequest(_ *shieldsdk.EnableProactiveEngagementInput) (*request.Request, *shieldsdk.EnableProactiveEngagementOutput) {
+	return newNotImplementedRequest(ServiceShield, "EnableProactiveEngagement"), nil
+}
+func (unimplementedShield) EnableProactiveEngagementWithContext(_ context.Context, _ *shieldsdk.EnableProactiveEngagementInput, _ ...request.Option) (*shieldsdk.EnableProactiveEngagementOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "EnableProactiveEngagement")
+}
+func (unimplementedShield) GetSubscriptionState(_ *shieldsdk.GetSubscriptionStateInput) (*shieldsdk.GetSubscriptionStateOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "GetSubscriptionState")
+}
+func (unimplementedShield) GetSubscriptionStateRequest(_ *shieldsdk.GetSubscriptionStateInput) (*request.Request, *shieldsdk.GetSubscriptionStateOutput) {
+	return newNotImplementedRequest(ServiceShield, "GetSubscriptionState"), nil
+}
+func (unimplementedShield) GetSubscriptionStateWithContext(_ context.Context, _ *shieldsdk.GetSubscriptionStateInput, _ ...request.Option) (*shieldsdk.GetSubscriptionStateOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "GetSubscriptionState")
+}
+func (unimplementedShield) ListAttacks(_ *shieldsdk.ListAttacksInput) (*shieldsdk.ListAttacksOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListAttacks")
+}
+func (unimplementedShield) ListAttacksPages(_ *shieldsdk.ListAttacksInput, _ func(*shieldsdk.ListAttacksOutput, bool) bool) error {
+	return newNotImplementedError(ServiceShield, "ListAttacks")
+}
+func (unimplementedShield) ListAttacksPagesWithContext(_ context.Context, _ *shieldsdk.ListAttacksInput, _ func(*shieldsdk.ListAttacksOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceShield, "ListAttacks")
+}
+func (unimplementedShield) ListAttacksRequest(_ *shieldsdk.ListAttacksInput) (*request.Request, *shieldsdk.ListAttacksOutput) {
+	return newNotImplementedRequest(ServiceShield, "ListAttacks"), nil
+}
+func (unimplementedShield) ListAttacksWithContext(_ context.Context, _ *shieldsdk.ListAttacksInput, _ ...request.Option) (*shieldsdk.ListAttacksOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListAttacks")
+}
+func (unimplementedShield) ListProtectionGroups(_ *shieldsdk.ListProtectionGroupsInput) (*shieldsdk.ListProtectionGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListProtectionGroups")
+}
+func (unimplementedShield) ListProtectionGroupsPages(_ *shieldsdk.ListProtectionGroupsInput, _ func(*shieldsdk.ListProtectionGroupsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceShield, "ListProtectionGroups")
+}
+func (unimplementedShield) ListProtectionGroupsPagesWithContext(_ context.Context, _ *shieldsdk.ListProtectionGroupsInput, _ func(*shieldsdk.ListProtectionGroupsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceShield, "ListProtectionGroups")
+}
+func (unimplementedShield) ListProtectionGroupsRequest(_ *shieldsdk.ListProtectionGroupsInput) (*request.Request, *shieldsdk.ListProtectionGroupsOutput) {
+	return newNotImplementedRequest(ServiceShield, "ListProtectionGroups"), nil
+}
+func (unimplementedShield) ListProtectionGroupsWithContext(_ context.Context, _ *shieldsdk.ListProtectionGroupsInput, _ ...request.Option) (*shieldsdk.ListProtectionGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListProtectionGroups")
+}
+func (unimplementedShield) ListProtections(_ *shieldsdk.ListProtectionsInput) (*shieldsdk.ListProtectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListProtections")
+}
+func (unimplementedShield) ListProtectionsPages(_ *shieldsdk.ListProtectionsInput, _ func(*shieldsdk.ListProtectionsOutput, bool) bool) error {
+	return newNotImplementedError(ServiceShield, "ListProtections")
+}
+func (unimplementedShield) ListProtectionsPagesWithContext(_ context.Context, _ *shieldsdk.ListProtectionsInput, _ func(*shieldsdk.ListProtectionsOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceShield, "ListProtections")
+}
+func (unimplementedShield) ListProtectionsRequest(_ *shieldsdk.ListProtectionsInput) (*request.Request, *shieldsdk.ListProtectionsOutput) {
+	return newNotImplementedRequest(ServiceShield, "ListProtections"), nil
+}
+func (unimplementedShield) ListProtectionsWithContext(_ context.Context, _ *shieldsdk.ListProtectionsInput, _ ...request.Option) (*shieldsdk.ListProtectionsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListProtections")
+}
+func (unimplementedShield) ListResourcesInProtectionGroup(_ *shieldsdk.ListResourcesInProtectionGroupInput) (*shieldsdk.ListResourcesInProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListResourcesInProtectionGroup")
+}
+func (unimplementedShield) ListResourcesInProtectionGroupPages(_ *shieldsdk.ListResourcesInProtectionGroupInput, _ func(*shieldsdk.ListResourcesInProtectionGroupOutput, bool) bool) error {
+	return newNotImplementedError(ServiceShield, "ListResourcesInProtectionGroup")
+}
+func (unimplementedShield) ListResourcesInProtectionGroupPagesWithContext(_ context.Context, _ *shieldsdk.ListResourcesInProtectionGroupInput, _ func(*shieldsdk.ListResourcesInProtectionGroupOutput, bool) bool, _ ...request.Option) error {
+	return newNotImplementedError(ServiceShield, "ListResourcesInProtectionGroup")
+}
+func (unimplementedShield) ListResourcesInProtectionGroupRequest(_ *shieldsdk.ListResourcesInProtectionGroupInput) (*request.Request, *shieldsdk.ListResourcesInProtectionGroupOutput) {
+	return newNotImplementedRequest(ServiceShield, "ListResourcesInProtectionGroup"), nil
+}
+func (unimplementedShield) ListResourcesInProtectionGroupWithContext(_ context.Context, _ *shieldsdk.ListResourcesInProtectionGroupInput, _ ...request.Option) (*shieldsdk.ListResourcesInProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListResourcesInProtectionGroup")
+}
+func (unimplementedShield) ListTagsForResource(_ *shieldsdk.ListTagsForResourceInput) (*shieldsdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListTagsForResource")
+}
+func (unimplementedShield) ListTagsForResourceRequest(_ *shieldsdk.ListTagsForResourceInput) (*request.Request, *shieldsdk.ListTagsForResourceOutput) {
+	return newNotImplementedRequest(ServiceShield, "ListTagsForResource"), nil
+}
+func (unimplementedShield) ListTagsForResourceWithContext(_ context.Context, _ *shieldsdk.ListTagsForResourceInput, _ ...request.Option) (*shieldsdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "ListTagsForResource")
+}
+func (unimplementedShield) TagResource(_ *shieldsdk.TagResourceInput) (*shieldsdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "TagResource")
+}
+func (unimplementedShield) TagResourceRequest(_ *shieldsdk.TagResourceInput) (*request.Request, *shieldsdk.TagResourceOutput) {
+	return newNotImplementedRequest(ServiceShield, "TagResource"), nil
+}
+func (unimplementedShield) TagResourceWithContext(_ context.Context, _ *shieldsdk.TagResourceInput, _ ...request.Option) (*shieldsdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "TagResource")
+}
+func (unimplementedShield) UntagResource(_ *shieldsdk.UntagResourceInput) (*shieldsdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UntagResource")
+}
+func (unimplementedShield) UntagResourceRequest(_ *shieldsdk.UntagResourceInput) (*request.Request, *shieldsdk.UntagResourceOutput) {
+	return newNotImplementedRequest(ServiceShield, "UntagResource"), nil
+}
+func (unimplementedShield) UntagResourceWithContext(_ context.Context, _ *shieldsdk.UntagResourceInput, _ ...request.Option) (*shieldsdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UntagResource")
+}
+func (unimplementedShield) UpdateApplicationLayerAutomaticResponse(_ *shieldsdk.UpdateApplicationLayerAutomaticResponseInput) (*shieldsdk.UpdateApplicationLayerAutomaticResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateApplicationLayerAutomaticResponse")
+}
+func (unimplementedShield) UpdateApplicationLayerAutomaticResponseRequest(_ *shieldsdk.UpdateApplicationLayerAutomaticResponseInput) (*request.Request, *shieldsdk.UpdateApplicationLayerAutomaticResponseOutput) {
+	return newNotImplementedRequest(ServiceShield, "UpdateApplicationLayerAutomaticResponse"), nil
+}
+func (unimplementedShield) UpdateApplicationLayerAutomaticResponseWithContext(_ context.Context, _ *shieldsdk.UpdateApplicationLayerAutomaticResponseInput, _ ...request.Option) (*shieldsdk.UpdateApplicationLayerAutomaticResponseOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateApplicationLayerAutomaticResponse")
+}
+func (unimplementedShield) UpdateEmergencyContactSettings(_ *shieldsdk.UpdateEmergencyContactSettingsInput) (*shieldsdk.UpdateEmergencyContactSettingsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateEmergencyContactSettings")
+}
+func (unimplementedShield) UpdateEmergencyContactSettingsRequest(_ *shieldsdk.UpdateEmergencyContactSettingsInput) (*request.Request, *shieldsdk.UpdateEmergencyContactSettingsOutput) {
+	return newNotImplementedRequest(ServiceShield, "UpdateEmergencyContactSettings"), nil
+}
+func (unimplementedShield) UpdateEmergencyContactSettingsWithContext(_ context.Context, _ *shieldsdk.UpdateEmergencyContactSettingsInput, _ ...request.Option) (*shieldsdk.UpdateEmergencyContactSettingsOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateEmergencyContactSettings")
+}
+func (unimplementedShield) UpdateProtectionGroup(_ *shieldsdk.UpdateProtectionGroupInput) (*shieldsdk.UpdateProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateProtectionGroup")
+}
+func (unimplementedShield) UpdateProtectionGroupRequest(_ *shieldsdk.UpdateProtectionGroupInput) (*request.Request, *shieldsdk.UpdateProtectionGroupOutput) {
+	return newNotImplementedRequest(ServiceShield, "UpdateProtectionGroup"), nil
+}
+func (unimplementedShield) UpdateProtectionGroupWithContext(_ context.Context, _ *shieldsdk.UpdateProtectionGroupInput, _ ...request.Option) (*shieldsdk.UpdateProtectionGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateProtectionGroup")
+}
+func (unimplementedShield) UpdateSubscription(_ *shieldsdk.UpdateSubscriptionInput) (*shieldsdk.UpdateSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateSubscription")
+}
+func (unimplementedShield) UpdateSubscriptionRequest(_ *shieldsdk.UpdateSubscriptionInput) (*request.Request, *shieldsdk.UpdateSubscriptionOutput) {
+	return newNotImplementedRequest(ServiceShield, "UpdateSubscription"), nil
+}
+func (unimplementedShield) UpdateSubscriptionWithContext(_ context.Context, _ *shieldsdk.UpdateSubscriptionInput, _ ...request.Option) (*shieldsdk.UpdateSubscriptionOutput, error) {
+	return nil, newNotImplementedError(ServiceShield, "UpdateSubscription")
+}
+
+// unimplementedWAFRegional implements wafregionaliface.WAFRegionalAPI with APIs that return NotImplemented error.
+type unimplementedWAFRegional struct{}
+
+func (unimplementedWAFRegional) AssociateWebACL(_ *wafregionalsdk.AssociateWebACLInput) (*wafregionalsdk.AssociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "AssociateWebACL")
+}
+func (unimplementedWAFRegional) AssociateWebACLRequest(_ *wafregionalsdk.AssociateWebACLInput) (*request.Request, *wafregionalsdk.AssociateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "AssociateWebACL"), nil
+}
+func (unimplementedWAFRegional) AssociateWebACLWithContext(_ context.Context, _ *wafregionalsdk.AssociateWebACLInput, _ ...request.Option) (*wafregionalsdk.AssociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "AssociateWebACL")
+}
+func (unimplementedWAFRegional) CreateByteMatchSet(_ *wafsdk.CreateByteMatchSetInput) (*wafsdk.CreateByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateByteMatchSet")
+}
+func (unimplementedWAFRegional) CreateByteMatchSetRequest(_ *wafsdk.CreateByteMatchSetInput) (*request.Request, *wafsdk.CreateByteMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateByteMatchSet"), nil
+}
+func (unimplementedWAFRegional) CreateByteMatchSetWithContext(_ context.Context, _ *wafsdk.CreateByteMatchSetInput, _ ...request.Option) (*wafsdk.CreateByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateByteMatchSet")
+}
+func (unimplementedWAFRegional) CreateGeoMatchSet(_ *wafsdk.CreateGeoMatchSetInput) (*wafsdk.CreateGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateGeoMatchSet")
+}
+func (unimplementedWAFRegional) CreateGeoMatchSetRequest(_ *wafsdk.CreateGeoMatchSetInput) (*request.Request, *wafsdk.CreateGeoMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateGeoMatchSet"), nil
+}
+func (unimplementedWAFRegional) CreateGeoMatchSetWithContext(_ context.Context, _ *wafsdk.CreateGeoMatchSetInput, _ ...request.Option) (*wafsdk.CreateGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateGeoMatchSet")
+}
+func (unimplementedWAFRegional) CreateIPSet(_ *wafsdk.CreateIPSetInput) (*wafsdk.CreateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateIPSet")
+}
+func (unimplementedWAFRegional) CreateIPSetRequest(_ *wafsdk.CreateIPSetInput) (*request.Request, *wafsdk.CreateIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateIPSet"), nil
+}
+func (unimplementedWAFRegional) CreateIPSetWithContext(_ context.Context, _ *wafsdk.CreateIPSetInput, _ ...request.Option) (*wafsdk.CreateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateIPSet")
+}
+func (unimplementedWAFRegional) CreateRateBasedRule(_ *wafsdk.CreateRateBasedRuleInput) (*wafsdk.CreateRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRateBasedRule")
+}
+func (unimplementedWAFRegional) CreateRateBasedRuleRequest(_ *wafsdk.CreateRateBasedRuleInput) (*request.Request, *wafsdk.CreateRateBasedRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateRateBasedRule"), nil
+}
+func (unimplementedWAFRegional) CreateRateBasedRuleWithContext(_ context.Context, _ *wafsdk.CreateRateBasedRuleInput, _ ...request.Option) (*wafsdk.CreateRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRateBasedRule")
+}
+func (unimplementedWAFRegional) CreateRegexMatchSet(_ *wafsdk.CreateRegexMatchSetInput) (*wafsdk.CreateRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRegexMatchSet")
+}
+func (unimplementedWAFRegional) CreateRegexMatchSetRequest(_ *wafsdk.CreateRegexMatchSetInput) (*request.Request, *wafsdk.CreateRegexMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateRegexMatchSet"), nil
+}
+func (unimplementedWAFRegional) CreateRegexMatchSetWithContext(_ context.Context, _ *wafsdk.CreateRegexMatchSetInput, _ ...request.Option) (*wafsdk.CreateRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRegexMatchSet")
+}
+func (unimplementedWAFRegional) CreateRegexPatternSet(_ *wafsdk.CreateRegexPatternSetInput) (*wafsdk.CreateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRegexPatternSet")
+}
+func (unimplementedWAFRegional) CreateRegexPatternSetRequest(_ *wafsdk.CreateRegexPatternSetInput) (*request.Request, *wafsdk.CreateRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateRegexPatternSet"), nil
+}
+func (unimplementedWAFRegional) CreateRegexPatternSetWithContext(_ context.Context, _ *wafsdk.CreateRegexPatternSetInput, _ ...request.Option) (*wafsdk.CreateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRegexPatternSet")
+}
+func (unimplementedWAFRegional) CreateRule(_ *wafsdk.CreateRuleInput) (*wafsdk.CreateRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRule")
+}
+func (unimplementedWAFRegional) CreateRuleGroup(_ *wafsdk.CreateRuleGroupInput) (*wafsdk.CreateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRuleGroup")
+}
+func (unimplementedWAFRegional) CreateRuleGroupRequest(_ *wafsdk.CreateRuleGroupInput) (*request.Request, *wafsdk.CreateRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateRuleGroup"), nil
+}
+func (unimplementedWAFRegional) CreateRuleGroupWithContext(_ context.Context, _ *wafsdk.CreateRuleGroupInput, _ ...request.Option) (*wafsdk.CreateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRuleGroup")
+}
+func (unimplementedWAFRegional) CreateRuleRequest(_ *wafsdk.CreateRuleInput) (*request.Request, *wafsdk.CreateRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateRule"), nil
+}
+func (unimplementedWAFRegional) CreateRuleWithContext(_ context.Context, _ *wafsdk.CreateRuleInput, _ ...request.Option) (*wafsdk.CreateRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateRule")
+}
+func (unimplementedWAFRegional) CreateSizeConstraintSet(_ *wafsdk.CreateSizeConstraintSetInput) (*wafsdk.CreateSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateSizeConstraintSet")
+}
+func (unimplementedWAFRegional) CreateSizeConstraintSetRequest(_ *wafsdk.CreateSizeConstraintSetInput) (*request.Request, *wafsdk.CreateSizeConstraintSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateSizeConstraintSet"), nil
+}
+func (unimplementedWAFRegional) CreateSizeConstraintSetWithContext(_ context.Context, _ *wafsdk.CreateSizeConstraintSetInput, _ ...request.Option) (*wafsdk.CreateSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateSizeConstraintSet")
+}
+func (unimplementedWAFRegional) CreateSqlInjectionMatchSet(_ *wafsdk.CreateSqlInjectionMatchSetInput) (*wafsdk.CreateSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) CreateSqlInjectionMatchSetRequest(_ *wafsdk.CreateSqlInjectionMatchSetInput) (*request.Request, *wafsdk.CreateSqlInjectionMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateSqlInjectionMatchSet"), nil
+}
+func (unimplementedWAFRegional) CreateSqlInjectionMatchSetWithContext(_ context.Context, _ *wafsdk.CreateSqlInjectionMatchSetInput, _ ...request.Option) (*wafsdk.CreateSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) CreateWebACL(_ *wafsdk.CreateWebACLInput) (*wafsdk.CreateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateWebACL")
+}
+func (unimplementedWAFRegional) CreateWebACLMigrationStack(_ *wafsdk.CreateWebACLMigrationStackInput) (*wafsdk.CreateWebACLMigrationStackOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateWebACLMigrationStack")
+}
+func (unimplementedWAFRegional) CreateWebACLMigrationStackRequest(_ *wafsdk.CreateWebACLMigrationStackInput) (*request.Request, *wafsdk.CreateWebACLMigrationStackOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateWebACLMigrationStack"), nil
+}
+func (unimplementedWAFRegional) CreateWebACLMigrationStackWithContext(_ context.Context, _ *wafsdk.CreateWebACLMigrationStackInput, _ ...request.Option) (*wafsdk.CreateWebACLMigrationStackOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateWebACLMigrationStack")
+}
+func (unimplementedWAFRegional) CreateWebACLRequest(_ *wafsdk.CreateWebACLInput) (*request.Request, *wafsdk.CreateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateWebACL"), nil
+}
+func (unimplementedWAFRegional) CreateWebACLWithContext(_ context.Context, _ *wafsdk.CreateWebACLInput, _ ...request.Option) (*wafsdk.CreateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateWebACL")
+}
+func (unimplementedWAFRegional) CreateXssMatchSet(_ *wafsdk.CreateXssMatchSetInput) (*wafsdk.CreateXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateXssMatchSet")
+}
+func (unimplementedWAFRegional) CreateXssMatchSetRequest(_ *wafsdk.CreateXssMatchSetInput) (*request.Request, *wafsdk.CreateXssMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "CreateXssMatchSet"), nil
+}
+func (unimplementedWAFRegional) CreateXssMatchSetWithContext(_ context.Context, _ *wafsdk.CreateXssMatchSetInput, _ ...request.Option) (*wafsdk.CreateXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "CreateXssMatchSet")
+}
+func (unimplementedWAFRegional) DeleteByteMatchSet(_ *wafsdk.DeleteByteMatchSetInput) (*wafsdk.DeleteByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteByteMatchSet")
+}
+func (unimplementedWAFRegional) DeleteByteMatchSetRequest(_ *wafsdk.DeleteByteMatchSetInput) (*request.Request, *wafsdk.DeleteByteMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteByteMatchSet"), nil
+}
+func (unimplementedWAFRegional) DeleteByteMatchSetWithContext(_ context.Context, _ *wafsdk.DeleteByteMatchSetInput, _ ...request.Option) (*wafsdk.DeleteByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteByteMatchSet")
+}
+func (unimplementedWAFRegional) DeleteGeoMatchSet(_ *wafsdk.DeleteGeoMatchSetInput) (*wafsdk.DeleteGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteGeoMatchSet")
+}
+func (unimplementedWAFRegional) DeleteGeoMatchSetRequest(_ *wafsdk.DeleteGeoMatchSetInput) (*request.Request, *wafsdk.DeleteGeoMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteGeoMatchSet"), nil
+}
+func (unimplementedWAFRegional) DeleteGeoMatchSetWithContext(_ context.Context, _ *wafsdk.DeleteGeoMatchSetInput, _ ...request.Option) (*wafsdk.DeleteGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteGeoMatchSet")
+}
+func (unimplementedWAFRegional) DeleteIPSet(_ *wafsdk.DeleteIPSetInput) (*wafsdk.DeleteIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteIPSet")
+}
+func (unimplementedWAFRegional) DeleteIPSetRequest(_ *wafsdk.DeleteIPSetInput) (*request.Request, *wafsdk.DeleteIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteIPSet"), nil
+}
+func (unimplementedWAFRegional) DeleteIPSetWithContext(_ context.Context, _ *wafsdk.DeleteIPSetInput, _ ...request.Option) (*wafsdk.DeleteIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteIPSet")
+}
+func (unimplementedWAFRegional) DeleteLoggingConfiguration(_ *wafsdk.DeleteLoggingConfigurationInput) (*wafsdk.DeleteLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteLoggingConfiguration")
+}
+func (unimplementedWAFRegional) DeleteLoggingConfigurationRequest(_ *wafsdk.DeleteLoggingConfigurationInput) (*request.Request, *wafsdk.DeleteLoggingConfigurationOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteLoggingConfiguration"), nil
+}
+func (unimplementedWAFRegional) DeleteLoggingConfigurationWithContext(_ context.Context, _ *wafsdk.DeleteLoggingConfigurationInput, _ ...request.Option) (*wafsdk.DeleteLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteLoggingConfiguration")
+}
+func (unimplementedWAFRegional) DeletePermissionPolicy(_ *wafsdk.DeletePermissionPolicyInput) (*wafsdk.DeletePermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeletePermissionPolicy")
+}
+func (unimplementedWAFRegional) DeletePermissionPolicyRequest(_ *wafsdk.DeletePermissionPolicyInput) (*request.Request, *wafsdk.DeletePermissionPolicyOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeletePermissionPolicy"), nil
+}
+func (unimplementedWAFRegional) DeletePermissionPolicyWithContext(_ context.Context, _ *wafsdk.DeletePermissionPolicyInput, _ ...request.Option) (*wafsdk.DeletePermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeletePermissionPolicy")
+}
+func (unimplementedWAFRegional) DeleteRateBasedRule(_ *wafsdk.DeleteRateBasedRuleInput) (*wafsdk.DeleteRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRateBasedRule")
+}
+func (unimplementedWAFRegional) DeleteRateBasedRuleRequest(_ *wafsdk.DeleteRateBasedRuleInput) (*request.Request, *wafsdk.DeleteRateBasedRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteRateBasedRule"), nil
+}
+func (unimplementedWAFRegional) DeleteRateBasedRuleWithContext(_ context.Context, _ *wafsdk.DeleteRateBasedRuleInput, _ ...request.Option) (*wafsdk.DeleteRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRateBasedRule")
+}
+func (unimplementedWAFRegional) DeleteRegexMatchSet(_ *wafsdk.DeleteRegexMatchSetInput) (*wafsdk.DeleteRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRegexMatchSet")
+}
+func (unimplementedWAFRegional) DeleteRegexMatchSetRequest(_ *wafsdk.DeleteRegexMatchSetInput) (*request.Request, *wafsdk.DeleteRegexMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteRegexMatchSet"), nil
+}
+func (unimplementedWAFRegional) DeleteRegexMatchSetWithContext(_ context.Context, _ *wafsdk.DeleteRegexMatchSetInput, _ ...request.Option) (*wafsdk.DeleteRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRegexMatchSet")
+}
+func (unimplementedWAFRegional) DeleteRegexPatternSet(_ *wafsdk.DeleteRegexPatternSetInput) (*wafsdk.DeleteRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRegexPatternSet")
+}
+func (unimplementedWAFRegional) DeleteRegexPatternSetRequest(_ *wafsdk.DeleteRegexPatternSetInput) (*request.Request, *wafsdk.DeleteRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteRegexPatternSet"), nil
+}
+func (unimplementedWAFRegional) DeleteRegexPatternSetWithContext(_ context.Context, _ *wafsdk.DeleteRegexPatternSetInput, _ ...request.Option) (*wafsdk.DeleteRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRegexPatternSet")
+}
+func (unimplementedWAFRegional) DeleteRule(_ *wafsdk.DeleteRuleInput) (*wafsdk.DeleteRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRule")
+}
+func (unimplementedWAFRegional) DeleteRuleGroup(_ *wafsdk.DeleteRuleGroupInput) (*wafsdk.DeleteRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRuleGroup")
+}
+func (unimplementedWAFRegional) DeleteRuleGroupRequest(_ *wafsdk.DeleteRuleGroupInput) (*request.Request, *wafsdk.DeleteRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteRuleGroup"), nil
+}
+func (unimplementedWAFRegional) DeleteRuleGroupWithContext(_ context.Context, _ *wafsdk.DeleteRuleGroupInput, _ ...request.Option) (*wafsdk.DeleteRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRuleGroup")
+}
+func (unimplementedWAFRegional) DeleteRuleRequest(_ *wafsdk.DeleteRuleInput) (*request.Request, *wafsdk.DeleteRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteRule"), nil
+}
+func (unimplementedWAFRegional) DeleteRuleWithContext(_ context.Context, _ *wafsdk.DeleteRuleInput, _ ...request.Option) (*wafsdk.DeleteRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteRule")
+}
+func (unimplementedWAFRegional) DeleteSizeConstraintSet(_ *wafsdk.DeleteSizeConstraintSetInput) (*wafsdk.DeleteSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteSizeConstraintSet")
+}
+func (unimplementedWAFRegional) DeleteSizeConstraintSetRequest(_ *wafsdk.DeleteSizeConstraintSetInput) (*request.Request, *wafsdk.DeleteSizeConstraintSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteSizeConstraintSet"), nil
+}
+func (unimplementedWAFRegional) DeleteSizeConstraintSetWithContext(_ context.Context, _ *wafsdk.DeleteSizeConstraintSetInput, _ ...request.Option) (*wafsdk.DeleteSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteSizeConstraintSet")
+}
+func (unimplementedWAFRegional) DeleteSqlInjectionMatchSet(_ *wafsdk.DeleteSqlInjectionMatchSetInput) (*wafsdk.DeleteSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) DeleteSqlInjectionMatchSetRequest(_ *wafsdk.DeleteSqlInjectionMatchSetInput) (*request.Request, *wafsdk.DeleteSqlInjectionMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteSqlInjectionMatchSet"), nil
+}
+func (unimplementedWAFRegional) DeleteSqlInjectionMatchSetWithContext(_ context.Context, _ *wafsdk.DeleteSqlInjectionMatchSetInput, _ ...request.Option) (*wafsdk.DeleteSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) DeleteWebACL(_ *wafsdk.DeleteWebACLInput) (*wafsdk.DeleteWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteWebACL")
+}
+func (unimplementedWAFRegional) DeleteWebACLRequest(_ *wafsdk.DeleteWebACLInput) (*request.Request, *wafsdk.DeleteWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteWebACL"), nil
+}
+func (unimplementedWAFRegional) DeleteWebACLWithContext(_ context.Context, _ *wafsdk.DeleteWebACLInput, _ ...request.Option) (*wafsdk.DeleteWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteWebACL")
+}
+func (unimplementedWAFRegional) DeleteXssMatchSet(_ *wafsdk.DeleteXssMatchSetInput) (*wafsdk.DeleteXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteXssMatchSet")
+}
+func (unimplementedWAFRegional) DeleteXssMatchSetRequest(_ *wafsdk.DeleteXssMatchSetInput) (*request.Request, *wafsdk.DeleteXssMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DeleteXssMatchSet"), nil
+}
+func (unimplementedWAFRegional) DeleteXssMatchSetWithContext(_ context.Context, _ *wafsdk.DeleteXssMatchSetInput, _ ...request.Option) (*wafsdk.DeleteXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DeleteXssMatchSet")
+}
+func (unimplementedWAFRegional) DisassociateWebACL(_ *wafregionalsdk.DisassociateWebACLInput) (*wafregionalsdk.DisassociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DisassociateWebACL")
+}
+func (unimplementedWAFRegional) DisassociateWebACLRequest(_ *wafregionalsdk.DisassociateWebACLInput) (*request.Request, *wafregionalsdk.DisassociateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "DisassociateWebACL"), nil
+}
+func (unimplementedWAFRegional) DisassociateWebACLWithContext(_ context.Context, _ *wafregionalsdk.DisassociateWebACLInput, _ ...request.Option) (*wafregionalsdk.DisassociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "DisassociateWebACL")
+}
+func (unimplementedWAFRegional) GetByteMatchSet(_ *wafsdk.GetByteMatchSetInput) (*wafsdk.GetByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetByteMatchSet")
+}
+func (unimplementedWAFRegional) GetByteMatchSetRequest(_ *wafsdk.GetByteMatchSetInput) (*request.Request, *wafsdk.GetByteMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetByteMatchSet"), nil
+}
+func (unimplementedWAFRegional) GetByteMatchSetWithContext(_ context.Context, _ *wafsdk.GetByteMatchSetInput, _ ...request.Option) (*wafsdk.GetByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetByteMatchSet")
+}
+func (unimplementedWAFRegional) GetChangeToken(_ *wafsdk.GetChangeTokenInput) (*wafsdk.GetChangeTokenOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetChangeToken")
+}
+func (unimplementedWAFRegional) GetChangeTokenRequest(_ *wafsdk.GetChangeTokenInput) (*request.Request, *wafsdk.GetChangeTokenOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetChangeToken"), nil
+}
+func (unimplementedWAFRegional) GetChangeTokenStatus(_ *wafsdk.GetChangeTokenStatusInput) (*wafsdk.GetChangeTokenStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetChangeTokenStatus")
+}
+func (unimplementedWAFRegional) GetChangeTokenStatusRequest(_ *wafsdk.GetChangeTokenStatusInput) (*request.Request, *wafsdk.GetChangeTokenStatusOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetChangeTokenStatus"), nil
+}
+func (unimplementedWAFRegional) GetChangeTokenStatusWithContext(_ context.Context, _ *wafsdk.GetChangeTokenStatusInput, _ ...request.Option) (*wafsdk.GetChangeTokenStatusOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetChangeTokenStatus")
+}
+func (unimplementedWAFRegional) GetChangeTokenWithContext(_ context.Context, _ *wafsdk.GetChangeTokenInput, _ ...request.Option) (*wafsdk.GetChangeTokenOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetChangeToken")
+}
+func (unimplementedWAFRegional) GetGeoMatchSet(_ *wafsdk.GetGeoMatchSetInput) (*wafsdk.GetGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetGeoMatchSet")
+}
+func (unimplementedWAFRegional) GetGeoMatchSetRequest(_ *wafsdk.GetGeoMatchSetInput) (*request.Request, *wafsdk.GetGeoMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetGeoMatchSet"), nil
+}
+func (unimplementedWAFRegional) GetGeoMatchSetWithContext(_ context.Context, _ *wafsdk.GetGeoMatchSetInput, _ ...request.Option) (*wafsdk.GetGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetGeoMatchSet")
+}
+func (unimplementedWAFRegional) GetIPSet(_ *wafsdk.GetIPSetInput) (*wafsdk.GetIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetIPSet")
+}
+func (unimplementedWAFRegional) GetIPSetRequest(_ *wafsdk.GetIPSetInput) (*request.Request, *wafsdk.GetIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetIPSet"), nil
+}
+func (unimplementedWAFRegional) GetIPSetWithContext(_ context.Context, _ *wafsdk.GetIPSetInput, _ ...request.Option) (*wafsdk.GetIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetIPSet")
+}
+func (unimplementedWAFRegional) GetLoggingConfiguration(_ *wafsdk.GetLoggingConfigurationInput) (*wafsdk.GetLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetLoggingConfiguration")
+}
+func (unimplementedWAFRegional) GetLoggingConfigurationRequest(_ *wafsdk.GetLoggingConfigurationInput) (*request.Request, *wafsdk.GetLoggingConfigurationOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetLoggingConfiguration"), nil
+}
+func (unimplementedWAFRegional) GetLoggingConfigurationWithContext(_ context.Context, _ *wafsdk.GetLoggingConfigurationInput, _ ...request.Option) (*wafsdk.GetLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetLoggingConfiguration")
+}
+func (unimplementedWAFRegional) GetPermissionPolicy(_ *wafsdk.GetPermissionPolicyInput) (*wafsdk.GetPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetPermissionPolicy")
+}
+func (unimplementedWAFRegional) GetPermissionPolicyRequest(_ *wafsdk.GetPermissionPolicyInput) (*request.Request, *wafsdk.GetPermissionPolicyOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetPermissionPolicy"), nil
+}
+func (unimplementedWAFRegional) GetPermissionPolicyWithContext(_ context.Context, _ *wafsdk.GetPermissionPolicyInput, _ ...request.Option) (*wafsdk.GetPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetPermissionPolicy")
+}
+func (unimplementedWAFRegional) GetRateBasedRule(_ *wafsdk.GetRateBasedRuleInput) (*wafsdk.GetRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRateBasedRule")
+}
+func (unimplementedWAFRegional) GetRateBasedRuleManagedKeys(_ *wafsdk.GetRateBasedRuleManagedKeysInput) (*wafsdk.GetRateBasedRuleManagedKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRateBasedRuleManagedKeys")
+}
+func (unimplementedWAFRegional) GetRateBasedRuleManagedKeysRequest(_ *wafsdk.GetRateBasedRuleManagedKeysInput) (*request.Request, *wafsdk.GetRateBasedRuleManagedKeysOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetRateBasedRuleManagedKeys"), nil
+}
+func (unimplementedWAFRegional) GetRateBasedRuleManagedKeysWithContext(_ context.Context, _ *wafsdk.GetRateBasedRuleManagedKeysInput, _ ...request.Option) (*wafsdk.GetRateBasedRuleManagedKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRateBasedRuleManagedKeys")
+}
+func (unimplementedWAFRegional) GetRateBasedRuleRequest(_ *wafsdk.GetRateBasedRuleInput) (*request.Request, *wafsdk.GetRateBasedRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetRateBasedRule"), nil
+}
+func (unimplementedWAFRegional) GetRateBasedRuleWithContext(_ context.Context, _ *wafsdk.GetRateBasedRuleInput, _ ...request.Option) (*wafsdk.GetRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRateBasedRule")
+}
+func (unimplementedWAFRegional) GetRegexMatchSet(_ *wafsdk.GetRegexMatchSetInput) (*wafsdk.GetRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRegexMatchSet")
+}
+func (unimplementedWAFRegional) GetRegexMatchSetRequest(_ *wafsdk.GetRegexMatchSetInput) (*request.Request, *wafsdk.GetRegexMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetRegexMatchSet"), nil
+}
+func (unimplementedWAFRegional) GetRegexMatchSetWithContext(_ context.Context, _ *wafsdk.GetRegexMatchSetInput, _ ...request.Option) (*wafsdk.GetRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRegexMatchSet")
+}
+func (unimplementedWAFRegional) GetRegexPatternSet(_ *wafsdk.GetRegexPatternSetInput) (*wafsdk.GetRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRegexPatternSet")
+}
+func (unimplementedWAFRegional) GetRegexPatternSetRequest(_ *wafsdk.GetRegexPatternSetInput) (*request.Request, *wafsdk.GetRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetRegexPatternSet"), nil
+}
+func (unimplementedWAFRegional) GetRegexPatternSetWithContext(_ context.Context, _ *wafsdk.GetRegexPatternSetInput, _ ...request.Option) (*wafsdk.GetRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRegexPatternSet")
+}
+func (unimplementedWAFRegional) GetRule(_ *wafsdk.GetRuleInput) (*wafsdk.GetRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRule")
+}
+func (unimplementedWAFRegional) GetRuleGroup(_ *wafsdk.GetRuleGroupInput) (*wafsdk.GetRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRuleGroup")
+}
+func (unimplementedWAFRegional) GetRuleGroupRequest(_ *wafsdk.GetRuleGroupInput) (*request.Request, *wafsdk.GetRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetRuleGroup"), nil
+}
+func (unimplementedWAFRegional) GetRuleGroupWithContext(_ context.Context, _ *wafsdk.GetRuleGroupInput, _ ...request.Option) (*wafsdk.GetRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRuleGroup")
+}
+func (unimplementedWAFRegional) GetRuleRequest(_ *wafsdk.GetRuleInput) (*request.Request, *wafsdk.GetRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetRule"), nil
+}
+func (unimplementedWAFRegional) GetRuleWithContext(_ context.Context, _ *wafsdk.GetRuleInput, _ ...request.Option) (*wafsdk.GetRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetRule")
+}
+func (unimplementedWAFRegional) GetSampledRequests(_ *wafsdk.GetSampledRequestsInput) (*wafsdk.GetSampledRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetSampledRequests")
+}
+func (unimplementedWAFRegional) GetSampledRequestsRequest(_ *wafsdk.GetSampledRequestsInput) (*request.Request, *wafsdk.GetSampledRequestsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetSampledRequests"), nil
+}
+func (unimplementedWAFRegional) GetSampledRequestsWithContext(_ context.Context, _ *wafsdk.GetSampledRequestsInput, _ ...request.Option) (*wafsdk.GetSampledRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetSampledRequests")
+}
+func (unimplementedWAFRegional) GetSizeConstraintSet(_ *wafsdk.GetSizeConstraintSetInput) (*wafsdk.GetSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetSizeConstraintSet")
+}
+func (unimplementedWAFRegional) GetSizeConstraintSetRequest(_ *wafsdk.GetSizeConstraintSetInput) (*request.Request, *wafsdk.GetSizeConstraintSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetSizeConstraintSet"), nil
+}
+func (unimplementedWAFRegional) GetSizeConstraintSetWithContext(_ context.Context, _ *wafsdk.GetSizeConstraintSetInput, _ ...request.Option) (*wafsdk.GetSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetSizeConstraintSet")
+}
+func (unimplementedWAFRegional) GetSqlInjectionMatchSet(_ *wafsdk.GetSqlInjectionMatchSetInput) (*wafsdk.GetSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) GetSqlInjectionMatchSetRequest(_ *wafsdk.GetSqlInjectionMatchSetInput) (*request.Request, *wafsdk.GetSqlInjectionMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetSqlInjectionMatchSet"), nil
+}
+func (unimplementedWAFRegional) GetSqlInjectionMatchSetWithContext(_ context.Context, _ *wafsdk.GetSqlInjectionMatchSetInput, _ ...request.Option) (*wafsdk.GetSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) GetWebACL(_ *wafsdk.GetWebACLInput) (*wafsdk.GetWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetWebACL")
+}
+func (unimplementedWAFRegional) GetWebACLForResource(_ *wafregionalsdk.GetWebACLForResourceInput) (*wafregionalsdk.GetWebACLForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetWebACLForResource")
+}
+func (unimplementedWAFRegional) GetWebACLForResourceRequest(_ *wafregionalsdk.GetWebACLForResourceInput) (*request.Request, *wafregionalsdk.GetWebACLForResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetWebACLForResource"), nil
+}
+func (unimplementedWAFRegional) GetWebACLForResourceWithContext(_ context.Context, _ *wafregionalsdk.GetWebACLForResourceInput, _ ...request.Option) (*wafregionalsdk.GetWebACLForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetWebACLForResource")
+}
+func (unimplementedWAFRegional) GetWebACLRequest(_ *wafsdk.GetWebACLInput) (*request.Request, *wafsdk.GetWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetWebACL"), nil
+}
+func (unimplementedWAFRegional) GetWebACLWithContext(_ context.Context, _ *wafsdk.GetWebACLInput, _ ...request.Option) (*wafsdk.GetWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetWebACL")
+}
+func (unimplementedWAFRegional) GetXssMatchSet(_ *wafsdk.GetXssMatchSetInput) (*wafsdk.GetXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetXssMatchSet")
+}
+func (unimplementedWAFRegional) GetXssMatchSetRequest(_ *wafsdk.GetXssMatchSetInput) (*request.Request, *wafsdk.GetXssMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "GetXssMatchSet"), nil
+}
+func (unimplementedWAFRegional) GetXssMatchSetWithContext(_ context.Context, _ *wafsdk.GetXssMatchSetInput, _ ...request.Option) (*wafsdk.GetXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "GetXssMatchSet")
+}
+func (unimplementedWAFRegional) ListActivatedRulesInRuleGroup(_ *wafsdk.ListActivatedRulesInRuleGroupInput) (*wafsdk.ListActivatedRulesInRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListActivatedRulesInRuleGroup")
+}
+func (unimplementedWAFRegional) ListActivatedRulesInRuleGroupRequest(_ *wafsdk.ListActivatedRulesInRuleGroupInput) (*request.Request, *wafsdk.ListActivatedRulesInRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListActivatedRulesInRuleGroup"), nil
+}
+func (unimplementedWAFRegional) ListActivatedRulesInRuleGroupWithContext(_ context.Context, _ *wafsdk.ListActivatedRulesInRuleGroupInput, _ ...request.Option) (*wafsdk.ListActivatedRulesInRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListActivatedRulesInRuleGroup")
+}
+func (unimplementedWAFRegional) ListByteMatchSets(_ *wafsdk.ListByteMatchSetsInput) (*wafsdk.ListByteMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListByteMatchSets")
+}
+func (unimplementedWAFRegional) ListByteMatchSetsRequest(_ *wafsdk.ListByteMatchSetsInput) (*request.Request, *wafsdk.ListByteMatchSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListByteMatchSets"), nil
+}
+func (unimplementedWAFRegional) ListByteMatchSetsWithContext(_ context.Context, _ *wafsdk.ListByteMatchSetsInput, _ ...request.Option) (*wafsdk.ListByteMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListByteMatchSets")
+}
+func (unimplementedWAFRegional) ListGeoMatchSets(_ *wafsdk.ListGeoMatchSetsInput) (*wafsdk.ListGeoMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListGeoMatchSets")
+}
+func (unimplementedWAFRegional) ListGeoMatchSetsRequest(_ *wafsdk.ListGeoMatchSetsInput) (*request.Request, *wafsdk.ListGeoMatchSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListGeoMatchSets"), nil
+}
+func (unimplementedWAFRegional) ListGeoMatchSetsWithContext(_ context.Context, _ *wafsdk.ListGeoMatchSetsInput, _ ...request.Option) (*wafsdk.ListGeoMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListGeoMatchSets")
+}
+func (unimplementedWAFRegional) ListIPSets(_ *wafsdk.ListIPSetsInput) (*wafsdk.ListIPSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListIPSets")
+}
+func (unimplementedWAFRegional) ListIPSetsRequest(_ *wafsdk.ListIPSetsInput) (*request.Request, *wafsdk.ListIPSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListIPSets"), nil
+}
+func (unimplementedWAFRegional) ListIPSetsWithContext(_ context.Context, _ *wafsdk.ListIPSetsInput, _ ...request.Option) (*wafsdk.ListIPSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListIPSets")
+}
+func (unimplementedWAFRegional) ListLoggingConfigurations(_ *wafsdk.ListLoggingConfigurationsInput) (*wafsdk.ListLoggingConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListLoggingConfigurations")
+}
+func (unimplementedWAFRegional) ListLoggingConfigurationsRequest(_ *wafsdk.ListLoggingConfigurationsInput) (*request.Request, *wafsdk.ListLoggingConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListLoggingConfigurations"), nil
+}
+func (unimplementedWAFRegional) ListLoggingConfigurationsWithContext(_ context.Context, _ *wafsdk.ListLoggingConfigurationsInput, _ ...request.Option) (*wafsdk.ListLoggingConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListLoggingConfigurations")
+}
+func (unimplementedWAFRegional) ListRateBasedRules(_ *wafsdk.ListRateBasedRulesInput) (*wafsdk.ListRateBasedRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRateBasedRules")
+}
+func (unimplementedWAFRegional) ListRateBasedRulesRequest(_ *wafsdk.ListRateBasedRulesInput) (*request.Request, *wafsdk.ListRateBasedRulesOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListRateBasedRules"), nil
+}
+func (unimplementedWAFRegional) ListRateBasedRulesWithContext(_ context.Context, _ *wafsdk.ListRateBasedRulesInput, _ ...request.Option) (*wafsdk.ListRateBasedRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRateBasedRules")
+}
+func (unimplementedWAFRegional) ListRegexMatchSets(_ *wafsdk.ListRegexMatchSetsInput) (*wafsdk.ListRegexMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRegexMatchSets")
+}
+func (unimplementedWAFRegional) ListRegexMatchSetsRequest(_ *wafsdk.ListRegexMatchSetsInput) (*request.Request, *wafsdk.ListRegexMatchSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListRegexMatchSets"), nil
+}
+func (unimplementedWAFRegional) ListRegexMatchSetsWithContext(_ context.Context, _ *wafsdk.ListRegexMatchSetsInput, _ ...request.Option) (*wafsdk.ListRegexMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRegexMatchSets")
+}
+func (unimplementedWAFRegional) ListRegexPatternSets(_ *wafsdk.ListRegexPatternSetsInput) (*wafsdk.ListRegexPatternSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRegexPatternSets")
+}
+func (unimplementedWAFRegional) ListRegexPatternSetsRequest(_ *wafsdk.ListRegexPatternSetsInput) (*request.Request, *wafsdk.ListRegexPatternSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListRegexPatternSets"), nil
+}
+func (unimplementedWAFRegional) ListRegexPatternSetsWithContext(_ context.Context, _ *wafsdk.ListRegexPatternSetsInput, _ ...request.Option) (*wafsdk.ListRegexPatternSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRegexPatternSets")
+}
+func (unimplementedWAFRegional) ListResourcesForWebACL(_ *wafregionalsdk.ListResourcesForWebACLInput) (*wafregionalsdk.ListResourcesForWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListResourcesForWebACL")
+}
+func (unimplementedWAFRegional) ListResourcesForWebACLRequest(_ *wafregionalsdk.ListResourcesForWebACLInput) (*request.Request, *wafregionalsdk.ListResourcesForWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListResourcesForWebACL"), nil
+}
+func (unimplementedWAFRegional) ListResourcesForWebACLWithContext(_ context.Context, _ *wafregionalsdk.ListResourcesForWebACLInput, _ ...request.Option) (*wafregionalsdk.ListResourcesForWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListResourcesForWebACL")
+}
+func (unimplementedWAFRegional) ListRuleGroups(_ *wafsdk.ListRuleGroupsInput) (*wafsdk.ListRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRuleGroups")
+}
+func (unimplementedWAFRegional) ListRuleGroupsRequest(_ *wafsdk.ListRuleGroupsInput) (*request.Request, *wafsdk.ListRuleGroupsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListRuleGroups"), nil
+}
+func (unimplementedWAFRegional) ListRuleGroupsWithContext(_ context.Context, _ *wafsdk.ListRuleGroupsInput, _ ...request.Option) (*wafsdk.ListRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRuleGroups")
+}
+func (unimplementedWAFRegional) ListRules(_ *wafsdk.ListRulesInput) (*wafsdk.ListRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRules")
+}
+func (unimplementedWAFRegional) ListRulesRequest(_ *wafsdk.ListRulesInput) (*request.Request, *wafsdk.ListRulesOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListRules"), nil
+}
+func (unimplementedWAFRegional) ListRulesWithContext(_ context.Context, _ *wafsdk.ListRulesInput, _ ...request.Option) (*wafsdk.ListRulesOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListRules")
+}
+func (unimplementedWAFRegional) ListSizeConstraintSets(_ *wafsdk.ListSizeConstraintSetsInput) (*wafsdk.ListSizeConstraintSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListSizeConstraintSets")
+}
+func (unimplementedWAFRegional) ListSizeConstraintSetsRequest(_ *wafsdk.ListSizeConstraintSetsInput) (*request.Request, *wafsdk.ListSizeConstraintSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListSizeConstraintSets"), nil
+}
+func (unimplementedWAFRegional) ListSizeConstraintSetsWithContext(_ context.Context, _ *wafsdk.ListSizeConstraintSetsInput, _ ...request.Option) (*wafsdk.ListSizeConstraintSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListSizeConstraintSets")
+}
+func (unimplementedWAFRegional) ListSqlInjectionMatchSets(_ *wafsdk.ListSqlInjectionMatchSetsInput) (*wafsdk.ListSqlInjectionMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListSqlInjectionMatchSets")
+}
+func (unimplementedWAFRegional) ListSqlInjectionMatchSetsRequest(_ *wafsdk.ListSqlInjectionMatchSetsInput) (*request.Request, *wafsdk.ListSqlInjectionMatchSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListSqlInjectionMatchSets"), nil
+}
+func (unimplementedWAFRegional) ListSqlInjectionMatchSetsWithContext(_ context.Context, _ *wafsdk.ListSqlInjectionMatchSetsInput, _ ...request.Option) (*wafsdk.ListSqlInjectionMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListSqlInjectionMatchSets")
+}
+func (unimplementedWAFRegional) ListSubscribedRuleGroups(_ *wafsdk.ListSubscribedRuleGroupsInput) (*wafsdk.ListSubscribedRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListSubscribedRuleGroups")
+}
+func (unimplementedWAFRegional) ListSubscribedRuleGroupsRequest(_ *wafsdk.ListSubscribedRuleGroupsInput) (*request.Request, *wafsdk.ListSubscribedRuleGroupsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListSubscribedRuleGroups"), nil
+}
+func (unimplementedWAFRegional) ListSubscribedRuleGroupsWithContext(_ context.Context, _ *wafsdk.ListSubscribedRuleGroupsInput, _ ...request.Option) (*wafsdk.ListSubscribedRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListSubscribedRuleGroups")
+}
+func (unimplementedWAFRegional) ListTagsForResource(_ *wafsdk.ListTagsForResourceInput) (*wafsdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListTagsForResource")
+}
+func (unimplementedWAFRegional) ListTagsForResourceRequest(_ *wafsdk.ListTagsForResourceInput) (*request.Request, *wafsdk.ListTagsForResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListTagsForResource"), nil
+}
+func (unimplementedWAFRegional) ListTagsForResourceWithContext(_ context.Context, _ *wafsdk.ListTagsForResourceInput, _ ...request.Option) (*wafsdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListTagsForResource")
+}
+func (unimplementedWAFRegional) ListWebACLs(_ *wafsdk.ListWebACLsInput) (*wafsdk.ListWebACLsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListWebACLs")
+}
+func (unimplementedWAFRegional) ListWebACLsRequest(_ *wafsdk.ListWebACLsInput) (*request.Request, *wafsdk.ListWebACLsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListWebACLs"), nil
+}
+func (unimplementedWAFRegional) ListWebACLsWithContext(_ context.Context, _ *wafsdk.ListWebACLsInput, _ ...request.Option) (*wafsdk.ListWebACLsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListWebACLs")
+}
+func (unimplementedWAFRegional) ListXssMatchSets(_ *wafsdk.ListXssMatchSetsInput) (*wafsdk.ListXssMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListXssMatchSets")
+}
+func (unimplementedWAFRegional) ListXssMatchSetsRequest(_ *wafsdk.ListXssMatchSetsInput) (*request.Request, *wafsdk.ListXssMatchSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "ListXssMatchSets"), nil
+}
+func (unimplementedWAFRegional) ListXssMatchSetsWithContext(_ context.Context, _ *wafsdk.ListXssMatchSetsInput, _ ...request.Option) (*wafsdk.ListXssMatchSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "ListXssMatchSets")
+}
+func (unimplementedWAFRegional) PutLoggingConfiguration(_ *wafsdk.PutLoggingConfigurationInput) (*wafsdk.PutLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "PutLoggingConfiguration")
+}
+func (unimplementedWAFRegional) PutLoggingConfigurationRequest(_ *wafsdk.PutLoggingConfigurationInput) (*request.Request, *wafsdk.PutLoggingConfigurationOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "PutLoggingConfiguration"), nil
+}
+func (unimplementedWAFRegional) PutLoggingConfigurationWithContext(_ context.Context, _ *wafsdk.PutLoggingConfigurationInput, _ ...request.Option) (*wafsdk.PutLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "PutLoggingConfiguration")
+}
+func (unimplementedWAFRegional) PutPermissionPolicy(_ *wafsdk.PutPermissionPolicyInput) (*wafsdk.PutPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "PutPermissionPolicy")
+}
+func (unimplementedWAFRegional) PutPermissionPolicyRequest(_ *wafsdk.PutPermissionPolicyInput) (*request.Request, *wafsdk.PutPermissionPolicyOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "PutPermissionPolicy"), nil
+}
+func (unimplementedWAFRegional) PutPermissionPolicyWithContext(_ context.Context, _ *wafsdk.PutPermissionPolicyInput, _ ...request.Option) (*wafsdk.PutPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "PutPermissionPolicy")
+}
+func (unimplementedWAFRegional) TagResource(_ *wafsdk.TagResourceInput) (*wafsdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "TagResource")
+}
+func (unimplementedWAFRegional) TagResourceRequest(_ *wafsdk.TagResourceInput) (*request.Request, *wafsdk.TagResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "TagResource"), nil
+}
+func (unimplementedWAFRegional) TagResourceWithContext(_ context.Context, _ *wafsdk.TagResourceInput, _ ...request.Option) (*wafsdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "TagResource")
+}
+func (unimplementedWAFRegional) UntagResource(_ *wafsdk.UntagResourceInput) (*wafsdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UntagResource")
+}
+func (unimplementedWAFRegional) UntagResourceRequest(_ *wafsdk.UntagResourceInput) (*request.Request, *wafsdk.UntagResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UntagResource"), nil
+}
+func (unimplementedWAFRegional) UntagResourceWithContext(_ context.Context, _ *wafsdk.UntagResourceInput, _ ...request.Option) (*wafsdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UntagResource")
+}
+func (unimplementedWAFRegional) UpdateByteMatchSet(_ *wafsdk.UpdateByteMatchSetInput) (*wafsdk.UpdateByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateByteMatchSet")
+}
+func (unimplementedWAFRegional) UpdateByteMatchSetRequest(_ *wafsdk.UpdateByteMatchSetInput) (*request.Request, *wafsdk.UpdateByteMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateByteMatchSet"), nil
+}
+func (unimplementedWAFRegional) UpdateByteMatchSetWithContext(_ context.Context, _ *wafsdk.UpdateByteMatchSetInput, _ ...request.Option) (*wafsdk.UpdateByteMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateByteMatchSet")
+}
+func (unimplementedWAFRegional) UpdateGeoMatchSet(_ *wafsdk.UpdateGeoMatchSetInput) (*wafsdk.UpdateGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateGeoMatchSet")
+}
+func (unimplementedWAFRegional) UpdateGeoMatchSetRequest(_ *wafsdk.UpdateGeoMatchSetInput) (*request.Request, *wafsdk.UpdateGeoMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateGeoMatchSet"), nil
+}
+func (unimplementedWAFRegional) UpdateGeoMatchSetWithContext(_ context.Context, _ *wafsdk.UpdateGeoMatchSetInput, _ ...request.Option) (*wafsdk.UpdateGeoMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateGeoMatchSet")
+}
+func (unimplementedWAFRegional) UpdateIPSet(_ *wafsdk.UpdateIPSetInput) (*wafsdk.UpdateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateIPSet")
+}
+func (unimplementedWAFRegional) UpdateIPSetRequest(_ *wafsdk.UpdateIPSetInput) (*request.Request, *wafsdk.UpdateIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateIPSet"), nil
+}
+func (unimplementedWAFRegional) UpdateIPSetWithContext(_ context.Context, _ *wafsdk.UpdateIPSetInput, _ ...request.Option) (*wafsdk.UpdateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateIPSet")
+}
+func (unimplementedWAFRegional) UpdateRateBasedRule(_ *wafsdk.UpdateRateBasedRuleInput) (*wafsdk.UpdateRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRateBasedRule")
+}
+func (unimplementedWAFRegional) UpdateRateBasedRuleRequest(_ *wafsdk.UpdateRateBasedRuleInput) (*request.Request, *wafsdk.UpdateRateBasedRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateRateBasedRule"), nil
+}
+func (unimplementedWAFRegional) UpdateRateBasedRuleWithContext(_ context.Context, _ *wafsdk.UpdateRateBasedRuleInput, _ ...request.Option) (*wafsdk.UpdateRateBasedRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRateBasedRule")
+}
+func (unimplementedWAFRegional) UpdateRegexMatchSet(_ *wafsdk.UpdateRegexMatchSetInput) (*wafsdk.UpdateRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRegexMatchSet")
+}
+func (unimplementedWAFRegional) UpdateRegexMatchSetRequest(_ *wafsdk.UpdateRegexMatchSetInput) (*request.Request, *wafsdk.UpdateRegexMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateRegexMatchSet"), nil
+}
+func (unimplementedWAFRegional) UpdateRegexMatchSetWithContext(_ context.Context, _ *wafsdk.UpdateRegexMatchSetInput, _ ...request.Option) (*wafsdk.UpdateRegexMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRegexMatchSet")
+}
+func (unimplementedWAFRegional) UpdateRegexPatternSet(_ *wafsdk.UpdateRegexPatternSetInput) (*wafsdk.UpdateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRegexPatternSet")
+}
+func (unimplementedWAFRegional) UpdateRegexPatternSetRequest(_ *wafsdk.UpdateRegexPatternSetInput) (*request.Request, *wafsdk.UpdateRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateRegexPatternSet"), nil
+}
+func (unimplementedWAFRegional) UpdateRegexPatternSetWithContext(_ context.Context, _ *wafsdk.UpdateRegexPatternSetInput, _ ...request.Option) (*wafsdk.UpdateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRegexPatternSet")
+}
+func (unimplementedWAFRegional) UpdateRule(_ *wafsdk.UpdateRuleInput) (*wafsdk.UpdateRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRule")
+}
+func (unimplementedWAFRegional) UpdateRuleGroup(_ *wafsdk.UpdateRuleGroupInput) (*wafsdk.UpdateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRuleGroup")
+}
+func (unimplementedWAFRegional) UpdateRuleGroupRequest(_ *wafsdk.UpdateRuleGroupInput) (*request.Request, *wafsdk.UpdateRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateRuleGroup"), nil
+}
+func (unimplementedWAFRegional) UpdateRuleGroupWithContext(_ context.Context, _ *wafsdk.UpdateRuleGroupInput, _ ...request.Option) (*wafsdk.UpdateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRuleGroup")
+}
+func (unimplementedWAFRegional) UpdateRuleRequest(_ *wafsdk.UpdateRuleInput) (*request.Request, *wafsdk.UpdateRuleOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateRule"), nil
+}
+func (unimplementedWAFRegional) UpdateRuleWithContext(_ context.Context, _ *wafsdk.UpdateRuleInput, _ ...request.Option) (*wafsdk.UpdateRuleOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateRule")
+}
+func (unimplementedWAFRegional) UpdateSizeConstraintSet(_ *wafsdk.UpdateSizeConstraintSetInput) (*wafsdk.UpdateSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateSizeConstraintSet")
+}
+func (unimplementedWAFRegional) UpdateSizeConstraintSetRequest(_ *wafsdk.UpdateSizeConstraintSetInput) (*request.Request, *wafsdk.UpdateSizeConstraintSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateSizeConstraintSet"), nil
+}
+func (unimplementedWAFRegional) UpdateSizeConstraintSetWithContext(_ context.Context, _ *wafsdk.UpdateSizeConstraintSetInput, _ ...request.Option) (*wafsdk.UpdateSizeConstraintSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateSizeConstraintSet")
+}
+func (unimplementedWAFRegional) UpdateSqlInjectionMatchSet(_ *wafsdk.UpdateSqlInjectionMatchSetInput) (*wafsdk.UpdateSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) UpdateSqlInjectionMatchSetRequest(_ *wafsdk.UpdateSqlInjectionMatchSetInput) (*request.Request, *wafsdk.UpdateSqlInjectionMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateSqlInjectionMatchSet"), nil
+}
+func (unimplementedWAFRegional) UpdateSqlInjectionMatchSetWithContext(_ context.Context, _ *wafsdk.UpdateSqlInjectionMatchSetInput, _ ...request.Option) (*wafsdk.UpdateSqlInjectionMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateSqlInjectionMatchSet")
+}
+func (unimplementedWAFRegional) UpdateWebACL(_ *wafsdk.UpdateWebACLInput) (*wafsdk.UpdateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateWebACL")
+}
+func (unimplementedWAFRegional) UpdateWebACLRequest(_ *wafsdk.UpdateWebACLInput) (*request.Request, *wafsdk.UpdateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateWebACL"), nil
+}
+func (unimplementedWAFRegional) UpdateWebACLWithContext(_ context.Context, _ *wafsdk.UpdateWebACLInput, _ ...request.Option) (*wafsdk.UpdateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateWebACL")
+}
+func (unimplementedWAFRegional) UpdateXssMatchSet(_ *wafsdk.UpdateXssMatchSetInput) (*wafsdk.UpdateXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateXssMatchSet")
+}
+func (unimplementedWAFRegional) UpdateXssMatchSetRequest(_ *wafsdk.UpdateXssMatchSetInput) (*request.Request, *wafsdk.UpdateXssMatchSetOutput) {
+	return newNotImplementedRequest(ServiceWAFRegional, "UpdateXssMatchSet"), nil
+}
+func (unimplementedWAFRegional) UpdateXssMatchSetWithContext(_ context.Context, _ *wafsdk.UpdateXssMatchSetInput, _ ...request.Option) (*wafsdk.UpdateXssMatchSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFRegional, "UpdateXssMatchSet")
+}
+
+// unimplementedWAFv2 implements wafv2iface.WAFV2API with APIs that return NotImplemented error.
+type unimplementedWAFv2 struct{}
+
+func (unimplementedWAFv2) AssociateWebACL(_ *wafv2sdk.AssociateWebACLInput) (*wafv2sdk.AssociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "AssociateWebACL")
+}
+func (unimplementedWAFv2) AssociateWebACLRequest(_ *wafv2sdk.AssociateWebACLInput) (*request.Request, *wafv2sdk.AssociateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "AssociateWebACL"), nil
+}
+func (unimplementedWAFv2) AssociateWebACLWithContext(_ context.Context, _ *wafv2sdk.AssociateWebACLInput, _ ...request.Option) (*wafv2sdk.AssociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "AssociateWebACL")
+}
+func (unimplementedWAFv2) CheckCapacity(_ *wafv2sdk.CheckCapacityInput) (*wafv2sdk.CheckCapacityOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CheckCapacity")
+}
+func (unimplementedWAFv2) CheckCapacityRequest(_ *wafv2sdk.CheckCapacityInput) (*request.Request, *wafv2sdk.CheckCapacityOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "CheckCapacity"), nil
+}
+func (unimplementedWAFv2) CheckCapacityWithContext(_ context.Context, _ *wafv2sdk.CheckCapacityInput, _ ...request.Option) (*wafv2sdk.CheckCapacityOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CheckCapacity")
+}
+func (unimplementedWAFv2) CreateAPIKey(_ *wafv2sdk.CreateAPIKeyInput) (*wafv2sdk.CreateAPIKeyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateAPIKey")
+}
+func (unimplementedWAFv2) CreateAPIKeyRequest(_ *wafv2sdk.CreateAPIKeyInput) (*request.Request, *wafv2sdk.CreateAPIKeyOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "CreateAPIKey"), nil
+}
+func (unimplementedWAFv2) CreateAPIKeyWithContext(_ context.Context, _ *wafv2sdk.CreateAPIKeyInput, _ ...request.Option) (*wafv2sdk.CreateAPIKeyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateAPIKey")
+}
+func (unimplementedWAFv2) CreateIPSet(_ *wafv2sdk.CreateIPSetInput) (*wafv2sdk.CreateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateIPSet")
+}
+func (unimplementedWAFv2) CreateIPSetRequest(_ *wafv2sdk.CreateIPSetInput) (*request.Request, *wafv2sdk.CreateIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "CreateIPSet"), nil
+}
+func (unimplementedWAFv2) CreateIPSetWithContext(_ context.Context, _ *wafv2sdk.CreateIPSetInput, _ ...request.Option) (*wafv2sdk.CreateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateIPSet")
+}
+func (unimplementedWAFv2) CreateRegexPatternSet(_ *wafv2sdk.CreateRegexPatternSetInput) (*wafv2sdk.CreateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateRegexPatternSet")
+}
+func (unimplementedWAFv2) CreateRegexPatternSetRequest(_ *wafv2sdk.CreateRegexPatternSetInput) (*request.Request, *wafv2sdk.CreateRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "CreateRegexPatternSet"), nil
+}
+func (unimplementedWAFv2) CreateRegexPatternSetWithContext(_ context.Context, _ *wafv2sdk.CreateRegexPatternSetInput, _ ...request.Option) (*wafv2sdk.CreateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateRegexPatternSet")
+}
+func (unimplementedWAFv2) CreateRuleGroup(_ *wafv2sdk.CreateRuleGroupInput) (*wafv2sdk.CreateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateRuleGroup")
+}
+func (unimplementedWAFv2) CreateRuleGroupRequest(_ *wafv2sdk.CreateRuleGroupInput) (*request.Request, *wafv2sdk.CreateRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "CreateRuleGroup"), nil
+}
+func (unimplementedWAFv2) CreateRuleGroupWithContext(_ context.Context, _ *wafv2sdk.CreateRuleGroupInput, _ ...request.Option) (*wafv2sdk.CreateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateRuleGroup")
+}
+func (unimplementedWAFv2) CreateWebACL(_ *wafv2sdk.CreateWebACLInput) (*wafv2sdk.CreateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateWebACL")
+}
+func (unimplementedWAFv2) CreateWebACLRequest(_ *wafv2sdk.CreateWebACLInput) (*request.Request, *wafv2sdk.CreateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "CreateWebACL"), nil
+}
+func (unimplementedWAFv2) CreateWebACLWithContext(_ context.Context, _ *wafv2sdk.CreateWebACLInput, _ ...request.Option) (*wafv2sdk.CreateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "CreateWebACL")
+}
+func (unimplementedWAFv2) DeleteFirewallManagerRuleGroups(_ *wafv2sdk.DeleteFirewallManagerRuleGroupsInput) (*wafv2sdk.DeleteFirewallManagerRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteFirewallManagerRuleGroups")
+}
+func (unimplementedWAFv2) DeleteFirewallManagerRuleGroupsRequest(_ *wafv2sdk.DeleteFirewallManagerRuleGroupsInput) (*request.Request, *wafv2sdk.DeleteFirewallManagerRuleGroupsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DeleteFirewallManagerRuleGroups"), nil
+}
+func (unimplementedWAFv2) DeleteFirewallManagerRuleGroupsWithContext(_ context.Context, _ *wafv2sdk.DeleteFirewallManagerRuleGroupsInput, _ ...request.Option) (*wafv2sdk.DeleteFirewallManagerRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteFirewallManagerRuleGroups")
+}
+func (unimplementedWAFv2) DeleteIPSet(_ *wafv2sdk.DeleteIPSetInput) (*wafv2sdk.DeleteIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteIPSet")
+}
+func (unimplementedWAFv2) DeleteIPSetRequest(_ *wafv2sdk.DeleteIPSetInput) (*request.Request, *wafv2sdk.DeleteIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DeleteIPSet"), nil
+}
+func (unimplementedWAFv2) DeleteIPSetWithContext(_ context.Context, _ *wafv2sdk.DeleteIPSetInput, _ ...request.Option) (*wafv2sdk.DeleteIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteIPSet")
+}
+func (unimplementedWAFv2) DeleteLoggingConfiguration(_ *wafv2sdk.DeleteLoggingConfigurationInput) (*wafv2sdk.DeleteLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteLoggingConfiguration")
+}
+func (unimplementedWAFv2) DeleteLoggingConfigurationRequest(_ *wafv2sdk.DeleteLoggingConfigurationInput) (*request.Request, *wafv2sdk.DeleteLoggingConfigurationOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DeleteLoggingConfiguration"), nil
+}
+func (unimplementedWAFv2) DeleteLoggingConfigurationWithContext(_ context.Context, _ *wafv2sdk.DeleteLoggingConfigurationInput, _ ...request.Option) (*wafv2sdk.DeleteLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteLoggingConfiguration")
+}
+func (unimplementedWAFv2) DeletePermissionPolicy(_ *wafv2sdk.DeletePermissionPolicyInput) (*wafv2sdk.DeletePermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeletePermissionPolicy")
+}
+func (unimplementedWAFv2) DeletePermissionPolicyRequest(_ *wafv2sdk.DeletePermissionPolicyInput) (*request.Request, *wafv2sdk.DeletePermissionPolicyOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DeletePermissionPolicy"), nil
+}
+func (unimplementedWAFv2) DeletePermissionPolicyWithContext(_ context.Context, _ *wafv2sdk.DeletePermissionPolicyInput, _ ...request.Option) (*wafv2sdk.DeletePermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeletePermissionPolicy")
+}
+func (unimplementedWAFv2) DeleteRegexPatternSet(_ *wafv2sdk.DeleteRegexPatternSetInput) (*wafv2sdk.DeleteRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteRegexPatternSet")
+}
+func (unimplementedWAFv2) DeleteRegexPatternSetRequest(_ *wafv2sdk.DeleteRegexPatternSetInput) (*request.Request, *wafv2sdk.DeleteRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DeleteRegexPatternSet"), nil
+}
+func (unimplementedWAFv2) DeleteRegexPatternSetWithContext(_ context.Context, _ *wafv2sdk.DeleteRegexPatternSetInput, _ ...request.Option) (*wafv2sdk.DeleteRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteRegexPatternSet")
+}
+func (unimplementedWAFv2) DeleteRuleGroup(_ *wafv2sdk.DeleteRuleGroupInput) (*wafv2sdk.DeleteRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteRuleGroup")
+}
+func (unimplementedWAFv2) DeleteRuleGroupRequest(_ *wafv2sdk.DeleteRuleGroupInput) (*request.Request, *wafv2sdk.DeleteRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DeleteRuleGroup"), nil
+}
+func (unimplementedWAFv2) DeleteRuleGroupWithContext(_ context.Context, _ *wafv2sdk.DeleteRuleGroupInput, _ ...request.Option) (*wafv2sdk.DeleteRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteRuleGroup")
+}
+func (unimplementedWAFv2) DeleteWebACL(_ *wafv2sdk.DeleteWebACLInput) (*wafv2sdk.DeleteWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteWebACL")
+}
+func (unimplementedWAFv2) DeleteWebACLRequest(_ *wafv2sdk.DeleteWebACLInput) (*request.Request, *wafv2sdk.DeleteWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DeleteWebACL"), nil
+}
+func (unimplementedWAFv2) DeleteWebACLWithContext(_ context.Context, _ *wafv2sdk.DeleteWebACLInput, _ ...request.Option) (*wafv2sdk.DeleteWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DeleteWebACL")
+}
+func (unimplementedWAFv2) DescribeAllManagedProducts(_ *wafv2sdk.DescribeAllManagedProductsInput) (*wafv2sdk.DescribeAllManagedProductsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DescribeAllManagedProducts")
+}
+func (unimplementedWAFv2) DescribeAllManagedProductsRequest(_ *wafv2sdk.DescribeAllManagedProductsInput) (*request.Request, *wafv2sdk.DescribeAllManagedProductsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DescribeAllManagedProducts"), nil
+}
+func (unimplementedWAFv2) DescribeAllManagedProductsWithContext(_ context.Context, _ *wafv2sdk.DescribeAllManagedProductsInput, _ ...request.Option) (*wafv2sdk.DescribeAllManagedProductsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DescribeAllManagedProducts")
+}
+func (unimplementedWAFv2) DescribeManagedProductsByVendor(_ *wafv2sdk.DescribeManagedProductsByVendorInput) (*wafv2sdk.DescribeManagedProductsByVendorOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DescribeManagedProductsByVendor")
+}
+func (unimplementedWAFv2) DescribeManagedProductsByVendorRequest(_ *wafv2sdk.DescribeManagedProductsByVendorInput) (*request.Request, *wafv2sdk.DescribeManagedProductsByVendorOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DescribeManagedProductsByVendor"), nil
+}
+func (unimplementedWAFv2) DescribeManagedProductsByVendorWithContext(_ context.Context, _ *wafv2sdk.DescribeManagedProductsByVendorInput, _ ...request.Option) (*wafv2sdk.DescribeManagedProductsByVendorOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DescribeManagedProductsByVendor")
+}
+func (unimplementedWAFv2) DescribeManagedRuleGroup(_ *wafv2sdk.DescribeManagedRuleGroupInput) (*wafv2sdk.DescribeManagedRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DescribeManagedRuleGroup")
+}
+func (unimplementedWAFv2) DescribeManagedRuleGroupRequest(_ *wafv2sdk.DescribeManagedRuleGroupInput) (*request.Request, *wafv2sdk.DescribeManagedRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DescribeManagedRuleGroup"), nil
+}
+func (unimplementedWAFv2) DescribeManagedRuleGroupWithContext(_ context.Context, _ *wafv2sdk.DescribeManagedRuleGroupInput, _ ...request.Option) (*wafv2sdk.DescribeManagedRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DescribeManagedRuleGroup")
+}
+func (unimplementedWAFv2) DisassociateWebACL(_ *wafv2sdk.DisassociateWebACLInput) (*wafv2sdk.DisassociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DisassociateWebACL")
+}
+func (unimplementedWAFv2) DisassociateWebACLRequest(_ *wafv2sdk.DisassociateWebACLInput) (*request.Request, *wafv2sdk.DisassociateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "DisassociateWebACL"), nil
+}
+func (unimplementedWAFv2) DisassociateWebACLWithContext(_ context.Context, _ *wafv2sdk.DisassociateWebACLInput, _ ...request.Option) (*wafv2sdk.DisassociateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "DisassociateWebACL")
+}
+func (unimplementedWAFv2) GenerateMobileSdkReleaseUrl(_ *wafv2sdk.GenerateMobileSdkReleaseUrlInput) (*wafv2sdk.GenerateMobileSdkReleaseUrlOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GenerateMobileSdkReleaseUrl")
+}
+func (unimplementedWAFv2) GenerateMobileSdkReleaseUrlRequest(_ *wafv2sdk.GenerateMobileSdkReleaseUrlInput) (*request.Request, *wafv2sdk.GenerateMobileSdkReleaseUrlOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GenerateMobileSdkReleaseUrl"), nil
+}
+func (unimplementedWAFv2) GenerateMobileSdkReleaseUrlWithContext(_ context.Context, _ *wafv2sdk.GenerateMobileSdkReleaseUrlInput, _ ...request.Option) (*wafv2sdk.GenerateMobileSdkReleaseUrlOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GenerateMobileSdkReleaseUrl")
+}
+func (unimplementedWAFv2) GetDecryptedAPIKey(_ *wafv2sdk.GetDecryptedAPIKeyInput) (*wafv2sdk.GetDecryptedAPIKeyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetDecryptedAPIKey")
+}
+func (unimplementedWAFv2) GetDecryptedAPIKeyRequest(_ *wafv2sdk.GetDecryptedAPIKeyInput) (*request.Request, *wafv2sdk.GetDecryptedAPIKeyOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetDecryptedAPIKey"), nil
+}
+func (unimplementedWAFv2) GetDecryptedAPIKeyWithContext(_ context.Context, _ *wafv2sdk.GetDecryptedAPIKeyInput, _ ...request.Option) (*wafv2sdk.GetDecryptedAPIKeyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetDecryptedAPIKey")
+}
+func (unimplementedWAFv2) GetIPSet(_ *wafv2sdk.GetIPSetInput) (*wafv2sdk.GetIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetIPSet")
+}
+func (unimplementedWAFv2) GetIPSetRequest(_ *wafv2sdk.GetIPSetInput) (*request.Request, *wafv2sdk.GetIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetIPSet"), nil
+}
+func (unimplementedWAFv2) GetIPSetWithContext(_ context.Context, _ *wafv2sdk.GetIPSetInput, _ ...request.Option) (*wafv2sdk.GetIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetIPSet")
+}
+func (unimplementedWAFv2) GetLoggingConfiguration(_ *wafv2sdk.GetLoggingConfigurationInput) (*wafv2sdk.GetLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetLoggingConfiguration")
+}
+func (unimplementedWAFv2) GetLoggingConfigurationRequest(_ *wafv2sdk.GetLoggingConfigurationInput) (*request.Request, *wafv2sdk.GetLoggingConfigurationOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetLoggingConfiguration"), nil
+}
+func (unimplementedWAFv2) GetLoggingConfigurationWithContext(_ context.Context, _ *wafv2sdk.GetLoggingConfigurationInput, _ ...request.Option) (*wafv2sdk.GetLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetLoggingConfiguration")
+}
+func (unimplementedWAFv2) GetManagedRuleSet(_ *wafv2sdk.GetManagedRuleSetInput) (*wafv2sdk.GetManagedRuleSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetManagedRuleSet")
+}
+func (unimplementedWAFv2) GetManagedRuleSetRequest(_ *wafv2sdk.GetManagedRuleSetInput) (*request.Request, *wafv2sdk.GetManagedRuleSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetManagedRuleSet"), nil
+}
+func (unimplementedWAFv2) GetManagedRuleSetWithContext(_ context.Context, _ *wafv2sdk.GetManagedRuleSetInput, _ ...request.Option) (*wafv2sdk.GetManagedRuleSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetManagedRuleSet")
+}
+func (unimplementedWAFv2) GetMobileSdkRelease(_ *wafv2sdk.GetMobileSdkReleaseInput) (*wafv2sdk.GetMobileSdkReleaseOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetMobileSdkRelease")
+}
+func (unimplementedWAFv2) GetMobileSdkReleaseRequest(_ *wafv2sdk.GetMobileSdkReleaseInput) (*request.Request, *wafv2sdk.GetMobileSdkReleaseOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetMobileSdkRelease"), nil
+}
+func (unimplementedWAFv2) GetMobileSdkReleaseWithContext(_ context.Context, _ *wafv2sdk.GetMobileSdkReleaseInput, _ ...request.Option) (*wafv2sdk.GetMobileSdkReleaseOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetMobileSdkRelease")
+}
+func (unimplementedWAFv2) GetPermissionPolicy(_ *wafv2sdk.GetPermissionPolicyInput) (*wafv2sdk.GetPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetPermissionPolicy")
+}
+func (unimplementedWAFv2) GetPermissionPolicyRequest(_ *wafv2sdk.GetPermissionPolicyInput) (*request.Request, *wafv2sdk.GetPermissionPolicyOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetPermissionPolicy"), nil
+}
+func (unimplementedWAFv2) GetPermissionPolicyWithContext(_ context.Context, _ *wafv2sdk.GetPermissionPolicyInput, _ ...request.Option) (*wafv2sdk.GetPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetPermissionPolicy")
+}
+func (unimplementedWAFv2) GetRateBasedStatementManagedKeys(_ *wafv2sdk.GetRateBasedStatementManagedKeysInput) (*wafv2sdk.GetRateBasedStatementManagedKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetRateBasedStatementManagedKeys")
+}
+func (unimplementedWAFv2) GetRateBasedStatementManagedKeysRequest(_ *wafv2sdk.GetRateBasedStatementManagedKeysInput) (*request.Request, *wafv2sdk.GetRateBasedStatementManagedKeysOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetRateBasedStatementManagedKeys"), nil
+}
+func (unimplementedWAFv2) GetRateBasedStatementManagedKeysWithContext(_ context.Context, _ *wafv2sdk.GetRateBasedStatementManagedKeysInput, _ ...request.Option) (*wafv2sdk.GetRateBasedStatementManagedKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetRateBasedStatementManagedKeys")
+}
+func (unimplementedWAFv2) GetRegexPatternSet(_ *wafv2sdk.GetRegexPatternSetInput) (*wafv2sdk.GetRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetRegexPatternSet")
+}
+func (unimplementedWAFv2) GetRegexPatternSetRequest(_ *wafv2sdk.GetRegexPatternSetInput) (*request.Request, *wafv2sdk.GetRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetRegexPatternSet"), nil
+}
+func (unimplementedWAFv2) GetRegexPatternSetWithContext(_ context.Context, _ *wafv2sdk.GetRegexPatternSetInput, _ ...request.Option) (*wafv2sdk.GetRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetRegexPatternSet")
+}
+func (unimplementedWAFv2) GetRuleGroup(_ *wafv2sdk.GetRuleGroupInput) (*wafv2sdk.GetRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetRuleGroup")
+}
+func (unimplementedWAFv2) GetRuleGroupRequest(_ *wafv2sdk.GetRuleGroupInput) (*request.Request, *wafv2sdk.GetRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetRuleGroup"), nil
+}
+func (unimplementedWAFv2) GetRuleGroupWithContext(_ context.Context, _ *wafv2sdk.GetRuleGroupInput, _ ...request.Option) (*wafv2sdk.GetRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetRuleGroup")
+}
+func (unimplementedWAFv2) GetSampledRequests(_ *wafv2sdk.GetSampledRequestsInput) (*wafv2sdk.GetSampledRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetSampledRequests")
+}
+func (unimplementedWAFv2) GetSampledRequestsRequest(_ *wafv2sdk.GetSampledRequestsInput) (*request.Request, *wafv2sdk.GetSampledRequestsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetSampledRequests"), nil
+}
+func (unimplementedWAFv2) GetSampledRequestsWithContext(_ context.Context, _ *wafv2sdk.GetSampledRequestsInput, _ ...request.Option) (*wafv2sdk.GetSampledRequestsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetSampledRequests")
+}
+func (unimplementedWAFv2) GetWebACL(_ *wafv2sdk.GetWebACLInput) (*wafv2sdk.GetWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetWebACL")
+}
+func (unimplementedWAFv2) GetWebACLForResource(_ *wafv2sdk.GetWebACLForResourceInput) (*wafv2sdk.GetWebACLForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetWebACLForResource")
+}
+func (unimplementedWAFv2) GetWebACLForResourceRequest(_ *wafv2sdk.GetWebACLForResourceInput) (*request.Request, *wafv2sdk.GetWebACLForResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetWebACLForResource"), nil
+}
+func (unimplementedWAFv2) GetWebACLForResourceWithContext(_ context.Context, _ *wafv2sdk.GetWebACLForResourceInput, _ ...request.Option) (*wafv2sdk.GetWebACLForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetWebACLForResource")
+}
+func (unimplementedWAFv2) GetWebACLRequest(_ *wafv2sdk.GetWebACLInput) (*request.Request, *wafv2sdk.GetWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "GetWebACL"), nil
+}
+func (unimplementedWAFv2) GetWebACLWithContext(_ context.Context, _ *wafv2sdk.GetWebACLInput, _ ...request.Option) (*wafv2sdk.GetWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "GetWebACL")
+}
+func (unimplementedWAFv2) ListAPIKeys(_ *wafv2sdk.ListAPIKeysInput) (*wafv2sdk.ListAPIKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListAPIKeys")
+}
+func (unimplementedWAFv2) ListAPIKeysRequest(_ *wafv2sdk.ListAPIKeysInput) (*request.Request, *wafv2sdk.ListAPIKeysOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListAPIKeys"), nil
+}
+func (unimplementedWAFv2) ListAPIKeysWithContext(_ context.Context, _ *wafv2sdk.ListAPIKeysInput, _ ...request.Option) (*wafv2sdk.ListAPIKeysOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListAPIKeys")
+}
+func (unimplementedWAFv2) ListAvailableManagedRuleGroupVersions(_ *wafv2sdk.ListAvailableManagedRuleGroupVersionsInput) (*wafv2sdk.ListAvailableManagedRuleGroupVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListAvailableManagedRuleGroupVersions")
+}
+func (unimplementedWAFv2) ListAvailableManagedRuleGroupVersionsRequest(_ *wafv2sdk.ListAvailableManagedRuleGroupVersionsInput) (*request.Request, *wafv2sdk.ListAvailableManagedRuleGroupVersionsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListAvailableManagedRuleGroupVersions"), nil
+}
+func (unimplementedWAFv2) ListAvailableManagedRuleGroupVersionsWithContext(_ context.Context, _ *wafv2sdk.ListAvailableManagedRuleGroupVersionsInput, _ ...request.Option) (*wafv2sdk.ListAvailableManagedRuleGroupVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListAvailableManagedRuleGroupVersions")
+}
+func (unimplementedWAFv2) ListAvailableManagedRuleGroups(_ *wafv2sdk.ListAvailableManagedRuleGroupsInput) (*wafv2sdk.ListAvailableManagedRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListAvailableManagedRuleGroups")
+}
+func (unimplementedWAFv2) ListAvailableManagedRuleGroupsRequest(_ *wafv2sdk.ListAvailableManagedRuleGroupsInput) (*request.Request, *wafv2sdk.ListAvailableManagedRuleGroupsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListAvailableManagedRuleGroups"), nil
+}
+func (unimplementedWAFv2) ListAvailableManagedRuleGroupsWithContext(_ context.Context, _ *wafv2sdk.ListAvailableManagedRuleGroupsInput, _ ...request.Option) (*wafv2sdk.ListAvailableManagedRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListAvailableManagedRuleGroups")
+}
+func (unimplementedWAFv2) ListIPSets(_ *wafv2sdk.ListIPSetsInput) (*wafv2sdk.ListIPSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListIPSets")
+}
+func (unimplementedWAFv2) ListIPSetsRequest(_ *wafv2sdk.ListIPSetsInput) (*request.Request, *wafv2sdk.ListIPSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListIPSets"), nil
+}
+func (unimplementedWAFv2) ListIPSetsWithContext(_ context.Context, _ *wafv2sdk.ListIPSetsInput, _ ...request.Option) (*wafv2sdk.ListIPSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListIPSets")
+}
+func (unimplementedWAFv2) ListLoggingConfigurations(_ *wafv2sdk.ListLoggingConfigurationsInput) (*wafv2sdk.ListLoggingConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListLoggingConfigurations")
+}
+func (unimplementedWAFv2) ListLoggingConfigurationsRequest(_ *wafv2sdk.ListLoggingConfigurationsInput) (*request.Request, *wafv2sdk.ListLoggingConfigurationsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListLoggingConfigurations"), nil
+}
+func (unimplementedWAFv2) ListLoggingConfigurationsWithContext(_ context.Context, _ *wafv2sdk.ListLoggingConfigurationsInput, _ ...request.Option) (*wafv2sdk.ListLoggingConfigurationsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListLoggingConfigurations")
+}
+func (unimplementedWAFv2) ListManagedRuleSets(_ *wafv2sdk.ListManagedRuleSetsInput) (*wafv2sdk.ListManagedRuleSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListManagedRuleSets")
+}
+func (unimplementedWAFv2) ListManagedRuleSetsRequest(_ *wafv2sdk.ListManagedRuleSetsInput) (*request.Request, *wafv2sdk.ListManagedRuleSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListManagedRuleSets"), nil
+}
+func (unimplementedWAFv2) ListManagedRuleSetsWithContext(_ context.Context, _ *wafv2sdk.ListManagedRuleSetsInput, _ ...request.Option) (*wafv2sdk.ListManagedRuleSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListManagedRuleSets")
+}
+func (unimplementedWAFv2) ListMobileSdkReleases(_ *wafv2sdk.ListMobileSdkReleasesInput) (*wafv2sdk.ListMobileSdkReleasesOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListMobileSdkReleases")
+}
+func (unimplementedWAFv2) ListMobileSdkReleasesRequest(_ *wafv2sdk.ListMobileSdkReleasesInput) (*request.Request, *wafv2sdk.ListMobileSdkReleasesOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListMobileSdkReleases"), nil
+}
+func (unimplementedWAFv2) ListMobileSdkReleasesWithContext(_ context.Context, _ *wafv2sdk.ListMobileSdkReleasesInput, _ ...request.Option) (*wafv2sdk.ListMobileSdkReleasesOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListMobileSdkReleases")
+}
+func (unimplementedWAFv2) ListRegexPatternSets(_ *wafv2sdk.ListRegexPatternSetsInput) (*wafv2sdk.ListRegexPatternSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListRegexPatternSets")
+}
+func (unimplementedWAFv2) ListRegexPatternSetsRequest(_ *wafv2sdk.ListRegexPatternSetsInput) (*request.Request, *wafv2sdk.ListRegexPatternSetsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListRegexPatternSets"), nil
+}
+func (unimplementedWAFv2) ListRegexPatternSetsWithContext(_ context.Context, _ *wafv2sdk.ListRegexPatternSetsInput, _ ...request.Option) (*wafv2sdk.ListRegexPatternSetsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListRegexPatternSets")
+}
+func (unimplementedWAFv2) ListResourcesForWebACL(_ *wafv2sdk.ListResourcesForWebACLInput) (*wafv2sdk.ListResourcesForWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListResourcesForWebACL")
+}
+func (unimplementedWAFv2) ListResourcesForWebACLRequest(_ *wafv2sdk.ListResourcesForWebACLInput) (*request.Request, *wafv2sdk.ListResourcesForWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListResourcesForWebACL"), nil
+}
+func (unimplementedWAFv2) ListResourcesForWebACLWithContext(_ context.Context, _ *wafv2sdk.ListResourcesForWebACLInput, _ ...request.Option) (*wafv2sdk.ListResourcesForWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListResourcesForWebACL")
+}
+func (unimplementedWAFv2) ListRuleGroups(_ *wafv2sdk.ListRuleGroupsInput) (*wafv2sdk.ListRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListRuleGroups")
+}
+func (unimplementedWAFv2) ListRuleGroupsRequest(_ *wafv2sdk.ListRuleGroupsInput) (*request.Request, *wafv2sdk.ListRuleGroupsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListRuleGroups"), nil
+}
+func (unimplementedWAFv2) ListRuleGroupsWithContext(_ context.Context, _ *wafv2sdk.ListRuleGroupsInput, _ ...request.Option) (*wafv2sdk.ListRuleGroupsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListRuleGroups")
+}
+func (unimplementedWAFv2) ListTagsForResource(_ *wafv2sdk.ListTagsForResourceInput) (*wafv2sdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListTagsForResource")
+}
+func (unimplementedWAFv2) ListTagsForResourceRequest(_ *wafv2sdk.ListTagsForResourceInput) (*request.Request, *wafv2sdk.ListTagsForResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListTagsForResource"), nil
+}
+func (unimplementedWAFv2) ListTagsForResourceWithContext(_ context.Context, _ *wafv2sdk.ListTagsForResourceInput, _ ...request.Option) (*wafv2sdk.ListTagsForResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListTagsForResource")
+}
+func (unimplementedWAFv2) ListWebACLs(_ *wafv2sdk.ListWebACLsInput) (*wafv2sdk.ListWebACLsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListWebACLs")
+}
+func (unimplementedWAFv2) ListWebACLsRequest(_ *wafv2sdk.ListWebACLsInput) (*request.Request, *wafv2sdk.ListWebACLsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "ListWebACLs"), nil
+}
+func (unimplementedWAFv2) ListWebACLsWithContext(_ context.Context, _ *wafv2sdk.ListWebACLsInput, _ ...request.Option) (*wafv2sdk.ListWebACLsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "ListWebACLs")
+}
+func (unimplementedWAFv2) PutLoggingConfiguration(_ *wafv2sdk.PutLoggingConfigurationInput) (*wafv2sdk.PutLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "PutLoggingConfiguration")
+}
+func (unimplementedWAFv2) PutLoggingConfigurationRequest(_ *wafv2sdk.PutLoggingConfigurationInput) (*request.Request, *wafv2sdk.PutLoggingConfigurationOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "PutLoggingConfiguration"), nil
+}
+func (unimplementedWAFv2) PutLoggingConfigurationWithContext(_ context.Context, _ *wafv2sdk.PutLoggingConfigurationInput, _ ...request.Option) (*wafv2sdk.PutLoggingConfigurationOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "PutLoggingConfiguration")
+}
+func (unimplementedWAFv2) PutManagedRuleSetVersions(_ *wafv2sdk.PutManagedRuleSetVersionsInput) (*wafv2sdk.PutManagedRuleSetVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "PutManagedRuleSetVersions")
+}
+func (unimplementedWAFv2) PutManagedRuleSetVersionsRequest(_ *wafv2sdk.PutManagedRuleSetVersionsInput) (*request.Request, *wafv2sdk.PutManagedRuleSetVersionsOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "PutManagedRuleSetVersions"), nil
+}
+func (unimplementedWAFv2) PutManagedRuleSetVersionsWithContext(_ context.Context, _ *wafv2sdk.PutManagedRuleSetVersionsInput, _ ...request.Option) (*wafv2sdk.PutManagedRuleSetVersionsOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "PutManagedRuleSetVersions")
+}
+func (unimplementedWAFv2) PutPermissionPolicy(_ *wafv2sdk.PutPermissionPolicyInput) (*wafv2sdk.PutPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "PutPermissionPolicy")
+}
+func (unimplementedWAFv2) PutPermissionPolicyRequest(_ *wafv2sdk.PutPermissionPolicyInput) (*request.Request, *wafv2sdk.PutPermissionPolicyOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "PutPermissionPolicy"), nil
+}
+func (unimplementedWAFv2) PutPermissionPolicyWithContext(_ context.Context, _ *wafv2sdk.PutPermissionPolicyInput, _ ...request.Option) (*wafv2sdk.PutPermissionPolicyOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "PutPermissionPolicy")
+}
+func (unimplementedWAFv2) TagResource(_ *wafv2sdk.TagResourceInput) (*wafv2sdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "TagResource")
+}
+func (unimplementedWAFv2) TagResourceRequest(_ *wafv2sdk.TagResourceInput) (*request.Request, *wafv2sdk.TagResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "TagResource"), nil
+}
+func (unimplementedWAFv2) TagResourceWithContext(_ context.Context, _ *wafv2sdk.TagResourceInput, _ ...request.Option) (*wafv2sdk.TagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "TagResource")
+}
+func (unimplementedWAFv2) UntagResource(_ *wafv2sdk.UntagResourceInput) (*wafv2sdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UntagResource")
+}
+func (unimplementedWAFv2) UntagResourceRequest(_ *wafv2sdk.UntagResourceInput) (*request.Request, *wafv2sdk.UntagResourceOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "UntagResource"), nil
+}
+func (unimplementedWAFv2) UntagResourceWithContext(_ context.Context, _ *wafv2sdk.UntagResourceInput, _ ...request.Option) (*wafv2sdk.UntagResourceOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UntagResource")
+}
+func (unimplementedWAFv2) UpdateIPSet(_ *wafv2sdk.UpdateIPSetInput) (*wafv2sdk.UpdateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateIPSet")
+}
+func (unimplementedWAFv2) UpdateIPSetRequest(_ *wafv2sdk.UpdateIPSetInput) (*request.Request, *wafv2sdk.UpdateIPSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "UpdateIPSet"), nil
+}
+func (unimplementedWAFv2) UpdateIPSetWithContext(_ context.Context, _ *wafv2sdk.UpdateIPSetInput, _ ...request.Option) (*wafv2sdk.UpdateIPSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateIPSet")
+}
+func (unimplementedWAFv2) UpdateManagedRuleSetVersionExpiryDate(_ *wafv2sdk.UpdateManagedRuleSetVersionExpiryDateInput) (*wafv2sdk.UpdateManagedRuleSetVersionExpiryDateOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateManagedRuleSetVersionExpiryDate")
+}
+func (unimplementedWAFv2) UpdateManagedRuleSetVersionExpiryDateRequest(_ *wafv2sdk.UpdateManagedRuleSetVersionExpiryDateInput) (*request.Request, *wafv2sdk.UpdateManagedRuleSetVersionExpiryDateOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "UpdateManagedRuleSetVersionExpiryDate"), nil
+}
+func (unimplementedWAFv2) UpdateManagedRuleSetVersionExpiryDateWithContext(_ context.Context, _ *wafv2sdk.UpdateManagedRuleSetVersionExpiryDateInput, _ ...request.Option) (*wafv2sdk.UpdateManagedRuleSetVersionExpiryDateOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateManagedRuleSetVersionExpiryDate")
+}
+func (unimplementedWAFv2) UpdateRegexPatternSet(_ *wafv2sdk.UpdateRegexPatternSetInput) (*wafv2sdk.UpdateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateRegexPatternSet")
+}
+func (unimplementedWAFv2) UpdateRegexPatternSetRequest(_ *wafv2sdk.UpdateRegexPatternSetInput) (*request.Request, *wafv2sdk.UpdateRegexPatternSetOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "UpdateRegexPatternSet"), nil
+}
+func (unimplementedWAFv2) UpdateRegexPatternSetWithContext(_ context.Context, _ *wafv2sdk.UpdateRegexPatternSetInput, _ ...request.Option) (*wafv2sdk.UpdateRegexPatternSetOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateRegexPatternSet")
+}
+func (unimplementedWAFv2) UpdateRuleGroup(_ *wafv2sdk.UpdateRuleGroupInput) (*wafv2sdk.UpdateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateRuleGroup")
+}
+func (unimplementedWAFv2) UpdateRuleGroupRequest(_ *wafv2sdk.UpdateRuleGroupInput) (*request.Request, *wafv2sdk.UpdateRuleGroupOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "UpdateRuleGroup"), nil
+}
+func (unimplementedWAFv2) UpdateRuleGroupWithContext(_ context.Context, _ *wafv2sdk.UpdateRuleGroupInput, _ ...request.Option) (*wafv2sdk.UpdateRuleGroupOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateRuleGroup")
+}
+func (unimplementedWAFv2) UpdateWebACL(_ *wafv2sdk.UpdateWebACLInput) (*wafv2sdk.UpdateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateWebACL")
+}
+func (unimplementedWAFv2) UpdateWebACLRequest(_ *wafv2sdk.UpdateWebACLInput) (*request.Request, *wafv2sdk.UpdateWebACLOutput) {
+	return newNotImplementedRequest(ServiceWAFv2, "UpdateWebACL"), nil
+}
+func (unimplementedWAFv2) UpdateWebACLWithContext(_ context.Context, _ *wafv2sdk.UpdateWebACLInput, _ ...request.Option) (*wafv2sdk.UpdateWebACLOutput, error) {
+	return nil, newNotImplementedError(ServiceWAFv2, "UpdateWebACL")
+}
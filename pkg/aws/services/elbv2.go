@@ -25,6 +25,9 @@ type ELBV2 interface {
 
 	// wrapper to DescribeRulesWithContext API, which aggregates paged results into list.
 	DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error)
+
+	// wrapper to DescribeTrustStoresPagesWithContext API, which aggregates paged results into list.
+	DescribeTrustStoresAsList(ctx context.Context, input *elbv2.DescribeTrustStoresInput) ([]*elbv2.TrustStore, error)
 }
 
 // NewELBV2 constructs new ELBV2 implementation.
@@ -105,3 +108,14 @@ func (c *defaultELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2.Des
 	}
 	return rules, p.Err()
 }
+
+func (c *defaultELBV2) DescribeTrustStoresAsList(ctx context.Context, input *elbv2.DescribeTrustStoresInput) ([]*elbv2.TrustStore, error) {
+	var result []*elbv2.TrustStore
+	if err := c.DescribeTrustStoresPagesWithContext(ctx, input, func(output *elbv2.DescribeTrustStoresOutput, _ bool) bool {
+		result = append(result, output.TrustStores...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -3,19 +3,22 @@ package backend
 import (
 	"context"
 	"fmt"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var ErrNotFound = errors.New("backend not found")
 
-// TODO: for pod endpoints, we currently rely on endpoints events, we might change to use pod events directly in the future.
+// TODO: for pod endpoints, we currently rely on endpointSlices(or endpoints) events, we might change to use pod events directly in the future.
 // under current implementation with pod readinessGate enabled, an unready endpoint but not match our inclusionCriteria won't be registered,
 // and it won't turn ready due to blocked by readinessGate, and no future endpoint events will trigger.
 // We solve this by requeue the TGB if unready endpoints have the potential to be ready if reconcile in later time.
@@ -33,11 +36,13 @@ type EndpointResolver interface {
 }
 
 // NewDefaultEndpointResolver constructs new defaultEndpointResolver
-func NewDefaultEndpointResolver(k8sClient client.Client, podInfoRepo k8s.PodInfoRepo, logger logr.Logger) *defaultEndpointResolver {
+// when endpointSliceEnabled is true, pod endpoints will be resolved from EndpointSlices instead of core Endpoints.
+func NewDefaultEndpointResolver(k8sClient client.Client, podInfoRepo k8s.PodInfoRepo, endpointSliceEnabled bool, logger logr.Logger) *defaultEndpointResolver {
 	return &defaultEndpointResolver{
-		k8sClient:   k8sClient,
-		podInfoRepo: podInfoRepo,
-		logger:      logger,
+		k8sClient:            k8sClient,
+		podInfoRepo:          podInfoRepo,
+		endpointSliceEnabled: endpointSliceEnabled,
+		logger:               logger,
 	}
 }
 
@@ -45,9 +50,10 @@ var _ EndpointResolver = &defaultEndpointResolver{}
 
 // default implementation for EndpointResolver
 type defaultEndpointResolver struct {
-	k8sClient   client.Client
-	podInfoRepo k8s.PodInfoRepo
-	logger      logr.Logger
+	k8sClient            client.Client
+	podInfoRepo          k8s.PodInfoRepo
+	endpointSliceEnabled bool
+	logger               logr.Logger
 }
 
 func (r *defaultEndpointResolver) ResolvePodEndpoints(ctx context.Context, svcKey types.NamespacedName, port intstr.IntOrString,
@@ -59,7 +65,15 @@ func (r *defaultEndpointResolver) ResolvePodEndpoints(ctx context.Context, svcKe
 	if err != nil {
 		return nil, false, err
 	}
+	if r.endpointSliceEnabled {
+		return r.resolvePodEndpointsViaEndpointSlices(ctx, svc, svcPort, resolveOpts)
+	}
+	return r.resolvePodEndpointsViaEndpoints(ctx, svc, svcPort, resolveOpts)
+}
 
+// resolvePodEndpointsViaEndpoints resolves pod endpoints from the core Endpoints object of service.
+func (r *defaultEndpointResolver) resolvePodEndpointsViaEndpoints(ctx context.Context, svc *corev1.Service, svcPort corev1.ServicePort,
+	resolveOpts EndpointResolveOptions) ([]PodEndpoint, bool, error) {
 	epsKey := k8s.NamespacedName(svc) // k8s Endpoints have same name as k8s Service
 	eps := &corev1.Endpoints{}
 	if err := r.k8sClient.Get(ctx, epsKey, eps); err != nil {
@@ -79,40 +93,27 @@ func (r *defaultEndpointResolver) ResolvePodEndpoints(ctx context.Context, svcKe
 			}
 
 			for _, epAddr := range epSubset.Addresses {
-				if epAddr.TargetRef == nil || epAddr.TargetRef.Kind != "Pod" {
-					continue
-				}
-				pod, exists, err := r.findPodByReference(ctx, svc.Namespace, *epAddr.TargetRef)
+				endpoint, included, _, err := r.resolvePodEndpoint(ctx, svc.Namespace, epAddr.TargetRef, epAddr.IP, epPort.Port, true, resolveOpts)
 				if err != nil {
 					return nil, false, err
 				}
-				if !exists {
-					return nil, false, errors.New("couldn't find podInfo for ready endpoint")
+				if included {
+					endpoints = append(endpoints, endpoint)
 				}
-				endpoints = append(endpoints, buildPodEndpoint(pod, epAddr, epPort))
 			}
 
 			if len(resolveOpts.PodReadinessGates) != 0 {
 				for _, epAddr := range epSubset.NotReadyAddresses {
-					if epAddr.TargetRef == nil || epAddr.TargetRef.Kind != "Pod" {
-						continue
-					}
-					pod, exists, err := r.findPodByReference(ctx, svc.Namespace, *epAddr.TargetRef)
+					endpoint, included, potentialReady, err := r.resolvePodEndpoint(ctx, svc.Namespace, epAddr.TargetRef, epAddr.IP, epPort.Port, false, resolveOpts)
 					if err != nil {
 						return nil, false, err
 					}
-					if !exists {
+					if potentialReady {
 						containsPotentialReadyEndpoints = true
-						continue
 					}
-					if !pod.HasAnyOfReadinessGates(resolveOpts.PodReadinessGates) {
-						continue
+					if included {
+						endpoints = append(endpoints, endpoint)
 					}
-					if !pod.IsContainersReady() {
-						containsPotentialReadyEndpoints = true
-						continue
-					}
-					endpoints = append(endpoints, buildPodEndpoint(pod, epAddr, epPort))
 				}
 			}
 		}
@@ -121,6 +122,115 @@ func (r *defaultEndpointResolver) ResolvePodEndpoints(ctx context.Context, svcKe
 	return endpoints, containsPotentialReadyEndpoints, nil
 }
 
+// resolvePodEndpointsViaEndpointSlices resolves pod endpoints by aggregating all EndpointSlices of service.
+// only EndpointSlices of the requested IP family are considered, which defaults to service's primary IP family to match the behavior of core Endpoints.
+func (r *defaultEndpointResolver) resolvePodEndpointsViaEndpointSlices(ctx context.Context, svc *corev1.Service, svcPort corev1.ServicePort,
+	resolveOpts EndpointResolveOptions) ([]PodEndpoint, bool, error) {
+	epSliceList := &discovery.EndpointSliceList{}
+	if err := r.k8sClient.List(ctx, epSliceList,
+		client.InNamespace(svc.Namespace),
+		client.MatchingLabels{discovery.LabelServiceName: svc.Name}); err != nil {
+		return nil, false, err
+	}
+	if len(epSliceList.Items) == 0 {
+		return nil, false, fmt.Errorf("%w: endpointSlices for service %v not found", ErrNotFound, k8s.NamespacedName(svc))
+	}
+
+	addressType := computeEndpointSliceAddressType(svc, resolveOpts.PodEndpointIPFamily)
+	containsPotentialReadyEndpoints := false
+	var endpoints []PodEndpoint
+	// the same endpoint can show up in multiple slices while the EndpointSlice controller is rebalancing slices.
+	resolvedEndpointKeys := sets.NewString()
+	for _, epSlice := range epSliceList.Items {
+		if epSlice.AddressType != addressType {
+			continue
+		}
+		for _, epPort := range epSlice.Ports {
+			// servicePort.Name is optional if there is only one port
+			if svcPort.Name != "" && svcPort.Name != awssdk.StringValue(epPort.Name) {
+				continue
+			}
+			if epPort.Port == nil {
+				continue
+			}
+
+			for _, ep := range epSlice.Endpoints {
+				if len(ep.Addresses) == 0 {
+					continue
+				}
+				// terminating endpoints are excluded by default, which matches the behavior of core Endpoints.
+				terminating := isEndpointTerminating(ep)
+				if terminating && !resolveOpts.IncludeTerminatingEndpoints {
+					continue
+				}
+				ready := isEndpointReady(ep)
+				if !terminating && !ready && len(resolveOpts.PodReadinessGates) == 0 {
+					continue
+				}
+				// addresses of an endpoint are fungible per EndpointSlice API, thus only the first one is used.
+				epAddr := ep.Addresses[0]
+				endpointKey := fmt.Sprintf("%v:%v", epAddr, *epPort.Port)
+				if resolvedEndpointKeys.Has(endpointKey) {
+					continue
+				}
+
+				var endpoint PodEndpoint
+				var included, potentialReady bool
+				var err error
+				if terminating {
+					endpoint, included, err = r.resolveTerminatingPodEndpoint(ctx, svc.Namespace, ep.TargetRef, epAddr, *epPort.Port)
+				} else {
+					endpoint, included, potentialReady, err = r.resolvePodEndpoint(ctx, svc.Namespace, ep.TargetRef, epAddr, *epPort.Port, ready, resolveOpts)
+				}
+				if err != nil {
+					return nil, false, err
+				}
+				if potentialReady {
+					containsPotentialReadyEndpoints = true
+				}
+				if included {
+					endpoint.Zone = awssdk.StringValue(ep.Zone)
+					endpoint.ZoneHints = extractEndpointZoneHints(ep)
+					endpoints = append(endpoints, endpoint)
+					resolvedEndpointKeys.Insert(endpointKey)
+				}
+			}
+		}
+	}
+
+	return endpoints, containsPotentialReadyEndpoints, nil
+}
+
+// resolvePodEndpoint resolves a single endpoint address backed by pod.
+// returns the resolved endpoint, whether it should be included, and whether it can potentially turn ready in future reconciles.
+func (r *defaultEndpointResolver) resolvePodEndpoint(ctx context.Context, namespace string, targetRef *corev1.ObjectReference, ip string, port int32,
+	ready bool, resolveOpts EndpointResolveOptions) (PodEndpoint, bool, bool, error) {
+	if targetRef == nil || targetRef.Kind != "Pod" {
+		return PodEndpoint{}, false, false, nil
+	}
+	pod, exists, err := r.findPodByReference(ctx, namespace, *targetRef)
+	if err != nil {
+		return PodEndpoint{}, false, false, err
+	}
+	if ready {
+		if !exists {
+			return PodEndpoint{}, false, false, errors.New("couldn't find podInfo for ready endpoint")
+		}
+		return buildPodEndpoint(pod, ip, port), true, false, nil
+	}
+
+	if !exists {
+		return PodEndpoint{}, false, true, nil
+	}
+	if !pod.HasAnyOfReadinessGates(resolveOpts.PodReadinessGates) {
+		return PodEndpoint{}, false, false, nil
+	}
+	if !pod.IsContainersReady() {
+		return PodEndpoint{}, false, true, nil
+	}
+	return buildPodEndpoint(pod, ip, port), true, false, nil
+}
+
 func (r *defaultEndpointResolver) ResolveNodePortEndpoints(ctx context.Context, svcKey types.NamespacedName, port intstr.IntOrString, opts ...EndpointResolveOption) ([]NodePortEndpoint, error) {
 	resolveOpts := defaultEndpointResolveOptions()
 	resolveOpts.ApplyOptions(opts)
@@ -175,14 +285,70 @@ func (r *defaultEndpointResolver) findPodByReference(ctx context.Context, namesp
 	return r.podInfoRepo.Get(ctx, podKey)
 }
 
-func buildPodEndpoint(pod k8s.PodInfo, epAddr corev1.EndpointAddress, epPort corev1.EndpointPort) PodEndpoint {
+// resolveTerminatingPodEndpoint resolves a single terminating endpoint address backed by pod.
+// returns the resolved endpoint and whether it should be included.
+func (r *defaultEndpointResolver) resolveTerminatingPodEndpoint(ctx context.Context, namespace string, targetRef *corev1.ObjectReference, ip string, port int32) (PodEndpoint, bool, error) {
+	if targetRef == nil || targetRef.Kind != "Pod" {
+		return PodEndpoint{}, false, nil
+	}
+	pod, exists, err := r.findPodByReference(ctx, namespace, *targetRef)
+	if err != nil {
+		return PodEndpoint{}, false, err
+	}
+	// the pod might already be gone, in which case there is nothing to signal.
+	if !exists {
+		return PodEndpoint{}, false, nil
+	}
+	endpoint := buildPodEndpoint(pod, ip, port)
+	endpoint.Terminating = true
+	return endpoint, true, nil
+}
+
+func buildPodEndpoint(pod k8s.PodInfo, ip string, port int32) PodEndpoint {
 	return PodEndpoint{
-		IP:   epAddr.IP,
-		Port: int64(epPort.Port),
+		IP:   ip,
+		Port: int64(port),
 		Pod:  pod,
 	}
 }
 
+// computeEndpointSliceAddressType computes the EndpointSlice addressType for ipFamily, an empty ipFamily means service's primary IP family.
+func computeEndpointSliceAddressType(svc *corev1.Service, ipFamily corev1.IPFamily) discovery.AddressType {
+	if ipFamily == "" && len(svc.Spec.IPFamilies) != 0 {
+		ipFamily = svc.Spec.IPFamilies[0]
+	}
+	if ipFamily == corev1.IPv6Protocol {
+		return discovery.AddressTypeIPv6
+	}
+	return discovery.AddressTypeIPv4
+}
+
+// isEndpointReady checks whether endpoint in EndpointSlice is ready.
+// per EndpointSlice API, an unset ready condition should be interpreted as true, unless the endpoint is explicitly not serving.
+func isEndpointReady(ep discovery.Endpoint) bool {
+	if ep.Conditions.Ready != nil {
+		return *ep.Conditions.Ready
+	}
+	return ep.Conditions.Serving == nil || *ep.Conditions.Serving
+}
+
+// isEndpointTerminating checks whether endpoint in EndpointSlice is terminating.
+func isEndpointTerminating(ep discovery.Endpoint) bool {
+	return ep.Conditions.Terminating != nil && *ep.Conditions.Terminating
+}
+
+// extractEndpointZoneHints extracts the zones hinted for endpoint in EndpointSlice.
+func extractEndpointZoneHints(ep discovery.Endpoint) []string {
+	if ep.Hints == nil || len(ep.Hints.ForZones) == 0 {
+		return nil
+	}
+	zoneHints := make([]string, 0, len(ep.Hints.ForZones))
+	for _, forZone := range ep.Hints.ForZones {
+		zoneHints = append(zoneHints, forZone.Name)
+	}
+	return zoneHints
+}
+
 func buildNodePortEndpoint(node *corev1.Node, instanceID string, nodePort int32) NodePortEndpoint {
 	return NodePortEndpoint{
 		InstanceID: instanceID,
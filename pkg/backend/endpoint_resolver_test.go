@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -1099,3 +1101,501 @@ func Test_defaultEndpointResolver_ResolveNodePortEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultEndpointResolver_ResolvePodEndpoints_viaEndpointSlices(t *testing.T) {
+	testNS := "test-ns"
+	readyConditions := []corev1.PodCondition{
+		{
+			Type:   corev1.PodReady,
+			Status: corev1.ConditionTrue,
+		},
+		{
+			Type:   corev1.ContainersReady,
+			Status: corev1.ConditionTrue,
+		},
+	}
+	pod1 := k8s.PodInfo{
+		Key:        types.NamespacedName{Namespace: testNS, Name: "pod-1"},
+		UID:        "pod-uuid-1",
+		Conditions: readyConditions,
+		PodIP:      "192.168.1.1",
+	}
+	pod2 := k8s.PodInfo{
+		Key:        types.NamespacedName{Namespace: testNS, Name: "pod-2"},
+		UID:        "pod-uuid-2",
+		Conditions: readyConditions,
+		PodIP:      "192.168.1.2",
+	}
+	pod3 := k8s.PodInfo{
+		Key: types.NamespacedName{Namespace: testNS, Name: "pod-3"},
+		UID: "pod-uuid-3",
+		ReadinessGates: []corev1.PodReadinessGate{
+			{
+				ConditionType: "custom-condition",
+			},
+		},
+		Conditions: []corev1.PodCondition{
+			{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionFalse,
+			},
+			{
+				Type:   corev1.ContainersReady,
+				Status: corev1.ConditionTrue,
+			},
+		},
+		PodIP: "192.168.1.3",
+	}
+	pod4 := k8s.PodInfo{
+		Key:        types.NamespacedName{Namespace: testNS, Name: "pod-4"},
+		UID:        "pod-uuid-4",
+		Conditions: readyConditions,
+		PodIP:      "192.168.1.4",
+	}
+	svc1 := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      "svc-1",
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{
+					Name: "http",
+					Port: 80,
+				},
+				{
+					Name: "https",
+					Port: 443,
+				},
+			},
+		},
+	}
+	buildEndpoint := func(pod k8s.PodInfo, ready bool, terminating bool, zone string) discovery.Endpoint {
+		return discovery.Endpoint{
+			Addresses: []string{pod.PodIP},
+			Conditions: discovery.EndpointConditions{
+				Ready:       awssdk.Bool(ready),
+				Serving:     awssdk.Bool(ready || terminating),
+				Terminating: awssdk.Bool(terminating),
+			},
+			TargetRef: &corev1.ObjectReference{
+				Kind:      "Pod",
+				Namespace: pod.Key.Namespace,
+				Name:      pod.Key.Name,
+			},
+			Zone: awssdk.String(zone),
+		}
+	}
+	httpPorts := []discovery.EndpointPort{
+		{
+			Name: awssdk.String("http"),
+			Port: awssdk.Int32(8080),
+		},
+	}
+	epSlice1A := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      "svc-1-abcde",
+			Labels:    map[string]string{discovery.LabelServiceName: "svc-1"},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Ports:       httpPorts,
+		Endpoints: []discovery.Endpoint{
+			buildEndpoint(pod1, true, false, "us-west-2a"),
+			buildEndpoint(pod3, false, false, "us-west-2b"),
+		},
+	}
+	epSlice1B := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      "svc-1-fghij",
+			Labels:    map[string]string{discovery.LabelServiceName: "svc-1"},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Ports:       httpPorts,
+		Endpoints: []discovery.Endpoint{
+			buildEndpoint(pod1, true, false, "us-west-2a"),
+			buildEndpoint(pod4, false, true, "us-west-2c"),
+			func() discovery.Endpoint {
+				ep := buildEndpoint(pod2, true, false, "us-west-2b")
+				ep.Hints = &discovery.EndpointHints{
+					ForZones: []discovery.ForZone{{Name: "us-west-2b"}},
+				}
+				return ep
+			}(),
+		},
+	}
+	epSlice1IPv6 := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      "svc-1-klmno",
+			Labels:    map[string]string{discovery.LabelServiceName: "svc-1"},
+		},
+		AddressType: discovery.AddressTypeIPv6,
+		Ports:       httpPorts,
+		Endpoints: []discovery.Endpoint{
+			{
+				Addresses:  []string{"2600:1f14::1"},
+				Conditions: discovery.EndpointConditions{Ready: awssdk.Bool(true)},
+				TargetRef: &corev1.ObjectReference{
+					Kind:      "Pod",
+					Namespace: pod1.Key.Namespace,
+					Name:      pod1.Key.Name,
+				},
+			},
+		},
+	}
+	epSliceOtherSvc := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      "svc-2-abcde",
+			Labels:    map[string]string{discovery.LabelServiceName: "svc-2"},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Ports:       httpPorts,
+		Endpoints: []discovery.Endpoint{
+			buildEndpoint(pod4, true, false, "us-west-2c"),
+		},
+	}
+
+	type podInfoRepoGetCall struct {
+		key    types.NamespacedName
+		pod    k8s.PodInfo
+		exists bool
+		err    error
+	}
+	type env struct {
+		services       []*corev1.Service
+		endpointSlices []*discovery.EndpointSlice
+	}
+	type fields struct {
+		podInfoRepoGetCalls []podInfoRepoGetCall
+	}
+	type args struct {
+		svcKey types.NamespacedName
+		port   intstr.IntOrString
+		opts   []EndpointResolveOption
+	}
+	tests := []struct {
+		name                                string
+		env                                 env
+		fields                              fields
+		args                                args
+		want                                []PodEndpoint
+		wantContainsPotentialReadyEndpoints bool
+		wantErr                             error
+	}{
+		{
+			name: "ready endpoints are aggregated across endpointSlices",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSlice1A, epSlice1B, epSlice1IPv6, epSliceOtherSvc},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+					{
+						key:    pod2.Key,
+						pod:    pod2,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   nil,
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.1",
+					Port: 8080,
+					Pod:  pod1,
+					Zone: "us-west-2a",
+				},
+				{
+					IP:        "192.168.1.2",
+					Port:      8080,
+					Pod:       pod2,
+					Zone:      "us-west-2b",
+					ZoneHints: []string{"us-west-2b"},
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
+		{
+			name: "endpoints of requested IP family instead of service's primary IP family",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSlice1A, epSlice1B, epSlice1IPv6, epSliceOtherSvc},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithPodEndpointIPFamily(corev1.IPv6Protocol)},
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "2600:1f14::1",
+					Port: 8080,
+					Pod:  pod1,
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
+		{
+			name: "unready endpoints only be included if it have readinessGate and containerReady",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSlice1A, epSlice1B},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+					{
+						key:    pod2.Key,
+						pod:    pod2,
+						exists: true,
+					},
+					{
+						key:    pod3.Key,
+						pod:    pod3,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithPodReadinessGate("custom-condition")},
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.1",
+					Port: 8080,
+					Pod:  pod1,
+					Zone: "us-west-2a",
+				},
+				{
+					IP:        "192.168.1.2",
+					Port:      8080,
+					Pod:       pod2,
+					Zone:      "us-west-2b",
+					ZoneHints: []string{"us-west-2b"},
+				},
+				{
+					IP:   "192.168.1.3",
+					Port: 8080,
+					Pod:  pod3,
+					Zone: "us-west-2b",
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
+		{
+			name: "unready endpoints without podInfo can potentially turn ready",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSlice1A},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+					{
+						key:    pod3.Key,
+						exists: false,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithPodReadinessGate("custom-condition")},
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.1",
+					Port: 8080,
+					Pod:  pod1,
+					Zone: "us-west-2a",
+				},
+			},
+			wantContainsPotentialReadyEndpoints: true,
+		},
+		{
+			name: "endpointSlices with mismatched port are ignored",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSlice1A, epSlice1B},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("https"),
+				opts:   nil,
+			},
+			want:                                nil,
+			wantContainsPotentialReadyEndpoints: false,
+		},
+		{
+			name: "ready endpoint without podInfo should error",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSlice1A},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						exists: false,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   nil,
+			},
+			wantErr: errors.New("couldn't find podInfo for ready endpoint"),
+		},
+		{
+			name: "terminating endpoints are included when requested",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSlice1B},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+					{
+						key:    pod2.Key,
+						pod:    pod2,
+						exists: true,
+					},
+					{
+						key:    pod4.Key,
+						pod:    pod4,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithTerminatingEndpoints()},
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.1",
+					Port: 8080,
+					Pod:  pod1,
+					Zone: "us-west-2a",
+				},
+				{
+					IP:        "192.168.1.2",
+					Port:      8080,
+					Pod:       pod2,
+					Zone:      "us-west-2b",
+					ZoneHints: []string{"us-west-2b"},
+				},
+				{
+					IP:          "192.168.1.4",
+					Port:        8080,
+					Pod:         pod4,
+					Zone:        "us-west-2c",
+					Terminating: true,
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
+		{
+			name: "endpointSlices not found",
+			env: env{
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{epSliceOtherSvc},
+			},
+			fields: fields{
+				podInfoRepoGetCalls: []podInfoRepoGetCall{},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   nil,
+			},
+			wantErr: fmt.Errorf("%w: %v", ErrNotFound, "endpointSlices for service test-ns/svc-1 not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			podInfoRepo := k8s.NewMockPodInfoRepo(ctrl)
+			for _, call := range tt.fields.podInfoRepoGetCalls {
+				podInfoRepo.EXPECT().Get(gomock.Any(), call.key).Return(call.pod, call.exists, call.err)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+
+			ctx := context.Background()
+			for _, svc := range tt.env.services {
+				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			}
+			for _, epSlice := range tt.env.endpointSlices {
+				assert.NoError(t, k8sClient.Create(ctx, epSlice.DeepCopy()))
+			}
+
+			r := &defaultEndpointResolver{
+				k8sClient:            k8sClient,
+				podInfoRepo:          podInfoRepo,
+				endpointSliceEnabled: true,
+				logger:               &log.NullLogger{},
+			}
+			got, gotContainsPotentialReadyEndpoints, err := r.ResolvePodEndpoints(ctx, tt.args.svcKey, tt.args.port, tt.args.opts...)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				opt := cmp.Options{
+					equality.IgnoreFakeClientPopulatedFields(),
+					cmpopts.SortSlices(func(lhs PodEndpoint, rhs PodEndpoint) bool {
+						return lhs.IP < rhs.IP
+					}),
+				}
+				assert.True(t, cmp.Equal(tt.want, got, opt),
+					"diff: %v", cmp.Diff(tt.want, got, opt))
+				assert.Equal(t, tt.wantContainsPotentialReadyEndpoints, gotContainsPotentialReadyEndpoints)
+			}
+		})
+	}
+}
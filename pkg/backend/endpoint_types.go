@@ -14,6 +14,12 @@ type PodEndpoint struct {
 	Port int64
 	// Pod that provides this endpoint.
 	Pod k8s.PodInfo
+	// Zone where this endpoint resides, only available when resolved from EndpointSlices.
+	Zone string
+	// Zones this endpoint is hinted to serve by topology aware hints, only available when resolved from EndpointSlices.
+	ZoneHints []string
+	// Whether this endpoint is terminating, only available when resolved from EndpointSlices.
+	Terminating bool
 }
 
 // An endpoint provided by nodePort as traffic proxy.
@@ -35,6 +41,15 @@ type EndpointResolveOptions struct {
 	// [Pod Endpoint] If pod readinessGates is defined, then pods from unready addresses with any of these readinessGates and containersReady condition will be included as well.
 	// By default, no readinessGate is specified.
 	PodReadinessGates []corev1.PodConditionType
+
+	// [Pod Endpoint] If true, terminating pods will be included as well, with Terminating set.
+	// Terminating pods are only known when endpoints are resolved from EndpointSlices.
+	// By default, terminating pods are not included.
+	IncludeTerminatingEndpoints bool
+
+	// [Pod Endpoint] If set, only pod endpoints of this IP family will be resolved from EndpointSlices.
+	// By default, the primary IP family of service is used.
+	PodEndpointIPFamily corev1.IPFamily
 }
 
 func (opts *EndpointResolveOptions) ApplyOptions(options []EndpointResolveOption) {
@@ -59,10 +74,26 @@ func WithPodReadinessGate(cond corev1.PodConditionType) EndpointResolveOption {
 	}
 }
 
+// WithTerminatingEndpoints is a option that includes terminating pods into resolved endpoints.
+func WithTerminatingEndpoints() EndpointResolveOption {
+	return func(opts *EndpointResolveOptions) {
+		opts.IncludeTerminatingEndpoints = true
+	}
+}
+
+// WithPodEndpointIPFamily is a option that sets the IP family of pod endpoints resolved from EndpointSlices.
+func WithPodEndpointIPFamily(ipFamily corev1.IPFamily) EndpointResolveOption {
+	return func(opts *EndpointResolveOptions) {
+		opts.PodEndpointIPFamily = ipFamily
+	}
+}
+
 // defaultEndpointResolveOptions returns the default value for EndpointResolveOptions.
 func defaultEndpointResolveOptions() EndpointResolveOptions {
 	return EndpointResolveOptions{
-		NodeSelector:      labels.Nothing(),
-		PodReadinessGates: nil,
+		NodeSelector:                labels.Nothing(),
+		PodReadinessGates:           nil,
+		IncludeTerminatingEndpoints: false,
+		PodEndpointIPFamily:         "",
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
@@ -18,7 +19,12 @@ const (
 	flagServiceMaxConcurrentReconciles               = "service-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxConcurrentReconciles    = "targetgroupbinding-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxExponentialBackoffDelay = "targetgroupbinding-max-exponential-backoff-delay"
+	flagTargetGroupMaxConcurrentReconciles           = "targetgroup-max-concurrent-reconciles"
 	flagDefaultSSLPolicy                             = "default-ssl-policy"
+	flagEnableGracefulDraining                       = "enable-graceful-draining"
+	flagEnableCertificateImport                      = "enable-certificate-import"
+	flagTrustStoreBucket                             = "trust-store-bucket"
+	flagEnableNLBSecurityGroups                      = "enable-nlb-security-groups"
 	defaultLogLevel                                  = "info"
 	defaultMaxConcurrentReconciles                   = 3
 	defaultMaxExponentialBackoffDelay                = time.Second * 1000
@@ -32,6 +38,12 @@ var (
 		"ingress.k8s.aws/resource",
 		"service.k8s.aws/stack",
 		"service.k8s.aws/resource",
+		"gateway.k8s.aws/stack",
+		"gateway.k8s.aws/resource",
+		"listener-rule.k8s.aws/stack",
+		"listener-rule.k8s.aws/resource",
+		"target-group.k8s.aws/stack",
+		"target-group.k8s.aws/resource",
 	)
 )
 
@@ -49,8 +61,16 @@ type ControllerConfig struct {
 	PodWebhookConfig inject.Config
 	// Configurations for the Ingress controller
 	IngressConfig IngressConfig
+	// Configurations for the Service controller
+	ServiceConfig ServiceConfig
+	// Configurations for the Gateway controller
+	GatewayConfig GatewayConfig
+	// Configurations for the ListenerRule controller
+	ListenerRuleConfig ListenerRuleConfig
 	// Configurations for Addons feature
 	AddonsConfig AddonsConfig
+	// Configurations for garbage collection of orphaned AWS resources
+	GCConfig GCConfig
 
 	// Default AWS Tags that will be applied to all AWS resources managed by this controller.
 	DefaultTags map[string]string
@@ -68,6 +88,16 @@ type ControllerConfig struct {
 	TargetGroupBindingMaxConcurrentReconciles int
 	// Max exponential backoff delay for reconcile failures of TargetGroupBinding
 	TargetGroupBindingMaxExponentialBackoffDelay time.Duration
+	// Max concurrent reconcile loops for TargetGroup objects
+	TargetGroupMaxConcurrentReconciles int
+	// Whether to keep track of terminating pods until their targets finished draining.
+	EnableGracefulDraining bool
+	// Whether to import TLS secrets referenced by Ingresses and Services into ACM.
+	EnableCertificateImport bool
+	// S3 bucket to stage CA certificates bundles for trust stores, trust stores are only managed if specified.
+	TrustStoreBucket string
+	// Whether to attach security groups to Network Load Balancers provisioned for Services.
+	EnableNLBSecurityGroups bool
 }
 
 // BindFlags binds the command line flags to the fields in the config object
@@ -85,15 +115,29 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Maximum number of concurrently running reconcile loops for targetGroupBinding")
 	fs.DurationVar(&cfg.TargetGroupBindingMaxExponentialBackoffDelay, flagTargetGroupBindingMaxExponentialBackoffDelay, defaultMaxExponentialBackoffDelay,
 		"Maximum duration of exponential backoff for targetGroupBinding reconcile failures")
+	fs.IntVar(&cfg.TargetGroupMaxConcurrentReconciles, flagTargetGroupMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
+		"Maximum number of concurrently running reconcile loops for targetGroup")
 	fs.StringVar(&cfg.DefaultSSLPolicy, flagDefaultSSLPolicy, defaultSSLPolicy,
 		"Default SSL policy for load balancers listeners")
+	fs.BoolVar(&cfg.EnableGracefulDraining, flagEnableGracefulDraining, false,
+		"Enable signaling terminating pods via pod condition once their targets finished draining")
+	fs.BoolVar(&cfg.EnableCertificateImport, flagEnableCertificateImport, false,
+		"Enable importing TLS secrets referenced by ingresses and services into ACM")
+	fs.StringVar(&cfg.TrustStoreBucket, flagTrustStoreBucket, "",
+		"S3 bucket to stage CA certificates bundles for trust stores created from secrets or configmaps")
+	fs.BoolVar(&cfg.EnableNLBSecurityGroups, flagEnableNLBSecurityGroups, false,
+		"Enable attaching security groups to network load balancers provisioned for services")
 
 	cfg.AWSConfig.BindFlags(fs)
 	cfg.RuntimeConfig.BindFlags(fs)
 
 	cfg.PodWebhookConfig.BindFlags(fs)
 	cfg.IngressConfig.BindFlags(fs)
+	cfg.ServiceConfig.BindFlags(fs)
+	cfg.GatewayConfig.BindFlags(fs)
+	cfg.ListenerRuleConfig.BindFlags(fs)
 	cfg.AddonsConfig.BindFlags(fs)
+	cfg.GCConfig.BindFlags(fs)
 }
 
 // Validate the controller configuration
@@ -111,6 +155,12 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateExternalManagedTagsCollisionWithDefaultTags(); err != nil {
 		return err
 	}
+	if err := cfg.validateOrphanGCWithWatchNamespace(); err != nil {
+		return err
+	}
+	if err := cfg.validateListenerRulePriorityRange(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -141,3 +191,21 @@ func (cfg *ControllerConfig) validateExternalManagedTagsCollisionWithDefaultTags
 	}
 	return nil
 }
+
+// orphaned AWS resources can only be identified when Ingresses, Services and Gateways of all namespaces are watched.
+func (cfg *ControllerConfig) validateOrphanGCWithWatchNamespace() error {
+	if cfg.GCConfig.EnableOrphanGC && cfg.RuntimeConfig.WatchNamespace != corev1.NamespaceAll {
+		return errors.Errorf("%v flag cannot be specified together with %v flag", flagEnableOrphanGC, flagWatchNamespace)
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateListenerRulePriorityRange() error {
+	minPriority := cfg.ListenerRuleConfig.MinPriority
+	maxPriority := cfg.ListenerRuleConfig.MaxPriority
+	if minPriority < 1 || maxPriority > listenerRulePriorityLimit || minPriority > maxPriority {
+		return errors.Errorf("%v flag and %v flag must specify a priority range within [1, %v]: [%v, %v]",
+			flagListenerRuleMinPriority, flagListenerRuleMaxPriority, listenerRulePriorityLimit, minPriority, maxPriority)
+	}
+	return nil
+}
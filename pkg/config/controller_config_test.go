@@ -164,3 +164,127 @@ func TestControllerConfig_validateExternalManagedTagsCollisionWithDefaultTags(t
 		})
 	}
 }
+
+func TestControllerConfig_validateOrphanGCWithWatchNamespace(t *testing.T) {
+	type fields struct {
+		EnableOrphanGC bool
+		WatchNamespace string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "orphan GC enabled and watching all namespaces",
+			fields: fields{
+				EnableOrphanGC: true,
+				WatchNamespace: "",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "orphan GC enabled and watching single namespace",
+			fields: fields{
+				EnableOrphanGC: true,
+				WatchNamespace: "awesome-ns",
+			},
+			wantErr: errors.New("enable-orphan-gc flag cannot be specified together with watch-namespace flag"),
+		},
+		{
+			name: "orphan GC disabled and watching single namespace",
+			fields: fields{
+				EnableOrphanGC: false,
+				WatchNamespace: "awesome-ns",
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				RuntimeConfig: RuntimeConfig{
+					WatchNamespace: tt.fields.WatchNamespace,
+				},
+				GCConfig: GCConfig{
+					EnableOrphanGC: tt.fields.EnableOrphanGC,
+				},
+			}
+			err := cfg.validateOrphanGCWithWatchNamespace()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateListenerRulePriorityRange(t *testing.T) {
+	type fields struct {
+		MinPriority int64
+		MaxPriority int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "default priority range",
+			fields: fields{
+				MinPriority: 40000,
+				MaxPriority: 50000,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "single priority",
+			fields: fields{
+				MinPriority: 100,
+				MaxPriority: 100,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "min priority below 1",
+			fields: fields{
+				MinPriority: 0,
+				MaxPriority: 100,
+			},
+			wantErr: errors.New("listener-rule-min-priority flag and listener-rule-max-priority flag must specify a priority range within [1, 50000]: [0, 100]"),
+		},
+		{
+			name: "max priority above limit",
+			fields: fields{
+				MinPriority: 100,
+				MaxPriority: 50001,
+			},
+			wantErr: errors.New("listener-rule-min-priority flag and listener-rule-max-priority flag must specify a priority range within [1, 50000]: [100, 50001]"),
+		},
+		{
+			name: "min priority above max priority",
+			fields: fields{
+				MinPriority: 200,
+				MaxPriority: 100,
+			},
+			wantErr: errors.New("listener-rule-min-priority flag and listener-rule-max-priority flag must specify a priority range within [1, 50000]: [200, 100]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				ListenerRuleConfig: ListenerRuleConfig{
+					MinPriority: tt.fields.MinPriority,
+					MaxPriority: tt.fields.MaxPriority,
+				},
+			}
+			err := cfg.validateListenerRulePriorityRange()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
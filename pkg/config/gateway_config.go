@@ -0,0 +1,28 @@
+package config
+
+import "github.com/spf13/pflag"
+
+const (
+	flagEnableGatewayAPI                  = "enable-gateway-api"
+	flagGatewayMaxConcurrentReconciles    = "gateway-max-concurrent-reconciles"
+	defaultEnableGatewayAPI               = false
+	defaultMaxGatewayConcurrentReconciles = 3
+)
+
+// GatewayConfig contains the configurations for the Gateway controller
+type GatewayConfig struct {
+	// EnableGatewayAPI specifies whether to reconcile Gateway API resources.
+	// The Gateway API CRDs must be installed in the cluster when enabled.
+	EnableGatewayAPI bool
+
+	// Max concurrent reconcile loops for Gateway objects
+	MaxConcurrentReconciles int
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *GatewayConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&cfg.EnableGatewayAPI, flagEnableGatewayAPI, defaultEnableGatewayAPI,
+		"Enable reconciliation of Gateway API resources(GatewayClass, Gateway and HTTPRoute)")
+	fs.IntVar(&cfg.MaxConcurrentReconciles, flagGatewayMaxConcurrentReconciles, defaultMaxGatewayConcurrentReconciles,
+		"Maximum number of concurrently running reconcile loops for gateway")
+}
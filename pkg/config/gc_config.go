@@ -0,0 +1,45 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagEnableOrphanGC         = "enable-orphan-gc"
+	flagOrphanGCInterval       = "orphan-gc-interval"
+	flagOrphanGCGracePeriod    = "orphan-gc-grace-period"
+	flagOrphanGCDryRun         = "orphan-gc-dry-run"
+	defaultEnableOrphanGC      = false
+	defaultOrphanGCInterval    = 10 * time.Minute
+	defaultOrphanGCGracePeriod = 1 * time.Hour
+	defaultOrphanGCDryRun      = false
+)
+
+// GCConfig contains the configurations for garbage collection of orphaned AWS resources
+type GCConfig struct {
+	// EnableOrphanGC specifies whether to garbage collect AWS resources whose Ingress group, Service or Gateway no longer exists.
+	EnableOrphanGC bool
+
+	// Interval between garbage collection runs.
+	Interval time.Duration
+
+	// GracePeriod is the duration AWS resources must stay orphaned before they are garbage collected.
+	GracePeriod time.Duration
+
+	// DryRun specifies whether to only report orphaned AWS resources without deleting them.
+	DryRun bool
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *GCConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&cfg.EnableOrphanGC, flagEnableOrphanGC, defaultEnableOrphanGC,
+		"Enable garbage collection of AWS resources whose ingress group, service or gateway no longer exists")
+	fs.DurationVar(&cfg.Interval, flagOrphanGCInterval, defaultOrphanGCInterval,
+		"Interval between garbage collection runs of orphaned AWS resources")
+	fs.DurationVar(&cfg.GracePeriod, flagOrphanGCGracePeriod, defaultOrphanGCGracePeriod,
+		"Duration AWS resources must stay orphaned before they are garbage collected")
+	fs.BoolVar(&cfg.DryRun, flagOrphanGCDryRun, defaultOrphanGCDryRun,
+		"Only report orphaned AWS resources without deleting them")
+}
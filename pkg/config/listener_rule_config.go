@@ -0,0 +1,46 @@
+package config
+
+import (
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagListenerRuleMaxConcurrentReconciles = "listener-rule-max-concurrent-reconciles"
+	flagListenerRuleMinPriority             = "listener-rule-min-priority"
+	flagListenerRuleMaxPriority             = "listener-rule-max-priority"
+	flagListenerRuleAllowedListenerARNs     = "listener-rule-allowed-listener-arns"
+	defaultListenerRuleMinPriority          = 40000
+	defaultListenerRuleMaxPriority          = 50000
+
+	// listenerRulePriorityLimit is the max priority of listener rules allowed by ELBV2.
+	listenerRulePriorityLimit = 50000
+)
+
+// ListenerRuleConfig contains the configurations for the ListenerRule controller
+type ListenerRuleConfig struct {
+	// Max concurrent reconcile loops for ListenerRule objects
+	MaxConcurrentReconciles int
+
+	// MinPriority is the lowest priority allocated to rules created for ListenerRule objects.
+	MinPriority int64
+
+	// MaxPriority is the highest priority allocated to rules created for ListenerRule objects.
+	// Controllers sharing a listener should be configured with non-overlapping priority ranges.
+	MaxPriority int64
+
+	// AllowedListenerARNs are the listeners that ListenerRule objects are allowed to attach rules to.
+	// ListenerRule objects cannot attach rules to any listener if it's empty.
+	AllowedListenerARNs []string
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *ListenerRuleConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&cfg.MaxConcurrentReconciles, flagListenerRuleMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
+		"Maximum number of concurrently running reconcile loops for listenerRule")
+	fs.Int64Var(&cfg.MinPriority, flagListenerRuleMinPriority, defaultListenerRuleMinPriority,
+		"Lowest priority allocated to rules created for listenerRules on shared listeners")
+	fs.Int64Var(&cfg.MaxPriority, flagListenerRuleMaxPriority, defaultListenerRuleMaxPriority,
+		"Highest priority allocated to rules created for listenerRules on shared listeners")
+	fs.StringSliceVar(&cfg.AllowedListenerARNs, flagListenerRuleAllowedListenerARNs, nil,
+		"List of listener ARNs that listenerRules are allowed to attach rules to, no listener is allowed if empty")
+}
@@ -0,0 +1,28 @@
+package config
+
+import "github.com/spf13/pflag"
+
+const (
+	flagLoadBalancerClass              = "load-balancer-class"
+	flagEnableServiceMutatorWebhook    = "enable-service-mutator-webhook"
+	defaultLoadBalancerClass           = "service.k8s.aws/nlb"
+	defaultEnableServiceMutatorWebhook = false
+)
+
+// ServiceConfig contains the configurations for the Service controller
+type ServiceConfig struct {
+	// LoadBalancerClass is the name of the spec.loadBalancerClass this controller satisfies.
+	// Services without spec.loadBalancerClass are still selected via the aws-load-balancer-type annotation.
+	LoadBalancerClass string
+
+	// EnableServiceMutatorWebhook specifies whether to default spec.loadBalancerClass of new LoadBalancer Services to LoadBalancerClass.
+	EnableServiceMutatorWebhook bool
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *ServiceConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&cfg.LoadBalancerClass, flagLoadBalancerClass, defaultLoadBalancerClass,
+		"Name of the load balancer class this controller satisfies")
+	fs.BoolVar(&cfg.EnableServiceMutatorWebhook, flagEnableServiceMutatorWebhook, defaultEnableServiceMutatorWebhook,
+		"Enable defaulting the load balancer class of new LoadBalancer services")
+}
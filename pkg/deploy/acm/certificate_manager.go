@@ -0,0 +1,166 @@
+package acm
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"time"
+)
+
+const (
+	// certificateChecksumTagKey is the AWS TagKey for the checksum of imported certificate materials.
+	// we cannot read back the private key from ACM, so the checksum is used to detect whether re-import is needed.
+	certificateChecksumTagKey = "elbv2.k8s.aws/certificate-checksum"
+
+	// ACM updates the usage of certificate asynchronously after it's removed from listeners.
+	defaultWaitCertDeletionPollInterval = 5 * time.Second
+	defaultWaitCertDeletionTimeout      = 2 * time.Minute
+)
+
+// CertificateManager is responsible for create/update/delete Certificate resources.
+type CertificateManager interface {
+	Create(ctx context.Context, resCert *acmmodel.Certificate) (acmmodel.CertificateStatus, error)
+
+	Update(ctx context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) (acmmodel.CertificateStatus, error)
+
+	Delete(ctx context.Context, sdkCert CertificateWithTags) error
+}
+
+// NewDefaultCertificateManager constructs new defaultCertificateManager.
+func NewDefaultCertificateManager(acmClient services.ACM, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultCertificateManager {
+	return &defaultCertificateManager{
+		acmClient:           acmClient,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitCertDeletionPollInterval: defaultWaitCertDeletionPollInterval,
+		waitCertDeletionTimeout:      defaultWaitCertDeletionTimeout,
+	}
+}
+
+var _ CertificateManager = &defaultCertificateManager{}
+
+// default implementation for CertificateManager.
+type defaultCertificateManager struct {
+	acmClient           services.ACM
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+
+	waitCertDeletionPollInterval time.Duration
+	waitCertDeletionTimeout      time.Duration
+}
+
+func (m *defaultCertificateManager) Create(ctx context.Context, resCert *acmmodel.Certificate) (acmmodel.CertificateStatus, error) {
+	certTags := buildCertificateTags(m.trackingProvider, resCert)
+	req := buildSDKImportCertificateInput(resCert.Spec)
+	req.Tags = convertTagsToSDKTags(certTags)
+
+	m.logger.Info("importing certificate",
+		"stackID", resCert.Stack().StackID(),
+		"resourceID", resCert.ID())
+	resp, err := m.acmClient.ImportCertificateWithContext(ctx, req)
+	if err != nil {
+		return acmmodel.CertificateStatus{}, err
+	}
+	certARN := awssdk.StringValue(resp.CertificateArn)
+	m.logger.Info("imported certificate",
+		"stackID", resCert.Stack().StackID(),
+		"resourceID", resCert.ID(),
+		"arn", certARN)
+	return acmmodel.CertificateStatus{
+		CertificateARN: certARN,
+	}, nil
+}
+
+func (m *defaultCertificateManager) Update(ctx context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) (acmmodel.CertificateStatus, error) {
+	if sdkCert.Tags[certificateChecksumTagKey] != resCert.Spec.Checksum {
+		if err := m.reimportSDKCertificate(ctx, resCert, sdkCert); err != nil {
+			return acmmodel.CertificateStatus{}, err
+		}
+	}
+	desiredCertTags := buildCertificateTags(m.trackingProvider, resCert)
+	if err := m.taggingManager.ReconcileTags(ctx, sdkCert.CertificateARN, desiredCertTags,
+		WithCurrentTags(sdkCert.Tags),
+		WithIgnoredTagKeys(m.externalManagedTags)); err != nil {
+		return acmmodel.CertificateStatus{}, err
+	}
+	return acmmodel.CertificateStatus{
+		CertificateARN: sdkCert.CertificateARN,
+	}, nil
+}
+
+func (m *defaultCertificateManager) Delete(ctx context.Context, sdkCert CertificateWithTags) error {
+	req := &acmsdk.DeleteCertificateInput{
+		CertificateArn: awssdk.String(sdkCert.CertificateARN),
+	}
+
+	m.logger.Info("deleting certificate",
+		"arn", sdkCert.CertificateARN)
+	if err := runtime.RetryImmediateOnError(m.waitCertDeletionPollInterval, m.waitCertDeletionTimeout, isCertificateInUseError, func() error {
+		_, err := m.acmClient.DeleteCertificateWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete certificate")
+	}
+	m.logger.Info("deleted certificate",
+		"arn", sdkCert.CertificateARN)
+	return nil
+}
+
+// reimportSDKCertificate re-imports certificate materials into existing certificate, so that it's ARN remains the same.
+func (m *defaultCertificateManager) reimportSDKCertificate(ctx context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) error {
+	req := buildSDKImportCertificateInput(resCert.Spec)
+	req.CertificateArn = awssdk.String(sdkCert.CertificateARN)
+
+	m.logger.Info("re-importing certificate",
+		"stackID", resCert.Stack().StackID(),
+		"resourceID", resCert.ID(),
+		"arn", sdkCert.CertificateARN)
+	if _, err := m.acmClient.ImportCertificateWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("re-imported certificate",
+		"stackID", resCert.Stack().StackID(),
+		"resourceID", resCert.ID(),
+		"arn", sdkCert.CertificateARN)
+	return nil
+}
+
+// buildCertificateTags builds the desired tags for certificate, including the checksum of certificate materials.
+func buildCertificateTags(trackingProvider tracking.Provider, resCert *acmmodel.Certificate) map[string]string {
+	checksumTags := map[string]string{
+		certificateChecksumTagKey: resCert.Spec.Checksum,
+	}
+	return trackingProvider.ResourceTags(resCert.Stack(), resCert, algorithm.MergeStringMap(checksumTags, resCert.Spec.Tags))
+}
+
+func buildSDKImportCertificateInput(certSpec acmmodel.CertificateSpec) *acmsdk.ImportCertificateInput {
+	sdkObj := &acmsdk.ImportCertificateInput{}
+	sdkObj.Certificate = []byte(certSpec.Certificate)
+	sdkObj.PrivateKey = []byte(certSpec.PrivateKey)
+	if len(certSpec.CertificateChain) != 0 {
+		sdkObj.CertificateChain = []byte(certSpec.CertificateChain)
+	}
+	return sdkObj
+}
+
+func isCertificateInUseError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == acmsdk.ErrCodeResourceInUseException
+	}
+	return false
+}
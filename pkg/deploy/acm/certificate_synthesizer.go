@@ -0,0 +1,196 @@
+package acm
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// resourceTypeCertificate is the resource type of Certificate resources.
+const resourceTypeCertificate = "AWS::CertificateManager::Certificate"
+
+// NewCertificateSynthesizer constructs new certificateSynthesizer.
+// when certificate import is disabled, it's an error to have Certificate resources, while previously imported certificates are still garbage collected.
+func NewCertificateSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	certManager CertificateManager, enabled bool, logger logr.Logger, stack core.Stack) *certificateSynthesizer {
+	return &certificateSynthesizer{
+		trackingProvider:  trackingProvider,
+		taggingManager:    taggingManager,
+		certManager:       certManager,
+		enabled:           enabled,
+		logger:            logger,
+		stack:             stack,
+		unmatchedSDKCerts: nil,
+	}
+}
+
+type certificateSynthesizer struct {
+	trackingProvider tracking.Provider
+	taggingManager   TaggingManager
+	certManager      CertificateManager
+	enabled          bool
+	logger           logr.Logger
+
+	stack             core.Stack
+	unmatchedSDKCerts []CertificateWithTags
+}
+
+func (s *certificateSynthesizer) Synthesize(ctx context.Context) error {
+	var resCerts []*acmmodel.Certificate
+	s.stack.ListResources(&resCerts)
+	if err := s.checkEnabled(resCerts); err != nil {
+		return err
+	}
+	sdkCerts, err := s.findSDKCertificates(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKCerts, unmatchedResCerts, unmatchedSDKCerts, err := matchResAndSDKCertificates(resCerts, sdkCerts, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For Certificate, we delete unmatched ones during post synthesize, after they are removed from listeners.
+	s.unmatchedSDKCerts = unmatchedSDKCerts
+
+	for _, resCert := range unmatchedResCerts {
+		certStatus, err := s.certManager.Create(ctx, resCert)
+		if err != nil {
+			return err
+		}
+		resCert.SetStatus(certStatus)
+	}
+	for _, resAndSDKCert := range matchedResAndSDKCerts {
+		certStatus, err := s.certManager.Update(ctx, resAndSDKCert.resCert, resAndSDKCert.sdkCert)
+		if err != nil {
+			return err
+		}
+		resAndSDKCert.resCert.SetStatus(certStatus)
+	}
+	return nil
+}
+
+func (s *certificateSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkCert := range s.unmatchedSDKCerts {
+		if err := s.certManager.Delete(ctx, sdkCert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *certificateSynthesizer) Plan(ctx context.Context) ([]plan.Change, error) {
+	var resCerts []*acmmodel.Certificate
+	s.stack.ListResources(&resCerts)
+	if err := s.checkEnabled(resCerts); err != nil {
+		return nil, err
+	}
+	sdkCerts, err := s.findSDKCertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKCerts, unmatchedResCerts, unmatchedSDKCerts, err := matchResAndSDKCertificates(resCerts, sdkCerts, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []plan.Change
+	for _, resCert := range unmatchedResCerts {
+		changes = append(changes, plan.NewCreateChange(resCert))
+		resCert.SetStatus(acmmodel.CertificateStatus{
+			CertificateARN: plan.PlaceholderIdentifier(resCert),
+		})
+	}
+	for _, resAndSDKCert := range matchedResAndSDKCerts {
+		diffs := plan.DiffString("checksum", resAndSDKCert.sdkCert.Tags[certificateChecksumTagKey], resAndSDKCert.resCert.Spec.Checksum)
+		if len(diffs) != 0 {
+			changes = append(changes, plan.NewUpdateChange(resAndSDKCert.resCert, resAndSDKCert.sdkCert.CertificateARN, diffs))
+		}
+		resAndSDKCert.resCert.SetStatus(acmmodel.CertificateStatus{
+			CertificateARN: resAndSDKCert.sdkCert.CertificateARN,
+		})
+	}
+	// unmatched certificates are deleted during post synthesize, which happens after everything else.
+	for _, sdkCert := range unmatchedSDKCerts {
+		changes = append(changes, plan.NewDeleteChange(resourceTypeCertificate, sdkCert.CertificateARN))
+	}
+	return changes, nil
+}
+
+func (s *certificateSynthesizer) checkEnabled(resCerts []*acmmodel.Certificate) error {
+	if !s.enabled && len(resCerts) != 0 {
+		return errors.Errorf("certificate import must be enabled to import certificate: %v", resCerts[0].ID())
+	}
+	return nil
+}
+
+// findSDKCertificates will find all AWS Certificates imported for stack.
+func (s *certificateSynthesizer) findSDKCertificates(ctx context.Context) ([]CertificateWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListCertificates(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKCertificatePair struct {
+	resCert *acmmodel.Certificate
+	sdkCert CertificateWithTags
+}
+
+func matchResAndSDKCertificates(resCerts []*acmmodel.Certificate, sdkCerts []CertificateWithTags,
+	resourceIDTagKey string) ([]resAndSDKCertificatePair, []*acmmodel.Certificate, []CertificateWithTags, error) {
+	var matchedResAndSDKCerts []resAndSDKCertificatePair
+	var unmatchedResCerts []*acmmodel.Certificate
+	var unmatchedSDKCerts []CertificateWithTags
+
+	resCertsByID := mapResCertificateByResourceID(resCerts)
+	sdkCertsByID, err := mapSDKCertificateByResourceID(sdkCerts, resourceIDTagKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resCertIDs := sets.StringKeySet(resCertsByID)
+	sdkCertIDs := sets.StringKeySet(sdkCertsByID)
+	for _, resID := range resCertIDs.Intersection(sdkCertIDs).List() {
+		resCert := resCertsByID[resID]
+		sdkCerts := sdkCertsByID[resID]
+		matchedResAndSDKCerts = append(matchedResAndSDKCerts, resAndSDKCertificatePair{
+			resCert: resCert,
+			sdkCert: sdkCerts[0],
+		})
+		for _, sdkCert := range sdkCerts[1:] {
+			unmatchedSDKCerts = append(unmatchedSDKCerts, sdkCert)
+		}
+	}
+	for _, resID := range resCertIDs.Difference(sdkCertIDs).List() {
+		unmatchedResCerts = append(unmatchedResCerts, resCertsByID[resID])
+	}
+	for _, resID := range sdkCertIDs.Difference(resCertIDs).List() {
+		unmatchedSDKCerts = append(unmatchedSDKCerts, sdkCertsByID[resID]...)
+	}
+
+	return matchedResAndSDKCerts, unmatchedResCerts, unmatchedSDKCerts, nil
+}
+
+func mapResCertificateByResourceID(resCerts []*acmmodel.Certificate) map[string]*acmmodel.Certificate {
+	resCertsByID := make(map[string]*acmmodel.Certificate, len(resCerts))
+	for _, resCert := range resCerts {
+		resCertsByID[resCert.ID()] = resCert
+	}
+	return resCertsByID
+}
+
+func mapSDKCertificateByResourceID(sdkCerts []CertificateWithTags, resourceIDTagKey string) (map[string][]CertificateWithTags, error) {
+	sdkCertsByID := make(map[string][]CertificateWithTags, len(sdkCerts))
+	for _, sdkCert := range sdkCerts {
+		resourceID, ok := sdkCert.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, errors.Errorf("unexpected certificate with no resourceID: %v", sdkCert.CertificateARN)
+		}
+		sdkCertsByID[resourceID] = append(sdkCertsByID[resourceID], sdkCert)
+	}
+	return sdkCertsByID, nil
+}
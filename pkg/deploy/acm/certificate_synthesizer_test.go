@@ -0,0 +1,170 @@
+package acm
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"testing"
+)
+
+func Test_matchResAndSDKCertificates(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resCert1 := &acmmodel.Certificate{
+		ResourceMeta: coremodel.NewResourceMeta(stack, resourceTypeCertificate, "ns/secret-1"),
+		Spec: acmmodel.CertificateSpec{
+			Checksum: "checksum-1",
+		},
+	}
+	resCert2 := &acmmodel.Certificate{
+		ResourceMeta: coremodel.NewResourceMeta(stack, resourceTypeCertificate, "ns/secret-2"),
+		Spec: acmmodel.CertificateSpec{
+			Checksum: "checksum-2",
+		},
+	}
+	sdkCert1 := CertificateWithTags{
+		CertificateARN: "arn-1",
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ns/secret-1",
+		},
+	}
+	sdkCert2 := CertificateWithTags{
+		CertificateARN: "arn-2",
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ns/secret-2",
+		},
+	}
+	sdkCert3 := CertificateWithTags{
+		CertificateARN: "arn-3",
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ns/secret-1",
+		},
+	}
+	type args struct {
+		resCerts         []*acmmodel.Certificate
+		sdkCerts         []CertificateWithTags
+		resourceIDTagKey string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []resAndSDKCertificatePair
+		want1   []*acmmodel.Certificate
+		want2   []CertificateWithTags
+		wantErr error
+	}{
+		{
+			name: "all certificates have match",
+			args: args{
+				resCerts:         []*acmmodel.Certificate{resCert1, resCert2},
+				sdkCerts:         []CertificateWithTags{sdkCert1, sdkCert2},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKCertificatePair{
+				{resCert: resCert1, sdkCert: sdkCert1},
+				{resCert: resCert2, sdkCert: sdkCert2},
+			},
+		},
+		{
+			name: "some res certificates don't have match",
+			args: args{
+				resCerts:         []*acmmodel.Certificate{resCert1, resCert2},
+				sdkCerts:         []CertificateWithTags{sdkCert1},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKCertificatePair{
+				{resCert: resCert1, sdkCert: sdkCert1},
+			},
+			want1: []*acmmodel.Certificate{resCert2},
+		},
+		{
+			name: "some sdk certificates don't have match",
+			args: args{
+				resCerts:         []*acmmodel.Certificate{resCert1},
+				sdkCerts:         []CertificateWithTags{sdkCert1, sdkCert2},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKCertificatePair{
+				{resCert: resCert1, sdkCert: sdkCert1},
+			},
+			want2: []CertificateWithTags{sdkCert2},
+		},
+		{
+			name: "duplicated sdk certificates for same resource",
+			args: args{
+				resCerts:         []*acmmodel.Certificate{resCert1},
+				sdkCerts:         []CertificateWithTags{sdkCert1, sdkCert3},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKCertificatePair{
+				{resCert: resCert1, sdkCert: sdkCert1},
+			},
+			want2: []CertificateWithTags{sdkCert3},
+		},
+		{
+			name: "sdk certificate without resourceID",
+			args: args{
+				resCerts: []*acmmodel.Certificate{resCert1},
+				sdkCerts: []CertificateWithTags{
+					{
+						CertificateARN: "arn-4",
+						Tags:           map[string]string{},
+					},
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			wantErr: errors.New("unexpected certificate with no resourceID: arn-4"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2, err := matchResAndSDKCertificates(tt.args.resCerts, tt.args.sdkCerts, tt.args.resourceIDTagKey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.want1, got1)
+				assert.Equal(t, tt.want2, got2)
+			}
+		})
+	}
+}
+
+func Test_certificateSynthesizer_checkEnabled(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resCert := acmmodel.NewCertificate(stack, "secret-a", acmmodel.CertificateSpec{})
+	tests := []struct {
+		name     string
+		enabled  bool
+		resCerts []*acmmodel.Certificate
+		wantErr  error
+	}{
+		{
+			name:     "enabled with certificates",
+			enabled:  true,
+			resCerts: []*acmmodel.Certificate{resCert},
+		},
+		{
+			name:    "disabled without certificates",
+			enabled: false,
+		},
+		{
+			name:     "disabled with certificates",
+			enabled:  false,
+			resCerts: []*acmmodel.Certificate{resCert},
+			wantErr:  errors.New("certificate import must be enabled to import certificate: secret-a"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &certificateSynthesizer{enabled: tt.enabled}
+			err := s.checkEnabled(tt.resCerts)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package acm
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+)
+
+// resourceTypeFilterCertificate is the resourceType filter of ACM Certificates for ResourceGroupsTaggingAPI.
+const resourceTypeFilterCertificate = "acm:certificate"
+
+// Certificate with it's tags.
+type CertificateWithTags struct {
+	CertificateARN string
+	Tags           map[string]string
+}
+
+// options for ReconcileTags API.
+type ReconcileTagsOptions struct {
+	// CurrentTags on resources.
+	// when it's nil, the TaggingManager will try to get the CurrentTags from AWS
+	CurrentTags map[string]string
+
+	// IgnoredTagKeys defines the tag keys that should be ignored.
+	// these tags shouldn't be altered or deleted.
+	IgnoredTagKeys []string
+}
+
+func (opts *ReconcileTagsOptions) ApplyOptions(options []ReconcileTagsOption) {
+	for _, option := range options {
+		option(opts)
+	}
+}
+
+type ReconcileTagsOption func(opts *ReconcileTagsOptions)
+
+// WithCurrentTags is a reconcile option that supplies current tags.
+func WithCurrentTags(tags map[string]string) ReconcileTagsOption {
+	return func(opts *ReconcileTagsOptions) {
+		opts.CurrentTags = tags
+	}
+}
+
+// WithIgnoredTagKeys is a reconcile option that configures IgnoredTagKeys.
+func WithIgnoredTagKeys(ignoredTagKeys []string) ReconcileTagsOption {
+	return func(opts *ReconcileTagsOptions) {
+		opts.IgnoredTagKeys = append(opts.IgnoredTagKeys, ignoredTagKeys...)
+	}
+}
+
+// abstraction around tagging operations for ACM.
+type TaggingManager interface {
+	// ReconcileTags will reconcile tags on resources.
+	ReconcileTags(ctx context.Context, arn string, desiredTags map[string]string, opts ...ReconcileTagsOption) error
+
+	// ListCertificates returns Certificates that matches any of the tagging requirements.
+	ListCertificates(ctx context.Context, tagFilters ...tracking.TagFilter) ([]CertificateWithTags, error)
+}
+
+// NewDefaultTaggingManager constructs new defaultTaggingManager.
+func NewDefaultTaggingManager(acmClient services.ACM, rgtClient services.RGT, logger logr.Logger) *defaultTaggingManager {
+	return &defaultTaggingManager{
+		acmClient: acmClient,
+		rgtClient: rgtClient,
+		logger:    logger,
+	}
+}
+
+var _ TaggingManager = &defaultTaggingManager{}
+
+// default implementation for TaggingManager.
+type defaultTaggingManager struct {
+	acmClient services.ACM
+	rgtClient services.RGT
+	logger    logr.Logger
+}
+
+func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, arn string, desiredTags map[string]string, opts ...ReconcileTagsOption) error {
+	reconcileOpts := ReconcileTagsOptions{
+		CurrentTags:    nil,
+		IgnoredTagKeys: nil,
+	}
+	reconcileOpts.ApplyOptions(opts)
+	currentTags := reconcileOpts.CurrentTags
+	if currentTags == nil {
+		tags, err := m.describeCertificateTags(ctx, arn)
+		if err != nil {
+			return err
+		}
+		currentTags = tags
+	}
+
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
+	for _, ignoredTagKey := range reconcileOpts.IgnoredTagKeys {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+
+	if len(tagsToUpdate) > 0 {
+		req := &acmsdk.AddTagsToCertificateInput{
+			CertificateArn: awssdk.String(arn),
+			Tags:           convertTagsToSDKTags(tagsToUpdate),
+		}
+
+		m.logger.Info("adding resource tags",
+			"arn", arn,
+			"change", tagsToUpdate)
+		if _, err := m.acmClient.AddTagsToCertificateWithContext(ctx, req); err != nil {
+			return err
+		}
+		m.logger.Info("added resource tags",
+			"arn", arn)
+	}
+
+	if len(tagsToRemove) > 0 {
+		req := &acmsdk.RemoveTagsFromCertificateInput{
+			CertificateArn: awssdk.String(arn),
+			Tags:           convertTagsToSDKTags(tagsToRemove),
+		}
+
+		m.logger.Info("removing resource tags",
+			"arn", arn,
+			"change", sets.StringKeySet(tagsToRemove).List())
+		if _, err := m.acmClient.RemoveTagsFromCertificateWithContext(ctx, req); err != nil {
+			return err
+		}
+		m.logger.Info("removed resource tags",
+			"arn", arn)
+	}
+	return nil
+}
+
+func (m *defaultTaggingManager) ListCertificates(ctx context.Context, tagFilters ...tracking.TagFilter) ([]CertificateWithTags, error) {
+	certByARN := make(map[string]CertificateWithTags)
+	var certARNs []string
+	for _, tagFilter := range tagFilters {
+		certsForTagFilter, err := m.listCertificatesWithTagFilter(ctx, tagFilter)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certsForTagFilter {
+			if _, exists := certByARN[cert.CertificateARN]; !exists {
+				certARNs = append(certARNs, cert.CertificateARN)
+			}
+			certByARN[cert.CertificateARN] = cert
+		}
+	}
+
+	certs := make([]CertificateWithTags, 0, len(certARNs))
+	for _, certARN := range certARNs {
+		certs = append(certs, certByARN[certARN])
+	}
+	return certs, nil
+}
+
+func (m *defaultTaggingManager) listCertificatesWithTagFilter(ctx context.Context, tagFilter tracking.TagFilter) ([]CertificateWithTags, error) {
+	req := &rgtsdk.GetResourcesInput{
+		ResourceTypeFilters: awssdk.StringSlice([]string{resourceTypeFilterCertificate}),
+	}
+	for _, tagKey := range sets.StringKeySet(tagFilter).List() {
+		req.TagFilters = append(req.TagFilters, &rgtsdk.TagFilter{
+			Key:    awssdk.String(tagKey),
+			Values: awssdk.StringSlice(tagFilter[tagKey]),
+		})
+	}
+
+	var certs []CertificateWithTags
+	if err := m.rgtClient.GetResourcesPagesWithContext(ctx, req, func(output *rgtsdk.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range output.ResourceTagMappingList {
+			tags := make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+			}
+			certs = append(certs, CertificateWithTags{
+				CertificateARN: awssdk.StringValue(mapping.ResourceARN),
+				Tags:           tags,
+			})
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+func (m *defaultTaggingManager) describeCertificateTags(ctx context.Context, arn string) (map[string]string, error) {
+	req := &acmsdk.ListTagsForCertificateInput{
+		CertificateArn: awssdk.String(arn),
+	}
+	resp, err := m.acmClient.ListTagsForCertificateWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(resp.Tags))
+	for _, tag := range resp.Tags {
+		tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+// convert tags into ACM SDK tag representation.
+func convertTagsToSDKTags(tags map[string]string) []*acmsdk.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	sdkTags := make([]*acmsdk.Tag, 0, len(tags))
+
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, &acmsdk.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
@@ -0,0 +1,201 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the maximum number of resources per UntagResources call.
+	untagResourcesBatchSize = 20
+)
+
+// DeletionProtectedError is returned when the LoadBalancer of a resource stack cannot be deleted due to deletion protection.
+type DeletionProtectedError struct {
+	LoadBalancerARN string
+}
+
+func (e *DeletionProtectedError) Error() string {
+	return fmt.Sprintf("deletion protection is enabled on loadBalancer: %v", e.LoadBalancerARN)
+}
+
+// DeletionPolicyEnforcer enforces the deletion policy before the AWS resources of a resource stack are deleted.
+type DeletionPolicyEnforcer interface {
+	// Enforce the deletion policy for resource stack.
+	//  * for Delete policy, nothing is done.
+	//  * for Retain policy, tracking tags are removed from AWS resources of the stack and tracking labels are removed from
+	//    TargetGroupBindings of the stack, so that they are left in place and targets remain registered.
+	//  * for Protect policy, a DeletionProtectedError is returned if deletion protection is enabled on LoadBalancer of the stack.
+	Enforce(ctx context.Context, stackID core.StackID, policy elbv2model.DeletionPolicy) error
+}
+
+// NewDefaultDeletionPolicyEnforcer constructs new defaultDeletionPolicyEnforcer.
+func NewDefaultDeletionPolicyEnforcer(cloud aws.Cloud, k8sClient client.Client, config config.ControllerConfig, tagPrefix string, logger logr.Logger) *defaultDeletionPolicyEnforcer {
+	return &defaultDeletionPolicyEnforcer{
+		k8sClient:           k8sClient,
+		elbv2Client:         cloud.ELBV2(),
+		rgtClient:           cloud.RGT(),
+		trackingProvider:    tracking.NewDefaultProvider(tagPrefix, config.ClusterName),
+		elbv2TaggingManager: elbv2.NewDefaultTaggingManager(cloud.ELBV2(), logger),
+		logger:              logger,
+	}
+}
+
+var _ DeletionPolicyEnforcer = &defaultDeletionPolicyEnforcer{}
+
+// default implementation for DeletionPolicyEnforcer
+type defaultDeletionPolicyEnforcer struct {
+	k8sClient           client.Client
+	elbv2Client         services.ELBV2
+	rgtClient           services.RGT
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2.TaggingManager
+	logger              logr.Logger
+}
+
+func (e *defaultDeletionPolicyEnforcer) Enforce(ctx context.Context, stackID core.StackID, policy elbv2model.DeletionPolicy) error {
+	stack := core.NewDefaultStack(stackID)
+	switch policy {
+	case "", elbv2model.DeletionPolicyDelete:
+		return nil
+	case elbv2model.DeletionPolicyRetain:
+		return e.retainStackResources(ctx, stack)
+	case elbv2model.DeletionPolicyProtect:
+		return e.checkDeletionProtection(ctx, stack)
+	default:
+		return errors.Errorf("unknown deletion policy: %v", policy)
+	}
+}
+
+// retainStackResources removes tracking tags from AWS resources of stack, so that they are no longer managed by this controller.
+func (e *defaultDeletionPolicyEnforcer) retainStackResources(ctx context.Context, stack core.Stack) error {
+	if err := e.retainStackTargetGroupBindings(ctx, stack); err != nil {
+		return err
+	}
+	resARNs, err := e.listStackResourceARNs(ctx, stack)
+	if err != nil {
+		return err
+	}
+	if len(resARNs) == 0 {
+		return nil
+	}
+
+	trackingTagKeys := sets.StringKeySet(e.trackingProvider.StackTags(stack)).
+		Union(sets.StringKeySet(e.trackingProvider.StackTagsLegacy(stack))).
+		Insert(e.trackingProvider.ResourceIDTagKey())
+	for start := 0; start < len(resARNs); start += untagResourcesBatchSize {
+		end := start + untagResourcesBatchSize
+		if end > len(resARNs) {
+			end = len(resARNs)
+		}
+		batchARNs := resARNs[start:end]
+		req := &rgtsdk.UntagResourcesInput{
+			ResourceARNList: awssdk.StringSlice(batchARNs),
+			TagKeys:         awssdk.StringSlice(trackingTagKeys.List()),
+		}
+		e.logger.Info("retaining resources",
+			"stackID", stack.StackID(),
+			"arns", batchARNs)
+		resp, err := e.rgtClient.UntagResourcesWithContext(ctx, req)
+		if err != nil {
+			return err
+		}
+		if len(resp.FailedResourcesMap) != 0 {
+			return errors.Errorf("failed to remove tracking tags from resources: %v", sets.StringKeySet(resp.FailedResourcesMap).List())
+		}
+		e.logger.Info("retained resources",
+			"stackID", stack.StackID(),
+			"arns", batchARNs)
+	}
+	return nil
+}
+
+// retainStackTargetGroupBindings removes tracking labels from TargetGroupBindings of stack, so that they are not deleted along with the stack.
+// the TargetGroupBindings keep registering targets into retained TargetGroups and keep the backend securityGroup rules,
+// until they are deleted by users.
+func (e *defaultDeletionPolicyEnforcer) retainStackTargetGroupBindings(ctx context.Context, stack core.Stack) error {
+	stackLabels := e.trackingProvider.StackLabels(stack)
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := e.k8sClient.List(ctx, tgbList, client.MatchingLabels(stackLabels)); err != nil {
+		return err
+	}
+	for i := range tgbList.Items {
+		tgb := &tgbList.Items[i]
+		oldTGB := tgb.DeepCopy()
+		for labelKey := range stackLabels {
+			delete(tgb.Labels, labelKey)
+		}
+		if err := e.k8sClient.Patch(ctx, tgb, client.MergeFrom(oldTGB)); err != nil {
+			return err
+		}
+		e.logger.Info("retained targetGroupBinding",
+			"stackID", stack.StackID(),
+			"targetGroupBinding", k8s.NamespacedName(tgb))
+	}
+	return nil
+}
+
+// listStackResourceARNs lists the ARN of AWS resources tracked for stack.
+func (e *defaultDeletionPolicyEnforcer) listStackResourceARNs(ctx context.Context, stack core.Stack) ([]string, error) {
+	resARNs := sets.NewString()
+	for _, stackTags := range []map[string]string{e.trackingProvider.StackTags(stack), e.trackingProvider.StackTagsLegacy(stack)} {
+		req := &rgtsdk.GetResourcesInput{}
+		for _, tagKey := range sets.StringKeySet(stackTags).List() {
+			req.TagFilters = append(req.TagFilters, &rgtsdk.TagFilter{
+				Key:    awssdk.String(tagKey),
+				Values: awssdk.StringSlice([]string{stackTags[tagKey]}),
+			})
+		}
+		if err := e.rgtClient.GetResourcesPagesWithContext(ctx, req, func(output *rgtsdk.GetResourcesOutput, _ bool) bool {
+			for _, mapping := range output.ResourceTagMappingList {
+				resARNs.Insert(awssdk.StringValue(mapping.ResourceARN))
+			}
+			return true
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return resARNs.List(), nil
+}
+
+// checkDeletionProtection returns DeletionProtectedError if deletion protection is enabled on any LoadBalancer of stack.
+func (e *defaultDeletionPolicyEnforcer) checkDeletionProtection(ctx context.Context, stack core.Stack) error {
+	sdkLBs, err := e.elbv2TaggingManager.ListLoadBalancers(ctx,
+		tracking.TagsAsTagFilter(e.trackingProvider.StackTags(stack)),
+		tracking.TagsAsTagFilter(e.trackingProvider.StackTagsLegacy(stack)))
+	if err != nil {
+		return err
+	}
+	for _, sdkLB := range sdkLBs {
+		req := &elbv2sdk.DescribeLoadBalancerAttributesInput{
+			LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		}
+		resp, err := e.elbv2Client.DescribeLoadBalancerAttributesWithContext(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, attr := range resp.Attributes {
+			if awssdk.StringValue(attr.Key) == elbv2model.LoadBalancerAttributeDeletionProtectionEnabled &&
+				awssdk.StringValue(attr.Value) == "true" {
+				return &DeletionProtectedError{LoadBalancerARN: awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+package deploy
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"testing"
+)
+
+func Test_defaultDeletionPolicyEnforcer_Enforce(t *testing.T) {
+	type getResourcesCall struct {
+		req  *rgtsdk.GetResourcesInput
+		resp *rgtsdk.GetResourcesOutput
+	}
+	type untagResourcesCall struct {
+		req  *rgtsdk.UntagResourcesInput
+		resp *rgtsdk.UntagResourcesOutput
+		err  error
+	}
+	type listLoadBalancersCall struct {
+		sdkLBs []elbv2.LoadBalancerWithTags
+	}
+	type describeLoadBalancerAttributesCall struct {
+		req  *elbv2sdk.DescribeLoadBalancerAttributesInput
+		resp *elbv2sdk.DescribeLoadBalancerAttributesOutput
+	}
+	type fields struct {
+		getResourcesCalls                   []getResourcesCall
+		untagResourcesCalls                 []untagResourcesCall
+		listLoadBalancersCalls              []listLoadBalancersCall
+		describeLoadBalancerAttributesCalls []describeLoadBalancerAttributesCall
+	}
+	stackID := core.StackID{Namespace: "awesome-ns", Name: "awesome-svc"}
+	tgbs := []*elbv2api.TargetGroupBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "tgb-stack",
+				Labels: map[string]string{
+					"service.k8s.aws/stack-namespace": "awesome-ns",
+					"service.k8s.aws/stack-name":      "awesome-svc",
+					"app":                             "awesome",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "tgb-other-stack",
+				Labels: map[string]string{
+					"service.k8s.aws/stack-namespace": "awesome-ns",
+					"service.k8s.aws/stack-name":      "other-svc",
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		policy        elbv2model.DeletionPolicy
+		wantTGBLabels map[string]map[string]string
+		wantErr       error
+	}{
+		{
+			name:   "Delete policy",
+			policy: elbv2model.DeletionPolicyDelete,
+		},
+		{
+			name:   "Retain policy removes tracking tags",
+			policy: elbv2model.DeletionPolicyRetain,
+			fields: fields{
+				getResourcesCalls: []getResourcesCall{
+					{
+						req: &rgtsdk.GetResourcesInput{
+							TagFilters: []*rgtsdk.TagFilter{
+								{Key: awssdk.String("elbv2.k8s.aws/cluster"), Values: awssdk.StringSlice([]string{"cluster-name"})},
+								{Key: awssdk.String("service.k8s.aws/stack"), Values: awssdk.StringSlice([]string{"awesome-ns/awesome-svc"})},
+							},
+						},
+						resp: &rgtsdk.GetResourcesOutput{
+							ResourceTagMappingList: []*rgtsdk.ResourceTagMapping{
+								{ResourceARN: awssdk.String("lb-arn")},
+								{ResourceARN: awssdk.String("tg-arn")},
+							},
+						},
+					},
+					{
+						req: &rgtsdk.GetResourcesInput{
+							TagFilters: []*rgtsdk.TagFilter{
+								{Key: awssdk.String("ingress.k8s.aws/cluster"), Values: awssdk.StringSlice([]string{"cluster-name"})},
+								{Key: awssdk.String("service.k8s.aws/stack"), Values: awssdk.StringSlice([]string{"awesome-ns/awesome-svc"})},
+							},
+						},
+						resp: &rgtsdk.GetResourcesOutput{},
+					},
+				},
+				untagResourcesCalls: []untagResourcesCall{
+					{
+						req: &rgtsdk.UntagResourcesInput{
+							ResourceARNList: awssdk.StringSlice([]string{"lb-arn", "tg-arn"}),
+							TagKeys:         awssdk.StringSlice([]string{"elbv2.k8s.aws/cluster", "ingress.k8s.aws/cluster", "service.k8s.aws/resource", "service.k8s.aws/stack"}),
+						},
+						resp: &rgtsdk.UntagResourcesOutput{},
+					},
+				},
+			},
+			wantTGBLabels: map[string]map[string]string{
+				"tgb-stack": {
+					"app": "awesome",
+				},
+				"tgb-other-stack": {
+					"service.k8s.aws/stack-namespace": "awesome-ns",
+					"service.k8s.aws/stack-name":      "other-svc",
+				},
+			},
+		},
+		{
+			name:   "Retain policy fails to remove tracking tags",
+			policy: elbv2model.DeletionPolicyRetain,
+			fields: fields{
+				getResourcesCalls: []getResourcesCall{
+					{
+						req: &rgtsdk.GetResourcesInput{
+							TagFilters: []*rgtsdk.TagFilter{
+								{Key: awssdk.String("elbv2.k8s.aws/cluster"), Values: awssdk.StringSlice([]string{"cluster-name"})},
+								{Key: awssdk.String("service.k8s.aws/stack"), Values: awssdk.StringSlice([]string{"awesome-ns/awesome-svc"})},
+							},
+						},
+						resp: &rgtsdk.GetResourcesOutput{
+							ResourceTagMappingList: []*rgtsdk.ResourceTagMapping{
+								{ResourceARN: awssdk.String("lb-arn")},
+							},
+						},
+					},
+					{
+						req: &rgtsdk.GetResourcesInput{
+							TagFilters: []*rgtsdk.TagFilter{
+								{Key: awssdk.String("ingress.k8s.aws/cluster"), Values: awssdk.StringSlice([]string{"cluster-name"})},
+								{Key: awssdk.String("service.k8s.aws/stack"), Values: awssdk.StringSlice([]string{"awesome-ns/awesome-svc"})},
+							},
+						},
+						resp: &rgtsdk.GetResourcesOutput{},
+					},
+				},
+				untagResourcesCalls: []untagResourcesCall{
+					{
+						req: &rgtsdk.UntagResourcesInput{
+							ResourceARNList: awssdk.StringSlice([]string{"lb-arn"}),
+							TagKeys:         awssdk.StringSlice([]string{"elbv2.k8s.aws/cluster", "ingress.k8s.aws/cluster", "service.k8s.aws/resource", "service.k8s.aws/stack"}),
+						},
+						resp: &rgtsdk.UntagResourcesOutput{
+							FailedResourcesMap: map[string]*rgtsdk.FailureInfo{
+								"lb-arn": {ErrorCode: awssdk.String("InternalServiceException")},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("failed to remove tracking tags from resources: [lb-arn]"),
+		},
+		{
+			name:   "Protect policy with deletion protection enabled",
+			policy: elbv2model.DeletionPolicyProtect,
+			fields: fields{
+				listLoadBalancersCalls: []listLoadBalancersCall{
+					{
+						sdkLBs: []elbv2.LoadBalancerWithTags{
+							{LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-arn")}},
+						},
+					},
+				},
+				describeLoadBalancerAttributesCalls: []describeLoadBalancerAttributesCall{
+					{
+						req: &elbv2sdk.DescribeLoadBalancerAttributesInput{LoadBalancerArn: awssdk.String("lb-arn")},
+						resp: &elbv2sdk.DescribeLoadBalancerAttributesOutput{
+							Attributes: []*elbv2sdk.LoadBalancerAttribute{
+								{Key: awssdk.String("deletion_protection.enabled"), Value: awssdk.String("true")},
+							},
+						},
+					},
+				},
+			},
+			wantErr: &DeletionProtectedError{LoadBalancerARN: "lb-arn"},
+		},
+		{
+			name:   "Protect policy with deletion protection disabled",
+			policy: elbv2model.DeletionPolicyProtect,
+			fields: fields{
+				listLoadBalancersCalls: []listLoadBalancersCall{
+					{
+						sdkLBs: []elbv2.LoadBalancerWithTags{
+							{LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-arn")}},
+						},
+					},
+				},
+				describeLoadBalancerAttributesCalls: []describeLoadBalancerAttributesCall{
+					{
+						req: &elbv2sdk.DescribeLoadBalancerAttributesInput{LoadBalancerArn: awssdk.String("lb-arn")},
+						resp: &elbv2sdk.DescribeLoadBalancerAttributesOutput{
+							Attributes: []*elbv2sdk.LoadBalancerAttribute{
+								{Key: awssdk.String("deletion_protection.enabled"), Value: awssdk.String("false")},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "unknown policy",
+			policy:  elbv2model.DeletionPolicy("Keep"),
+			wantErr: errors.New("unknown deletion policy: Keep"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			rgtClient := services.NewMockRGT(ctrl)
+			elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+			for _, call := range tt.fields.getResourcesCalls {
+				resp := call.resp
+				rgtClient.EXPECT().GetResourcesPagesWithContext(gomock.Any(), call.req, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *rgtsdk.GetResourcesInput, fn func(*rgtsdk.GetResourcesOutput, bool) bool, _ ...request.Option) error {
+						fn(resp, true)
+						return nil
+					})
+			}
+			for _, call := range tt.fields.untagResourcesCalls {
+				rgtClient.EXPECT().UntagResourcesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.listLoadBalancersCalls {
+				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(call.sdkLBs, nil)
+			}
+			for _, call := range tt.fields.describeLoadBalancerAttributesCalls {
+				elbv2Client.EXPECT().DescribeLoadBalancerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			ctx := context.Background()
+			for _, tgb := range tgbs {
+				assert.NoError(t, k8sClient.Create(ctx, tgb.DeepCopy()))
+			}
+
+			e := &defaultDeletionPolicyEnforcer{
+				k8sClient:           k8sClient,
+				elbv2Client:         elbv2Client,
+				rgtClient:           rgtClient,
+				trackingProvider:    tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+				elbv2TaggingManager: elbv2TaggingManager,
+				logger:              &log.NullLogger{},
+			}
+			err := e.Enforce(ctx, stackID, tt.policy)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			for tgbName, wantLabels := range tt.wantTGBLabels {
+				tgb := &elbv2api.TargetGroupBinding{}
+				assert.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "awesome-ns", Name: tgbName}, tgb))
+				assert.Equal(t, wantLabels, tgb.Labels)
+			}
+		})
+	}
+}
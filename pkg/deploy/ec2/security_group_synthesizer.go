@@ -6,12 +6,16 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 )
 
+// resourceTypeSecurityGroup is the resource type of SecurityGroup resources.
+const resourceTypeSecurityGroup = "AWS::EC2::SecurityGroup"
+
 // NewSecurityGroupSynthesizer constructs new securityGroupSynthesizer.
 func NewSecurityGroupSynthesizer(ec2Client services.EC2, trackingProvider tracking.Provider, taggingManager TaggingManager,
 	sgManager SecurityGroupManager, vpcID string, logger logr.Logger, stack core.Stack) *securityGroupSynthesizer {
@@ -80,6 +84,64 @@ func (s *securityGroupSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
+func (s *securityGroupSynthesizer) Plan(ctx context.Context) ([]plan.Change, error) {
+	var resSGs []*ec2model.SecurityGroup
+	s.stack.ListResources(&resSGs)
+	sdkSGs, err := s.findSDKSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKSGs, unmatchedResSGs, unmatchedSDKSGs, err := matchResAndSDKSecurityGroups(resSGs, sdkSGs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []plan.Change
+	for _, resSG := range unmatchedResSGs {
+		changes = append(changes, plan.NewCreateChange(resSG))
+		resSG.SetStatus(ec2model.SecurityGroupStatus{
+			GroupID: plan.PlaceholderIdentifier(resSG),
+		})
+	}
+	for _, resAndSDKSG := range matchedResAndSDKSGs {
+		diffs, err := computeSecurityGroupDiffs(resAndSDKSG.resSG, resAndSDKSG.sdkSG)
+		if err != nil {
+			return nil, err
+		}
+		if len(diffs) != 0 {
+			changes = append(changes, plan.NewUpdateChange(resAndSDKSG.resSG, resAndSDKSG.sdkSG.SecurityGroupID, diffs))
+		}
+		resAndSDKSG.resSG.SetStatus(ec2model.SecurityGroupStatus{
+			GroupID: resAndSDKSG.sdkSG.SecurityGroupID,
+		})
+	}
+	// unmatched securityGroups are deleted during post synthesize, which happens after everything else.
+	for _, sdkSG := range unmatchedSDKSGs {
+		changes = append(changes, plan.NewDeleteChange(resourceTypeSecurityGroup, sdkSG.SecurityGroupID))
+	}
+	return changes, nil
+}
+
+// computeSecurityGroupDiffs computes the differences that SecurityGroupManager will reconcile on sdk SecurityGroup.
+func computeSecurityGroupDiffs(resSG *ec2model.SecurityGroup, sdkSG networking.SecurityGroupInfo) ([]plan.FieldDiff, error) {
+	desiredPermissionInfos, err := buildIPPermissionInfos(resSG.Spec.Ingress)
+	if err != nil {
+		return nil, err
+	}
+	desiredPermissions := sets.NewString()
+	for _, permissionInfo := range desiredPermissionInfos {
+		desiredPermissions.Insert(permissionInfo.HashCode())
+	}
+	currentPermissions := sets.NewString()
+	for _, permissionInfo := range sdkSG.Ingress {
+		currentPermissions.Insert(permissionInfo.HashCode())
+	}
+	if desiredPermissions.Equal(currentPermissions) {
+		return nil, nil
+	}
+	return plan.DiffObject("ingress", currentPermissions.List(), desiredPermissions.List()), nil
+}
+
 // findSDKSecurityGroups will find all AWS SecurityGroups created for stack.
 func (s *securityGroupSynthesizer) findSDKSecurityGroups(ctx context.Context) ([]networking.SecurityGroupInfo, error) {
 	stackTags := s.trackingProvider.StackTags(s.stack)
@@ -0,0 +1,144 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewALBTargetRegistrationSynthesizer constructs new albTargetRegistrationSynthesizer.
+func NewALBTargetRegistrationSynthesizer(elbv2Client services.ELBV2, logger logr.Logger, stack core.Stack) *albTargetRegistrationSynthesizer {
+	return &albTargetRegistrationSynthesizer{
+		elbv2Client: elbv2Client,
+		logger:      logger,
+		stack:       stack,
+	}
+}
+
+// albTargetRegistrationSynthesizer is responsible for synthesize the registered Application LoadBalancers of alb TargetGroups for certain stack.
+type albTargetRegistrationSynthesizer struct {
+	elbv2Client services.ELBV2
+	logger      logr.Logger
+	stack       core.Stack
+}
+
+func (s *albTargetRegistrationSynthesizer) Synthesize(ctx context.Context) error {
+	var resRegistrations []*elbv2model.ALBTargetRegistration
+	s.stack.ListResources(&resRegistrations)
+	resRegistrationsByTGARN, err := mapResALBTargetRegistrationByTargetGroupARN(ctx, resRegistrations)
+	if err != nil {
+		return err
+	}
+
+	var resTGs []*elbv2model.TargetGroup
+	s.stack.ListResources(&resTGs)
+	for _, resTG := range resTGs {
+		if resTG.Spec.TargetType != elbv2model.TargetTypeALB {
+			continue
+		}
+		tgARN, err := resTG.TargetGroupARN().Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		if err := s.synthesizeALBTargetsOnTargetGroup(ctx, tgARN, resRegistrationsByTGARN[tgARN]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *albTargetRegistrationSynthesizer) PostSynthesize(ctx context.Context) error {
+	// nothing to do here.
+	return nil
+}
+
+// synthesizeALBTargetsOnTargetGroup registers desired Application LoadBalancers with TargetGroup and deregisters the rest.
+// a TargetGroup with TargetType alb can only have a single Application LoadBalancer registered, so the previous one
+// gets deregistered before a replaced Application LoadBalancer is registered.
+func (s *albTargetRegistrationSynthesizer) synthesizeALBTargetsOnTargetGroup(ctx context.Context, tgARN string, resRegistrations []*elbv2model.ALBTargetRegistration) error {
+	desiredTargets := make([]*elbv2sdk.TargetDescription, 0, len(resRegistrations))
+	for _, resRegistration := range resRegistrations {
+		desiredTargets = append(desiredTargets, &elbv2sdk.TargetDescription{
+			Id:   awssdk.String(resRegistration.Spec.LoadBalancerARN),
+			Port: awssdk.Int64(resRegistration.Spec.Port),
+		})
+	}
+	resp, err := s.elbv2Client.DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	if err != nil {
+		return err
+	}
+	currentTargets := make([]*elbv2sdk.TargetDescription, 0, len(resp.TargetHealthDescriptions))
+	for _, targetHealth := range resp.TargetHealthDescriptions {
+		currentTargets = append(currentTargets, targetHealth.Target)
+	}
+
+	targetsToRegister := diffALBTargets(desiredTargets, currentTargets)
+	targetsToDeregister := diffALBTargets(currentTargets, desiredTargets)
+	if len(targetsToDeregister) != 0 {
+		s.logger.Info("deregistering ALB targets",
+			"arn", tgARN,
+			"targets", targetsToDeregister)
+		if _, err := s.elbv2Client.DeregisterTargetsWithContext(ctx, &elbv2sdk.DeregisterTargetsInput{
+			TargetGroupArn: awssdk.String(tgARN),
+			Targets:        targetsToDeregister,
+		}); err != nil {
+			return errors.Wrap(err, "failed to deregister ALB targets")
+		}
+		s.logger.Info("deregistered ALB targets",
+			"arn", tgARN)
+	}
+	if len(targetsToRegister) != 0 {
+		s.logger.Info("registering ALB targets",
+			"arn", tgARN,
+			"targets", targetsToRegister)
+		if _, err := s.elbv2Client.RegisterTargetsWithContext(ctx, &elbv2sdk.RegisterTargetsInput{
+			TargetGroupArn: awssdk.String(tgARN),
+			Targets:        targetsToRegister,
+		}); err != nil {
+			return errors.Wrap(err, "failed to register ALB targets")
+		}
+		s.logger.Info("registered ALB targets",
+			"arn", tgARN)
+	}
+	return nil
+}
+
+// diffALBTargets returns the targets in lhs but not in rhs.
+func diffALBTargets(lhs []*elbv2sdk.TargetDescription, rhs []*elbv2sdk.TargetDescription) []*elbv2sdk.TargetDescription {
+	rhsTargetKeys := make(map[string]struct{}, len(rhs))
+	for _, target := range rhs {
+		rhsTargetKeys[albTargetKey(target)] = struct{}{}
+	}
+	var diff []*elbv2sdk.TargetDescription
+	for _, target := range lhs {
+		if _, ok := rhsTargetKeys[albTargetKey(target)]; !ok {
+			diff = append(diff, target)
+		}
+	}
+	return diff
+}
+
+func albTargetKey(target *elbv2sdk.TargetDescription) string {
+	return fmt.Sprintf("%s:%d", awssdk.StringValue(target.Id), awssdk.Int64Value(target.Port))
+}
+
+func mapResALBTargetRegistrationByTargetGroupARN(ctx context.Context, resRegistrations []*elbv2model.ALBTargetRegistration) (map[string][]*elbv2model.ALBTargetRegistration, error) {
+	resRegistrationsByTGARN := make(map[string][]*elbv2model.ALBTargetRegistration, len(resRegistrations))
+	for _, resRegistration := range resRegistrations {
+		tgARN, err := resRegistration.Spec.TargetGroupARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resRegistrationsByTGARN[tgARN] = append(resRegistrationsByTGARN[tgARN], resRegistration)
+	}
+	return resRegistrationsByTGARN, nil
+}
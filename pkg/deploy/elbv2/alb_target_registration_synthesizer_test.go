@@ -0,0 +1,201 @@
+package elbv2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"testing"
+)
+
+func Test_albTargetRegistrationSynthesizer_synthesizeALBTargetsOnTargetGroup(t *testing.T) {
+	type describeTargetHealthWithContextCall struct {
+		req  *elbv2sdk.DescribeTargetHealthInput
+		resp *elbv2sdk.DescribeTargetHealthOutput
+		err  error
+	}
+	type registerTargetsWithContextCall struct {
+		req  *elbv2sdk.RegisterTargetsInput
+		resp *elbv2sdk.RegisterTargetsOutput
+		err  error
+	}
+	type deregisterTargetsWithContextCall struct {
+		req  *elbv2sdk.DeregisterTargetsInput
+		resp *elbv2sdk.DeregisterTargetsOutput
+		err  error
+	}
+	type fields struct {
+		describeTargetHealthWithContextCalls []describeTargetHealthWithContextCall
+		registerTargetsWithContextCalls      []registerTargetsWithContextCall
+		deregisterTargetsWithContextCalls    []deregisterTargetsWithContextCall
+	}
+	type args struct {
+		tgARN            string
+		resRegistrations []*elbv2model.ALBTargetRegistration
+	}
+
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resRegistration := &elbv2model.ALBTargetRegistration{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ALBTargetRegistration", "namespace/name:80"),
+		Spec: elbv2model.ALBTargetRegistrationSpec{
+			TargetGroupARN:  coremodel.LiteralStringToken("my-tg"),
+			LoadBalancerARN: "my-alb-2",
+			Port:            80,
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ALB already registered",
+			fields: fields{
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+					{
+						req: &elbv2sdk.DescribeTargetHealthInput{TargetGroupArn: awssdk.String("my-tg")},
+						resp: &elbv2sdk.DescribeTargetHealthOutput{
+							TargetHealthDescriptions: []*elbv2sdk.TargetHealthDescription{
+								{Target: &elbv2sdk.TargetDescription{Id: awssdk.String("my-alb-2"), Port: awssdk.Int64(80)}},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				tgARN:            "my-tg",
+				resRegistrations: []*elbv2model.ALBTargetRegistration{resRegistration},
+			},
+		},
+		{
+			name: "ALB registration failed",
+			fields: fields{
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+					{
+						req:  &elbv2sdk.DescribeTargetHealthInput{TargetGroupArn: awssdk.String("my-tg")},
+						resp: &elbv2sdk.DescribeTargetHealthOutput{},
+					},
+				},
+				registerTargetsWithContextCalls: []registerTargetsWithContextCall{
+					{
+						req: &elbv2sdk.RegisterTargetsInput{
+							TargetGroupArn: awssdk.String("my-tg"),
+							Targets:        []*elbv2sdk.TargetDescription{{Id: awssdk.String("my-alb-2"), Port: awssdk.Int64(80)}},
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			args: args{
+				tgARN:            "my-tg",
+				resRegistrations: []*elbv2model.ALBTargetRegistration{resRegistration},
+			},
+			wantErr: errors.New("failed to register ALB targets: some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.describeTargetHealthWithContextCalls {
+				elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.registerTargetsWithContextCalls {
+				elbv2Client.EXPECT().RegisterTargetsWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.deregisterTargetsWithContextCalls {
+				elbv2Client.EXPECT().DeregisterTargetsWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			s := &albTargetRegistrationSynthesizer{
+				elbv2Client: elbv2Client,
+				logger:      &log.NullLogger{},
+			}
+			err := s.synthesizeALBTargetsOnTargetGroup(context.Background(), tt.args.tgARN, tt.args.resRegistrations)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test_albTargetRegistrationSynthesizer_synthesizeALBTargetsOnTargetGroup_ALBReplaced verifies a replaced ALB gets
+// registered, given a TargetGroup with TargetType alb can only have a single ALB registered.
+func Test_albTargetRegistrationSynthesizer_synthesizeALBTargetsOnTargetGroup_ALBReplaced(t *testing.T) {
+	ctx := context.Background()
+	cloud := fake.NewCloud("us-west-2")
+	var subnetIDs []string
+	for _, subnet := range []struct {
+		cidrBlock string
+		az        string
+	}{
+		{cidrBlock: "192.168.0.0/19", az: "us-west-2a"},
+		{cidrBlock: "192.168.32.0/19", az: "us-west-2b"},
+	} {
+		resp, err := cloud.EC2().CreateSubnetWithContext(ctx, &ec2sdk.CreateSubnetInput{
+			VpcId:            awssdk.String(cloud.VpcID()),
+			CidrBlock:        awssdk.String(subnet.cidrBlock),
+			AvailabilityZone: awssdk.String(subnet.az),
+		})
+		require.NoError(t, err)
+		subnetIDs = append(subnetIDs, awssdk.StringValue(resp.Subnet.SubnetId))
+	}
+	var albARNs []string
+	for _, name := range []string{"my-alb-1", "my-alb-2"} {
+		resp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+			Name:    awssdk.String(name),
+			Type:    awssdk.String(elbv2sdk.LoadBalancerTypeEnumApplication),
+			Subnets: awssdk.StringSlice(subnetIDs),
+		})
+		require.NoError(t, err)
+		albARNs = append(albARNs, awssdk.StringValue(resp.LoadBalancers[0].LoadBalancerArn))
+	}
+	tgResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("my-tg"),
+		Port:       awssdk.Int64(80),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumTcp),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumAlb),
+		VpcId:      awssdk.String(cloud.VpcID()),
+	})
+	require.NoError(t, err)
+	tgARN := awssdk.StringValue(tgResp.TargetGroups[0].TargetGroupArn)
+	_, err = cloud.ELBV2().RegisterTargetsWithContext(ctx, &elbv2sdk.RegisterTargetsInput{
+		TargetGroupArn: awssdk.String(tgARN),
+		Targets:        []*elbv2sdk.TargetDescription{{Id: awssdk.String(albARNs[0]), Port: awssdk.Int64(80)}},
+	})
+	require.NoError(t, err)
+
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resRegistration := &elbv2model.ALBTargetRegistration{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ALBTargetRegistration", "namespace/name:80"),
+		Spec: elbv2model.ALBTargetRegistrationSpec{
+			TargetGroupARN:  coremodel.LiteralStringToken(tgARN),
+			LoadBalancerARN: albARNs[1],
+			Port:            80,
+		},
+	}
+	s := &albTargetRegistrationSynthesizer{
+		elbv2Client: cloud.ELBV2(),
+		logger:      &log.NullLogger{},
+	}
+	require.NoError(t, s.synthesizeALBTargetsOnTargetGroup(ctx, tgARN, []*elbv2model.ALBTargetRegistration{resRegistration}))
+
+	resp, err := cloud.ELBV2().DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.TargetHealthDescriptions, 1)
+	assert.Equal(t, albARNs[1], awssdk.StringValue(resp.TargetHealthDescriptions[0].Target.Id))
+}
@@ -124,11 +124,18 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 	if err != nil {
 		return err
 	}
-	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
-	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts) {
+	desiredDefaultCerts, _, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return err
+	}
+	desiredMutualAuth, err := buildSDKMutualAuthentication(resLS.Spec)
+	if err != nil {
+		return err
+	}
+	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredMutualAuth) {
 		return nil
 	}
-	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts)
+	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts, desiredMutualAuth)
 	req.ListenerArn = sdkLS.Listener.ListenerArn
 	m.logger.Info("modifying listener",
 		"stackID", resLS.Stack().StackID(),
@@ -149,7 +156,10 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx context.Context, resLS *elbv2model.Listener,
 	sdkLS ListenerWithTags, isNewSDKListener bool) error {
 	desiredExtraCertARNs := sets.NewString()
-	_, desiredExtraCerts := buildSDKCertificates(resLS.Spec.Certificates)
+	_, desiredExtraCerts, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return err
+	}
 	for _, cert := range desiredExtraCerts {
 		desiredExtraCertARNs.Insert(awssdk.StringValue(cert.CertificateArn))
 	}
@@ -231,7 +241,8 @@ func (m *defaultListenerManager) fetchSDKListenerExtraCertificateARNs(ctx contex
 }
 
 func isSDKListenerSettingsDrifted(lsSpec elbv2model.ListenerSpec, sdkLS ListenerWithTags,
-	desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate) bool {
+	desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate,
+	desiredMutualAuth *elbv2sdk.MutualAuthenticationAttributes) bool {
 	if lsSpec.Port != awssdk.Int64Value(sdkLS.Listener.Port) {
 		return true
 	}
@@ -250,7 +261,36 @@ func isSDKListenerSettingsDrifted(lsSpec elbv2model.ListenerSpec, sdkLS Listener
 	if len(lsSpec.ALPNPolicy) != 0 && !cmp.Equal(lsSpec.ALPNPolicy, awssdk.StringValueSlice(sdkLS.Listener.AlpnPolicy), cmpopts.EquateEmpty()) {
 		return true
 	}
+	if isSDKListenerMutualAuthenticationDrifted(desiredMutualAuth, sdkLS.Listener.MutualAuthentication) {
+		return true
+	}
+
+	return false
+}
 
+// isSDKListenerMutualAuthenticationDrifted checks whether the mutual authentication settings drifted.
+// mutual authentication is considered as off if it's absent on listener.
+func isSDKListenerMutualAuthenticationDrifted(desiredMutualAuth *elbv2sdk.MutualAuthenticationAttributes,
+	sdkMutualAuth *elbv2sdk.MutualAuthenticationAttributes) bool {
+	if desiredMutualAuth == nil {
+		return false
+	}
+	sdkMode := string(elbv2model.MutualAuthenticationModeOff)
+	if sdkMutualAuth != nil && sdkMutualAuth.Mode != nil {
+		sdkMode = awssdk.StringValue(sdkMutualAuth.Mode)
+	}
+	if awssdk.StringValue(desiredMutualAuth.Mode) != sdkMode {
+		return true
+	}
+	if sdkMode == string(elbv2model.MutualAuthenticationModeOff) {
+		return false
+	}
+	if awssdk.StringValue(desiredMutualAuth.TrustStoreArn) != awssdk.StringValue(sdkMutualAuth.TrustStoreArn) {
+		return true
+	}
+	if awssdk.BoolValue(desiredMutualAuth.IgnoreClientCertificateExpiry) != awssdk.BoolValue(sdkMutualAuth.IgnoreClientCertificateExpiry) {
+		return true
+	}
 	return false
 }
 
@@ -269,15 +309,27 @@ func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec) (*elbv2sdk.Crea
 		return nil, err
 	}
 	sdkObj.DefaultActions = defaultActions
-	sdkObj.Certificates, _ = buildSDKCertificates(lsSpec.Certificates)
+	certificates, _, err := buildSDKCertificates(lsSpec.Certificates)
+	if err != nil {
+		return nil, err
+	}
+	sdkObj.Certificates = certificates
 	sdkObj.SslPolicy = lsSpec.SSLPolicy
 	if len(lsSpec.ALPNPolicy) != 0 {
 		sdkObj.AlpnPolicy = awssdk.StringSlice(lsSpec.ALPNPolicy)
 	}
+	if lsSpec.MutualAuthentication != nil {
+		mutualAuth, err := buildSDKMutualAuthentication(lsSpec)
+		if err != nil {
+			return nil, err
+		}
+		sdkObj.MutualAuthentication = mutualAuth
+	}
 	return sdkObj, nil
 }
 
-func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate) *elbv2sdk.ModifyListenerInput {
+func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate,
+	desiredMutualAuth *elbv2sdk.MutualAuthenticationAttributes) *elbv2sdk.ModifyListenerInput {
 	sdkObj := &elbv2sdk.ModifyListenerInput{}
 	sdkObj.Port = awssdk.Int64(lsSpec.Port)
 	sdkObj.Protocol = awssdk.String(string(lsSpec.Protocol))
@@ -287,29 +339,68 @@ func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultA
 	if len(lsSpec.ALPNPolicy) != 0 {
 		sdkObj.AlpnPolicy = awssdk.StringSlice(lsSpec.ALPNPolicy)
 	}
+	sdkObj.MutualAuthentication = desiredMutualAuth
 	return sdkObj
 }
 
+// buildSDKMutualAuthentication builds the desired mutual authentication settings for listener.
+// mutual authentication is turned off for HTTPS listeners without it, and left untouched for other listeners.
+func buildSDKMutualAuthentication(lsSpec elbv2model.ListenerSpec) (*elbv2sdk.MutualAuthenticationAttributes, error) {
+	if lsSpec.MutualAuthentication == nil {
+		if lsSpec.Protocol == elbv2model.ProtocolHTTPS {
+			return &elbv2sdk.MutualAuthenticationAttributes{
+				Mode: awssdk.String(string(elbv2model.MutualAuthenticationModeOff)),
+			}, nil
+		}
+		return nil, nil
+	}
+
+	sdkObj := &elbv2sdk.MutualAuthenticationAttributes{
+		Mode: awssdk.String(string(lsSpec.MutualAuthentication.Mode)),
+	}
+	if lsSpec.MutualAuthentication.TrustStoreARN != nil {
+		trustStoreARN, err := lsSpec.MutualAuthentication.TrustStoreARN.Resolve(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		sdkObj.TrustStoreArn = awssdk.String(trustStoreARN)
+	}
+	sdkObj.IgnoreClientCertificateExpiry = lsSpec.MutualAuthentication.IgnoreClientCertificateExpiry
+	return sdkObj, nil
+}
+
 // buildSDKCertificates builds the certificate list for listener.
 // returns the default certificates and extra certificates.
-func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]*elbv2sdk.Certificate, []*elbv2sdk.Certificate) {
+func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]*elbv2sdk.Certificate, []*elbv2sdk.Certificate, error) {
 	if len(modelCerts) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	var defaultSDKCerts []*elbv2sdk.Certificate
 	var extraSDKCerts []*elbv2sdk.Certificate
-	defaultSDKCerts = append(defaultSDKCerts, buildSDKCertificate(modelCerts[0]))
-	for _, cert := range modelCerts[1:] {
-		extraSDKCerts = append(extraSDKCerts, buildSDKCertificate(cert))
+	for index, modelCert := range modelCerts {
+		sdkCert, err := buildSDKCertificate(modelCert)
+		if err != nil {
+			return nil, nil, err
+		}
+		if index == 0 {
+			defaultSDKCerts = append(defaultSDKCerts, sdkCert)
+		} else {
+			extraSDKCerts = append(extraSDKCerts, sdkCert)
+		}
 	}
-	return defaultSDKCerts, extraSDKCerts
+	return defaultSDKCerts, extraSDKCerts, nil
 }
 
-func buildSDKCertificate(modelCert elbv2model.Certificate) *elbv2sdk.Certificate {
-	return &elbv2sdk.Certificate{
-		CertificateArn: modelCert.CertificateARN,
+func buildSDKCertificate(modelCert elbv2model.Certificate) (*elbv2sdk.Certificate, error) {
+	ctx := context.Background()
+	certARN, err := modelCert.CertificateARN.Resolve(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return &elbv2sdk.Certificate{
+		CertificateArn: awssdk.String(certARN),
+	}, nil
 }
 
 func buildResListenerStatus(sdkLS ListenerWithTags) elbv2model.ListenerStatus {
@@ -4,6 +4,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
@@ -14,6 +15,7 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 		sdkLS                 ListenerWithTags
 		desiredDefaultActions []*elbv2sdk.Action
 		desiredDefaultCerts   []*elbv2sdk.Certificate
+		desiredMutualAuth     *elbv2sdk.MutualAuthenticationAttributes
 	}
 	tests := []struct {
 		name string
@@ -77,10 +79,10 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 					ALPNPolicy: []string{"HTTP2Preferred"},
 					Certificates: []elbv2model.Certificate{
 						{
-							CertificateARN: awssdk.String("cert-arn1"),
+							CertificateARN: core.LiteralStringToken("cert-arn1"),
 						},
 						{
-							CertificateARN: awssdk.String("cert-arn2"),
+							CertificateARN: core.LiteralStringToken("cert-arn2"),
 						},
 					},
 				},
@@ -179,7 +181,102 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := isSDKListenerSettingsDrifted(tt.args.lsSpec, tt.args.sdkLS, tt.args.desiredDefaultActions, tt.args.desiredDefaultCerts)
+			got := isSDKListenerSettingsDrifted(tt.args.lsSpec, tt.args.sdkLS, tt.args.desiredDefaultActions, tt.args.desiredDefaultCerts, tt.args.desiredMutualAuth)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isSDKListenerMutualAuthenticationDrifted(t *testing.T) {
+	type args struct {
+		desiredMutualAuth *elbv2sdk.MutualAuthenticationAttributes
+		sdkMutualAuth     *elbv2sdk.MutualAuthenticationAttributes
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "desired mutual authentication is nil",
+			args: args{
+				desiredMutualAuth: nil,
+				sdkMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("passthrough"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "mutual authentication is off and absent on listener",
+			args: args{
+				desiredMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("off"),
+				},
+				sdkMutualAuth: nil,
+			},
+			want: false,
+		},
+		{
+			name: "mutual authentication is off but passthrough on listener",
+			args: args{
+				desiredMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("off"),
+				},
+				sdkMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("passthrough"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "mutual authentication is verify with same trust store",
+			args: args{
+				desiredMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn"),
+				},
+				sdkMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:                          awssdk.String("verify"),
+					TrustStoreArn:                 awssdk.String("ts-arn"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(false),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "mutual authentication is verify with different trust store",
+			args: args{
+				desiredMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn-2"),
+				},
+				sdkMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "mutual authentication is verify with different ignoreClientCertificateExpiry",
+			args: args{
+				desiredMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:                          awssdk.String("verify"),
+					TrustStoreArn:                 awssdk.String("ts-arn"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(true),
+				},
+				sdkMutualAuth: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn"),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKListenerMutualAuthenticationDrifted(tt.args.desiredMutualAuth, tt.args.sdkMutualAuth)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -22,6 +22,9 @@ type ListenerRuleManager interface {
 	Update(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) (elbv2model.ListenerRuleStatus, error)
 
 	Delete(ctx context.Context, sdkLR ListenerRuleWithTags) error
+
+	// SetRulePriorities changes the priorities of ListenerRules in a single batch, so that rules can swap priorities.
+	SetRulePriorities(ctx context.Context, rulePriorities []*elbv2sdk.RulePriorityPair) error
 }
 
 // NewDefaultListenerRuleManager constructs new defaultListenerRuleManager.
@@ -107,6 +110,23 @@ func (m *defaultListenerRuleManager) Delete(ctx context.Context, sdkLR ListenerR
 	return nil
 }
 
+func (m *defaultListenerRuleManager) SetRulePriorities(ctx context.Context, rulePriorities []*elbv2sdk.RulePriorityPair) error {
+	if len(rulePriorities) == 0 {
+		return nil
+	}
+	req := &elbv2sdk.SetRulePrioritiesInput{
+		RulePriorities: rulePriorities,
+	}
+	m.logger.Info("setting listener rule priorities",
+		"rulePriorities", rulePriorities)
+	if _, err := m.elbv2Client.SetRulePrioritiesWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to set listener rule priorities")
+	}
+	m.logger.Info("set listener rule priorities",
+		"rulePriorities", rulePriorities)
+	return nil
+}
+
 func (m *defaultListenerRuleManager) updateSDKListenerRuleWithSettings(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) error {
 	desiredActions, err := buildSDKActions(resLR.Spec.Actions)
 	if err != nil {
@@ -3,31 +3,43 @@ package elbv2
 import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sort"
 	"strconv"
 )
 
+// resourceTypeListenerRule is the resource type of ListenerRule resources.
+const resourceTypeListenerRule = "AWS::ElasticLoadBalancingV2::ListenerRule"
+
 // NewListenerRuleSynthesizer constructs new listenerRuleSynthesizer.
-func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
+func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
 	lrManager ListenerRuleManager, logger logr.Logger, stack core.Stack) *listenerRuleSynthesizer {
 	return &listenerRuleSynthesizer{
-		elbv2Client:    elbv2Client,
-		lrManager:      lrManager,
-		logger:         logger,
-		taggingManager: taggingManager,
-		stack:          stack,
+		elbv2Client:      elbv2Client,
+		trackingProvider: trackingProvider,
+		lrManager:        lrManager,
+		logger:           logger,
+		taggingManager:   taggingManager,
+		stack:            stack,
 	}
 }
 
 type listenerRuleSynthesizer struct {
-	elbv2Client    services.ELBV2
-	lrManager      ListenerRuleManager
-	logger         logr.Logger
-	taggingManager TaggingManager
+	elbv2Client      services.ELBV2
+	trackingProvider tracking.Provider
+	lrManager        ListenerRuleManager
+	logger           logr.Logger
+	taggingManager   TaggingManager
 
 	stack core.Stack
 }
@@ -42,13 +54,27 @@ func (s *listenerRuleSynthesizer) Synthesize(ctx context.Context) error {
 
 	var resLSs []*elbv2model.Listener
 	s.stack.ListResources(&resLSs)
+	resLSARNs := sets.NewString()
 	for _, resLS := range resLSs {
 		lsARN, err := resLS.ListenerARN().Resolve(ctx)
 		if err != nil {
 			return err
 		}
-		resLRs := resLRsByLSARN[lsARN]
-		if err := s.synthesizeListenerRulesOnListener(ctx, lsARN, resLRs); err != nil {
+		resLSARNs.Insert(lsARN)
+		sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
+		if err != nil {
+			return err
+		}
+		if err := s.synthesizeListenerRulesOnListener(ctx, resLRsByLSARN[lsARN], sdkLRs); err != nil {
+			return err
+		}
+	}
+	for _, lsARN := range sets.StringKeySet(resLRsByLSARN).Difference(resLSARNs).List() {
+		sdkLRs, err := s.findSDKStackListenerRulesOnSharedLS(ctx, lsARN)
+		if err != nil {
+			return err
+		}
+		if err := s.synthesizeListenerRulesOnListener(ctx, resLRsByLSARN[lsARN], sdkLRs); err != nil {
 			return err
 		}
 	}
@@ -60,24 +86,90 @@ func (s *listenerRuleSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
-func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule) error {
-	sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
+func (s *listenerRuleSynthesizer) Plan(ctx context.Context) ([]plan.Change, error) {
+	var resLRs []*elbv2model.ListenerRule
+	s.stack.ListResources(&resLRs)
+	resLRsByLSARN, err := mapResListenerRuleByListenerARN(resLRs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs)
-	for _, sdkLR := range unmatchedSDKLRs {
-		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
-			return err
+	var resLSs []*elbv2model.Listener
+	s.stack.ListResources(&resLSs)
+	resLSARNs := sets.NewString()
+	var changes []plan.Change
+	for _, resLS := range resLSs {
+		lsARN, err := resLS.ListenerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resLSARNs.Insert(lsARN)
+		var sdkLRs []ListenerRuleWithTags
+		// there won't be any rules on a Listener that is yet to be created.
+		if !plan.IsPlaceholderIdentifier(lsARN) {
+			if sdkLRs, err = s.findSDKListenersRulesOnLS(ctx, lsARN); err != nil {
+				return nil, err
+			}
 		}
+		lsChanges, err := s.planListenerRulesOnListener(ctx, resLRsByLSARN[lsARN], sdkLRs)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, lsChanges...)
+	}
+	for _, lsARN := range sets.StringKeySet(resLRsByLSARN).Difference(resLSARNs).List() {
+		sdkLRs, err := s.findSDKStackListenerRulesOnSharedLS(ctx, lsARN)
+		if err != nil {
+			return nil, err
+		}
+		lsChanges, err := s.planListenerRulesOnListener(ctx, resLRsByLSARN[lsARN], sdkLRs)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, lsChanges...)
+	}
+	return changes, nil
+}
+
+func (s *listenerRuleSynthesizer) planListenerRulesOnListener(_ context.Context, resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) ([]plan.Change, error) {
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs, s.trackingProvider.ResourceIDTagKey())
+
+	var changes []plan.Change
+	for _, sdkLR := range unmatchedSDKLRs {
+		changes = append(changes, plan.NewDeleteChange(resourceTypeListenerRule, awssdk.StringValue(sdkLR.ListenerRule.RuleArn)))
 	}
 	for _, resLR := range unmatchedResLRs {
-		lrStatus, err := s.lrManager.Create(ctx, resLR)
+		changes = append(changes, plan.NewCreateChange(resLR))
+		resLR.SetStatus(elbv2model.ListenerRuleStatus{
+			RuleARN: plan.PlaceholderIdentifier(resLR),
+		})
+	}
+	for _, resAndSDKLR := range matchedResAndSDKLRs {
+		diffs, err := computeListenerRuleDiffs(resAndSDKLR.resLR, resAndSDKLR.sdkLR)
 		if err != nil {
+			return nil, err
+		}
+		if len(diffs) != 0 {
+			changes = append(changes, plan.NewUpdateChange(resAndSDKLR.resLR, awssdk.StringValue(resAndSDKLR.sdkLR.ListenerRule.RuleArn), diffs))
+		}
+		resAndSDKLR.resLR.SetStatus(buildResListenerRuleStatus(resAndSDKLR.sdkLR))
+	}
+	return changes, nil
+}
+
+func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) error {
+	// Listener rule priorities must be unique within a Listener, so we reconcile them in following order:
+	// 1. delete unmatched rules to release their priorities.
+	// 2. reorder matched rules in place to their desired priorities.
+	// 3. update matched rules, then create unmatched rules on the priorities left available.
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs, s.trackingProvider.ResourceIDTagKey())
+	for _, sdkLR := range unmatchedSDKLRs {
+		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
 			return err
 		}
-		resLR.SetStatus(lrStatus)
+	}
+	if err := s.lrManager.SetRulePriorities(ctx, buildSDKRulePriorityPairs(matchedResAndSDKLRs)); err != nil {
+		return err
 	}
 	for _, resAndSDKLR := range matchedResAndSDKLRs {
 		lsStatus, err := s.lrManager.Update(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR)
@@ -86,6 +178,13 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		}
 		resAndSDKLR.resLR.SetStatus(lsStatus)
 	}
+	for _, resLR := range unmatchedResLRs {
+		lrStatus, err := s.lrManager.Create(ctx, resLR)
+		if err != nil {
+			return err
+		}
+		resLR.SetStatus(lrStatus)
+	}
 	return nil
 }
 
@@ -105,18 +204,86 @@ func (s *listenerRuleSynthesizer) findSDKListenersRulesOnLS(ctx context.Context,
 	return nonDefaultRules, nil
 }
 
+// findSDKStackListenerRulesOnSharedLS returns the listenerRules of this stack configured on a Listener that isn't part of this stack.
+// such Listeners are managed outside of this stack, and can contain rules managed by others.
+func (s *listenerRuleSynthesizer) findSDKStackListenerRulesOnSharedLS(ctx context.Context, lsARN string) ([]ListenerRuleWithTags, error) {
+	sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
+	if err != nil {
+		return nil, err
+	}
+	return filterSDKListenerRulesByTags(sdkLRs, s.trackingProvider.StackTags(s.stack)), nil
+}
+
+// filterSDKListenerRulesByTags returns the listenerRules that contain all specified tags.
+func filterSDKListenerRulesByTags(sdkLRs []ListenerRuleWithTags, tags map[string]string) []ListenerRuleWithTags {
+	var matchedSDKLRs []ListenerRuleWithTags
+	for _, sdkLR := range sdkLRs {
+		if algorithm.IsStringMapSubset(tags, sdkLR.Tags) {
+			matchedSDKLRs = append(matchedSDKLRs, sdkLR)
+		}
+	}
+	return matchedSDKLRs
+}
+
+// computeListenerRuleDiffs computes the differences that ListenerRuleManager will reconcile on sdk ListenerRule.
+func computeListenerRuleDiffs(resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) ([]plan.FieldDiff, error) {
+	desiredActions, err := buildSDKActions(resLR.Spec.Actions)
+	if err != nil {
+		return nil, err
+	}
+	desiredConditions := buildSDKRuleConditions(resLR.Spec.Conditions)
+
+	var diffs []plan.FieldDiff
+	if sdkPriority := parseSDKListenerRulePriority(sdkLR); sdkPriority != resLR.Spec.Priority {
+		diffs = append(diffs, plan.DiffObject("priority", sdkPriority, resLR.Spec.Priority)...)
+	}
+	if !cmp.Equal(desiredActions, sdkLR.ListenerRule.Actions, elbv2equality.CompareOptionForActions()) {
+		diffs = append(diffs, plan.DiffObject("actions", sdkLR.ListenerRule.Actions, desiredActions)...)
+	}
+	if !cmp.Equal(desiredConditions, sdkLR.ListenerRule.Conditions, elbv2equality.CompareOptionForRuleConditions()) {
+		diffs = append(diffs, plan.DiffObject("conditions", sdkLR.ListenerRule.Conditions, desiredConditions)...)
+	}
+	return diffs, nil
+}
+
 type resAndSDKListenerRulePair struct {
 	resLR *elbv2model.ListenerRule
 	sdkLR ListenerRuleWithTags
 }
 
-func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags) {
+// matchResAndSDKListenerRules matches listener rule resources with sdk listener rules.
+// Rules are matched by resourceID tag first, so that rules keep their identity when priorities shift.
+// The remaining rules are matched by priority, which covers rules created before resourceID is independent of priority.
+func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags,
+	resourceIDTagKey string) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags) {
 	var matchedResAndSDKLRs []resAndSDKListenerRulePair
 	var unmatchedResLRs []*elbv2model.ListenerRule
 	var unmatchedSDKLRs []ListenerRuleWithTags
 
-	resLRByPriority := mapResListenerRuleByPriority(resLRs)
-	sdkLRByPriority := mapSDKListenerRuleByPriority(sdkLRs)
+	resLRByID := mapResListenerRuleByResourceID(resLRs)
+	matchedResIDs := sets.NewString()
+	var sdkLRsWithoutIDMatch []ListenerRuleWithTags
+	for _, sdkLR := range sdkLRs {
+		resID, ok := sdkLR.Tags[resourceIDTagKey]
+		if resLR, exists := resLRByID[resID]; ok && exists && !matchedResIDs.Has(resID) {
+			matchedResIDs.Insert(resID)
+			matchedResAndSDKLRs = append(matchedResAndSDKLRs, resAndSDKListenerRulePair{
+				resLR: resLR,
+				sdkLR: sdkLR,
+			})
+			continue
+		}
+		sdkLRsWithoutIDMatch = append(sdkLRsWithoutIDMatch, sdkLR)
+	}
+	var resLRsWithoutIDMatch []*elbv2model.ListenerRule
+	for _, resLR := range resLRs {
+		if !matchedResIDs.Has(resLR.ID()) {
+			resLRsWithoutIDMatch = append(resLRsWithoutIDMatch, resLR)
+		}
+	}
+
+	resLRByPriority := mapResListenerRuleByPriority(resLRsWithoutIDMatch)
+	sdkLRByPriority := mapSDKListenerRuleByPriority(sdkLRsWithoutIDMatch)
 	resLRPriorities := sets.Int64KeySet(resLRByPriority)
 	sdkLRPriorities := sets.Int64KeySet(sdkLRByPriority)
 	for _, priority := range resLRPriorities.Intersection(sdkLRPriorities).List() {
@@ -137,6 +304,32 @@ func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []Lis
 	return matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs
 }
 
+// buildSDKRulePriorityPairs builds the priority changes needed for matched listener rules, ordered by desired priority.
+func buildSDKRulePriorityPairs(resAndSDKLRs []resAndSDKListenerRulePair) []*elbv2sdk.RulePriorityPair {
+	var rulePriorities []*elbv2sdk.RulePriorityPair
+	for _, resAndSDKLR := range resAndSDKLRs {
+		if parseSDKListenerRulePriority(resAndSDKLR.sdkLR) == resAndSDKLR.resLR.Spec.Priority {
+			continue
+		}
+		rulePriorities = append(rulePriorities, &elbv2sdk.RulePriorityPair{
+			RuleArn:  resAndSDKLR.sdkLR.ListenerRule.RuleArn,
+			Priority: awssdk.Int64(resAndSDKLR.resLR.Spec.Priority),
+		})
+	}
+	sort.Slice(rulePriorities, func(i, j int) bool {
+		return awssdk.Int64Value(rulePriorities[i].Priority) < awssdk.Int64Value(rulePriorities[j].Priority)
+	})
+	return rulePriorities
+}
+
+func mapResListenerRuleByResourceID(resLRs []*elbv2model.ListenerRule) map[string]*elbv2model.ListenerRule {
+	resLRByID := make(map[string]*elbv2model.ListenerRule, len(resLRs))
+	for _, resLR := range resLRs {
+		resLRByID[resLR.ID()] = resLR
+	}
+	return resLRByID
+}
+
 func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*elbv2model.ListenerRule {
 	resLRByPriority := make(map[int64]*elbv2model.ListenerRule, len(resLRs))
 	for _, resLR := range resLRs {
@@ -148,12 +341,16 @@ func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*
 func mapSDKListenerRuleByPriority(sdkLRs []ListenerRuleWithTags) map[int64]ListenerRuleWithTags {
 	sdkLRByPriority := make(map[int64]ListenerRuleWithTags, len(sdkLRs))
 	for _, sdkLR := range sdkLRs {
-		priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
-		sdkLRByPriority[priority] = sdkLR
+		sdkLRByPriority[parseSDKListenerRulePriority(sdkLR)] = sdkLR
 	}
 	return sdkLRByPriority
 }
 
+func parseSDKListenerRulePriority(sdkLR ListenerRuleWithTags) int64 {
+	priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+	return priority
+}
+
 func mapResListenerRuleByListenerARN(resLRs []*elbv2model.ListenerRule) (map[string][]*elbv2model.ListenerRule, error) {
 	resLRsByLSARN := make(map[string][]*elbv2model.ListenerRule, len(resLRs))
 	ctx := context.Background()
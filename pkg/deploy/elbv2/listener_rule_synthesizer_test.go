@@ -0,0 +1,304 @@
+package elbv2
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"testing"
+)
+
+func Test_matchResAndSDKListenerRules(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	buildResLR := func(id string, priority int64) *elbv2model.ListenerRule {
+		return &elbv2model.ListenerRule{
+			ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", id),
+			Spec: elbv2model.ListenerRuleSpec{
+				Priority: priority,
+			},
+		}
+	}
+	buildSDKLR := func(arn string, priority string, resID string) ListenerRuleWithTags {
+		return ListenerRuleWithTags{
+			ListenerRule: &elbv2sdk.Rule{
+				RuleArn:  awssdk.String(arn),
+				Priority: awssdk.String(priority),
+			},
+			Tags: map[string]string{
+				"ingress.k8s.aws/resource": resID,
+			},
+		}
+	}
+	type args struct {
+		resLRs           []*elbv2model.ListenerRule
+		sdkLRs           []ListenerRuleWithTags
+		resourceIDTagKey string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  []resAndSDKListenerRulePair
+		want1 []*elbv2model.ListenerRule
+		want2 []ListenerRuleWithTags
+	}{
+		{
+			name: "all rules matched by resourceID",
+			args: args{
+				resLRs: []*elbv2model.ListenerRule{
+					buildResLR("80:rule-a", 1),
+					buildResLR("80:rule-b", 2),
+				},
+				sdkLRs: []ListenerRuleWithTags{
+					buildSDKLR("arn-1", "1", "80:rule-a"),
+					buildSDKLR("arn-2", "2", "80:rule-b"),
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKListenerRulePair{
+				{
+					resLR: buildResLR("80:rule-a", 1),
+					sdkLR: buildSDKLR("arn-1", "1", "80:rule-a"),
+				},
+				{
+					resLR: buildResLR("80:rule-b", 2),
+					sdkLR: buildSDKLR("arn-2", "2", "80:rule-b"),
+				},
+			},
+		},
+		{
+			name: "rule inserted at top shifts priorities of matched rules",
+			args: args{
+				resLRs: []*elbv2model.ListenerRule{
+					buildResLR("80:rule-c", 1),
+					buildResLR("80:rule-a", 2),
+					buildResLR("80:rule-b", 3),
+				},
+				sdkLRs: []ListenerRuleWithTags{
+					buildSDKLR("arn-1", "1", "80:rule-a"),
+					buildSDKLR("arn-2", "2", "80:rule-b"),
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKListenerRulePair{
+				{
+					resLR: buildResLR("80:rule-a", 2),
+					sdkLR: buildSDKLR("arn-1", "1", "80:rule-a"),
+				},
+				{
+					resLR: buildResLR("80:rule-b", 3),
+					sdkLR: buildSDKLR("arn-2", "2", "80:rule-b"),
+				},
+			},
+			want1: []*elbv2model.ListenerRule{
+				buildResLR("80:rule-c", 1),
+			},
+		},
+		{
+			name: "rule removed",
+			args: args{
+				resLRs: []*elbv2model.ListenerRule{
+					buildResLR("80:rule-b", 1),
+				},
+				sdkLRs: []ListenerRuleWithTags{
+					buildSDKLR("arn-1", "1", "80:rule-a"),
+					buildSDKLR("arn-2", "2", "80:rule-b"),
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKListenerRulePair{
+				{
+					resLR: buildResLR("80:rule-b", 1),
+					sdkLR: buildSDKLR("arn-2", "2", "80:rule-b"),
+				},
+			},
+			want2: []ListenerRuleWithTags{
+				buildSDKLR("arn-1", "1", "80:rule-a"),
+			},
+		},
+		{
+			name: "rules with positional resourceID matched by priority",
+			args: args{
+				resLRs: []*elbv2model.ListenerRule{
+					buildResLR("80:rule-a", 1),
+					buildResLR("80:rule-b", 2),
+					buildResLR("80:rule-c", 3),
+				},
+				sdkLRs: []ListenerRuleWithTags{
+					buildSDKLR("arn-1", "1", "80:1"),
+					buildSDKLR("arn-2", "2", "80:2"),
+					buildSDKLR("arn-4", "4", "80:4"),
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKListenerRulePair{
+				{
+					resLR: buildResLR("80:rule-a", 1),
+					sdkLR: buildSDKLR("arn-1", "1", "80:1"),
+				},
+				{
+					resLR: buildResLR("80:rule-b", 2),
+					sdkLR: buildSDKLR("arn-2", "2", "80:2"),
+				},
+			},
+			want1: []*elbv2model.ListenerRule{
+				buildResLR("80:rule-c", 3),
+			},
+			want2: []ListenerRuleWithTags{
+				buildSDKLR("arn-4", "4", "80:4"),
+			},
+		},
+		{
+			name: "duplicated resourceID on sdk rules",
+			args: args{
+				resLRs: []*elbv2model.ListenerRule{
+					buildResLR("80:rule-a", 1),
+				},
+				sdkLRs: []ListenerRuleWithTags{
+					buildSDKLR("arn-1", "1", "80:rule-a"),
+					buildSDKLR("arn-2", "2", "80:rule-a"),
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKListenerRulePair{
+				{
+					resLR: buildResLR("80:rule-a", 1),
+					sdkLR: buildSDKLR("arn-1", "1", "80:rule-a"),
+				},
+			},
+			want2: []ListenerRuleWithTags{
+				buildSDKLR("arn-2", "2", "80:rule-a"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := matchResAndSDKListenerRules(tt.args.resLRs, tt.args.sdkLRs, tt.args.resourceIDTagKey)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want1, got1)
+			assert.Equal(t, tt.want2, got2)
+		})
+	}
+}
+
+func Test_buildSDKRulePriorityPairs(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	buildPair := func(arn string, currentPriority string, desiredPriority int64) resAndSDKListenerRulePair {
+		return resAndSDKListenerRulePair{
+			resLR: &elbv2model.ListenerRule{
+				ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", arn),
+				Spec: elbv2model.ListenerRuleSpec{
+					Priority: desiredPriority,
+				},
+			},
+			sdkLR: ListenerRuleWithTags{
+				ListenerRule: &elbv2sdk.Rule{
+					RuleArn:  awssdk.String(arn),
+					Priority: awssdk.String(currentPriority),
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		resAndSDKLRs []resAndSDKListenerRulePair
+		want         []*elbv2sdk.RulePriorityPair
+	}{
+		{
+			name: "no priority changes",
+			resAndSDKLRs: []resAndSDKListenerRulePair{
+				buildPair("arn-1", "1", 1),
+				buildPair("arn-2", "2", 2),
+			},
+			want: nil,
+		},
+		{
+			name: "swapped priorities",
+			resAndSDKLRs: []resAndSDKListenerRulePair{
+				buildPair("arn-1", "1", 2),
+				buildPair("arn-2", "2", 1),
+				buildPair("arn-3", "3", 3),
+			},
+			want: []*elbv2sdk.RulePriorityPair{
+				{
+					RuleArn:  awssdk.String("arn-2"),
+					Priority: awssdk.Int64(1),
+				},
+				{
+					RuleArn:  awssdk.String("arn-1"),
+					Priority: awssdk.Int64(2),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildSDKRulePriorityPairs(tt.resAndSDKLRs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_filterSDKListenerRulesByTags(t *testing.T) {
+	buildSDKLR := func(arn string, tags map[string]string) ListenerRuleWithTags {
+		return ListenerRuleWithTags{
+			ListenerRule: &elbv2sdk.Rule{
+				RuleArn: awssdk.String(arn),
+			},
+			Tags: tags,
+		}
+	}
+	stackTags := map[string]string{
+		"elbv2.k8s.aws/cluster":       "cluster-name",
+		"listener-rule.k8s.aws/stack": "namespace/name",
+	}
+	tests := []struct {
+		name   string
+		sdkLRs []ListenerRuleWithTags
+		tags   map[string]string
+		want   []ListenerRuleWithTags
+	}{
+		{
+			name: "only rules with all tags are matched",
+			sdkLRs: []ListenerRuleWithTags{
+				buildSDKLR("arn-1", map[string]string{
+					"elbv2.k8s.aws/cluster":          "cluster-name",
+					"listener-rule.k8s.aws/stack":    "namespace/name",
+					"listener-rule.k8s.aws/resource": "rule",
+				}),
+				buildSDKLR("arn-2", map[string]string{
+					"elbv2.k8s.aws/cluster":       "cluster-name",
+					"listener-rule.k8s.aws/stack": "namespace/other-name",
+				}),
+				buildSDKLR("arn-3", map[string]string{
+					"listener-rule.k8s.aws/stack": "namespace/name",
+				}),
+				buildSDKLR("arn-4", nil),
+			},
+			tags: stackTags,
+			want: []ListenerRuleWithTags{
+				buildSDKLR("arn-1", map[string]string{
+					"elbv2.k8s.aws/cluster":          "cluster-name",
+					"listener-rule.k8s.aws/stack":    "namespace/name",
+					"listener-rule.k8s.aws/resource": "rule",
+				}),
+			},
+		},
+		{
+			name: "no rules matched",
+			sdkLRs: []ListenerRuleWithTags{
+				buildSDKLR("arn-1", map[string]string{
+					"team": "awesome-team",
+				}),
+			},
+			tags: stackTags,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterSDKListenerRulesByTags(tt.sdkLRs, tt.tags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,28 +4,38 @@ import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
-func NewListenerSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
+// resourceTypeListener is the resource type of Listener resources.
+const resourceTypeListener = "AWS::ElasticLoadBalancingV2::Listener"
+
+func NewListenerSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
 	lsManager ListenerManager, logger logr.Logger, stack core.Stack) *listenerSynthesizer {
 	return &listenerSynthesizer{
-		elbv2Client:    elbv2Client,
-		lsManager:      lsManager,
-		logger:         logger,
-		taggingManager: taggingManager,
-		stack:          stack,
+		elbv2Client:      elbv2Client,
+		trackingProvider: trackingProvider,
+		lsManager:        lsManager,
+		logger:           logger,
+		taggingManager:   taggingManager,
+		stack:            stack,
 	}
 }
 
 type listenerSynthesizer struct {
-	elbv2Client    services.ELBV2
-	lsManager      ListenerManager
-	logger         logr.Logger
-	taggingManager TaggingManager
+	elbv2Client      services.ELBV2
+	trackingProvider tracking.Provider
+	lsManager        ListenerManager
+	logger           logr.Logger
+	taggingManager   TaggingManager
 
 	stack core.Stack
 }
@@ -37,9 +47,13 @@ func (s *listenerSynthesizer) Synthesize(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	preservingLBARNs, err := s.findLoadBalancersPreservingUnmanagedListeners(ctx)
+	if err != nil {
+		return err
+	}
 
 	for lbARN, resLSs := range resLSsByLBARN {
-		if err := s.synthesizeListenersOnLB(ctx, lbARN, resLSs); err != nil {
+		if err := s.synthesizeListenersOnLB(ctx, lbARN, resLSs, preservingLBARNs.Has(lbARN)); err != nil {
 			return err
 		}
 	}
@@ -51,12 +65,75 @@ func (s *listenerSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
-func (s *listenerSynthesizer) synthesizeListenersOnLB(ctx context.Context, lbARN string, resLSs []*elbv2model.Listener) error {
+func (s *listenerSynthesizer) Plan(ctx context.Context) ([]plan.Change, error) {
+	var resLSs []*elbv2model.Listener
+	s.stack.ListResources(&resLSs)
+	resLSsByLBARN, err := mapResListenerByLoadBalancerARN(resLSs)
+	if err != nil {
+		return nil, err
+	}
+	preservingLBARNs, err := s.findLoadBalancersPreservingUnmanagedListeners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []plan.Change
+	for _, lbARN := range sets.StringKeySet(resLSsByLBARN).List() {
+		lbChanges, err := s.planListenersOnLB(ctx, lbARN, resLSsByLBARN[lbARN], preservingLBARNs.Has(lbARN))
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, lbChanges...)
+	}
+	return changes, nil
+}
+
+func (s *listenerSynthesizer) planListenersOnLB(ctx context.Context, lbARN string, resLSs []*elbv2model.Listener, preserveUnmanagedListeners bool) ([]plan.Change, error) {
+	var sdkLSs []ListenerWithTags
+	// there won't be any listeners on a LoadBalancer that is yet to be created.
+	if !plan.IsPlaceholderIdentifier(lbARN) {
+		var err error
+		if sdkLSs, err = s.findSDKListenersOnLB(ctx, lbARN); err != nil {
+			return nil, err
+		}
+	}
+	matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs := matchResAndSDKListeners(resLSs, sdkLSs)
+	if preserveUnmanagedListeners {
+		unmatchedSDKLSs = s.filterManagedSDKListeners(unmatchedSDKLSs)
+	}
+
+	var changes []plan.Change
+	for _, sdkLS := range unmatchedSDKLSs {
+		changes = append(changes, plan.NewDeleteChange(resourceTypeListener, awssdk.StringValue(sdkLS.Listener.ListenerArn)))
+	}
+	for _, resLS := range unmatchedResLSs {
+		changes = append(changes, plan.NewCreateChange(resLS))
+		resLS.SetStatus(elbv2model.ListenerStatus{
+			ListenerARN: plan.PlaceholderIdentifier(resLS),
+		})
+	}
+	for _, resAndSDKLS := range matchedResAndSDKLSs {
+		diffs, err := computeListenerDiffs(resAndSDKLS.resLS, resAndSDKLS.sdkLS)
+		if err != nil {
+			return nil, err
+		}
+		if len(diffs) != 0 {
+			changes = append(changes, plan.NewUpdateChange(resAndSDKLS.resLS, awssdk.StringValue(resAndSDKLS.sdkLS.Listener.ListenerArn), diffs))
+		}
+		resAndSDKLS.resLS.SetStatus(buildResListenerStatus(resAndSDKLS.sdkLS))
+	}
+	return changes, nil
+}
+
+func (s *listenerSynthesizer) synthesizeListenersOnLB(ctx context.Context, lbARN string, resLSs []*elbv2model.Listener, preserveUnmanagedListeners bool) error {
 	sdkLSs, err := s.findSDKListenersOnLB(ctx, lbARN)
 	if err != nil {
 		return err
 	}
 	matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs := matchResAndSDKListeners(resLSs, sdkLSs)
+	if preserveUnmanagedListeners {
+		unmatchedSDKLSs = s.filterManagedSDKListeners(unmatchedSDKLSs)
+	}
 	for _, sdkLS := range unmatchedSDKLSs {
 		if err := s.lsManager.Delete(ctx, sdkLS); err != nil {
 			return err
@@ -84,6 +161,81 @@ func (s *listenerSynthesizer) findSDKListenersOnLB(ctx context.Context, lbARN st
 	return s.taggingManager.ListListeners(ctx, lbARN)
 }
 
+// findLoadBalancersPreservingUnmanagedListeners returns the ARN of LoadBalancers that requested to preserve listeners not managed by controller.
+func (s *listenerSynthesizer) findLoadBalancersPreservingUnmanagedListeners(ctx context.Context) (sets.String, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	s.stack.ListResources(&resLBs)
+	lbARNs := sets.NewString()
+	for _, resLB := range resLBs {
+		if !resLB.Spec.PreserveUnmanagedListeners {
+			continue
+		}
+		lbARN, err := resLB.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lbARNs.Insert(lbARN)
+	}
+	return lbARNs, nil
+}
+
+// filterManagedSDKListeners returns the listeners that are tracked by stack, i.e. created by controller.
+func (s *listenerSynthesizer) filterManagedSDKListeners(sdkLSs []ListenerWithTags) []ListenerWithTags {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	var managedSDKLSs []ListenerWithTags
+	for _, sdkLS := range sdkLSs {
+		if isSDKListenerManaged(sdkLS, stackTags) {
+			managedSDKLSs = append(managedSDKLSs, sdkLS)
+		}
+	}
+	return managedSDKLSs
+}
+
+// isSDKListenerManaged checks whether sdk Listener carries all stack tags.
+func isSDKListenerManaged(sdkLS ListenerWithTags, stackTags map[string]string) bool {
+	for tagKey, tagValue := range stackTags {
+		if sdkLS.Tags[tagKey] != tagValue {
+			return false
+		}
+	}
+	return true
+}
+
+// computeListenerDiffs computes the differences that ListenerManager will reconcile on sdk Listener.
+func computeListenerDiffs(resLS *elbv2model.Listener, sdkLS ListenerWithTags) ([]plan.FieldDiff, error) {
+	desiredDefaultActions, err := buildSDKActions(resLS.Spec.DefaultActions)
+	if err != nil {
+		return nil, err
+	}
+	desiredDefaultCerts, _, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return nil, err
+	}
+	desiredMutualAuth, err := buildSDKMutualAuthentication(resLS.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []plan.FieldDiff
+	diffs = append(diffs, plan.DiffString("protocol", awssdk.StringValue(sdkLS.Listener.Protocol), string(resLS.Spec.Protocol))...)
+	if !cmp.Equal(desiredDefaultActions, sdkLS.Listener.DefaultActions, elbv2equality.CompareOptionForActions()) {
+		diffs = append(diffs, plan.DiffObject("defaultActions", sdkLS.Listener.DefaultActions, desiredDefaultActions)...)
+	}
+	if !cmp.Equal(desiredDefaultCerts, sdkLS.Listener.Certificates, elbv2equality.CompareOptionForCertificates()) {
+		diffs = append(diffs, plan.DiffObject("certificates", sdkLS.Listener.Certificates, desiredDefaultCerts)...)
+	}
+	if resLS.Spec.SSLPolicy != nil {
+		diffs = append(diffs, plan.DiffString("sslPolicy", awssdk.StringValue(sdkLS.Listener.SslPolicy), awssdk.StringValue(resLS.Spec.SSLPolicy))...)
+	}
+	if len(resLS.Spec.ALPNPolicy) != 0 && !cmp.Equal(resLS.Spec.ALPNPolicy, awssdk.StringValueSlice(sdkLS.Listener.AlpnPolicy), cmpopts.EquateEmpty()) {
+		diffs = append(diffs, plan.DiffObject("alpnPolicy", awssdk.StringValueSlice(sdkLS.Listener.AlpnPolicy), resLS.Spec.ALPNPolicy)...)
+	}
+	if isSDKListenerMutualAuthenticationDrifted(desiredMutualAuth, sdkLS.Listener.MutualAuthentication) {
+		diffs = append(diffs, plan.DiffObject("mutualAuthentication", sdkLS.Listener.MutualAuthentication, desiredMutualAuth)...)
+	}
+	return diffs, nil
+}
+
 type resAndSDKListenerPair struct {
 	resLS *elbv2model.Listener
 	sdkLS ListenerWithTags
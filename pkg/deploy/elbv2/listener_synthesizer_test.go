@@ -0,0 +1,189 @@
+package elbv2
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"testing"
+)
+
+func Test_computeListenerDiffs(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	type args struct {
+		resLS *elbv2model.Listener
+		sdkLS ListenerWithTags
+	}
+	tests := []struct {
+		name string
+		args args
+		want []plan.FieldDiff
+	}{
+		{
+			name: "listener hasn't drifted",
+			args: args{
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "80"),
+					Spec: elbv2model.ListenerSpec{
+						Port:     80,
+						Protocol: elbv2model.ProtocolHTTP,
+						DefaultActions: []elbv2model.Action{
+							{
+								Type: elbv2model.ActionTypeFixedResponse,
+								FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+									StatusCode: "404",
+								},
+							},
+						},
+					},
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						Port:     awssdk.Int64(80),
+						Protocol: awssdk.String("HTTP"),
+						DefaultActions: []*elbv2sdk.Action{
+							{
+								Type: awssdk.String("fixed-response"),
+								FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+									StatusCode: awssdk.String("404"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "listener protocol and sslPolicy drifted",
+			args: args{
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "443"),
+					Spec: elbv2model.ListenerSpec{
+						Port:      443,
+						Protocol:  elbv2model.ProtocolHTTPS,
+						SSLPolicy: awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+					},
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						Port:      awssdk.Int64(443),
+						Protocol:  awssdk.String("HTTP"),
+						SslPolicy: awssdk.String("ELBSecurityPolicy-2016-08"),
+					},
+				},
+			},
+			want: []plan.FieldDiff{
+				{
+					Field:   "protocol",
+					Current: "HTTP",
+					Desired: "HTTPS",
+				},
+				{
+					Field:   "sslPolicy",
+					Current: "ELBSecurityPolicy-2016-08",
+					Desired: "ELBSecurityPolicy-TLS-1-2-2017-01",
+				},
+			},
+		},
+		{
+			name: "listener defaultActions drifted",
+			args: args{
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "80"),
+					Spec: elbv2model.ListenerSpec{
+						Port:     80,
+						Protocol: elbv2model.ProtocolHTTP,
+						DefaultActions: []elbv2model.Action{
+							{
+								Type: elbv2model.ActionTypeFixedResponse,
+								FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+									StatusCode: "503",
+								},
+							},
+						},
+					},
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						Port:     awssdk.Int64(80),
+						Protocol: awssdk.String("HTTP"),
+						DefaultActions: []*elbv2sdk.Action{
+							{
+								Type: awssdk.String("fixed-response"),
+								FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+									StatusCode: awssdk.String("404"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []plan.FieldDiff{
+				{
+					Field:   "defaultActions",
+					Current: `[{"AuthenticateCognitoConfig":null,"AuthenticateOidcConfig":null,"FixedResponseConfig":{"ContentType":null,"MessageBody":null,"StatusCode":"404"},"ForwardConfig":null,"Order":null,"RedirectConfig":null,"TargetGroupArn":null,"Type":"fixed-response"}]`,
+					Desired: `[{"AuthenticateCognitoConfig":null,"AuthenticateOidcConfig":null,"FixedResponseConfig":{"ContentType":null,"MessageBody":null,"StatusCode":"503"},"ForwardConfig":null,"Order":1,"RedirectConfig":null,"TargetGroupArn":null,"Type":"fixed-response"}]`,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := computeListenerDiffs(tt.args.resLS, tt.args.sdkLS)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isSDKListenerManaged(t *testing.T) {
+	stackTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "awesome-ns/awesome-ing",
+	}
+	tests := []struct {
+		name  string
+		sdkLS ListenerWithTags
+		want  bool
+	}{
+		{
+			name: "listener with stack tags",
+			sdkLS: ListenerWithTags{
+				Listener: &elbv2sdk.Listener{ListenerArn: awssdk.String("ls-arn")},
+				Tags: map[string]string{
+					"elbv2.k8s.aws/cluster":    "cluster-name",
+					"ingress.k8s.aws/stack":    "awesome-ns/awesome-ing",
+					"ingress.k8s.aws/resource": "80",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "listener without tags",
+			sdkLS: ListenerWithTags{
+				Listener: &elbv2sdk.Listener{ListenerArn: awssdk.String("ls-arn")},
+			},
+			want: false,
+		},
+		{
+			name: "listener with tags of another stack",
+			sdkLS: ListenerWithTags{
+				Listener: &elbv2sdk.Listener{ListenerArn: awssdk.String("ls-arn")},
+				Tags: map[string]string{
+					"elbv2.k8s.aws/cluster": "cluster-name",
+					"ingress.k8s.aws/stack": "another-ns/another-ing",
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKListenerManaged(tt.sdkLS, stackTags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,9 +5,13 @@ import (
 	"errors"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
 )
 
@@ -410,3 +414,48 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 		})
 	}
 }
+
+// the IAM policy for adoption only allows tagging untagged loadBalancers with the cluster tag,
+// so the cluster tag must be added before any other change is made to an adopted loadBalancer.
+func Test_defaultLoadBalancerManager_updateSDKLoadBalancerWithTags_adoption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "awesome-ns", Name: "awesome-ing"})
+	resLB := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+		Type:                 elbv2model.LoadBalancerTypeApplication,
+		AdoptLoadBalancerARN: awssdk.String("lb-arn"),
+	})
+	sdkLB := LoadBalancerWithTags{
+		LoadBalancer: &elbv2sdk.LoadBalancer{
+			LoadBalancerArn: awssdk.String("lb-arn"),
+		},
+		Tags: map[string]string{
+			"Environment": "prod",
+		},
+	}
+
+	elbv2Client := services.NewMockELBV2(ctrl)
+	gomock.InOrder(
+		elbv2Client.EXPECT().AddTagsWithContext(gomock.Any(), &elbv2sdk.AddTagsInput{
+			ResourceArns: awssdk.StringSlice([]string{"lb-arn"}),
+			Tags: []*elbv2sdk.Tag{
+				{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster-name")},
+				{Key: awssdk.String("ingress.k8s.aws/resource"), Value: awssdk.String("LoadBalancer")},
+				{Key: awssdk.String("ingress.k8s.aws/stack"), Value: awssdk.String("awesome-ns/awesome-ing")},
+			},
+		}).Return(&elbv2sdk.AddTagsOutput{}, nil),
+		elbv2Client.EXPECT().RemoveTagsWithContext(gomock.Any(), &elbv2sdk.RemoveTagsInput{
+			ResourceArns: awssdk.StringSlice([]string{"lb-arn"}),
+			TagKeys:      awssdk.StringSlice([]string{"Environment"}),
+		}).Return(&elbv2sdk.RemoveTagsOutput{}, nil),
+	)
+
+	m := &defaultLoadBalancerManager{
+		trackingProvider: tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+		taggingManager:   NewDefaultTaggingManager(elbv2Client, &log.NullLogger{}),
+		logger:           &log.NullLogger{},
+	}
+	err := m.updateSDKLoadBalancerWithTags(context.Background(), resLB, sdkLB)
+	assert.NoError(t, err)
+}
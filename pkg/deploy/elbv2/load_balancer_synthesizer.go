@@ -3,23 +3,29 @@ package elbv2
 import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+// resourceTypeLoadBalancer is the resource type of LoadBalancer resources.
+const resourceTypeLoadBalancer = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
 // NewLoadBalancerSynthesizer constructs loadBalancerSynthesizer
 func NewLoadBalancerSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
-	lbManager LoadBalancerManager, logger logr.Logger, stack core.Stack) *loadBalancerSynthesizer {
+	lbManager LoadBalancerManager, vpcID string, logger logr.Logger, stack core.Stack) *loadBalancerSynthesizer {
 	return &loadBalancerSynthesizer{
 		elbv2Client:      elbv2Client,
 		trackingProvider: trackingProvider,
 		taggingManager:   taggingManager,
 		lbManager:        lbManager,
+		vpcID:            vpcID,
 		logger:           logger,
 		stack:            stack,
 	}
@@ -31,6 +37,7 @@ type loadBalancerSynthesizer struct {
 	trackingProvider tracking.Provider
 	taggingManager   TaggingManager
 	lbManager        LoadBalancerManager
+	vpcID            string
 	logger           logr.Logger
 
 	stack core.Stack
@@ -48,6 +55,11 @@ func (s *loadBalancerSynthesizer) Synthesize(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	adoptedResAndSDKLBs, unmatchedResLBs, err := s.adoptSDKLoadBalancers(ctx, unmatchedResLBs)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKLBs = append(matchedResAndSDKLBs, adoptedResAndSDKLBs...)
 
 	// For LoadBalancers, we delete unmatched ones first given below facts:
 	//  * LoadBalancer delete will automatically delete listeners attached to it.
@@ -80,6 +92,98 @@ func (s *loadBalancerSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
+func (s *loadBalancerSynthesizer) Plan(ctx context.Context) ([]plan.Change, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	s.stack.ListResources(&resLBs)
+	sdkLBs, err := s.findSDKLoadBalancers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matchedResAndSDKLBs, unmatchedResLBs, unmatchedSDKLBs, err := matchResAndSDKLoadBalancers(resLBs, sdkLBs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+	adoptedResAndSDKLBs, unmatchedResLBs, err := s.adoptSDKLoadBalancers(ctx, unmatchedResLBs)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKLBs = append(matchedResAndSDKLBs, adoptedResAndSDKLBs...)
+
+	var changes []plan.Change
+	for _, sdkLB := range unmatchedSDKLBs {
+		changes = append(changes, plan.NewDeleteChange(resourceTypeLoadBalancer, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)))
+	}
+	for _, resLB := range unmatchedResLBs {
+		changes = append(changes, plan.NewCreateChange(resLB))
+		placeholder := plan.PlaceholderIdentifier(resLB)
+		resLB.SetStatus(elbv2model.LoadBalancerStatus{
+			LoadBalancerARN: placeholder,
+			DNSName:         placeholder,
+		})
+	}
+	for _, resAndSDKLB := range matchedResAndSDKLBs {
+		diffs, err := s.computeLoadBalancerDiffs(ctx, resAndSDKLB.resLB, resAndSDKLB.sdkLB)
+		if err != nil {
+			return nil, err
+		}
+		if len(diffs) != 0 {
+			changes = append(changes, plan.NewUpdateChange(resAndSDKLB.resLB, awssdk.StringValue(resAndSDKLB.sdkLB.LoadBalancer.LoadBalancerArn), diffs))
+		}
+		resAndSDKLB.resLB.SetStatus(buildResLoadBalancerStatus(resAndSDKLB.sdkLB))
+	}
+	return changes, nil
+}
+
+// computeLoadBalancerDiffs computes the differences that LoadBalancerManager will reconcile on sdk LoadBalancer.
+func (s *loadBalancerSynthesizer) computeLoadBalancerDiffs(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) ([]plan.FieldDiff, error) {
+	var diffs []plan.FieldDiff
+	securityGroups, err := buildSDKSecurityGroups(resLB.Spec.SecurityGroups)
+	if err != nil {
+		return nil, err
+	}
+	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
+	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)...)
+	if !desiredSecurityGroups.Equal(currentSecurityGroups) {
+		diffs = append(diffs, plan.DiffObject("securityGroups", currentSecurityGroups.List(), desiredSecurityGroups.List())...)
+	}
+
+	desiredSubnets := sets.NewString()
+	for _, mapping := range resLB.Spec.SubnetMappings {
+		desiredSubnets.Insert(mapping.SubnetID)
+	}
+	currentSubnets := sets.NewString()
+	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
+		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
+	}
+	if !desiredSubnets.Equal(currentSubnets) {
+		diffs = append(diffs, plan.DiffObject("subnets", currentSubnets.List(), desiredSubnets.List())...)
+	}
+
+	if resLB.Spec.IPAddressType != nil {
+		diffs = append(diffs, plan.DiffString("ipAddressType", awssdk.StringValue(sdkLB.LoadBalancer.IpAddressType), string(*resLB.Spec.IPAddressType))...)
+	}
+
+	desiredAttrs := make(map[string]string, len(resLB.Spec.LoadBalancerAttributes))
+	for _, attr := range resLB.Spec.LoadBalancerAttributes {
+		desiredAttrs[attr.Key] = attr.Value
+	}
+	if len(desiredAttrs) != 0 {
+		resp, err := s.elbv2Client.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2sdk.DescribeLoadBalancerAttributesInput{
+			LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		})
+		if err != nil {
+			return nil, err
+		}
+		currentAttrs := make(map[string]string, len(resp.Attributes))
+		for _, attr := range resp.Attributes {
+			currentAttrs[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+		}
+		diffs = append(diffs, plan.DiffStringMap("loadBalancerAttributes", currentAttrs, desiredAttrs)...)
+	}
+	return diffs, nil
+}
+
 // findSDKLoadBalancers will find all AWS LoadBalancer created for stack.
 func (s *loadBalancerSynthesizer) findSDKLoadBalancers(ctx context.Context) ([]LoadBalancerWithTags, error) {
 	stackTags := s.trackingProvider.StackTags(s.stack)
@@ -89,6 +193,93 @@ func (s *loadBalancerSynthesizer) findSDKLoadBalancers(ctx context.Context) ([]L
 		tracking.TagsAsTagFilter(stackTagsLegacy))
 }
 
+// adoptSDKLoadBalancers finds the existing LoadBalancers to adopt for LoadBalancer resources that don't have a matching AWS LoadBalancer.
+// LoadBalancer resources that don't request adoption are returned as is, so that new LoadBalancers will be created for them.
+func (s *loadBalancerSynthesizer) adoptSDKLoadBalancers(ctx context.Context, resLBs []*elbv2model.LoadBalancer) ([]resAndSDKLoadBalancerPair, []*elbv2model.LoadBalancer, error) {
+	var adoptedResAndSDKLBs []resAndSDKLoadBalancerPair
+	var unadoptedResLBs []*elbv2model.LoadBalancer
+	for _, resLB := range resLBs {
+		if resLB.Spec.AdoptLoadBalancerARN == nil {
+			unadoptedResLBs = append(unadoptedResLBs, resLB)
+			continue
+		}
+		sdkLB, err := s.describeSDKLoadBalancer(ctx, awssdk.StringValue(resLB.Spec.AdoptLoadBalancerARN))
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := s.validateSDKLoadBalancerForAdoption(resLB, sdkLB); err != nil {
+			return nil, nil, err
+		}
+		adoptedResAndSDKLBs = append(adoptedResAndSDKLBs, resAndSDKLoadBalancerPair{
+			resLB: resLB,
+			sdkLB: sdkLB,
+		})
+	}
+	return adoptedResAndSDKLBs, unadoptedResLBs, nil
+}
+
+// describeSDKLoadBalancer describes the AWS LoadBalancer along with its tags by ARN.
+func (s *loadBalancerSynthesizer) describeSDKLoadBalancer(ctx context.Context, lbARN string) (LoadBalancerWithTags, error) {
+	lbs, err := s.elbv2Client.DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN}),
+	})
+	if err != nil {
+		return LoadBalancerWithTags{}, errors.Wrapf(err, "failed to describe loadBalancer to adopt: %v", lbARN)
+	}
+	if len(lbs) != 1 {
+		return LoadBalancerWithTags{}, errors.Errorf("expect exactly one loadBalancer to adopt: %v", lbARN)
+	}
+	resp, err := s.elbv2Client.DescribeTagsWithContext(ctx, &elbv2sdk.DescribeTagsInput{
+		ResourceArns: awssdk.StringSlice([]string{lbARN}),
+	})
+	if err != nil {
+		return LoadBalancerWithTags{}, err
+	}
+	tags := make(map[string]string)
+	if len(resp.TagDescriptions) != 0 {
+		tags = convertSDKTagsToTags(resp.TagDescriptions[0].Tags)
+	}
+	return LoadBalancerWithTags{
+		LoadBalancer: lbs[0],
+		Tags:         tags,
+	}, nil
+}
+
+// stackTagKeys are the tag keys used to track AWS resources provisioned for Ingresses, Services and Gateways.
+var stackTagKeys = []string{"ingress.k8s.aws/stack", "service.k8s.aws/stack", "gateway.k8s.aws/stack"}
+
+// validateSDKLoadBalancerForAdoption checks whether an AWS LoadBalancer can be adopted to fulfill a LoadBalancer resource.
+// adoption is refused if type/scheme/VPC mismatches, or if it's already tracked by another stack of any kind.
+func (s *loadBalancerSynthesizer) validateSDKLoadBalancerForAdoption(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	if sdkLBType := awssdk.StringValue(sdkLB.LoadBalancer.Type); string(resLB.Spec.Type) != sdkLBType {
+		return errors.Errorf("cannot adopt loadBalancer %v: expect type %v, got %v", lbARN, resLB.Spec.Type, sdkLBType)
+	}
+	if sdkLBScheme := awssdk.StringValue(sdkLB.LoadBalancer.Scheme); resLB.Spec.Scheme != nil && string(*resLB.Spec.Scheme) != sdkLBScheme {
+		return errors.Errorf("cannot adopt loadBalancer %v: expect scheme %v, got %v", lbARN, *resLB.Spec.Scheme, sdkLBScheme)
+	}
+	if sdkLBVpcID := awssdk.StringValue(sdkLB.LoadBalancer.VpcId); s.vpcID != sdkLBVpcID {
+		return errors.Errorf("cannot adopt loadBalancer %v: expect vpc %v, got %v", lbARN, s.vpcID, sdkLBVpcID)
+	}
+	for _, stackTags := range []map[string]string{s.trackingProvider.StackTags(s.stack), s.trackingProvider.StackTagsLegacy(s.stack)} {
+		for tagKey, tagValue := range stackTags {
+			if sdkTagValue, exists := sdkLB.Tags[tagKey]; exists && sdkTagValue != tagValue {
+				return errors.Errorf("cannot adopt loadBalancer %v: already tracked with tag %v=%v", lbARN, tagKey, sdkTagValue)
+			}
+		}
+	}
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	for _, tagKey := range stackTagKeys {
+		if _, isOwnTagKey := stackTags[tagKey]; isOwnTagKey {
+			continue
+		}
+		if sdkTagValue, exists := sdkLB.Tags[tagKey]; exists {
+			return errors.Errorf("cannot adopt loadBalancer %v: already tracked with tag %v=%v", lbARN, tagKey, sdkTagValue)
+		}
+	}
+	return nil
+}
+
 type resAndSDKLoadBalancerPair struct {
 	resLB *elbv2model.LoadBalancer
 	sdkLB LoadBalancerWithTags
@@ -113,6 +304,11 @@ func matchResAndSDKLoadBalancers(resLBs []*elbv2model.LoadBalancer, sdkLBs []Loa
 		sdkLBs := sdkLBsByID[resID]
 		foundMatch := false
 		for _, sdkLB := range sdkLBs {
+			// changing the LoadBalancer to adopt must not replace the LoadBalancer that is already provisioned.
+			if resLB.Spec.AdoptLoadBalancerARN != nil && awssdk.StringValue(resLB.Spec.AdoptLoadBalancerARN) != awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn) {
+				return nil, nil, nil, errors.Errorf("cannot adopt loadBalancer %v: loadBalancer %v is already provisioned and won't be replaced",
+					awssdk.StringValue(resLB.Spec.AdoptLoadBalancerARN), awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+			}
 			if isSDKLoadBalancerRequiresReplacement(sdkLB, resLB) {
 				unmatchedSDKLBs = append(unmatchedSDKLBs, sdkLB)
 				continue
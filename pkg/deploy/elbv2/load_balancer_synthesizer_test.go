@@ -5,6 +5,7 @@ import (
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
@@ -279,6 +280,34 @@ func Test_matchResAndSDKLoadBalancers(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "changing loadBalancer to adopt is refused",
+			args: args{
+				resLBs: []*elbv2model.LoadBalancer{
+					{
+						ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::LoadBalancer", "id-1"),
+						Spec: elbv2model.LoadBalancerSpec{
+							Name:                 "id-1",
+							Type:                 elbv2model.LoadBalancerTypeApplication,
+							AdoptLoadBalancerARN: awssdk.String("arn-2"),
+						},
+					},
+				},
+				sdkLBs: []LoadBalancerWithTags{
+					{
+						LoadBalancer: &elbv2sdk.LoadBalancer{
+							LoadBalancerArn: awssdk.String("arn-1"),
+							Type:            awssdk.String("application"),
+						},
+						Tags: map[string]string{
+							"ingress.k8s.aws/resource": "id-1",
+						},
+					},
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			wantErr: errors.New("cannot adopt loadBalancer arn-2: loadBalancer arn-1 is already provisioned and won't be replaced"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -586,6 +615,28 @@ func Test_isSDKLoadBalancerRequiresReplacement(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "adopted loadBalancer don't need replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("lb-arn-1"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:                 elbv2model.LoadBalancerTypeApplication,
+						Scheme:               &schemaInternetFacing,
+						Name:                 "my-lb",
+						AdoptLoadBalancerARN: awssdk.String("lb-arn-1"),
+					},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -594,3 +645,93 @@ func Test_isSDKLoadBalancerRequiresReplacement(t *testing.T) {
 		})
 	}
 }
+
+func Test_loadBalancerSynthesizer_validateSDKLoadBalancerForAdoption(t *testing.T) {
+	schemeInternetFacing := elbv2model.LoadBalancerSchemeInternetFacing
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "awesome-ns", Name: "awesome-ing"})
+	resLB := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+		Type:                 elbv2model.LoadBalancerTypeApplication,
+		Scheme:               &schemeInternetFacing,
+		AdoptLoadBalancerARN: awssdk.String("lb-arn"),
+	})
+	buildSDKLB := func(lbType string, scheme string, vpcID string, tags map[string]string) LoadBalancerWithTags {
+		return LoadBalancerWithTags{
+			LoadBalancer: &elbv2sdk.LoadBalancer{
+				LoadBalancerArn: awssdk.String("lb-arn"),
+				Type:            awssdk.String(lbType),
+				Scheme:          awssdk.String(scheme),
+				VpcId:           awssdk.String(vpcID),
+			},
+			Tags: tags,
+		}
+	}
+	tests := []struct {
+		name    string
+		sdkLB   LoadBalancerWithTags
+		wantErr error
+	}{
+		{
+			name:  "unmanaged loadBalancer can be adopted",
+			sdkLB: buildSDKLB("application", "internet-facing", "vpc-xxx", map[string]string{"Environment": "prod"}),
+		},
+		{
+			name: "loadBalancer tracked by same stack can be adopted",
+			sdkLB: buildSDKLB("application", "internet-facing", "vpc-xxx", map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster-name",
+				"ingress.k8s.aws/stack": "awesome-ns/awesome-ing",
+			}),
+		},
+		{
+			name:    "type mismatches",
+			sdkLB:   buildSDKLB("network", "internet-facing", "vpc-xxx", nil),
+			wantErr: errors.New("cannot adopt loadBalancer lb-arn: expect type application, got network"),
+		},
+		{
+			name:    "scheme mismatches",
+			sdkLB:   buildSDKLB("application", "internal", "vpc-xxx", nil),
+			wantErr: errors.New("cannot adopt loadBalancer lb-arn: expect scheme internet-facing, got internal"),
+		},
+		{
+			name:    "vpc mismatches",
+			sdkLB:   buildSDKLB("application", "internet-facing", "vpc-yyy", nil),
+			wantErr: errors.New("cannot adopt loadBalancer lb-arn: expect vpc vpc-xxx, got vpc-yyy"),
+		},
+		{
+			name: "loadBalancer tracked by another stack",
+			sdkLB: buildSDKLB("application", "internet-facing", "vpc-xxx", map[string]string{
+				"ingress.k8s.aws/stack": "another-ns/another-ing",
+			}),
+			wantErr: errors.New("cannot adopt loadBalancer lb-arn: already tracked with tag ingress.k8s.aws/stack=another-ns/another-ing"),
+		},
+		{
+			name: "loadBalancer tracked by a service",
+			sdkLB: buildSDKLB("application", "internet-facing", "vpc-xxx", map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster-name",
+				"service.k8s.aws/stack": "awesome-ns/awesome-svc",
+			}),
+			wantErr: errors.New("cannot adopt loadBalancer lb-arn: already tracked with tag service.k8s.aws/stack=awesome-ns/awesome-svc"),
+		},
+		{
+			name: "loadBalancer tracked by a gateway",
+			sdkLB: buildSDKLB("application", "internet-facing", "vpc-xxx", map[string]string{
+				"gateway.k8s.aws/stack": "awesome-ns/awesome-gw",
+			}),
+			wantErr: errors.New("cannot adopt loadBalancer lb-arn: already tracked with tag gateway.k8s.aws/stack=awesome-ns/awesome-gw"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &loadBalancerSynthesizer{
+				trackingProvider: tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+				vpcID:            "vpc-xxx",
+				stack:            stack,
+			}
+			err := s.validateSDKLoadBalancerForAdoption(resLB, tt.sdkLB)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -195,6 +195,9 @@ func buildSDKCreateTargetGroupInput(tgSpec elbv2model.TargetGroupSpec) *elbv2sdk
 	if tgSpec.ProtocolVersion != nil {
 		sdkObj.ProtocolVersion = (*string)(tgSpec.ProtocolVersion)
 	}
+	if tgSpec.IPAddressType != nil {
+		sdkObj.IpAddressType = (*string)(tgSpec.IPAddressType)
+	}
 	if tgSpec.HealthCheckConfig != nil {
 		hcConfig := *tgSpec.HealthCheckConfig
 		sdkObj.HealthCheckEnabled = awssdk.Bool(true)
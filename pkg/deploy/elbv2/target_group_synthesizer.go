@@ -3,15 +3,20 @@ package elbv2
 import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+// resourceTypeTargetGroup is the resource type of TargetGroup resources.
+const resourceTypeTargetGroup = "AWS::ElasticLoadBalancingV2::TargetGroup"
+
 // NewTargetGroupSynthesizer constructs targetGroupSynthesizer
 func NewTargetGroupSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
 	tgManager TargetGroupManager, logger logr.Logger, stack core.Stack) *targetGroupSynthesizer {
@@ -80,6 +85,82 @@ func (s *targetGroupSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
+func (s *targetGroupSynthesizer) Plan(ctx context.Context) ([]plan.Change, error) {
+	var resTGs []*elbv2model.TargetGroup
+	s.stack.ListResources(&resTGs)
+	sdkTGs, err := s.findSDKTargetGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKTGs, unmatchedResTGs, unmatchedSDKTGs, err := matchResAndSDKTargetGroups(resTGs, sdkTGs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []plan.Change
+	for _, resTG := range unmatchedResTGs {
+		changes = append(changes, plan.NewCreateChange(resTG))
+		resTG.SetStatus(elbv2model.TargetGroupStatus{
+			TargetGroupARN: plan.PlaceholderIdentifier(resTG),
+		})
+	}
+	for _, resAndSDKTG := range matchedResAndSDKTGs {
+		diffs, err := s.computeTargetGroupDiffs(ctx, resAndSDKTG.resTG, resAndSDKTG.sdkTG)
+		if err != nil {
+			return nil, err
+		}
+		if len(diffs) != 0 {
+			changes = append(changes, plan.NewUpdateChange(resAndSDKTG.resTG, awssdk.StringValue(resAndSDKTG.sdkTG.TargetGroup.TargetGroupArn), diffs))
+		}
+		resAndSDKTG.resTG.SetStatus(buildResTargetGroupStatus(resAndSDKTG.sdkTG))
+	}
+	// unmatched targetGroups are deleted during post synthesize, which happens after everything else.
+	for _, sdkTG := range unmatchedSDKTGs {
+		changes = append(changes, plan.NewDeleteChange(resourceTypeTargetGroup, awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn)))
+	}
+	return changes, nil
+}
+
+// computeTargetGroupDiffs computes the differences that TargetGroupManager will reconcile on sdk TargetGroup.
+func (s *targetGroupSynthesizer) computeTargetGroupDiffs(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) ([]plan.FieldDiff, error) {
+	var diffs []plan.FieldDiff
+	if isSDKTargetGroupHealthCheckDrifted(resTG.Spec, sdkTG) {
+		sdkObj := sdkTG.TargetGroup
+		currentHealthCheck := &elbv2sdk.ModifyTargetGroupInput{
+			HealthCheckPort:            sdkObj.HealthCheckPort,
+			HealthCheckProtocol:        sdkObj.HealthCheckProtocol,
+			HealthCheckPath:            sdkObj.HealthCheckPath,
+			Matcher:                    sdkObj.Matcher,
+			HealthCheckIntervalSeconds: sdkObj.HealthCheckIntervalSeconds,
+			HealthCheckTimeoutSeconds:  sdkObj.HealthCheckTimeoutSeconds,
+			HealthyThresholdCount:      sdkObj.HealthyThresholdCount,
+			UnhealthyThresholdCount:    sdkObj.UnhealthyThresholdCount,
+		}
+		desiredHealthCheck := buildSDKModifyTargetGroupInput(resTG.Spec)
+		desiredHealthCheck.HealthCheckEnabled = nil
+		diffs = append(diffs, plan.DiffObject("healthCheck", currentHealthCheck, desiredHealthCheck)...)
+	}
+
+	desiredAttrs := make(map[string]string, len(resTG.Spec.TargetGroupAttributes))
+	for _, attr := range resTG.Spec.TargetGroupAttributes {
+		desiredAttrs[attr.Key] = attr.Value
+	}
+	if len(desiredAttrs) != 0 {
+		resp, err := s.elbv2Client.DescribeTargetGroupAttributesWithContext(ctx, &elbv2sdk.DescribeTargetGroupAttributesInput{
+			TargetGroupArn: sdkTG.TargetGroup.TargetGroupArn,
+		})
+		if err != nil {
+			return nil, err
+		}
+		currentAttrs := make(map[string]string, len(resp.Attributes))
+		for _, attr := range resp.Attributes {
+			currentAttrs[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+		}
+		diffs = append(diffs, plan.DiffStringMap("targetGroupAttributes", currentAttrs, desiredAttrs)...)
+	}
+	return diffs, nil
+}
+
 // findSDKTargetGroups will find all AWS TargetGroups created for stack.
 func (s *targetGroupSynthesizer) findSDKTargetGroups(ctx context.Context) ([]TargetGroupWithTags, error) {
 	stackTags := s.trackingProvider.StackTags(s.stack)
@@ -170,6 +251,16 @@ func isSDKTargetGroupRequiresReplacement(sdkTG TargetGroupWithTags, resTG *elbv2
 			return true
 		}
 	}
+	if resTG.Spec.IPAddressType != nil {
+		// targetGroups created before ipv6 support don't report ipAddressType, which are ipv4 ones.
+		sdkIPAddressType := awssdk.StringValue(sdkTG.TargetGroup.IpAddressType)
+		if sdkIPAddressType == "" {
+			sdkIPAddressType = string(elbv2model.TargetGroupIPAddressTypeIPv4)
+		}
+		if string(*resTG.Spec.IPAddressType) != sdkIPAddressType {
+			return true
+		}
+	}
 
 	return isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(sdkTG, resTG)
 }
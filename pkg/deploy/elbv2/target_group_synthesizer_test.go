@@ -499,6 +499,8 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 	port8080 := intstr.FromInt(8080)
 	protocolHTTP := elbv2model.ProtocolHTTP
+	ipAddressTypeIPv4 := elbv2model.TargetGroupIPAddressTypeIPv4
+	ipAddressTypeIPv6 := elbv2model.TargetGroupIPAddressTypeIPv6
 	type args struct {
 		sdkTG TargetGroupWithTags
 		resTG *elbv2model.TargetGroup
@@ -574,6 +576,53 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "ipAddressType change need replacement",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2sdk.TargetGroup{
+						TargetType:      awssdk.String("ip"),
+						Port:            awssdk.Int64(8080),
+						Protocol:        awssdk.String("HTTP"),
+						IpAddressType:   awssdk.String("ipv4"),
+						TargetGroupName: awssdk.String("my-tg"),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType:    elbv2model.TargetTypeIP,
+						Port:          8080,
+						Protocol:      elbv2model.ProtocolHTTP,
+						IPAddressType: &ipAddressTypeIPv6,
+						Name:          "my-tg",
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "targetGroup without ipAddressType is treated as ipv4",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2sdk.TargetGroup{
+						TargetType:      awssdk.String("ip"),
+						Port:            awssdk.Int64(8080),
+						Protocol:        awssdk.String("HTTP"),
+						TargetGroupName: awssdk.String("my-tg"),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType:    elbv2model.TargetTypeIP,
+						Port:          8080,
+						Protocol:      elbv2model.ProtocolHTTP,
+						IPAddressType: &ipAddressTypeIPv4,
+						Name:          "my-tg",
+					},
+				},
+			},
+			want: false,
+		},
 		{
 			name: "targetType change need replacement",
 			args: args{
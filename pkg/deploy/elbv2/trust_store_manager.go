@@ -0,0 +1,246 @@
+package elbv2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	s3sdk "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"strings"
+	"time"
+)
+
+const (
+	// trustStoreChecksumTagKey is the AWS TagKey for the checksum of CA certificates bundle.
+	trustStoreChecksumTagKey = "elbv2.k8s.aws/ca-bundle-checksum"
+	// trustStoreObjectKeyPrefix is the S3 object key prefix for staged CA certificates bundles.
+	trustStoreObjectKeyPrefix = "trust-stores/"
+
+	defaultWaitTrustStoreActivePollInterval = 2 * time.Second
+	defaultWaitTrustStoreActiveTimeout      = 2 * time.Minute
+	// ELBV2 updates the usage of trust store asynchronously after it's removed from listeners.
+	defaultWaitTrustStoreDeletionPollInterval = 5 * time.Second
+	defaultWaitTrustStoreDeletionTimeout      = 2 * time.Minute
+)
+
+// TrustStoreManager is responsible for create/update/delete TrustStore resources.
+type TrustStoreManager interface {
+	Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error)
+
+	Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error)
+
+	Delete(ctx context.Context, sdkTS TrustStoreWithTags) error
+}
+
+// NewDefaultTrustStoreManager constructs new defaultTrustStoreManager.
+func NewDefaultTrustStoreManager(elbv2Client services.ELBV2, s3Client services.S3, trackingProvider tracking.Provider,
+	taggingManager TaggingManager, bucket string, externalManagedTags []string, logger logr.Logger) *defaultTrustStoreManager {
+	return &defaultTrustStoreManager{
+		elbv2Client:         elbv2Client,
+		s3Client:            s3Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		bucket:              bucket,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitTSActivePollInterval:   defaultWaitTrustStoreActivePollInterval,
+		waitTSActiveTimeout:        defaultWaitTrustStoreActiveTimeout,
+		waitTSDeletionPollInterval: defaultWaitTrustStoreDeletionPollInterval,
+		waitTSDeletionTimeout:      defaultWaitTrustStoreDeletionTimeout,
+	}
+}
+
+var _ TrustStoreManager = &defaultTrustStoreManager{}
+
+// default implementation for TrustStoreManager.
+type defaultTrustStoreManager struct {
+	elbv2Client         services.ELBV2
+	s3Client            services.S3
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	bucket              string
+	externalManagedTags []string
+	logger              logr.Logger
+
+	waitTSActivePollInterval   time.Duration
+	waitTSActiveTimeout        time.Duration
+	waitTSDeletionPollInterval time.Duration
+	waitTSDeletionTimeout      time.Duration
+}
+
+func (m *defaultTrustStoreManager) Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error) {
+	objectKey, err := m.stageCACertificatesBundle(ctx, resTS)
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	tsTags := buildTrustStoreTags(m.trackingProvider, resTS)
+	req := &elbv2sdk.CreateTrustStoreInput{
+		Name:                         awssdk.String(resTS.Spec.Name),
+		CaCertificatesBundleS3Bucket: awssdk.String(m.bucket),
+		CaCertificatesBundleS3Key:    awssdk.String(objectKey),
+		Tags:                         convertTagsToSDKTags(tsTags),
+	}
+
+	m.logger.Info("creating trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID())
+	resp, err := m.elbv2Client.CreateTrustStoreWithContext(ctx, req)
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	sdkTS := resp.TrustStores[0]
+	tsARN := awssdk.StringValue(sdkTS.TrustStoreArn)
+	m.logger.Info("created trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+	if err := m.waitUntilTrustStoreActive(ctx, tsARN); err != nil {
+		return elbv2model.TrustStoreStatus{}, errors.Wrap(err, "failed to wait trustStore active")
+	}
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: tsARN,
+	}, nil
+}
+
+func (m *defaultTrustStoreManager) Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error) {
+	tsARN := awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn)
+	if sdkTS.Tags[trustStoreChecksumTagKey] != resTS.Spec.Checksum {
+		if err := m.updateSDKTrustStoreWithCACertificatesBundle(ctx, resTS, sdkTS); err != nil {
+			return elbv2model.TrustStoreStatus{}, err
+		}
+	}
+	desiredTSTags := buildTrustStoreTags(m.trackingProvider, resTS)
+	if err := m.taggingManager.ReconcileTags(ctx, tsARN, desiredTSTags,
+		WithCurrentTags(sdkTS.Tags),
+		WithIgnoredTagKeys(m.externalManagedTags)); err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: tsARN,
+	}, nil
+}
+
+func (m *defaultTrustStoreManager) Delete(ctx context.Context, sdkTS TrustStoreWithTags) error {
+	tsARN := awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn)
+	req := &elbv2sdk.DeleteTrustStoreInput{
+		TrustStoreArn: awssdk.String(tsARN),
+	}
+
+	m.logger.Info("deleting trustStore",
+		"arn", tsARN)
+	if err := runtime.RetryImmediateOnError(m.waitTSDeletionPollInterval, m.waitTSDeletionTimeout, isTrustStoreInUseError, func() error {
+		_, err := m.elbv2Client.DeleteTrustStoreWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete trustStore")
+	}
+	m.logger.Info("deleted trustStore",
+		"arn", tsARN)
+
+	if len(m.bucket) != 0 {
+		objectKey := buildTrustStoreObjectKey(awssdk.StringValue(sdkTS.TrustStore.Name))
+		if _, err := m.s3Client.DeleteObjectWithContext(ctx, &s3sdk.DeleteObjectInput{
+			Bucket: awssdk.String(m.bucket),
+			Key:    awssdk.String(objectKey),
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete CA certificates bundle of trustStore")
+		}
+	}
+	return nil
+}
+
+func (m *defaultTrustStoreManager) updateSDKTrustStoreWithCACertificatesBundle(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) error {
+	tsARN := awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn)
+	objectKey, err := m.stageCACertificatesBundle(ctx, resTS)
+	if err != nil {
+		return err
+	}
+	req := &elbv2sdk.ModifyTrustStoreInput{
+		TrustStoreArn:                awssdk.String(tsARN),
+		CaCertificatesBundleS3Bucket: awssdk.String(m.bucket),
+		CaCertificatesBundleS3Key:    awssdk.String(objectKey),
+	}
+
+	m.logger.Info("modifying trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+	if _, err := m.elbv2Client.ModifyTrustStoreWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+	if err := m.waitUntilTrustStoreActive(ctx, tsARN); err != nil {
+		return errors.Wrap(err, "failed to wait trustStore active")
+	}
+	return nil
+}
+
+// stageCACertificatesBundle uploads the CA certificates bundle to S3, so that it can be loaded into trust store.
+func (m *defaultTrustStoreManager) stageCACertificatesBundle(ctx context.Context, resTS *elbv2model.TrustStore) (string, error) {
+	if len(m.bucket) == 0 {
+		return "", errors.Errorf("trust store bucket must be configured to create trustStore: %v", resTS.ID())
+	}
+	objectKey := buildTrustStoreObjectKey(resTS.Spec.Name)
+	req := &s3sdk.PutObjectInput{
+		Bucket: awssdk.String(m.bucket),
+		Key:    awssdk.String(objectKey),
+		Body:   strings.NewReader(resTS.Spec.CACertificatesBundle),
+	}
+	if _, err := m.s3Client.PutObjectWithContext(ctx, req); err != nil {
+		return "", errors.Wrap(err, "failed to stage CA certificates bundle of trustStore")
+	}
+	return objectKey, nil
+}
+
+func (m *defaultTrustStoreManager) waitUntilTrustStoreActive(ctx context.Context, tsARN string) error {
+	ctx, cancel := context.WithTimeout(ctx, m.waitTSActiveTimeout)
+	defer cancel()
+
+	req := &elbv2sdk.DescribeTrustStoresInput{
+		TrustStoreArns: awssdk.StringSlice([]string{tsARN}),
+	}
+	return wait.PollImmediateUntil(m.waitTSActivePollInterval, func() (bool, error) {
+		resp, err := m.elbv2Client.DescribeTrustStoresWithContext(ctx, req)
+		if err != nil {
+			return false, err
+		}
+		for _, sdkTS := range resp.TrustStores {
+			if awssdk.StringValue(sdkTS.Status) == elbv2sdk.TrustStoreStatusActive {
+				return true, nil
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+}
+
+// buildTrustStoreTags builds the desired tags for trust store, including the checksum of CA certificates bundle.
+func buildTrustStoreTags(trackingProvider tracking.Provider, resTS *elbv2model.TrustStore) map[string]string {
+	checksumTags := map[string]string{
+		trustStoreChecksumTagKey: resTS.Spec.Checksum,
+	}
+	return trackingProvider.ResourceTags(resTS.Stack(), resTS, algorithm.MergeStringMap(checksumTags, resTS.Spec.Tags))
+}
+
+func buildTrustStoreObjectKey(trustStoreName string) string {
+	return trustStoreObjectKeyPrefix + trustStoreName + ".pem"
+}
+
+func isTrustStoreInUseError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == elbv2sdk.ErrCodeTrustStoreInUseException
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package elbv2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// resourceTypeTrustStore is the resource type of TrustStore resources.
+const resourceTypeTrustStore = "AWS::ElasticLoadBalancingV2::TrustStore"
+
+// NewTrustStoreSynthesizer constructs new trustStoreSynthesizer.
+// when trust store management is disabled, existing trust stores are left untouched and it's an error to have TrustStore resources.
+func NewTrustStoreSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager, tsManager TrustStoreManager,
+	enabled bool, logger logr.Logger, stack core.Stack) *trustStoreSynthesizer {
+	return &trustStoreSynthesizer{
+		trackingProvider: trackingProvider,
+		taggingManager:   taggingManager,
+		tsManager:        tsManager,
+		enabled:          enabled,
+		logger:           logger,
+		stack:            stack,
+		unmatchedSDKTSs:  nil,
+	}
+}
+
+type trustStoreSynthesizer struct {
+	trackingProvider tracking.Provider
+	taggingManager   TaggingManager
+	tsManager        TrustStoreManager
+	enabled          bool
+	logger           logr.Logger
+
+	stack           core.Stack
+	unmatchedSDKTSs []TrustStoreWithTags
+}
+
+func (s *trustStoreSynthesizer) Synthesize(ctx context.Context) error {
+	var resTSs []*elbv2model.TrustStore
+	s.stack.ListResources(&resTSs)
+	if err := s.checkEnabled(resTSs); err != nil || !s.enabled {
+		return err
+	}
+	sdkTSs, err := s.findSDKTrustStores(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKTSs, unmatchedResTSs, unmatchedSDKTSs, err := matchResAndSDKTrustStores(resTSs, sdkTSs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For TrustStore, we delete unmatched ones during post synthesize, after they are removed from listeners.
+	s.unmatchedSDKTSs = unmatchedSDKTSs
+
+	for _, resTS := range unmatchedResTSs {
+		tsStatus, err := s.tsManager.Create(ctx, resTS)
+		if err != nil {
+			return err
+		}
+		resTS.SetStatus(tsStatus)
+	}
+	for _, resAndSDKTS := range matchedResAndSDKTSs {
+		tsStatus, err := s.tsManager.Update(ctx, resAndSDKTS.resTS, resAndSDKTS.sdkTS)
+		if err != nil {
+			return err
+		}
+		resAndSDKTS.resTS.SetStatus(tsStatus)
+	}
+	return nil
+}
+
+func (s *trustStoreSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkTS := range s.unmatchedSDKTSs {
+		if err := s.tsManager.Delete(ctx, sdkTS); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *trustStoreSynthesizer) Plan(ctx context.Context) ([]plan.Change, error) {
+	var resTSs []*elbv2model.TrustStore
+	s.stack.ListResources(&resTSs)
+	if err := s.checkEnabled(resTSs); err != nil || !s.enabled {
+		return nil, err
+	}
+	sdkTSs, err := s.findSDKTrustStores(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKTSs, unmatchedResTSs, unmatchedSDKTSs, err := matchResAndSDKTrustStores(resTSs, sdkTSs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []plan.Change
+	for _, resTS := range unmatchedResTSs {
+		changes = append(changes, plan.NewCreateChange(resTS))
+		resTS.SetStatus(elbv2model.TrustStoreStatus{
+			TrustStoreARN: plan.PlaceholderIdentifier(resTS),
+		})
+	}
+	for _, resAndSDKTS := range matchedResAndSDKTSs {
+		tsARN := awssdk.StringValue(resAndSDKTS.sdkTS.TrustStore.TrustStoreArn)
+		diffs := plan.DiffString("checksum", resAndSDKTS.sdkTS.Tags[trustStoreChecksumTagKey], resAndSDKTS.resTS.Spec.Checksum)
+		if len(diffs) != 0 {
+			changes = append(changes, plan.NewUpdateChange(resAndSDKTS.resTS, tsARN, diffs))
+		}
+		resAndSDKTS.resTS.SetStatus(elbv2model.TrustStoreStatus{
+			TrustStoreARN: tsARN,
+		})
+	}
+	// unmatched trust stores are deleted during post synthesize, which happens after listeners are updated.
+	for _, sdkTS := range unmatchedSDKTSs {
+		changes = append(changes, plan.NewDeleteChange(resourceTypeTrustStore, awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn)))
+	}
+	return changes, nil
+}
+
+func (s *trustStoreSynthesizer) checkEnabled(resTSs []*elbv2model.TrustStore) error {
+	if !s.enabled && len(resTSs) != 0 {
+		return errors.Errorf("trust store bucket must be configured to create trustStore: %v", resTSs[0].ID())
+	}
+	return nil
+}
+
+// findSDKTrustStores will find all AWS TrustStores created for stack.
+func (s *trustStoreSynthesizer) findSDKTrustStores(ctx context.Context) ([]TrustStoreWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListTrustStores(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKTrustStorePair struct {
+	resTS *elbv2model.TrustStore
+	sdkTS TrustStoreWithTags
+}
+
+func matchResAndSDKTrustStores(resTSs []*elbv2model.TrustStore, sdkTSs []TrustStoreWithTags,
+	resourceIDTagKey string) ([]resAndSDKTrustStorePair, []*elbv2model.TrustStore, []TrustStoreWithTags, error) {
+	var matchedResAndSDKTSs []resAndSDKTrustStorePair
+	var unmatchedResTSs []*elbv2model.TrustStore
+	var unmatchedSDKTSs []TrustStoreWithTags
+
+	resTSsByID := mapResTrustStoreByResourceID(resTSs)
+	sdkTSsByID, err := mapSDKTrustStoreByResourceID(sdkTSs, resourceIDTagKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resTSIDs := sets.StringKeySet(resTSsByID)
+	sdkTSIDs := sets.StringKeySet(sdkTSsByID)
+	for _, resID := range resTSIDs.Intersection(sdkTSIDs).List() {
+		resTS := resTSsByID[resID]
+		sdkTSs := sdkTSsByID[resID]
+		matchedResAndSDKTSs = append(matchedResAndSDKTSs, resAndSDKTrustStorePair{
+			resTS: resTS,
+			sdkTS: sdkTSs[0],
+		})
+		unmatchedSDKTSs = append(unmatchedSDKTSs, sdkTSs[1:]...)
+	}
+	for _, resID := range resTSIDs.Difference(sdkTSIDs).List() {
+		unmatchedResTSs = append(unmatchedResTSs, resTSsByID[resID])
+	}
+	for _, resID := range sdkTSIDs.Difference(resTSIDs).List() {
+		unmatchedSDKTSs = append(unmatchedSDKTSs, sdkTSsByID[resID]...)
+	}
+
+	return matchedResAndSDKTSs, unmatchedResTSs, unmatchedSDKTSs, nil
+}
+
+func mapResTrustStoreByResourceID(resTSs []*elbv2model.TrustStore) map[string]*elbv2model.TrustStore {
+	resTSsByID := make(map[string]*elbv2model.TrustStore, len(resTSs))
+	for _, resTS := range resTSs {
+		resTSsByID[resTS.ID()] = resTS
+	}
+	return resTSsByID
+}
+
+func mapSDKTrustStoreByResourceID(sdkTSs []TrustStoreWithTags, resourceIDTagKey string) (map[string][]TrustStoreWithTags, error) {
+	sdkTSsByID := make(map[string][]TrustStoreWithTags, len(sdkTSs))
+	for _, sdkTS := range sdkTSs {
+		resourceID, ok := sdkTS.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, errors.Errorf("unexpected trustStore with no resourceID: %v", awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn))
+		}
+		sdkTSsByID[resourceID] = append(sdkTSsByID[resourceID], sdkTS)
+	}
+	return sdkTSsByID, nil
+}
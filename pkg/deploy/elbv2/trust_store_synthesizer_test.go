@@ -0,0 +1,144 @@
+package elbv2
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"testing"
+)
+
+func Test_matchResAndSDKTrustStores(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resTS1 := &elbv2model.TrustStore{
+		ResourceMeta: coremodel.NewResourceMeta(stack, resourceTypeTrustStore, "ns/secret-1"),
+		Spec: elbv2model.TrustStoreSpec{
+			Name:     "k8s-ts-1",
+			Checksum: "checksum-1",
+		},
+	}
+	resTS2 := &elbv2model.TrustStore{
+		ResourceMeta: coremodel.NewResourceMeta(stack, resourceTypeTrustStore, "ns/secret-2"),
+		Spec: elbv2model.TrustStoreSpec{
+			Name:     "k8s-ts-2",
+			Checksum: "checksum-2",
+		},
+	}
+	sdkTS1 := TrustStoreWithTags{
+		TrustStore: &elbv2sdk.TrustStore{
+			TrustStoreArn: awssdk.String("arn-1"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ns/secret-1",
+		},
+	}
+	sdkTS2 := TrustStoreWithTags{
+		TrustStore: &elbv2sdk.TrustStore{
+			TrustStoreArn: awssdk.String("arn-2"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ns/secret-2",
+		},
+	}
+	sdkTS3 := TrustStoreWithTags{
+		TrustStore: &elbv2sdk.TrustStore{
+			TrustStoreArn: awssdk.String("arn-3"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ns/secret-1",
+		},
+	}
+	type args struct {
+		resTSs           []*elbv2model.TrustStore
+		sdkTSs           []TrustStoreWithTags
+		resourceIDTagKey string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []resAndSDKTrustStorePair
+		want1   []*elbv2model.TrustStore
+		want2   []TrustStoreWithTags
+		wantErr error
+	}{
+		{
+			name: "all trustStores have match",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS1, resTS2},
+				sdkTSs:           []TrustStoreWithTags{sdkTS1, sdkTS2},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+				{resTS: resTS2, sdkTS: sdkTS2},
+			},
+		},
+		{
+			name: "some res trustStores don't have match",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS1, resTS2},
+				sdkTSs:           []TrustStoreWithTags{sdkTS1},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+			want1: []*elbv2model.TrustStore{resTS2},
+		},
+		{
+			name: "some sdk trustStores don't have match",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS1},
+				sdkTSs:           []TrustStoreWithTags{sdkTS1, sdkTS2},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+			want2: []TrustStoreWithTags{sdkTS2},
+		},
+		{
+			name: "duplicated sdk trustStores for same resource",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS1},
+				sdkTSs:           []TrustStoreWithTags{sdkTS1, sdkTS3},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+			want2: []TrustStoreWithTags{sdkTS3},
+		},
+		{
+			name: "sdk trustStore without resourceID",
+			args: args{
+				resTSs: []*elbv2model.TrustStore{resTS1},
+				sdkTSs: []TrustStoreWithTags{
+					{
+						TrustStore: &elbv2sdk.TrustStore{
+							TrustStoreArn: awssdk.String("arn-4"),
+						},
+						Tags: map[string]string{},
+					},
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			wantErr: errors.New("unexpected trustStore with no resourceID: arn-4"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2, err := matchResAndSDKTrustStores(tt.args.resTSs, tt.args.sdkTSs, tt.args.resourceIDTagKey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.want1, got1)
+				assert.Equal(t, tt.want2, got2)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"strings"
+)
+
+// ChangeAction is the kind of mutation a Change would perform against AWS.
+type ChangeAction string
+
+const (
+	ChangeActionCreate ChangeAction = "Create"
+	ChangeActionUpdate ChangeAction = "Update"
+	ChangeActionDelete ChangeAction = "Delete"
+)
+
+// placeholderPrefix prefixes identifiers that are assigned to planned resources which don't exist in AWS yet.
+const placeholderPrefix = "planned:"
+
+// MaxAnnotationSize is the maximum size of a marshaled ChangeSet that is surfaced via annotations.
+// Kubernetes limits the total size of annotations on an object to 256KiB, so we leave enough room for other annotations.
+const MaxAnnotationSize = 64 * 1024
+
+// FieldDiff describes the difference of a single field between current and desired state.
+type FieldDiff struct {
+	// the name of the field.
+	Field string `json:"field"`
+
+	// the current value of the field.
+	// +optional
+	Current string `json:"current,omitempty"`
+
+	// the desired value of the field.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+}
+
+// Change describes the mutation that would be performed on a single resource.
+type Change struct {
+	// the action to perform.
+	Action ChangeAction `json:"action"`
+
+	// the type of resource, e.g. AWS::ElasticLoadBalancingV2::Listener.
+	ResourceType string `json:"resourceType"`
+
+	// the ID of resource within stack.
+	// it's empty for resources that are going to be deleted since they are no longer in stack.
+	// +optional
+	ResourceID string `json:"resourceID,omitempty"`
+
+	// the identifier of the existing resource in AWS, e.g. an ARN or securityGroupID.
+	// it's empty for resources that are going to be created.
+	// +optional
+	Identifier string `json:"identifier,omitempty"`
+
+	// the field level differences, only populated for Update.
+	// +optional
+	Diffs []FieldDiff `json:"diffs,omitempty"`
+}
+
+// NewCreateChange constructs a Change that creates resource.
+func NewCreateChange(res core.Resource) Change {
+	return Change{
+		Action:       ChangeActionCreate,
+		ResourceType: res.Type(),
+		ResourceID:   res.ID(),
+	}
+}
+
+// NewUpdateChange constructs a Change that updates resource with identifier using diffs.
+func NewUpdateChange(res core.Resource, identifier string, diffs []FieldDiff) Change {
+	return Change{
+		Action:       ChangeActionUpdate,
+		ResourceType: res.Type(),
+		ResourceID:   res.ID(),
+		Identifier:   identifier,
+		Diffs:        diffs,
+	}
+}
+
+// NewDeleteChange constructs a Change that deletes existing resource with identifier.
+func NewDeleteChange(resType string, identifier string) Change {
+	return Change{
+		Action:       ChangeActionDelete,
+		ResourceType: resType,
+		Identifier:   identifier,
+	}
+}
+
+// ChangeSet is the ordered list of changes needed to deploy a stack.
+type ChangeSet struct {
+	// the stackID of stack.
+	StackID string `json:"stackID"`
+
+	// the changes needed, in the order they'll be applied.
+	// +optional
+	Changes []Change `json:"changes,omitempty"`
+
+	// whether changes are truncated to fit into size limit.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Add appends changes into ChangeSet.
+func (cs *ChangeSet) Add(changes ...Change) {
+	cs.Changes = append(cs.Changes, changes...)
+}
+
+// IsEmpty tests whether the ChangeSet contains no change.
+func (cs ChangeSet) IsEmpty() bool {
+	return len(cs.Changes) == 0
+}
+
+// Deletions returns changes that would delete resources.
+func (cs ChangeSet) Deletions() []Change {
+	var deletions []Change
+	for _, change := range cs.Changes {
+		if change.Action == ChangeActionDelete {
+			deletions = append(deletions, change)
+		}
+	}
+	return deletions
+}
+
+// Summary returns an human readable summary of ChangeSet.
+func (cs ChangeSet) Summary() string {
+	countByAction := make(map[ChangeAction]int)
+	for _, change := range cs.Changes {
+		countByAction[change.Action]++
+	}
+	summary := fmt.Sprintf("%d to create, %d to update, %d to delete",
+		countByAction[ChangeActionCreate], countByAction[ChangeActionUpdate], countByAction[ChangeActionDelete])
+	deletions := cs.Deletions()
+	if len(deletions) == 0 {
+		return summary
+	}
+	deletionDescs := make([]string, 0, len(deletions))
+	for _, change := range deletions {
+		deletionDescs = append(deletionDescs, fmt.Sprintf("%s(%s)", change.ResourceType, change.Identifier))
+	}
+	return fmt.Sprintf("%s; deleting: %s", summary, strings.Join(deletionDescs, ", "))
+}
+
+// Marshal encodes the ChangeSet as JSON.
+func (cs ChangeSet) Marshal() (string, error) {
+	payload, err := json.Marshal(cs)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// MarshalWithSizeLimit encodes the ChangeSet as JSON that is no larger than sizeLimit.
+// When the full ChangeSet doesn't fit, field level diffs are dropped first and then trailing changes, and the ChangeSet is flagged as truncated.
+func (cs ChangeSet) MarshalWithSizeLimit(sizeLimit int) (string, error) {
+	payload, err := cs.Marshal()
+	if err != nil || len(payload) <= sizeLimit {
+		return payload, err
+	}
+	truncatedCS := ChangeSet{
+		StackID:   cs.StackID,
+		Changes:   make([]Change, 0, len(cs.Changes)),
+		Truncated: true,
+	}
+	for _, change := range cs.Changes {
+		change.Diffs = nil
+		truncatedCS.Changes = append(truncatedCS.Changes, change)
+	}
+	for {
+		payload, err := truncatedCS.Marshal()
+		if err != nil {
+			return "", err
+		}
+		if len(payload) <= sizeLimit || len(truncatedCS.Changes) == 0 {
+			return payload, nil
+		}
+		truncatedCS.Changes = truncatedCS.Changes[:len(truncatedCS.Changes)-1]
+	}
+}
+
+// PlaceholderIdentifier returns an identifier for a planned resource that don't exist in AWS yet.
+// It's used to fulfill resource status during planning so that dependent resources can be planned as well.
+func PlaceholderIdentifier(res core.Resource) string {
+	return fmt.Sprintf("%s%s/%s", placeholderPrefix, res.Type(), res.ID())
+}
+
+// IsPlaceholderIdentifier tests whether identifier is an placeholder for planned resources.
+func IsPlaceholderIdentifier(identifier string) bool {
+	return strings.HasPrefix(identifier, placeholderPrefix)
+}
+
+// DiffString returns the FieldDiff for a string field if it differs.
+func DiffString(field string, current string, desired string) []FieldDiff {
+	if current == desired {
+		return nil
+	}
+	return []FieldDiff{{Field: field, Current: current, Desired: desired}}
+}
+
+// DiffObject returns the FieldDiff for a structured field, with both value encoded as JSON.
+// the caller is responsible to determine whether the field differs since structured values usually need special equality semantics.
+func DiffObject(field string, current interface{}, desired interface{}) []FieldDiff {
+	currentPayload, _ := json.Marshal(current)
+	desiredPayload, _ := json.Marshal(desired)
+	return []FieldDiff{{Field: field, Current: string(currentPayload), Desired: string(desiredPayload)}}
+}
+
+// DiffStringMap returns the FieldDiffs for keys that differs between current and desired map.
+// keys that only exists in current map are ignored, since we never remove them.
+func DiffStringMap(field string, current map[string]string, desired map[string]string) []FieldDiff {
+	var diffs []FieldDiff
+	for _, key := range sets.StringKeySet(desired).List() {
+		if currentValue, ok := current[key]; !ok || currentValue != desired[key] {
+			diffs = append(diffs, FieldDiff{
+				Field:   fmt.Sprintf("%s[%s]", field, key),
+				Current: current[key],
+				Desired: desired[key],
+			})
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,181 @@
+package plan
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"testing"
+)
+
+func TestChangeSet_Summary(t *testing.T) {
+	tests := []struct {
+		name      string
+		changeSet ChangeSet
+		want      string
+	}{
+		{
+			name:      "empty changeSet",
+			changeSet: ChangeSet{},
+			want:      "0 to create, 0 to update, 0 to delete",
+		},
+		{
+			name: "changeSet without deletions",
+			changeSet: ChangeSet{
+				Changes: []Change{
+					{
+						Action:       ChangeActionCreate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+						ResourceID:   "tg-1",
+					},
+					{
+						Action:       ChangeActionUpdate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+						ResourceID:   "80",
+						Identifier:   "ls-arn-1",
+					},
+				},
+			},
+			want: "1 to create, 1 to update, 0 to delete",
+		},
+		{
+			name: "changeSet with deletions",
+			changeSet: ChangeSet{
+				Changes: []Change{
+					{
+						Action:       ChangeActionCreate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+						ResourceID:   "tg-1",
+					},
+					{
+						Action:       ChangeActionDelete,
+						ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+						Identifier:   "ls-arn-1",
+					},
+					{
+						Action:       ChangeActionDelete,
+						ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+						Identifier:   "tg-arn-2",
+					},
+				},
+			},
+			want: "1 to create, 0 to update, 2 to delete; deleting: AWS::ElasticLoadBalancingV2::Listener(ls-arn-1), AWS::ElasticLoadBalancingV2::TargetGroup(tg-arn-2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.changeSet.Summary()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChangeSet_Marshal(t *testing.T) {
+	changeSet := ChangeSet{StackID: "namespace/name"}
+	changeSet.Add(Change{
+		Action:       ChangeActionUpdate,
+		ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+		ResourceID:   "80",
+		Identifier:   "ls-arn-1",
+		Diffs:        DiffString("sslPolicy", "policy-a", "policy-b"),
+	})
+	got, err := changeSet.Marshal()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"stackID":"namespace/name","changes":[{"action":"Update","resourceType":"AWS::ElasticLoadBalancingV2::Listener","resourceID":"80","identifier":"ls-arn-1","diffs":[{"field":"sslPolicy","current":"policy-a","desired":"policy-b"}]}]}`, got)
+}
+
+func TestChangeSet_MarshalWithSizeLimit(t *testing.T) {
+	changeSet := ChangeSet{StackID: "namespace/name"}
+	changeSet.Add(
+		Change{
+			Action:       ChangeActionUpdate,
+			ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+			ResourceID:   "80",
+			Identifier:   "ls-arn-1",
+			Diffs:        DiffString("sslPolicy", "policy-a", "policy-b"),
+		},
+		Change{
+			Action:       ChangeActionDelete,
+			ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+			Identifier:   "tg-arn-2",
+		},
+	)
+	tests := []struct {
+		name      string
+		sizeLimit int
+		want      string
+	}{
+		{
+			name:      "fits into size limit",
+			sizeLimit: MaxAnnotationSize,
+			want:      `{"stackID":"namespace/name","changes":[{"action":"Update","resourceType":"AWS::ElasticLoadBalancingV2::Listener","resourceID":"80","identifier":"ls-arn-1","diffs":[{"field":"sslPolicy","current":"policy-a","desired":"policy-b"}]},{"action":"Delete","resourceType":"AWS::ElasticLoadBalancingV2::TargetGroup","identifier":"tg-arn-2"}]}`,
+		},
+		{
+			name:      "diffs are dropped",
+			sizeLimit: 300,
+			want:      `{"stackID":"namespace/name","changes":[{"action":"Update","resourceType":"AWS::ElasticLoadBalancingV2::Listener","resourceID":"80","identifier":"ls-arn-1"},{"action":"Delete","resourceType":"AWS::ElasticLoadBalancingV2::TargetGroup","identifier":"tg-arn-2"}],"truncated":true}`,
+		},
+		{
+			name:      "trailing changes are dropped",
+			sizeLimit: 200,
+			want:      `{"stackID":"namespace/name","changes":[{"action":"Update","resourceType":"AWS::ElasticLoadBalancingV2::Listener","resourceID":"80","identifier":"ls-arn-1"}],"truncated":true}`,
+		},
+		{
+			name:      "all changes are dropped",
+			sizeLimit: 10,
+			want:      `{"stackID":"namespace/name","truncated":true}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := changeSet.MarshalWithSizeLimit(tt.sizeLimit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlaceholderIdentifier(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	res := core.NewFakeResource(stack, "typeX", "resA", core.FakeResourceSpec{}, nil)
+	placeholder := PlaceholderIdentifier(res)
+	assert.Equal(t, "planned:typeX/resA", placeholder)
+	assert.True(t, IsPlaceholderIdentifier(placeholder))
+	assert.False(t, IsPlaceholderIdentifier("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/abc"))
+}
+
+func TestDiffStringMap(t *testing.T) {
+	type args struct {
+		current map[string]string
+		desired map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []FieldDiff
+	}{
+		{
+			name: "no differences",
+			args: args{
+				current: map[string]string{"a": "1", "b": "2"},
+				desired: map[string]string{"a": "1"},
+			},
+			want: nil,
+		},
+		{
+			name: "changed and added keys",
+			args: args{
+				current: map[string]string{"a": "1"},
+				desired: map[string]string{"a": "2", "b": "3"},
+			},
+			want: []FieldDiff{
+				{Field: "attributes[a]", Current: "1", Desired: "2"},
+				{Field: "attributes[b]", Current: "", Desired: "3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffStringMap("attributes", tt.args.current, tt.args.desired)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/acm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafregional"
@@ -22,6 +24,12 @@ type StackDeployer interface {
 	Deploy(ctx context.Context, stack core.Stack) error
 }
 
+// StackPlanner will compute the changes needed to deploy a resource stack without mutating anything.
+type StackPlanner interface {
+	// Plan the changes needed to deploy a resource stack.
+	Plan(ctx context.Context, stack core.Stack) (plan.ChangeSet, error)
+}
+
 // NewDefaultStackDeployer constructs new defaultStackDeployer.
 func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 	networkingSGManager networking.SecurityGroupManager, networkingSGReconciler networking.SecurityGroupReconciler,
@@ -30,20 +38,26 @@ func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
 	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
 	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), logger)
+	acmTaggingManager := acm.NewDefaultTaggingManager(cloud.ACM(), cloud.RGT(), logger)
 
 	return &defaultStackDeployer{
 		cloud:                               cloud,
 		k8sClient:                           k8sClient,
 		addonsConfig:                        config.AddonsConfig,
+		enableCertificateImport:             config.EnableCertificateImport,
+		trustStoreBucket:                    config.TrustStoreBucket,
 		trackingProvider:                    trackingProvider,
 		ec2TaggingManager:                   ec2TaggingManager,
 		ec2SGManager:                        ec2.NewDefaultSecurityGroupManager(cloud.EC2(), trackingProvider, ec2TaggingManager, networkingSGReconciler, cloud.VpcID(), config.ExternalManagedTags, logger),
+		acmTaggingManager:                   acmTaggingManager,
+		acmCertManager:                      acm.NewDefaultCertificateManager(cloud.ACM(), trackingProvider, acmTaggingManager, config.ExternalManagedTags, logger),
 		elbv2TaggingManager:                 elbv2TaggingManager,
 		elbv2LBManager:                      elbv2.NewDefaultLoadBalancerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2LSManager:                      elbv2.NewDefaultListenerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, cloud.VpcID(), config.ExternalManagedTags, logger),
 		elbv2TGBManager:                     elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger),
+		elbv2TSManager:                      elbv2.NewDefaultTrustStoreManager(cloud.ELBV2(), cloud.S3(), trackingProvider, elbv2TaggingManager, config.TrustStoreBucket, config.ExternalManagedTags, logger),
 		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
 		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
 		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
@@ -53,21 +67,27 @@ func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 }
 
 var _ StackDeployer = &defaultStackDeployer{}
+var _ StackPlanner = &defaultStackDeployer{}
 
 // defaultStackDeployer is the default implementation for StackDeployer
 type defaultStackDeployer struct {
 	cloud                               aws.Cloud
 	k8sClient                           client.Client
 	addonsConfig                        config.AddonsConfig
+	enableCertificateImport             bool
+	trustStoreBucket                    string
 	trackingProvider                    tracking.Provider
 	ec2TaggingManager                   ec2.TaggingManager
 	ec2SGManager                        ec2.SecurityGroupManager
+	acmTaggingManager                   acm.TaggingManager
+	acmCertManager                      acm.CertificateManager
 	elbv2TaggingManager                 elbv2.TaggingManager
 	elbv2LBManager                      elbv2.LoadBalancerManager
 	elbv2LSManager                      elbv2.ListenerManager
 	elbv2LRManager                      elbv2.ListenerRuleManager
 	elbv2TGManager                      elbv2.TargetGroupManager
 	elbv2TGBManager                     elbv2.TargetGroupBindingManager
+	elbv2TSManager                      elbv2.TrustStoreManager
 	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
 	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
 	shieldProtectionManager             shield.ProtectionManager
@@ -81,16 +101,67 @@ type ResourceSynthesizer interface {
 	PostSynthesize(ctx context.Context) error
 }
 
+// ResourcePlanner is implemented by ResourceSynthesizers that can compute their changes without mutating anything.
+type ResourcePlanner interface {
+	// Plan returns the changes Synthesize & PostSynthesize would perform.
+	// Resources in stack will be fulfilled with either actual or placeholder status, so that dependent resources can be planned.
+	Plan(ctx context.Context) ([]plan.Change, error)
+}
+
 // Deploy a resource stack.
 func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) error {
-	synthesizers := []ResourceSynthesizer{
+	synthesizers := d.buildSynthesizers(stack)
+	for _, synthesizer := range synthesizers {
+		if err := synthesizer.Synthesize(ctx); err != nil {
+			return err
+		}
+	}
+	for i := len(synthesizers) - 1; i >= 0; i-- {
+		if err := synthesizers[i].PostSynthesize(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Plan the changes needed to deploy a resource stack.
+// Only synthesizers that implements ResourcePlanner are planned, others(e.g. TargetGroupBindings, addons) are skipped.
+func (d *defaultStackDeployer) Plan(ctx context.Context, stack core.Stack) (plan.ChangeSet, error) {
+	changeSet := plan.ChangeSet{
+		StackID: stack.StackID().String(),
+	}
+	for _, synthesizer := range d.buildSynthesizers(stack) {
+		planner, ok := synthesizer.(ResourcePlanner)
+		if !ok {
+			continue
+		}
+		changes, err := planner.Plan(ctx)
+		if err != nil {
+			return plan.ChangeSet{}, err
+		}
+		changeSet.Add(changes...)
+	}
+	return changeSet, nil
+}
+
+func (d *defaultStackDeployer) buildSynthesizers(stack core.Stack) []ResourceSynthesizer {
+	var synthesizers []ResourceSynthesizer
+	// imported certificates are synthesized first and garbage collected last, since listeners depend on them.
+	// it's always registered, so that certificates imported before certificate import got disabled are garbage collected.
+	synthesizers = append(synthesizers, acm.NewCertificateSynthesizer(d.trackingProvider, d.acmTaggingManager, d.acmCertManager,
+		d.enableCertificateImport, d.logger, stack))
+	// trust stores are synthesized before listeners and garbage collected after them for the same reason.
+	synthesizers = append(synthesizers,
+		elbv2.NewTrustStoreSynthesizer(d.trackingProvider, d.elbv2TaggingManager, d.elbv2TSManager, len(d.trustStoreBucket) != 0, d.logger, stack),
 		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
 		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, stack),
-		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
-		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
-		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, stack),
+		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.vpcID, d.logger, stack),
+		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
+		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LRManager, d.logger, stack),
 		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack),
-	}
+		elbv2.NewALBTargetRegistrationSynthesizer(d.cloud.ELBV2(), d.logger, stack),
+	)
 
 	if d.addonsConfig.WAFV2Enabled {
 		synthesizers = append(synthesizers, wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack))
@@ -105,17 +176,5 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) err
 	if shieldNeeded {
 		synthesizers = append(synthesizers, shield.NewProtectionSynthesizer(d.shieldProtectionManager, d.logger, stack))
 	}
-
-	for _, synthesizer := range synthesizers {
-		if err := synthesizer.Synthesize(ctx); err != nil {
-			return err
-		}
-	}
-	for i := len(synthesizers) - 1; i >= 0; i-- {
-		if err := synthesizers[i].PostSynthesize(ctx); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return synthesizers
 }
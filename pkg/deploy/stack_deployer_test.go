@@ -0,0 +1,315 @@
+package deploy
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"testing"
+)
+
+func Test_defaultStackDeployer_Deploy_withFakeCloud(t *testing.T) {
+	ctx := context.Background()
+	cloud, subnetIDs, deployer := newFakeCloudStackDeployer(ctx, t)
+
+	stackID := core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-ing"})
+	buildStack := func() core.Stack {
+		stack := core.NewDefaultStack(stackID)
+		sg := ec2model.NewSecurityGroup(stack, "ManagedLBSecurityGroup", ec2model.SecurityGroupSpec{
+			GroupName:   "k8s-awesomen-awesomei-0000000000",
+			Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+			Ingress: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+			},
+		})
+		tg := elbv2model.NewTargetGroup(stack, "awesome-ns/awesome-ing-svc:80", elbv2model.TargetGroupSpec{
+			Name:       "k8s-awesomen-svc-0000000000",
+			TargetType: elbv2model.TargetTypeIP,
+			Port:       80,
+			Protocol:   elbv2model.ProtocolHTTP,
+		})
+		var subnetMappings []elbv2model.SubnetMapping
+		for _, subnetID := range subnetIDs {
+			subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{SubnetID: subnetID})
+		}
+		lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+			Name:           "k8s-awesomen-awesomei-0000000000",
+			Type:           elbv2model.LoadBalancerTypeApplication,
+			SubnetMappings: subnetMappings,
+			SecurityGroups: []core.StringToken{sg.GroupID()},
+		})
+		elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{
+			LoadBalancerARN: lb.LoadBalancerARN(),
+			Port:            80,
+			Protocol:        elbv2model.ProtocolHTTP,
+			DefaultActions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{{TargetGroupARN: tg.TargetGroupARN()}},
+					},
+				},
+			},
+		})
+		return stack
+	}
+
+	// deploying twice should converge to the same resources.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, deployer.Deploy(ctx, buildStack()))
+	}
+
+	sdkLBs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	require.Len(t, sdkLBs, 1)
+	assert.Equal(t, "k8s-awesomen-awesomei-0000000000", awssdk.StringValue(sdkLBs[0].LoadBalancerName))
+	require.Len(t, sdkLBs[0].SecurityGroups, 1)
+	sdkSGs, err := cloud.EC2().DescribeSecurityGroupsAsList(ctx, &ec2sdk.DescribeSecurityGroupsInput{
+		GroupIds: sdkLBs[0].SecurityGroups,
+	})
+	require.NoError(t, err)
+	require.Len(t, sdkSGs, 1)
+	assert.Len(t, sdkSGs[0].IpPermissions, 1)
+
+	sdkTGs, err := cloud.ELBV2().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{})
+	require.NoError(t, err)
+	require.Len(t, sdkTGs, 1)
+	assert.Equal(t, []*string{sdkLBs[0].LoadBalancerArn}, sdkTGs[0].LoadBalancerArns)
+	tagsResp, err := cloud.ELBV2().DescribeTagsWithContext(ctx, &elbv2sdk.DescribeTagsInput{
+		ResourceArns: []*string{sdkTGs[0].TargetGroupArn},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, tagsResp.TagDescriptions[0].Tags, &elbv2sdk.Tag{
+		Key:   awssdk.String("elbv2.k8s.aws/cluster"),
+		Value: awssdk.String("cluster-name"),
+	})
+
+	sdkLSs, err := cloud.ELBV2().DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{
+		LoadBalancerArn: sdkLBs[0].LoadBalancerArn,
+	})
+	require.NoError(t, err)
+	require.Len(t, sdkLSs, 1)
+	assert.Equal(t, sdkTGs[0].TargetGroupArn, sdkLSs[0].DefaultActions[0].TargetGroupArn)
+
+	// deploying an empty stack should delete all resources.
+	require.NoError(t, deployer.Deploy(ctx, core.NewDefaultStack(stackID)))
+	sdkLBs, err = cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	assert.Empty(t, sdkLBs)
+	sdkTGs, err = cloud.ELBV2().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{})
+	require.NoError(t, err)
+	assert.Empty(t, sdkTGs)
+}
+
+func Test_defaultStackDeployer_Plan_withFakeCloud(t *testing.T) {
+	ctx := context.Background()
+	cloud, subnetIDs, deployer := newFakeCloudStackDeployer(ctx, t)
+
+	type stackOptions struct {
+		sgCIDR          string
+		healthCheckPath string
+		idleTimeout     string
+		rulePath        string
+	}
+	stackID := core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-ing"})
+	buildStack := func(opts stackOptions) core.Stack {
+		stack := core.NewDefaultStack(stackID)
+		sg := ec2model.NewSecurityGroup(stack, "ManagedLBSecurityGroup", ec2model.SecurityGroupSpec{
+			GroupName:   "k8s-awesomen-awesomei-0000000000",
+			Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+			Ingress: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: opts.sgCIDR}},
+				},
+			},
+		})
+		tg := elbv2model.NewTargetGroup(stack, "awesome-ns/awesome-ing-svc:80", elbv2model.TargetGroupSpec{
+			Name:       "k8s-awesomen-svc-0000000000",
+			TargetType: elbv2model.TargetTypeIP,
+			Port:       80,
+			Protocol:   elbv2model.ProtocolHTTP,
+			HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+				Path: awssdk.String(opts.healthCheckPath),
+			},
+		})
+		var subnetMappings []elbv2model.SubnetMapping
+		for _, subnetID := range subnetIDs {
+			subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{SubnetID: subnetID})
+		}
+		lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+			Name:           "k8s-awesomen-awesomei-0000000000",
+			Type:           elbv2model.LoadBalancerTypeApplication,
+			SubnetMappings: subnetMappings,
+			SecurityGroups: []core.StringToken{sg.GroupID()},
+			LoadBalancerAttributes: []elbv2model.LoadBalancerAttribute{
+				{
+					Key:   "idle_timeout.timeout_seconds",
+					Value: opts.idleTimeout,
+				},
+			},
+		})
+		ls := elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{
+			LoadBalancerARN: lb.LoadBalancerARN(),
+			Port:            80,
+			Protocol:        elbv2model.ProtocolHTTP,
+			DefaultActions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						StatusCode: "404",
+					},
+				},
+			},
+		})
+		if opts.rulePath != "" {
+			elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{
+				ListenerARN: ls.ListenerARN(),
+				Priority:    1,
+				Actions: []elbv2model.Action{
+					{
+						Type: elbv2model.ActionTypeForward,
+						ForwardConfig: &elbv2model.ForwardActionConfig{
+							TargetGroups: []elbv2model.TargetGroupTuple{{TargetGroupARN: tg.TargetGroupARN()}},
+						},
+					},
+				},
+				Conditions: []elbv2model.RuleCondition{
+					{
+						Field: elbv2model.RuleConditionFieldPathPattern,
+						PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+							Values: []string{opts.rulePath},
+						},
+					},
+				},
+			})
+		}
+		return stack
+	}
+	type changeKey struct {
+		action       plan.ChangeAction
+		resourceType string
+		resourceID   string
+	}
+	planChanges := func(stack core.Stack) []changeKey {
+		changeSet, err := deployer.Plan(ctx, stack)
+		require.NoError(t, err)
+		var keys []changeKey
+		for _, change := range changeSet.Changes {
+			keys = append(keys, changeKey{action: change.Action, resourceType: change.ResourceType, resourceID: change.ResourceID})
+		}
+		return keys
+	}
+	originalOpts := stackOptions{
+		sgCIDR:          "0.0.0.0/0",
+		healthCheckPath: "/healthz",
+		idleTimeout:     "60",
+		rulePath:        "/api",
+	}
+
+	// planning against an empty account creates everything without mutating anything.
+	assert.Equal(t, []changeKey{
+		{action: plan.ChangeActionCreate, resourceType: "AWS::EC2::SecurityGroup", resourceID: "ManagedLBSecurityGroup"},
+		{action: plan.ChangeActionCreate, resourceType: "AWS::ElasticLoadBalancingV2::TargetGroup", resourceID: "awesome-ns/awesome-ing-svc:80"},
+		{action: plan.ChangeActionCreate, resourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer", resourceID: "LoadBalancer"},
+		{action: plan.ChangeActionCreate, resourceType: "AWS::ElasticLoadBalancingV2::Listener", resourceID: "80"},
+		{action: plan.ChangeActionCreate, resourceType: "AWS::ElasticLoadBalancingV2::ListenerRule", resourceID: "80:1"},
+	}, planChanges(buildStack(originalOpts)))
+	sdkLBs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	assert.Empty(t, sdkLBs)
+	sdkTGs, err := cloud.ELBV2().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{})
+	require.NoError(t, err)
+	assert.Empty(t, sdkTGs)
+
+	require.NoError(t, deployer.Deploy(ctx, buildStack(originalOpts)))
+
+	// planning the deployed stack yields no changes.
+	assert.Empty(t, planChanges(buildStack(originalOpts)))
+
+	// planning drifted fields of every resource yields updates.
+	assert.Equal(t, []changeKey{
+		{action: plan.ChangeActionUpdate, resourceType: "AWS::EC2::SecurityGroup", resourceID: "ManagedLBSecurityGroup"},
+		{action: plan.ChangeActionUpdate, resourceType: "AWS::ElasticLoadBalancingV2::TargetGroup", resourceID: "awesome-ns/awesome-ing-svc:80"},
+		{action: plan.ChangeActionUpdate, resourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer", resourceID: "LoadBalancer"},
+		{action: plan.ChangeActionUpdate, resourceType: "AWS::ElasticLoadBalancingV2::ListenerRule", resourceID: "80:1"},
+	}, planChanges(buildStack(stackOptions{
+		sgCIDR:          "10.0.0.0/8",
+		healthCheckPath: "/ready",
+		idleTimeout:     "120",
+		rulePath:        "/v2/api",
+	})))
+
+	// planning a stack without listener rules deletes them.
+	assert.Equal(t, []changeKey{
+		{action: plan.ChangeActionDelete, resourceType: "AWS::ElasticLoadBalancingV2::ListenerRule"},
+	}, planChanges(buildStack(stackOptions{
+		sgCIDR:          "0.0.0.0/0",
+		healthCheckPath: "/healthz",
+		idleTimeout:     "60",
+	})))
+
+	// planning an empty stack deletes everything, with targetGroups and securityGroups deleted last.
+	assert.Equal(t, []changeKey{
+		{action: plan.ChangeActionDelete, resourceType: "AWS::EC2::SecurityGroup"},
+		{action: plan.ChangeActionDelete, resourceType: "AWS::ElasticLoadBalancingV2::TargetGroup"},
+		{action: plan.ChangeActionDelete, resourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer"},
+	}, planChanges(core.NewDefaultStack(stackID)))
+	sdkLBs, err = cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	assert.Len(t, sdkLBs, 1)
+}
+
+// newFakeCloudStackDeployer constructs a stackDeployer backed by fake cloud with two subnets in its VPC.
+func newFakeCloudStackDeployer(ctx context.Context, t *testing.T) (*fake.Cloud, []string, *defaultStackDeployer) {
+	cloud := fake.NewCloud("us-west-2")
+	var subnetIDs []string
+	for _, subnet := range []struct {
+		cidrBlock string
+		az        string
+	}{
+		{cidrBlock: "192.168.0.0/19", az: "us-west-2a"},
+		{cidrBlock: "192.168.32.0/19", az: "us-west-2b"},
+	} {
+		resp, err := cloud.EC2().CreateSubnetWithContext(ctx, &ec2sdk.CreateSubnetInput{
+			VpcId:            awssdk.String(cloud.VpcID()),
+			CidrBlock:        awssdk.String(subnet.cidrBlock),
+			AvailabilityZone: awssdk.String(subnet.az),
+		})
+		require.NoError(t, err)
+		subnetIDs = append(subnetIDs, awssdk.StringValue(resp.Subnet.SubnetId))
+	}
+
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+	logger := &log.NullLogger{}
+	networkingSGManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), logger)
+	networkingSGReconciler := networking.NewDefaultSecurityGroupReconciler(networkingSGManager, logger)
+	deployer := NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
+		config.ControllerConfig{ClusterName: "cluster-name"}, "ingress.k8s.aws", logger)
+	return cloud, subnetIDs, deployer
+}
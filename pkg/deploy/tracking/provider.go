@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"strings"
 )
 
 //we use AWS tags and K8s labels to track resources we have created.
@@ -17,11 +18,18 @@ import (
 //    * For LoadBalancer, `resource-id` will be `LoadBalancer`
 //    * For Managed LB SecurityGroup, `resource-id` will be `ManagedLBSecurityGroup`
 //    * For TargetGroup, `resource-id` will be `namespace/ingressName-serviceName:servicePort`
+//    * For ListenerRule, `resource-id` will be `port:hash(namespace, ingressName, host, pathType, path)`
 //  * `service.k8s.aws/stack: stack-id` will be applied on all AWS resources provisioned for Service resources:
 //    * `stack-id` will be `namespace/serviceName`
 //  * `service.k8s.aws/resource: resource-id` will be applied on all AWS resources provisioned for Service resources:
 //    * For LoadBalancer, `resource-id` will be `LoadBalancer`
 //    * For TargetGroup, `resource-id` will be `namespace/serviceName:servicePort`
+//  * `gateway.k8s.aws/stack: stack-id` will be applied on all AWS resources provisioned for Gateway resources:
+//    * `stack-id` will be `namespace/gatewayName`
+//  * `gateway.k8s.aws/resource: resource-id` will be applied on all AWS resources provisioned for Gateway resources:
+//    * For LoadBalancer, `resource-id` will be `LoadBalancer`
+//    * For Managed LB SecurityGroup, `resource-id` will be `ManagedLBSecurityGroup`
+//    * For TargetGroup, `resource-id` will be `namespace/serviceName:servicePort`
 //For K8s resources created by this controller, the labelling strategy is as follows:
 //  * For explicit IngressGroup, the following tags will be applied on all K8s resources:
 //    * `ingress.k8s.aws/stack: groupName`
@@ -31,6 +39,9 @@ import (
 //  * For Service, the following tags will be applied on all K8s resources:
 //    * `service.k8s.aws/stack-namespace: namespace`
 //    * `service.k8s.aws/stack-name: serviceName`
+//  * For Gateway, the following tags will be applied on all K8s resources:
+//    * `gateway.k8s.aws/stack-namespace: namespace`
+//    * `gateway.k8s.aws/stack-name: gatewayName`
 
 // AWS TagKey for cluster resources.
 const clusterNameTagKey = "elbv2.k8s.aws/cluster"
@@ -52,6 +63,13 @@ type Provider interface {
 	// StackLabels provide the suitable k8s labels for stack.
 	StackLabels(stack core.Stack) map[string]string
 
+	// ClusterTags provide the tags shared by all resources provisioned within cluster.
+	ClusterTags() map[string]string
+
+	// StackIDFromTags decodes the stackID from tags of resources provisioned for stack.
+	// returns false if the resource isn't provisioned for any stack tracked by this provider.
+	StackIDFromTags(tags map[string]string) (core.StackID, bool)
+
 	// StackTagsLegacy provides the tags for stack with legacy clusterName.
 	// this is for backwards compatibility with AWSALBIngressController(v1.1.3+)
 	StackTagsLegacy(stack core.Stack) map[string]string
@@ -111,6 +129,27 @@ func (p *defaultProvider) StackLabels(stack core.Stack) map[string]string {
 	}
 }
 
+func (p *defaultProvider) ClusterTags() map[string]string {
+	return map[string]string{
+		clusterNameTagKey: p.clusterName,
+	}
+}
+
+func (p *defaultProvider) StackIDFromTags(tags map[string]string) (core.StackID, bool) {
+	if tags[clusterNameTagKey] != p.clusterName {
+		return core.StackID{}, false
+	}
+	rawStackID, ok := tags[p.prefixedTrackingKey("stack")]
+	if !ok || rawStackID == "" {
+		return core.StackID{}, false
+	}
+	parts := strings.SplitN(rawStackID, "/", 2)
+	if len(parts) == 1 {
+		return core.StackID{Name: parts[0]}, true
+	}
+	return core.StackID{Namespace: parts[0], Name: parts[1]}, true
+}
+
 func (p *defaultProvider) StackTagsLegacy(stack core.Stack) map[string]string {
 	stackID := stack.StackID()
 	return map[string]string{
@@ -159,6 +159,73 @@ func Test_defaultProvider_StackLabels(t *testing.T) {
 	}
 }
 
+func Test_defaultProvider_ClusterTags(t *testing.T) {
+	provider := NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+	got := provider.ClusterTags()
+	assert.Equal(t, map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+	}, got)
+}
+
+func Test_defaultProvider_StackIDFromTags(t *testing.T) {
+	type args struct {
+		tags map[string]string
+	}
+	tests := []struct {
+		name        string
+		provider    *defaultProvider
+		args        args
+		wantStackID core.StackID
+		wantOK      bool
+	}{
+		{
+			name:     "stackID for explicit IngressGroup",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			args: args{tags: map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster-name",
+				"ingress.k8s.aws/stack": "awesome-group",
+			}},
+			wantStackID: core.StackID{Namespace: "", Name: "awesome-group"},
+			wantOK:      true,
+		},
+		{
+			name:     "stackID for implicit IngressGroup",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			args: args{tags: map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster-name",
+				"ingress.k8s.aws/stack": "namespace/ingressName",
+			}},
+			wantStackID: core.StackID{Namespace: "namespace", Name: "ingressName"},
+			wantOK:      true,
+		},
+		{
+			name:     "resource provisioned for Service with Ingress provider",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			args: args{tags: map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster-name",
+				"service.k8s.aws/stack": "namespace/serviceName",
+			}},
+			wantOK: false,
+		},
+		{
+			name:     "resource provisioned for another cluster",
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			args: args{tags: map[string]string{
+				"elbv2.k8s.aws/cluster": "other-cluster",
+				"service.k8s.aws/stack": "namespace/serviceName",
+			}},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStackID, gotOK := tt.provider.StackIDFromTags(tt.args.tags)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.Equal(t, tt.wantStackID, gotStackID)
+		})
+	}
+}
+
 func Test_defaultProvider_StackTagsLegacy(t *testing.T) {
 	type args struct {
 		stack core.Stack
@@ -0,0 +1,38 @@
+package gateway
+
+import (
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	// GatewayClassController is the controller name for GatewayClasses managed by this controller.
+	GatewayClassController = "gateway.k8s.aws/alb"
+
+	// kindGateway is the only parent kind routes can be attached to.
+	kindGateway = "Gateway"
+	// kindHTTPRoute is the only route kind supported by ALB listeners.
+	kindHTTPRoute = "HTTPRoute"
+	// kindService is the only backend kind supported by ALB target groups.
+	kindService = "Service"
+)
+
+// IsGatewayClassManaged checks whether GatewayClass is managed by this controller.
+func IsGatewayClassManaged(gwClass *gwapi.GatewayClass) bool {
+	return gwClass.Spec.ControllerName == GatewayClassController
+}
+
+// AttachedGateway is a Gateway along with the routes attached to its listeners.
+type AttachedGateway struct {
+	Gateway *gwapi.Gateway
+
+	// Listeners are in the same order as Gateway's listeners.
+	Listeners []AttachedListener
+}
+
+// AttachedListener is a Gateway listener along with the routes attached to it.
+type AttachedListener struct {
+	Listener gwapi.Listener
+
+	// Routes are ordered by creation timestamp, then by namespace/name.
+	Routes []*gwapi.HTTPRoute
+}
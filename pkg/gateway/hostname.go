@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"strings"
+
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// computeRouteHostnames computes the hostnames a route serves on a listener.
+// it returns false if none of route hostnames is accepted by listener.
+// an empty hostname list means the route serves any hostname.
+func computeRouteHostnames(listenerHostname *gwapi.Hostname, routeHostnames []gwapi.Hostname) ([]string, bool) {
+	lsHostname := ""
+	if listenerHostname != nil {
+		lsHostname = string(*listenerHostname)
+	}
+	if len(routeHostnames) == 0 {
+		if lsHostname == "" {
+			return nil, true
+		}
+		return []string{lsHostname}, true
+	}
+
+	var hostnames []string
+	for _, routeHostname := range routeHostnames {
+		if hostname, ok := intersectHostname(lsHostname, string(routeHostname)); ok {
+			hostnames = append(hostnames, hostname)
+		}
+	}
+	return hostnames, len(hostnames) != 0
+}
+
+// intersectHostname computes the most specific hostname matched by both lhs and rhs.
+// an empty hostname matches any hostname, and a wildcard hostname like "*.example.com" matches any subdomain of "example.com".
+func intersectHostname(lhs string, rhs string) (string, bool) {
+	switch {
+	case lhs == "":
+		return rhs, true
+	case rhs == "":
+		return lhs, true
+	case lhs == rhs:
+		return lhs, true
+	case isWildcardHostname(lhs) && hostnameMatchesWildcard(rhs, lhs):
+		return rhs, true
+	case isWildcardHostname(rhs) && hostnameMatchesWildcard(lhs, rhs):
+		return lhs, true
+	default:
+		return "", false
+	}
+}
+
+// isWildcardHostname checks whether hostname is a wildcard hostname like "*.example.com".
+func isWildcardHostname(hostname string) bool {
+	return strings.HasPrefix(hostname, "*.")
+}
+
+// hostnameMatchesWildcard checks whether hostname is matched by wildcardHostname.
+// hostname itself can be a more specific wildcard hostname.
+func hostnameMatchesWildcard(hostname string, wildcardHostname string) bool {
+	suffix := strings.TrimPrefix(wildcardHostname, "*")
+	return strings.HasSuffix(hostname, suffix) && len(hostname) > len(suffix)
+}
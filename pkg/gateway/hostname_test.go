@@ -0,0 +1,168 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func Test_computeRouteHostnames(t *testing.T) {
+	listenerHostname := gwapi.Hostname("*.example.com")
+	type args struct {
+		listenerHostname *gwapi.Hostname
+		routeHostnames   []gwapi.Hostname
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantHostnames []string
+		wantMatched   bool
+	}{
+		{
+			name: "both listener and route don't have hostnames",
+			args: args{
+				listenerHostname: nil,
+				routeHostnames:   nil,
+			},
+			wantHostnames: nil,
+			wantMatched:   true,
+		},
+		{
+			name: "only listener have hostname",
+			args: args{
+				listenerHostname: &listenerHostname,
+				routeHostnames:   nil,
+			},
+			wantHostnames: []string{"*.example.com"},
+			wantMatched:   true,
+		},
+		{
+			name: "only route have hostnames",
+			args: args{
+				listenerHostname: nil,
+				routeHostnames:   []gwapi.Hostname{"a.example.com", "b.example.org"},
+			},
+			wantHostnames: []string{"a.example.com", "b.example.org"},
+			wantMatched:   true,
+		},
+		{
+			name: "route hostnames partially matches listener hostname",
+			args: args{
+				listenerHostname: &listenerHostname,
+				routeHostnames:   []gwapi.Hostname{"a.example.com", "b.example.org"},
+			},
+			wantHostnames: []string{"a.example.com"},
+			wantMatched:   true,
+		},
+		{
+			name: "route hostnames doesn't match listener hostname",
+			args: args{
+				listenerHostname: &listenerHostname,
+				routeHostnames:   []gwapi.Hostname{"example.com", "b.example.org"},
+			},
+			wantHostnames: nil,
+			wantMatched:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHostnames, gotMatched := computeRouteHostnames(tt.args.listenerHostname, tt.args.routeHostnames)
+			assert.Equal(t, tt.wantHostnames, gotHostnames)
+			assert.Equal(t, tt.wantMatched, gotMatched)
+		})
+	}
+}
+
+func Test_intersectHostname(t *testing.T) {
+	type args struct {
+		lhs string
+		rhs string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantHostname string
+		wantMatched  bool
+	}{
+		{
+			name: "lhs is empty",
+			args: args{
+				lhs: "",
+				rhs: "a.example.com",
+			},
+			wantHostname: "a.example.com",
+			wantMatched:  true,
+		},
+		{
+			name: "rhs is empty",
+			args: args{
+				lhs: "*.example.com",
+				rhs: "",
+			},
+			wantHostname: "*.example.com",
+			wantMatched:  true,
+		},
+		{
+			name: "identical hostnames",
+			args: args{
+				lhs: "a.example.com",
+				rhs: "a.example.com",
+			},
+			wantHostname: "a.example.com",
+			wantMatched:  true,
+		},
+		{
+			name: "lhs is wildcard that matches rhs",
+			args: args{
+				lhs: "*.example.com",
+				rhs: "a.b.example.com",
+			},
+			wantHostname: "a.b.example.com",
+			wantMatched:  true,
+		},
+		{
+			name: "rhs is wildcard that matches lhs",
+			args: args{
+				lhs: "a.example.com",
+				rhs: "*.example.com",
+			},
+			wantHostname: "a.example.com",
+			wantMatched:  true,
+		},
+		{
+			name: "wildcard matches more specific wildcard",
+			args: args{
+				lhs: "*.example.com",
+				rhs: "*.a.example.com",
+			},
+			wantHostname: "*.a.example.com",
+			wantMatched:  true,
+		},
+		{
+			name: "wildcard doesn't match the parent domain",
+			args: args{
+				lhs: "*.example.com",
+				rhs: "example.com",
+			},
+			wantHostname: "",
+			wantMatched:  false,
+		},
+		{
+			name: "different hostnames",
+			args: args{
+				lhs: "a.example.com",
+				rhs: "b.example.com",
+			},
+			wantHostname: "",
+			wantMatched:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHostname, gotMatched := intersectHostname(tt.args.lhs, tt.args.rhs)
+			assert.Equal(t, tt.wantHostname, gotHostname)
+			assert.Equal(t, tt.wantMatched, gotMatched)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig) (*elbv2model.Listener, error) {
+	lsSpec, err := t.buildListenerSpec(ctx, lbARN, port, config)
+	if err != nil {
+		return nil, err
+	}
+	lsResID := fmt.Sprintf("%v", port)
+	ls := elbv2model.NewListener(t.stack, lsResID, lsSpec)
+	return ls, nil
+}
+
+func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig) (elbv2model.ListenerSpec, error) {
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts))
+	for _, certARN := range config.tlsCerts {
+		certs = append(certs, elbv2model.Certificate{
+			CertificateARN: core.LiteralStringToken(certARN),
+		})
+	}
+	return elbv2model.ListenerSpec{
+		LoadBalancerARN: lbARN,
+		Port:            port,
+		Protocol:        config.protocol,
+		DefaultActions:  []elbv2model.Action{t.build404Action(ctx)},
+		Certificates:    certs,
+		SSLPolicy:       config.sslPolicy,
+		Tags:            algorithm.MergeStringMap(t.defaultTags, tags),
+	}, nil
+}
+
+// the listen port config for specific listener port.
+type listenPortConfig struct {
+	protocol       elbv2model.Protocol
+	inboundCIDRv4s []string
+	inboundCIDRv6s []string
+	sslPolicy      *string
+	tlsCerts       []string
+}
+
+// computeListenPortConfig computes the listen port config for Gateway listeners that shares the same port.
+func (t *defaultModelBuildTask) computeListenPortConfig(ctx context.Context, port int64, listeners []AttachedListener) (listenPortConfig, error) {
+	protocols := sets.NewString()
+	for _, listener := range listeners {
+		protocols.Insert(string(listener.Listener.Protocol))
+	}
+	if len(protocols) > 1 {
+		return listenPortConfig{}, errors.Errorf("conflicting protocol for port %v: %v", port, protocols.List())
+	}
+	rawProtocol, _ := protocols.PopAny()
+	var protocol elbv2model.Protocol
+	switch rawProtocol {
+	case string(gwapi.HTTPProtocolType):
+		protocol = elbv2model.ProtocolHTTP
+	case string(gwapi.HTTPSProtocolType):
+		protocol = elbv2model.ProtocolHTTPS
+	default:
+		return listenPortConfig{}, errors.Errorf("listener protocol must be within [%v, %v]: %v", gwapi.HTTPProtocolType, gwapi.HTTPSProtocolType, rawProtocol)
+	}
+
+	inboundCIDRv4s, inboundCIDRv6s, err := t.computeExplicitInboundCIDRs(ctx)
+	if err != nil {
+		return listenPortConfig{}, err
+	}
+	if len(inboundCIDRv4s) == 0 && len(inboundCIDRv6s) == 0 {
+		inboundCIDRv4s = []string{"0.0.0.0/0"}
+		inboundCIDRv6s = []string{"::/0"}
+	}
+	cfg := listenPortConfig{
+		protocol:       protocol,
+		inboundCIDRv4s: inboundCIDRv4s,
+		inboundCIDRv6s: inboundCIDRv6s,
+	}
+	if protocol == elbv2model.ProtocolHTTPS {
+		tlsCerts, err := t.computeTLSCertARNs(ctx, port, listeners)
+		if err != nil {
+			return listenPortConfig{}, err
+		}
+		cfg.tlsCerts = tlsCerts
+		cfg.sslPolicy = awssdk.String(t.defaultSSLPolicy)
+		_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSSLPolicy, cfg.sslPolicy, t.gw.Gateway.Annotations)
+	}
+	return cfg, nil
+}
+
+// computeTLSCertARNs computes the TLS certificates for HTTPS listeners.
+// certificates can be specified explicitly via annotation on Gateway, otherwise they are discovered from ACM by listener hostnames.
+func (t *defaultModelBuildTask) computeTLSCertARNs(ctx context.Context, port int64, listeners []AttachedListener) ([]string, error) {
+	for _, listener := range listeners {
+		if listener.Listener.TLS != nil && listener.Listener.TLS.Mode != nil && *listener.Listener.TLS.Mode == gwapi.TLSModePassthrough {
+			return nil, errors.Errorf("TLS passthrough is not supported for port %v", port)
+		}
+	}
+
+	var explicitTLSCertARNs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &explicitTLSCertARNs, t.gw.Gateway.Annotations); exists {
+		return explicitTLSCertARNs, nil
+	}
+	hosts := sets.NewString()
+	for _, listener := range listeners {
+		if listener.Listener.Hostname != nil && len(*listener.Listener.Hostname) != 0 {
+			hosts.Insert(string(*listener.Listener.Hostname))
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, errors.Errorf("no certificate specified for HTTPS port %v, either specify listener hostname or %v annotation",
+			port, annotations.IngressSuffixCertificateARN)
+	}
+	return t.certDiscovery.Discover(ctx, hosts.List())
+}
+
+func (t *defaultModelBuildTask) computeExplicitInboundCIDRs(_ context.Context) ([]string, []string, error) {
+	var rawInboundCIDRs []string
+	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &rawInboundCIDRs, t.gw.Gateway.Annotations)
+
+	var inboundCIDRv4s, inboundCIDRv6s []string
+	for _, cidr := range rawInboundCIDRs {
+		_, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid %v settings on Gateway: %v", annotations.IngressSuffixInboundCIDRs, k8s.NamespacedName(t.gw.Gateway))
+		}
+		if strings.Contains(cidr, ":") {
+			inboundCIDRv6s = append(inboundCIDRv6s, cidr)
+		} else {
+			inboundCIDRv4s = append(inboundCIDRv4s, cidr)
+		}
+	}
+	return inboundCIDRv4s, inboundCIDRv6s, nil
+}
+
+func (t *defaultModelBuildTask) build404Action(_ context.Context) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "404",
+		},
+	}
+}
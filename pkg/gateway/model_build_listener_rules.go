@@ -0,0 +1,434 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// routeMatch is a single HTTPRoute match on a specific hostname, which is translated into a single listener rule.
+type routeMatch struct {
+	route     *gwapi.HTTPRoute
+	ruleIdx   int
+	matchIdx  int
+	hostname  string
+	pathType  gwapi.PathMatchType
+	pathValue string
+	match     gwapi.HTTPRouteMatch
+}
+
+func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN core.StringToken, port int64, protocol elbv2model.Protocol, listeners []AttachedListener) error {
+	routeMatches, err := t.computeRouteMatches(ctx, listeners)
+	if err != nil {
+		return err
+	}
+	sortRouteMatchesByPrecedence(routeMatches)
+
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return err
+	}
+	var rules []ingress.Rule
+	ruleIDOccurrences := make(map[string]int)
+	for _, routeMatch := range routeMatches {
+		conditions, err := t.buildRuleConditions(ctx, routeMatch)
+		if err != nil {
+			return errors.Wrapf(err, "httproute: %v", k8s.NamespacedName(routeMatch.route))
+		}
+		actions, err := t.buildRuleActions(ctx, routeMatch)
+		if err != nil {
+			return errors.Wrapf(err, "httproute: %v", k8s.NamespacedName(routeMatch.route))
+		}
+		ruleID, err := buildListenerRuleID(routeMatch)
+		if err != nil {
+			return errors.Wrapf(err, "httproute: %v", k8s.NamespacedName(routeMatch.route))
+		}
+		ruleIDOccurrences[ruleID]++
+		if occurrence := ruleIDOccurrences[ruleID]; occurrence > 1 {
+			ruleID = fmt.Sprintf("%v-%v", ruleID, occurrence)
+		}
+		rules = append(rules, ingress.Rule{
+			ID:         ruleID,
+			Conditions: conditions,
+			Actions:    actions,
+			Tags:       algorithm.MergeStringMap(t.defaultTags, tags),
+		})
+	}
+	optimizedRules, err := t.ruleOptimizer.Optimize(ctx, port, protocol, rules)
+	if err != nil {
+		return err
+	}
+
+	priority := int64(1)
+	for _, rule := range optimizedRules {
+		ruleResID := fmt.Sprintf("%v:%v", port, rule.ID)
+		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
+			ListenerARN: lsARN,
+			Priority:    priority,
+			Conditions:  rule.Conditions,
+			Actions:     rule.Actions,
+			Tags:        rule.Tags,
+		})
+		priority += 1
+	}
+	return nil
+}
+
+// buildListenerRuleID computes a stable identity for the listener rule generated for HTTPRoute match.
+// The identity is derived from HTTPRoute key, hostname and match instead of the rule's priority,
+// so that existing listener rules can be matched and reordered in place when matches are inserted or removed.
+func buildListenerRuleID(routeMatch routeMatch) (string, error) {
+	matchJSON, err := json.Marshal(routeMatch.match)
+	if err != nil {
+		return "", err
+	}
+	return ingress.ComputeListenerRuleID(routeMatch.route.Namespace, routeMatch.route.Name, routeMatch.hostname, string(matchJSON)), nil
+}
+
+// computeRouteMatches computes the route matches for Gateway listeners that shares the same port.
+// a route attached to multiple listeners will only contribute the same route match once.
+func (t *defaultModelBuildTask) computeRouteMatches(_ context.Context, listeners []AttachedListener) ([]routeMatch, error) {
+	var routeMatches []routeMatch
+	visitedRouteMatches := sets.NewString()
+	for _, listener := range listeners {
+		for _, route := range listener.Routes {
+			hostnames, _ := computeRouteHostnames(listener.Listener.Hostname, route.Spec.Hostnames)
+			if len(hostnames) == 0 {
+				hostnames = []string{""}
+			}
+			for ruleIdx, rule := range route.Spec.Rules {
+				matches := rule.Matches
+				if len(matches) == 0 {
+					matches = []gwapi.HTTPRouteMatch{{}}
+				}
+				for matchIdx, match := range matches {
+					pathType, pathValue := computeMatchPath(match)
+					for _, hostname := range hostnames {
+						routeMatchKey := fmt.Sprintf("%v/%v/%v/%v", k8s.NamespacedName(route), ruleIdx, matchIdx, hostname)
+						if visitedRouteMatches.Has(routeMatchKey) {
+							continue
+						}
+						visitedRouteMatches.Insert(routeMatchKey)
+						routeMatches = append(routeMatches, routeMatch{
+							route:     route,
+							ruleIdx:   ruleIdx,
+							matchIdx:  matchIdx,
+							hostname:  hostname,
+							pathType:  pathType,
+							pathValue: pathValue,
+							match:     match,
+						})
+					}
+				}
+			}
+		}
+	}
+	return routeMatches, nil
+}
+
+// computeMatchPath computes the path type and value with defaults applied.
+func computeMatchPath(match gwapi.HTTPRouteMatch) (gwapi.PathMatchType, string) {
+	pathType := gwapi.PathMatchPathPrefix
+	pathValue := "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			pathValue = *match.Path.Value
+		}
+	}
+	return pathType, pathValue
+}
+
+// sortRouteMatchesByPrecedence sorts route matches by the Gateway API precedence rules:
+//   - non-wildcard hostname over wildcard hostname, and longer hostname over shorter ones.
+//   - exact path match over prefix path match, and longer path over shorter ones.
+//   - method match over no method match.
+//   - the largest number of header matches.
+//   - the largest number of query param matches.
+//   - the oldest route based on creation timestamp, then the route appearing first in alphabetical order by namespace/name.
+//   - the first matching rule within the route.
+func sortRouteMatchesByPrecedence(routeMatches []routeMatch) {
+	sort.SliceStable(routeMatches, func(i, j int) bool {
+		lhs, rhs := routeMatches[i], routeMatches[j]
+		if lhsWildcard, rhsWildcard := isWildcardHostname(lhs.hostname), isWildcardHostname(rhs.hostname); lhsWildcard != rhsWildcard {
+			return !lhsWildcard
+		}
+		if len(lhs.hostname) != len(rhs.hostname) {
+			return len(lhs.hostname) > len(rhs.hostname)
+		}
+		if lhsExact, rhsExact := lhs.pathType == gwapi.PathMatchExact, rhs.pathType == gwapi.PathMatchExact; lhsExact != rhsExact {
+			return lhsExact
+		}
+		if len(lhs.pathValue) != len(rhs.pathValue) {
+			return len(lhs.pathValue) > len(rhs.pathValue)
+		}
+		if lhsMethod, rhsMethod := lhs.match.Method != nil, rhs.match.Method != nil; lhsMethod != rhsMethod {
+			return lhsMethod
+		}
+		if lhsHeaders, rhsHeaders := countHeaderMatches(lhs.match), countHeaderMatches(rhs.match); lhsHeaders != rhsHeaders {
+			return lhsHeaders > rhsHeaders
+		}
+		if lhsQueryParams, rhsQueryParams := countQueryParamMatches(lhs.match), countQueryParamMatches(rhs.match); lhsQueryParams != rhsQueryParams {
+			return lhsQueryParams > rhsQueryParams
+		}
+		if lhs.route != rhs.route {
+			return isRouteOrderedBefore(lhs.route, rhs.route)
+		}
+		if lhs.ruleIdx != rhs.ruleIdx {
+			return lhs.ruleIdx < rhs.ruleIdx
+		}
+		return lhs.matchIdx < rhs.matchIdx
+	})
+}
+
+func countHeaderMatches(match gwapi.HTTPRouteMatch) int {
+	return len(match.Headers)
+}
+
+func countQueryParamMatches(match gwapi.HTTPRouteMatch) int {
+	return len(match.QueryParams)
+}
+
+func (t *defaultModelBuildTask) buildRuleConditions(_ context.Context, routeMatch routeMatch) ([]elbv2model.RuleCondition, error) {
+	var conditions []elbv2model.RuleCondition
+	if routeMatch.hostname != "" {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+				Values: []string{routeMatch.hostname},
+			},
+		})
+	}
+
+	pathPatterns, err := buildPathPatterns(routeMatch.pathType, routeMatch.pathValue)
+	if err != nil {
+		return nil, err
+	}
+	conditions = append(conditions, elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldPathPattern,
+		PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+			Values: pathPatterns,
+		},
+	})
+
+	if routeMatch.match.Method != nil {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+				Values: []string{string(*routeMatch.match.Method)},
+			},
+		})
+	}
+
+	for _, headerMatch := range routeMatch.match.Headers {
+		if headerMatch.Type != nil && *headerMatch.Type != gwapi.HeaderMatchExact {
+			return nil, errors.Errorf("unsupported header match type: %v", *headerMatch.Type)
+		}
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+				HTTPHeaderName: string(headerMatch.Name),
+				Values:         []string{headerMatch.Value},
+			},
+		})
+	}
+
+	// multiple key/value pairs within single query string condition are ORed, thus we use a condition per query param.
+	for _, queryParamMatch := range routeMatch.match.QueryParams {
+		if queryParamMatch.Type != nil && *queryParamMatch.Type != gwapi.QueryParamMatchExact {
+			return nil, errors.Errorf("unsupported query param match type: %v", *queryParamMatch.Type)
+		}
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldQueryString,
+			QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+				Values: []elbv2model.QueryStringKeyValuePair{
+					{
+						Key:   awssdk.String(queryParamMatch.Name),
+						Value: queryParamMatch.Value,
+					},
+				},
+			},
+		})
+	}
+	return conditions, nil
+}
+
+// buildPathPatterns will build ELBv2's path patterns for given path match.
+// with Prefix type, "/foo" matches path like "/foo" or "/foo/" or "/foo/bar", thus we generate two path patterns: "/foo" and "/foo/*".
+// a special case is "/", which matches all paths, thus we generate the path pattern as "/*".
+func buildPathPatterns(pathType gwapi.PathMatchType, path string) ([]string, error) {
+	switch pathType {
+	case gwapi.PathMatchExact:
+		if strings.ContainsAny(path, "*?") {
+			return nil, errors.Errorf("exact path shouldn't contain wildcards: %v", path)
+		}
+		return []string{path}, nil
+	case gwapi.PathMatchPathPrefix:
+		if strings.ContainsAny(path, "*?") {
+			return nil, errors.Errorf("prefix path shouldn't contain wildcards: %v", path)
+		}
+		normalizedPath := strings.TrimSuffix(path, "/")
+		if normalizedPath == "" {
+			return []string{"/*"}, nil
+		}
+		return []string{normalizedPath, normalizedPath + "/*"}, nil
+	default:
+		return nil, errors.Errorf("unsupported path match type: %v", pathType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildRuleActions(ctx context.Context, routeMatch routeMatch) ([]elbv2model.Action, error) {
+	rule := routeMatch.route.Spec.Rules[routeMatch.ruleIdx]
+	var redirectFilter *gwapi.HTTPRequestRedirectFilter
+	for _, filter := range rule.Filters {
+		switch filter.Type {
+		case gwapi.HTTPRouteFilterRequestRedirect:
+			if filter.RequestRedirect == nil {
+				return nil, errors.Errorf("missing requestRedirect for filter type: %v", filter.Type)
+			}
+			if redirectFilter != nil {
+				return nil, errors.New("multiple requestRedirect filters are not supported")
+			}
+			redirectFilter = filter.RequestRedirect
+		default:
+			return nil, errors.Errorf("unsupported filter type: %v", filter.Type)
+		}
+	}
+	if redirectFilter != nil {
+		if len(rule.BackendRefs) != 0 {
+			return nil, errors.New("requestRedirect filter cannot be combined with backendRefs")
+		}
+		redirectAction, err := t.buildRedirectAction(ctx, *redirectFilter)
+		if err != nil {
+			return nil, err
+		}
+		return []elbv2model.Action{redirectAction}, nil
+	}
+
+	targetGroupTuples := make([]elbv2model.TargetGroupTuple, 0, len(rule.BackendRefs))
+	for _, backendRef := range rule.BackendRefs {
+		if len(backendRef.Filters) != 0 {
+			return nil, errors.Errorf("unsupported filter type: %v", backendRef.Filters[0].Type)
+		}
+		svcKey, err := buildBackendServiceKey(routeMatch.route, backendRef.BackendObjectReference)
+		if err != nil {
+			return nil, err
+		}
+		if backendRef.Port == nil {
+			return nil, errors.Errorf("backendRef must specify port for service: %v", svcKey)
+		}
+		permitted, err := isServiceReferencePermitted(ctx, t.k8sClient, routeMatch.route, svcKey)
+		if err != nil {
+			return nil, err
+		}
+		if !permitted {
+			t.logger.Info("ignoring backendRef not permitted by ReferencePolicy",
+				"httpRoute", k8s.NamespacedName(routeMatch.route), "service", svcKey)
+			continue
+		}
+		tg, err := t.buildTargetGroup(ctx, svcKey, intstr.FromInt(int(*backendRef.Port)))
+		if err != nil {
+			return nil, err
+		}
+		weight := int64(1)
+		if backendRef.Weight != nil {
+			weight = int64(*backendRef.Weight)
+		}
+		targetGroupTuples = append(targetGroupTuples, elbv2model.TargetGroupTuple{
+			TargetGroupARN: tg.TargetGroupARN(),
+			Weight:         awssdk.Int64(weight),
+		})
+	}
+	if len(targetGroupTuples) == 0 {
+		return []elbv2model.Action{t.build503Action(ctx)}, nil
+	}
+	return []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeForward,
+			ForwardConfig: &elbv2model.ForwardActionConfig{
+				TargetGroups: targetGroupTuples,
+			},
+		},
+	}, nil
+}
+
+// buildBackendServiceKey computes the Service referenced by backendRef, which defaults to the route's namespace.
+func buildBackendServiceKey(route *gwapi.HTTPRoute, backendRef gwapi.BackendObjectReference) (types.NamespacedName, error) {
+	if backendRef.Group != nil && *backendRef.Group != "" {
+		return types.NamespacedName{}, errors.Errorf("unsupported backendRef group: %v", *backendRef.Group)
+	}
+	if backendRef.Kind != nil && *backendRef.Kind != kindService {
+		return types.NamespacedName{}, errors.Errorf("unsupported backendRef kind: %v", *backendRef.Kind)
+	}
+	namespace := route.Namespace
+	if backendRef.Namespace != nil {
+		namespace = string(*backendRef.Namespace)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: string(backendRef.Name)}, nil
+}
+
+// buildRedirectAction builds the ALB redirect action for RequestRedirect filter.
+// when scheme is changed without port, the port is derived from the scheme instead of keeping the request port.
+func (t *defaultModelBuildTask) buildRedirectAction(_ context.Context, redirect gwapi.HTTPRequestRedirectFilter) (elbv2model.Action, error) {
+	statusCode := http.StatusFound
+	if redirect.StatusCode != nil {
+		statusCode = *redirect.StatusCode
+	}
+	if statusCode != http.StatusMovedPermanently && statusCode != http.StatusFound {
+		return elbv2model.Action{}, errors.Errorf("redirect statusCode must be within [%v, %v]: %v",
+			http.StatusMovedPermanently, http.StatusFound, statusCode)
+	}
+	redirectConfig := &elbv2model.RedirectActionConfig{
+		StatusCode: fmt.Sprintf("HTTP_%v", statusCode),
+	}
+	if redirect.Scheme != nil {
+		switch strings.ToLower(*redirect.Scheme) {
+		case "http":
+			redirectConfig.Protocol = awssdk.String(string(elbv2model.ProtocolHTTP))
+			redirectConfig.Port = awssdk.String("80")
+		case "https":
+			redirectConfig.Protocol = awssdk.String(string(elbv2model.ProtocolHTTPS))
+			redirectConfig.Port = awssdk.String("443")
+		default:
+			return elbv2model.Action{}, errors.Errorf("redirect scheme must be within [http, https]: %v", *redirect.Scheme)
+		}
+	}
+	if redirect.Hostname != nil {
+		redirectConfig.Host = awssdk.String(string(*redirect.Hostname))
+	}
+	if redirect.Port != nil {
+		redirectConfig.Port = awssdk.String(strconv.Itoa(int(*redirect.Port)))
+	}
+	return elbv2model.Action{
+		Type:           elbv2model.ActionTypeRedirect,
+		RedirectConfig: redirectConfig,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) build503Action(_ context.Context) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "503",
+		},
+	}
+}
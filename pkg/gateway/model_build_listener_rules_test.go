@@ -0,0 +1,331 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func Test_buildPathPatterns(t *testing.T) {
+	type args struct {
+		pathType gwapi.PathMatchType
+		path     string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "exact path",
+			args: args{
+				pathType: gwapi.PathMatchExact,
+				path:     "/foo",
+			},
+			want: []string{"/foo"},
+		},
+		{
+			name: "exact path with wildcard",
+			args: args{
+				pathType: gwapi.PathMatchExact,
+				path:     "/foo/*",
+			},
+			wantErr: errors.New("exact path shouldn't contain wildcards: /foo/*"),
+		},
+		{
+			name: "prefix path",
+			args: args{
+				pathType: gwapi.PathMatchPathPrefix,
+				path:     "/foo",
+			},
+			want: []string{"/foo", "/foo/*"},
+		},
+		{
+			name: "prefix path with trailing slash",
+			args: args{
+				pathType: gwapi.PathMatchPathPrefix,
+				path:     "/foo/",
+			},
+			want: []string{"/foo", "/foo/*"},
+		},
+		{
+			name: "prefix root path",
+			args: args{
+				pathType: gwapi.PathMatchPathPrefix,
+				path:     "/",
+			},
+			want: []string{"/*"},
+		},
+		{
+			name: "prefix path with wildcard",
+			args: args{
+				pathType: gwapi.PathMatchPathPrefix,
+				path:     "/foo?",
+			},
+			wantErr: errors.New("prefix path shouldn't contain wildcards: /foo?"),
+		},
+		{
+			name: "regular expression path",
+			args: args{
+				pathType: gwapi.PathMatchRegularExpression,
+				path:     "/foo.*",
+			},
+			wantErr: errors.New("unsupported path match type: RegularExpression"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildPathPatterns(tt.args.pathType, tt.args.path)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_sortRouteMatchesByPrecedence(t *testing.T) {
+	now := time.Now()
+	routeOld := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns-b",
+			Name:              "route-old",
+			CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+		},
+	}
+	routeNewA := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns-a",
+			Name:              "route-new",
+			CreationTimestamp: metav1.NewTime(now),
+		},
+	}
+	routeNewB := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns-b",
+			Name:              "route-new",
+			CreationTimestamp: metav1.NewTime(now),
+		},
+	}
+	methodGet := gwapi.HTTPMethodGet
+	methodMatch := gwapi.HTTPRouteMatch{
+		Method: &methodGet,
+	}
+	oneHeaderMatch := gwapi.HTTPRouteMatch{
+		Headers: []gwapi.HTTPHeaderMatch{{Name: "h1", Value: "v1"}},
+	}
+	twoHeaderMatch := gwapi.HTTPRouteMatch{
+		Headers: []gwapi.HTTPHeaderMatch{{Name: "h1", Value: "v1"}, {Name: "h2", Value: "v2"}},
+	}
+	oneQueryParamMatch := gwapi.HTTPRouteMatch{
+		QueryParams: []gwapi.HTTPQueryParamMatch{{Name: "q1", Value: "v1"}},
+	}
+	tests := []struct {
+		name         string
+		routeMatches []routeMatch
+		want         []routeMatch
+	}{
+		{
+			name: "non-wildcard hostname before wildcard hostname, longer hostname before shorter ones",
+			routeMatches: []routeMatch{
+				{route: routeOld, hostname: ""},
+				{route: routeOld, hostname: "*.example.com"},
+				{route: routeOld, hostname: "example.com"},
+				{route: routeOld, hostname: "a.example.com"},
+			},
+			want: []routeMatch{
+				{route: routeOld, hostname: "a.example.com"},
+				{route: routeOld, hostname: "example.com"},
+				{route: routeOld, hostname: ""},
+				{route: routeOld, hostname: "*.example.com"},
+			},
+		},
+		{
+			name: "exact path before prefix path, longer path before shorter ones",
+			routeMatches: []routeMatch{
+				{route: routeOld, pathType: gwapi.PathMatchPathPrefix, pathValue: "/"},
+				{route: routeOld, pathType: gwapi.PathMatchPathPrefix, pathValue: "/foo/bar"},
+				{route: routeOld, pathType: gwapi.PathMatchExact, pathValue: "/foo"},
+				{route: routeOld, pathType: gwapi.PathMatchPathPrefix, pathValue: "/foo"},
+			},
+			want: []routeMatch{
+				{route: routeOld, pathType: gwapi.PathMatchExact, pathValue: "/foo"},
+				{route: routeOld, pathType: gwapi.PathMatchPathPrefix, pathValue: "/foo/bar"},
+				{route: routeOld, pathType: gwapi.PathMatchPathPrefix, pathValue: "/foo"},
+				{route: routeOld, pathType: gwapi.PathMatchPathPrefix, pathValue: "/"},
+			},
+		},
+		{
+			name: "method match before more header matches",
+			routeMatches: []routeMatch{
+				{route: routeOld, matchIdx: 0, match: twoHeaderMatch},
+				{route: routeOld, matchIdx: 1, match: methodMatch},
+			},
+			want: []routeMatch{
+				{route: routeOld, matchIdx: 1, match: methodMatch},
+				{route: routeOld, matchIdx: 0, match: twoHeaderMatch},
+			},
+		},
+		{
+			name: "more header matches before less header matches, then more query param matches",
+			routeMatches: []routeMatch{
+				{route: routeOld, matchIdx: 0},
+				{route: routeOld, matchIdx: 1, match: oneQueryParamMatch},
+				{route: routeOld, matchIdx: 2, match: oneHeaderMatch},
+				{route: routeOld, matchIdx: 3, match: twoHeaderMatch},
+			},
+			want: []routeMatch{
+				{route: routeOld, matchIdx: 3, match: twoHeaderMatch},
+				{route: routeOld, matchIdx: 2, match: oneHeaderMatch},
+				{route: routeOld, matchIdx: 1, match: oneQueryParamMatch},
+				{route: routeOld, matchIdx: 0},
+			},
+		},
+		{
+			name: "older route first, then by namespace/name, then by rule and match index",
+			routeMatches: []routeMatch{
+				{route: routeNewB, ruleIdx: 0},
+				{route: routeNewA, ruleIdx: 1, matchIdx: 0},
+				{route: routeNewA, ruleIdx: 0, matchIdx: 1},
+				{route: routeNewA, ruleIdx: 0, matchIdx: 0},
+				{route: routeOld, ruleIdx: 1},
+			},
+			want: []routeMatch{
+				{route: routeOld, ruleIdx: 1},
+				{route: routeNewA, ruleIdx: 0, matchIdx: 0},
+				{route: routeNewA, ruleIdx: 0, matchIdx: 1},
+				{route: routeNewA, ruleIdx: 1, matchIdx: 0},
+				{route: routeNewB, ruleIdx: 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sortRouteMatchesByPrecedence(tt.routeMatches)
+			assert.Equal(t, tt.want, tt.routeMatches)
+		})
+	}
+}
+
+func Test_buildListenerRuleID(t *testing.T) {
+	route := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "route-1"},
+	}
+	otherRoute := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "route-2"},
+	}
+	methodGet := gwapi.HTTPMethodGet
+	methodMatch := gwapi.HTTPRouteMatch{Method: &methodGet}
+	headerMatch := gwapi.HTTPRouteMatch{Headers: []gwapi.HTTPHeaderMatch{{Name: "h1", Value: "v1"}}}
+
+	got, err := buildListenerRuleID(routeMatch{route: route, ruleIdx: 0, matchIdx: 0, hostname: "app.example.com", match: methodMatch})
+	assert.NoError(t, err)
+	assert.Len(t, got, 16)
+	// the identity doesn't depend on the position of the match within HTTPRoute.
+	movedID, err := buildListenerRuleID(routeMatch{route: route, ruleIdx: 2, matchIdx: 1, hostname: "app.example.com", match: methodMatch})
+	assert.NoError(t, err)
+	assert.Equal(t, got, movedID)
+	for _, other := range []routeMatch{
+		{route: otherRoute, hostname: "app.example.com", match: methodMatch},
+		{route: route, hostname: "other.example.com", match: methodMatch},
+		{route: route, hostname: "app.example.com", match: headerMatch},
+	} {
+		otherID, err := buildListenerRuleID(other)
+		assert.NoError(t, err)
+		assert.NotEqual(t, got, otherID)
+	}
+}
+
+func Test_defaultModelBuildTask_buildRedirectAction(t *testing.T) {
+	schemeHTTPS := "https"
+	schemeFTP := "ftp"
+	hostname := gwapi.PreciseHostname("example.com")
+	port := gwapi.PortNumber(8443)
+	statusCode301 := 301
+	statusCode307 := 307
+	tests := []struct {
+		name     string
+		redirect gwapi.HTTPRequestRedirectFilter
+		want     elbv2model.Action
+		wantErr  error
+	}{
+		{
+			name:     "default status code",
+			redirect: gwapi.HTTPRequestRedirectFilter{},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					StatusCode: "HTTP_302",
+				},
+			},
+		},
+		{
+			name: "scheme without port",
+			redirect: gwapi.HTTPRequestRedirectFilter{
+				Scheme:     &schemeHTTPS,
+				StatusCode: &statusCode301,
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					Port:       awssdk.String("443"),
+					Protocol:   awssdk.String("HTTPS"),
+					StatusCode: "HTTP_301",
+				},
+			},
+		},
+		{
+			name: "scheme, hostname and port",
+			redirect: gwapi.HTTPRequestRedirectFilter{
+				Scheme:   &schemeHTTPS,
+				Hostname: &hostname,
+				Port:     &port,
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					Host:       awssdk.String("example.com"),
+					Port:       awssdk.String("8443"),
+					Protocol:   awssdk.String("HTTPS"),
+					StatusCode: "HTTP_302",
+				},
+			},
+		},
+		{
+			name: "unsupported scheme",
+			redirect: gwapi.HTTPRequestRedirectFilter{
+				Scheme: &schemeFTP,
+			},
+			wantErr: errors.New("redirect scheme must be within [http, https]: ftp"),
+		},
+		{
+			name: "unsupported status code",
+			redirect: gwapi.HTTPRequestRedirectFilter{
+				StatusCode: &statusCode307,
+			},
+			wantErr: errors.New("redirect statusCode must be within [301, 302]: 307"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{}
+			got, err := task.buildRedirectAction(context.Background(), tt.redirect)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceIDLoadBalancer         = "LoadBalancer"
+	resourceIDManagedSecurityGroup = "ManagedLBSecurityGroup"
+)
+
+func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
+	lbSpec, err := t.buildLoadBalancerSpec(ctx, listenPortConfigByPort)
+	if err != nil {
+		return nil, err
+	}
+	lb := elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, lbSpec)
+	t.loadBalancer = lb
+	return lb, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (elbv2model.LoadBalancerSpec, error) {
+	scheme, err := t.buildLoadBalancerScheme(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	sg, err := t.buildManagedSecurityGroup(ctx, listenPortConfigByPort, ipAddressType)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	loadBalancerAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	name := t.buildLoadBalancerName(ctx, scheme)
+	return elbv2model.LoadBalancerSpec{
+		Name:                   name,
+		Type:                   elbv2model.LoadBalancerTypeApplication,
+		Scheme:                 &scheme,
+		IPAddressType:          &ipAddressType,
+		SubnetMappings:         subnetMappings,
+		SecurityGroups:         []core.StringToken{sg.GroupID()},
+		LoadBalancerAttributes: loadBalancerAttributes,
+		Tags:                   algorithm.MergeStringMap(t.defaultTags, tags),
+	}, nil
+}
+
+var invalidLoadBalancerNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme elbv2model.LoadBalancerScheme) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	_, _ = uuidHash.Write([]byte(scheme))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv2model.LoadBalancerScheme, error) {
+	rawScheme := string(t.defaultScheme)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixScheme, &rawScheme, t.gw.Gateway.Annotations)
+	switch rawScheme {
+	case string(elbv2model.LoadBalancerSchemeInternetFacing):
+		return elbv2model.LoadBalancerSchemeInternetFacing, nil
+	case string(elbv2model.LoadBalancerSchemeInternal):
+		return elbv2model.LoadBalancerSchemeInternal, nil
+	default:
+		return "", errors.Errorf("unknown scheme: %v", rawScheme)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
+	rawIPAddressType := string(t.defaultIPAddressType)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixIPAddressType, &rawIPAddressType, t.gw.Gateway.Annotations)
+	switch rawIPAddressType {
+	case string(elbv2model.IPAddressTypeIPV4):
+		return elbv2model.IPAddressTypeIPV4, nil
+	case string(elbv2model.IPAddressTypeDualStack):
+		return elbv2model.IPAddressTypeDualStack, nil
+	default:
+		return "", errors.Errorf("unknown IPAddressType: %v", rawIPAddressType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]elbv2model.SubnetMapping, error) {
+	var rawSubnetNameOrIDs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, t.gw.Gateway.Annotations); exists {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+			networking.WithSubnetsResolveLBScheme(scheme),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	stackTags := t.trackingProvider.StackTags(t.stack)
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return nil, err
+	}
+	if len(sdkLBs) == 0 {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+			networking.WithSubnetsResolveLBScheme(scheme),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't auto-discover subnets")
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	// keep subnets of existing LoadBalancer to avoid unnecessary changes when subnets are auto-discovered.
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(sdkLBs[0].LoadBalancer.AvailabilityZones))
+	for _, availabilityZone := range sdkLBs[0].LoadBalancer.AvailabilityZones {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.StringValue(availabilityZone.SubnetId),
+		})
+	}
+	return subnetMappings, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &rawAttributes, t.gw.Gateway.Annotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.LoadBalancerAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	return attributes, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) (*ec2model.SecurityGroup, error) {
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sgSpec := ec2model.SecurityGroupSpec{
+		GroupName:   t.buildManagedSecurityGroupName(ctx),
+		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+		Tags:        algorithm.MergeStringMap(t.defaultTags, tags),
+		Ingress:     t.buildManagedSecurityGroupIngressPermissions(ctx, listenPortConfigByPort, ipAddressType),
+	}
+	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	t.managedSG = sg
+	return sg, nil
+}
+
+var invalidSecurityGroupNamePtn = regexp.MustCompile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidSecurityGroupNamePtn.ReplaceAllString(t.gw.Gateway.Namespace, "")
+	sanitizedName := invalidSecurityGroupNamePtn.ReplaceAllString(t.gw.Gateway.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(_ context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) []ec2model.IPPermission {
+	var permissions []ec2model.IPPermission
+	for port, cfg := range listenPortConfigByPort {
+		for _, cidr := range cfg.inboundCIDRv4s {
+			permissions = append(permissions, ec2model.IPPermission{
+				IPProtocol: "tcp",
+				FromPort:   awssdk.Int64(port),
+				ToPort:     awssdk.Int64(port),
+				IPRanges: []ec2model.IPRange{
+					{
+						CIDRIP: cidr,
+					},
+				},
+			})
+		}
+		if ipAddressType == elbv2model.IPAddressTypeDualStack {
+			for _, cidr := range cfg.inboundCIDRv6s {
+				permissions = append(permissions, ec2model.IPPermission{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(port),
+					ToPort:     awssdk.Int64(port),
+					IPv6Range: []ec2model.IPv6Range{
+						{
+							CIDRIPv6: cidr,
+						},
+					},
+				})
+			}
+		}
+	}
+	return permissions
+}
+
+func buildLoadBalancerSubnetMappingsWithSubnets(subnets []*ec2sdk.Subnet) []elbv2model.SubnetMapping {
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.StringValue(subnet.SubnetId),
+		})
+	}
+	return subnetMappings
+}
@@ -0,0 +1,48 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// buildGatewayResourceTags builds the AWS Tags used for a Gateway. e.g. LoadBalancer, SecurityGroup, Listener, ListenerRule
+func (t *defaultModelBuildTask) buildGatewayResourceTags(_ context.Context) (map[string]string, error) {
+	var annotationTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &annotationTags, t.gw.Gateway.Annotations); err != nil {
+		return nil, err
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(annotationTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Gateway %v",
+			k8s.NamespacedName(t.gw.Gateway).String())
+	}
+	return annotationTags, nil
+}
+
+// buildGatewayBackendResourceTags builds the AWS Tags used for a Gateway and Backend. e.g. TargetGroup.
+// Note: the Tags annotation of Service takes higher priority than annotation of Gateway.
+func (t *defaultModelBuildTask) buildGatewayBackendResourceTags(_ context.Context, backend *corev1.Service) (map[string]string, error) {
+	mergedAnnotations := algorithm.MergeStringMap(backend.Annotations, t.gw.Gateway.Annotations)
+	var annotationTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &annotationTags, mergedAnnotations); err != nil {
+		return nil, err
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(annotationTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Gateway %v and Service %v",
+			k8s.NamespacedName(t.gw.Gateway).String(), k8s.NamespacedName(backend).String())
+	}
+	return annotationTags, nil
+}
+
+func (t *defaultModelBuildTask) validateTagCollisionWithExternalManagedTags(tags map[string]string) error {
+	for tagKey := range tags {
+		if t.externalManagedTags.Has(tagKey) {
+			return errors.Errorf("external managed tag key %v cannot be specified", tagKey)
+		}
+	}
+	return nil
+}
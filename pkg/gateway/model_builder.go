@@ -0,0 +1,176 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ModelBuilder is responsible for build mode stack for a Gateway.
+type ModelBuilder interface {
+	// build mode stack for a Gateway.
+	Build(ctx context.Context, gw AttachedGateway) (core.Stack, *elbv2model.LoadBalancer, error)
+}
+
+// NewDefaultModelBuilder constructs new defaultModelBuilder.
+func NewDefaultModelBuilder(k8sClient client.Client, annotationParser annotations.Parser,
+	subnetsResolver networkingpkg.SubnetsResolver, certDiscovery ingress.CertDiscovery,
+	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager,
+	clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string,
+	logger logr.Logger) *defaultModelBuilder {
+	return &defaultModelBuilder{
+		k8sClient:           k8sClient,
+		annotationParser:    annotationParser,
+		subnetsResolver:     subnetsResolver,
+		certDiscovery:       certDiscovery,
+		ruleOptimizer:       ingress.NewDefaultRuleOptimizer(logger),
+		trackingProvider:    trackingProvider,
+		elbv2TaggingManager: elbv2TaggingManager,
+		clusterName:         clusterName,
+		defaultTags:         defaultTags,
+		externalManagedTags: sets.NewString(externalManagedTags...),
+		defaultSSLPolicy:    defaultSSLPolicy,
+		logger:              logger,
+	}
+}
+
+var _ ModelBuilder = &defaultModelBuilder{}
+
+// default implementation for ModelBuilder
+type defaultModelBuilder struct {
+	k8sClient           client.Client
+	annotationParser    annotations.Parser
+	subnetsResolver     networkingpkg.SubnetsResolver
+	certDiscovery       ingress.CertDiscovery
+	ruleOptimizer       ingress.RuleOptimizer
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2deploy.TaggingManager
+
+	clusterName         string
+	defaultTags         map[string]string
+	externalManagedTags sets.String
+	defaultSSLPolicy    string
+
+	logger logr.Logger
+}
+
+// build mode stack for a Gateway.
+func (b *defaultModelBuilder) Build(ctx context.Context, gw AttachedGateway) (core.Stack, *elbv2model.LoadBalancer, error) {
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw.Gateway)))
+	task := &defaultModelBuildTask{
+		k8sClient:           b.k8sClient,
+		annotationParser:    b.annotationParser,
+		subnetsResolver:     b.subnetsResolver,
+		certDiscovery:       b.certDiscovery,
+		ruleOptimizer:       b.ruleOptimizer,
+		trackingProvider:    b.trackingProvider,
+		elbv2TaggingManager: b.elbv2TaggingManager,
+		clusterName:         b.clusterName,
+		logger:              b.logger,
+
+		gw:    gw,
+		stack: stack,
+
+		defaultTags:                               b.defaultTags,
+		externalManagedTags:                       b.externalManagedTags,
+		defaultIPAddressType:                      elbv2model.IPAddressTypeIPV4,
+		defaultScheme:                             elbv2model.LoadBalancerSchemeInternal,
+		defaultSSLPolicy:                          b.defaultSSLPolicy,
+		defaultTargetType:                         elbv2model.TargetTypeInstance,
+		defaultBackendProtocol:                    elbv2model.ProtocolHTTP,
+		defaultHealthCheckPath:                    "/",
+		defaultHealthCheckIntervalSeconds:         15,
+		defaultHealthCheckTimeoutSeconds:          5,
+		defaultHealthCheckHealthyThresholdCount:   2,
+		defaultHealthCheckUnhealthyThresholdCount: 2,
+		defaultHealthCheckMatcherHTTPCode:         "200",
+
+		loadBalancer:    nil,
+		tgByResID:       make(map[string]*elbv2model.TargetGroup),
+		backendServices: make(map[types.NamespacedName]*corev1.Service),
+	}
+	if err := task.run(ctx); err != nil {
+		return nil, nil, err
+	}
+	return task.stack, task.loadBalancer, nil
+}
+
+// the default model build task
+type defaultModelBuildTask struct {
+	k8sClient           client.Client
+	annotationParser    annotations.Parser
+	subnetsResolver     networkingpkg.SubnetsResolver
+	certDiscovery       ingress.CertDiscovery
+	ruleOptimizer       ingress.RuleOptimizer
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	clusterName         string
+	logger              logr.Logger
+
+	gw    AttachedGateway
+	stack core.Stack
+
+	defaultTags                               map[string]string
+	externalManagedTags                       sets.String
+	defaultIPAddressType                      elbv2model.IPAddressType
+	defaultScheme                             elbv2model.LoadBalancerScheme
+	defaultSSLPolicy                          string
+	defaultTargetType                         elbv2model.TargetType
+	defaultBackendProtocol                    elbv2model.Protocol
+	defaultHealthCheckPath                    string
+	defaultHealthCheckTimeoutSeconds          int64
+	defaultHealthCheckIntervalSeconds         int64
+	defaultHealthCheckHealthyThresholdCount   int64
+	defaultHealthCheckUnhealthyThresholdCount int64
+	defaultHealthCheckMatcherHTTPCode         string
+
+	loadBalancer    *elbv2model.LoadBalancer
+	managedSG       *ec2model.SecurityGroup
+	tgByResID       map[string]*elbv2model.TargetGroup
+	backendServices map[types.NamespacedName]*corev1.Service
+}
+
+func (t *defaultModelBuildTask) run(ctx context.Context) error {
+	listenersByPort := make(map[int64][]AttachedListener)
+	for _, listener := range t.gw.Listeners {
+		port := int64(listener.Listener.Port)
+		listenersByPort[port] = append(listenersByPort[port], listener)
+	}
+	listenPortConfigByPort := make(map[int64]listenPortConfig, len(listenersByPort))
+	for port, listeners := range listenersByPort {
+		cfg, err := t.computeListenPortConfig(ctx, port, listeners)
+		if err != nil {
+			return errors.Wrapf(err, "gateway: %v", k8s.NamespacedName(t.gw.Gateway))
+		}
+		listenPortConfigByPort[port] = cfg
+	}
+
+	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
+	if err != nil {
+		return err
+	}
+	for port, cfg := range listenPortConfigByPort {
+		ls, err := t.buildListener(ctx, lb.LoadBalancerARN(), port, cfg)
+		if err != nil {
+			return err
+		}
+		if err := t.buildListenerRules(ctx, ls.ListenerARN(), port, cfg.protocol, listenersByPort[port]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// isServiceReferencePermitted checks whether route is permitted to reference the Service.
+// references within the same namespace are always permitted, while cross-namespace references must be permitted by
+// a ReferencePolicy in the Service's namespace.
+func isServiceReferencePermitted(ctx context.Context, k8sClient client.Client, route *gwapi.HTTPRoute, svcKey types.NamespacedName) (bool, error) {
+	if svcKey.Namespace == route.Namespace {
+		return true, nil
+	}
+	policyList := &gwapi.ReferencePolicyList{}
+	if err := k8sClient.List(ctx, policyList, client.InNamespace(svcKey.Namespace)); err != nil {
+		return false, err
+	}
+	for _, policy := range policyList.Items {
+		if isReferencePolicyFromRoute(policy, route) && isReferencePolicyToService(policy, svcKey) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isReferencePolicyFromRoute checks whether ReferencePolicy permits references from HTTPRoutes in route's namespace.
+func isReferencePolicyFromRoute(policy gwapi.ReferencePolicy, route *gwapi.HTTPRoute) bool {
+	for _, from := range policy.Spec.From {
+		if from.Group == gwapi.GroupName && from.Kind == kindHTTPRoute && string(from.Namespace) == route.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// isReferencePolicyToService checks whether ReferencePolicy permits references to the Service.
+func isReferencePolicyToService(policy gwapi.ReferencePolicy, svcKey types.NamespacedName) bool {
+	for _, to := range policy.Spec.To {
+		if to.Group != "" || to.Kind != kindService {
+			continue
+		}
+		if to.Name == nil || string(*to.Name) == svcKey.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func Test_isServiceReferencePermitted(t *testing.T) {
+	svcName := gwapi.ObjectName("svc-a")
+	policyForAllServices := &gwapi.ReferencePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "backend-a",
+			Name:      "allow-routes",
+		},
+		Spec: gwapi.ReferencePolicySpec{
+			From: []gwapi.ReferencePolicyFrom{
+				{Group: gwapi.GroupName, Kind: "HTTPRoute", Namespace: "frontend"},
+			},
+			To: []gwapi.ReferencePolicyTo{
+				{Group: "", Kind: "Service"},
+			},
+		},
+	}
+	policyForSingleService := &gwapi.ReferencePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "backend-b",
+			Name:      "allow-routes",
+		},
+		Spec: gwapi.ReferencePolicySpec{
+			From: []gwapi.ReferencePolicyFrom{
+				{Group: gwapi.GroupName, Kind: "HTTPRoute", Namespace: "frontend"},
+			},
+			To: []gwapi.ReferencePolicyTo{
+				{Group: "", Kind: "Service", Name: &svcName},
+			},
+		},
+	}
+	policyForOtherKind := &gwapi.ReferencePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "backend-c",
+			Name:      "allow-tls-routes",
+		},
+		Spec: gwapi.ReferencePolicySpec{
+			From: []gwapi.ReferencePolicyFrom{
+				{Group: gwapi.GroupName, Kind: "TLSRoute", Namespace: "frontend"},
+			},
+			To: []gwapi.ReferencePolicyTo{
+				{Group: "", Kind: "Service"},
+			},
+		},
+	}
+	route := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "frontend",
+			Name:      "route",
+		},
+	}
+	tests := []struct {
+		name   string
+		svcKey types.NamespacedName
+		want   bool
+	}{
+		{
+			name:   "service in same namespace",
+			svcKey: types.NamespacedName{Namespace: "frontend", Name: "svc"},
+			want:   true,
+		},
+		{
+			name:   "service permitted by policy for all services",
+			svcKey: types.NamespacedName{Namespace: "backend-a", Name: "svc-z"},
+			want:   true,
+		},
+		{
+			name:   "service permitted by policy for single service",
+			svcKey: types.NamespacedName{Namespace: "backend-b", Name: "svc-a"},
+			want:   true,
+		},
+		{
+			name:   "service not permitted by policy for single service",
+			svcKey: types.NamespacedName{Namespace: "backend-b", Name: "svc-z"},
+			want:   false,
+		},
+		{
+			name:   "service not permitted by policy from other route kind",
+			svcKey: types.NamespacedName{Namespace: "backend-c", Name: "svc-a"},
+			want:   false,
+		},
+		{
+			name:   "service in namespace without policy",
+			svcKey: types.NamespacedName{Namespace: "backend-d", Name: "svc-a"},
+			want:   false,
+		},
+	}
+
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	gwapi.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+	for _, policy := range []*gwapi.ReferencePolicy{policyForAllServices, policyForSingleService, policyForOtherKind} {
+		assert.NoError(t, k8sClient.Create(context.Background(), policy.DeepCopy()))
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isServiceReferencePermitted(context.Background(), k8sClient, route, tt.svcKey)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// RouteLoader loads the routes attached to Gateways.
+type RouteLoader interface {
+	// Load returns the Gateway along with the HTTPRoutes attached to each of its listeners.
+	Load(ctx context.Context, gw *gwapi.Gateway) (AttachedGateway, error)
+}
+
+// NewDefaultRouteLoader constructs new defaultRouteLoader.
+func NewDefaultRouteLoader(k8sClient client.Client) *defaultRouteLoader {
+	return &defaultRouteLoader{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ RouteLoader = &defaultRouteLoader{}
+
+// default implementation for RouteLoader.
+// a route is attached to a listener only if both sides agree, i.e.
+//   - the route references the Gateway by its parentRefs, and the listener by sectionName if specified.
+//   - the listener allows the route by its allowedRoutes(kinds and namespaces).
+//   - the route hostnames intersects with listener hostname.
+type defaultRouteLoader struct {
+	k8sClient client.Client
+}
+
+func (l *defaultRouteLoader) Load(ctx context.Context, gw *gwapi.Gateway) (AttachedGateway, error) {
+	routeList := &gwapi.HTTPRouteList{}
+	if err := l.k8sClient.List(ctx, routeList); err != nil {
+		return AttachedGateway{}, err
+	}
+	routes := make([]*gwapi.HTTPRoute, 0, len(routeList.Items))
+	for i := range routeList.Items {
+		route := &routeList.Items[i]
+		if !route.DeletionTimestamp.IsZero() || !IsGatewayReferencedByRoute(gw, route) {
+			continue
+		}
+		routes = append(routes, route)
+	}
+	sortRoutes(routes)
+
+	nsLabelsCache := make(map[string]labels.Set)
+	listeners := make([]AttachedListener, 0, len(gw.Spec.Listeners))
+	for _, listener := range gw.Spec.Listeners {
+		var attachedRoutes []*gwapi.HTTPRoute
+		if isHTTPRouteKindAllowed(listener) {
+			for _, route := range routes {
+				allowed, err := l.isRouteAllowedByListener(ctx, gw, listener, route, nsLabelsCache)
+				if err != nil {
+					return AttachedGateway{}, err
+				}
+				if allowed {
+					attachedRoutes = append(attachedRoutes, route)
+				}
+			}
+		}
+		listeners = append(listeners, AttachedListener{
+			Listener: listener,
+			Routes:   attachedRoutes,
+		})
+	}
+	return AttachedGateway{
+		Gateway:   gw,
+		Listeners: listeners,
+	}, nil
+}
+
+// isRouteAllowedByListener checks whether route references and is allowed by the listener of Gateway.
+func (l *defaultRouteLoader) isRouteAllowedByListener(ctx context.Context, gw *gwapi.Gateway, listener gwapi.Listener,
+	route *gwapi.HTTPRoute, nsLabelsCache map[string]labels.Set) (bool, error) {
+	if !isListenerReferencedByRoute(gw, listener, route) {
+		return false, nil
+	}
+	if _, ok := computeRouteHostnames(listener.Hostname, route.Spec.Hostnames); !ok {
+		return false, nil
+	}
+
+	from := gwapi.NamespacesFromSame
+	if listener.AllowedRoutes != nil && listener.AllowedRoutes.Namespaces != nil && listener.AllowedRoutes.Namespaces.From != nil {
+		from = *listener.AllowedRoutes.Namespaces.From
+	}
+	switch from {
+	case gwapi.NamespacesFromAll:
+		return true, nil
+	case gwapi.NamespacesFromSame:
+		return route.Namespace == gw.Namespace, nil
+	case gwapi.NamespacesFromSelector:
+		if listener.AllowedRoutes.Namespaces.Selector == nil {
+			return false, errors.Errorf("missing namespaces selector on gateway: %v", k8s.NamespacedName(gw))
+		}
+		nsSelector, err := metav1.LabelSelectorAsSelector(listener.AllowedRoutes.Namespaces.Selector)
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid namespaces selector on gateway: %v", k8s.NamespacedName(gw))
+		}
+		nsLabels, err := l.loadNamespaceLabels(ctx, route.Namespace, nsLabelsCache)
+		if err != nil {
+			return false, err
+		}
+		return nsSelector.Matches(nsLabels), nil
+	default:
+		return false, errors.Errorf("unknown allowedRoutes namespaces selection: %v, gateway: %v", from, k8s.NamespacedName(gw))
+	}
+}
+
+func (l *defaultRouteLoader) loadNamespaceLabels(ctx context.Context, namespace string, nsLabelsCache map[string]labels.Set) (labels.Set, error) {
+	if nsLabels, exists := nsLabelsCache[namespace]; exists {
+		return nsLabels, nil
+	}
+	ns := &corev1.Namespace{}
+	if err := l.k8sClient.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return nil, err
+	}
+	nsLabels := labels.Set(ns.Labels)
+	nsLabelsCache[namespace] = nsLabels
+	return nsLabels, nil
+}
+
+// isHTTPRouteKindAllowed checks whether the listener allows HTTPRoutes.
+// when allowedRoutes kinds is not specified, HTTPRoute is allowed for HTTP and HTTPS listeners.
+func isHTTPRouteKindAllowed(listener gwapi.Listener) bool {
+	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
+		return listener.Protocol == gwapi.HTTPProtocolType || listener.Protocol == gwapi.HTTPSProtocolType
+	}
+	for _, kind := range listener.AllowedRoutes.Kinds {
+		if kind.Group != nil && *kind.Group != gwapi.GroupName {
+			continue
+		}
+		if kind.Kind == kindHTTPRoute {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGatewayReferencedByRoute checks whether route references the Gateway by any of its parentRefs.
+func IsGatewayReferencedByRoute(gw *gwapi.Gateway, route *gwapi.HTTPRoute) bool {
+	for _, parentRef := range route.Spec.ParentRefs {
+		if isGatewayReferencedByParentRef(gw, route, parentRef) {
+			return true
+		}
+	}
+	return false
+}
+
+// isListenerReferencedByRoute checks whether route references the listener of Gateway.
+// a parentRef without sectionName references all listeners of Gateway.
+func isListenerReferencedByRoute(gw *gwapi.Gateway, listener gwapi.Listener, route *gwapi.HTTPRoute) bool {
+	for _, parentRef := range route.Spec.ParentRefs {
+		if !isGatewayReferencedByParentRef(gw, route, parentRef) {
+			continue
+		}
+		if parentRef.SectionName == nil || *parentRef.SectionName == listener.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// isGatewayReferencedByParentRef checks whether the parentRef of route references the Gateway.
+func isGatewayReferencedByParentRef(gw *gwapi.Gateway, route *gwapi.HTTPRoute, parentRef gwapi.ParentRef) bool {
+	if parentRef.Group != nil && *parentRef.Group != gwapi.GroupName {
+		return false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != kindGateway {
+		return false
+	}
+	namespace := route.Namespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	return namespace == gw.Namespace && string(parentRef.Name) == gw.Name
+}
+
+// sortRoutes sorts routes by creation timestamp, then by namespace/name.
+func sortRoutes(routes []*gwapi.HTTPRoute) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		return isRouteOrderedBefore(routes[i], routes[j])
+	})
+}
+
+// isRouteOrderedBefore checks whether lhs route should be ordered before rhs route, which is the tie-breaker for route precedence.
+func isRouteOrderedBefore(lhs *gwapi.HTTPRoute, rhs *gwapi.HTTPRoute) bool {
+	if !lhs.CreationTimestamp.Equal(&rhs.CreationTimestamp) {
+		return lhs.CreationTimestamp.Before(&rhs.CreationTimestamp)
+	}
+	return k8s.NamespacedName(lhs).String() < k8s.NamespacedName(rhs).String()
+}
@@ -0,0 +1,260 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func Test_defaultRouteLoader_Load(t *testing.T) {
+	namespacesFromAll := gwapi.NamespacesFromAll
+	namespacesFromSelector := gwapi.NamespacesFromSelector
+	listenerHostname := gwapi.Hostname("*.example.com")
+	gwNamespace := gwapi.Namespace("gw-ns")
+	sectionNameHTTPAlt := gwapi.SectionName("http-alt")
+
+	nsGW := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gw-ns",
+		},
+	}
+	nsTeamA := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-a",
+			Labels: map[string]string{"gateway-access": "true"},
+		},
+	}
+	nsTeamB := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-b",
+		},
+	}
+	routeSameNS := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "gw-ns",
+			Name:      "route-same-ns",
+		},
+		Spec: gwapi.HTTPRouteSpec{
+			CommonRouteSpec: gwapi.CommonRouteSpec{
+				ParentRefs: []gwapi.ParentRef{{Name: "gw"}},
+			},
+		},
+	}
+	routeTeamA := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team-a",
+			Name:      "route-team-a",
+		},
+		Spec: gwapi.HTTPRouteSpec{
+			CommonRouteSpec: gwapi.CommonRouteSpec{
+				ParentRefs: []gwapi.ParentRef{{Namespace: &gwNamespace, Name: "gw"}},
+			},
+			Hostnames: []gwapi.Hostname{"a.example.com"},
+		},
+	}
+	routeTeamBWithSectionName := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team-b",
+			Name:      "route-team-b-section-name",
+		},
+		Spec: gwapi.HTTPRouteSpec{
+			CommonRouteSpec: gwapi.CommonRouteSpec{
+				ParentRefs: []gwapi.ParentRef{{Namespace: &gwNamespace, Name: "gw", SectionName: &sectionNameHTTPAlt}},
+			},
+			Hostnames: []gwapi.Hostname{"b.example.org"},
+		},
+	}
+	routeTeamBWithoutParentRefs := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team-b",
+			Name:      "route-team-b-without-parent-refs",
+		},
+	}
+
+	gw := &gwapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "gw-ns",
+			Name:      "gw",
+		},
+		Spec: gwapi.GatewaySpec{
+			GatewayClassName: "alb",
+			Listeners: []gwapi.Listener{
+				{
+					Name:     "http",
+					Port:     80,
+					Protocol: gwapi.HTTPProtocolType,
+					AllowedRoutes: &gwapi.AllowedRoutes{
+						Namespaces: &gwapi.RouteNamespaces{From: &namespacesFromAll},
+					},
+				},
+				{
+					Name:     "http-selector",
+					Port:     8080,
+					Protocol: gwapi.HTTPProtocolType,
+					Hostname: &listenerHostname,
+					AllowedRoutes: &gwapi.AllowedRoutes{
+						Namespaces: &gwapi.RouteNamespaces{
+							From: &namespacesFromSelector,
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"gateway-access": "true"},
+							},
+						},
+					},
+				},
+				{
+					Name:     "http-same-ns",
+					Port:     8081,
+					Protocol: gwapi.HTTPProtocolType,
+				},
+				{
+					Name:     "http-alt",
+					Port:     8082,
+					Protocol: gwapi.HTTPProtocolType,
+					AllowedRoutes: &gwapi.AllowedRoutes{
+						Namespaces: &gwapi.RouteNamespaces{From: &namespacesFromAll},
+					},
+				},
+				{
+					Name:     "tcp",
+					Port:     8083,
+					Protocol: gwapi.HTTPProtocolType,
+					AllowedRoutes: &gwapi.AllowedRoutes{
+						Namespaces: &gwapi.RouteNamespaces{From: &namespacesFromAll},
+						Kinds:      []gwapi.RouteGroupKind{{Kind: "TCPRoute"}},
+					},
+				},
+			},
+		},
+	}
+
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	gwapi.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+	for _, ns := range []*corev1.Namespace{nsGW, nsTeamA, nsTeamB} {
+		assert.NoError(t, k8sClient.Create(context.Background(), ns.DeepCopy()))
+	}
+	for _, route := range []*gwapi.HTTPRoute{routeSameNS, routeTeamA, routeTeamBWithSectionName, routeTeamBWithoutParentRefs} {
+		assert.NoError(t, k8sClient.Create(context.Background(), route.DeepCopy()))
+	}
+
+	loader := NewDefaultRouteLoader(k8sClient)
+	got, err := loader.Load(context.Background(), gw)
+	assert.NoError(t, err)
+	assert.Equal(t, gw, got.Gateway)
+
+	var gotRouteKeysByPort [][]string
+	for _, listener := range got.Listeners {
+		var routeKeys []string
+		for _, route := range listener.Routes {
+			routeKeys = append(routeKeys, k8s.NamespacedName(route).String())
+		}
+		gotRouteKeysByPort = append(gotRouteKeysByPort, routeKeys)
+	}
+	assert.Equal(t, [][]string{
+		{"gw-ns/route-same-ns", "team-a/route-team-a"},
+		{"team-a/route-team-a"},
+		{"gw-ns/route-same-ns"},
+		{"gw-ns/route-same-ns", "team-a/route-team-a", "team-b/route-team-b-section-name"},
+		nil,
+	}, gotRouteKeysByPort)
+}
+
+func TestIsGatewayReferencedByRoute(t *testing.T) {
+	gwNamespace := gwapi.Namespace("gw-ns")
+	otherGroup := gwapi.Group("networking.x-k8s.io")
+	otherKind := gwapi.Kind("Mesh")
+	gw := &gwapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "gw-ns",
+			Name:      "gw",
+		},
+	}
+	tests := []struct {
+		name  string
+		route *gwapi.HTTPRoute
+		want  bool
+	}{
+		{
+			name: "route without parentRefs",
+			route: &gwapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+			},
+			want: false,
+		},
+		{
+			name: "route in same namespace references gateway",
+			route: &gwapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+				Spec: gwapi.HTTPRouteSpec{
+					CommonRouteSpec: gwapi.CommonRouteSpec{
+						ParentRefs: []gwapi.ParentRef{{Name: "gw"}},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "route in other namespace references gateway without namespace",
+			route: &gwapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "route"},
+				Spec: gwapi.HTTPRouteSpec{
+					CommonRouteSpec: gwapi.CommonRouteSpec{
+						ParentRefs: []gwapi.ParentRef{{Name: "gw"}},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "route in other namespace references gateway with namespace",
+			route: &gwapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "route"},
+				Spec: gwapi.HTTPRouteSpec{
+					CommonRouteSpec: gwapi.CommonRouteSpec{
+						ParentRefs: []gwapi.ParentRef{{Name: "other-gw"}, {Namespace: &gwNamespace, Name: "gw"}},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "route references parent of other group",
+			route: &gwapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+				Spec: gwapi.HTTPRouteSpec{
+					CommonRouteSpec: gwapi.CommonRouteSpec{
+						ParentRefs: []gwapi.ParentRef{{Group: &otherGroup, Name: "gw"}},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "route references parent of other kind",
+			route: &gwapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+				Spec: gwapi.HTTPRouteSpec{
+					CommonRouteSpec: gwapi.CommonRouteSpec{
+						ParentRefs: []gwapi.ParentRef{{Kind: &otherKind, Name: "gw"}},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsGatewayReferencedByRoute(gw, tt.route)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
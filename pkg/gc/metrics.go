@@ -0,0 +1,57 @@
+package gc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemOrphanGC = "orphan_gc"
+
+	metricOrphanedResources     = "orphaned_resources"
+	metricDeletedResourcesTotal = "deleted_resources_total"
+	metricDeleteErrorsTotal     = "delete_errors_total"
+)
+
+const (
+	labelResourceType = "resource_type"
+)
+
+type instruments struct {
+	orphanedResources     *prometheus.GaugeVec
+	deletedResourcesTotal *prometheus.CounterVec
+	deleteErrorsTotal     *prometheus.CounterVec
+}
+
+// newInstruments allocates and register new metrics to registerer
+func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
+	orphanedResources := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemOrphanGC,
+		Name:      metricOrphanedResources,
+		Help:      "Number of orphaned AWS resources found during the last garbage collection run",
+	}, []string{labelResourceType})
+	deletedResourcesTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemOrphanGC,
+		Name:      metricDeletedResourcesTotal,
+		Help:      "Total number of orphaned AWS resources deleted by garbage collection",
+	}, []string{labelResourceType})
+	deleteErrorsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemOrphanGC,
+		Name:      metricDeleteErrorsTotal,
+		Help:      "Total number of failures to delete orphaned AWS resources",
+	}, []string{labelResourceType})
+
+	if err := registerer.Register(orphanedResources); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(deletedResourcesTotal); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(deleteErrorsTotal); err != nil {
+		return nil, err
+	}
+	return &instruments{
+		orphanedResources:     orphanedResources,
+		deletedResourcesTotal: deletedResourcesTotal,
+		deleteErrorsTotal:     deleteErrorsTotal,
+	}, nil
+}
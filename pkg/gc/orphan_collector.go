@@ -0,0 +1,516 @@
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	tagPrefixIngress = "ingress.k8s.aws"
+	tagPrefixService = "service.k8s.aws"
+	tagPrefixGateway = "gateway.k8s.aws"
+
+	// resourceTypeFilterSecurityGroup is the resourceType filter of EC2 SecurityGroups for ResourceGroupsTaggingAPI.
+	resourceTypeFilterSecurityGroup = "ec2:security-group"
+)
+
+// AWS resource types that are garbage collected, in the order they are deleted.
+const (
+	resourceTypeLoadBalancer  = "LoadBalancer"
+	resourceTypeTargetGroup   = "TargetGroup"
+	resourceTypeSecurityGroup = "SecurityGroup"
+)
+
+var resourceTypeDeletionOrder = map[string]int{
+	resourceTypeLoadBalancer:  0,
+	resourceTypeTargetGroup:   1,
+	resourceTypeSecurityGroup: 2,
+}
+
+// kinds of Kubernetes objects that own stacks.
+const (
+	ownerKindIngress      = "Ingress"
+	ownerKindIngressGroup = "IngressGroup"
+	ownerKindService      = "Service"
+	ownerKindGateway      = "Gateway"
+)
+
+// stackOwner identifies the Kubernetes object that AWS resources are provisioned for.
+type stackOwner struct {
+	kind    string
+	stackID core.StackID
+}
+
+func (o stackOwner) objectReference() *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		Kind:      o.kind,
+		Namespace: o.stackID.Namespace,
+		Name:      o.stackID.Name,
+	}
+}
+
+// taggedResource is an AWS resource provisioned by this controller along with it's tags.
+type taggedResource struct {
+	resourceType string
+	// ARN for LoadBalancer and TargetGroup, ID for SecurityGroup.
+	resourceID string
+	tags       map[string]string
+}
+
+// orphanedResource is an AWS resource whose owner no longer exists.
+type orphanedResource struct {
+	resourceType string
+	resourceID   string
+	owner        stackOwner
+}
+
+// OrphanCollector garbage collects AWS resources whose Ingress group, Service or Gateway no longer exists.
+// These resources leak when Kubernetes objects are force-deleted or their finalizers are removed.
+type OrphanCollector interface {
+	// Collect runs a single round of garbage collection.
+	Collect(ctx context.Context) error
+}
+
+// NewDefaultOrphanCollector constructs new defaultOrphanCollector.
+func NewDefaultOrphanCollector(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	metricsRegisterer prometheus.Registerer, config config.ControllerConfig, logger logr.Logger) (*defaultOrphanCollector, error) {
+	instruments, err := newInstruments(metricsRegisterer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize orphan GC metrics")
+	}
+	ingAnnotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	classLoader := ingress.NewDefaultClassLoader(k8sClient)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(config.IngressConfig.IngressClass)
+	manageIngressesWithoutIngressClass := config.IngressConfig.IngressClass == ""
+	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, ingAnnotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
+
+	return &defaultOrphanCollector{
+		k8sClient:           k8sClient,
+		elbv2Client:         cloud.ELBV2(),
+		ec2Client:           cloud.EC2(),
+		rgtClient:           cloud.RGT(),
+		elbv2TaggingManager: elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), logger),
+		groupLoader:         groupLoader,
+		ingAnnotationParser: ingAnnotationParser,
+		eventRecorder:       eventRecorder,
+		instruments:         instruments,
+		logger:              logger,
+
+		ingTrackingProvider: tracking.NewDefaultProvider(tagPrefixIngress, config.ClusterName),
+		svcTrackingProvider: tracking.NewDefaultProvider(tagPrefixService, config.ClusterName),
+		gwTrackingProvider:  tracking.NewDefaultProvider(tagPrefixGateway, config.ClusterName),
+		enableGatewayAPI:    config.GatewayConfig.EnableGatewayAPI,
+		interval:            config.GCConfig.Interval,
+		gracePeriod:         config.GCConfig.GracePeriod,
+		dryRun:              config.GCConfig.DryRun,
+
+		orphanedSince: make(map[string]time.Time),
+	}, nil
+}
+
+var _ OrphanCollector = &defaultOrphanCollector{}
+var _ manager.Runnable = &defaultOrphanCollector{}
+var _ manager.LeaderElectionRunnable = &defaultOrphanCollector{}
+
+// default implementation for OrphanCollector
+type defaultOrphanCollector struct {
+	k8sClient           client.Client
+	elbv2Client         services.ELBV2
+	ec2Client           services.EC2
+	rgtClient           services.RGT
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	groupLoader         ingress.GroupLoader
+	ingAnnotationParser annotations.Parser
+	eventRecorder       record.EventRecorder
+	instruments         *instruments
+	logger              logr.Logger
+
+	ingTrackingProvider tracking.Provider
+	svcTrackingProvider tracking.Provider
+	gwTrackingProvider  tracking.Provider
+	enableGatewayAPI    bool
+	interval            time.Duration
+	gracePeriod         time.Duration
+	dryRun              bool
+
+	// orphanedSince tracks the time AWS resources are first observed as orphaned.
+	orphanedSince map[string]time.Time
+}
+
+// Start runs garbage collection periodically until ctx is done.
+func (c *defaultOrphanCollector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.Collect(ctx); err != nil {
+			c.logger.Error(err, "failed to garbage collect orphaned resources")
+		}
+	}, c.interval)
+	return nil
+}
+
+// NeedLeaderElection ensures only the leader garbage collects orphaned resources.
+func (c *defaultOrphanCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (c *defaultOrphanCollector) Collect(ctx context.Context) error {
+	// AWS resources must be listed before owners are loaded,
+	// otherwise resources provisioned for owners created in between would be considered as orphaned.
+	resources, err := c.listTaggedResources(ctx)
+	if err != nil {
+		return err
+	}
+	liveOwners, err := c.loadLiveOwners(ctx)
+	if err != nil {
+		return err
+	}
+	orphanedResources := c.findOrphanedResources(resources, liveOwners)
+	c.reportOrphanedResources(orphanedResources)
+
+	now := time.Now()
+	orphanedResIDs := sets.NewString()
+	var expiredResources []orphanedResource
+	for _, res := range orphanedResources {
+		orphanedResIDs.Insert(res.resourceID)
+		orphanedSince, exists := c.orphanedSince[res.resourceID]
+		if !exists {
+			orphanedSince = now
+			c.orphanedSince[res.resourceID] = now
+		}
+		if now.Sub(orphanedSince) >= c.gracePeriod {
+			expiredResources = append(expiredResources, res)
+		}
+	}
+	// resources that are deleted or adopted again are no longer tracked.
+	for resID := range c.orphanedSince {
+		if !orphanedResIDs.Has(resID) {
+			delete(c.orphanedSince, resID)
+		}
+	}
+	if len(expiredResources) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(expiredResources, func(i, j int) bool {
+		return resourceTypeDeletionOrder[expiredResources[i].resourceType] < resourceTypeDeletionOrder[expiredResources[j].resourceType]
+	})
+	tgbsByTGARN, err := c.loadTargetGroupBindingsByTargetGroupARN(ctx)
+	if err != nil {
+		return err
+	}
+	for _, res := range expiredResources {
+		c.collectOrphanedResource(ctx, res, tgbsByTGARN[res.resourceID])
+	}
+	return nil
+}
+
+func (c *defaultOrphanCollector) collectOrphanedResource(ctx context.Context, res orphanedResource, tgbs []*elbv2api.TargetGroupBinding) {
+	ownerRef := res.owner.objectReference()
+	if c.dryRun {
+		c.logger.Info("found orphaned resource",
+			"resourceType", res.resourceType,
+			"resourceID", res.resourceID,
+			"owner", res.owner.kind,
+			"stackID", res.owner.stackID.String())
+		c.eventRecorder.Event(ownerRef, corev1.EventTypeWarning, k8s.OrphanGCEventReasonOrphanedResource,
+			fmt.Sprintf("Found orphaned %v %v", res.resourceType, res.resourceID))
+		return
+	}
+
+	if err := c.deleteOrphanedResource(ctx, res, tgbs); err != nil {
+		c.instruments.deleteErrorsTotal.WithLabelValues(res.resourceType).Inc()
+		c.logger.Error(err, "failed to delete orphaned resource",
+			"resourceType", res.resourceType,
+			"resourceID", res.resourceID)
+		c.eventRecorder.Event(ownerRef, corev1.EventTypeWarning, k8s.OrphanGCEventReasonFailedDeleteOrphanedResource,
+			fmt.Sprintf("Failed delete orphaned %v %v due to %v", res.resourceType, res.resourceID, err))
+		return
+	}
+	delete(c.orphanedSince, res.resourceID)
+	c.instruments.deletedResourcesTotal.WithLabelValues(res.resourceType).Inc()
+	c.eventRecorder.Event(ownerRef, corev1.EventTypeNormal, k8s.OrphanGCEventReasonDeletedOrphanedResource,
+		fmt.Sprintf("Deleted orphaned %v %v", res.resourceType, res.resourceID))
+}
+
+func (c *defaultOrphanCollector) deleteOrphanedResource(ctx context.Context, res orphanedResource, tgbs []*elbv2api.TargetGroupBinding) error {
+	c.logger.Info("deleting orphaned resource",
+		"resourceType", res.resourceType,
+		"resourceID", res.resourceID)
+	switch res.resourceType {
+	case resourceTypeLoadBalancer:
+		req := &elbv2sdk.DeleteLoadBalancerInput{
+			LoadBalancerArn: awssdk.String(res.resourceID),
+		}
+		if _, err := c.elbv2Client.DeleteLoadBalancerWithContext(ctx, req); err != nil {
+			return err
+		}
+	case resourceTypeTargetGroup:
+		// TargetGroupBindings must be deleted first so that targets are deregistered and networking rules are revoked.
+		if len(tgbs) != 0 {
+			tgbKeys := make([]string, 0, len(tgbs))
+			for _, tgb := range tgbs {
+				if err := c.k8sClient.Delete(ctx, tgb); client.IgnoreNotFound(err) != nil {
+					return err
+				}
+				tgbKeys = append(tgbKeys, k8s.NamespacedName(tgb).String())
+			}
+			return errors.Errorf("waiting for targetGroupBindings to be deleted: %v", tgbKeys)
+		}
+		req := &elbv2sdk.DeleteTargetGroupInput{
+			TargetGroupArn: awssdk.String(res.resourceID),
+		}
+		if _, err := c.elbv2Client.DeleteTargetGroupWithContext(ctx, req); err != nil {
+			return err
+		}
+	case resourceTypeSecurityGroup:
+		req := &ec2sdk.DeleteSecurityGroupInput{
+			GroupId: awssdk.String(res.resourceID),
+		}
+		if _, err := c.ec2Client.DeleteSecurityGroupWithContext(ctx, req); err != nil {
+			return err
+		}
+	}
+	c.logger.Info("deleted orphaned resource",
+		"resourceType", res.resourceType,
+		"resourceID", res.resourceID)
+	return nil
+}
+
+func (c *defaultOrphanCollector) reportOrphanedResources(orphanedResources []orphanedResource) {
+	countByResourceType := map[string]int{
+		resourceTypeLoadBalancer:  0,
+		resourceTypeTargetGroup:   0,
+		resourceTypeSecurityGroup: 0,
+	}
+	for _, res := range orphanedResources {
+		countByResourceType[res.resourceType]++
+	}
+	for resourceType, count := range countByResourceType {
+		c.instruments.orphanedResources.WithLabelValues(resourceType).Set(float64(count))
+	}
+}
+
+// findOrphanedResources finds resources whose owner doesn't exist.
+// resources with unknown owner are never considered as orphaned.
+func (c *defaultOrphanCollector) findOrphanedResources(resources []taggedResource, liveOwners map[stackOwner]struct{}) []orphanedResource {
+	var orphanedResources []orphanedResource
+	for _, res := range resources {
+		owner, ok := c.decodeStackOwner(res.tags)
+		if !ok {
+			continue
+		}
+		if _, exists := liveOwners[owner]; exists {
+			continue
+		}
+		orphanedResources = append(orphanedResources, orphanedResource{
+			resourceType: res.resourceType,
+			resourceID:   res.resourceID,
+			owner:        owner,
+		})
+	}
+	return orphanedResources
+}
+
+func (c *defaultOrphanCollector) decodeStackOwner(tags map[string]string) (stackOwner, bool) {
+	if stackID, ok := c.ingTrackingProvider.StackIDFromTags(tags); ok {
+		if stackID.Namespace == "" {
+			return stackOwner{kind: ownerKindIngressGroup, stackID: stackID}, true
+		}
+		return stackOwner{kind: ownerKindIngress, stackID: stackID}, true
+	}
+	if stackID, ok := c.svcTrackingProvider.StackIDFromTags(tags); ok {
+		return stackOwner{kind: ownerKindService, stackID: stackID}, true
+	}
+	// without Gateway API enabled, we cannot tell whether Gateways exist.
+	if c.enableGatewayAPI {
+		if stackID, ok := c.gwTrackingProvider.StackIDFromTags(tags); ok {
+			return stackOwner{kind: ownerKindGateway, stackID: stackID}, true
+		}
+	}
+	return stackOwner{}, false
+}
+
+// loadLiveOwners loads owners of stacks that still exist.
+// Ingresses are considered regardless of their IngressClass, so that stacks owned by other controllers within cluster are kept.
+func (c *defaultOrphanCollector) loadLiveOwners(ctx context.Context) (map[stackOwner]struct{}, error) {
+	liveOwners := make(map[stackOwner]struct{})
+	svcList := &corev1.ServiceList{}
+	if err := c.k8sClient.List(ctx, svcList); err != nil {
+		return nil, errors.Wrap(err, "failed to list services")
+	}
+	for _, svc := range svcList.Items {
+		liveOwners[stackOwner{kind: ownerKindService, stackID: core.StackID(k8s.NamespacedName(&svc))}] = struct{}{}
+	}
+
+	ingList := &networking.IngressList{}
+	if err := c.k8sClient.List(ctx, ingList); err != nil {
+		return nil, errors.Wrap(err, "failed to list ingresses")
+	}
+	for i := range ingList.Items {
+		ing := &ingList.Items[i]
+		liveOwners[stackOwner{kind: ownerKindIngress, stackID: core.StackID(k8s.NamespacedName(ing))}] = struct{}{}
+		for _, groupID := range c.groupLoader.LoadGroupIDsPendingFinalization(ctx, ing) {
+			liveOwners[buildIngressGroupOwner(groupID)] = struct{}{}
+		}
+		groupName := ""
+		if exists := c.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, ing.Annotations); exists {
+			liveOwners[buildIngressGroupOwner(ingress.NewGroupIDForExplicitGroup(groupName))] = struct{}{}
+		}
+	}
+	ingClassParamsList := &elbv2api.IngressClassParamsList{}
+	if err := c.k8sClient.List(ctx, ingClassParamsList); err != nil {
+		return nil, errors.Wrap(err, "failed to list ingressClassParams")
+	}
+	for _, ingClassParams := range ingClassParamsList.Items {
+		if ingClassParams.Spec.Group != nil {
+			liveOwners[buildIngressGroupOwner(ingress.NewGroupIDForExplicitGroup(ingClassParams.Spec.Group.Name))] = struct{}{}
+		}
+	}
+
+	if c.enableGatewayAPI {
+		gwList := &gwapi.GatewayList{}
+		if err := c.k8sClient.List(ctx, gwList); err != nil {
+			return nil, errors.Wrap(err, "failed to list gateways")
+		}
+		for _, gw := range gwList.Items {
+			liveOwners[stackOwner{kind: ownerKindGateway, stackID: core.StackID(k8s.NamespacedName(&gw))}] = struct{}{}
+		}
+	}
+	return liveOwners, nil
+}
+
+func buildIngressGroupOwner(groupID ingress.GroupID) stackOwner {
+	if groupID.IsExplicit() {
+		return stackOwner{kind: ownerKindIngressGroup, stackID: core.StackID(groupID)}
+	}
+	return stackOwner{kind: ownerKindIngress, stackID: core.StackID(groupID)}
+}
+
+func (c *defaultOrphanCollector) loadTargetGroupBindingsByTargetGroupARN(ctx context.Context) (map[string][]*elbv2api.TargetGroupBinding, error) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := c.k8sClient.List(ctx, tgbList); err != nil {
+		return nil, errors.Wrap(err, "failed to list targetGroupBindings")
+	}
+	tgbsByTGARN := make(map[string][]*elbv2api.TargetGroupBinding, len(tgbList.Items))
+	for i := range tgbList.Items {
+		tgb := &tgbList.Items[i]
+		tgbsByTGARN[tgb.Spec.TargetGroupARN] = append(tgbsByTGARN[tgb.Spec.TargetGroupARN], tgb)
+	}
+	return tgbsByTGARN, nil
+}
+
+// listTaggedResources lists AWS resources provisioned by this controller within cluster.
+func (c *defaultOrphanCollector) listTaggedResources(ctx context.Context) ([]taggedResource, error) {
+	clusterTagFilter := tracking.TagsAsTagFilter(c.ingTrackingProvider.ClusterTags())
+	var resources []taggedResource
+	sdkLBs, err := c.elbv2TaggingManager.ListLoadBalancers(ctx, clusterTagFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list loadBalancers")
+	}
+	for _, sdkLB := range sdkLBs {
+		resources = append(resources, taggedResource{
+			resourceType: resourceTypeLoadBalancer,
+			resourceID:   awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+			tags:         sdkLB.Tags,
+		})
+	}
+	sdkTGs, err := c.elbv2TaggingManager.ListTargetGroups(ctx, clusterTagFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list targetGroups")
+	}
+	for _, sdkTG := range sdkTGs {
+		resources = append(resources, taggedResource{
+			resourceType: resourceTypeTargetGroup,
+			resourceID:   awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn),
+			tags:         sdkTG.Tags,
+		})
+	}
+	sgResources, err := c.listTaggedSecurityGroups(ctx, clusterTagFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list securityGroups")
+	}
+	resources = append(resources, sgResources...)
+	return resources, nil
+}
+
+func (c *defaultOrphanCollector) listTaggedSecurityGroups(ctx context.Context, tagFilter tracking.TagFilter) ([]taggedResource, error) {
+	req := &rgtsdk.GetResourcesInput{
+		ResourceTypeFilters: awssdk.StringSlice([]string{resourceTypeFilterSecurityGroup}),
+	}
+	for _, tagKey := range sets.StringKeySet(tagFilter).List() {
+		req.TagFilters = append(req.TagFilters, &rgtsdk.TagFilter{
+			Key:    awssdk.String(tagKey),
+			Values: awssdk.StringSlice(tagFilter[tagKey]),
+		})
+	}
+
+	var resources []taggedResource
+	var parseErr error
+	if err := c.rgtClient.GetResourcesPagesWithContext(ctx, req, func(output *rgtsdk.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range output.ResourceTagMappingList {
+			sgID, err := parseSecurityGroupIDFromARN(awssdk.StringValue(mapping.ResourceARN))
+			if err != nil {
+				parseErr = err
+				return false
+			}
+			tags := make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+			}
+			resources = append(resources, taggedResource{
+				resourceType: resourceTypeSecurityGroup,
+				resourceID:   sgID,
+				tags:         tags,
+			})
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	return resources, nil
+}
+
+// parseSecurityGroupIDFromARN parses securityGroupID from ARN like arn:aws:ec2:region:account:security-group/sg-xxxx.
+func parseSecurityGroupIDFromARN(sgARN string) (string, error) {
+	parsedARN, err := arn.Parse(sgARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse securityGroup ARN: %v", sgARN)
+	}
+	parts := strings.SplitN(parsedARN.Resource, "/", 2)
+	if len(parts) != 2 || parts[0] != "security-group" {
+		return "", errors.Errorf("unexpected securityGroup ARN: %v", sgARN)
+	}
+	return parts[1], nil
+}
@@ -0,0 +1,371 @@
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultOrphanCollector_Collect(t *testing.T) {
+	type fields struct {
+		gracePeriod   time.Duration
+		dryRun        bool
+		orphanedSince map[string]time.Time
+	}
+	type env struct {
+		objs []runtime.Object
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		env            env
+		sdkLBs         []elbv2deploy.LoadBalancerWithTags
+		sdkTGs         []elbv2deploy.TargetGroupWithTags
+		sdkSGs         []*rgtsdk.ResourceTagMapping
+		wantDeletedLBs []string
+		wantDeletedTGs []string
+		wantDeletedSGs []string
+		wantEvents     []string
+		wantTGBs       []types.NamespacedName
+	}{
+		{
+			name: "resources of deleted service are collected",
+			fields: fields{
+				gracePeriod: 0,
+			},
+			env: env{
+				objs: []runtime.Object{
+					&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "live-svc"}},
+				},
+			},
+			sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "service.k8s.aws/stack": "ns-1/deleted-svc"},
+				},
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-2")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "service.k8s.aws/stack": "ns-1/live-svc"},
+				},
+			},
+			sdkTGs: []elbv2deploy.TargetGroupWithTags{
+				{
+					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-1")},
+					Tags:        map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "service.k8s.aws/stack": "ns-1/deleted-svc"},
+				},
+			},
+			sdkSGs: []*rgtsdk.ResourceTagMapping{
+				{
+					ResourceARN: awssdk.String("arn:aws:ec2:us-west-2:123456789012:security-group/sg-1"),
+					Tags: []*rgtsdk.Tag{
+						{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster-name")},
+						{Key: awssdk.String("service.k8s.aws/stack"), Value: awssdk.String("ns-1/deleted-svc")},
+					},
+				},
+				{
+					ResourceARN: awssdk.String("arn:aws:ec2:us-west-2:123456789012:security-group/sg-backend"),
+					Tags: []*rgtsdk.Tag{
+						{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster-name")},
+						{Key: awssdk.String("elbv2.k8s.aws/resource"), Value: awssdk.String("backend-sg")},
+					},
+				},
+			},
+			wantDeletedLBs: []string{"lb-1"},
+			wantDeletedTGs: []string{"tg-1"},
+			wantDeletedSGs: []string{"sg-1"},
+			wantEvents: []string{
+				"Normal DeletedOrphanedResource Deleted orphaned LoadBalancer lb-1",
+				"Normal DeletedOrphanedResource Deleted orphaned TargetGroup tg-1",
+				"Normal DeletedOrphanedResource Deleted orphaned SecurityGroup sg-1",
+			},
+		},
+		{
+			name: "resources within grace period are not collected",
+			fields: fields{
+				gracePeriod: time.Hour,
+			},
+			sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "service.k8s.aws/stack": "ns-1/deleted-svc"},
+				},
+			},
+		},
+		{
+			name: "resources beyond grace period are collected",
+			fields: fields{
+				gracePeriod: time.Hour,
+				orphanedSince: map[string]time.Time{
+					"lb-1": time.Now().Add(-2 * time.Hour),
+				},
+			},
+			sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "service.k8s.aws/stack": "ns-1/deleted-svc"},
+				},
+			},
+			wantDeletedLBs: []string{"lb-1"},
+			wantEvents: []string{
+				"Normal DeletedOrphanedResource Deleted orphaned LoadBalancer lb-1",
+			},
+		},
+		{
+			name: "orphaned resources are only reported in dry-run mode",
+			fields: fields{
+				gracePeriod: 0,
+				dryRun:      true,
+			},
+			sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "ingress.k8s.aws/stack": "ns-1/deleted-ing"},
+				},
+			},
+			wantEvents: []string{
+				"Warning OrphanedResource Found orphaned LoadBalancer lb-1",
+			},
+		},
+		{
+			name: "resources of live ingress groups are not collected",
+			fields: fields{
+				gracePeriod: 0,
+			},
+			env: env{
+				objs: []runtime.Object{
+					&networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/group.name": "annotated-group",
+						},
+					}},
+					&networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+						Namespace:  "ns-1",
+						Name:       "ing-2",
+						Finalizers: []string{"group.ingress.k8s.aws/finalizing-group"},
+					}},
+					&elbv2api.IngressClassParams{
+						ObjectMeta: metav1.ObjectMeta{Name: "params"},
+						Spec: elbv2api.IngressClassParamsSpec{
+							Group: &elbv2api.IngressGroup{Name: "params-group"},
+						},
+					},
+				},
+			},
+			sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "ingress.k8s.aws/stack": "ns-1/ing-1"},
+				},
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-2")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "ingress.k8s.aws/stack": "annotated-group"},
+				},
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-3")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "ingress.k8s.aws/stack": "finalizing-group"},
+				},
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-4")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "ingress.k8s.aws/stack": "params-group"},
+				},
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-5")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "ingress.k8s.aws/stack": "deleted-group"},
+				},
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-6")},
+					Tags:         map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "gateway.k8s.aws/stack": "ns-1/gw-1"},
+				},
+			},
+			wantDeletedLBs: []string{"lb-5"},
+			wantEvents: []string{
+				"Normal DeletedOrphanedResource Deleted orphaned LoadBalancer lb-5",
+			},
+		},
+		{
+			name: "targetGroupBindings are deleted before targetGroups",
+			fields: fields{
+				gracePeriod: 0,
+			},
+			env: env{
+				objs: []runtime.Object{
+					&elbv2api.TargetGroupBinding{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "tgb-1"},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupARN: "tg-1",
+						},
+					},
+					&elbv2api.TargetGroupBinding{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "tgb-2"},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupARN: "tg-2",
+						},
+					},
+				},
+			},
+			sdkTGs: []elbv2deploy.TargetGroupWithTags{
+				{
+					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-1")},
+					Tags:        map[string]string{"elbv2.k8s.aws/cluster": "cluster-name", "service.k8s.aws/stack": "ns-1/deleted-svc"},
+				},
+			},
+			wantEvents: []string{
+				"Warning FailedDeleteOrphanedResource Failed delete orphaned TargetGroup tg-1 due to waiting for targetGroupBindings to be deleted: [ns-1/tgb-1]",
+			},
+			wantTGBs: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "tgb-2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			ec2Client := services.NewMockEC2(ctrl)
+			rgtClient := services.NewMockRGT(ctrl)
+			elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+			clusterTagFilter := tracking.TagFilter{"elbv2.k8s.aws/cluster": []string{"cluster-name"}}
+			elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), clusterTagFilter).Return(tt.sdkLBs, nil)
+			elbv2TaggingManager.EXPECT().ListTargetGroups(gomock.Any(), clusterTagFilter).Return(tt.sdkTGs, nil)
+			rgtClient.EXPECT().GetResourcesPagesWithContext(gomock.Any(), &rgtsdk.GetResourcesInput{
+				ResourceTypeFilters: awssdk.StringSlice([]string{"ec2:security-group"}),
+				TagFilters: []*rgtsdk.TagFilter{
+					{Key: awssdk.String("elbv2.k8s.aws/cluster"), Values: awssdk.StringSlice([]string{"cluster-name"})},
+				},
+			}, gomock.Any()).DoAndReturn(func(_ context.Context, _ *rgtsdk.GetResourcesInput, fn func(*rgtsdk.GetResourcesOutput, bool) bool, _ ...request.Option) error {
+				fn(&rgtsdk.GetResourcesOutput{ResourceTagMappingList: tt.sdkSGs}, true)
+				return nil
+			})
+			for _, lbARN := range tt.wantDeletedLBs {
+				elbv2Client.EXPECT().DeleteLoadBalancerWithContext(gomock.Any(), &elbv2sdk.DeleteLoadBalancerInput{
+					LoadBalancerArn: awssdk.String(lbARN),
+				}).Return(&elbv2sdk.DeleteLoadBalancerOutput{}, nil)
+			}
+			for _, tgARN := range tt.wantDeletedTGs {
+				elbv2Client.EXPECT().DeleteTargetGroupWithContext(gomock.Any(), &elbv2sdk.DeleteTargetGroupInput{
+					TargetGroupArn: awssdk.String(tgARN),
+				}).Return(&elbv2sdk.DeleteTargetGroupOutput{}, nil)
+			}
+			for _, sgID := range tt.wantDeletedSGs {
+				ec2Client.EXPECT().DeleteSecurityGroupWithContext(gomock.Any(), &ec2sdk.DeleteSecurityGroupInput{
+					GroupId: awssdk.String(sgID),
+				}).Return(&ec2sdk.DeleteSecurityGroupOutput{}, nil)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema, tt.env.objs...)
+			eventRecorder := record.NewFakeRecorder(10)
+			instruments, err := newInstruments(prometheus.NewRegistry())
+			assert.NoError(t, err)
+			ingAnnotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+			orphanedSince := tt.fields.orphanedSince
+			if orphanedSince == nil {
+				orphanedSince = make(map[string]time.Time)
+			}
+			c := &defaultOrphanCollector{
+				k8sClient:           k8sClient,
+				elbv2Client:         elbv2Client,
+				ec2Client:           ec2Client,
+				rgtClient:           rgtClient,
+				elbv2TaggingManager: elbv2TaggingManager,
+				groupLoader:         ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, ingAnnotationParser, nil, nil, false),
+				ingAnnotationParser: ingAnnotationParser,
+				eventRecorder:       eventRecorder,
+				instruments:         instruments,
+				logger:              &log.NullLogger{},
+				ingTrackingProvider: tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+				svcTrackingProvider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+				gwTrackingProvider:  tracking.NewDefaultProvider("gateway.k8s.aws", "cluster-name"),
+				gracePeriod:         tt.fields.gracePeriod,
+				dryRun:              tt.fields.dryRun,
+				orphanedSince:       orphanedSince,
+			}
+			err = c.Collect(context.Background())
+			assert.NoError(t, err)
+
+			close(eventRecorder.Events)
+			var gotEvents []string
+			for event := range eventRecorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+
+			if tt.wantTGBs != nil {
+				tgbList := &elbv2api.TargetGroupBindingList{}
+				assert.NoError(t, k8sClient.List(context.Background(), tgbList))
+				var gotTGBs []types.NamespacedName
+				for _, tgb := range tgbList.Items {
+					gotTGBs = append(gotTGBs, types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Name})
+				}
+				assert.Equal(t, tt.wantTGBs, gotTGBs)
+			}
+		})
+	}
+}
+
+func Test_parseSecurityGroupIDFromARN(t *testing.T) {
+	tests := []struct {
+		name    string
+		sgARN   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "securityGroup ARN",
+			sgARN: "arn:aws:ec2:us-west-2:123456789012:security-group/sg-xxxx",
+			want:  "sg-xxxx",
+		},
+		{
+			name:    "non securityGroup ARN",
+			sgARN:   "arn:aws:ec2:us-west-2:123456789012:instance/i-xxxx",
+			wantErr: errors.New("unexpected securityGroup ARN: arn:aws:ec2:us-west-2:123456789012:instance/i-xxxx"),
+		},
+		{
+			name:    "invalid ARN",
+			sgARN:   "sg-xxxx",
+			wantErr: errors.New("failed to parse securityGroup ARN: sg-xxxx: arn: invalid prefix"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSecurityGroupIDFromARN(tt.sgARN)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -19,3 +19,16 @@ type ClassConfiguration struct {
 	// The IngressClassParams for Ingress if any.
 	IngClassParams *elbv2api.IngressClassParams
 }
+
+// annotationOverrideAllowed checks whether annotations on Ingress are allowed to override the specified field of IngressClassParams.
+func (c ClassConfiguration) annotationOverrideAllowed(field elbv2api.IngressClassParamsField) bool {
+	if c.IngClassParams == nil {
+		return true
+	}
+	for _, overridableField := range c.IngClassParams.Spec.AnnotationOverrides {
+		if overridableField == field {
+			return true
+		}
+	}
+	return false
+}
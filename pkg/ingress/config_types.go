@@ -414,3 +414,79 @@ type AuthIDPConfigOIDC struct {
 	// +optional
 	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
 }
+
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// Information about mutual authentication of a HTTPS listener.
+type MutualAuthenticationConfig struct {
+	// The listen port.
+	Port int64 `json:"port"`
+
+	// The client certificate handling mode.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// The Amazon Resource Name (ARN) of an existing trust store.
+	// +optional
+	TrustStore *string `json:"trustStore,omitempty"`
+
+	// The CA certificates bundle to create trust store from.
+	// +optional
+	CABundle *CABundleConfig `json:"caBundle,omitempty"`
+
+	// Whether expired client certificates are accepted.
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+func (c *MutualAuthenticationConfig) validate() error {
+	switch c.Mode {
+	case MutualAuthenticationModeOff, MutualAuthenticationModePassthrough:
+		if c.TrustStore != nil || c.CABundle != nil {
+			return errors.Errorf("trustStore and caBundle are only supported in %v mode", MutualAuthenticationModeVerify)
+		}
+		if c.IgnoreClientCertificateExpiry != nil {
+			return errors.Errorf("ignoreClientCertificateExpiry is only supported in %v mode", MutualAuthenticationModeVerify)
+		}
+	case MutualAuthenticationModeVerify:
+		if (c.TrustStore == nil) == (c.CABundle == nil) {
+			return errors.New("exactly one of trustStore or caBundle must be specified")
+		}
+		if c.CABundle != nil {
+			if err := c.CABundle.validate(); err != nil {
+				return errors.Wrap(err, "invalid caBundle")
+			}
+		}
+	default:
+		return errors.Errorf("mode must be within [%v, %v, %v]: %v", MutualAuthenticationModeOff,
+			MutualAuthenticationModePassthrough, MutualAuthenticationModeVerify, c.Mode)
+	}
+	return nil
+}
+
+// Information about the k8s object that holds PEM encoded CA certificates bundle.
+type CABundleConfig struct {
+	// The k8s secretName.
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+
+	// The k8s configMapName.
+	// +optional
+	ConfigMapName *string `json:"configMapName,omitempty"`
+
+	// The data key of CA certificates bundle, defaults to ca.crt.
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+func (c *CABundleConfig) validate() error {
+	if (c.SecretName == nil) == (c.ConfigMapName == nil) {
+		return errors.New("exactly one of secretName or configMapName must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// DeletionPolicyResolver resolves the deletion policy of LoadBalancer for IngressGroups that are pending finalization.
+type DeletionPolicyResolver interface {
+	// Resolve the deletion policy from Ingresses that no longer belong to the IngressGroup.
+	Resolve(ctx context.Context, ings []*networking.Ingress) (elbv2model.DeletionPolicy, error)
+}
+
+// NewDefaultDeletionPolicyResolver constructs new defaultDeletionPolicyResolver.
+func NewDefaultDeletionPolicyResolver(classLoader ClassLoader, annotationParser annotations.Parser, logger logr.Logger) *defaultDeletionPolicyResolver {
+	return &defaultDeletionPolicyResolver{
+		classLoader:      classLoader,
+		annotationParser: annotationParser,
+		logger:           logger,
+	}
+}
+
+var _ DeletionPolicyResolver = &defaultDeletionPolicyResolver{}
+
+// default implementation for DeletionPolicyResolver
+type defaultDeletionPolicyResolver struct {
+	classLoader      ClassLoader
+	annotationParser annotations.Parser
+	logger           logr.Logger
+}
+
+// Resolve the deletion policy from Ingresses that no longer belong to the IngressGroup.
+// Unlike active members, conflicting deletion policies are not treated as error here, the most conservative one is chosen instead.
+// (i.e. Retain over Protect over Delete)
+func (r *defaultDeletionPolicyResolver) Resolve(ctx context.Context, ings []*networking.Ingress) (elbv2model.DeletionPolicy, error) {
+	resolvedPolicy := elbv2model.DeletionPolicyDelete
+	for _, ing := range ings {
+		classifiedIng := ClassifiedIngress{Ing: ing}
+		if ing.Spec.IngressClassName != nil {
+			ingClassConfig, err := r.classLoader.Load(ctx, ing)
+			if err != nil {
+				// IngressClass might be deleted along with Ingresses, deletion policy on Ingress annotation is used in that case.
+				if !errors.Is(err, ErrInvalidIngressClass) {
+					return "", err
+				}
+				r.logger.V(1).Info("ignoring IngressClass for deletion policy", "ingress", k8s.NamespacedName(ing), "reason", err.Error())
+			}
+			classifiedIng.IngClassConfig = ingClassConfig
+		}
+		policy, exists, err := buildIngressDeletionPolicy(r.annotationParser, classifiedIng)
+		if err != nil {
+			return "", err
+		}
+		if exists && deletionPolicyPriority(policy) > deletionPolicyPriority(resolvedPolicy) {
+			resolvedPolicy = policy
+		}
+	}
+	return resolvedPolicy, nil
+}
+
+// buildLoadBalancerDeletionPolicy builds the deletion policy of LoadBalancer for the IngressGroup.
+func (t *defaultModelBuildTask) buildLoadBalancerDeletionPolicy(_ context.Context) (elbv2model.DeletionPolicy, error) {
+	explicitPolicies := sets.String{}
+	for _, member := range t.ingGroup.Members {
+		policy, exists, err := buildIngressDeletionPolicy(t.annotationParser, member)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			explicitPolicies.Insert(string(policy))
+		}
+	}
+	if len(explicitPolicies) == 0 {
+		return elbv2model.DeletionPolicyDelete, nil
+	}
+	if len(explicitPolicies) > 1 {
+		return "", errors.Errorf("conflicting deletion policy: %v", explicitPolicies.List())
+	}
+	rawPolicy, _ := explicitPolicies.PopAny()
+	return elbv2model.DeletionPolicy(rawPolicy), nil
+}
+
+// buildIngressDeletionPolicy builds the deletion policy for a single Ingress.
+// Note: the deletion policy specified via IngressClassParams takes higher priority than the one specified via annotation on Ingress,
+// unless deletionPolicy is listed in annotationOverrides of IngressClassParams.
+func buildIngressDeletionPolicy(annotationParser annotations.Parser, ing ClassifiedIngress) (elbv2model.DeletionPolicy, bool, error) {
+	var rawPolicy string
+	exists := annotationParser.ParseStringAnnotation(annotations.IngressSuffixDeletionPolicy, &rawPolicy, ing.Ing.Annotations)
+	if ingClassParams := ing.IngClassConfig.IngClassParams; ingClassParams != nil && ingClassParams.Spec.DeletionPolicy != nil &&
+		(!exists || !ing.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldDeletionPolicy)) {
+		rawPolicy = string(*ingClassParams.Spec.DeletionPolicy)
+		exists = true
+	}
+	if !exists {
+		return "", false, nil
+	}
+	switch rawPolicy {
+	case string(elbv2model.DeletionPolicyDelete):
+		return elbv2model.DeletionPolicyDelete, true, nil
+	case string(elbv2model.DeletionPolicyRetain):
+		return elbv2model.DeletionPolicyRetain, true, nil
+	case string(elbv2model.DeletionPolicyProtect):
+		return elbv2model.DeletionPolicyProtect, true, nil
+	default:
+		return "", false, errors.Errorf("unknown deletion policy: %v", rawPolicy)
+	}
+}
+
+// deletionPolicyPriority ranks deletion policies by how conservative they are.
+func deletionPolicyPriority(policy elbv2model.DeletionPolicy) int {
+	switch policy {
+	case elbv2model.DeletionPolicyRetain:
+		return 2
+	case elbv2model.DeletionPolicyProtect:
+		return 1
+	default:
+		return 0
+	}
+}
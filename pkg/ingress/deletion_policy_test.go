@@ -0,0 +1,234 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultDeletionPolicyResolver_Resolve(t *testing.T) {
+	retainPolicy := elbv2api.DeletionPolicyRetain
+	apiGroup := elbv2api.GroupVersion.Group
+	ingClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb"},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: &apiGroup,
+				Kind:     "IngressClassParams",
+				Name:     "params",
+			},
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "params"},
+		Spec: elbv2api.IngressClassParamsSpec{
+			DeletionPolicy: &retainPolicy,
+		},
+	}
+	ingClassParamsWithOverrides := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "params"},
+		Spec: elbv2api.IngressClassParamsSpec{
+			DeletionPolicy:      &retainPolicy,
+			AnnotationOverrides: []elbv2api.IngressClassParamsField{elbv2api.IngressClassParamsFieldDeletionPolicy},
+		},
+	}
+	buildIng := func(name string, ingClassName *string, policy string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: ingClassName,
+			},
+		}
+		if policy != "" {
+			ing.Annotations = map[string]string{"alb.ingress.kubernetes.io/deletion-policy": policy}
+		}
+		return ing
+	}
+	type env struct {
+		ingClassList       []*networking.IngressClass
+		ingClassParamsList []*elbv2api.IngressClassParams
+	}
+	tests := []struct {
+		name    string
+		env     env
+		ings    []*networking.Ingress
+		want    elbv2model.DeletionPolicy
+		wantErr error
+	}{
+		{
+			name: "no deletion policy specified",
+			ings: []*networking.Ingress{buildIng("ing-1", nil, "")},
+			want: elbv2model.DeletionPolicyDelete,
+		},
+		{
+			name: "deletion policy specified via annotation",
+			ings: []*networking.Ingress{buildIng("ing-1", nil, "Protect")},
+			want: elbv2model.DeletionPolicyProtect,
+		},
+		{
+			name: "most conservative deletion policy is chosen",
+			ings: []*networking.Ingress{
+				buildIng("ing-1", nil, "Protect"),
+				buildIng("ing-2", nil, "Retain"),
+				buildIng("ing-3", nil, "Delete"),
+			},
+			want: elbv2model.DeletionPolicyRetain,
+		},
+		{
+			name: "deletion policy specified via IngressClassParams takes priority",
+			env: env{
+				ingClassList:       []*networking.IngressClass{ingClass},
+				ingClassParamsList: []*elbv2api.IngressClassParams{ingClassParams},
+			},
+			ings: []*networking.Ingress{buildIng("ing-1", &ingClass.Name, "Delete")},
+			want: elbv2model.DeletionPolicyRetain,
+		},
+		{
+			name: "deletion policy specified via annotation overrides IngressClassParams when allowed",
+			env: env{
+				ingClassList:       []*networking.IngressClass{ingClass},
+				ingClassParamsList: []*elbv2api.IngressClassParams{ingClassParamsWithOverrides},
+			},
+			ings: []*networking.Ingress{buildIng("ing-1", &ingClass.Name, "Delete")},
+			want: elbv2model.DeletionPolicyDelete,
+		},
+		{
+			name: "deletion policy specified via IngressClassParams is the default when overrides allowed",
+			env: env{
+				ingClassList:       []*networking.IngressClass{ingClass},
+				ingClassParamsList: []*elbv2api.IngressClassParams{ingClassParamsWithOverrides},
+			},
+			ings: []*networking.Ingress{buildIng("ing-1", &ingClass.Name, "")},
+			want: elbv2model.DeletionPolicyRetain,
+		},
+		{
+			name: "deletion policy specified via annotation is used when IngressClass is deleted",
+			ings: []*networking.Ingress{buildIng("ing-1", &ingClass.Name, "Protect")},
+			want: elbv2model.DeletionPolicyProtect,
+		},
+		{
+			name:    "unknown deletion policy",
+			ings:    []*networking.Ingress{buildIng("ing-1", nil, "Keep")},
+			wantErr: errors.New("unknown deletion policy: Keep"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, ingClass := range tt.env.ingClassList {
+				assert.NoError(t, k8sClient.Create(context.Background(), ingClass.DeepCopy()))
+			}
+			for _, ingClassParams := range tt.env.ingClassParamsList {
+				assert.NoError(t, k8sClient.Create(context.Background(), ingClassParams.DeepCopy()))
+			}
+			r := NewDefaultDeletionPolicyResolver(NewDefaultClassLoader(k8sClient),
+				annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"), &log.NullLogger{})
+			got, err := r.Resolve(context.Background(), tt.ings)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerAttributes(t *testing.T) {
+	buildIng := func(name string, ingAnnotations map[string]string) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        name,
+					Annotations: ingAnnotations,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     []elbv2model.LoadBalancerAttribute
+		wantErr  error
+	}{
+		{
+			name: "deletion protection is enabled for Protect policy",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					buildIng("ing-1", map[string]string{"alb.ingress.kubernetes.io/deletion-policy": "Protect"}),
+					buildIng("ing-2", nil),
+				},
+			},
+			want: []elbv2model.LoadBalancerAttribute{
+				{Key: "deletion_protection.enabled", Value: "true"},
+			},
+		},
+		{
+			name: "explicit deletion protection is honored for Protect policy",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					buildIng("ing-1", map[string]string{
+						"alb.ingress.kubernetes.io/deletion-policy":          "Protect",
+						"alb.ingress.kubernetes.io/load-balancer-attributes": "deletion_protection.enabled=false",
+					}),
+				},
+			},
+			want: []elbv2model.LoadBalancerAttribute{
+				{Key: "deletion_protection.enabled", Value: "false"},
+			},
+		},
+		{
+			name: "deletion protection is untouched for Retain policy",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					buildIng("ing-1", map[string]string{"alb.ingress.kubernetes.io/deletion-policy": "Retain"}),
+				},
+			},
+			want: []elbv2model.LoadBalancerAttribute{},
+		},
+		{
+			name: "conflicting deletion policy",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					buildIng("ing-1", map[string]string{"alb.ingress.kubernetes.io/deletion-policy": "Protect"}),
+					buildIng("ing-2", map[string]string{"alb.ingress.kubernetes.io/deletion-policy": "Delete"}),
+				},
+			},
+			wantErr: errors.New("conflicting deletion policy: [Delete Protect]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup:         tt.ingGroup,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildLoadBalancerAttributes(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +33,7 @@ const (
 // EnhancedBackend is an enhanced version of Ingress backend.
 // It contains additional routing conditions and authentication configurations we parsed from annotations.
 // Also, when magic string `use-annotation` is specified as backend, the actions will be parsed from annotations as well.
+// When a ListenerRuleAction is referenced as resource backend, the conditions, actions and authentication configuration will be parsed from it instead.
 type EnhancedBackend struct {
 	Conditions []RuleCondition
 	Action     Action
@@ -48,6 +50,14 @@ type EnhancedBackendBuildOptions struct {
 
 	// whether to load auth configuration. when load authConfiguration, LoadBackendServices must be enabled as well.
 	LoadAuthConfig bool
+
+	// whether to load ListenerRuleAction referenced as resource backend.
+	// when disabled, the Action for resource backend will be left empty.
+	LoadListenerRuleAction bool
+
+	// whether to load TrafficShift that shifts the backend action.
+	// when disabled, the weights specified in actions annotation will be used as is.
+	LoadTrafficShift bool
 }
 
 type EnhancedBackendBuildOption func(opts *EnhancedBackendBuildOptions)
@@ -73,6 +83,20 @@ func WithLoadAuthConfig(loadAuthConfig bool) EnhancedBackendBuildOption {
 	}
 }
 
+// WithLoadListenerRuleAction is a option that sets the LoadListenerRuleAction.
+func WithLoadListenerRuleAction(loadListenerRuleAction bool) EnhancedBackendBuildOption {
+	return func(opts *EnhancedBackendBuildOptions) {
+		opts.LoadListenerRuleAction = loadListenerRuleAction
+	}
+}
+
+// WithLoadTrafficShift is a option that sets the LoadTrafficShift.
+func WithLoadTrafficShift(loadTrafficShift bool) EnhancedBackendBuildOption {
+	return func(opts *EnhancedBackendBuildOptions) {
+		opts.LoadTrafficShift = loadTrafficShift
+	}
+}
+
 // EnhancedBackendBuilder is capable of build EnhancedBackend for Ingress backend.
 type EnhancedBackendBuilder interface {
 	Build(ctx context.Context, ing *networking.Ingress, backend networking.IngressBackend, opts ...EnhancedBackendBuildOption) (EnhancedBackend, error)
@@ -108,25 +132,45 @@ type defaultEnhancedBackendBuilder struct {
 
 func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networking.Ingress, backend networking.IngressBackend, opts ...EnhancedBackendBuildOption) (EnhancedBackend, error) {
 	buildOpts := EnhancedBackendBuildOptions{
-		LoadBackendServices: true,
-		LoadAuthConfig:      true,
-		BackendServices:     map[types.NamespacedName]*corev1.Service{},
+		LoadBackendServices:    true,
+		LoadAuthConfig:         true,
+		LoadListenerRuleAction: true,
+		LoadTrafficShift:       true,
+		BackendServices:        map[types.NamespacedName]*corev1.Service{},
 	}
 	buildOpts.ApplyOptions(opts...)
 
-	conditions, err := b.buildConditions(ctx, ing.Annotations, backend.ServiceName)
-	if err != nil {
-		return EnhancedBackend{}, err
-	}
-
+	var conditions []RuleCondition
 	var action Action
-	if backend.ServicePort.String() == magicServicePortUseAnnotation {
-		action, err = b.buildActionViaAnnotation(ctx, ing.Annotations, backend.ServiceName)
+	var explicitAuthCfg *AuthConfig
+	var err error
+	if backend.Resource != nil {
+		if !buildOpts.LoadListenerRuleAction {
+			return EnhancedBackend{}, nil
+		}
+		conditions, action, explicitAuthCfg, err = b.buildViaListenerRuleAction(ctx, ing.Namespace, *backend.Resource)
 		if err != nil {
 			return EnhancedBackend{}, err
 		}
 	} else {
-		action = b.buildActionViaServiceAndServicePort(ctx, backend.ServiceName, backend.ServicePort)
+		conditions, err = b.buildConditions(ctx, ing.Annotations, backend.ServiceName)
+		if err != nil {
+			return EnhancedBackend{}, err
+		}
+
+		if backend.ServicePort.String() == magicServicePortUseAnnotation {
+			action, err = b.buildActionViaAnnotation(ctx, ing.Annotations, backend.ServiceName)
+			if err != nil {
+				return EnhancedBackend{}, err
+			}
+			if buildOpts.LoadTrafficShift {
+				if err := b.applyTrafficShift(ctx, ing, backend.ServiceName, &action); err != nil {
+					return EnhancedBackend{}, err
+				}
+			}
+		} else {
+			action = b.buildActionViaServiceAndServicePort(ctx, backend.ServiceName, backend.ServicePort)
+		}
 	}
 
 	var authCfg AuthConfig
@@ -136,9 +180,13 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 		}
 
 		if buildOpts.LoadAuthConfig {
-			authCfg, err = b.buildAuthConfig(ctx, action, ing.Namespace, ing.Annotations, buildOpts.BackendServices)
-			if err != nil {
-				return EnhancedBackend{}, err
+			if explicitAuthCfg != nil {
+				authCfg = *explicitAuthCfg
+			} else {
+				authCfg, err = b.buildAuthConfig(ctx, action, ing.Namespace, ing.Annotations, buildOpts.BackendServices)
+				if err != nil {
+					return EnhancedBackend{}, err
+				}
 			}
 		}
 	}
@@ -187,6 +235,55 @@ func (b *defaultEnhancedBackendBuilder) buildActionViaAnnotation(ctx context.Con
 	return action, nil
 }
 
+// applyTrafficShift will override the weights of backend action with the TrafficShift that shifts it, if any.
+func (b *defaultEnhancedBackendBuilder) applyTrafficShift(ctx context.Context, ing *networking.Ingress, actionName string, action *Action) error {
+	tsList := &elbv2api.TrafficShiftList{}
+	if err := b.k8sClient.List(ctx, tsList, client.InNamespace(ing.Namespace)); err != nil {
+		return errors.Wrap(err, "failed to list trafficShifts")
+	}
+	ts := findTrafficShift(tsList.Items, ing.Name, actionName)
+	if ts == nil {
+		return nil
+	}
+	return applyTrafficShiftWeights(action, ts)
+}
+
+// buildViaListenerRuleAction will build the backend conditions, Action and optional AuthConfig from the ListenerRuleAction referenced as resource backend.
+func (b *defaultEnhancedBackendBuilder) buildViaListenerRuleAction(ctx context.Context, namespace string, resourceRef corev1.TypedLocalObjectReference) ([]RuleCondition, Action, *AuthConfig, error) {
+	if !isListenerRuleActionRef(resourceRef) {
+		return nil, Action{}, nil, errors.Errorf("unsupported resource backend: %v, only %v/%v is supported",
+			resourceRef.Kind, elbv2api.GroupVersion.Group, listenerRuleActionKind)
+	}
+
+	ruleAction := &elbv2api.ListenerRuleAction{}
+	ruleActionKey := types.NamespacedName{Namespace: namespace, Name: resourceRef.Name}
+	if err := b.k8sClient.Get(ctx, ruleActionKey, ruleAction); err != nil {
+		if apierrors.IsNotFound(err) && b.tolerateNonExistentBackendAction {
+			return nil, b.build503ResponseAction(nonExistentBackendActionMessageBody), nil, nil
+		}
+		return nil, Action{}, nil, err
+	}
+
+	conditions := buildConditionsFromListenerRuleAction(ruleAction.Spec.Conditions)
+	for _, condition := range conditions {
+		if err := condition.validate(); err != nil {
+			return nil, Action{}, nil, errors.Wrapf(err, "invalid listenerRuleAction: %v", ruleActionKey)
+		}
+	}
+	action := buildActionFromListenerRuleAction(ruleAction.Spec.Action)
+	if err := action.validate(); err != nil {
+		return nil, Action{}, nil, errors.Wrapf(err, "invalid listenerRuleAction: %v", ruleActionKey)
+	}
+	b.normalizeSimplifiedSchemaForwardAction(ctx, &action)
+
+	var authCfg *AuthConfig
+	if ruleAction.Spec.Authentication != nil {
+		cfg := buildAuthConfigFromListenerRuleAction(*ruleAction.Spec.Authentication)
+		authCfg = &cfg
+	}
+	return conditions, action, authCfg, nil
+}
+
 // buildActionViaServiceAndServicePort will build the backend Action that forward to specified Kubernetes Service.
 func (b *defaultEnhancedBackendBuilder) buildActionViaServiceAndServicePort(_ context.Context, svcName string, svcPort intstr.IntOrString) Action {
 	action := Action{
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -21,7 +22,9 @@ import (
 
 func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 	type env struct {
-		svcs []*corev1.Service
+		svcs          []*corev1.Service
+		ruleActions   []*elbv2api.ListenerRuleAction
+		trafficShifts []*elbv2api.TrafficShift
 	}
 	type fields struct {
 		tolerateNonExistentBackendService bool
@@ -31,9 +34,10 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 		ing     *networking.Ingress
 		backend networking.IngressBackend
 
-		loadBackendServices bool
-		loadAuthConfig      bool
-		backendServices     map[types.NamespacedName]*corev1.Service
+		loadBackendServices       bool
+		loadAuthConfig            bool
+		disableListenerRuleAction bool
+		backendServices           map[types.NamespacedName]*corev1.Service
 	}
 
 	svc1 := &corev1.Service{
@@ -42,7 +46,61 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 			Name:      "svc-1",
 		},
 	}
+	svc2 := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-2",
+		},
+	}
 	portHTTP := intstr.FromString("http")
+	ruleActionForward := &elbv2api.ListenerRuleAction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "forward-svc-1",
+		},
+		Spec: elbv2api.ListenerRuleActionSpec{
+			Action: elbv2api.RuleAction{
+				Type: elbv2api.RuleActionTypeForward,
+				ForwardConfig: &elbv2api.ForwardActionConfig{
+					TargetGroups: []elbv2api.TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("svc-1"),
+							ServicePort: &portHTTP,
+							Weight:      awssdk.Int64(100),
+						},
+					},
+				},
+			},
+			Conditions: []elbv2api.RuleCondition{
+				{
+					Field: elbv2api.RuleConditionFieldSourceIP,
+					SourceIPConfig: &elbv2api.SourceIPConditionConfig{
+						Values: []string{"192.168.0.0/16"},
+					},
+				},
+			},
+			Authentication: &elbv2api.AuthenticationConfig{
+				Type: elbv2api.AuthenticationTypeCognito,
+				IDPConfigCognito: &elbv2api.AuthIDPConfigCognito{
+					UserPoolARN:      "arn:aws:cognito-idp:us-west-2:xxx:userpool/xxx",
+					UserPoolClientID: "my-clientID",
+					UserPoolDomain:   "my-domain",
+				},
+				Scope: awssdk.String("email"),
+			},
+		},
+	}
+	ruleActionInvalid := &elbv2api.ListenerRuleAction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "invalid-action",
+		},
+		Spec: elbv2api.ListenerRuleActionSpec{
+			Action: elbv2api.RuleAction{
+				Type: elbv2api.RuleActionTypeRedirect,
+			},
+		},
+	}
 	tests := []struct {
 		name                string
 		env                 env
@@ -373,6 +431,83 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}: svc1,
 			},
 		},
+		{
+			name: "annotation-based serviceBackend shifted by trafficShift",
+			env: env{
+				svcs: []*corev1.Service{svc1, svc2},
+				trafficShifts: []*elbv2api.TrafficShift{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "shift-svc-2",
+						},
+						Spec: elbv2api.TrafficShiftSpec{
+							IngressName:   "awesome-ing",
+							ActionName:    "fake-my-svc",
+							StableService: "svc-1",
+							CanaryService: "svc-2",
+							Steps:         []elbv2api.TrafficShiftStep{{Weight: 20}},
+						},
+						Status: elbv2api.TrafficShiftStatus{
+							Phase:        elbv2api.TrafficShiftPhaseProgressing,
+							CanaryWeight: awssdk.Int32(20),
+						},
+					},
+				},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "awesome-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/actions.fake-my-svc": `{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"svc-1","servicePort":"http","weight":100},{"serviceName":"svc-2","servicePort":"http","weight":0}]}}`,
+						},
+					},
+				},
+				backend: networking.IngressBackend{
+					ServiceName: "fake-my-svc",
+					ServicePort: intstr.FromString("use-annotation"),
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("svc-1"),
+								ServicePort: &portHTTP,
+								Weight:      awssdk.Int64(80),
+							},
+							{
+								ServiceName: awssdk.String("svc-2"),
+								ServicePort: &portHTTP,
+								Weight:      awssdk.Int64(20),
+							},
+						},
+					},
+				},
+				AuthConfig: AuthConfig{
+					Type:                     AuthTypeNone,
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}: svc1,
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-2"}: svc2,
+			},
+		},
 		{
 			name: "annotation-based with additional conditions",
 			env: env{
@@ -559,16 +694,224 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 			},
 			wantErr: errors.New("missing actions.fake-my-svc configuration"),
 		},
+		{
+			name: "resource backend - listenerRuleAction with conditions and authentication",
+			env: env{
+				svcs:        []*corev1.Service{svc1},
+				ruleActions: []*elbv2api.ListenerRuleAction{ruleActionForward},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: awssdk.String("elbv2.k8s.aws"),
+						Kind:     "ListenerRuleAction",
+						Name:     "forward-svc-1",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Conditions: []RuleCondition{
+					{
+						Field: RuleConditionFieldSourceIP,
+						SourceIPConfig: &SourceIPConditionConfig{
+							Values: []string{"192.168.0.0/16"},
+						},
+					},
+				},
+				Action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("svc-1"),
+								ServicePort: &portHTTP,
+								Weight:      awssdk.Int64(100),
+							},
+						},
+					},
+				},
+				AuthConfig: AuthConfig{
+					Type: AuthTypeCognito,
+					IDPConfigCognito: &AuthIDPConfigCognito{
+						UserPoolARN:      "arn:aws:cognito-idp:us-west-2:xxx:userpool/xxx",
+						UserPoolClientID: "my-clientID",
+						UserPoolDomain:   "my-domain",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}: svc1,
+			},
+		},
+		{
+			name: "resource backend - listenerRuleAction when loadListenerRuleAction==false",
+			env: env{
+				svcs:        []*corev1.Service{svc1},
+				ruleActions: []*elbv2api.ListenerRuleAction{ruleActionForward},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: awssdk.String("elbv2.k8s.aws"),
+						Kind:     "ListenerRuleAction",
+						Name:     "forward-svc-1",
+					},
+				},
+				loadBackendServices:       false,
+				loadAuthConfig:            false,
+				disableListenerRuleAction: true,
+			},
+			want: EnhancedBackend{},
+		},
+		{
+			name: "resource backend - non-existent listenerRuleAction and tolerateNonExistentBackendAction==true",
+			env: env{
+				svcs: []*corev1.Service{svc1},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: awssdk.String("elbv2.k8s.aws"),
+						Kind:     "ListenerRuleAction",
+						Name:     "forward-svc-1",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Action: Action{
+					Type: ActionTypeFixedResponse,
+					FixedResponseConfig: &FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						StatusCode:  "503",
+						MessageBody: awssdk.String(nonExistentBackendActionMessageBody),
+					},
+				},
+				AuthConfig: AuthConfig{
+					Type:                     AuthTypeNone,
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{},
+		},
+		{
+			name: "resource backend - invalid listenerRuleAction",
+			env: env{
+				svcs:        []*corev1.Service{svc1},
+				ruleActions: []*elbv2api.ListenerRuleAction{ruleActionInvalid},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: awssdk.String("elbv2.k8s.aws"),
+						Kind:     "ListenerRuleAction",
+						Name:     "invalid-action",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			wantErr: errors.New("invalid listenerRuleAction: awesome-ns/invalid-action: missing RedirectConfig"),
+		},
+		{
+			name: "resource backend - unsupported resource kind",
+			env: env{
+				svcs: []*corev1.Service{svc1},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: awssdk.String("k8s.example.com"),
+						Kind:     "StorageBucket",
+						Name:     "static-assets",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			wantErr: errors.New("unsupported resource backend: StorageBucket, only elbv2.k8s.aws/ListenerRuleAction is supported"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
 			k8sSchema := runtime.NewScheme()
 			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
 			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
 			for _, svc := range tt.env.svcs {
 				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
 			}
+			for _, ruleAction := range tt.env.ruleActions {
+				assert.NoError(t, k8sClient.Create(ctx, ruleAction.DeepCopy()))
+			}
+			for _, ts := range tt.env.trafficShifts {
+				assert.NoError(t, k8sClient.Create(ctx, ts.DeepCopy()))
+			}
 
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
@@ -582,7 +925,8 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 
 			got, err := b.Build(context.Background(), tt.args.ing, tt.args.backend,
 				WithLoadBackendServices(tt.args.loadBackendServices, tt.args.backendServices),
-				WithLoadAuthConfig(tt.args.loadAuthConfig))
+				WithLoadAuthConfig(tt.args.loadAuthConfig),
+				WithLoadListenerRuleAction(!tt.args.disableListenerRuleAction))
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -34,6 +34,8 @@ var (
 // GroupLoader loads Ingress groups.
 type GroupLoader interface {
 	// Load returns an Ingress group given groupID.
+	// If group members are loaded but can't be ordered, the returned Ingress group contains unordered members along with the error,
+	// so that the failure can be reported on them.
 	Load(ctx context.Context, groupID GroupID) (Group, error)
 
 	// LoadGroupIDIfAny loads the groupID for Ingress if Ingress belong to any IngressGroup.
@@ -100,7 +102,11 @@ func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group,
 
 	sortedMembers, err := m.sortGroupMembers(members)
 	if err != nil {
-		return Group{}, err
+		return Group{
+			ID:              groupID,
+			Members:         members,
+			InactiveMembers: inactiveMembers,
+		}, err
 	}
 
 	return Group{
@@ -0,0 +1,160 @@
+package ingress
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+const (
+	// the kind of ListenerRuleAction resource, which can be referenced as Ingress resource backend.
+	listenerRuleActionKind = "ListenerRuleAction"
+)
+
+// isListenerRuleActionRef checks whether the resource backend is a reference to ListenerRuleAction.
+func isListenerRuleActionRef(resourceRef corev1.TypedLocalObjectReference) bool {
+	return resourceRef.APIGroup != nil &&
+		(*resourceRef.APIGroup) == elbv2api.GroupVersion.Group &&
+		resourceRef.Kind == listenerRuleActionKind
+}
+
+// buildActionFromListenerRuleAction converts the action in ListenerRuleAction into Action.
+func buildActionFromListenerRuleAction(ruleAction elbv2api.RuleAction) Action {
+	action := Action{
+		Type:           ActionType(ruleAction.Type),
+		TargetGroupARN: ruleAction.TargetGroupARN,
+	}
+	if ruleAction.FixedResponseConfig != nil {
+		action.FixedResponseConfig = &FixedResponseActionConfig{
+			ContentType: ruleAction.FixedResponseConfig.ContentType,
+			MessageBody: ruleAction.FixedResponseConfig.MessageBody,
+			StatusCode:  ruleAction.FixedResponseConfig.StatusCode,
+		}
+	}
+	if ruleAction.RedirectConfig != nil {
+		action.RedirectConfig = &RedirectActionConfig{
+			Host:       ruleAction.RedirectConfig.Host,
+			Path:       ruleAction.RedirectConfig.Path,
+			Port:       ruleAction.RedirectConfig.Port,
+			Protocol:   ruleAction.RedirectConfig.Protocol,
+			Query:      ruleAction.RedirectConfig.Query,
+			StatusCode: ruleAction.RedirectConfig.StatusCode,
+		}
+	}
+	if ruleAction.ForwardConfig != nil {
+		targetGroups := make([]TargetGroupTuple, 0, len(ruleAction.ForwardConfig.TargetGroups))
+		for _, tgt := range ruleAction.ForwardConfig.TargetGroups {
+			targetGroups = append(targetGroups, TargetGroupTuple{
+				TargetGroupARN: tgt.TargetGroupARN,
+				ServiceName:    tgt.ServiceName,
+				ServicePort:    tgt.ServicePort,
+				Weight:         tgt.Weight,
+			})
+		}
+		action.ForwardConfig = &ForwardActionConfig{
+			TargetGroups: targetGroups,
+		}
+		if ruleAction.ForwardConfig.TargetGroupStickinessConfig != nil {
+			action.ForwardConfig.TargetGroupStickinessConfig = &TargetGroupStickinessConfig{
+				Enabled:         ruleAction.ForwardConfig.TargetGroupStickinessConfig.Enabled,
+				DurationSeconds: ruleAction.ForwardConfig.TargetGroupStickinessConfig.DurationSeconds,
+			}
+		}
+	}
+	return action
+}
+
+// buildConditionsFromListenerRuleAction converts the conditions in ListenerRuleAction into RuleConditions.
+func buildConditionsFromListenerRuleAction(ruleConditions []elbv2api.RuleCondition) []RuleCondition {
+	if len(ruleConditions) == 0 {
+		return nil
+	}
+	conditions := make([]RuleCondition, 0, len(ruleConditions))
+	for _, ruleCondition := range ruleConditions {
+		condition := RuleCondition{
+			Field: RuleConditionField(ruleCondition.Field),
+		}
+		if ruleCondition.HostHeaderConfig != nil {
+			condition.HostHeaderConfig = &HostHeaderConditionConfig{
+				Values: ruleCondition.HostHeaderConfig.Values,
+			}
+		}
+		if ruleCondition.HTTPHeaderConfig != nil {
+			condition.HTTPHeaderConfig = &HTTPHeaderConditionConfig{
+				HTTPHeaderName: ruleCondition.HTTPHeaderConfig.HTTPHeaderName,
+				Values:         ruleCondition.HTTPHeaderConfig.Values,
+			}
+		}
+		if ruleCondition.HTTPRequestMethodConfig != nil {
+			condition.HTTPRequestMethodConfig = &HTTPRequestMethodConditionConfig{
+				Values: ruleCondition.HTTPRequestMethodConfig.Values,
+			}
+		}
+		if ruleCondition.PathPatternConfig != nil {
+			condition.PathPatternConfig = &PathPatternConditionConfig{
+				Values: ruleCondition.PathPatternConfig.Values,
+			}
+		}
+		if ruleCondition.QueryStringConfig != nil {
+			pairs := make([]QueryStringKeyValuePair, 0, len(ruleCondition.QueryStringConfig.Values))
+			for _, pair := range ruleCondition.QueryStringConfig.Values {
+				pairs = append(pairs, QueryStringKeyValuePair{
+					Key:   pair.Key,
+					Value: pair.Value,
+				})
+			}
+			condition.QueryStringConfig = &QueryStringConditionConfig{
+				Values: pairs,
+			}
+		}
+		if ruleCondition.SourceIPConfig != nil {
+			condition.SourceIPConfig = &SourceIPConditionConfig{
+				Values: ruleCondition.SourceIPConfig.Values,
+			}
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// buildAuthConfigFromListenerRuleAction converts the authentication in ListenerRuleAction into AuthConfig.
+// unspecified settings default to the same values as auth annotations.
+func buildAuthConfigFromListenerRuleAction(authentication elbv2api.AuthenticationConfig) AuthConfig {
+	authCfg := AuthConfig{
+		Type:                     AuthType(authentication.Type),
+		OnUnauthenticatedRequest: defaultAuthOnUnauthenticatedRequest,
+		Scope:                    defaultAuthScope,
+		SessionCookieName:        defaultAuthSessionCookieName,
+		SessionTimeout:           defaultAuthSessionTimeout,
+	}
+	if authentication.IDPConfigCognito != nil {
+		authCfg.IDPConfigCognito = &AuthIDPConfigCognito{
+			UserPoolARN:                      authentication.IDPConfigCognito.UserPoolARN,
+			UserPoolClientID:                 authentication.IDPConfigCognito.UserPoolClientID,
+			UserPoolDomain:                   authentication.IDPConfigCognito.UserPoolDomain,
+			AuthenticationRequestExtraParams: authentication.IDPConfigCognito.AuthenticationRequestExtraParams,
+		}
+	}
+	if authentication.IDPConfigOIDC != nil {
+		authCfg.IDPConfigOIDC = &AuthIDPConfigOIDC{
+			Issuer:                           authentication.IDPConfigOIDC.Issuer,
+			AuthorizationEndpoint:            authentication.IDPConfigOIDC.AuthorizationEndpoint,
+			TokenEndpoint:                    authentication.IDPConfigOIDC.TokenEndpoint,
+			UserInfoEndpoint:                 authentication.IDPConfigOIDC.UserInfoEndpoint,
+			SecretName:                       authentication.IDPConfigOIDC.SecretName,
+			AuthenticationRequestExtraParams: authentication.IDPConfigOIDC.AuthenticationRequestExtraParams,
+		}
+	}
+	if authentication.OnUnauthenticatedRequest != nil {
+		authCfg.OnUnauthenticatedRequest = *authentication.OnUnauthenticatedRequest
+	}
+	if authentication.Scope != nil {
+		authCfg.Scope = *authentication.Scope
+	}
+	if authentication.SessionCookieName != nil {
+		authCfg.SessionCookieName = *authentication.SessionCookieName
+	}
+	if authentication.SessionTimeout != nil {
+		authCfg.SessionTimeout = *authentication.SessionTimeout
+	}
+	return authCfg
+}
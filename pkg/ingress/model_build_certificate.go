@@ -0,0 +1,83 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+)
+
+// buildImportedCertificate builds the ACM Certificate imported from TLS secret.
+// Certificates are shared across listeners and Ingresses within IngressGroup that references the same secret.
+func (t *defaultModelBuildTask) buildImportedCertificate(ctx context.Context, secretKey types.NamespacedName) (*acmmodel.Certificate, error) {
+	certResID := secretKey.String()
+	if cert, exists := t.certByResID[certResID]; exists {
+		return cert, nil
+	}
+	certSpec, err := t.buildImportedCertificateSpec(ctx, secretKey)
+	if err != nil {
+		return nil, err
+	}
+	cert := acmmodel.NewCertificate(t.stack, certResID, certSpec)
+	t.certByResID[certResID] = cert
+	return cert, nil
+}
+
+func (t *defaultModelBuildTask) buildImportedCertificateSpec(ctx context.Context, secretKey types.NamespacedName) (acmmodel.CertificateSpec, error) {
+	secret := &corev1.Secret{}
+	if err := t.k8sClient.Get(ctx, secretKey, secret); err != nil {
+		return acmmodel.CertificateSpec{}, errors.Wrapf(err, "failed to load TLS secret: %v", secretKey)
+	}
+	tlsCert, err := k8s.ExtractTLSCertificate(secret)
+	if err != nil {
+		return acmmodel.CertificateSpec{}, err
+	}
+	tags, err := t.buildImportedCertificateTags(ctx)
+	if err != nil {
+		return acmmodel.CertificateSpec{}, err
+	}
+	return acmmodel.CertificateSpec{
+		Certificate:      tlsCert.Certificate,
+		CertificateChain: tlsCert.CertificateChain,
+		PrivateKey:       tlsCert.PrivateKey,
+		Checksum:         tlsCert.Checksum(),
+		Tags:             tags,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildImportedCertificateTags(_ context.Context) (map[string]string, error) {
+	ingGroupTags, err := t.buildIngressGroupResourceTags(t.ingGroup.Members)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, ingGroupTags), nil
+}
+
+// computeIngressImportedTLSSecrets computes the TLS secrets to import into ACM for Ingress.
+// returns nil if certificate import is disabled.
+func (t *defaultModelBuildTask) computeIngressImportedTLSSecrets(_ context.Context, ing *networking.Ingress) []types.NamespacedName {
+	if !t.enableCertImport {
+		return nil
+	}
+	return extractIngressTLSSecretKeys(ing)
+}
+
+// extractIngressTLSSecretKeys returns the TLS secrets referenced by Ingress, in the order they're specified.
+func extractIngressTLSSecretKeys(ing *networking.Ingress) []types.NamespacedName {
+	secretNames := sets.NewString()
+	var secretKeys []types.NamespacedName
+	for _, tls := range ing.Spec.TLS {
+		if len(tls.SecretName) == 0 || secretNames.Has(tls.SecretName) {
+			continue
+		}
+		secretNames.Insert(tls.SecretName)
+		secretKeys = append(secretKeys, types.NamespacedName{Namespace: ing.Namespace, Name: tls.SecretName})
+	}
+	return secretKeys
+}
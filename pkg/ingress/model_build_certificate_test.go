@@ -0,0 +1,75 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_extractIngressTLSSecretKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		tls  []networking.IngressTLS
+		want []types.NamespacedName
+	}{
+		{
+			name: "no TLS",
+			tls:  nil,
+			want: nil,
+		},
+		{
+			name: "multiple TLS secrets",
+			tls: []networking.IngressTLS{
+				{
+					Hosts:      []string{"app-1.example.com"},
+					SecretName: "app-1-tls",
+				},
+				{
+					Hosts:      []string{"app-2.example.com"},
+					SecretName: "app-2-tls",
+				},
+			},
+			want: []types.NamespacedName{
+				{Namespace: "awesome-ns", Name: "app-1-tls"},
+				{Namespace: "awesome-ns", Name: "app-2-tls"},
+			},
+		},
+		{
+			name: "duplicated and empty TLS secrets",
+			tls: []networking.IngressTLS{
+				{
+					Hosts:      []string{"app-1.example.com"},
+					SecretName: "app-tls",
+				},
+				{
+					Hosts: []string{"app-2.example.com"},
+				},
+				{
+					Hosts:      []string{"app-3.example.com"},
+					SecretName: "app-tls",
+				},
+			},
+			want: []types.NamespacedName{
+				{Namespace: "awesome-ns", Name: "app-tls"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ing := &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "ing",
+				},
+				Spec: networking.IngressSpec{
+					TLS: tt.tls,
+				},
+			}
+			got := extractIngressTLSSecretKeys(ing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
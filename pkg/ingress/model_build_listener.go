@@ -7,11 +7,11 @@ import (
 	"net"
 	"strings"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	networking "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -38,13 +38,22 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN cor
 	if err != nil {
 		return elbv2model.ListenerSpec{}, err
 	}
-	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts))
+	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts)+len(config.tlsCertSecrets))
 	for _, certARN := range config.tlsCerts {
 		certs = append(certs, elbv2model.Certificate{
-			CertificateARN: awssdk.String(certARN),
+			CertificateARN: core.LiteralStringToken(certARN),
 		})
 	}
-	return elbv2model.ListenerSpec{
+	for _, secretKey := range config.tlsCertSecrets {
+		cert, err := t.buildImportedCertificate(ctx, secretKey)
+		if err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+		certs = append(certs, elbv2model.Certificate{
+			CertificateARN: cert.CertificateARN(),
+		})
+	}
+	lsSpec := elbv2model.ListenerSpec{
 		LoadBalancerARN: lbARN,
 		Port:            port,
 		Protocol:        config.protocol,
@@ -52,7 +61,15 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN cor
 		Certificates:    certs,
 		SSLPolicy:       config.sslPolicy,
 		Tags:            tags,
-	}, nil
+	}
+	if config.mutualAuth != nil {
+		mutualAuth, err := t.buildListenerMutualAuthentication(ctx, *config.mutualAuth)
+		if err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+		lsSpec.MutualAuthentication = mutualAuth
+	}
+	return lsSpec, nil
 }
 
 func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context, protocol elbv2model.Protocol, ingList []ClassifiedIngress) ([]elbv2model.Action, error) {
@@ -102,20 +119,43 @@ type listenPortConfig struct {
 	inboundCIDRv6s []string
 	sslPolicy      *string
 	tlsCerts       []string
+	tlsCertSecrets []types.NamespacedName
+	mutualAuth     *mutualAuthConfig
+}
+
+// the mutual authentication config for specific listener port.
+type mutualAuthConfig struct {
+	mode                          elbv2model.MutualAuthenticationMode
+	trustStoreARN                 *string
+	caBundle                      *caBundleRef
+	ignoreClientCertificateExpiry *bool
 }
 
-func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, ing *networking.Ingress) (map[int64]listenPortConfig, error) {
+func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, ing ClassifiedIngress) (map[int64]listenPortConfig, error) {
 	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing)
 	explicitSSLPolicy := t.computeIngressExplicitSSLPolicy(ctx, ing)
 	inboundCIDRv4s, inboundCIDRV6s, err := t.computeIngressExplicitInboundCIDRs(ctx, ing)
 	if err != nil {
 		return nil, err
 	}
-	preferTLS := len(explicitTLSCertARNs) != 0
-	listenPorts, err := t.computeIngressListenPorts(ctx, ing, preferTLS)
+	var importedTLSSecrets []types.NamespacedName
+	if len(explicitTLSCertARNs) == 0 {
+		importedTLSSecrets = t.computeIngressImportedTLSSecrets(ctx, ing.Ing)
+	}
+	preferTLS := len(explicitTLSCertARNs) != 0 || len(importedTLSSecrets) != 0
+	listenPorts, err := t.computeIngressListenPorts(ctx, ing.Ing, preferTLS)
+	if err != nil {
+		return nil, err
+	}
+	mutualAuthByPort, err := t.computeIngressMutualAuthConfigByPort(ctx, ing.Ing)
 	if err != nil {
 		return nil, err
 	}
+	for port := range mutualAuthByPort {
+		if listenPorts[port] != elbv2model.ProtocolHTTPS {
+			return nil, errors.Errorf("mutual authentication is only supported on HTTPS listen port: %v", port)
+		}
+	}
 
 	containsHTTPSPort := false
 	for _, protocol := range listenPorts {
@@ -125,8 +165,8 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 		}
 	}
 	var inferredTLSCertARNs []string
-	if containsHTTPSPort && len(explicitTLSCertARNs) == 0 {
-		inferredTLSCertARNs, err = t.computeIngressInferredTLSCertARNs(ctx, ing)
+	if containsHTTPSPort && len(explicitTLSCertARNs) == 0 && len(importedTLSSecrets) == 0 {
+		inferredTLSCertARNs, err = t.computeIngressInferredTLSCertARNs(ctx, ing.Ing)
 		if err != nil {
 			return nil, err
 		}
@@ -140,12 +180,15 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 			inboundCIDRv6s: inboundCIDRV6s,
 		}
 		if protocol == elbv2model.ProtocolHTTPS {
-			if len(explicitTLSCertARNs) == 0 {
-				cfg.tlsCerts = inferredTLSCertARNs
-			} else {
+			if len(explicitTLSCertARNs) != 0 {
 				cfg.tlsCerts = explicitTLSCertARNs
+			} else if len(importedTLSSecrets) != 0 {
+				cfg.tlsCertSecrets = importedTLSSecrets
+			} else {
+				cfg.tlsCerts = inferredTLSCertARNs
 			}
 			cfg.sslPolicy = explicitSSLPolicy
+			cfg.mutualAuth = mutualAuthByPort[port]
 		}
 		listenPortConfigByPort[port] = cfg
 	}
@@ -153,9 +196,13 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 	return listenPortConfigByPort, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing *networking.Ingress) []string {
+func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing ClassifiedIngress) []string {
 	var rawTLSCertARNs []string
-	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Annotations)
+	exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Ing.Annotations)
+	if ingClassParams := ing.IngClassConfig.IngClassParams; ingClassParams != nil && len(ingClassParams.Spec.CertificateARNs) != 0 &&
+		(!exists || !ing.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldCertificateARNs)) {
+		return ingClassParams.Spec.CertificateARNs
+	}
 	return rawTLSCertARNs
 }
 
@@ -209,15 +256,61 @@ func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing
 	return portAndProtocols, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Context, ing *networking.Ingress) ([]string, []string, error) {
+func (t *defaultModelBuildTask) computeIngressMutualAuthConfigByPort(_ context.Context, ing *networking.Ingress) (map[int64]*mutualAuthConfig, error) {
+	rawConfigs, err := parseIngressMutualAuthenticationConfigs(t.annotationParser, ing)
+	if err != nil {
+		return nil, err
+	}
+	mutualAuthByPort := make(map[int64]*mutualAuthConfig, len(rawConfigs))
+	for _, rawConfig := range rawConfigs {
+		if _, exists := mutualAuthByPort[rawConfig.Port]; exists {
+			return nil, errors.Errorf("duplicate %v settings for port: %v", annotations.IngressSuffixMutualAuthentication, rawConfig.Port)
+		}
+		cfg := &mutualAuthConfig{
+			mode:                          elbv2model.MutualAuthenticationMode(rawConfig.Mode),
+			trustStoreARN:                 rawConfig.TrustStore,
+			ignoreClientCertificateExpiry: rawConfig.IgnoreClientCertificateExpiry,
+		}
+		if rawConfig.CABundle != nil {
+			cfg.caBundle = buildCABundleRef(ing.Namespace, *rawConfig.CABundle)
+		}
+		mutualAuthByPort[rawConfig.Port] = cfg
+	}
+	return mutualAuthByPort, nil
+}
+
+// parseIngressMutualAuthenticationConfigs parses and validates the mutual authentication settings on Ingress.
+func parseIngressMutualAuthenticationConfigs(annotationParser annotations.Parser, ing *networking.Ingress) ([]MutualAuthenticationConfig, error) {
+	var rawConfigs []MutualAuthenticationConfig
+	if _, err := annotationParser.ParseJSONAnnotation(annotations.IngressSuffixMutualAuthentication, &rawConfigs, ing.Annotations); err != nil {
+		return nil, err
+	}
+	for _, rawConfig := range rawConfigs {
+		if err := rawConfig.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid %v settings for port: %v", annotations.IngressSuffixMutualAuthentication, rawConfig.Port)
+		}
+	}
+	return rawConfigs, nil
+}
+
+func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Context, ing ClassifiedIngress) ([]string, []string, error) {
 	var rawInboundCIDRs []string
-	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &rawInboundCIDRs, ing.Annotations)
+	exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &rawInboundCIDRs, ing.Ing.Annotations)
+	ingClassParams := ing.IngClassConfig.IngClassParams
+	useIngClassParams := ingClassParams != nil && len(ingClassParams.Spec.InboundCIDRs) != 0 &&
+		(!exists || !ing.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldInboundCIDRs))
+	if useIngClassParams {
+		rawInboundCIDRs = ingClassParams.Spec.InboundCIDRs
+	}
 
 	var inboundCIDRv4s, inboundCIDRv6s []string
 	for _, cidr := range rawInboundCIDRs {
 		_, _, err := net.ParseCIDR(cidr)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "invalid %v settings on Ingress: %v", annotations.IngressSuffixInboundCIDRs, k8s.NamespacedName(ing))
+			if useIngClassParams {
+				return nil, nil, errors.Wrapf(err, "invalid inboundCIDRs settings on IngressClassParams: %v", ingClassParams.Name)
+			}
+			return nil, nil, errors.Wrapf(err, "invalid %v settings on Ingress: %v", annotations.IngressSuffixInboundCIDRs, k8s.NamespacedName(ing.Ing))
 		}
 		if strings.Contains(cidr, ":") {
 			inboundCIDRv6s = append(inboundCIDRv6s, cidr)
@@ -228,9 +321,14 @@ func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Con
 	return inboundCIDRv4s, inboundCIDRv6s, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitSSLPolicy(_ context.Context, ing *networking.Ingress) *string {
+func (t *defaultModelBuildTask) computeIngressExplicitSSLPolicy(_ context.Context, ing ClassifiedIngress) *string {
 	var rawSSLPolicy string
-	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSSLPolicy, &rawSSLPolicy, ing.Annotations); !exists {
+	exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSSLPolicy, &rawSSLPolicy, ing.Ing.Annotations)
+	if ingClassParams := ing.IngClassConfig.IngClassParams; ingClassParams != nil && ingClassParams.Spec.SSLPolicy != nil &&
+		(!exists || !ing.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldSSLPolicy)) {
+		return ingClassParams.Spec.SSLPolicy
+	}
+	if !exists {
 		return nil
 	}
 	return &rawSSLPolicy
@@ -2,6 +2,8 @@ package ingress
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -19,6 +21,7 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 	}
 
 	var rules []Rule
+	ruleIDOccurrences := make(map[string]int)
 	for _, ing := range ingList {
 		for _, rule := range ing.Ing.Spec.Rules {
 			if rule.HTTP == nil {
@@ -43,7 +46,13 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
 				}
+				ruleID := buildListenerRuleID(ing, rule.Host, path)
+				ruleIDOccurrences[ruleID]++
+				if occurrence := ruleIDOccurrences[ruleID]; occurrence > 1 {
+					ruleID = fmt.Sprintf("%v-%v", ruleID, occurrence)
+				}
 				rules = append(rules, Rule{
+					ID:         ruleID,
 					Conditions: conditions,
 					Actions:    actions,
 					Tags:       tags,
@@ -58,7 +67,7 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 
 	priority := int64(1)
 	for _, rule := range optimizedRules {
-		ruleResID := fmt.Sprintf("%v:%v", port, priority)
+		ruleResID := fmt.Sprintf("%v:%v", port, rule.ID)
 		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
 			ListenerARN: lsARN,
 			Priority:    priority,
@@ -72,6 +81,28 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 	return nil
 }
 
+// buildListenerRuleID computes a stable identity for the listener rule generated for Ingress path.
+// The identity is derived from Ingress key, host and path instead of the rule's priority,
+// so that existing listener rules can be matched and reordered in place when rules are inserted or removed.
+func buildListenerRuleID(ing ClassifiedIngress, host string, path networking.HTTPIngressPath) string {
+	pathType := ""
+	if path.PathType != nil {
+		pathType = string(*path.PathType)
+	}
+	return ComputeListenerRuleID(ing.Ing.Namespace, ing.Ing.Name, host, pathType, path.Path)
+}
+
+// ComputeListenerRuleID computes a listener rule identity by hashing fields.
+// each field is length prefixed, so that different fields never hash the same when concatenated.
+func ComputeListenerRuleID(fields ...string) string {
+	uuidHash := sha256.New()
+	for _, field := range fields {
+		_, _ = fmt.Fprintf(uuidHash, "%d:%s", len(field), field)
+	}
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+	return fmt.Sprintf("%.16s", uuid)
+}
+
 func (t *defaultModelBuildTask) buildRuleConditions(ctx context.Context, rule networking.IngressRule,
 	path networking.HTTPIngressPath, backend EnhancedBackend) ([]elbv2model.RuleCondition, error) {
 	var hosts []string
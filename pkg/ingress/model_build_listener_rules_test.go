@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
 )
 
@@ -330,3 +331,43 @@ func Test_defaultModelBuildTask_buildPathPatternsForPrefixPathType(t *testing.T)
 		})
 	}
 }
+
+func Test_buildListenerRuleID(t *testing.T) {
+	pathTypePrefix := networking.PathTypePrefix
+	pathTypeExact := networking.PathTypeExact
+	ing := ClassifiedIngress{
+		Ing: &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "ing-1",
+			},
+		},
+	}
+	otherIng := ClassifiedIngress{
+		Ing: &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "ing-2",
+			},
+		},
+	}
+	path := networking.HTTPIngressPath{Path: "/svc-1", PathType: &pathTypePrefix}
+
+	got := buildListenerRuleID(ing, "app.example.com", path)
+	assert.Len(t, got, 16)
+	assert.Equal(t, got, buildListenerRuleID(ing, "app.example.com", path))
+	assert.NotEqual(t, got, buildListenerRuleID(otherIng, "app.example.com", path))
+	assert.NotEqual(t, got, buildListenerRuleID(ing, "other.example.com", path))
+	assert.NotEqual(t, got, buildListenerRuleID(ing, "app.example.com", networking.HTTPIngressPath{Path: "/svc-2", PathType: &pathTypePrefix}))
+	assert.NotEqual(t, got, buildListenerRuleID(ing, "app.example.com", networking.HTTPIngressPath{Path: "/svc-1", PathType: &pathTypeExact}))
+}
+
+func Test_ComputeListenerRuleID(t *testing.T) {
+	got := ComputeListenerRuleID("awesome-ns", "ing-1", "app.example.com")
+	assert.Len(t, got, 16)
+	assert.Equal(t, got, ComputeListenerRuleID("awesome-ns", "ing-1", "app.example.com"))
+	// fields that only differ by boundaries must not collide.
+	assert.NotEqual(t, got, ComputeListenerRuleID("awesome-n", "sing-1", "app.example.com"))
+	assert.NotEqual(t, got, ComputeListenerRuleID("awesome-ns", "ing-1app.example.com", ""))
+	assert.NotEqual(t, ComputeListenerRuleID("a", ""), ComputeListenerRuleID("", "a"))
+}
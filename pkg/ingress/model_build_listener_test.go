@@ -0,0 +1,339 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_computeIngressExplicitSSLPolicy(t *testing.T) {
+	type args struct {
+		ingAnnotations map[string]string
+		ingClassParams *elbv2api.IngressClassParams
+	}
+	tests := []struct {
+		name string
+		args args
+		want *string
+	}{
+		{
+			name: "sslPolicy not specified",
+			args: args{},
+			want: nil,
+		},
+		{
+			name: "sslPolicy specified via annotation",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/ssl-policy": "ELBSecurityPolicy-2016-08",
+				},
+			},
+			want: awssdk.String("ELBSecurityPolicy-2016-08"),
+		},
+		{
+			name: "sslPolicy specified via IngressClassParams is enforced",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/ssl-policy": "ELBSecurityPolicy-2016-08",
+				},
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						SSLPolicy: awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+					},
+				},
+			},
+			want: awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+		},
+		{
+			name: "sslPolicy specified via IngressClassParams is overridden by annotation",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/ssl-policy": "ELBSecurityPolicy-2016-08",
+				},
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						SSLPolicy: awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+						AnnotationOverrides: []elbv2api.IngressClassParamsField{
+							elbv2api.IngressClassParamsFieldSSLPolicy,
+						},
+					},
+				},
+			},
+			want: awssdk.String("ELBSecurityPolicy-2016-08"),
+		},
+		{
+			name: "sslPolicy specified via IngressClassParams is used as default",
+			args: args{
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						SSLPolicy: awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+						AnnotationOverrides: []elbv2api.IngressClassParamsField{
+							elbv2api.IngressClassParamsFieldSSLPolicy,
+						},
+					},
+				},
+			},
+			want: awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			ing := ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "ing-1",
+						Annotations: tt.args.ingAnnotations,
+					},
+				},
+				IngClassConfig: ClassConfiguration{IngClassParams: tt.args.ingClassParams},
+			}
+			got := task.computeIngressExplicitSSLPolicy(context.Background(), ing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_computeIngressExplicitInboundCIDRs(t *testing.T) {
+	type args struct {
+		ingAnnotations map[string]string
+		ingClassParams *elbv2api.IngressClassParams
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantCIDRv4s []string
+		wantCIDRv6s []string
+		wantErr     error
+	}{
+		{
+			name: "inboundCIDRs specified via annotation",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/inbound-cidrs": "10.0.0.0/16, 2001:db8::/32",
+				},
+			},
+			wantCIDRv4s: []string{"10.0.0.0/16"},
+			wantCIDRv6s: []string{"2001:db8::/32"},
+		},
+		{
+			name: "inboundCIDRs specified via IngressClassParams is enforced",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/inbound-cidrs": "0.0.0.0/0",
+				},
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						InboundCIDRs: []string{"10.0.0.0/16"},
+					},
+				},
+			},
+			wantCIDRv4s: []string{"10.0.0.0/16"},
+		},
+		{
+			name: "inboundCIDRs specified via IngressClassParams is overridden by annotation",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/inbound-cidrs": "0.0.0.0/0",
+				},
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						InboundCIDRs: []string{"10.0.0.0/16"},
+						AnnotationOverrides: []elbv2api.IngressClassParamsField{
+							elbv2api.IngressClassParamsFieldInboundCIDRs,
+						},
+					},
+				},
+			},
+			wantCIDRv4s: []string{"0.0.0.0/0"},
+		},
+		{
+			name: "invalid inboundCIDRs specified via IngressClassParams",
+			args: args{
+				ingClassParams: &elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+					Spec: elbv2api.IngressClassParamsSpec{
+						InboundCIDRs: []string{"10.0.0.0"},
+					},
+				},
+			},
+			wantErr: errors.New("invalid inboundCIDRs settings on IngressClassParams: awesome-class: invalid CIDR address: 10.0.0.0"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			ing := ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "ing-1",
+						Annotations: tt.args.ingAnnotations,
+					},
+				},
+				IngClassConfig: ClassConfiguration{IngClassParams: tt.args.ingClassParams},
+			}
+			gotCIDRv4s, gotCIDRv6s, err := task.computeIngressExplicitInboundCIDRs(context.Background(), ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCIDRv4s, gotCIDRv4s)
+				assert.Equal(t, tt.wantCIDRv6s, gotCIDRv6s)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_computeIngressMutualAuthConfigByPort(t *testing.T) {
+	type args struct {
+		ingAnnotations map[string]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[int64]*mutualAuthConfig
+		wantErr error
+	}{
+		{
+			name: "mutual authentication not specified",
+			args: args{},
+			want: map[int64]*mutualAuthConfig{},
+		},
+		{
+			name: "passthrough mode",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "passthrough"}]`,
+				},
+			},
+			want: map[int64]*mutualAuthConfig{
+				443: {
+					mode: elbv2model.MutualAuthenticationModePassthrough,
+				},
+			},
+		},
+		{
+			name: "verify mode with existing trust store and CA bundle",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify", "trustStore": "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/my-ts/73e2d6bc24d8a067", "ignoreClientCertificateExpiry": true},` +
+						`{"port": 8443, "mode": "verify", "caBundle": {"configMapName": "my-ca"}}]`,
+				},
+			},
+			want: map[int64]*mutualAuthConfig{
+				443: {
+					mode:                          elbv2model.MutualAuthenticationModeVerify,
+					trustStoreARN:                 awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/my-ts/73e2d6bc24d8a067"),
+					ignoreClientCertificateExpiry: awssdk.Bool(true),
+				},
+				8443: {
+					mode: elbv2model.MutualAuthenticationModeVerify,
+					caBundle: &caBundleRef{
+						kind:    caBundleKindConfigMap,
+						objKey:  types.NamespacedName{Namespace: "awesome-ns", Name: "my-ca"},
+						dataKey: "ca.crt",
+					},
+				},
+			},
+		},
+		{
+			name: "verify mode with CA bundle in secret with custom key",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify", "caBundle": {"secretName": "my-ca", "key": "bundle.pem"}}]`,
+				},
+			},
+			want: map[int64]*mutualAuthConfig{
+				443: {
+					mode: elbv2model.MutualAuthenticationModeVerify,
+					caBundle: &caBundleRef{
+						kind:    caBundleKindSecret,
+						objKey:  types.NamespacedName{Namespace: "awesome-ns", Name: "my-ca"},
+						dataKey: "bundle.pem",
+					},
+				},
+			},
+		},
+		{
+			name: "verify mode without trust store",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify"}]`,
+				},
+			},
+			wantErr: errors.New("invalid mutual-authentication settings for port: 443: exactly one of trustStore or caBundle must be specified"),
+		},
+		{
+			name: "passthrough mode with trust store",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "passthrough", "caBundle": {"secretName": "my-ca"}}]`,
+				},
+			},
+			wantErr: errors.New("invalid mutual-authentication settings for port: 443: trustStore and caBundle are only supported in verify mode"),
+		},
+		{
+			name: "CA bundle with both secret and configMap",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify", "caBundle": {"secretName": "my-ca", "configMapName": "my-ca"}}]`,
+				},
+			},
+			wantErr: errors.New("invalid mutual-authentication settings for port: 443: invalid caBundle: exactly one of secretName or configMapName must be specified"),
+		},
+		{
+			name: "unknown mode",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "strict"}]`,
+				},
+			},
+			wantErr: errors.New("invalid mutual-authentication settings for port: 443: mode must be within [off, passthrough, verify]: strict"),
+		},
+		{
+			name: "duplicate port",
+			args: args{
+				ingAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "off"}, {"port": 443, "mode": "passthrough"}]`,
+				},
+			},
+			wantErr: errors.New("duplicate mutual-authentication settings for port: 443"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			ing := &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "ing-1",
+					Annotations: tt.args.ingAnnotations,
+				},
+			}
+			got, err := task.computeIngressMutualAuthConfigByPort(context.Background(), ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
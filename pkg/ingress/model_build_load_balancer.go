@@ -13,7 +13,9 @@ import (
 	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
@@ -25,6 +27,10 @@ import (
 
 const (
 	resourceIDLoadBalancer = "LoadBalancer"
+
+	lbAttrsAccessLogsS3Enabled = "access_logs.s3.enabled"
+	lbAttrsAccessLogsS3Bucket  = "access_logs.s3.bucket"
+	lbAttrsAccessLogsS3Prefix  = "access_logs.s3.prefix"
 )
 
 func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
@@ -70,16 +76,22 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	adoptLBARN, preserveUnmanagedListeners, err := t.buildLoadBalancerAdoption(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	return elbv2model.LoadBalancerSpec{
-		Name:                   name,
-		Type:                   elbv2model.LoadBalancerTypeApplication,
-		Scheme:                 &scheme,
-		IPAddressType:          &ipAddressType,
-		SubnetMappings:         subnetMappings,
-		SecurityGroups:         securityGroups,
-		CustomerOwnedIPv4Pool:  coIPv4Pool,
-		LoadBalancerAttributes: loadBalancerAttributes,
-		Tags:                   tags,
+		Name:                       name,
+		Type:                       elbv2model.LoadBalancerTypeApplication,
+		Scheme:                     &scheme,
+		IPAddressType:              &ipAddressType,
+		SubnetMappings:             subnetMappings,
+		SecurityGroups:             securityGroups,
+		CustomerOwnedIPv4Pool:      coIPv4Pool,
+		LoadBalancerAttributes:     loadBalancerAttributes,
+		Tags:                       tags,
+		AdoptLoadBalancerARN:       adoptLBARN,
+		PreserveUnmanagedListeners: preserveUnmanagedListeners,
 	}, nil
 }
 
@@ -184,7 +196,13 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 	var explicitSubnetNameOrIDsList [][]string
 	for _, member := range t.ingGroup.Members {
 		var rawSubnetNameOrIDs []string
-		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, member.Ing.Annotations); !exists {
+		exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, member.Ing.Annotations)
+		if ingClassParams := member.IngClassConfig.IngClassParams; ingClassParams != nil && len(ingClassParams.Spec.Subnets) != 0 &&
+			(!exists || !member.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldSubnets)) {
+			rawSubnetNameOrIDs = ingClassParams.Spec.Subnets
+			exists = true
+		}
+		if !exists {
 			continue
 		}
 		explicitSubnetNameOrIDsList = append(explicitSubnetNameOrIDsList, rawSubnetNameOrIDs)
@@ -215,10 +233,15 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 	}
 
 	if len(sdkLBs) == 0 {
-		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx,
+		subnetDiscoveryOpts, err := t.buildLoadBalancerSubnetDiscoveryOptions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resolveOpts := append([]networking.SubnetsResolveOption{
 			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
 			networking.WithSubnetsResolveLBScheme(scheme),
-		)
+		}, subnetDiscoveryOpts...)
+		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx, resolveOpts...)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't auto-discover subnets")
 		}
@@ -234,11 +257,50 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 	return buildLoadBalancerSubnetMappingsWithSubnetIDs(subnetIDs), nil
 }
 
+// buildLoadBalancerSubnetDiscoveryOptions builds the options to discover subnets, based on the subnetDiscovery of IngressClassParams.
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetDiscoveryOptions(_ context.Context) ([]networking.SubnetsResolveOption, error) {
+	var explicitIngClassParamsList []*elbv2api.IngressClassParams
+	eventObjects := make([]runtime.Object, 0, len(t.ingGroup.Members))
+	for _, member := range t.ingGroup.Members {
+		eventObjects = append(eventObjects, member.Ing)
+		if member.IngClassConfig.IngClassParams == nil || member.IngClassConfig.IngClassParams.Spec.SubnetDiscovery == nil {
+			continue
+		}
+		explicitIngClassParamsList = append(explicitIngClassParamsList, member.IngClassConfig.IngClassParams)
+	}
+	resolveOpts := []networking.SubnetsResolveOption{
+		networking.WithSubnetsResolveEventRecorder(t.eventRecorder, eventObjects...),
+	}
+	if len(explicitIngClassParamsList) == 0 {
+		return resolveOpts, nil
+	}
+	chosenIngClassParams := explicitIngClassParamsList[0]
+	for _, ingClassParams := range explicitIngClassParamsList[1:] {
+		if !cmp.Equal(*chosenIngClassParams.Spec.SubnetDiscovery, *ingClassParams.Spec.SubnetDiscovery) {
+			return nil, errors.Errorf("conflicting subnetDiscovery in IngressClassParams: %v | %v", chosenIngClassParams.Name, ingClassParams.Name)
+		}
+	}
+	chosenSubnetDiscovery := chosenIngClassParams.Spec.SubnetDiscovery
+	if len(chosenSubnetDiscovery.Tags) != 0 {
+		resolveOpts = append(resolveOpts, networking.WithSubnetsResolveSubnetTags(chosenSubnetDiscovery.Tags))
+	}
+	if chosenSubnetDiscovery.MinAvailableIPAddresses != nil {
+		resolveOpts = append(resolveOpts, networking.WithSubnetsResolveMinAvailableIPAddressCount(*chosenSubnetDiscovery.MinAvailableIPAddresses))
+	}
+	return resolveOpts, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
 	var explicitSGNameOrIDsList [][]string
 	for _, member := range t.ingGroup.Members {
 		var rawSGNameOrIDs []string
-		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroups, &rawSGNameOrIDs, member.Ing.Annotations); !exists {
+		exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroups, &rawSGNameOrIDs, member.Ing.Annotations)
+		if ingClassParams := member.IngClassConfig.IngClassParams; ingClassParams != nil && len(ingClassParams.Spec.SecurityGroups) != 0 &&
+			(!exists || !member.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldSecurityGroups)) {
+			rawSGNameOrIDs = ingClassParams.Spec.SecurityGroups
+			exists = true
+		}
+		if !exists {
 			continue
 		}
 		explicitSGNameOrIDsList = append(explicitSGNameOrIDsList, rawSGNameOrIDs)
@@ -294,11 +356,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerCOIPv4Pool(_ context.Context) (
 	return &rawCOIPv4Pool, nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
+func (t *defaultModelBuildTask) buildLoadBalancerAttributes(ctx context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
 	mergedAttributes := make(map[string]string)
 	for _, member := range t.ingGroup.Members {
-		var rawAttributes map[string]string
-		if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &rawAttributes, member.Ing.Annotations); err != nil {
+		rawAttributes, err := t.buildIngressLoadBalancerAttributes(member)
+		if err != nil {
 			return nil, err
 		}
 		for attrKey, attrValue := range rawAttributes {
@@ -308,6 +370,14 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 			mergedAttributes[attrKey] = attrValue
 		}
 	}
+	deletionPolicy, err := t.buildLoadBalancerDeletionPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// deletion protection is enabled for Protect policy, unless it's explicitly specified.
+	if _, exists := mergedAttributes[elbv2model.LoadBalancerAttributeDeletionProtectionEnabled]; !exists && deletionPolicy == elbv2model.DeletionPolicyProtect {
+		mergedAttributes[elbv2model.LoadBalancerAttributeDeletionProtectionEnabled] = "true"
+	}
 	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(mergedAttributes))
 	for attrKey, attrValue := range mergedAttributes {
 		attributes = append(attributes, elbv2model.LoadBalancerAttribute{
@@ -318,6 +388,92 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 	return attributes, nil
 }
 
+// buildIngressLoadBalancerAttributes builds the LoadBalancer attributes for a single Ingress.
+// Note: the attributes specified via IngressClassParams takes higher priority than attributes specified via annotation on Ingress,
+// unless annotations are allowed to override them.
+func (t *defaultModelBuildTask) buildIngressLoadBalancerAttributes(ing ClassifiedIngress) (map[string]string, error) {
+	var annotationAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &annotationAttributes, ing.Ing.Annotations); err != nil {
+		return nil, err
+	}
+	ingClassParams := ing.IngClassConfig.IngClassParams
+	if ingClassParams == nil {
+		return annotationAttributes, nil
+	}
+
+	attributes := annotationAttributes
+	if len(ingClassParams.Spec.LoadBalancerAttributes) != 0 {
+		ingClassAttributes := make(map[string]string, len(ingClassParams.Spec.LoadBalancerAttributes))
+		for _, attr := range ingClassParams.Spec.LoadBalancerAttributes {
+			ingClassAttributes[attr.Key] = attr.Value
+		}
+		if ing.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldLoadBalancerAttributes) {
+			attributes = algorithm.MergeStringMap(attributes, ingClassAttributes)
+		} else {
+			attributes = algorithm.MergeStringMap(ingClassAttributes, attributes)
+		}
+	}
+	if ingClassParams.Spec.AccessLogs != nil {
+		accessLogsAttributes := map[string]string{
+			lbAttrsAccessLogsS3Enabled: "true",
+			lbAttrsAccessLogsS3Bucket:  ingClassParams.Spec.AccessLogs.S3Bucket,
+			lbAttrsAccessLogsS3Prefix:  ingClassParams.Spec.AccessLogs.S3Prefix,
+		}
+		if ing.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldAccessLogs) {
+			attributes = algorithm.MergeStringMap(attributes, accessLogsAttributes)
+		} else {
+			attributes = algorithm.MergeStringMap(accessLogsAttributes, attributes)
+		}
+	}
+	return attributes, nil
+}
+
+// buildLoadBalancerAdoption builds the existing LoadBalancer to adopt for the IngressGroup,
+// and whether listeners that are not managed by controller should be preserved on LoadBalancer.
+// Note: the adoption specified via IngressClassParams takes higher priority than annotations on Ingress.
+// Note: listeners not managed by controller are preserved on adopted LoadBalancer unless explicitly opted out.
+func (t *defaultModelBuildTask) buildLoadBalancerAdoption(_ context.Context) (*string, bool, error) {
+	explicitARNs := sets.NewString()
+	var preserveUnmanagedListeners *bool
+	mergePreserveUnmanagedListeners := func(preserve bool) {
+		if preserveUnmanagedListeners == nil || preserve {
+			preserveUnmanagedListeners = &preserve
+		}
+	}
+	for _, member := range t.ingGroup.Members {
+		if ingClassParams := member.IngClassConfig.IngClassParams; ingClassParams != nil && ingClassParams.Spec.AdoptLoadBalancer != nil {
+			explicitARNs.Insert(ingClassParams.Spec.AdoptLoadBalancer.ARN)
+			if ingClassParams.Spec.AdoptLoadBalancer.PreserveUnmanagedListeners != nil {
+				mergePreserveUnmanagedListeners(*ingClassParams.Spec.AdoptLoadBalancer.PreserveUnmanagedListeners)
+			}
+			continue
+		}
+		rawARN := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixAdoptLoadBalancerARN, &rawARN, member.Ing.Annotations); exists {
+			explicitARNs.Insert(rawARN)
+		}
+		preserve := false
+		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixPreserveUnmanagedListeners, &preserve, member.Ing.Annotations)
+		if err != nil {
+			return nil, false, err
+		}
+		if exists {
+			mergePreserveUnmanagedListeners(preserve)
+		}
+	}
+	if len(explicitARNs) == 0 {
+		return nil, awssdk.BoolValue(preserveUnmanagedListeners), nil
+	}
+	if len(explicitARNs) > 1 {
+		return nil, false, errors.Errorf("conflicting load balancer to adopt: %v", explicitARNs.List())
+	}
+	rawARN, _ := explicitARNs.PopAny()
+	if preserveUnmanagedListeners == nil {
+		return &rawARN, true, nil
+	}
+	return &rawARN, *preserveUnmanagedListeners, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerTags(_ context.Context) (map[string]string, error) {
 	ingGroupTags, err := t.buildIngressGroupResourceTags(t.ingGroup.Members)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
@@ -28,7 +29,13 @@ func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(_ context.Context, l
 	explicitWebACLARNs := sets.NewString()
 	for _, member := range t.ingGroup.Members {
 		rawWebACLARN := ""
-		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &rawWebACLARN, member.Ing.Annotations); exists {
+		exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &rawWebACLARN, member.Ing.Annotations)
+		if ingClassParams := member.IngClassConfig.IngClassParams; ingClassParams != nil && ingClassParams.Spec.WAFv2ACLARN != nil &&
+			(!exists || !member.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldWAFv2ACLARN)) {
+			rawWebACLARN = *ingClassParams.Spec.WAFv2ACLARN
+			exists = true
+		}
+		if exists {
 			explicitWebACLARNs.Insert(rawWebACLARN)
 		}
 	}
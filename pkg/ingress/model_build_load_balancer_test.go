@@ -8,8 +8,10 @@ import (
 	networking "k8s.io/api/networking/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"testing"
 )
 
@@ -643,3 +645,393 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildIngressLoadBalancerAttributes(t *testing.T) {
+	buildIng := func(ingAnnotations map[string]string, ingClassParams *elbv2api.IngressClassParams) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "ing-1",
+					Annotations: ingAnnotations,
+				},
+			},
+			IngClassConfig: ClassConfiguration{
+				IngClassParams: ingClassParams,
+			},
+		}
+	}
+	ingAnnotations := map[string]string{
+		"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=120,access_logs.s3.enabled=false",
+	}
+	tests := []struct {
+		name string
+		ing  ClassifiedIngress
+		want map[string]string
+	}{
+		{
+			name: "attributes specified via annotation only",
+			ing:  buildIng(ingAnnotations, nil),
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "120",
+				"access_logs.s3.enabled":       "false",
+			},
+		},
+		{
+			name: "attributes specified via IngressClassParams are enforced",
+			ing: buildIng(ingAnnotations, &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					LoadBalancerAttributes: []elbv2api.Attribute{
+						{Key: "idle_timeout.timeout_seconds", Value: "60"},
+						{Key: "routing.http2.enabled", Value: "false"},
+					},
+					AccessLogs: &elbv2api.AccessLogs{
+						S3Bucket: "my-bucket",
+					},
+				},
+			}),
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "60",
+				"routing.http2.enabled":        "false",
+				"access_logs.s3.enabled":       "true",
+				"access_logs.s3.bucket":        "my-bucket",
+				"access_logs.s3.prefix":        "",
+			},
+		},
+		{
+			name: "attributes specified via IngressClassParams are overridden by annotation",
+			ing: buildIng(ingAnnotations, &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					LoadBalancerAttributes: []elbv2api.Attribute{
+						{Key: "idle_timeout.timeout_seconds", Value: "60"},
+						{Key: "routing.http2.enabled", Value: "false"},
+					},
+					AccessLogs: &elbv2api.AccessLogs{
+						S3Bucket: "my-bucket",
+						S3Prefix: "my-prefix",
+					},
+					AnnotationOverrides: []elbv2api.IngressClassParamsField{
+						elbv2api.IngressClassParamsFieldLoadBalancerAttributes,
+					},
+				},
+			}),
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "120",
+				"routing.http2.enabled":        "false",
+				"access_logs.s3.enabled":       "true",
+				"access_logs.s3.bucket":        "my-bucket",
+				"access_logs.s3.prefix":        "my-prefix",
+			},
+		},
+		{
+			name: "accessLogs specified via IngressClassParams are overridden by annotation",
+			ing: buildIng(ingAnnotations, &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					AccessLogs: &elbv2api.AccessLogs{
+						S3Bucket: "my-bucket",
+					},
+					AnnotationOverrides: []elbv2api.IngressClassParamsField{
+						elbv2api.IngressClassParamsFieldAccessLogs,
+					},
+				},
+			}),
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "120",
+				"access_logs.s3.enabled":       "false",
+				"access_logs.s3.bucket":        "my-bucket",
+				"access_logs.s3.prefix":        "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildIngressLoadBalancerAttributes(tt.ing)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerSubnetDiscoveryOptions(t *testing.T) {
+	buildIng := func(name string, subnetDiscovery *elbv2api.SubnetDiscovery) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name},
+			},
+			IngClassConfig: ClassConfiguration{
+				IngClassParams: &elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "class-" + name},
+					Spec:       elbv2api.IngressClassParamsSpec{SubnetDiscovery: subnetDiscovery},
+				},
+			},
+		}
+	}
+	subnetDiscovery := &elbv2api.SubnetDiscovery{
+		Tags:                    map[string][]string{"tier": {"public"}},
+		MinAvailableIPAddresses: awssdk.Int64(32),
+	}
+	tests := []struct {
+		name           string
+		ingGroup       Group
+		wantSubnetTags map[string][]string
+		wantMinIPs     int64
+		wantErr        error
+	}{
+		{
+			name: "subnetDiscovery not specified",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{buildIng("ing-1", nil)},
+			},
+			wantMinIPs: 8,
+		},
+		{
+			name: "subnetDiscovery specified",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{buildIng("ing-1", subnetDiscovery), buildIng("ing-2", nil)},
+			},
+			wantSubnetTags: map[string][]string{"tier": {"public"}},
+			wantMinIPs:     32,
+		},
+		{
+			name: "conflicting subnetDiscovery",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					buildIng("ing-1", subnetDiscovery),
+					buildIng("ing-2", &elbv2api.SubnetDiscovery{MinAvailableIPAddresses: awssdk.Int64(16)}),
+				},
+			},
+			wantErr: errors.New("conflicting subnetDiscovery in IngressClassParams: class-ing-1 | class-ing-2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup: tt.ingGroup,
+			}
+			got, err := task.buildLoadBalancerSubnetDiscoveryOptions(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				resolveOpts := networkingpkg.SubnetsResolveOptions{MinAvailableIPAddressCount: 8}
+				resolveOpts.ApplyOptions(got)
+				assert.Equal(t, tt.wantSubnetTags, resolveOpts.SubnetTags)
+				assert.Equal(t, tt.wantMinIPs, resolveOpts.MinAvailableIPAddressCount)
+				assert.Len(t, resolveOpts.EventObjects, len(tt.ingGroup.Members))
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerAdoption(t *testing.T) {
+	type fields struct {
+		ingGroup Group
+	}
+	tests := []struct {
+		name                           string
+		fields                         fields
+		wantARN                        *string
+		wantPreserveUnmanagedListeners bool
+		wantErr                        error
+	}{
+		{
+			name: "adoption not configured",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantARN: nil,
+		},
+		{
+			name: "adoption configured via annotation",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/adopt-load-balancer-arn":      "lb-arn",
+										"alb.ingress.kubernetes.io/preserve-unmanaged-listeners": "true",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-2",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantARN:                        awssdk.String("lb-arn"),
+			wantPreserveUnmanagedListeners: true,
+		},
+		{
+			name: "adoption configured via IngressClassParams takes priority",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/adopt-load-balancer-arn":      "lb-arn-from-annotation",
+										"alb.ingress.kubernetes.io/preserve-unmanaged-listeners": "true",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &elbv2api.IngressClassParams{
+									Spec: elbv2api.IngressClassParamsSpec{
+										AdoptLoadBalancer: &elbv2api.AdoptLoadBalancer{
+											ARN:                        "lb-arn",
+											PreserveUnmanagedListeners: awssdk.Bool(false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantARN:                        awssdk.String("lb-arn"),
+			wantPreserveUnmanagedListeners: false,
+		},
+		{
+			name: "unmanaged listeners preserved on adopted loadBalancer by default",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/adopt-load-balancer-arn": "lb-arn",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantARN:                        awssdk.String("lb-arn"),
+			wantPreserveUnmanagedListeners: true,
+		},
+		{
+			name: "unmanaged listeners deleted on adopted loadBalancer when opted out",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/adopt-load-balancer-arn":      "lb-arn",
+										"alb.ingress.kubernetes.io/preserve-unmanaged-listeners": "false",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantARN:                        awssdk.String("lb-arn"),
+			wantPreserveUnmanagedListeners: false,
+		},
+		{
+			name: "conflicting adoption",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/adopt-load-balancer-arn": "lb-arn-1",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-2",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/adopt-load-balancer-arn": "lb-arn-2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting load balancer to adopt: [lb-arn-1 lb-arn-2]"),
+		},
+		{
+			name: "invalid preserve-unmanaged-listeners annotation",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/preserve-unmanaged-listeners": "yes",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("failed to parse bool annotation, alb.ingress.kubernetes.io/preserve-unmanaged-listeners: yes: strconv.ParseBool: parsing \"yes\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				ingGroup:         tt.fields.ingGroup,
+			}
+			gotARN, gotPreserveUnmanagedListeners, err := task.buildLoadBalancerAdoption(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantARN, gotARN)
+				assert.Equal(t, tt.wantPreserveUnmanagedListeners, gotPreserveUnmanagedListeners)
+			}
+		})
+	}
+}
@@ -26,7 +26,7 @@ const (
 
 func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context,
 	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString) (*elbv2model.TargetGroup, error) {
-	tgResID := t.buildTargetGroupResourceID(k8s.NamespacedName(ing.Ing), k8s.NamespacedName(svc), port)
+	tgResID := BuildTargetGroupResourceID(k8s.NamespacedName(ing.Ing), k8s.NamespacedName(svc), port)
 	if tg, exists := t.tgByResID[tgResID]; exists {
 		return tg, nil
 	}
@@ -54,6 +54,10 @@ func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tg
 func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, nodeSelector *metav1.LabelSelector) elbv2model.TargetGroupBindingResourceSpec {
 	targetType := elbv2api.TargetType(tg.Spec.TargetType)
 	tgbNetworking := t.buildTargetGroupBindingNetworking(ctx)
+	var tgbIPAddressType *elbv2api.TargetGroupIPAddressType
+	if tg.Spec.IPAddressType != nil {
+		tgbIPAddressType = (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType)
+	}
 	return elbv2model.TargetGroupBindingResourceSpec{
 		Template: elbv2model.TargetGroupBindingTemplate{
 			ObjectMeta: metav1.ObjectMeta{
@@ -67,8 +71,9 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 					Name: svc.Name,
 					Port: port,
 				},
-				Networking:   tgbNetworking,
-				NodeSelector: nodeSelector,
+				Networking:    tgbNetworking,
+				NodeSelector:  nodeSelector,
+				IPAddressType: tgbIPAddressType,
 			},
 		},
 	}
@@ -103,7 +108,7 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Cont
 func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString) (elbv2model.TargetGroupSpec, error) {
 	svcAndIngAnnotations := algorithm.MergeStringMap(svc.Annotations, ing.Ing.Annotations)
-	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndIngAnnotations)
+	targetType, err := t.buildTargetGroupTargetType(ctx, ing, svcAndIngAnnotations)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
@@ -131,9 +136,13 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, svc, targetType)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
 	tgPort := t.buildTargetGroupPort(ctx, targetType, svcPort)
-	name := t.buildTargetGroupName(ctx, k8s.NamespacedName(ing.Ing), svc, port, tgPort, targetType, tgProtocol, tgProtocolVersion)
-	return elbv2model.TargetGroupSpec{
+	name := t.buildTargetGroupName(ctx, k8s.NamespacedName(ing.Ing), svc, port, tgPort, targetType, tgProtocol, tgProtocolVersion, ipAddressType)
+	tgSpec := elbv2model.TargetGroupSpec{
 		Name:                  name,
 		TargetType:            targetType,
 		Port:                  tgPort,
@@ -142,7 +151,24 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 		HealthCheckConfig:     &healthCheckConfig,
 		TargetGroupAttributes: tgAttributes,
 		Tags:                  tags,
-	}, nil
+	}
+	if ipAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+		tgSpec.IPAddressType = &ipAddressType
+	}
+	return tgSpec, nil
+}
+
+// buildTargetGroupIPAddressType constructs the TargetGroup's IPAddressType.
+// ip targetType uses IPv6 TargetGroup when Service's primary IP family is IPv6, which can only be fronted by a dualstack LoadBalancer.
+func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context, svc *corev1.Service, targetType elbv2model.TargetType) (elbv2model.TargetGroupIPAddressType, error) {
+	if targetType != elbv2model.TargetTypeIP || len(svc.Spec.IPFamilies) == 0 || svc.Spec.IPFamilies[0] != corev1.IPv6Protocol {
+		return elbv2model.TargetGroupIPAddressTypeIPv4, nil
+	}
+	if t.loadBalancer == nil || t.loadBalancer.Spec.IPAddressType == nil || *t.loadBalancer.Spec.IPAddressType != elbv2model.IPAddressTypeDualStack {
+		return "", errors.Errorf("unsupported IPv6 configuration for service %v, load balancer IPAddressType must be %v for IPv6 targets",
+			k8s.NamespacedName(svc), elbv2model.IPAddressTypeDualStack)
+	}
+	return elbv2model.TargetGroupIPAddressTypeIPv6, nil
 }
 
 var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
@@ -150,7 +176,8 @@ var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
 // buildTargetGroupName will calculate the targetGroup's name.
 func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
 	ingKey types.NamespacedName, svc *corev1.Service, port intstr.IntOrString, tgPort int64,
-	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) string {
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion,
+	ipAddressType elbv2model.TargetGroupIPAddressType) string {
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
@@ -162,6 +189,10 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
 	_, _ = uuidHash.Write([]byte(targetType))
 	_, _ = uuidHash.Write([]byte(tgProtocol))
 	_, _ = uuidHash.Write([]byte(tgProtocolVersion))
+	// IPAddressType is only hashed for IPv6 to keep names of existing IPv4 TargetGroups unchanged.
+	if ipAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+		_, _ = uuidHash.Write([]byte(ipAddressType))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(svc.Namespace, "")
@@ -169,9 +200,13 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svcAndIngAnnotations map[string]string) (elbv2model.TargetType, error) {
+func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, ing ClassifiedIngress, svcAndIngAnnotations map[string]string) (elbv2model.TargetType, error) {
 	rawTargetType := string(t.defaultTargetType)
-	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetType, &rawTargetType, svcAndIngAnnotations)
+	exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetType, &rawTargetType, svcAndIngAnnotations)
+	if ingClassParams := ing.IngClassConfig.IngClassParams; ingClassParams != nil && ingClassParams.Spec.TargetType != nil &&
+		(!exists || !ing.IngClassConfig.annotationOverrideAllowed(elbv2api.IngressClassParamsFieldTargetType)) {
+		rawTargetType = string(*ingClassParams.Spec.TargetType)
+	}
 	switch rawTargetType {
 	case string(elbv2model.TargetTypeInstance):
 		return elbv2model.TargetTypeInstance, nil
@@ -395,7 +430,8 @@ func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing Clas
 	return algorithm.MergeStringMap(t.defaultTags, ingSvcTags), nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupResourceID(ingKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString) string {
+// BuildTargetGroupResourceID returns the resource ID of TargetGroup for Ingress backend, which is tracked by the resource tag of TargetGroup.
+func BuildTargetGroupResourceID(ingKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString) string {
 	return fmt.Sprintf("%s/%s-%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Name, port.String())
 }
 
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
@@ -25,6 +26,7 @@ func Test_defaultModelBuildTask_buildTargetGroupName(t *testing.T) {
 		targetType        elbv2model.TargetType
 		tgProtocol        elbv2model.Protocol
 		tgProtocolVersion elbv2model.ProtocolVersion
+		ipAddressType     elbv2model.TargetGroupIPAddressType
 	}
 	tests := []struct {
 		name string
@@ -126,11 +128,51 @@ func Test_defaultModelBuildTask_buildTargetGroupName(t *testing.T) {
 			},
 			want: "k8s-ns1-name1-22fbce26a7",
 		},
+		{
+			name: "standard case - ipv4 ipAddressType",
+			args: args{
+				ingKey: types.NamespacedName{Namespace: "ns-1", Name: "name-1"},
+				svc: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "name-1",
+						UID:       "my-uuid",
+					},
+				},
+				port:              intstr.FromString("http"),
+				tgPort:            8080,
+				targetType:        elbv2model.TargetTypeIP,
+				tgProtocol:        elbv2model.ProtocolHTTP,
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+				ipAddressType:     elbv2model.TargetGroupIPAddressTypeIPv4,
+			},
+			want: "k8s-ns1-name1-2c37289a00",
+		},
+		{
+			name: "standard case - ipv6 ipAddressType",
+			args: args{
+				ingKey: types.NamespacedName{Namespace: "ns-1", Name: "name-1"},
+				svc: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "name-1",
+						UID:       "my-uuid",
+					},
+				},
+				port:              intstr.FromString("http"),
+				tgPort:            8080,
+				targetType:        elbv2model.TargetTypeIP,
+				tgProtocol:        elbv2model.ProtocolHTTP,
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+				ipAddressType:     elbv2model.TargetGroupIPAddressTypeIPv6,
+			},
+			want: "k8s-ns1-name1-948884fb74",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			task := &defaultModelBuildTask{}
-			got := task.buildTargetGroupName(context.Background(), tt.args.ingKey, tt.args.svc, tt.args.port, tt.args.tgPort, tt.args.targetType, tt.args.tgProtocol, tt.args.tgProtocolVersion)
+			got := task.buildTargetGroupName(context.Background(), tt.args.ingKey, tt.args.svc, tt.args.port, tt.args.tgPort, tt.args.targetType, tt.args.tgProtocol, tt.args.tgProtocolVersion, tt.args.ipAddressType)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -732,3 +774,192 @@ func Test_defaultModelBuildTask_buildTargetGroupBindingNodeSelector(t *testing.T
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildTargetGroupTargetType(t *testing.T) {
+	targetTypeIP := elbv2api.TargetTypeIP
+	type args struct {
+		ingClassParams       *elbv2api.IngressClassParams
+		svcAndIngAnnotations map[string]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    elbv2model.TargetType
+		wantErr error
+	}{
+		{
+			name: "default targetType",
+			args: args{},
+			want: elbv2model.TargetTypeInstance,
+		},
+		{
+			name: "targetType specified via annotation",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-type": "ip",
+				},
+			},
+			want: elbv2model.TargetTypeIP,
+		},
+		{
+			name: "targetType specified via IngressClassParams",
+			args: args{
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						TargetType: &targetTypeIP,
+					},
+				},
+			},
+			want: elbv2model.TargetTypeIP,
+		},
+		{
+			name: "targetType specified via IngressClassParams is enforced",
+			args: args{
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						TargetType: &targetTypeIP,
+					},
+				},
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-type": "instance",
+				},
+			},
+			want: elbv2model.TargetTypeIP,
+		},
+		{
+			name: "targetType specified via IngressClassParams is overridden by annotation",
+			args: args{
+				ingClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						TargetType: &targetTypeIP,
+						AnnotationOverrides: []elbv2api.IngressClassParamsField{
+							elbv2api.IngressClassParamsFieldTargetType,
+						},
+					},
+				},
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-type": "instance",
+				},
+			},
+			want: elbv2model.TargetTypeInstance,
+		},
+		{
+			name: "unknown targetType",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-type": "lambda",
+				},
+			},
+			wantErr: errors.New("unknown targetType: lambda"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser:  annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				defaultTargetType: elbv2model.TargetTypeInstance,
+			}
+			ing := ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1"},
+				},
+				IngClassConfig: ClassConfiguration{IngClassParams: tt.args.ingClassParams},
+			}
+			got, err := task.buildTargetGroupTargetType(context.Background(), ing, tt.args.svcAndIngAnnotations)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildTargetGroupIPAddressType(t *testing.T) {
+	ipAddressTypeIPV4 := elbv2model.IPAddressTypeIPV4
+	ipAddressTypeDualStack := elbv2model.IPAddressTypeDualStack
+	type args struct {
+		svc        *corev1.Service
+		targetType elbv2model.TargetType
+	}
+	tests := []struct {
+		name          string
+		ipAddressType *elbv2model.IPAddressType
+		args          args
+		want          elbv2model.TargetGroupIPAddressType
+		wantErr       error
+	}{
+		{
+			name:          "ip target with IPv4 service",
+			ipAddressType: &ipAddressTypeDualStack,
+			args: args{
+				svc: &corev1.Service{
+					Spec: corev1.ServiceSpec{
+						IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+					},
+				},
+				targetType: elbv2model.TargetTypeIP,
+			},
+			want: elbv2model.TargetGroupIPAddressTypeIPv4,
+		},
+		{
+			name:          "ip target with IPv6 service",
+			ipAddressType: &ipAddressTypeDualStack,
+			args: args{
+				svc: &corev1.Service{
+					Spec: corev1.ServiceSpec{
+						IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+					},
+				},
+				targetType: elbv2model.TargetTypeIP,
+			},
+			want: elbv2model.TargetGroupIPAddressTypeIPv6,
+		},
+		{
+			name:          "instance target with IPv6 service",
+			ipAddressType: &ipAddressTypeDualStack,
+			args: args{
+				svc: &corev1.Service{
+					Spec: corev1.ServiceSpec{
+						IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+					},
+				},
+				targetType: elbv2model.TargetTypeInstance,
+			},
+			want: elbv2model.TargetGroupIPAddressTypeIPv4,
+		},
+		{
+			name:          "ip target with IPv6 service on ipv4 load balancer",
+			ipAddressType: &ipAddressTypeIPV4,
+			args: args{
+				svc: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+					Spec: corev1.ServiceSpec{
+						IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+					},
+				},
+				targetType: elbv2model.TargetTypeIP,
+			},
+			wantErr: errors.New("unsupported IPv6 configuration for service awesome-ns/svc-1, load balancer IPAddressType must be dualstack for IPv6 targets"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				loadBalancer: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						IPAddressType: tt.ipAddressType,
+					},
+				},
+			}
+			got, err := task.buildTargetGroupIPAddressType(context.Background(), tt.args.svc, tt.args.targetType)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
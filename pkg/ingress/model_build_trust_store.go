@@ -0,0 +1,153 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	caBundleKindSecret    = "Secret"
+	caBundleKindConfigMap = "ConfigMap"
+
+	// the default data key of CA certificates bundle.
+	defaultCABundleKey = "ca.crt"
+)
+
+// reference to the k8s object that holds PEM encoded CA certificates bundle.
+type caBundleRef struct {
+	kind    string
+	objKey  types.NamespacedName
+	dataKey string
+}
+
+func (r caBundleRef) String() string {
+	return fmt.Sprintf("%v/%v/%v", r.kind, r.objKey, r.dataKey)
+}
+
+func buildCABundleRef(namespace string, cfg CABundleConfig) *caBundleRef {
+	dataKey := defaultCABundleKey
+	if cfg.Key != nil {
+		dataKey = awssdk.StringValue(cfg.Key)
+	}
+	if cfg.SecretName != nil {
+		return &caBundleRef{
+			kind:    caBundleKindSecret,
+			objKey:  types.NamespacedName{Namespace: namespace, Name: awssdk.StringValue(cfg.SecretName)},
+			dataKey: dataKey,
+		}
+	}
+	return &caBundleRef{
+		kind:    caBundleKindConfigMap,
+		objKey:  types.NamespacedName{Namespace: namespace, Name: awssdk.StringValue(cfg.ConfigMapName)},
+		dataKey: dataKey,
+	}
+}
+
+func (t *defaultModelBuildTask) buildListenerMutualAuthentication(ctx context.Context, cfg mutualAuthConfig) (*elbv2model.MutualAuthenticationAttributes, error) {
+	mutualAuth := &elbv2model.MutualAuthenticationAttributes{
+		Mode:                          cfg.mode,
+		IgnoreClientCertificateExpiry: cfg.ignoreClientCertificateExpiry,
+	}
+	if cfg.trustStoreARN != nil {
+		mutualAuth.TrustStoreARN = core.LiteralStringToken(awssdk.StringValue(cfg.trustStoreARN))
+	}
+	if cfg.caBundle != nil {
+		ts, err := t.buildTrustStore(ctx, *cfg.caBundle)
+		if err != nil {
+			return nil, err
+		}
+		mutualAuth.TrustStoreARN = ts.TrustStoreARN()
+	}
+	return mutualAuth, nil
+}
+
+// buildTrustStore builds the TrustStore from CA certificates bundle.
+// TrustStores are shared across listeners and Ingresses within IngressGroup that references the same CA certificates bundle.
+func (t *defaultModelBuildTask) buildTrustStore(ctx context.Context, caBundle caBundleRef) (*elbv2model.TrustStore, error) {
+	tsResID := caBundle.String()
+	if ts, exists := t.tsByResID[tsResID]; exists {
+		return ts, nil
+	}
+	tsSpec, err := t.buildTrustStoreSpec(ctx, caBundle)
+	if err != nil {
+		return nil, err
+	}
+	ts := elbv2model.NewTrustStore(t.stack, tsResID, tsSpec)
+	t.tsByResID[tsResID] = ts
+	return ts, nil
+}
+
+func (t *defaultModelBuildTask) buildTrustStoreSpec(ctx context.Context, caBundle caBundleRef) (elbv2model.TrustStoreSpec, error) {
+	bundle, err := t.loadCABundle(ctx, caBundle)
+	if err != nil {
+		return elbv2model.TrustStoreSpec{}, err
+	}
+	tags, err := t.buildTrustStoreTags(ctx)
+	if err != nil {
+		return elbv2model.TrustStoreSpec{}, err
+	}
+	checksum := sha256.Sum256([]byte(bundle))
+	return elbv2model.TrustStoreSpec{
+		Name:                 t.buildTrustStoreName(ctx, caBundle),
+		CACertificatesBundle: bundle,
+		Checksum:             hex.EncodeToString(checksum[:]),
+		Tags:                 tags,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildTrustStoreName(_ context.Context, caBundle caBundleRef) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(caBundle.String()))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(caBundle.objKey.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(caBundle.objKey.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildTrustStoreTags(_ context.Context) (map[string]string, error) {
+	ingGroupTags, err := t.buildIngressGroupResourceTags(t.ingGroup.Members)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, ingGroupTags), nil
+}
+
+// loadCABundle loads the PEM encoded CA certificates bundle.
+// ConfigMaps are read from API server directly to avoid caching all ConfigMaps in cluster.
+func (t *defaultModelBuildTask) loadCABundle(ctx context.Context, caBundle caBundleRef) (string, error) {
+	var bundle string
+	switch caBundle.kind {
+	case caBundleKindSecret:
+		secret := &corev1.Secret{}
+		if err := t.k8sClient.Get(ctx, caBundle.objKey, secret); err != nil {
+			return "", errors.Wrapf(err, "failed to load CA certificates bundle secret: %v", caBundle.objKey)
+		}
+		bundle = string(secret.Data[caBundle.dataKey])
+	case caBundleKindConfigMap:
+		configMap := &corev1.ConfigMap{}
+		if err := t.apiReader.Get(ctx, caBundle.objKey, configMap); err != nil {
+			return "", errors.Wrapf(err, "failed to load CA certificates bundle configMap: %v", caBundle.objKey)
+		}
+		bundle = configMap.Data[caBundle.dataKey]
+		if len(bundle) == 0 {
+			bundle = string(configMap.BinaryData[caBundle.dataKey])
+		}
+	}
+	if len(bundle) == 0 {
+		return "", errors.Errorf("CA certificates bundle not found in %v", caBundle)
+	}
+	return bundle, nil
+}
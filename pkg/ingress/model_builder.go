@@ -10,11 +10,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"reflect"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -29,17 +31,18 @@ type ModelBuilder interface {
 }
 
 // NewDefaultModelBuilder constructs new defaultModelBuilder.
-func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventRecorder,
+func NewDefaultModelBuilder(k8sClient client.Client, apiReader client.Reader, eventRecorder record.EventRecorder,
 	ec2Client services.EC2, acmClient services.ACM,
 	annotationParser annotations.Parser, subnetsResolver networkingpkg.SubnetsResolver,
 	authConfigBuilder AuthConfigBuilder, enhancedBackendBuilder EnhancedBackendBuilder,
 	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager,
 	vpcID string, clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string,
-	logger logr.Logger) *defaultModelBuilder {
+	enableCertImport bool, logger logr.Logger) *defaultModelBuilder {
 	certDiscovery := NewACMCertDiscovery(acmClient, logger)
 	ruleOptimizer := NewDefaultRuleOptimizer(logger)
 	return &defaultModelBuilder{
 		k8sClient:              k8sClient,
+		apiReader:              apiReader,
 		eventRecorder:          eventRecorder,
 		ec2Client:              ec2Client,
 		vpcID:                  vpcID,
@@ -55,6 +58,7 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		defaultTags:            defaultTags,
 		externalManagedTags:    sets.NewString(externalManagedTags...),
 		defaultSSLPolicy:       defaultSSLPolicy,
+		enableCertImport:       enableCertImport,
 		logger:                 logger,
 	}
 }
@@ -64,6 +68,7 @@ var _ ModelBuilder = &defaultModelBuilder{}
 // default implementation for ModelBuilder
 type defaultModelBuilder struct {
 	k8sClient     client.Client
+	apiReader     client.Reader
 	eventRecorder record.EventRecorder
 	ec2Client     services.EC2
 
@@ -81,6 +86,7 @@ type defaultModelBuilder struct {
 	defaultTags            map[string]string
 	externalManagedTags    sets.String
 	defaultSSLPolicy       string
+	enableCertImport       bool
 
 	logger logr.Logger
 }
@@ -90,6 +96,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
 	task := &defaultModelBuildTask{
 		k8sClient:              b.k8sClient,
+		apiReader:              b.apiReader,
 		eventRecorder:          b.eventRecorder,
 		ec2Client:              b.ec2Client,
 		vpcID:                  b.vpcID,
@@ -102,6 +109,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		ruleOptimizer:          b.ruleOptimizer,
 		trackingProvider:       b.trackingProvider,
 		elbv2TaggingManager:    b.elbv2TaggingManager,
+		enableCertImport:       b.enableCertImport,
 		logger:                 b.logger,
 
 		ingGroup: ingGroup,
@@ -126,6 +134,8 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 
 		loadBalancer:    nil,
 		tgByResID:       make(map[string]*elbv2model.TargetGroup),
+		certByResID:     make(map[string]*acmmodel.Certificate),
+		tsByResID:       make(map[string]*elbv2model.TrustStore),
 		backendServices: make(map[types.NamespacedName]*corev1.Service),
 	}
 	if err := task.run(ctx); err != nil {
@@ -137,6 +147,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 // the default model build task
 type defaultModelBuildTask struct {
 	k8sClient              client.Client
+	apiReader              client.Reader
 	eventRecorder          record.EventRecorder
 	ec2Client              services.EC2
 	vpcID                  string
@@ -149,6 +160,7 @@ type defaultModelBuildTask struct {
 	ruleOptimizer          RuleOptimizer
 	trackingProvider       tracking.Provider
 	elbv2TaggingManager    elbv2deploy.TaggingManager
+	enableCertImport       bool
 	logger                 logr.Logger
 
 	ingGroup          Group
@@ -175,6 +187,8 @@ type defaultModelBuildTask struct {
 	loadBalancer    *elbv2model.LoadBalancer
 	managedSG       *ec2model.SecurityGroup
 	tgByResID       map[string]*elbv2model.TargetGroup
+	certByResID     map[string]*acmmodel.Certificate
+	tsByResID       map[string]*elbv2model.TrustStore
 	backendServices map[types.NamespacedName]*corev1.Service
 }
 
@@ -187,7 +201,7 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 	listenPortConfigsByPort := make(map[int64][]listenPortConfigWithIngress)
 	for _, member := range t.ingGroup.Members {
 		ingKey := k8s.NamespacedName(member.Ing)
-		listenPortConfigByPortForIngress, err := t.computeIngressListenPortConfigByPort(ctx, member.Ing)
+		listenPortConfigByPortForIngress, err := t.computeIngressListenPortConfigByPort(ctx, member)
 		if err != nil {
 			return errors.Wrapf(err, "ingress: %v", ingKey.String())
 		}
@@ -249,6 +263,12 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 	var mergedTLSCerts []string
 	mergedTLSCertsSet := sets.NewString()
 
+	var mergedTLSCertSecrets []types.NamespacedName
+	mergedTLSCertSecretsSet := make(map[types.NamespacedName]struct{})
+
+	var mergedMutualAuthProvider *types.NamespacedName
+	var mergedMutualAuth *mutualAuthConfig
+
 	for _, cfg := range listenPortConfigs {
 		if mergedProtocolProvider == nil {
 			mergedProtocolProvider = &cfg.ingKey
@@ -281,6 +301,16 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 			}
 		}
 
+		if cfg.listenPortConfig.mutualAuth != nil {
+			if mergedMutualAuthProvider == nil {
+				mergedMutualAuthProvider = &cfg.ingKey
+				mergedMutualAuth = cfg.listenPortConfig.mutualAuth
+			} else if !reflect.DeepEqual(mergedMutualAuth, cfg.listenPortConfig.mutualAuth) {
+				return listenPortConfig{}, errors.Errorf("conflicting mutualAuthentication, %v | %v",
+					*mergedMutualAuthProvider, cfg.ingKey)
+			}
+		}
+
 		for _, cert := range cfg.listenPortConfig.tlsCerts {
 			if mergedTLSCertsSet.Has(cert) {
 				continue
@@ -288,6 +318,14 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 			mergedTLSCertsSet.Insert(cert)
 			mergedTLSCerts = append(mergedTLSCerts, cert)
 		}
+
+		for _, secretKey := range cfg.listenPortConfig.tlsCertSecrets {
+			if _, exists := mergedTLSCertSecretsSet[secretKey]; exists {
+				continue
+			}
+			mergedTLSCertSecretsSet[secretKey] = struct{}{}
+			mergedTLSCertSecrets = append(mergedTLSCertSecrets, secretKey)
+		}
 	}
 
 	if len(mergedInboundCIDRv4s) == 0 && len(mergedInboundCIDRv6s) == 0 {
@@ -304,6 +342,8 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 		inboundCIDRv6s: mergedInboundCIDRv6s.List(),
 		sslPolicy:      mergedSSLPolicy,
 		tlsCerts:       mergedTLSCerts,
+		tlsCertSecrets: mergedTLSCertSecrets,
+		mutualAuth:     mergedMutualAuth,
 	}, nil
 }
 
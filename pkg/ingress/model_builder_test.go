@@ -268,7 +268,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
             }
         },
         "AWS::ElasticLoadBalancingV2::ListenerRule":{
-            "80:1":{
+            "80:3e901502ec86cf8d":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -308,7 +308,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "80:2":{
+            "80:83e6512628ab256b":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -348,7 +348,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "80:3":{
+            "80:9d326521996c09c9":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -719,7 +719,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
             }
         },
         "AWS::ElasticLoadBalancingV2::ListenerRule":{
-            "80:1":{
+            "80:3e901502ec86cf8d":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -759,7 +759,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "80:2":{
+            "80:83e6512628ab256b":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -799,7 +799,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "80:3":{
+            "80:9d326521996c09c9":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -1183,7 +1183,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
             }
         },
         "AWS::ElasticLoadBalancingV2::ListenerRule":{
-            "443:1":{
+            "443:3e901502ec86cf8d":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
@@ -1223,7 +1223,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "443:2":{
+            "443:83e6512628ab256b":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
@@ -1263,7 +1263,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "443:3":{
+            "443:9d326521996c09c9":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
@@ -1615,7 +1615,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
             }
         },
         "AWS::ElasticLoadBalancingV2::ListenerRule":{
-            "80:1":{
+            "80:50c53c01e0d820c8":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -1655,7 +1655,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "80:2":{
+            "80:fa2fa42ebacac098":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -2016,7 +2016,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
             }
         },
         "AWS::ElasticLoadBalancingV2::ListenerRule":{
-            "80:1":{
+            "80:3e901502ec86cf8d":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -2056,7 +2056,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "80:2":{
+            "80:83e6512628ab256b":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -2096,7 +2096,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                     ]
                 }
             },
-            "80:3":{
+            "80:9d326521996c09c9":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -7,6 +7,7 @@ import (
 	networking "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -19,6 +20,8 @@ const (
 	IndexKeyIngressClassRefName = "ingress.ingressClassRef.name"
 	// IndexKeyIngressClassParamsRefName is index key for ingressClassParams referenced by IngressClass.
 	IndexKeyIngressClassParamsRefName = "ingressClass.ingressClassParamsRef.name"
+	// IndexKeyListenerRuleActionRefName is index key for listenerRuleActions referenced by Ingress.
+	IndexKeyListenerRuleActionRefName = "ingress.listenerRuleActionRef.name"
 )
 
 // ReferenceIndexer has the ability to index Ingresses with referenced objects.
@@ -31,13 +34,22 @@ type ReferenceIndexer interface {
 	BuildIngressClassRefIndexes(ctx context.Context, ing *networking.Ingress) []string
 	// BuildIngressClassParamsRefIndexes returns the name of related IngressClassParams objects.
 	BuildIngressClassParamsRefIndexes(ctx context.Context, ingClass *networking.IngressClass) []string
+	// BuildListenerRuleActionRefIndexes returns the name of related ListenerRuleAction objects.
+	BuildListenerRuleActionRefIndexes(ctx context.Context, ing *networking.Ingress) []string
+	// BuildListenerRuleActionServiceRefIndexes returns the name of Service objects related to ListenerRuleAction.
+	BuildListenerRuleActionServiceRefIndexes(ctx context.Context, ruleAction *elbv2api.ListenerRuleAction) []string
+	// BuildListenerRuleActionSecretRefIndexes returns the name of Secret objects related to ListenerRuleAction.
+	BuildListenerRuleActionSecretRefIndexes(ctx context.Context, ruleAction *elbv2api.ListenerRuleAction) []string
 }
 
 // NewDefaultReferenceIndexer constructs new defaultReferenceIndexer.
-func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder, logger logr.Logger) *defaultReferenceIndexer {
+func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder,
+	annotationParser annotations.Parser, enableCertImport bool, logger logr.Logger) *defaultReferenceIndexer {
 	return &defaultReferenceIndexer{
 		enhancedBackendBuilder: enhancedBackendBuilder,
 		authConfigBuilder:      authConfigBuilder,
+		annotationParser:       annotationParser,
+		enableCertImport:       enableCertImport,
 		logger:                 logger,
 	}
 }
@@ -48,6 +60,8 @@ var _ ReferenceIndexer = &defaultReferenceIndexer{}
 type defaultReferenceIndexer struct {
 	enhancedBackendBuilder EnhancedBackendBuilder
 	authConfigBuilder      AuthConfigBuilder
+	annotationParser       annotations.Parser
+	enableCertImport       bool
 	logger                 logr.Logger
 }
 
@@ -70,6 +84,8 @@ func (i *defaultReferenceIndexer) BuildServiceRefIndexes(ctx context.Context, in
 		enhancedBackend, err := i.enhancedBackendBuilder.Build(ctx, ing, backend,
 			WithLoadBackendServices(false, nil),
 			WithLoadAuthConfig(false),
+			WithLoadListenerRuleAction(false),
+			WithLoadTrafficShift(false),
 		)
 		if err != nil {
 			i.logger.Error(err, "failed to build Ingress indexes",
@@ -89,7 +105,28 @@ func (i *defaultReferenceIndexer) BuildSecretRefIndexes(ctx context.Context, ing
 			"indexKey", IndexKeySecretRefName)
 		return nil
 	}
-	return extractSecretNamesFromAuthConfig(authCfg)
+	secretNames := extractSecretNamesFromAuthConfig(authCfg)
+	ing, ok := ingOrSvc.(*networking.Ingress)
+	if !ok {
+		return secretNames
+	}
+	if i.enableCertImport {
+		for _, secretKey := range extractIngressTLSSecretKeys(ing) {
+			secretNames = append(secretNames, secretKey.Name)
+		}
+	}
+	mutualAuthCfgs, err := parseIngressMutualAuthenticationConfigs(i.annotationParser, ing)
+	if err != nil {
+		i.logger.Error(err, "failed to build Ingress indexes",
+			"indexKey", IndexKeySecretRefName)
+		return secretNames
+	}
+	for _, mutualAuthCfg := range mutualAuthCfgs {
+		if mutualAuthCfg.CABundle != nil && mutualAuthCfg.CABundle.SecretName != nil {
+			secretNames = append(secretNames, awssdk.StringValue(mutualAuthCfg.CABundle.SecretName))
+		}
+	}
+	return secretNames
 }
 
 func (i *defaultReferenceIndexer) BuildIngressClassRefIndexes(_ context.Context, ing *networking.Ingress) []string {
@@ -114,6 +151,42 @@ func (i *defaultReferenceIndexer) BuildIngressClassParamsRefIndexes(_ context.Co
 	return []string{ingClassParamsName}
 }
 
+func (i *defaultReferenceIndexer) BuildListenerRuleActionRefIndexes(_ context.Context, ing *networking.Ingress) []string {
+	var backends []networking.IngressBackend
+	if ing.Spec.Backend != nil {
+		backends = append(backends, *ing.Spec.Backend)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			backends = append(backends, path.Backend)
+		}
+	}
+
+	ruleActionNames := sets.NewString()
+	for _, backend := range backends {
+		if backend.Resource != nil && isListenerRuleActionRef(*backend.Resource) {
+			ruleActionNames.Insert(backend.Resource.Name)
+		}
+	}
+	return ruleActionNames.List()
+}
+
+func (i *defaultReferenceIndexer) BuildListenerRuleActionServiceRefIndexes(_ context.Context, ruleAction *elbv2api.ListenerRuleAction) []string {
+	action := buildActionFromListenerRuleAction(ruleAction.Spec.Action)
+	return extractServiceNamesFromAction(action)
+}
+
+func (i *defaultReferenceIndexer) BuildListenerRuleActionSecretRefIndexes(_ context.Context, ruleAction *elbv2api.ListenerRuleAction) []string {
+	if ruleAction.Spec.Authentication == nil {
+		return nil
+	}
+	authCfg := buildAuthConfigFromListenerRuleAction(*ruleAction.Spec.Authentication)
+	return extractSecretNamesFromAuthConfig(authCfg)
+}
+
 func extractServiceNamesFromAction(action Action) []string {
 	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
 		return nil
@@ -5,9 +5,11 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -267,13 +269,17 @@ func Test_defaultReferenceIndexer_BuildServiceRefIndexes(t *testing.T) {
 }
 
 func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
+	type fields struct {
+		enableCertImport bool
+	}
 	type args struct {
 		ingOrSvc client.Object
 	}
 	tests := []struct {
-		name string
-		args args
-		want []string
+		name   string
+		fields fields
+		args   args
+		want   []string
 	}{
 		{
 			name: "ingress with AuthOIDC annotation",
@@ -300,6 +306,53 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "ingress with TLS secrets and certificate import enabled",
+			fields: fields{
+				enableCertImport: true,
+			},
+			args: args{
+				ingOrSvc: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/auth-idp-oidc": `{"issuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretName":"my-k8s-secret"}`,
+						},
+					},
+					Spec: networking.IngressSpec{
+						TLS: []networking.IngressTLS{
+							{
+								Hosts:      []string{"app.example.com"},
+								SecretName: "app-tls",
+							},
+							{
+								Hosts: []string{"www.example.com"},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"my-k8s-secret", "app-tls"},
+		},
+		{
+			name: "ingress with TLS secrets and certificate import disabled",
+			args: args{
+				ingOrSvc: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ing",
+					},
+					Spec: networking.IngressSpec{
+						TLS: []networking.IngressTLS{
+							{
+								Hosts:      []string{"app.example.com"},
+								SecretName: "app-tls",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -309,6 +362,8 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 			i := &defaultReferenceIndexer{
 				enhancedBackendBuilder: enhancedBackendBuilder,
 				authConfigBuilder:      authConfigBuilder,
+				annotationParser:       annotationParser,
+				enableCertImport:       tt.fields.enableCertImport,
 				logger:                 &log.NullLogger{},
 			}
 			got := i.BuildSecretRefIndexes(context.Background(), tt.args.ingOrSvc)
@@ -456,3 +511,156 @@ func Test_defaultReferenceIndexer_BuildIngressClassParamsRefIndexes(t *testing.T
 		})
 	}
 }
+
+func Test_defaultReferenceIndexer_BuildListenerRuleActionRefIndexes(t *testing.T) {
+	type args struct {
+		ing *networking.Ingress
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Ingress refers ListenerRuleActions via default backend and rules",
+			args: args{
+				ing: &networking.Ingress{
+					Spec: networking.IngressSpec{
+						Backend: &networking.IngressBackend{
+							Resource: &corev1.TypedLocalObjectReference{
+								APIGroup: awssdk.String("elbv2.k8s.aws"),
+								Kind:     "ListenerRuleAction",
+								Name:     "action-a",
+							},
+						},
+						Rules: []networking.IngressRule{
+							{
+								IngressRuleValue: networking.IngressRuleValue{
+									HTTP: &networking.HTTPIngressRuleValue{
+										Paths: []networking.HTTPIngressPath{
+											{
+												Path: "/pathB",
+												Backend: networking.IngressBackend{
+													Resource: &corev1.TypedLocalObjectReference{
+														APIGroup: awssdk.String("elbv2.k8s.aws"),
+														Kind:     "ListenerRuleAction",
+														Name:     "action-b",
+													},
+												},
+											},
+											{
+												Path: "/pathC",
+												Backend: networking.IngressBackend{
+													ServiceName: "svc-c",
+													ServicePort: intstr.FromInt(80),
+												},
+											},
+											{
+												Path: "/pathD",
+												Backend: networking.IngressBackend{
+													Resource: &corev1.TypedLocalObjectReference{
+														APIGroup: awssdk.String("elbv2.k8s.aws"),
+														Kind:     "ListenerRuleAction",
+														Name:     "action-a",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"action-a", "action-b"},
+		},
+		{
+			name: "Ingress refers resource backend of other kind",
+			args: args{
+				ing: &networking.Ingress{
+					Spec: networking.IngressSpec{
+						Backend: &networking.IngressBackend{
+							Resource: &corev1.TypedLocalObjectReference{
+								APIGroup: awssdk.String("k8s.example.com"),
+								Kind:     "StorageBucket",
+								Name:     "static-assets",
+							},
+						},
+					},
+				},
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{}
+			got := i.BuildListenerRuleActionRefIndexes(context.Background(), tt.args.ing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReferenceIndexer_BuildListenerRuleActionServiceRefIndexes(t *testing.T) {
+	portHTTP := intstr.FromString("http")
+	type args struct {
+		ruleAction *elbv2api.ListenerRuleAction
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "ListenerRuleAction forwards to services",
+			args: args{
+				ruleAction: &elbv2api.ListenerRuleAction{
+					Spec: elbv2api.ListenerRuleActionSpec{
+						Action: elbv2api.RuleAction{
+							Type: elbv2api.RuleActionTypeForward,
+							ForwardConfig: &elbv2api.ForwardActionConfig{
+								TargetGroups: []elbv2api.TargetGroupTuple{
+									{
+										ServiceName: awssdk.String("svc-b"),
+										ServicePort: &portHTTP,
+										Weight:      awssdk.Int64(80),
+									},
+									{
+										ServiceName: awssdk.String("svc-a"),
+										ServicePort: &portHTTP,
+										Weight:      awssdk.Int64(20),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"svc-a", "svc-b"},
+		},
+		{
+			name: "ListenerRuleAction with fixed-response",
+			args: args{
+				ruleAction: &elbv2api.ListenerRuleAction{
+					Spec: elbv2api.ListenerRuleActionSpec{
+						Action: elbv2api.RuleAction{
+							Type: elbv2api.RuleActionTypeFixedResponse,
+							FixedResponseConfig: &elbv2api.FixedResponseActionConfig{
+								StatusCode: "404",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{}
+			got := i.BuildListenerRuleActionServiceRefIndexes(context.Background(), tt.args.ruleAction)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 )
 
 type Rule struct {
+	// ID is the stable identity of rule within a Listener, which is independent of the rule priority.
+	ID         string
 	Conditions []elbv2model.RuleCondition
 	Actions    []elbv2model.Action
 	Tags       map[string]string
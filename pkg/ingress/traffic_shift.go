@@ -0,0 +1,49 @@
+package ingress
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// findTrafficShift returns the TrafficShift that shifts the backend action of Ingress, or nil if there is none.
+func findTrafficShift(trafficShifts []elbv2api.TrafficShift, ingName string, actionName string) *elbv2api.TrafficShift {
+	for i := range trafficShifts {
+		ts := &trafficShifts[i]
+		if ts.Spec.IngressName == ingName && ts.Spec.ActionName == actionName {
+			return ts
+		}
+	}
+	return nil
+}
+
+// applyTrafficShiftWeights overrides the weights of stableService and canaryService in forward action with canaryWeight of TrafficShift.
+// The action is left untouched if TrafficShift haven't started yet.
+func applyTrafficShiftWeights(action *Action, ts *elbv2api.TrafficShift) error {
+	if ts.Status.CanaryWeight == nil {
+		return nil
+	}
+	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
+		return errors.Errorf("trafficShift %v requires action %v to be forward action", k8s.NamespacedName(ts), ts.Spec.ActionName)
+	}
+
+	var stableTGT, canaryTGT *TargetGroupTuple
+	for i := range action.ForwardConfig.TargetGroups {
+		tgt := &action.ForwardConfig.TargetGroups[i]
+		switch awssdk.StringValue(tgt.ServiceName) {
+		case ts.Spec.StableService:
+			stableTGT = tgt
+		case ts.Spec.CanaryService:
+			canaryTGT = tgt
+		}
+	}
+	if stableTGT == nil || canaryTGT == nil {
+		return errors.Errorf("trafficShift %v requires action %v to forward to both stableService %v and canaryService %v",
+			k8s.NamespacedName(ts), ts.Spec.ActionName, ts.Spec.StableService, ts.Spec.CanaryService)
+	}
+	canaryWeight := int64(awssdk.Int32Value(ts.Status.CanaryWeight))
+	stableTGT.Weight = awssdk.Int64(100 - canaryWeight)
+	canaryTGT.Weight = awssdk.Int64(canaryWeight)
+	return nil
+}
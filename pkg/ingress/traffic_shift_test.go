@@ -0,0 +1,158 @@
+package ingress
+
+import (
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func Test_findTrafficShift(t *testing.T) {
+	trafficShifts := []elbv2api.TrafficShift{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "shift-1"},
+			Spec:       elbv2api.TrafficShiftSpec{IngressName: "ing-1", ActionName: "action-1"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "shift-2"},
+			Spec:       elbv2api.TrafficShiftSpec{IngressName: "ing-1", ActionName: "action-2"},
+		},
+	}
+	tests := []struct {
+		name       string
+		ingName    string
+		actionName string
+		want       *elbv2api.TrafficShift
+	}{
+		{
+			name:       "matches ingress and action",
+			ingName:    "ing-1",
+			actionName: "action-2",
+			want:       &trafficShifts[1],
+		},
+		{
+			name:       "action doesn't match",
+			ingName:    "ing-1",
+			actionName: "action-3",
+			want:       nil,
+		},
+		{
+			name:       "ingress doesn't match",
+			ingName:    "ing-2",
+			actionName: "action-1",
+			want:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findTrafficShift(trafficShifts, tt.ingName, tt.actionName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_applyTrafficShiftWeights(t *testing.T) {
+	portHTTP := intstr.FromString("http")
+	buildTrafficShift := func(canaryWeight *int32) *elbv2api.TrafficShift {
+		return &elbv2api.TrafficShift{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "shift"},
+			Spec: elbv2api.TrafficShiftSpec{
+				IngressName:   "ing",
+				ActionName:    "action",
+				StableService: "svc-stable",
+				CanaryService: "svc-canary",
+			},
+			Status: elbv2api.TrafficShiftStatus{
+				CanaryWeight: canaryWeight,
+			},
+		}
+	}
+	buildForwardAction := func(stableWeight *int64, canaryWeight *int64) Action {
+		return Action{
+			Type: ActionTypeForward,
+			ForwardConfig: &ForwardActionConfig{
+				TargetGroups: []TargetGroupTuple{
+					{
+						ServiceName: awssdk.String("svc-stable"),
+						ServicePort: &portHTTP,
+						Weight:      stableWeight,
+					},
+					{
+						ServiceName: awssdk.String("svc-canary"),
+						ServicePort: &portHTTP,
+						Weight:      canaryWeight,
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		action     Action
+		ts         *elbv2api.TrafficShift
+		wantAction Action
+		wantErr    error
+	}{
+		{
+			name:       "trafficShift not started yet",
+			action:     buildForwardAction(awssdk.Int64(100), awssdk.Int64(0)),
+			ts:         buildTrafficShift(nil),
+			wantAction: buildForwardAction(awssdk.Int64(100), awssdk.Int64(0)),
+		},
+		{
+			name:       "weights overridden",
+			action:     buildForwardAction(awssdk.Int64(100), awssdk.Int64(0)),
+			ts:         buildTrafficShift(awssdk.Int32(30)),
+			wantAction: buildForwardAction(awssdk.Int64(70), awssdk.Int64(30)),
+		},
+		{
+			name:       "weights overridden when unspecified in action",
+			action:     buildForwardAction(nil, nil),
+			ts:         buildTrafficShift(awssdk.Int32(100)),
+			wantAction: buildForwardAction(awssdk.Int64(0), awssdk.Int64(100)),
+		},
+		{
+			name: "non-forward action",
+			action: Action{
+				Type: ActionTypeFixedResponse,
+				FixedResponseConfig: &FixedResponseActionConfig{
+					StatusCode: "503",
+				},
+			},
+			ts:      buildTrafficShift(awssdk.Int32(30)),
+			wantErr: errors.New("trafficShift awesome-ns/shift requires action action to be forward action"),
+		},
+		{
+			name: "forward action without canaryService",
+			action: Action{
+				Type: ActionTypeForward,
+				ForwardConfig: &ForwardActionConfig{
+					TargetGroups: []TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("svc-stable"),
+							ServicePort: &portHTTP,
+						},
+					},
+				},
+			},
+			ts:      buildTrafficShift(awssdk.Int32(30)),
+			wantErr: errors.New("trafficShift awesome-ns/shift requires action action to forward to both stableService svc-stable and canaryService svc-canary"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := tt.action
+			err := applyTrafficShiftWeights(&action, tt.ts)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAction, action)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package k8s
+
+import (
+	discovery "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sdiscovery "k8s.io/client-go/discovery"
+)
+
+const (
+	endpointSliceKind = "EndpointSlice"
+)
+
+// IsEndpointSliceAPIAvailable checks whether the discovery.k8s.io/v1 EndpointSlice API is served by the cluster.
+func IsEndpointSliceAPIAvailable(discoveryClient k8sdiscovery.DiscoveryInterface) (bool, error) {
+	return isResourceKindAvailable(discoveryClient, discovery.SchemeGroupVersion.String(), endpointSliceKind)
+}
+
+// isResourceKindAvailable checks whether specific kind is served under groupVersion by the cluster.
+func isResourceKindAvailable(discoveryClient k8sdiscovery.DiscoveryInterface, groupVersion string, kind string) (bool, error) {
+	resList, err := discoveryClient.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, res := range resList.APIResources {
+		if res.Kind == kind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,85 @@
+package k8s
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sdiscovery "k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestIsEndpointSliceAPIAvailable(t *testing.T) {
+	tests := []struct {
+		name         string
+		resources    []*metav1.APIResourceList
+		discoveryErr error
+		want         bool
+		wantErr      error
+	}{
+		{
+			name: "EndpointSlice is served",
+			resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "discovery.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						{
+							Name: "endpointslices",
+							Kind: "EndpointSlice",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "EndpointSlice is not served under groupVersion",
+			resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "discovery.k8s.io/v1",
+					APIResources: nil,
+				},
+			},
+			want: false,
+		},
+		{
+			name:         "groupVersion is not served",
+			discoveryErr: apierrors.NewNotFound(schema.GroupResource{Group: "discovery.k8s.io"}, "v1"),
+			want:         false,
+		},
+		{
+			name:         "discovery failed",
+			discoveryErr: errors.New("some error"),
+			wantErr:      errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var discoveryClient k8sdiscovery.DiscoveryInterface = &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: tt.resources}}
+			if tt.discoveryErr != nil {
+				discoveryClient = &erroredDiscovery{DiscoveryInterface: discoveryClient, err: tt.discoveryErr}
+			}
+			got, err := IsEndpointSliceAPIAvailable(discoveryClient)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+// erroredDiscovery is a discovery client that fails ServerResourcesForGroupVersion calls with err.
+type erroredDiscovery struct {
+	k8sdiscovery.DiscoveryInterface
+	err error
+}
+
+func (d *erroredDiscovery) ServerResourcesForGroupVersion(_ string) (*metav1.APIResourceList, error) {
+	return nil, d.err
+}
@@ -9,7 +9,11 @@ const (
 	IngressEventReasonFailedUpdateStatus      = "FailedUpdateStatus"
 	IngressEventReasonFailedBuildModel        = "FailedBuildModel"
 	IngressEventReasonFailedDeployModel       = "FailedDeployModel"
+	IngressEventReasonFailedPlanModel         = "FailedPlanModel"
+	IngressEventReasonPlannedChanges          = "PlannedChanges"
 	IngressEventReasonSuccessfullyReconciled  = "SuccessfullyReconciled"
+	IngressEventReasonDeletionProtected       = "DeletionProtected"
+	IngressEventReasonFailedDeletionPolicy    = "FailedDeletionPolicy"
 
 	// Service events
 	ServiceEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
@@ -17,13 +21,61 @@ const (
 	ServiceEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
 	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
 	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
+	ServiceEventReasonFailedPlanModel        = "FailedPlanModel"
+	ServiceEventReasonPlannedChanges         = "PlannedChanges"
 	ServiceEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	ServiceEventReasonDeletionProtected      = "DeletionProtected"
+	ServiceEventReasonFailedDeletionPolicy   = "FailedDeletionPolicy"
+
+	// Gateway events
+	GatewayEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	GatewayEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
+	GatewayEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
+	GatewayEventReasonFailedLoadRoutes       = "FailedLoadRoutes"
+	GatewayEventReasonFailedBuildModel       = "FailedBuildModel"
+	GatewayEventReasonFailedDeployModel      = "FailedDeployModel"
+	GatewayEventReasonGatewayClassNotFound   = "GatewayClassNotFound"
+	GatewayEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
 
 	// TargetGroupBinding events
-	TargetGroupBindingEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
-	TargetGroupBindingEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
-	TargetGroupBindingEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
-	TargetGroupBindingEventReasonFailedCleanup          = "FailedCleanup"
-	TargetGroupBindingEventReasonBackendNotFound        = "BackendNotFound"
-	TargetGroupBindingEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	TargetGroupBindingEventReasonFailedAddFinalizer       = "FailedAddFinalizer"
+	TargetGroupBindingEventReasonFailedRemoveFinalizer    = "FailedRemoveFinalizer"
+	TargetGroupBindingEventReasonFailedUpdateStatus       = "FailedUpdateStatus"
+	TargetGroupBindingEventReasonFailedCleanup            = "FailedCleanup"
+	TargetGroupBindingEventReasonBackendNotFound          = "BackendNotFound"
+	TargetGroupBindingEventReasonFailedResolveTargetGroup = "FailedResolveTargetGroup"
+	TargetGroupBindingEventReasonSuccessfullyReconciled   = "SuccessfullyReconciled"
+
+	// TrafficShift events
+	TrafficShiftEventReasonFailedUpdateStatus = "FailedUpdateStatus"
+	TrafficShiftEventReasonFailedAnalysis     = "FailedAnalysis"
+	TrafficShiftEventReasonStepProgressed     = "StepProgressed"
+	TrafficShiftEventReasonSucceeded          = "Succeeded"
+	TrafficShiftEventReasonRolledBack         = "RolledBack"
+
+	// ListenerRule events
+	ListenerRuleEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	ListenerRuleEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
+	ListenerRuleEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
+	ListenerRuleEventReasonFailedBuildModel       = "FailedBuildModel"
+	ListenerRuleEventReasonFailedDeployModel      = "FailedDeployModel"
+	ListenerRuleEventReasonFailedCleanup          = "FailedCleanup"
+	ListenerRuleEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+
+	// TargetGroup events
+	TargetGroupEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	TargetGroupEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
+	TargetGroupEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
+	TargetGroupEventReasonFailedBuildModel       = "FailedBuildModel"
+	TargetGroupEventReasonFailedDeployModel      = "FailedDeployModel"
+	TargetGroupEventReasonFailedCleanup          = "FailedCleanup"
+	TargetGroupEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+
+	// Orphan GC events
+	OrphanGCEventReasonOrphanedResource             = "OrphanedResource"
+	OrphanGCEventReasonDeletedOrphanedResource      = "DeletedOrphanedResource"
+	OrphanGCEventReasonFailedDeleteOrphanedResource = "FailedDeleteOrphanedResource"
+
+	// Subnet events
+	SubnetEventReasonSubnetsDiscovered = "SubnetsDiscovered"
 )
@@ -0,0 +1,147 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileStage is the stage of reconciliation for an Ingress group or Service.
+type ReconcileStage string
+
+const (
+	ReconcileStageLoadGroup       ReconcileStage = "LoadGroup"
+	ReconcileStageAddFinalizer    ReconcileStage = "AddFinalizer"
+	ReconcileStageBuildModel      ReconcileStage = "BuildModel"
+	ReconcileStageDeployModel     ReconcileStage = "DeployModel"
+	ReconcileStageUpdateStatus    ReconcileStage = "UpdateStatus"
+	ReconcileStageRemoveFinalizer ReconcileStage = "RemoveFinalizer"
+)
+
+// ReconcileStatus is the machine readable result of the latest reconciliation for an object.
+// It's persisted as JSON annotation on the object, so that it can be consumed by tools like Argo CD or Flux.
+type ReconcileStatus struct {
+	// the generation of object observed by the latest reconciliation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// whether the latest reconciliation succeeded.
+	Succeeded bool `json:"succeeded"`
+
+	// the time since the latest successful reconciliation observed the current generation and load balancer.
+	// it's not bumped on every reconciliation, so that objects are only patched when the status actually changes.
+	// +optional
+	LastSuccessfulReconcileTime *metav1.Time `json:"lastSuccessfulReconcileTime,omitempty"`
+
+	// the ARN of load balancer provisioned by the latest successful reconciliation.
+	// +optional
+	LoadBalancerARN string `json:"loadBalancerARN,omitempty"`
+
+	// the stage the latest reconciliation failed at.
+	// +optional
+	FailedStage ReconcileStage `json:"failedStage,omitempty"`
+
+	// the error message of the latest failed reconciliation.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ReconcileStatusManager persists reconcile status onto k8s objects.
+type ReconcileStatusManager interface {
+	// RecordSuccess records a successful reconciliation that provisioned load balancer with lbARN.
+	RecordSuccess(ctx context.Context, obj client.Object, lbARN string) error
+
+	// RecordFailure records a failed reconciliation at stage with reconcileErr.
+	// the last successful reconcile time and load balancer ARN are preserved.
+	RecordFailure(ctx context.Context, obj client.Object, stage ReconcileStage, reconcileErr error) error
+}
+
+// NewDefaultReconcileStatusManager constructs new defaultReconcileStatusManager that persists status into annotationKey.
+func NewDefaultReconcileStatusManager(k8sClient client.Client, annotationKey string, logger logr.Logger) *defaultReconcileStatusManager {
+	return &defaultReconcileStatusManager{
+		k8sClient:     k8sClient,
+		annotationKey: annotationKey,
+		logger:        logger,
+	}
+}
+
+var _ ReconcileStatusManager = &defaultReconcileStatusManager{}
+
+// default implementation for ReconcileStatusManager.
+type defaultReconcileStatusManager struct {
+	k8sClient     client.Client
+	annotationKey string
+	logger        logr.Logger
+}
+
+func (m *defaultReconcileStatusManager) RecordSuccess(ctx context.Context, obj client.Object, lbARN string) error {
+	status := ReconcileStatus{
+		ObservedGeneration: obj.GetGeneration(),
+		Succeeded:          true,
+		LoadBalancerARN:    lbARN,
+	}
+	// the existing time is preserved if nothing else changed, which leaves the object untouched.
+	if existingStatus, exists := m.loadReconcileStatus(obj); exists && existingStatus.LastSuccessfulReconcileTime != nil {
+		existingStatus.LastSuccessfulReconcileTime = nil
+		if existingStatus == status {
+			return nil
+		}
+	}
+	now := metav1.Now()
+	status.LastSuccessfulReconcileTime = &now
+	return m.updateReconcileStatus(ctx, obj, status)
+}
+
+func (m *defaultReconcileStatusManager) RecordFailure(ctx context.Context, obj client.Object, stage ReconcileStage, reconcileErr error) error {
+	status := ReconcileStatus{
+		ObservedGeneration: obj.GetGeneration(),
+		Succeeded:          false,
+		FailedStage:        stage,
+		Message:            reconcileErr.Error(),
+	}
+	if existingStatus, exists := m.loadReconcileStatus(obj); exists {
+		status.LastSuccessfulReconcileTime = existingStatus.LastSuccessfulReconcileTime
+		status.LoadBalancerARN = existingStatus.LoadBalancerARN
+	}
+	return m.updateReconcileStatus(ctx, obj, status)
+}
+
+// loadReconcileStatus loads the existing reconcile status from object if any.
+// malformed status is ignored since it will be overwritten anyway.
+func (m *defaultReconcileStatusManager) loadReconcileStatus(obj client.Object) (ReconcileStatus, bool) {
+	rawStatus, exists := obj.GetAnnotations()[m.annotationKey]
+	if !exists {
+		return ReconcileStatus{}, false
+	}
+	var status ReconcileStatus
+	if err := json.Unmarshal([]byte(rawStatus), &status); err != nil {
+		m.logger.V(1).Info("ignoring malformed reconcile status", "object", NamespacedName(obj), "status", rawStatus)
+		return ReconcileStatus{}, false
+	}
+	return status, true
+}
+
+func (m *defaultReconcileStatusManager) updateReconcileStatus(ctx context.Context, obj client.Object, status ReconcileStatus) error {
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	rawStatus := string(payload)
+	if obj.GetAnnotations()[m.annotationKey] == rawStatus {
+		return nil
+	}
+
+	oldObj := obj.DeepCopyObject().(client.Object)
+	objAnnotations := obj.GetAnnotations()
+	if objAnnotations == nil {
+		objAnnotations = make(map[string]string)
+	}
+	objAnnotations[m.annotationKey] = rawStatus
+	obj.SetAnnotations(objAnnotations)
+	if err := m.k8sClient.Patch(ctx, obj, client.MergeFrom(oldObj)); err != nil {
+		return errors.Wrapf(err, "failed to update reconcile status: %v", NamespacedName(obj))
+	}
+	return nil
+}
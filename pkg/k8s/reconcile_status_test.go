@@ -0,0 +1,229 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"testing"
+	"time"
+)
+
+const testReconcileStatusAnnotation = "ingress.k8s.aws/reconcile-status"
+
+func Test_defaultReconcileStatusManager_RecordSuccess(t *testing.T) {
+	tests := []struct {
+		name  string
+		obj   *networking.Ingress
+		lbARN string
+		want  ReconcileStatus
+	}{
+		{
+			name: "ingress without status",
+			obj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 3,
+				},
+			},
+			lbARN: "my-lb-arn",
+			want: ReconcileStatus{
+				ObservedGeneration: 3,
+				Succeeded:          true,
+				LoadBalancerARN:    "my-lb-arn",
+			},
+		},
+		{
+			name: "ingress with failed status",
+			obj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 4,
+					Annotations: map[string]string{
+						testReconcileStatusAnnotation: `{"observedGeneration":3,"succeeded":false,"failedStage":"DeployModel","message":"some error"}`,
+					},
+				},
+			},
+			lbARN: "my-lb-arn",
+			want: ReconcileStatus{
+				ObservedGeneration: 4,
+				Succeeded:          true,
+				LoadBalancerARN:    "my-lb-arn",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			m := NewDefaultReconcileStatusManager(k8sClient, testReconcileStatusAnnotation, &log.NullLogger{})
+
+			err := k8sClient.Create(ctx, tt.obj.DeepCopy())
+			assert.NoError(t, err)
+
+			err = m.RecordSuccess(ctx, tt.obj, tt.lbARN)
+			assert.NoError(t, err)
+
+			got := loadTestReconcileStatus(t, ctx, k8sClient, tt.obj)
+			assert.NotNil(t, got.LastSuccessfulReconcileTime)
+			got.LastSuccessfulReconcileTime = nil
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReconcileStatusManager_RecordSuccess_withUnchangedStatus(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+	m := NewDefaultReconcileStatusManager(k8sClient, testReconcileStatusAnnotation, &log.NullLogger{})
+
+	rawStatus := `{"observedGeneration":3,"succeeded":true,"lastSuccessfulReconcileTime":"2021-03-28T11:11:11Z","loadBalancerARN":"my-lb-arn"}`
+	obj := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "awesome-ns",
+			Name:       "ing-1",
+			Generation: 3,
+			Annotations: map[string]string{
+				testReconcileStatusAnnotation: rawStatus,
+			},
+		},
+	}
+	err := k8sClient.Create(ctx, obj)
+	assert.NoError(t, err)
+	resourceVersion := obj.ResourceVersion
+
+	// reconciling again with the same generation and load balancer doesn't patch the object.
+	err = m.RecordSuccess(ctx, obj, "my-lb-arn")
+	assert.NoError(t, err)
+	gotObj := &networking.Ingress{}
+	err = k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), gotObj)
+	assert.NoError(t, err)
+	assert.Equal(t, resourceVersion, gotObj.ResourceVersion)
+	assert.Equal(t, rawStatus, gotObj.Annotations[testReconcileStatusAnnotation])
+
+	// a new load balancer bumps the time.
+	err = m.RecordSuccess(ctx, obj, "my-new-lb-arn")
+	assert.NoError(t, err)
+	got := loadTestReconcileStatus(t, ctx, k8sClient, obj)
+	assert.Equal(t, "my-new-lb-arn", got.LoadBalancerARN)
+	assert.True(t, got.LastSuccessfulReconcileTime.After(time.Date(2021, 3, 28, 11, 11, 11, 0, time.UTC)))
+}
+
+func Test_defaultReconcileStatusManager_RecordFailure(t *testing.T) {
+	lastSuccessfulReconcileTime := metav1.NewTime(time.Date(2021, 03, 28, 11, 11, 11, 0, time.UTC))
+	tests := []struct {
+		name         string
+		obj          *networking.Ingress
+		stage        ReconcileStage
+		reconcileErr error
+		want         ReconcileStatus
+	}{
+		{
+			name: "ingress without status",
+			obj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 1,
+				},
+			},
+			stage:        ReconcileStageBuildModel,
+			reconcileErr: errors.New("some error"),
+			want: ReconcileStatus{
+				ObservedGeneration: 1,
+				Succeeded:          false,
+				FailedStage:        ReconcileStageBuildModel,
+				Message:            "some error",
+			},
+		},
+		{
+			name: "ingress with succeeded status",
+			obj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 2,
+					Annotations: map[string]string{
+						testReconcileStatusAnnotation: `{"observedGeneration":1,"succeeded":true,"lastSuccessfulReconcileTime":"2021-03-28T11:11:11Z","loadBalancerARN":"my-lb-arn"}`,
+					},
+				},
+			},
+			stage:        ReconcileStageDeployModel,
+			reconcileErr: errors.New("some error"),
+			want: ReconcileStatus{
+				ObservedGeneration:          2,
+				Succeeded:                   false,
+				LastSuccessfulReconcileTime: &lastSuccessfulReconcileTime,
+				LoadBalancerARN:             "my-lb-arn",
+				FailedStage:                 ReconcileStageDeployModel,
+				Message:                     "some error",
+			},
+		},
+		{
+			name: "ingress with malformed status",
+			obj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 2,
+					Annotations: map[string]string{
+						testReconcileStatusAnnotation: `{malformed`,
+					},
+				},
+			},
+			stage:        ReconcileStageUpdateStatus,
+			reconcileErr: errors.New("some error"),
+			want: ReconcileStatus{
+				ObservedGeneration: 2,
+				Succeeded:          false,
+				FailedStage:        ReconcileStageUpdateStatus,
+				Message:            "some error",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			m := NewDefaultReconcileStatusManager(k8sClient, testReconcileStatusAnnotation, &log.NullLogger{})
+
+			err := k8sClient.Create(ctx, tt.obj.DeepCopy())
+			assert.NoError(t, err)
+
+			err = m.RecordFailure(ctx, tt.obj, tt.stage, tt.reconcileErr)
+			assert.NoError(t, err)
+
+			got := loadTestReconcileStatus(t, ctx, k8sClient, tt.obj)
+			if tt.want.LastSuccessfulReconcileTime != nil {
+				assert.True(t, tt.want.LastSuccessfulReconcileTime.Equal(got.LastSuccessfulReconcileTime))
+				got.LastSuccessfulReconcileTime = tt.want.LastSuccessfulReconcileTime
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func loadTestReconcileStatus(t *testing.T, ctx context.Context, k8sClient client.Client, obj *networking.Ingress) ReconcileStatus {
+	gotObj := &networking.Ingress{}
+	err := k8sClient.Get(ctx, NamespacedName(obj), gotObj)
+	assert.NoError(t, err)
+	var status ReconcileStatus
+	err = json.Unmarshal([]byte(gotObj.Annotations[testReconcileStatusAnnotation]), &status)
+	assert.NoError(t, err)
+	return status
+}
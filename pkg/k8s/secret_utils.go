@@ -0,0 +1,70 @@
+package k8s
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TLSCertificate contains the PEM encoded materials of a TLS certificate.
+type TLSCertificate struct {
+	// PEM encoded server certificate.
+	Certificate string
+	// PEM encoded intermediate certificates, empty if there is none.
+	CertificateChain string
+	// PEM encoded private key.
+	PrivateKey string
+}
+
+// Checksum returns the checksum of TLS certificate materials.
+func (c TLSCertificate) Checksum() string {
+	checksum := sha256.New()
+	_, _ = checksum.Write([]byte(c.Certificate))
+	_, _ = checksum.Write([]byte(c.CertificateChain))
+	_, _ = checksum.Write([]byte(c.PrivateKey))
+	return hex.EncodeToString(checksum.Sum(nil))
+}
+
+// ExtractTLSCertificate extracts the TLS certificate from secret with `tls.crt` and `tls.key` keys.
+// The first certificate in `tls.crt` is the server certificate, and the remaining ones are the certificate chain.
+func ExtractTLSCertificate(secret *corev1.Secret) (TLSCertificate, error) {
+	rawCert, ok := secret.Data[corev1.TLSCertKey]
+	if !ok {
+		return TLSCertificate{}, errors.Errorf("%v is missing in secret %v", corev1.TLSCertKey, NamespacedName(secret))
+	}
+	rawKey, ok := secret.Data[corev1.TLSPrivateKeyKey]
+	if !ok {
+		return TLSCertificate{}, errors.Errorf("%v is missing in secret %v", corev1.TLSPrivateKeyKey, NamespacedName(secret))
+	}
+
+	var certBlocks []*pem.Block
+	for rest := rawCert; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			certBlocks = append(certBlocks, block)
+		}
+	}
+	if len(certBlocks) == 0 {
+		return TLSCertificate{}, errors.Errorf("no PEM encoded certificate found in %v of secret %v", corev1.TLSCertKey, NamespacedName(secret))
+	}
+	if keyBlock, _ := pem.Decode(rawKey); keyBlock == nil {
+		return TLSCertificate{}, errors.Errorf("no PEM encoded private key found in %v of secret %v", corev1.TLSPrivateKeyKey, NamespacedName(secret))
+	}
+
+	var chain bytes.Buffer
+	for _, block := range certBlocks[1:] {
+		_ = pem.Encode(&chain, block)
+	}
+	return TLSCertificate{
+		Certificate:      string(pem.EncodeToMemory(certBlocks[0])),
+		CertificateChain: chain.String(),
+		PrivateKey:       string(rawKey),
+	}, nil
+}
@@ -0,0 +1,356 @@
+package listenerrule
+
+import (
+	"context"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// resource ID of the rule created for ListenerRule.
+	listenerRuleResourceID = "rule"
+	// the tag key of the cluster that manages the LoadBalancer.
+	clusterNameTagKey = "elbv2.k8s.aws/cluster"
+)
+
+func (t *defaultModelBuildTask) buildListenerRule(ctx context.Context) (*elbv2model.ListenerRule, error) {
+	lsARN := t.lr.Spec.ListenerARN
+	if err := t.resolveSharedListener(ctx, lsARN); err != nil {
+		return nil, errors.Wrapf(err, "listenerRule: %v", k8s.NamespacedName(t.lr))
+	}
+	sdkLRs, err := t.elbv2TaggingManager.ListListenerRules(ctx, lsARN)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := t.buildListenerRulePriority(ctx, lsARN, sdkLRs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listenerRule: %v", k8s.NamespacedName(t.lr))
+	}
+	conditions, err := t.buildRuleConditions(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listenerRule: %v", k8s.NamespacedName(t.lr))
+	}
+	action, err := t.buildAction(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listenerRule: %v", k8s.NamespacedName(t.lr))
+	}
+	tags, err := t.buildListenerRuleTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lrSpec := elbv2model.ListenerRuleSpec{
+		ListenerARN: core.LiteralStringToken(lsARN),
+		Priority:    priority,
+		Actions:     []elbv2model.Action{action},
+		Conditions:  conditions,
+		Tags:        tags,
+	}
+	return elbv2model.NewListenerRule(t.stack, listenerRuleResourceID, lrSpec), nil
+}
+
+// resolveSharedListener validates the shared listener is an allowed HTTP or HTTPS listener of an Application Load Balancer
+// within cluster's VPC that isn't managed by the controller, and resolves the securityGroups of its LoadBalancer.
+func (t *defaultModelBuildTask) resolveSharedListener(ctx context.Context, lsARN string) error {
+	if !t.allowedListenerARNs.Has(lsARN) {
+		return errors.Errorf("listener isn't allowed: %v", lsARN)
+	}
+	sdkLSs, err := t.elbv2Client.DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{
+		ListenerArns: awssdk.StringSlice([]string{lsARN}),
+	})
+	if err != nil {
+		return err
+	}
+	if len(sdkLSs) == 0 {
+		return errors.Errorf("listener not found: %v", lsARN)
+	}
+	sdkLS := sdkLSs[0]
+	switch awssdk.StringValue(sdkLS.Protocol) {
+	case elbv2sdk.ProtocolEnumHttp, elbv2sdk.ProtocolEnumHttps:
+	default:
+		return errors.Errorf("listener protocol must be within [%v, %v]: %v",
+			elbv2sdk.ProtocolEnumHttp, elbv2sdk.ProtocolEnumHttps, awssdk.StringValue(sdkLS.Protocol))
+	}
+
+	sdkLBs, err := t.elbv2Client.DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: []*string{sdkLS.LoadBalancerArn},
+	})
+	if err != nil {
+		return err
+	}
+	if len(sdkLBs) == 0 {
+		return errors.Errorf("loadBalancer not found: %v", awssdk.StringValue(sdkLS.LoadBalancerArn))
+	}
+	sdkLB := sdkLBs[0]
+	if awssdk.StringValue(sdkLB.VpcId) != t.vpcID {
+		return errors.Errorf("loadBalancer %v must be within cluster's VPC %v: %v",
+			awssdk.StringValue(sdkLB.LoadBalancerArn), t.vpcID, awssdk.StringValue(sdkLB.VpcId))
+	}
+	if err := t.validateLoadBalancerNotManaged(ctx, awssdk.StringValue(sdkLB.LoadBalancerArn)); err != nil {
+		return err
+	}
+	t.securityGroupIDs = awssdk.StringValueSlice(sdkLB.SecurityGroups)
+	return nil
+}
+
+// validateLoadBalancerNotManaged validates the LoadBalancer isn't managed by Ingress, Service or Gateway stacks of
+// any controller, which are tagged with the cluster tag.
+// Rules on listeners of managed LoadBalancers would be removed or conflict with rules synthesized by their stacks.
+func (t *defaultModelBuildTask) validateLoadBalancerNotManaged(ctx context.Context, lbARN string) error {
+	resp, err := t.elbv2Client.DescribeTagsWithContext(ctx, &elbv2sdk.DescribeTagsInput{
+		ResourceArns: awssdk.StringSlice([]string{lbARN}),
+	})
+	if err != nil {
+		return err
+	}
+	for _, tagDescription := range resp.TagDescriptions {
+		for _, tag := range tagDescription.Tags {
+			if awssdk.StringValue(tag.Key) == clusterNameTagKey {
+				return errors.Errorf("loadBalancer %v is managed by cluster %v",
+					lbARN, awssdk.StringValue(tag.Value))
+			}
+		}
+	}
+	return nil
+}
+
+// validateTargetGroupWithinVPC validates the TargetGroup referenced by ARN is within cluster's VPC.
+// rules can only forward to TargetGroups within the VPC of the listener's LoadBalancer, which has been validated to be cluster's VPC.
+func (t *defaultModelBuildTask) validateTargetGroupWithinVPC(ctx context.Context, tgARN string) error {
+	sdkTGs, err := t.elbv2Client.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+	})
+	if err != nil {
+		return err
+	}
+	if len(sdkTGs) == 0 {
+		return errors.Errorf("targetGroup not found: %v", tgARN)
+	}
+	if awssdk.StringValue(sdkTGs[0].VpcId) != t.vpcID {
+		return errors.Errorf("targetGroup %v must be within cluster's VPC %v: %v",
+			tgARN, t.vpcID, awssdk.StringValue(sdkTGs[0].VpcId))
+	}
+	return nil
+}
+
+// buildListenerRulePriority allocates the priority of rule.
+// the priority of existing rule for this ListenerRule is kept if it's still within the configured range,
+// otherwise the lowest priority within the range that isn't used by any rule on the listener is allocated.
+func (t *defaultModelBuildTask) buildListenerRulePriority(_ context.Context, lsARN string, sdkLRs []elbv2deploy.ListenerRuleWithTags) (int64, error) {
+	stackTags := t.trackingProvider.StackTags(t.stack)
+	usedPriorities := sets.NewInt64()
+	for _, sdkLR := range sdkLRs {
+		if awssdk.BoolValue(sdkLR.ListenerRule.IsDefault) {
+			continue
+		}
+		priority, err := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+		if err != nil {
+			continue
+		}
+		if algorithm.IsStringMapSubset(stackTags, sdkLR.Tags) && priority >= t.minPriority && priority <= t.maxPriority {
+			return priority, nil
+		}
+		usedPriorities.Insert(priority)
+	}
+	for priority := t.minPriority; priority <= t.maxPriority; priority++ {
+		if !usedPriorities.Has(priority) {
+			return priority, nil
+		}
+	}
+	return 0, errors.Errorf("no priority available within [%v, %v] on listener: %v", t.minPriority, t.maxPriority, lsARN)
+}
+
+func (t *defaultModelBuildTask) buildRuleConditions(_ context.Context) ([]elbv2model.RuleCondition, error) {
+	conditions := make([]elbv2model.RuleCondition, 0, len(t.lr.Spec.Conditions))
+	for _, condition := range t.lr.Spec.Conditions {
+		modelCondition, err := buildRuleCondition(condition)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, modelCondition)
+	}
+	return conditions, nil
+}
+
+func (t *defaultModelBuildTask) buildAction(ctx context.Context) (elbv2model.Action, error) {
+	action := t.lr.Spec.Action
+	switch action.Type {
+	case elbv2api.RuleActionTypeFixedResponse:
+		if action.FixedResponseConfig == nil {
+			return elbv2model.Action{}, errors.New("missing FixedResponseConfig")
+		}
+		return elbv2model.Action{
+			Type: elbv2model.ActionTypeFixedResponse,
+			FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+				ContentType: action.FixedResponseConfig.ContentType,
+				MessageBody: action.FixedResponseConfig.MessageBody,
+				StatusCode:  action.FixedResponseConfig.StatusCode,
+			},
+		}, nil
+	case elbv2api.RuleActionTypeRedirect:
+		if action.RedirectConfig == nil {
+			return elbv2model.Action{}, errors.New("missing RedirectConfig")
+		}
+		return elbv2model.Action{
+			Type: elbv2model.ActionTypeRedirect,
+			RedirectConfig: &elbv2model.RedirectActionConfig{
+				Host:       action.RedirectConfig.Host,
+				Path:       action.RedirectConfig.Path,
+				Port:       action.RedirectConfig.Port,
+				Protocol:   action.RedirectConfig.Protocol,
+				Query:      action.RedirectConfig.Query,
+				StatusCode: action.RedirectConfig.StatusCode,
+			},
+		}, nil
+	case elbv2api.RuleActionTypeForward:
+		return t.buildForwardAction(ctx, action)
+	}
+	return elbv2model.Action{}, errors.Errorf("unknown action type: %v", action.Type)
+}
+
+func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, action elbv2api.RuleAction) (elbv2model.Action, error) {
+	if action.TargetGroupARN != nil {
+		if err := t.validateTargetGroupWithinVPC(ctx, *action.TargetGroupARN); err != nil {
+			return elbv2model.Action{}, err
+		}
+		return elbv2model.Action{
+			Type: elbv2model.ActionTypeForward,
+			ForwardConfig: &elbv2model.ForwardActionConfig{
+				TargetGroups: []elbv2model.TargetGroupTuple{
+					{
+						TargetGroupARN: core.LiteralStringToken(*action.TargetGroupARN),
+					},
+				},
+			},
+		}, nil
+	}
+	if action.ForwardConfig == nil {
+		return elbv2model.Action{}, errors.New("missing ForwardConfig")
+	}
+
+	var targetGroupTuples []elbv2model.TargetGroupTuple
+	for _, tgt := range action.ForwardConfig.TargetGroups {
+		var tgARN core.StringToken
+		switch {
+		case tgt.TargetGroupARN != nil && (tgt.ServiceName != nil || tgt.ServicePort != nil):
+			return elbv2model.Action{}, errors.New("targetGroupARN cannot be specified together with serviceName or servicePort")
+		case tgt.TargetGroupARN != nil:
+			if err := t.validateTargetGroupWithinVPC(ctx, *tgt.TargetGroupARN); err != nil {
+				return elbv2model.Action{}, err
+			}
+			tgARN = core.LiteralStringToken(*tgt.TargetGroupARN)
+		case tgt.ServiceName != nil && tgt.ServicePort != nil:
+			svcKey := types.NamespacedName{
+				Namespace: t.lr.Namespace,
+				Name:      *tgt.ServiceName,
+			}
+			tg, err := t.buildTargetGroup(ctx, svcKey, *tgt.ServicePort)
+			if err != nil {
+				return elbv2model.Action{}, err
+			}
+			tgARN = tg.TargetGroupARN()
+		default:
+			return elbv2model.Action{}, errors.New("either targetGroupARN or both serviceName and servicePort must be specified")
+		}
+		targetGroupTuples = append(targetGroupTuples, elbv2model.TargetGroupTuple{
+			TargetGroupARN: tgARN,
+			Weight:         tgt.Weight,
+		})
+	}
+	var stickinessCfg *elbv2model.TargetGroupStickinessConfig
+	if action.ForwardConfig.TargetGroupStickinessConfig != nil {
+		stickinessCfg = &elbv2model.TargetGroupStickinessConfig{
+			Enabled:         action.ForwardConfig.TargetGroupStickinessConfig.Enabled,
+			DurationSeconds: action.ForwardConfig.TargetGroupStickinessConfig.DurationSeconds,
+		}
+	}
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeForward,
+		ForwardConfig: &elbv2model.ForwardActionConfig{
+			TargetGroups:                targetGroupTuples,
+			TargetGroupStickinessConfig: stickinessCfg,
+		},
+	}, nil
+}
+
+// buildListenerRuleTags builds the AWS Tags used for a ListenerRule. e.g. ListenerRule, TargetGroup
+func (t *defaultModelBuildTask) buildListenerRuleTags(_ context.Context) (map[string]string, error) {
+	for tagKey := range t.lr.Spec.Tags {
+		if t.externalManagedTags.Has(tagKey) {
+			return nil, errors.Errorf("failed build tags for ListenerRule %v: external managed tag key %v cannot be specified",
+				k8s.NamespacedName(t.lr).String(), tagKey)
+		}
+	}
+	return algorithm.MergeStringMap(t.lr.Spec.Tags, t.defaultTags), nil
+}
+
+func buildRuleCondition(condition elbv2api.RuleCondition) (elbv2model.RuleCondition, error) {
+	modelCondition := elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionField(condition.Field),
+	}
+	switch condition.Field {
+	case elbv2api.RuleConditionFieldHostHeader:
+		if condition.HostHeaderConfig == nil {
+			return elbv2model.RuleCondition{}, errors.New("missing HostHeaderConfig")
+		}
+		modelCondition.HostHeaderConfig = &elbv2model.HostHeaderConditionConfig{
+			Values: condition.HostHeaderConfig.Values,
+		}
+	case elbv2api.RuleConditionFieldHTTPHeader:
+		if condition.HTTPHeaderConfig == nil {
+			return elbv2model.RuleCondition{}, errors.New("missing HTTPHeaderConfig")
+		}
+		modelCondition.HTTPHeaderConfig = &elbv2model.HTTPHeaderConditionConfig{
+			HTTPHeaderName: condition.HTTPHeaderConfig.HTTPHeaderName,
+			Values:         condition.HTTPHeaderConfig.Values,
+		}
+	case elbv2api.RuleConditionFieldHTTPRequestMethod:
+		if condition.HTTPRequestMethodConfig == nil {
+			return elbv2model.RuleCondition{}, errors.New("missing HTTPRequestMethodConfig")
+		}
+		modelCondition.HTTPRequestMethodConfig = &elbv2model.HTTPRequestMethodConditionConfig{
+			Values: condition.HTTPRequestMethodConfig.Values,
+		}
+	case elbv2api.RuleConditionFieldPathPattern:
+		if condition.PathPatternConfig == nil {
+			return elbv2model.RuleCondition{}, errors.New("missing PathPatternConfig")
+		}
+		modelCondition.PathPatternConfig = &elbv2model.PathPatternConditionConfig{
+			Values: condition.PathPatternConfig.Values,
+		}
+	case elbv2api.RuleConditionFieldQueryString:
+		if condition.QueryStringConfig == nil {
+			return elbv2model.RuleCondition{}, errors.New("missing QueryStringConfig")
+		}
+		pairs := make([]elbv2model.QueryStringKeyValuePair, 0, len(condition.QueryStringConfig.Values))
+		for _, pair := range condition.QueryStringConfig.Values {
+			pairs = append(pairs, elbv2model.QueryStringKeyValuePair{
+				Key:   pair.Key,
+				Value: pair.Value,
+			})
+		}
+		modelCondition.QueryStringConfig = &elbv2model.QueryStringConditionConfig{
+			Values: pairs,
+		}
+	case elbv2api.RuleConditionFieldSourceIP:
+		if condition.SourceIPConfig == nil {
+			return elbv2model.RuleCondition{}, errors.New("missing SourceIPConfig")
+		}
+		modelCondition.SourceIPConfig = &elbv2model.SourceIPConditionConfig{
+			Values: condition.SourceIPConfig.Values,
+		}
+	default:
+		return elbv2model.RuleCondition{}, errors.Errorf("unknown condition field: %v", condition.Field)
+	}
+	return modelCondition, nil
+}
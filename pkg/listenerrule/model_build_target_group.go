@@ -0,0 +1,248 @@
+package listenerrule
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	healthCheckPortTrafficPort = "traffic-port"
+)
+
+func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, svcKey types.NamespacedName, port intstr.IntOrString) (*elbv2model.TargetGroup, error) {
+	tgResID := t.buildTargetGroupResourceID(svcKey, port)
+	if tg, exists := t.tgByResID[tgResID]; exists {
+		return tg, nil
+	}
+	svc, err := t.loadBackendService(ctx, svcKey)
+	if err != nil {
+		return nil, err
+	}
+	tgSpec, err := t.buildTargetGroupSpec(ctx, svc, port)
+	if err != nil {
+		return nil, err
+	}
+	tg := elbv2model.NewTargetGroup(t.stack, tgResID, tgSpec)
+	t.tgByResID[tgResID] = tg
+	_ = t.buildTargetGroupBinding(ctx, tg, svc, port)
+	return tg, nil
+}
+
+func (t *defaultModelBuildTask) loadBackendService(ctx context.Context, svcKey types.NamespacedName) (*corev1.Service, error) {
+	if svc, exists := t.backendServices[svcKey]; exists {
+		return svc, nil
+	}
+	svc := &corev1.Service{}
+	if err := t.k8sClient.Get(ctx, svcKey, svc); err != nil {
+		return nil, errors.Wrapf(err, "failed to load backend service: %v", svcKey)
+	}
+	t.backendServices[svcKey] = svc
+	return svc, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString) *elbv2model.TargetGroupBindingResource {
+	targetType := elbv2api.TargetType(tg.Spec.TargetType)
+	tgbSpec := elbv2model.TargetGroupBindingResourceSpec{
+		Template: elbv2model.TargetGroupBindingTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: svc.Namespace,
+				Name:      tg.Spec.Name,
+			},
+			Spec: elbv2model.TargetGroupBindingSpec{
+				TargetGroupARN: tg.TargetGroupARN(),
+				TargetType:     &targetType,
+				ServiceRef: elbv2api.ServiceReference{
+					Name: svc.Name,
+					Port: port,
+				},
+				Networking: t.buildTargetGroupBindingNetworking(ctx),
+			},
+		},
+	}
+	return elbv2model.NewTargetGroupBindingResource(t.stack, tg.ID(), tgbSpec)
+}
+
+// buildTargetGroupBindingNetworking allows traffic from the securityGroups of shared LoadBalancer to targets.
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Context) *elbv2model.TargetGroupBindingNetworking {
+	if len(t.securityGroupIDs) == 0 {
+		return nil
+	}
+	peers := make([]elbv2model.NetworkingPeer, 0, len(t.securityGroupIDs))
+	for _, sgID := range t.securityGroupIDs {
+		peers = append(peers, elbv2model.NetworkingPeer{
+			SecurityGroup: &elbv2model.SecurityGroup{
+				GroupID: core.LiteralStringToken(sgID),
+			},
+		})
+	}
+	protocolTCP := elbv2api.NetworkingProtocolTCP
+	return &elbv2model.TargetGroupBindingNetworking{
+		Ingress: []elbv2model.NetworkingIngressRule{
+			{
+				From: peers,
+				Ports: []elbv2api.NetworkingPort{
+					{
+						Protocol: &protocolTCP,
+						Port:     nil,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, svc *corev1.Service, port intstr.IntOrString) (elbv2model.TargetGroupSpec, error) {
+	targetType := t.defaultTargetType
+	if t.lr.Spec.TargetType != nil {
+		targetType = elbv2model.TargetType(*t.lr.Spec.TargetType)
+	}
+	tgProtocol, err := t.buildTargetGroupProtocol(ctx, svc.Annotations)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc.Annotations, tgProtocol)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tags, err := t.buildListenerRuleTags(ctx)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	svcPort, err := k8s.LookupServicePort(svc, port)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgPort := t.buildTargetGroupPort(ctx, targetType, svcPort)
+	tgProtocolVersion := elbv2model.ProtocolVersionHTTP1
+	name := t.buildTargetGroupName(ctx, svc, port, tgPort, targetType, tgProtocol)
+	return elbv2model.TargetGroupSpec{
+		Name:              name,
+		TargetType:        targetType,
+		Port:              tgPort,
+		Protocol:          tgProtocol,
+		ProtocolVersion:   &tgProtocolVersion,
+		HealthCheckConfig: &healthCheckConfig,
+		Tags:              tags,
+	}, nil
+}
+
+var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// buildTargetGroupName will calculate the targetGroup's name.
+func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svc *corev1.Service, port intstr.IntOrString, tgPort int64,
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	_, _ = uuidHash.Write([]byte(svc.UID))
+	_, _ = uuidHash.Write([]byte(port.String()))
+	_, _ = uuidHash.Write([]byte(strconv.Itoa(int(tgPort))))
+	_, _ = uuidHash.Write([]byte(targetType))
+	_, _ = uuidHash.Write([]byte(tgProtocol))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(svc.Namespace, "")
+	sanitizedName := invalidTargetGroupNamePattern.ReplaceAllString(svc.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+// buildTargetGroupPort constructs the TargetGroup's port.
+// Note: TargetGroup's port is not in the data path as we always register targets with port specified.
+func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetType elbv2model.TargetType, svcPort corev1.ServicePort) int64 {
+	if targetType == elbv2model.TargetTypeInstance {
+		return int64(svcPort.NodePort)
+	}
+	if svcPort.TargetPort.Type == intstr.Int {
+		return int64(svcPort.TargetPort.IntValue())
+	}
+	return 1
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svcAnnotations map[string]string) (elbv2model.Protocol, error) {
+	rawBackendProtocol := string(t.defaultBackendProtocol)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixBackendProtocol, &rawBackendProtocol, svcAnnotations)
+	switch rawBackendProtocol {
+	case string(elbv2model.ProtocolHTTP):
+		return elbv2model.ProtocolHTTP, nil
+	case string(elbv2model.ProtocolHTTPS):
+		return elbv2model.ProtocolHTTPS, nil
+	default:
+		return "", errors.Errorf("backend protocol must be within [%v, %v]: %v", elbv2model.ProtocolHTTP, elbv2model.ProtocolHTTPS, rawBackendProtocol)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(_ context.Context, svcAnnotations map[string]string, tgProtocol elbv2model.Protocol) (elbv2model.TargetGroupHealthCheckConfig, error) {
+	rawHealthCheckPort := healthCheckPortTrafficPort
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPort, &rawHealthCheckPort, svcAnnotations)
+	healthCheckPort := intstr.Parse(rawHealthCheckPort)
+	if healthCheckPort.Type == intstr.String && rawHealthCheckPort != healthCheckPortTrafficPort {
+		return elbv2model.TargetGroupHealthCheckConfig{}, errors.Errorf("healthCheckPort must be either %v or a port number: %v", healthCheckPortTrafficPort, rawHealthCheckPort)
+	}
+
+	rawHealthCheckProtocol := string(tgProtocol)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckProtocol, &rawHealthCheckProtocol, svcAnnotations)
+	var healthCheckProtocol elbv2model.Protocol
+	switch rawHealthCheckProtocol {
+	case string(elbv2model.ProtocolHTTP):
+		healthCheckProtocol = elbv2model.ProtocolHTTP
+	case string(elbv2model.ProtocolHTTPS):
+		healthCheckProtocol = elbv2model.ProtocolHTTPS
+	default:
+		return elbv2model.TargetGroupHealthCheckConfig{}, errors.Errorf("healthCheckProtocol must be within [%v, %v]", elbv2model.ProtocolHTTP, elbv2model.ProtocolHTTPS)
+	}
+
+	healthCheckPath := t.defaultHealthCheckPath
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPath, &healthCheckPath, svcAnnotations)
+	healthCheckMatcherHTTPCode := t.defaultHealthCheckMatcherHTTPCode
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSuccessCodes, &healthCheckMatcherHTTPCode, svcAnnotations)
+
+	healthCheckIntervalSeconds := t.defaultHealthCheckIntervalSeconds
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckIntervalSeconds,
+		&healthCheckIntervalSeconds, svcAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthCheckTimeoutSeconds := t.defaultHealthCheckTimeoutSeconds
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckTimeoutSeconds,
+		&healthCheckTimeoutSeconds, svcAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthCheckHealthyThresholdCount := t.defaultHealthCheckHealthyThresholdCount
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthyThresholdCount,
+		&healthCheckHealthyThresholdCount, svcAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthCheckUnhealthyThresholdCount := t.defaultHealthCheckUnhealthyThresholdCount
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixUnhealthyThresholdCount,
+		&healthCheckUnhealthyThresholdCount, svcAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	return elbv2model.TargetGroupHealthCheckConfig{
+		Port:                    &healthCheckPort,
+		Protocol:                &healthCheckProtocol,
+		Path:                    &healthCheckPath,
+		Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: &healthCheckMatcherHTTPCode},
+		IntervalSeconds:         &healthCheckIntervalSeconds,
+		TimeoutSeconds:          &healthCheckTimeoutSeconds,
+		HealthyThresholdCount:   &healthCheckHealthyThresholdCount,
+		UnhealthyThresholdCount: &healthCheckUnhealthyThresholdCount,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupResourceID(svcKey types.NamespacedName, port intstr.IntOrString) string {
+	return fmt.Sprintf("%s/%s:%s", svcKey.Namespace, svcKey.Name, port.String())
+}
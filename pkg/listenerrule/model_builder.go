@@ -0,0 +1,152 @@
+package listenerrule
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ModelBuilder is responsible for build mode stack for a ListenerRule.
+type ModelBuilder interface {
+	// build mode stack for a ListenerRule.
+	Build(ctx context.Context, lr *elbv2api.ListenerRule) (core.Stack, *elbv2model.ListenerRule, error)
+}
+
+// NewDefaultModelBuilder constructs new defaultModelBuilder.
+func NewDefaultModelBuilder(k8sClient client.Client, elbv2Client services.ELBV2, annotationParser annotations.Parser,
+	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager, vpcID string,
+	clusterName string, defaultTags map[string]string, externalManagedTags []string,
+	minPriority int64, maxPriority int64, allowedListenerARNs []string, logger logr.Logger) *defaultModelBuilder {
+	return &defaultModelBuilder{
+		k8sClient:           k8sClient,
+		elbv2Client:         elbv2Client,
+		annotationParser:    annotationParser,
+		trackingProvider:    trackingProvider,
+		elbv2TaggingManager: elbv2TaggingManager,
+		vpcID:               vpcID,
+		clusterName:         clusterName,
+		defaultTags:         defaultTags,
+		externalManagedTags: sets.NewString(externalManagedTags...),
+		minPriority:         minPriority,
+		maxPriority:         maxPriority,
+		allowedListenerARNs: sets.NewString(allowedListenerARNs...),
+		logger:              logger,
+	}
+}
+
+var _ ModelBuilder = &defaultModelBuilder{}
+
+// default implementation for ModelBuilder
+type defaultModelBuilder struct {
+	k8sClient           client.Client
+	elbv2Client         services.ELBV2
+	annotationParser    annotations.Parser
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2deploy.TaggingManager
+
+	vpcID               string
+	clusterName         string
+	defaultTags         map[string]string
+	externalManagedTags sets.String
+	minPriority         int64
+	maxPriority         int64
+	allowedListenerARNs sets.String
+
+	logger logr.Logger
+}
+
+// build mode stack for a ListenerRule.
+func (b *defaultModelBuilder) Build(ctx context.Context, lr *elbv2api.ListenerRule) (core.Stack, *elbv2model.ListenerRule, error) {
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(lr)))
+	task := &defaultModelBuildTask{
+		k8sClient:           b.k8sClient,
+		elbv2Client:         b.elbv2Client,
+		annotationParser:    b.annotationParser,
+		trackingProvider:    b.trackingProvider,
+		elbv2TaggingManager: b.elbv2TaggingManager,
+		vpcID:               b.vpcID,
+		clusterName:         b.clusterName,
+		logger:              b.logger,
+
+		lr:    lr,
+		stack: stack,
+
+		defaultTags:                               b.defaultTags,
+		externalManagedTags:                       b.externalManagedTags,
+		minPriority:                               b.minPriority,
+		maxPriority:                               b.maxPriority,
+		allowedListenerARNs:                       b.allowedListenerARNs,
+		defaultTargetType:                         elbv2model.TargetTypeInstance,
+		defaultBackendProtocol:                    elbv2model.ProtocolHTTP,
+		defaultHealthCheckPath:                    "/",
+		defaultHealthCheckIntervalSeconds:         15,
+		defaultHealthCheckTimeoutSeconds:          5,
+		defaultHealthCheckHealthyThresholdCount:   2,
+		defaultHealthCheckUnhealthyThresholdCount: 2,
+		defaultHealthCheckMatcherHTTPCode:         "200",
+
+		listenerRule:    nil,
+		tgByResID:       make(map[string]*elbv2model.TargetGroup),
+		backendServices: make(map[types.NamespacedName]*corev1.Service),
+	}
+	if err := task.run(ctx); err != nil {
+		return nil, nil, err
+	}
+	return task.stack, task.listenerRule, nil
+}
+
+// the default model build task
+type defaultModelBuildTask struct {
+	k8sClient           client.Client
+	elbv2Client         services.ELBV2
+	annotationParser    annotations.Parser
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	vpcID               string
+	clusterName         string
+	logger              logr.Logger
+
+	lr    *elbv2api.ListenerRule
+	stack core.Stack
+
+	defaultTags                               map[string]string
+	externalManagedTags                       sets.String
+	minPriority                               int64
+	maxPriority                               int64
+	allowedListenerARNs                       sets.String
+	defaultTargetType                         elbv2model.TargetType
+	defaultBackendProtocol                    elbv2model.Protocol
+	defaultHealthCheckPath                    string
+	defaultHealthCheckTimeoutSeconds          int64
+	defaultHealthCheckIntervalSeconds         int64
+	defaultHealthCheckHealthyThresholdCount   int64
+	defaultHealthCheckUnhealthyThresholdCount int64
+	defaultHealthCheckMatcherHTTPCode         string
+
+	listenerRule *elbv2model.ListenerRule
+	// securityGroupIDs are the securityGroups of LoadBalancer that owns the listener.
+	securityGroupIDs []string
+	tgByResID        map[string]*elbv2model.TargetGroup
+	backendServices  map[types.NamespacedName]*corev1.Service
+}
+
+func (t *defaultModelBuildTask) run(ctx context.Context) error {
+	lr, err := t.buildListenerRule(ctx)
+	if err != nil {
+		return err
+	}
+	t.listenerRule = lr
+	return nil
+}
@@ -0,0 +1,485 @@
+package listenerrule
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// sharedALBFixture is an externally managed ALB with an HTTP listener and a rule owned by someone else.
+type sharedALBFixture struct {
+	cloud         *fake.Cloud
+	sgID          string
+	albARN        string
+	httpARN       string
+	tcpARN        string
+	tgARN         string
+	otherVPCTGARN string
+}
+
+func newSharedALBFixture(t *testing.T) sharedALBFixture {
+	ctx := context.Background()
+	cloud := fake.NewCloud("us-west-2")
+	var subnetIDs []string
+	for _, subnet := range []struct {
+		cidrBlock string
+		az        string
+	}{
+		{cidrBlock: "192.168.0.0/19", az: "us-west-2a"},
+		{cidrBlock: "192.168.32.0/19", az: "us-west-2b"},
+	} {
+		resp, err := cloud.EC2().CreateSubnetWithContext(ctx, &ec2sdk.CreateSubnetInput{
+			VpcId:            awssdk.String(cloud.VpcID()),
+			CidrBlock:        awssdk.String(subnet.cidrBlock),
+			AvailabilityZone: awssdk.String(subnet.az),
+		})
+		require.NoError(t, err)
+		subnetIDs = append(subnetIDs, awssdk.StringValue(resp.Subnet.SubnetId))
+	}
+	sgResp, err := cloud.EC2().CreateSecurityGroupWithContext(ctx, &ec2sdk.CreateSecurityGroupInput{
+		VpcId:       awssdk.String(cloud.VpcID()),
+		GroupName:   awssdk.String("shared-alb"),
+		Description: awssdk.String("shared alb"),
+	})
+	require.NoError(t, err)
+	sgID := awssdk.StringValue(sgResp.GroupId)
+
+	albResp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+		Name:           awssdk.String("shared-alb"),
+		Type:           awssdk.String(elbv2sdk.LoadBalancerTypeEnumApplication),
+		Subnets:        awssdk.StringSlice(subnetIDs),
+		SecurityGroups: awssdk.StringSlice([]string{sgID}),
+	})
+	require.NoError(t, err)
+	httpResp, err := cloud.ELBV2().CreateListenerWithContext(ctx, &elbv2sdk.CreateListenerInput{
+		LoadBalancerArn: albResp.LoadBalancers[0].LoadBalancerArn,
+		Port:            awssdk.Int64(80),
+		Protocol:        awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		DefaultActions: []*elbv2sdk.Action{
+			{
+				Type: awssdk.String(elbv2sdk.ActionTypeEnumFixedResponse),
+				FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+					StatusCode: awssdk.String("404"),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	httpARN := awssdk.StringValue(httpResp.Listeners[0].ListenerArn)
+	_, err = cloud.ELBV2().CreateRuleWithContext(ctx, &elbv2sdk.CreateRuleInput{
+		ListenerArn: awssdk.String(httpARN),
+		Priority:    awssdk.Int64(40000),
+		Conditions: []*elbv2sdk.RuleCondition{
+			{
+				Field:             awssdk.String("path-pattern"),
+				PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{Values: awssdk.StringSlice([]string{"/other-team"})},
+			},
+		},
+		Actions: []*elbv2sdk.Action{
+			{
+				Type:                awssdk.String(elbv2sdk.ActionTypeEnumFixedResponse),
+				FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{StatusCode: awssdk.String("200")},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	nlbResp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+		Name:    awssdk.String("shared-nlb"),
+		Type:    awssdk.String(elbv2sdk.LoadBalancerTypeEnumNetwork),
+		Subnets: awssdk.StringSlice(subnetIDs),
+	})
+	require.NoError(t, err)
+	tgResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("shared-nlb-tg"),
+		Port:       awssdk.Int64(80),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumTcp),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumIp),
+		VpcId:      awssdk.String(cloud.VpcID()),
+	})
+	require.NoError(t, err)
+	tcpResp, err := cloud.ELBV2().CreateListenerWithContext(ctx, &elbv2sdk.CreateListenerInput{
+		LoadBalancerArn: nlbResp.LoadBalancers[0].LoadBalancerArn,
+		Port:            awssdk.Int64(80),
+		Protocol:        awssdk.String(elbv2sdk.ProtocolEnumTcp),
+		DefaultActions: []*elbv2sdk.Action{
+			{
+				Type:           awssdk.String(elbv2sdk.ActionTypeEnumForward),
+				TargetGroupArn: tgResp.TargetGroups[0].TargetGroupArn,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	otherVPCResp, err := cloud.EC2().CreateVpcWithContext(ctx, &ec2sdk.CreateVpcInput{
+		CidrBlock: awssdk.String("10.0.0.0/16"),
+	})
+	require.NoError(t, err)
+	otherVPCTGResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("other-vpc-tg"),
+		Port:       awssdk.Int64(80),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumIp),
+		VpcId:      otherVPCResp.Vpc.VpcId,
+	})
+	require.NoError(t, err)
+
+	return sharedALBFixture{
+		cloud:         cloud,
+		sgID:          sgID,
+		albARN:        awssdk.StringValue(albResp.LoadBalancers[0].LoadBalancerArn),
+		httpARN:       httpARN,
+		tcpARN:        awssdk.StringValue(tcpResp.Listeners[0].ListenerArn),
+		tgARN:         awssdk.StringValue(tgResp.TargetGroups[0].TargetGroupArn),
+		otherVPCTGARN: awssdk.StringValue(otherVPCTGResp.TargetGroups[0].TargetGroupArn),
+	}
+}
+
+func Test_defaultModelBuilder_Build(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+			UID:       "awesome-svc-uid",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/healthcheck-path": "/healthz",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       80,
+					TargetPort: intstr.FromInt(8080),
+					NodePort:   32768,
+				},
+			},
+		},
+	}
+	buildListenerRule := func(lsARN string) *elbv2api.ListenerRule {
+		servicePort := intstr.FromString("http")
+		return &elbv2api.ListenerRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-rule",
+			},
+			Spec: elbv2api.ListenerRuleSpec{
+				ListenerARN: lsARN,
+				Conditions: []elbv2api.RuleCondition{
+					{
+						Field: elbv2api.RuleConditionFieldPathPattern,
+						PathPatternConfig: &elbv2api.PathPatternConditionConfig{
+							Values: []string{"/awesome"},
+						},
+					},
+				},
+				Action: elbv2api.RuleAction{
+					Type: elbv2api.RuleActionTypeForward,
+					ForwardConfig: &elbv2api.ForwardActionConfig{
+						TargetGroups: []elbv2api.TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("awesome-svc"),
+								ServicePort: &servicePort,
+							},
+						},
+					},
+				},
+				Tags: map[string]string{
+					"team": "awesome-team",
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name                string
+		minPriority         int64
+		maxPriority         int64
+		useTCP              bool
+		vpcID               string
+		albTags             map[string]string
+		allowedListenerARNs func(fixture sharedALBFixture) []string
+		targetGroupARN      func(fixture sharedALBFixture) string
+		wantPriority        int64
+		wantErr             string
+	}{
+		{
+			name:         "allocate lowest free priority on shared listener",
+			minPriority:  40000,
+			maxPriority:  50000,
+			wantPriority: 40001,
+		},
+		{
+			name:        "no free priority on shared listener",
+			minPriority: 40000,
+			maxPriority: 40000,
+			wantErr:     "listenerRule: awesome-ns/awesome-rule: no priority available within [40000, 40000] on listener: ",
+		},
+		{
+			name:        "listener isn't HTTP or HTTPS",
+			minPriority: 40000,
+			maxPriority: 50000,
+			useTCP:      true,
+			wantErr:     "listenerRule: awesome-ns/awesome-rule: listener protocol must be within [HTTP, HTTPS]: TCP",
+		},
+		{
+			name:        "listener is within allowed listeners",
+			minPriority: 40000,
+			maxPriority: 50000,
+			allowedListenerARNs: func(fixture sharedALBFixture) []string {
+				return []string{fixture.httpARN}
+			},
+			wantPriority: 40001,
+		},
+		{
+			name:        "listener isn't within allowed listeners",
+			minPriority: 40000,
+			maxPriority: 50000,
+			allowedListenerARNs: func(fixture sharedALBFixture) []string {
+				return []string{fixture.tcpARN}
+			},
+			wantErr: "listenerRule: awesome-ns/awesome-rule: listener isn't allowed: ",
+		},
+		{
+			name:        "no listener is allowed",
+			minPriority: 40000,
+			maxPriority: 50000,
+			allowedListenerARNs: func(fixture sharedALBFixture) []string {
+				return []string{}
+			},
+			wantErr: "listenerRule: awesome-ns/awesome-rule: listener isn't allowed: ",
+		},
+		{
+			name:        "forward to targetGroup within cluster's VPC",
+			minPriority: 40000,
+			maxPriority: 50000,
+			targetGroupARN: func(fixture sharedALBFixture) string {
+				return fixture.tgARN
+			},
+			wantPriority: 40001,
+		},
+		{
+			name:        "forward to targetGroup outside cluster's VPC",
+			minPriority: 40000,
+			maxPriority: 50000,
+			targetGroupARN: func(fixture sharedALBFixture) string {
+				return fixture.otherVPCTGARN
+			},
+			wantErr: "must be within cluster's VPC",
+		},
+		{
+			name:        "loadBalancer isn't within cluster's VPC",
+			minPriority: 40000,
+			maxPriority: 50000,
+			vpcID:       "vpc-other",
+			wantErr:     "must be within cluster's VPC vpc-other",
+		},
+		{
+			name:        "loadBalancer is managed by controller",
+			minPriority: 40000,
+			maxPriority: 50000,
+			albTags: map[string]string{
+				"elbv2.k8s.aws/cluster":    "other-cluster",
+				"ingress.k8s.aws/stack":    "awesome-group",
+				"ingress.k8s.aws/resource": "LoadBalancer",
+			},
+			wantErr: "is managed by cluster other-cluster",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newSharedALBFixture(t)
+			if len(tt.albTags) != 0 {
+				var sdkTags []*elbv2sdk.Tag
+				for key, value := range tt.albTags {
+					sdkTags = append(sdkTags, &elbv2sdk.Tag{Key: awssdk.String(key), Value: awssdk.String(value)})
+				}
+				_, err := fixture.cloud.ELBV2().AddTagsWithContext(context.Background(), &elbv2sdk.AddTagsInput{
+					ResourceArns: awssdk.StringSlice([]string{fixture.albARN}),
+					Tags:         sdkTags,
+				})
+				require.NoError(t, err)
+			}
+			vpcID := fixture.cloud.VpcID()
+			if tt.vpcID != "" {
+				vpcID = tt.vpcID
+			}
+			lsARN := fixture.httpARN
+			if tt.useTCP {
+				lsARN = fixture.tcpARN
+			}
+			allowedListenerARNs := []string{lsARN}
+			if tt.allowedListenerARNs != nil {
+				allowedListenerARNs = tt.allowedListenerARNs(fixture)
+			}
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema, svc.DeepCopy())
+			logger := &log.NullLogger{}
+			trackingProvider := tracking.NewDefaultProvider("listener-rule.k8s.aws", "cluster-name")
+			builder := NewDefaultModelBuilder(k8sClient, fixture.cloud.ELBV2(),
+				annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress), trackingProvider,
+				elbv2deploy.NewDefaultTaggingManager(fixture.cloud.ELBV2(), logger), vpcID, "cluster-name",
+				map[string]string{"env": "test"}, nil, tt.minPriority, tt.maxPriority, allowedListenerARNs, logger)
+
+			lr := buildListenerRule(lsARN)
+			if tt.targetGroupARN != nil {
+				lr.Spec.Action.ForwardConfig.TargetGroups = []elbv2api.TargetGroupTuple{
+					{TargetGroupARN: awssdk.String(tt.targetGroupARN(fixture))},
+				}
+			}
+			stack, resLR, err := builder.Build(context.Background(), lr)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-rule"}), stack.StackID())
+			assert.Equal(t, tt.wantPriority, resLR.Spec.Priority)
+			assert.Equal(t, core.LiteralStringToken(lsARN), resLR.Spec.ListenerARN)
+			assert.Equal(t, map[string]string{"team": "awesome-team", "env": "test"}, resLR.Spec.Tags)
+			if tt.targetGroupARN != nil {
+				assert.Equal(t, core.LiteralStringToken(tt.targetGroupARN(fixture)), resLR.Spec.Actions[0].ForwardConfig.TargetGroups[0].TargetGroupARN)
+				return
+			}
+
+			var resTGs []*elbv2model.TargetGroup
+			require.NoError(t, stack.ListResources(&resTGs))
+			require.Len(t, resTGs, 1)
+			assert.Equal(t, "awesome-ns/awesome-svc:http", resTGs[0].ID())
+			assert.Equal(t, elbv2model.TargetTypeInstance, resTGs[0].Spec.TargetType)
+			assert.Equal(t, int64(32768), resTGs[0].Spec.Port)
+			assert.Equal(t, "/healthz", awssdk.StringValue(resTGs[0].Spec.HealthCheckConfig.Path))
+
+			var resTGBs []*elbv2model.TargetGroupBindingResource
+			require.NoError(t, stack.ListResources(&resTGBs))
+			require.Len(t, resTGBs, 1)
+			networking := resTGBs[0].Spec.Template.Spec.Networking
+			require.NotNil(t, networking)
+			require.Len(t, networking.Ingress, 1)
+			assert.Equal(t, []elbv2model.NetworkingPeer{
+				{
+					SecurityGroup: &elbv2model.SecurityGroup{GroupID: core.LiteralStringToken(fixture.sgID)},
+				},
+			}, networking.Ingress[0].From)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildListenerRulePriority(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-rule"})
+	trackingProvider := tracking.NewDefaultProvider("listener-rule.k8s.aws", "cluster-name")
+	buildSDKLR := func(priority string, tags map[string]string) elbv2deploy.ListenerRuleWithTags {
+		return elbv2deploy.ListenerRuleWithTags{
+			ListenerRule: &elbv2sdk.Rule{
+				Priority:  awssdk.String(priority),
+				IsDefault: awssdk.Bool(priority == "default"),
+			},
+			Tags: tags,
+		}
+	}
+	stackTags := trackingProvider.StackTags(stack)
+	otherStackTags := map[string]string{
+		"elbv2.k8s.aws/cluster":       "cluster-name",
+		"listener-rule.k8s.aws/stack": "awesome-ns/other-rule",
+	}
+	tests := []struct {
+		name        string
+		minPriority int64
+		maxPriority int64
+		sdkLRs      []elbv2deploy.ListenerRuleWithTags
+		want        int64
+		wantErr     error
+	}{
+		{
+			name:        "empty listener",
+			minPriority: 100,
+			maxPriority: 200,
+			sdkLRs: []elbv2deploy.ListenerRuleWithTags{
+				buildSDKLR("default", nil),
+			},
+			want: 100,
+		},
+		{
+			name:        "priorities used by other rules are skipped",
+			minPriority: 100,
+			maxPriority: 200,
+			sdkLRs: []elbv2deploy.ListenerRuleWithTags{
+				buildSDKLR("100", nil),
+				buildSDKLR("101", otherStackTags),
+				buildSDKLR("103", nil),
+				buildSDKLR("default", nil),
+			},
+			want: 102,
+		},
+		{
+			name:        "priority of existing rule is kept",
+			minPriority: 100,
+			maxPriority: 200,
+			sdkLRs: []elbv2deploy.ListenerRuleWithTags{
+				buildSDKLR("100", nil),
+				buildSDKLR("150", stackTags),
+			},
+			want: 150,
+		},
+		{
+			name:        "priority of existing rule outside range is reallocated",
+			minPriority: 100,
+			maxPriority: 200,
+			sdkLRs: []elbv2deploy.ListenerRuleWithTags{
+				buildSDKLR("100", nil),
+				buildSDKLR("300", stackTags),
+			},
+			want: 101,
+		},
+		{
+			name:        "all priorities used",
+			minPriority: 100,
+			maxPriority: 101,
+			sdkLRs: []elbv2deploy.ListenerRuleWithTags{
+				buildSDKLR("100", nil),
+				buildSDKLR("101", otherStackTags),
+			},
+			wantErr: errors.New("no priority available within [100, 101] on listener: ls-arn"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				trackingProvider: trackingProvider,
+				stack:            stack,
+				minPriority:      tt.minPriority,
+				maxPriority:      tt.maxPriority,
+			}
+			got, err := task.buildListenerRulePriority(context.Background(), "ls-arn", tt.sdkLRs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
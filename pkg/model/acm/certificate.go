@@ -0,0 +1,76 @@
+package acm
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &Certificate{}
+
+// Certificate represents an ACM Certificate imported from a Kubernetes TLS secret.
+type Certificate struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of Certificate
+	Spec CertificateSpec `json:"spec"`
+
+	// observed state of Certificate
+	// +optional
+	Status *CertificateStatus `json:"status,omitempty"`
+}
+
+// NewCertificate constructs new Certificate resource.
+func NewCertificate(stack core.Stack, id string, spec CertificateSpec) *Certificate {
+	cert := &Certificate{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::CertificateManager::Certificate", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(cert)
+	return cert
+}
+
+// SetStatus sets the Certificate's status
+func (cert *Certificate) SetStatus(status CertificateStatus) {
+	cert.Status = &status
+}
+
+// CertificateARN returns The Amazon Resource Name (ARN) of the Certificate.
+func (cert *Certificate) CertificateARN() core.StringToken {
+	return core.NewResourceFieldStringToken(cert, "status/certificateARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			cert := res.(*Certificate)
+			if cert.Status == nil {
+				return "", errors.Errorf("Certificate is not fulfilled yet: %v", cert.ID())
+			}
+			return cert.Status.CertificateARN, nil
+		},
+	)
+}
+
+// CertificateSpec defines the desired state of Certificate
+// The PEM encoded materials are never marshaled, the checksum of them is used to detect changes instead.
+type CertificateSpec struct {
+	// The PEM encoded certificate.
+	Certificate string `json:"-"`
+
+	// The PEM encoded certificate chain.
+	// +optional
+	CertificateChain string `json:"-"`
+
+	// The PEM encoded private key that matches the public key in the certificate.
+	PrivateKey string `json:"-"`
+
+	// The checksum of PEM encoded materials.
+	Checksum string `json:"checksum"`
+
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// CertificateStatus defines the observed state of Certificate
+type CertificateStatus struct {
+	// The Amazon Resource Name (ARN) of the certificate.
+	CertificateARN string `json:"certificateARN"`
+}
@@ -0,0 +1,45 @@
+package elbv2
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &ALBTargetRegistration{}
+
+// ALBTargetRegistration represents the registration of an Application LoadBalancer as target of an alb TargetGroup.
+type ALBTargetRegistration struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of ALBTargetRegistration
+	Spec ALBTargetRegistrationSpec `json:"spec"`
+}
+
+// NewALBTargetRegistration constructs new ALBTargetRegistration resource.
+func NewALBTargetRegistration(stack core.Stack, id string, spec ALBTargetRegistrationSpec) *ALBTargetRegistration {
+	r := &ALBTargetRegistration{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ALBTargetRegistration", id),
+		Spec:         spec,
+	}
+	stack.AddResource(r)
+	r.registerDependencies(stack)
+	return r
+}
+
+// register dependencies for ALBTargetRegistration.
+func (r *ALBTargetRegistration) registerDependencies(stack core.Stack) {
+	for _, dep := range r.Spec.TargetGroupARN.Dependencies() {
+		stack.AddDependency(dep, r)
+	}
+}
+
+// ALBTargetRegistrationSpec defines the desired state of ALBTargetRegistration
+type ALBTargetRegistrationSpec struct {
+	// The Amazon Resource Name (ARN) of the alb TargetGroup.
+	TargetGroupARN core.StringToken `json:"targetGroupARN"`
+
+	// The Amazon Resource Name (ARN) of the Application LoadBalancer registered as target.
+	LoadBalancerARN string `json:"loadBalancerARN"`
+
+	// The port of the Application LoadBalancer's listener that receives traffic.
+	Port int64 `json:"port"`
+}
@@ -56,6 +56,16 @@ func (ls *Listener) registerDependencies(stack core.Stack) {
 	for _, dep := range ls.Spec.LoadBalancerARN.Dependencies() {
 		stack.AddDependency(dep, ls)
 	}
+	for _, cert := range ls.Spec.Certificates {
+		for _, dep := range cert.CertificateARN.Dependencies() {
+			stack.AddDependency(dep, ls)
+		}
+	}
+	if ls.Spec.MutualAuthentication != nil && ls.Spec.MutualAuthentication.TrustStoreARN != nil {
+		for _, dep := range ls.Spec.MutualAuthentication.TrustStoreARN.Dependencies() {
+			stack.AddDependency(dep, ls)
+		}
+	}
 }
 
 type Protocol string
@@ -288,8 +298,29 @@ type Action struct {
 // Information about an SSL server certificate.
 type Certificate struct {
 	// The Amazon Resource Name (ARN) of the certificate.
+	CertificateARN core.StringToken `json:"certificateARN"`
+}
+
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// Information about the mutual authentication attributes of a listener.
+type MutualAuthenticationAttributes struct {
+	// The client certificate handling method.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// The Amazon Resource Name (ARN) of the trust store, required for verify mode.
+	// +optional
+	TrustStoreARN core.StringToken `json:"trustStoreARN,omitempty"`
+
+	// Indicates whether expired client certificates are ignored, only applies to verify mode.
 	// +optional
-	CertificateARN *string `json:"certificateARN,omitempty"`
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
 }
 
 // ALPNPolicy ALPN policy configuration for TLS listeners forwarding to TLS target groups
@@ -332,6 +363,10 @@ type ListenerSpec struct {
 	// +optional
 	ALPNPolicy []string `json:"alpnPolicy,omitempty"`
 
+	// [HTTPS listener] The mutual authentication configuration.
+	// +optional
+	MutualAuthentication *MutualAuthenticationAttributes `json:"mutualAuthentication,omitempty"`
+
 	// The tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
@@ -93,6 +93,18 @@ const (
 	LoadBalancerSchemeInternetFacing LoadBalancerScheme = "internet-facing"
 )
 
+// DeletionPolicy is the policy applied to LoadBalancer once it's no longer needed.
+type DeletionPolicy string
+
+const (
+	DeletionPolicyDelete  DeletionPolicy = "Delete"
+	DeletionPolicyRetain  DeletionPolicy = "Retain"
+	DeletionPolicyProtect DeletionPolicy = "Protect"
+)
+
+// LoadBalancerAttributeDeletionProtectionEnabled is the key of LoadBalancer attribute for deletion protection.
+const LoadBalancerAttributeDeletionProtectionEnabled = "deletion_protection.enabled"
+
 // Information about a subnet mapping.
 type SubnetMapping struct {
 	// [Network Load Balancers] The allocation ID of the Elastic IP address for
@@ -151,6 +163,14 @@ type LoadBalancerSpec struct {
 	// The tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// The ARN of an existing load balancer to adopt instead of creating a new one.
+	// +optional
+	AdoptLoadBalancerARN *string `json:"adoptLoadBalancerARN,omitempty"`
+
+	// Whether to leave listeners that are not managed by controller on the load balancer alone.
+	// +optional
+	PreserveUnmanagedListeners bool `json:"preserveUnmanagedListeners,omitempty"`
 }
 
 // LoadBalancerStatus defines the observed state of LoadBalancer
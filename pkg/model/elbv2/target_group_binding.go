@@ -99,6 +99,10 @@ type TargetGroupBindingSpec struct {
 	// node selector for instance type target groups to only register certain nodes
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// ipAddressType is the IP address type of TargetGroup's targets.
+	// +optional
+	IPAddressType *elbv2api.TargetGroupIPAddressType `json:"ipAddressType,omitempty"`
 }
 
 // Template for TargetGroupBinding Custom Resource.
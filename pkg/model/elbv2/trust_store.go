@@ -0,0 +1,73 @@
+package elbv2
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &TrustStore{}
+
+// TrustStore represents a ELBV2 TrustStore
+type TrustStore struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of TrustStore
+	Spec TrustStoreSpec `json:"spec"`
+
+	// observed state of TrustStore
+	// +optional
+	Status *TrustStoreStatus `json:"status,omitempty"`
+}
+
+// NewTrustStore constructs new TrustStore resource.
+func NewTrustStore(stack core.Stack, id string, spec TrustStoreSpec) *TrustStore {
+	ts := &TrustStore{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(ts)
+	return ts
+}
+
+// SetStatus sets the TrustStore's status
+func (ts *TrustStore) SetStatus(status TrustStoreStatus) {
+	ts.Status = &status
+}
+
+// TrustStoreARN returns The Amazon Resource Name (ARN) of the TrustStore.
+func (ts *TrustStore) TrustStoreARN() core.StringToken {
+	return core.NewResourceFieldStringToken(ts, "status/trustStoreARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			ts := res.(*TrustStore)
+			if ts.Status == nil {
+				return "", errors.Errorf("TrustStore is not fulfilled yet: %v", ts.ID())
+			}
+			return ts.Status.TrustStoreARN, nil
+		},
+	)
+}
+
+// TrustStoreSpec defines the desired state of TrustStore
+// The CA certificates bundle is never marshaled, the checksum of it is used to detect changes instead.
+type TrustStoreSpec struct {
+	// The name of the trust store.
+	Name string `json:"name"`
+
+	// The PEM encoded CA certificates bundle.
+	CACertificatesBundle string `json:"-"`
+
+	// The checksum of PEM encoded CA certificates bundle.
+	Checksum string `json:"checksum"`
+
+	// The tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// TrustStoreStatus defines the observed state of TrustStore
+type TrustStoreStatus struct {
+	// The Amazon Resource Name (ARN) of the trust store.
+	TrustStoreARN string `json:"trustStoreARN"`
+}
@@ -0,0 +1,130 @@
+package networking
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"testing"
+)
+
+func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
+	type describeSecurityGroupsAsListCall struct {
+		req  *ec2sdk.DescribeSecurityGroupsInput
+		resp []*ec2sdk.SecurityGroup
+		err  error
+	}
+	tests := []struct {
+		name        string
+		nameOrIDs   []string
+		describeSGs []describeSecurityGroupsAsListCall
+		want        []string
+		wantErr     error
+	}{
+		{
+			name:      "resolve via IDs",
+			nameOrIDs: []string{"sg-xxxx1", "sg-xxxx2"},
+			describeSGs: []describeSecurityGroupsAsListCall{
+				{
+					req: &ec2sdk.DescribeSecurityGroupsInput{
+						GroupIds: awssdk.StringSlice([]string{"sg-xxxx1", "sg-xxxx2"}),
+					},
+					resp: []*ec2sdk.SecurityGroup{
+						{GroupId: awssdk.String("sg-xxxx1")},
+						{GroupId: awssdk.String("sg-xxxx2")},
+					},
+				},
+			},
+			want: []string{"sg-xxxx1", "sg-xxxx2"},
+		},
+		{
+			name:      "resolve via IDs and names",
+			nameOrIDs: []string{"sg-xxxx1", "awesome-sg"},
+			describeSGs: []describeSecurityGroupsAsListCall{
+				{
+					req: &ec2sdk.DescribeSecurityGroupsInput{
+						GroupIds: awssdk.StringSlice([]string{"sg-xxxx1"}),
+					},
+					resp: []*ec2sdk.SecurityGroup{
+						{GroupId: awssdk.String("sg-xxxx1")},
+					},
+				},
+				{
+					req: &ec2sdk.DescribeSecurityGroupsInput{
+						Filters: []*ec2sdk.Filter{
+							{
+								Name:   awssdk.String("tag:Name"),
+								Values: awssdk.StringSlice([]string{"awesome-sg"}),
+							},
+							{
+								Name:   awssdk.String("vpc-id"),
+								Values: awssdk.StringSlice([]string{"vpc-xxxx"}),
+							},
+						},
+					},
+					resp: []*ec2sdk.SecurityGroup{
+						{GroupId: awssdk.String("sg-xxxx3")},
+					},
+				},
+			},
+			want: []string{"sg-xxxx1", "sg-xxxx3"},
+		},
+		{
+			name:      "security group not found",
+			nameOrIDs: []string{"awesome-sg"},
+			describeSGs: []describeSecurityGroupsAsListCall{
+				{
+					req: &ec2sdk.DescribeSecurityGroupsInput{
+						Filters: []*ec2sdk.Filter{
+							{
+								Name:   awssdk.String("tag:Name"),
+								Values: awssdk.StringSlice([]string{"awesome-sg"}),
+							},
+							{
+								Name:   awssdk.String("vpc-id"),
+								Values: awssdk.StringSlice([]string{"vpc-xxxx"}),
+							},
+						},
+					},
+					resp: nil,
+				},
+			},
+			wantErr: errors.New("couldn't found all securityGroups, nameOrIDs: [awesome-sg], found: []"),
+		},
+		{
+			name:      "describe security groups failed",
+			nameOrIDs: []string{"sg-xxxx1"},
+			describeSGs: []describeSecurityGroupsAsListCall{
+				{
+					req: &ec2sdk.DescribeSecurityGroupsInput{
+						GroupIds: awssdk.StringSlice([]string{"sg-xxxx1"}),
+					},
+					err: errors.New("some error"),
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.describeSGs {
+				ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			sgResolver := NewDefaultSecurityGroupResolver(ec2Client, "vpc-xxxx")
+			got, err := sgResolver.ResolveViaNameOrID(context.Background(), tt.nameOrIDs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -7,8 +7,12 @@ import (
 	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sort"
 	"strings"
@@ -19,6 +23,11 @@ const (
 	TagKeySubnetPublicELB   = "kubernetes.io/role/elb"
 )
 
+const (
+	// both ALB and NLB requires at least 8 available IP addresses in each subnet.
+	defaultMinAvailableIPAddressCount int64 = 8
+)
+
 type subnetLocaleType string
 
 const (
@@ -42,6 +51,18 @@ type SubnetsResolveOptions struct {
 	// The Load Balancer Scheme.
 	// By default, it's internet-facing.
 	LBScheme elbv2model.LoadBalancerScheme
+	// Additional tags that subnets must have to be discovered.
+	// If the tag values are empty, subnets with the tag key are matched regardless of value.
+	// By default, it's empty.
+	SubnetTags map[string][]string
+	// The minimal count of available IP addresses for subnets to be discovered.
+	// By default, it's 8.
+	MinAvailableIPAddressCount int64
+	// The EventRecorder used to record subnet discovery decisions.
+	// By default, decisions are only logged.
+	EventRecorder record.EventRecorder
+	// The objects to record subnet discovery decisions on.
+	EventObjects []runtime.Object
 }
 
 // ApplyOptions applies slice of SubnetsResolveOption.
@@ -54,8 +75,9 @@ func (opts *SubnetsResolveOptions) ApplyOptions(options []SubnetsResolveOption)
 // defaultSubnetsResolveOptions generates the default SubnetsResolveOptions
 func defaultSubnetsResolveOptions() SubnetsResolveOptions {
 	return SubnetsResolveOptions{
-		LBType:   elbv2model.LoadBalancerTypeApplication,
-		LBScheme: elbv2model.LoadBalancerSchemeInternetFacing,
+		LBType:                     elbv2model.LoadBalancerTypeApplication,
+		LBScheme:                   elbv2model.LoadBalancerSchemeInternetFacing,
+		MinAvailableIPAddressCount: defaultMinAvailableIPAddressCount,
 	}
 }
 
@@ -75,6 +97,28 @@ func WithSubnetsResolveLBScheme(lbScheme elbv2model.LoadBalancerScheme) SubnetsR
 	}
 }
 
+// WithSubnetsResolveSubnetTags generates a option that configures SubnetTags.
+func WithSubnetsResolveSubnetTags(subnetTags map[string][]string) SubnetsResolveOption {
+	return func(opts *SubnetsResolveOptions) {
+		opts.SubnetTags = subnetTags
+	}
+}
+
+// WithSubnetsResolveMinAvailableIPAddressCount generates a option that configures MinAvailableIPAddressCount.
+func WithSubnetsResolveMinAvailableIPAddressCount(minAvailableIPAddressCount int64) SubnetsResolveOption {
+	return func(opts *SubnetsResolveOptions) {
+		opts.MinAvailableIPAddressCount = minAvailableIPAddressCount
+	}
+}
+
+// WithSubnetsResolveEventRecorder generates a option that configures EventRecorder and EventObjects.
+func WithSubnetsResolveEventRecorder(eventRecorder record.EventRecorder, eventObjects ...runtime.Object) SubnetsResolveOption {
+	return func(opts *SubnetsResolveOptions) {
+		opts.EventRecorder = eventRecorder
+		opts.EventObjects = eventObjects
+	}
+}
+
 // SubnetsResolver is responsible for resolve EC2 Subnets for Load Balancers.
 type SubnetsResolver interface {
 	// ResolveViaDiscovery resolve subnets by auto discover matching subnets.
@@ -82,7 +126,12 @@ type SubnetsResolver interface {
 	// Additionally,
 	//   * for internet-facing Load Balancer, "kubernetes.io/role/elb" tag must presents.
 	//   * for internal Load Balancer, "kubernetes.io/role/internal-elb" tag must presents.
-	// If multiple subnets are found for specific AZ, one subnet is chosen based on the lexical order of subnetID.
+	//   * subnets must contain the additional SubnetTags if any.
+	//   * subnets must have at least MinAvailableIPAddressCount available IP addresses.
+	// If multiple subnets are found for specific AZ, one subnet is chosen in following order:
+	//   * subnets with the "kubernetes.io/cluster/<cluster-name>" tag.
+	//   * subnets with more available IP addresses.
+	//   * the lexical order of subnetID.
 	ResolveViaDiscovery(ctx context.Context, opts ...SubnetsResolveOption) ([]*ec2sdk.Subnet, error)
 
 	// ResolveViaNameOrIDSlice resolve subnets using subnet name or ID.
@@ -132,6 +181,7 @@ func (r *defaultSubnetsResolver) ResolveViaDiscovery(ctx context.Context, opts .
 			Values: awssdk.StringSlice([]string{r.vpcID}),
 		},
 	}}
+	req.Filters = append(req.Filters, buildSubnetTagFilters(resolveOpts.SubnetTags)...)
 
 	allSubnets, err := r.ec2Client.DescribeSubnetsAsList(ctx, req)
 	if err != nil {
@@ -145,26 +195,17 @@ func (r *defaultSubnetsResolver) ResolveViaDiscovery(ctx context.Context, opts .
 	}
 	subnetsByAZ := mapSDKSubnetsByAZ(subnets)
 	chosenSubnets := make([]*ec2sdk.Subnet, 0, len(subnetsByAZ))
-	for az, subnets := range subnetsByAZ {
-		if len(subnets) == 1 {
-			chosenSubnets = append(chosenSubnets, subnets[0])
-		} else if len(subnets) > 1 {
-			sort.Slice(subnets, func(i, j int) bool {
-				clusterTagI := r.checkSubnetHasClusterTag(subnets[i])
-				clusterTagJ := r.checkSubnetHasClusterTag(subnets[j])
-				if clusterTagI != clusterTagJ {
-					if clusterTagI {
-						return true
-					}
-					return false
-				}
-				return awssdk.StringValue(subnets[i].SubnetId) < awssdk.StringValue(subnets[j].SubnetId)
-			})
-			r.logger.Info("multiple subnet in the same AvailabilityZone", "AvailabilityZone", az,
-				"chosen", subnets[0].SubnetId, "ignored", subnets[1:])
-			chosenSubnets = append(chosenSubnets, subnets[0])
+	var decisions []string
+	for _, az := range sets.StringKeySet(subnetsByAZ).List() {
+		chosenSubnet, decision := r.chooseSubnetForAZ(az, subnetsByAZ[az], resolveOpts)
+		if chosenSubnet != nil {
+			chosenSubnets = append(chosenSubnets, chosenSubnet)
+		}
+		if len(decision) != 0 {
+			decisions = append(decisions, decision)
 		}
 	}
+	r.recordSubnetsDiscoveryDecisions(decisions, resolveOpts)
 	if len(chosenSubnets) == 0 {
 		return nil, errors.New("unable to discover at least one subnet")
 	}
@@ -243,6 +284,84 @@ func (r *defaultSubnetsResolver) ResolveViaNameOrIDSlice(ctx context.Context, su
 	return resolvedSubnets, nil
 }
 
+// chooseSubnetForAZ chooses one subnet among candidate subnets within the same AZ.
+// It returns the chosen subnet if any, and a human-readable decision if any candidate subnet was rejected.
+func (r *defaultSubnetsResolver) chooseSubnetForAZ(az string, subnets []*ec2sdk.Subnet, resolveOpts SubnetsResolveOptions) (*ec2sdk.Subnet, string) {
+	var eligibleSubnets []*ec2sdk.Subnet
+	var rejections []string
+	for _, subnet := range subnets {
+		// AvailableIpAddressCount is always reported by EC2, we don't reject subnets with unknown capacity.
+		if subnet.AvailableIpAddressCount != nil && awssdk.Int64Value(subnet.AvailableIpAddressCount) < resolveOpts.MinAvailableIPAddressCount {
+			rejections = append(rejections, fmt.Sprintf("%v(%v available IPs, below minimum %v)",
+				awssdk.StringValue(subnet.SubnetId), awssdk.Int64Value(subnet.AvailableIpAddressCount), resolveOpts.MinAvailableIPAddressCount))
+			continue
+		}
+		eligibleSubnets = append(eligibleSubnets, subnet)
+	}
+	if len(eligibleSubnets) == 0 {
+		return nil, fmt.Sprintf("no subnet chosen in %v, rejected %v", az, strings.Join(rejections, ", "))
+	}
+
+	sort.Slice(eligibleSubnets, func(i, j int) bool {
+		return r.compareSubnetsRank(eligibleSubnets[i], eligibleSubnets[j]) < 0
+	})
+	chosenSubnet := eligibleSubnets[0]
+	for _, subnet := range eligibleSubnets[1:] {
+		rejections = append(rejections, fmt.Sprintf("%v(%v)", awssdk.StringValue(subnet.SubnetId), r.explainSubnetRejection(chosenSubnet, subnet)))
+	}
+	if len(rejections) == 0 {
+		return chosenSubnet, ""
+	}
+	r.logger.Info("multiple subnet in the same AvailabilityZone", "AvailabilityZone", az,
+		"chosen", chosenSubnet.SubnetId, "rejected", rejections)
+	return chosenSubnet, fmt.Sprintf("chose %v(%v available IPs) in %v, rejected %v", awssdk.StringValue(chosenSubnet.SubnetId),
+		awssdk.Int64Value(chosenSubnet.AvailableIpAddressCount), az, strings.Join(rejections, ", "))
+}
+
+// compareSubnetsRank compares the rank of two subnets within the same AZ, the subnet with lower rank is preferred.
+// returns negative value if subnetA is preferred, positive value if subnetB is preferred.
+func (r *defaultSubnetsResolver) compareSubnetsRank(subnetA *ec2sdk.Subnet, subnetB *ec2sdk.Subnet) int {
+	clusterTagA := r.checkSubnetHasClusterTag(subnetA)
+	clusterTagB := r.checkSubnetHasClusterTag(subnetB)
+	if clusterTagA != clusterTagB {
+		if clusterTagA {
+			return -1
+		}
+		return 1
+	}
+	availableIPsA := awssdk.Int64Value(subnetA.AvailableIpAddressCount)
+	availableIPsB := awssdk.Int64Value(subnetB.AvailableIpAddressCount)
+	if availableIPsA != availableIPsB {
+		if availableIPsA > availableIPsB {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(awssdk.StringValue(subnetA.SubnetId), awssdk.StringValue(subnetB.SubnetId))
+}
+
+// explainSubnetRejection explains why the rejected subnet is ranked lower than the chosen subnet.
+func (r *defaultSubnetsResolver) explainSubnetRejection(chosenSubnet *ec2sdk.Subnet, rejectedSubnet *ec2sdk.Subnet) string {
+	if r.checkSubnetHasClusterTag(chosenSubnet) != r.checkSubnetHasClusterTag(rejectedSubnet) {
+		return "not tagged for cluster"
+	}
+	if awssdk.Int64Value(chosenSubnet.AvailableIpAddressCount) != awssdk.Int64Value(rejectedSubnet.AvailableIpAddressCount) {
+		return fmt.Sprintf("%v available IPs, fewer than chosen", awssdk.Int64Value(rejectedSubnet.AvailableIpAddressCount))
+	}
+	return "higher lexical order of subnetID"
+}
+
+// recordSubnetsDiscoveryDecisions records the subnet discovery decisions as event on the configured objects.
+func (r *defaultSubnetsResolver) recordSubnetsDiscoveryDecisions(decisions []string, resolveOpts SubnetsResolveOptions) {
+	if len(decisions) == 0 || resolveOpts.EventRecorder == nil {
+		return
+	}
+	message := fmt.Sprintf("Subnets discovered: %v", strings.Join(decisions, "; "))
+	for _, obj := range resolveOpts.EventObjects {
+		resolveOpts.EventRecorder.Event(obj, corev1.EventTypeNormal, k8s.SubnetEventReasonSubnetsDiscovered, message)
+	}
+}
+
 // validateSDKSubnetsAZExclusivity validates subnets belong to different AZs.
 // subnets passed-in must be non-empty
 func (r *defaultSubnetsResolver) validateSubnetsAZExclusivity(subnets []*ec2sdk.Subnet) error {
@@ -364,6 +483,26 @@ func mapSDKSubnetsByAZ(subnets []*ec2sdk.Subnet) map[string][]*ec2sdk.Subnet {
 	return subnetsByAZ
 }
 
+// buildSubnetTagFilters builds the EC2 filters for subnet tags.
+func buildSubnetTagFilters(subnetTags map[string][]string) []*ec2sdk.Filter {
+	var filters []*ec2sdk.Filter
+	for _, tagKey := range sets.StringKeySet(subnetTags).List() {
+		tagValues := subnetTags[tagKey]
+		if len(tagValues) == 0 {
+			filters = append(filters, &ec2sdk.Filter{
+				Name:   awssdk.String("tag-key"),
+				Values: awssdk.StringSlice([]string{tagKey}),
+			})
+		} else {
+			filters = append(filters, &ec2sdk.Filter{
+				Name:   awssdk.String("tag:" + tagKey),
+				Values: awssdk.StringSlice(tagValues),
+			})
+		}
+	}
+	return filters
+}
+
 // sortSubnetsByID sorts given subnets slice by subnetID.
 func sortSubnetsByID(subnets []*ec2sdk.Subnet) {
 	sort.Slice(subnets, func(i, j int) bool {
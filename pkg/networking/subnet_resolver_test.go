@@ -9,6 +9,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -886,6 +889,160 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "subnets ranked by available IP addresses with additional tag filters",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							Filters: []*ec2sdk.Filter{
+								{
+									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
+									Values: awssdk.StringSlice([]string{"", "1"}),
+								},
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: awssdk.StringSlice([]string{"vpc-1"}),
+								},
+								{
+									Name:   awssdk.String("tag-key"),
+									Values: awssdk.StringSlice([]string{"team"}),
+								},
+								{
+									Name:   awssdk.String("tag:tier"),
+									Values: awssdk.StringSlice([]string{"public", "edge"}),
+								},
+							},
+						},
+						output: []*ec2sdk.Subnet{
+							{
+								SubnetId:                awssdk.String("subnet-1"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int64(20),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-2"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int64(200),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-3"),
+								AvailabilityZone:        awssdk.String("us-west-2b"),
+								AvailabilityZoneId:      awssdk.String("usw2-az2"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int64(15),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-4"),
+								AvailabilityZone:        awssdk.String("us-west-2b"),
+								AvailabilityZoneId:      awssdk.String("usw2-az2"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int64(5),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-5"),
+								AvailabilityZone:        awssdk.String("us-west-2c"),
+								AvailabilityZoneId:      awssdk.String("usw2-az3"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int64(3),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-az1"},
+						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+							"usw2-az1": {
+								ZoneId:   awssdk.String("usw2-az1"),
+								ZoneType: awssdk.String("availability-zone"),
+							},
+						},
+					},
+					{
+						availabilityZoneIDs: []string{"usw2-az2"},
+						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+							"usw2-az2": {
+								ZoneId:   awssdk.String("usw2-az2"),
+								ZoneType: awssdk.String("availability-zone"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+					WithSubnetsResolveSubnetTags(map[string][]string{
+						"tier": {"public", "edge"},
+						"team": nil,
+					}),
+					WithSubnetsResolveMinAvailableIPAddressCount(10),
+				},
+			},
+			want: []*ec2sdk.Subnet{
+				{
+					SubnetId:                awssdk.String("subnet-2"),
+					AvailabilityZone:        awssdk.String("us-west-2a"),
+					AvailabilityZoneId:      awssdk.String("usw2-az1"),
+					VpcId:                   awssdk.String("vpc-1"),
+					AvailableIpAddressCount: awssdk.Int64(200),
+				},
+				{
+					SubnetId:                awssdk.String("subnet-3"),
+					AvailabilityZone:        awssdk.String("us-west-2b"),
+					AvailabilityZoneId:      awssdk.String("usw2-az2"),
+					VpcId:                   awssdk.String("vpc-1"),
+					AvailableIpAddressCount: awssdk.Int64(15),
+				},
+			},
+		},
+		{
+			name: "no subnets with enough available IP addresses",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							Filters: []*ec2sdk.Filter{
+								{
+									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
+									Values: awssdk.StringSlice([]string{"", "1"}),
+								},
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: awssdk.StringSlice([]string{"vpc-1"}),
+								},
+							},
+						},
+						output: []*ec2sdk.Subnet{
+							{
+								SubnetId:                awssdk.String("subnet-1"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int64(7),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+				},
+			},
+			wantErr: errors.New("unable to discover at least one subnet"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1794,3 +1951,85 @@ func Test_sortSubnetsByID(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultSubnetsResolver_chooseSubnetForAZ(t *testing.T) {
+	clusterTags := []*ec2sdk.Tag{
+		{
+			Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
+			Value: awssdk.String("owned"),
+		},
+	}
+	tests := []struct {
+		name         string
+		subnets      []*ec2sdk.Subnet
+		wantSubnetID string
+		wantDecision string
+	}{
+		{
+			name: "single subnet",
+			subnets: []*ec2sdk.Subnet{
+				{SubnetId: awssdk.String("subnet-1"), AvailableIpAddressCount: awssdk.Int64(100)},
+			},
+			wantSubnetID: "subnet-1",
+			wantDecision: "",
+		},
+		{
+			name: "subnets ranked by cluster tag, available IPs and subnetID",
+			subnets: []*ec2sdk.Subnet{
+				{SubnetId: awssdk.String("subnet-1"), AvailableIpAddressCount: awssdk.Int64(500)},
+				{SubnetId: awssdk.String("subnet-2"), AvailableIpAddressCount: awssdk.Int64(100), Tags: clusterTags},
+				{SubnetId: awssdk.String("subnet-3"), AvailableIpAddressCount: awssdk.Int64(200), Tags: clusterTags},
+				{SubnetId: awssdk.String("subnet-4"), AvailableIpAddressCount: awssdk.Int64(200), Tags: clusterTags},
+				{SubnetId: awssdk.String("subnet-5"), AvailableIpAddressCount: awssdk.Int64(4), Tags: clusterTags},
+			},
+			wantSubnetID: "subnet-3",
+			wantDecision: "chose subnet-3(200 available IPs) in us-west-2a, rejected subnet-5(4 available IPs, below minimum 8), " +
+				"subnet-4(higher lexical order of subnetID), subnet-2(100 available IPs, fewer than chosen), subnet-1(not tagged for cluster)",
+		},
+		{
+			name: "all subnets below minimal available IPs",
+			subnets: []*ec2sdk.Subnet{
+				{SubnetId: awssdk.String("subnet-1"), AvailableIpAddressCount: awssdk.Int64(2)},
+				{SubnetId: awssdk.String("subnet-2"), AvailableIpAddressCount: awssdk.Int64(0)},
+			},
+			wantSubnetID: "",
+			wantDecision: "no subnet chosen in us-west-2a, rejected subnet-1(2 available IPs, below minimum 8), subnet-2(0 available IPs, below minimum 8)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &defaultSubnetsResolver{
+				clusterName: "kube-cluster",
+				logger:      &log.NullLogger{},
+			}
+			resolveOpts := defaultSubnetsResolveOptions()
+			got, gotDecision := r.chooseSubnetForAZ("us-west-2a", tt.subnets, resolveOpts)
+			if tt.wantSubnetID == "" {
+				assert.Nil(t, got)
+			} else {
+				assert.Equal(t, tt.wantSubnetID, awssdk.StringValue(got.SubnetId))
+			}
+			assert.Equal(t, tt.wantDecision, gotDecision)
+		})
+	}
+}
+
+func Test_defaultSubnetsResolver_recordSubnetsDiscoveryDecisions(t *testing.T) {
+	eventRecorder := record.NewFakeRecorder(10)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+	}
+	r := &defaultSubnetsResolver{
+		logger: &log.NullLogger{},
+	}
+	resolveOpts := defaultSubnetsResolveOptions()
+	resolveOpts.ApplyOptions([]SubnetsResolveOption{WithSubnetsResolveEventRecorder(eventRecorder, svc)})
+	r.recordSubnetsDiscoveryDecisions(nil, resolveOpts)
+	r.recordSubnetsDiscoveryDecisions([]string{"decision-a", "decision-b"}, resolveOpts)
+	close(eventRecorder.Events)
+	var gotEvents []string
+	for event := range eventRecorder.Events {
+		gotEvents = append(gotEvents, event)
+	}
+	assert.Equal(t, []string{"Normal SubnetsDiscovered Subnets discovered: decision-a; decision-b"}, gotEvents)
+}
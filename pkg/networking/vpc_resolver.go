@@ -13,6 +13,9 @@ import (
 type VPCResolver interface {
 	// ResolveCIDRs resolves the VPC CIDRs
 	ResolveCIDRs(ctx context.Context) ([]string, error)
+
+	// ResolveIPv6CIDRs resolves the VPC IPv6 CIDRs
+	ResolveIPv6CIDRs(ctx context.Context) ([]string, error)
 }
 
 // NewDefaultVPCResolver constructs a new defaultVPCResolver
@@ -34,16 +37,11 @@ type defaultVPCResolver struct {
 }
 
 func (r *defaultVPCResolver) ResolveCIDRs(ctx context.Context) ([]string, error) {
-	vpcs, err := r.ec2Client.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
-		VpcIds: []*string{awssdk.String(r.vpcID)},
-	})
+	vpc, err := r.describeVPC(ctx)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to describe VPC")
+		return nil, err
 	}
-	if len(vpcs.Vpcs) == 0 {
-		return nil, errors.Errorf("unable to find matching VPC %q", r.vpcID)
-	}
-	cidrBlockAssociationSet := vpcs.Vpcs[0].CidrBlockAssociationSet
+	cidrBlockAssociationSet := vpc.CidrBlockAssociationSet
 	var vpcCIDRs []string
 
 	for _, cidr := range cidrBlockAssociationSet {
@@ -52,3 +50,31 @@ func (r *defaultVPCResolver) ResolveCIDRs(ctx context.Context) ([]string, error)
 
 	return vpcCIDRs, nil
 }
+
+func (r *defaultVPCResolver) ResolveIPv6CIDRs(ctx context.Context) ([]string, error) {
+	vpc, err := r.describeVPC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var vpcIPv6CIDRs []string
+	for _, cidr := range vpc.Ipv6CidrBlockAssociationSet {
+		vpcIPv6CIDRs = append(vpcIPv6CIDRs, awssdk.StringValue(cidr.Ipv6CidrBlock))
+	}
+	if len(vpcIPv6CIDRs) == 0 {
+		return nil, errors.Errorf("unable to find IPv6 CIDRs for VPC %q", r.vpcID)
+	}
+	return vpcIPv6CIDRs, nil
+}
+
+func (r *defaultVPCResolver) describeVPC(ctx context.Context) (*ec2.Vpc, error) {
+	vpcs, err := r.ec2Client.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: []*string{awssdk.String(r.vpcID)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to describe VPC")
+	}
+	if len(vpcs.Vpcs) == 0 {
+		return nil, errors.Errorf("unable to find matching VPC %q", r.vpcID)
+	}
+	return vpcs.Vpcs[0], nil
+}
@@ -123,3 +123,98 @@ func Test_defaultVPCResolver_ResolveCIDRs(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultVPCResolver_ResolveIPv6CIDRs(t *testing.T) {
+	type describeVpcsCall struct {
+		input  *ec2sdk.DescribeVpcsInput
+		output *ec2sdk.DescribeVpcsOutput
+		err    error
+	}
+	tests := []struct {
+		name             string
+		vpcID            string
+		want             []string
+		wantErr          error
+		describeVpcsCall describeVpcsCall
+	}{
+		{
+			name:  "vpc ipv6 cidr discovery",
+			vpcID: "vpc-01xxx2",
+			want:  []string{"2600:1f13:837:8500::/56"},
+			describeVpcsCall: describeVpcsCall{
+				input: &ec2sdk.DescribeVpcsInput{
+					VpcIds: []*string{awssdk.String("vpc-01xxx2")},
+				},
+				output: &ec2sdk.DescribeVpcsOutput{
+					Vpcs: []*ec2sdk.Vpc{
+						{
+							CidrBlockAssociationSet: []*ec2sdk.VpcCidrBlockAssociation{
+								{
+									CidrBlock: awssdk.String("192.160.0.0/16"),
+								},
+							},
+							Ipv6CidrBlockAssociationSet: []*ec2sdk.VpcIpv6CidrBlockAssociation{
+								{
+									Ipv6CidrBlock: awssdk.String("2600:1f13:837:8500::/56"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "vpc without ipv6 cidr",
+			vpcID:   "vpc-01xxx2",
+			wantErr: errors.New("unable to find IPv6 CIDRs for VPC \"vpc-01xxx2\""),
+			describeVpcsCall: describeVpcsCall{
+				input: &ec2sdk.DescribeVpcsInput{
+					VpcIds: []*string{awssdk.String("vpc-01xxx2")},
+				},
+				output: &ec2sdk.DescribeVpcsOutput{
+					Vpcs: []*ec2sdk.Vpc{
+						{
+							CidrBlockAssociationSet: []*ec2sdk.VpcCidrBlockAssociation{
+								{
+									CidrBlock: awssdk.String("192.160.0.0/16"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "unable to describe VPC",
+			vpcID:   "vpc-01xxx3",
+			wantErr: errors.Wrapf(errors.New("aws error"), "unable to describe VPC"),
+			describeVpcsCall: describeVpcsCall{
+				input: &ec2sdk.DescribeVpcsInput{
+					VpcIds: []*string{awssdk.String("vpc-01xxx3")},
+				},
+				err: errors.New("aws error"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			ec2Client.EXPECT().DescribeVpcsWithContext(gomock.Any(), tt.describeVpcsCall.input).Return(
+				tt.describeVpcsCall.output, tt.describeVpcsCall.err)
+			vpcResolver := &defaultVPCResolver{
+				ec2Client: ec2Client,
+				vpcID:     tt.vpcID,
+			}
+			got, err := vpcResolver.ResolveIPv6CIDRs(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// BuildDeletionPolicy builds the deletion policy of LoadBalancer for Service.
+func BuildDeletionPolicy(annotationParser annotations.Parser, service *corev1.Service) (elbv2model.DeletionPolicy, error) {
+	rawPolicy := ""
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixDeletionPolicy, &rawPolicy, service.Annotations); !exists {
+		return elbv2model.DeletionPolicyDelete, nil
+	}
+	switch rawPolicy {
+	case string(elbv2model.DeletionPolicyDelete):
+		return elbv2model.DeletionPolicyDelete, nil
+	case string(elbv2model.DeletionPolicyRetain):
+		return elbv2model.DeletionPolicyRetain, nil
+	case string(elbv2model.DeletionPolicyProtect):
+		return elbv2model.DeletionPolicyProtect, nil
+	default:
+		return "", errors.Errorf("unknown deletion policy: %v", rawPolicy)
+	}
+}
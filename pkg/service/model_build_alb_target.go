@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// ingressTagPrefix is the tag prefix used by the ingress controller to track resources of IngressGroups.
+const ingressTagPrefix = "ingress.k8s.aws"
+
+// BuildTargetIngressStackID returns the stackID of the IngressGroup referenced by Service for alb targetType.
+// An Ingress is referenced by name within Service's namespace, and an IngressGroup is referenced by its group name.
+// The boolean result is false if Service doesn't reference any Ingress or IngressGroup.
+func BuildTargetIngressStackID(annotationParser annotations.Parser, svc *corev1.Service) (core.StackID, bool, error) {
+	var ingName string
+	ingNameExists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetIngress, &ingName, svc.Annotations)
+	var groupName string
+	groupNameExists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetIngressGroup, &groupName, svc.Annotations)
+	switch {
+	case ingNameExists && groupNameExists:
+		return core.StackID{}, false, errors.Errorf("only one of %v and %v can be specified",
+			annotations.SvcLBSuffixTargetIngress, annotations.SvcLBSuffixTargetIngressGroup)
+	case ingNameExists && len(ingName) != 0:
+		return core.StackID{Namespace: svc.Namespace, Name: ingName}, true, nil
+	case groupNameExists && len(groupName) != 0:
+		return core.StackID{Name: groupName}, true, nil
+	}
+	return core.StackID{}, false, nil
+}
+
+// buildALBTargetGroup constructs the TargetGroup for alb targetType,
+// which registers the Application LoadBalancer of referenced Ingress or IngressGroup as target instead of pods or nodes.
+func (t *defaultModelBuildTask) buildALBTargetGroup(ctx context.Context, tgResourceID string, port corev1.ServicePort, tgProtocol elbv2model.Protocol) (*elbv2model.TargetGroup, error) {
+	if tgProtocol != elbv2model.ProtocolTCP {
+		return nil, errors.Errorf("unsupported protocol %v for target type %v, only %v is supported",
+			tgProtocol, elbv2model.TargetTypeALB, elbv2model.ProtocolTCP)
+	}
+	albARN, err := t.resolveTargetALB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfigForALBTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tgAttrs, err := t.buildTargetGroupAttributesForALBTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tgSpec, err := t.buildTargetGroupSpec(ctx, tgProtocol, elbv2model.TargetTypeALB, port, healthCheckConfig, tgAttrs)
+	if err != nil {
+		return nil, err
+	}
+	targetGroup := elbv2model.NewTargetGroup(t.stack, tgResourceID, tgSpec)
+	elbv2model.NewALBTargetRegistration(t.stack, tgResourceID, elbv2model.ALBTargetRegistrationSpec{
+		TargetGroupARN:  targetGroup.TargetGroupARN(),
+		LoadBalancerARN: albARN,
+		Port:            tgSpec.Port,
+	})
+	t.tgByResID[tgResourceID] = targetGroup
+	return targetGroup, nil
+}
+
+// resolveTargetALB resolves the ARN of the Application LoadBalancer for Ingress or IngressGroup referenced by Service.
+// The Application LoadBalancer is discovered via the tracking tags of the ingress stack,
+// so that a replaced Application LoadBalancer is picked up when Service is reconciled again.
+func (t *defaultModelBuildTask) resolveTargetALB(ctx context.Context) (string, error) {
+	if t.targetALBARN != "" {
+		return t.targetALBARN, nil
+	}
+	stackID, exists, err := BuildTargetIngressStackID(t.annotationParser, t.service)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", errors.Errorf("either %v or %v must be specified for target type %v",
+			annotations.SvcLBSuffixTargetIngress, annotations.SvcLBSuffixTargetIngressGroup, elbv2model.TargetTypeALB)
+	}
+	ingressTrackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, t.clusterName)
+	stackTags := ingressTrackingProvider.StackTags(core.NewDefaultStack(stackID))
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return "", err
+	}
+	var albARNs []string
+	for _, sdkLB := range sdkLBs {
+		if awssdk.StringValue(sdkLB.LoadBalancer.Type) != elbv2sdk.LoadBalancerTypeEnumApplication {
+			continue
+		}
+		albARNs = append(albARNs, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+	}
+	if len(albARNs) == 0 {
+		return "", errors.Errorf("application load balancer for IngressGroup %v not found", stackID)
+	}
+	if len(albARNs) > 1 {
+		return "", errors.Errorf("multiple application load balancers found for IngressGroup %v: %v", stackID, albARNs)
+	}
+	t.targetALBARN = albARNs[0]
+	return t.targetALBARN, nil
+}
+
+// buildTargetGroupHealthCheckConfigForALBTarget constructs the health check config for alb targetType.
+// Application LoadBalancer targets only support HTTP and HTTPS health checks.
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigForALBTarget(ctx context.Context) (*elbv2model.TargetGroupHealthCheckConfig, error) {
+	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, t.defaultHealthCheckProtocolForALBTarget)
+	if err != nil {
+		return nil, err
+	}
+	if healthCheckProtocol == elbv2model.ProtocolTCP {
+		return nil, errors.Errorf("unsupported health check protocol %v for target type %v", healthCheckProtocol, elbv2model.TargetTypeALB)
+	}
+	healthCheckPath := t.buildTargetGroupHealthCheckPath(ctx, t.defaultHealthCheckPath)
+	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, t.defaultHealthCheckPort)
+	if err != nil {
+		return nil, err
+	}
+	intervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, t.defaultHealthCheckInterval)
+	if err != nil {
+		return nil, err
+	}
+	healthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, t.defaultHealthCheckHealthyThreshold)
+	if err != nil {
+		return nil, err
+	}
+	unhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, t.defaultHealthCheckUnhealthyThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2model.TargetGroupHealthCheckConfig{
+		Port:                    &healthCheckPort,
+		Protocol:                &healthCheckProtocol,
+		Path:                    healthCheckPath,
+		IntervalSeconds:         &intervalSeconds,
+		HealthyThresholdCount:   &healthyThresholdCount,
+		UnhealthyThresholdCount: &unhealthyThresholdCount,
+	}, nil
+}
+
+// buildTargetGroupAttributesForALBTarget constructs the TargetGroup attributes for alb targetType.
+// Application LoadBalancer targets support neither proxy protocol v2 nor client IP preservation.
+func (t *defaultModelBuildTask) buildTargetGroupAttributesForALBTarget(ctx context.Context) ([]elbv2model.TargetGroupAttribute, error) {
+	tgAttrs, err := t.buildTargetGroupAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(tgAttrs))
+	for _, attr := range tgAttrs {
+		switch attr.Key {
+		case tgAttrsProxyProtocolV2Enabled:
+			proxyProtocolV2Enabled, err := strconv.ParseBool(attr.Value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsProxyProtocolV2Enabled, attr.Value)
+			}
+			if proxyProtocolV2Enabled {
+				return nil, errors.Errorf("proxy protocol v2 is not supported for target type %v", elbv2model.TargetTypeALB)
+			}
+		case tgAttrsPreserveClientIPEnabled:
+			return nil, errors.Errorf("attribute %v is not supported for target type %v", tgAttrsPreserveClientIPEnabled, elbv2model.TargetTypeALB)
+		default:
+			attributes = append(attributes, attr)
+		}
+	}
+	return attributes, nil
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_BuildTargetIngressStackID(t *testing.T) {
+	tests := []struct {
+		testName   string
+		svc        *corev1.Service
+		want       core.StackID
+		wantExists bool
+		wantErr    error
+	}{
+		{
+			testName: "no reference",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"},
+			},
+			wantExists: false,
+		},
+		{
+			testName: "reference Ingress",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress": "awesome-ing",
+					},
+				},
+			},
+			want:       core.StackID{Namespace: "awesome-ns", Name: "awesome-ing"},
+			wantExists: true,
+		},
+		{
+			testName: "reference IngressGroup",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress-group": "awesome-group",
+					},
+				},
+			},
+			want:       core.StackID{Name: "awesome-group"},
+			wantExists: true,
+		},
+		{
+			testName: "reference both Ingress and IngressGroup",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress":       "awesome-ing",
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress-group": "awesome-group",
+					},
+				},
+			},
+			wantErr: errors.New("only one of aws-load-balancer-target-ingress and aws-load-balancer-target-ingress-group can be specified"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			parser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			got, exists, err := BuildTargetIngressStackID(parser, tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantExists, exists)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_resolveTargetALB(t *testing.T) {
+	type listLoadBalancersCall struct {
+		tagFilter tracking.TagFilter
+		sdkLBs    []elbv2deploy.LoadBalancerWithTags
+		err       error
+	}
+	albWithTags := func(arn string) elbv2deploy.LoadBalancerWithTags {
+		return elbv2deploy.LoadBalancerWithTags{
+			LoadBalancer: &elbv2sdk.LoadBalancer{
+				LoadBalancerArn: aws.String(arn),
+				Type:            aws.String(elbv2sdk.LoadBalancerTypeEnumApplication),
+			},
+		}
+	}
+	groupTagFilter := tracking.TagFilter{
+		"elbv2.k8s.aws/cluster": {"my-cluster"},
+		"ingress.k8s.aws/stack": {"awesome-group"},
+	}
+	tests := []struct {
+		testName               string
+		svc                    *corev1.Service
+		listLoadBalancersCalls []listLoadBalancersCall
+		want                   string
+		wantErr                error
+	}{
+		{
+			testName: "ALB of IngressGroup found",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress-group": "awesome-group",
+					},
+				},
+			},
+			listLoadBalancersCalls: []listLoadBalancersCall{
+				{
+					tagFilter: groupTagFilter,
+					sdkLBs:    []elbv2deploy.LoadBalancerWithTags{albWithTags("my-alb")},
+				},
+			},
+			want: "my-alb",
+		},
+		{
+			testName: "ALB of Ingress found",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress": "awesome-ing",
+					},
+				},
+			},
+			listLoadBalancersCalls: []listLoadBalancersCall{
+				{
+					tagFilter: tracking.TagFilter{
+						"elbv2.k8s.aws/cluster": {"my-cluster"},
+						"ingress.k8s.aws/stack": {"awesome-ns/awesome-ing"},
+					},
+					sdkLBs: []elbv2deploy.LoadBalancerWithTags{albWithTags("my-alb")},
+				},
+			},
+			want: "my-alb",
+		},
+		{
+			testName: "no reference",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"},
+			},
+			wantErr: errors.New("either aws-load-balancer-target-ingress or aws-load-balancer-target-ingress-group must be specified for target type alb"),
+		},
+		{
+			testName: "ALB not provisioned yet",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress-group": "awesome-group",
+					},
+				},
+			},
+			listLoadBalancersCalls: []listLoadBalancersCall{
+				{
+					tagFilter: groupTagFilter,
+				},
+			},
+			wantErr: errors.New("application load balancer for IngressGroup awesome-group not found"),
+		},
+		{
+			testName: "multiple ALBs found",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-ingress-group": "awesome-group",
+					},
+				},
+			},
+			listLoadBalancersCalls: []listLoadBalancersCall{
+				{
+					tagFilter: groupTagFilter,
+					sdkLBs:    []elbv2deploy.LoadBalancerWithTags{albWithTags("my-alb-1"), albWithTags("my-alb-2")},
+				},
+			},
+			wantErr: errors.New("multiple application load balancers found for IngressGroup awesome-group: [my-alb-1 my-alb-2]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+			for _, call := range tt.listLoadBalancersCalls {
+				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), call.tagFilter).Return(call.sdkLBs, call.err)
+			}
+			builder := &defaultModelBuildTask{
+				annotationParser:    annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				elbv2TaggingManager: elbv2TaggingManager,
+				clusterName:         "my-cluster",
+				service:             tt.svc,
+			}
+			got, err := builder.resolveTargetALB(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildTargetGroupAttributesForALBTarget(t *testing.T) {
+	tests := []struct {
+		testName string
+		svc      *corev1.Service
+		want     []elbv2.TargetGroupAttribute
+		wantErr  error
+	}{
+		{
+			testName: "default values",
+			svc:      &corev1.Service{},
+			want:     []elbv2.TargetGroupAttribute{},
+		},
+		{
+			testName: "with target group attributes",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "deregistration_delay.timeout_seconds=60",
+					},
+				},
+			},
+			want: []elbv2.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "60",
+				},
+			},
+		},
+		{
+			testName: "proxy protocol v2 enabled",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-proxy-protocol": "*",
+					},
+				},
+			},
+			wantErr: errors.New("proxy protocol v2 is not supported for target type alb"),
+		},
+		{
+			testName: "client IP preservation configured",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "preserve_client_ip.enabled=true",
+					},
+				},
+			},
+			wantErr: errors.New("attribute preserve_client_ip.enabled is not supported for target type alb"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			builder := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service:          tt.svc,
+			}
+			got, err := builder.buildTargetGroupAttributesForALBTarget(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+)
+
+// buildImportedCertificates builds the ACM Certificates imported from TLS secrets specified via annotation.
+func (t *defaultModelBuildTask) buildImportedCertificates(ctx context.Context) ([]*acmmodel.Certificate, error) {
+	var secretNames []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLCertSecrets, &secretNames, t.service.Annotations); !exists {
+		return nil, nil
+	}
+	if !t.enableCertImport {
+		return nil, errors.Errorf("certificate import must be enabled to use %v annotation", annotations.SvcLBSuffixSSLCertSecrets)
+	}
+
+	certs := make([]*acmmodel.Certificate, 0, len(secretNames))
+	for _, secretName := range secretNames {
+		secretKey := types.NamespacedName{Namespace: t.service.Namespace, Name: secretName}
+		certSpec, err := t.buildImportedCertificateSpec(ctx, secretKey)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, acmmodel.NewCertificate(t.stack, secretKey.String(), certSpec))
+	}
+	return certs, nil
+}
+
+func (t *defaultModelBuildTask) buildImportedCertificateSpec(ctx context.Context, secretKey types.NamespacedName) (acmmodel.CertificateSpec, error) {
+	secret := &corev1.Secret{}
+	if err := t.k8sClient.Get(ctx, secretKey, secret); err != nil {
+		return acmmodel.CertificateSpec{}, errors.Wrapf(err, "failed to load TLS secret: %v", secretKey)
+	}
+	tlsCert, err := k8s.ExtractTLSCertificate(secret)
+	if err != nil {
+		return acmmodel.CertificateSpec{}, err
+	}
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return acmmodel.CertificateSpec{}, err
+	}
+	return acmmodel.CertificateSpec{
+		Certificate:      tlsCert.Certificate,
+		CertificateChain: tlsCert.CertificateChain,
+		PrivateKey:       tlsCert.PrivateKey,
+		Checksum:         tlsCert.Checksum(),
+		Tags:             tags,
+	}, nil
+}
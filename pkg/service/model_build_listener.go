@@ -3,19 +3,39 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"strconv"
 )
 
 func (t *defaultModelBuildTask) buildListeners(ctx context.Context, scheme elbv2model.LoadBalancerScheme) error {
-	cfg := t.buildListenerConfig(ctx)
+	cfg, err := t.buildListenerConfig(ctx)
+	if err != nil {
+		return err
+	}
+	// ServicePorts sharing the same port number are merged into a single listener, as only one listener can be created per port.
+	portsByPortNumber := make(map[int32][]corev1.ServicePort, len(t.service.Spec.Ports))
+	for _, port := range t.service.Spec.Ports {
+		portsByPortNumber[port.Port] = append(portsByPortNumber[port.Port], port)
+	}
 	for _, port := range t.service.Spec.Ports {
-		_, err := t.buildListener(ctx, port, cfg, scheme)
+		ports, exists := portsByPortNumber[port.Port]
+		if !exists {
+			continue
+		}
+		delete(portsByPortNumber, port.Port)
+		listenerPort := port
+		if len(ports) > 1 {
+			listenerPort, err = t.mergeServicePortsForListener(ctx, ports)
+			if err != nil {
+				return err
+			}
+		}
+		_, err := t.buildListener(ctx, listenerPort, cfg, scheme)
 		if err != nil {
 			return err
 		}
@@ -23,6 +43,35 @@ func (t *defaultModelBuildTask) buildListeners(ctx context.Context, scheme elbv2
 	return nil
 }
 
+// mergeServicePortsForListener merges a pair of TCP and UDP ServicePorts with the same port number into a TCP_UDP ServicePort.
+// the merged ServicePorts must have the same targetPort, as well as the same nodePort for instance targetType, so that both protocols reach the same targets.
+func (t *defaultModelBuildTask) mergeServicePortsForListener(ctx context.Context, ports []corev1.ServicePort) (corev1.ServicePort, error) {
+	protocols := sets.NewString()
+	for _, port := range ports {
+		protocols.Insert(string(port.Protocol))
+	}
+	if len(ports) != 2 || !protocols.Equal(sets.NewString(string(corev1.ProtocolTCP), string(corev1.ProtocolUDP))) {
+		return corev1.ServicePort{}, errors.Errorf("unsupported protocols %v for port %v, only a pair of TCP and UDP ports can share the same port",
+			protocols.List(), ports[0].Port)
+	}
+	if ports[0].TargetPort != ports[1].TargetPort {
+		return corev1.ServicePort{}, errors.Errorf("TCP and UDP ports of port %v must have the same targetPort", ports[0].Port)
+	}
+	targetType, err := t.buildTargetType(ctx)
+	if err != nil {
+		return corev1.ServicePort{}, err
+	}
+	if targetType == elbv2model.TargetTypeInstance && ports[0].NodePort != ports[1].NodePort {
+		return corev1.ServicePort{}, errors.Errorf("TCP and UDP ports of port %v must have the same nodePort for target type %v", ports[0].Port, targetType)
+	}
+	mergedPort := ports[0]
+	if mergedPort.Protocol != corev1.ProtocolTCP {
+		mergedPort = ports[1]
+	}
+	mergedPort.Protocol = corev1.Protocol(elbv2model.ProtocolTCP_UDP)
+	return mergedPort, nil
+}
+
 func (t *defaultModelBuildTask) buildListener(ctx context.Context, port corev1.ServicePort, cfg listenerConfig,
 	scheme elbv2model.LoadBalancerScheme) (*elbv2model.Listener, error) {
 	lsSpec, err := t.buildListenerSpec(ctx, port, cfg, scheme)
@@ -38,7 +87,7 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	scheme elbv2model.LoadBalancerScheme) (elbv2model.ListenerSpec, error) {
 	tgProtocol := elbv2model.Protocol(port.Protocol)
 	listenerProtocol := elbv2model.Protocol(port.Protocol)
-	if tgProtocol != elbv2model.ProtocolUDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
+	if tgProtocol != elbv2model.ProtocolUDP && tgProtocol != elbv2model.ProtocolTCP_UDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
 		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
 		if cfg.backendProtocol == "ssl" {
 			tgProtocol = elbv2model.ProtocolTLS
@@ -103,15 +152,23 @@ func (t *defaultModelBuildTask) buildSSLNegotiationPolicy(_ context.Context) *st
 	return &t.defaultSSLPolicy
 }
 
-func (t *defaultModelBuildTask) buildListenerCertificates(_ context.Context) []elbv2model.Certificate {
+func (t *defaultModelBuildTask) buildListenerCertificates(ctx context.Context) ([]elbv2model.Certificate, error) {
 	var rawCertificateARNs []string
 	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLCertificate, &rawCertificateARNs, t.service.Annotations)
 
 	var certificates []elbv2model.Certificate
 	for _, cert := range rawCertificateARNs {
-		certificates = append(certificates, elbv2model.Certificate{CertificateARN: aws.String(cert)})
+		certificates = append(certificates, elbv2model.Certificate{CertificateARN: core.LiteralStringToken(cert)})
+	}
+
+	importedCerts, err := t.buildImportedCertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range importedCerts {
+		certificates = append(certificates, elbv2model.Certificate{CertificateARN: cert.CertificateARN()})
 	}
-	return certificates
+	return certificates, nil
 }
 
 func (t *defaultModelBuildTask) buildTLSPortsSet(_ context.Context) sets.String {
@@ -153,8 +210,11 @@ type listenerConfig struct {
 	backendProtocol string
 }
 
-func (t *defaultModelBuildTask) buildListenerConfig(ctx context.Context) listenerConfig {
-	certificates := t.buildListenerCertificates(ctx)
+func (t *defaultModelBuildTask) buildListenerConfig(ctx context.Context) (listenerConfig, error) {
+	certificates, err := t.buildListenerCertificates(ctx)
+	if err != nil {
+		return listenerConfig{}, err
+	}
 	tlsPortsSet := t.buildTLSPortsSet(ctx)
 	backendProtocol := t.buildBackendProtocol(ctx)
 	sslPolicy := t.buildSSLNegotiationPolicy(ctx)
@@ -164,7 +224,7 @@ func (t *defaultModelBuildTask) buildListenerConfig(ctx context.Context) listene
 		tlsPortsSet:     tlsPortsSet,
 		sslPolicy:       sslPolicy,
 		backendProtocol: backendProtocol,
-	}
+	}, nil
 }
 
 func (t *defaultModelBuildTask) buildListenerTags(ctx context.Context) (map[string]string, error) {
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
@@ -86,3 +87,177 @@ func Test_defaultModelBuilderTask_buildListenerALPNPolicy(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuilderTask_mergeServicePortsForListener(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		ports   []corev1.ServicePort
+		want    corev1.ServicePort
+		wantErr error
+	}{
+		{
+			name: "TCP and UDP ports",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+					},
+				},
+			},
+			ports: []corev1.ServicePort{
+				{
+					Name:       "dns-udp",
+					Protocol:   corev1.ProtocolUDP,
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					NodePort:   31053,
+				},
+				{
+					Name:       "dns-tcp",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					NodePort:   31053,
+				},
+			},
+			want: corev1.ServicePort{
+				Name:       "dns-tcp",
+				Protocol:   corev1.Protocol(elbv2model.ProtocolTCP_UDP),
+				Port:       53,
+				TargetPort: intstr.FromInt(5353),
+				NodePort:   31053,
+			},
+		},
+		{
+			name: "TCP and UDP ports with different nodePort for ip targets",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+					},
+				},
+			},
+			ports: []corev1.ServicePort{
+				{
+					Name:       "dns-tcp",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					NodePort:   31053,
+				},
+				{
+					Name:       "dns-udp",
+					Protocol:   corev1.ProtocolUDP,
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					NodePort:   32053,
+				},
+			},
+			want: corev1.ServicePort{
+				Name:       "dns-tcp",
+				Protocol:   corev1.Protocol(elbv2model.ProtocolTCP_UDP),
+				Port:       53,
+				TargetPort: intstr.FromInt(5353),
+				NodePort:   31053,
+			},
+		},
+		{
+			name: "TCP and UDP ports with different nodePort for instance targets",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+					},
+				},
+			},
+			ports: []corev1.ServicePort{
+				{
+					Name:       "dns-tcp",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					NodePort:   31053,
+				},
+				{
+					Name:       "dns-udp",
+					Protocol:   corev1.ProtocolUDP,
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					NodePort:   32053,
+				},
+			},
+			wantErr: errors.New("TCP and UDP ports of port 53 must have the same nodePort for target type instance"),
+		},
+		{
+			name: "TCP and UDP ports with different targetPort",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+					},
+				},
+			},
+			ports: []corev1.ServicePort{
+				{
+					Name:       "dns-tcp",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+				},
+				{
+					Name:       "dns-udp",
+					Protocol:   corev1.ProtocolUDP,
+					Port:       53,
+					TargetPort: intstr.FromInt(53),
+				},
+			},
+			wantErr: errors.New("TCP and UDP ports of port 53 must have the same targetPort"),
+		},
+		{
+			name: "TCP and SCTP ports",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+					},
+				},
+			},
+			ports: []corev1.ServicePort{
+				{
+					Name:     "tcp",
+					Protocol: corev1.ProtocolTCP,
+					Port:     80,
+				},
+				{
+					Name:     "sctp",
+					Protocol: corev1.ProtocolSCTP,
+					Port:     80,
+				},
+			},
+			wantErr: errors.New("unsupported protocols [SCTP TCP] for port 80, only a pair of TCP and UDP ports can share the same port"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			builder := &defaultModelBuildTask{
+				annotationParser: parser,
+				service:          tt.svc,
+			}
+			got, err := builder.mergeServicePortsForListener(context.Background(), tt.ports)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
@@ -54,19 +55,46 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, schem
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, scheme, ipAddressType)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	adoptLBARN, preserveUnmanagedListeners, err := t.buildLoadBalancerAdoption(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	name := t.buildLoadBalancerName(ctx, scheme)
 	spec := elbv2model.LoadBalancerSpec{
-		Name:                   name,
-		Type:                   elbv2model.LoadBalancerTypeNetwork,
-		Scheme:                 &scheme,
-		IPAddressType:          &ipAddressType,
-		SubnetMappings:         subnetMappings,
-		LoadBalancerAttributes: lbAttributes,
-		Tags:                   tags,
+		Name:                       name,
+		Type:                       elbv2model.LoadBalancerTypeNetwork,
+		Scheme:                     &scheme,
+		IPAddressType:              &ipAddressType,
+		SubnetMappings:             subnetMappings,
+		SecurityGroups:             securityGroups,
+		LoadBalancerAttributes:     lbAttributes,
+		Tags:                       tags,
+		AdoptLoadBalancerARN:       adoptLBARN,
+		PreserveUnmanagedListeners: preserveUnmanagedListeners,
 	}
 	return spec, nil
 }
 
+// buildLoadBalancerAdoption builds the existing LoadBalancer to adopt for the service,
+// and whether listeners that are not managed by controller should be preserved on LoadBalancer.
+// Note: listeners not managed by controller are preserved on adopted LoadBalancer unless explicitly opted out.
+func (t *defaultModelBuildTask) buildLoadBalancerAdoption(_ context.Context) (*string, bool, error) {
+	var adoptLBARN *string
+	rawARN := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixAdoptARN, &rawARN, t.service.Annotations); exists {
+		adoptLBARN = &rawARN
+	}
+	preserveUnmanagedListeners := adoptLBARN != nil
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixPreserveUnmanagedListeners, &preserveUnmanagedListeners, t.service.Annotations); err != nil {
+		return nil, false, err
+	}
+	return adoptLBARN, preserveUnmanagedListeners, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
 	rawIPAddressType := ""
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIPAddressType, &rawIPAddressType, t.service.Annotations); !exists {
@@ -116,15 +144,14 @@ func (t *defaultModelBuildTask) buildLoadBalancerSchemeLegacyAnnotation(_ contex
 }
 
 func (t *defaultModelBuildTask) getExistingLoadBalancerScheme(ctx context.Context) (elbv2model.LoadBalancerScheme, error) {
-	stackTags := t.trackingProvider.StackTags(t.stack)
-	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	sdkLB, err := t.fetchExistingLoadBalancer(ctx)
 	if err != nil {
 		return "", err
 	}
-	if len(sdkLBs) == 0 {
+	if sdkLB == nil {
 		return elbv2model.LoadBalancerSchemeInternal, nil
 	}
-	switch aws.StringValue(sdkLBs[0].LoadBalancer.Scheme) {
+	switch aws.StringValue(sdkLB.LoadBalancer.Scheme) {
 	case string(elbv2model.LoadBalancerSchemeInternal):
 		return elbv2model.LoadBalancerSchemeInternal, nil
 	case string(elbv2model.LoadBalancerSchemeInternetFacing):
@@ -134,6 +161,23 @@ func (t *defaultModelBuildTask) getExistingLoadBalancerScheme(ctx context.Contex
 	}
 }
 
+// fetchExistingLoadBalancer returns the existing LoadBalancer for the service, or nil if there is none.
+func (t *defaultModelBuildTask) fetchExistingLoadBalancer(ctx context.Context) (*elbv2deploy.LoadBalancerWithTags, error) {
+	if t.existingLoadBalancerFetched {
+		return t.existingLoadBalancer, nil
+	}
+	stackTags := t.trackingProvider.StackTags(t.stack)
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return nil, err
+	}
+	if len(sdkLBs) != 0 {
+		t.existingLoadBalancer = &sdkLBs[0]
+	}
+	t.existingLoadBalancerFetched = true
+	return t.existingLoadBalancer, nil
+}
+
 func (t *defaultModelBuildTask) buildAdditionalResourceTags(_ context.Context) (map[string]string, error) {
 	var annotationTags map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixAdditionalTags, &annotationTags, t.service.Annotations); err != nil {
@@ -225,10 +269,66 @@ func (t *defaultModelBuildTask) resolveLoadBalancerSubnets(ctx context.Context,
 			networking.WithSubnetsResolveLBScheme(scheme),
 		)
 	}
-	return t.subnetsResolver.ResolveViaDiscovery(ctx,
+	existingLB, err := t.fetchExistingLoadBalancer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the subnets of existing LoadBalancer are kept, since rerunning subnet discovery can choose different subnets
+	// once the available IP addresses in subnets change. Subnets are only discovered when LoadBalancer will be created.
+	if existingLB != nil && string(scheme) == aws.StringValue(existingLB.LoadBalancer.Scheme) {
+		availabilityZones := existingLB.LoadBalancer.AvailabilityZones
+		subnetIDs := make([]string, 0, len(availabilityZones))
+		for _, availabilityZone := range availabilityZones {
+			subnetIDs = append(subnetIDs, aws.StringValue(availabilityZone.SubnetId))
+		}
+		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, subnetIDs,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBScheme(scheme),
+		)
+	}
+	subnetDiscoveryOpts, err := t.buildSubnetDiscoveryOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolveOpts := append([]networking.SubnetsResolveOption{
 		networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
 		networking.WithSubnetsResolveLBScheme(scheme),
-	)
+	}, subnetDiscoveryOpts...)
+	return t.subnetsResolver.ResolveViaDiscovery(ctx, resolveOpts...)
+}
+
+// buildSubnetDiscoveryOptions builds the options to discover subnets, based on the subnet discovery annotations.
+func (t *defaultModelBuildTask) buildSubnetDiscoveryOptions(_ context.Context) ([]networking.SubnetsResolveOption, error) {
+	resolveOpts := []networking.SubnetsResolveOption{
+		networking.WithSubnetsResolveEventRecorder(t.eventRecorder, t.service),
+	}
+	var rawSubnetTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixSubnetDiscoveryTags, &rawSubnetTags, t.service.Annotations); err != nil {
+		return nil, err
+	}
+	if len(rawSubnetTags) != 0 {
+		subnetTags := make(map[string][]string, len(rawSubnetTags))
+		for tagKey, tagValue := range rawSubnetTags {
+			if len(tagValue) == 0 {
+				subnetTags[tagKey] = nil
+			} else {
+				subnetTags[tagKey] = []string{tagValue}
+			}
+		}
+		resolveOpts = append(resolveOpts, networking.WithSubnetsResolveSubnetTags(subnetTags))
+	}
+	var minAvailableIPs int64
+	exists, err := t.annotationParser.ParseInt64Annotation(annotations.SvcLBSuffixSubnetDiscoveryMinIPs, &minAvailableIPs, t.service.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		if minAvailableIPs < 0 {
+			return nil, errors.Errorf("invalid %v annotation: %v", annotations.SvcLBSuffixSubnetDiscoveryMinIPs, minAvailableIPs)
+		}
+		resolveOpts = append(resolveOpts, networking.WithSubnetsResolveMinAvailableIPAddressCount(minAvailableIPs))
+	}
+	return resolveOpts, nil
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
@@ -241,6 +341,14 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 		return []elbv2model.LoadBalancerAttribute{}, err
 	}
 	mergedAttributes := algorithm.MergeStringMap(specificAttributes, loadBalancerAttributes)
+	deletionPolicy, err := BuildDeletionPolicy(t.annotationParser, t.service)
+	if err != nil {
+		return []elbv2model.LoadBalancerAttribute{}, err
+	}
+	// deletion protection is enabled for Protect policy, unless it's explicitly specified.
+	if _, exists := mergedAttributes[elbv2model.LoadBalancerAttributeDeletionProtectionEnabled]; !exists && deletionPolicy == elbv2model.DeletionPolicyProtect {
+		mergedAttributes[elbv2model.LoadBalancerAttributeDeletionProtectionEnabled] = "true"
+	}
 	return makeAttributesSliceFromMap(mergedAttributes), nil
 }
 
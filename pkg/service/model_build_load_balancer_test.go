@@ -9,11 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -144,6 +147,52 @@ func Test_defaultModelBuilderTask_buildLBAttributes(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName: "Deletion protection enabled for Protect policy",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Protect",
+					},
+				},
+			},
+			wantError: false,
+			wantValue: []elbv2.LoadBalancerAttribute{
+				{
+					Key:   lbAttrsDeletionProtectionEnabled,
+					Value: "true",
+				},
+			},
+		},
+		{
+			testName: "Explicit deletion protection honored for Protect policy",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Protect",
+						"service.beta.kubernetes.io/aws-load-balancer-attributes":      "deletion_protection.enabled=false",
+					},
+				},
+			},
+			wantError: false,
+			wantValue: []elbv2.LoadBalancerAttribute{
+				{
+					Key:   lbAttrsDeletionProtectionEnabled,
+					Value: "false",
+				},
+			},
+		},
+		{
+			testName: "Deletion policy invalid",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Keep",
+					},
+				},
+			},
+			wantError: true,
+		},
 		{
 			testName: "Annotation invalid",
 			svc: &corev1.Service{
@@ -536,8 +585,10 @@ func Test_defaultModelBuilderTask_resolveLoadBalancerSubnets(t *testing.T) {
 		name                     string
 		svc                      *corev1.Service
 		scheme                   elbv2.LoadBalancerScheme
+		existingLB               *elbv2deploy.LoadBalancerWithTags
 		resolveViaDiscovery      []resolveSubnetResults
 		resolveViaNameOrIDSlilce []resolveSubnetResults
+		want                     []*ec2.Subnet
 	}{
 		{
 			name:   "subnet auto-discovery",
@@ -553,6 +604,70 @@ func Test_defaultModelBuilderTask_resolveLoadBalancerSubnets(t *testing.T) {
 					},
 				},
 			},
+			want: []*ec2.Subnet{
+				{
+					SubnetId:  aws.String("subnet-1"),
+					CidrBlock: aws.String("192.168.0.0/19"),
+				},
+			},
+		},
+		{
+			name:   "subnets of existing load balancer",
+			svc:    &corev1.Service{},
+			scheme: elbv2.LoadBalancerSchemeInternal,
+			existingLB: &elbv2deploy.LoadBalancerWithTags{
+				LoadBalancer: &elbv2sdk.LoadBalancer{
+					Scheme: aws.String("internal"),
+					AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+						{SubnetId: aws.String("subnet-2")},
+					},
+				},
+			},
+			resolveViaNameOrIDSlilce: []resolveSubnetResults{
+				{
+					subnets: []*ec2.Subnet{
+						{
+							SubnetId:  aws.String("subnet-2"),
+							CidrBlock: aws.String("192.168.32.0/19"),
+						},
+					},
+				},
+			},
+			want: []*ec2.Subnet{
+				{
+					SubnetId:  aws.String("subnet-2"),
+					CidrBlock: aws.String("192.168.32.0/19"),
+				},
+			},
+		},
+		{
+			name:   "subnet auto-discovery when scheme of existing load balancer changes",
+			svc:    &corev1.Service{},
+			scheme: elbv2.LoadBalancerSchemeInternetFacing,
+			existingLB: &elbv2deploy.LoadBalancerWithTags{
+				LoadBalancer: &elbv2sdk.LoadBalancer{
+					Scheme: aws.String("internal"),
+					AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+						{SubnetId: aws.String("subnet-2")},
+					},
+				},
+			},
+			resolveViaDiscovery: []resolveSubnetResults{
+				{
+					subnets: []*ec2.Subnet{
+						{
+							SubnetId:  aws.String("subnet-1"),
+							CidrBlock: aws.String("192.168.0.0/19"),
+						},
+					},
+				},
+			},
+			want: []*ec2.Subnet{
+				{
+					SubnetId:  aws.String("subnet-1"),
+					CidrBlock: aws.String("192.168.0.0/19"),
+				},
+			},
 		},
 		{
 			name: "subnet annotation",
@@ -578,6 +693,16 @@ func Test_defaultModelBuilderTask_resolveLoadBalancerSubnets(t *testing.T) {
 					},
 				},
 			},
+			want: []*ec2.Subnet{
+				{
+					SubnetId:  aws.String("subnet-abc"),
+					CidrBlock: aws.String("192.168.0.0/19"),
+				},
+				{
+					SubnetId:  aws.String("subnet-xyz"),
+					CidrBlock: aws.String("192.168.0.0/19"),
+				},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -593,9 +718,12 @@ func Test_defaultModelBuilderTask_resolveLoadBalancerSubnets(t *testing.T) {
 				subnetsResolver.EXPECT().ResolveViaNameOrIDSlice(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
 			}
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
-			builder := &defaultModelBuildTask{service: tt.svc, annotationParser: annotationParser, subnetsResolver: subnetsResolver}
+			builder := &defaultModelBuildTask{service: tt.svc, annotationParser: annotationParser, subnetsResolver: subnetsResolver,
+				existingLoadBalancer: tt.existingLB, existingLoadBalancerFetched: true}
 
-			builder.resolveLoadBalancerSubnets(context.Background(), tt.scheme)
+			got, err := builder.resolveLoadBalancerSubnets(context.Background(), tt.scheme)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -849,3 +977,150 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildSubnetDiscoveryOptions(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		wantSubnetTags map[string][]string
+		wantMinIPs     int64
+		wantErr        error
+	}{
+		{
+			name:        "no subnet discovery annotations",
+			annotations: map[string]string{},
+			wantMinIPs:  8,
+		},
+		{
+			name: "subnet discovery annotations",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-subnet-discovery-tags":                       "tier=public,team=",
+				"service.beta.kubernetes.io/aws-load-balancer-subnet-discovery-min-available-ip-addresses": "32",
+			},
+			wantSubnetTags: map[string][]string{
+				"tier": {"public"},
+				"team": nil,
+			},
+			wantMinIPs: 32,
+		},
+		{
+			name: "negative min available ip addresses",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-subnet-discovery-min-available-ip-addresses": "-1",
+			},
+			wantErr: errors.New("invalid aws-load-balancer-subnet-discovery-min-available-ip-addresses annotation: -1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "svc-1",
+					Annotations: tt.annotations,
+				},
+			}
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service:          svc,
+			}
+			got, err := task.buildSubnetDiscoveryOptions(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				resolveOpts := networking.SubnetsResolveOptions{MinAvailableIPAddressCount: 8}
+				resolveOpts.ApplyOptions(got)
+				assert.Equal(t, tt.wantSubnetTags, resolveOpts.SubnetTags)
+				assert.Equal(t, tt.wantMinIPs, resolveOpts.MinAvailableIPAddressCount)
+				assert.Equal(t, []runtime.Object{svc}, resolveOpts.EventObjects)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerAdoption(t *testing.T) {
+	tests := []struct {
+		name                           string
+		service                        *corev1.Service
+		wantARN                        *string
+		wantPreserveUnmanagedListeners bool
+		wantErr                        error
+	}{
+		{
+			name: "adoption not configured",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+				},
+			},
+			wantARN: nil,
+		},
+		{
+			name: "adoption configured",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-adopt-arn":                    "lb-arn",
+						"service.beta.kubernetes.io/aws-load-balancer-preserve-unmanaged-listeners": "true",
+					},
+				},
+			},
+			wantARN:                        aws.String("lb-arn"),
+			wantPreserveUnmanagedListeners: true,
+		},
+		{
+			name: "unmanaged listeners preserved on adopted loadBalancer by default",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-adopt-arn": "lb-arn",
+					},
+				},
+			},
+			wantARN:                        aws.String("lb-arn"),
+			wantPreserveUnmanagedListeners: true,
+		},
+		{
+			name: "unmanaged listeners deleted on adopted loadBalancer when opted out",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-adopt-arn":                    "lb-arn",
+						"service.beta.kubernetes.io/aws-load-balancer-preserve-unmanaged-listeners": "false",
+					},
+				},
+			},
+			wantARN:                        aws.String("lb-arn"),
+			wantPreserveUnmanagedListeners: false,
+		},
+		{
+			name: "invalid preserve-unmanaged-listeners annotation",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-adopt-arn":                    "lb-arn",
+						"service.beta.kubernetes.io/aws-load-balancer-preserve-unmanaged-listeners": "yes",
+					},
+				},
+			},
+			wantErr: errors.New("failed to parse bool annotation, service.beta.kubernetes.io/aws-load-balancer-preserve-unmanaged-listeners: yes: strconv.ParseBool: parsing \"yes\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service:          tt.service,
+			}
+			gotARN, gotPreserveUnmanagedListeners, err := builder.buildLoadBalancerAdoption(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantARN, gotARN)
+				assert.Equal(t, tt.wantPreserveUnmanagedListeners, gotPreserveUnmanagedListeners)
+			}
+		})
+	}
+}
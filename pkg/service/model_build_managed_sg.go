@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	resourceIDManagedSecurityGroup = "ManagedLBSecurityGroup"
+)
+
+// buildLoadBalancerSecurityGroups builds the frontend securityGroups of the NLB.
+// NLBs created without securityGroups cannot have securityGroups attached later, such NLBs are left without securityGroups.
+// NLBs created with securityGroups cannot have all securityGroups removed, such NLBs keep their securityGroups even if NLB securityGroups are disabled.
+func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
+	var sgNameOrIDs []string
+	explicitSGs := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSecurityGroups, &sgNameOrIDs, t.service.Annotations)
+	existingLB, err := t.fetchExistingLoadBalancer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingLBWithSGs := existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) != 0
+	if !t.enableNLBSGs && !existingLBWithSGs {
+		if explicitSGs {
+			return nil, errors.Errorf("NLB security groups must be enabled to use %v annotation", annotations.SvcLBSuffixSecurityGroups)
+		}
+		return nil, nil
+	}
+	if existingLB != nil && !existingLBWithSGs {
+		if explicitSGs {
+			return nil, errors.Errorf("securityGroups cannot be added to existing NLB without securityGroups: %v", aws.StringValue(existingLB.LoadBalancer.LoadBalancerArn))
+		}
+		return nil, nil
+	}
+
+	if !explicitSGs {
+		sg, err := t.buildManagedSecurityGroup(ctx, scheme, ipAddressType)
+		if err != nil {
+			return nil, err
+		}
+		return []core.StringToken{sg.GroupID()}, nil
+	}
+	sgIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, sgNameOrIDs)
+	if err != nil {
+		return nil, err
+	}
+	sgIDTokens := make([]core.StringToken, 0, len(sgIDs))
+	for _, sgID := range sgIDs {
+		sgIDTokens = append(sgIDTokens, core.LiteralStringToken(sgID))
+	}
+	return sgIDTokens, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) (*ec2model.SecurityGroup, error) {
+	sgSpec, err := t.buildManagedSecurityGroupSpec(ctx, scheme, ipAddressType)
+	if err != nil {
+		return nil, err
+	}
+	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	t.managedSG = sg
+	return sg, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupSpec(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) (ec2model.SecurityGroupSpec, error) {
+	name := t.buildManagedSecurityGroupName(ctx)
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	ingressPermissions, err := t.buildManagedSecurityGroupIngressPermissions(ctx, scheme, ipAddressType)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	return ec2model.SecurityGroupSpec{
+		GroupName:   name,
+		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+		Tags:        tags,
+		Ingress:     ingressPermissions,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.service.UID))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) ([]ec2model.IPPermission, error) {
+	sourceRanges := t.buildLoadBalancerSourceRanges(ctx)
+	if len(sourceRanges) == 0 {
+		if scheme == elbv2model.LoadBalancerSchemeInternal {
+			vpcCIDRs, err := t.vpcResolver.ResolveCIDRs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sourceRanges = vpcCIDRs
+		} else {
+			sourceRanges = []string{"0.0.0.0/0"}
+			if ipAddressType == elbv2model.IPAddressTypeDualStack {
+				sourceRanges = append(sourceRanges, "::/0")
+			}
+		}
+	}
+
+	var permissions []ec2model.IPPermission
+	for _, port := range t.service.Spec.Ports {
+		ipProtocol := strings.ToLower(string(port.Protocol))
+		for _, cidr := range sourceRanges {
+			permission := ec2model.IPPermission{
+				IPProtocol: ipProtocol,
+				FromPort:   aws.Int64(int64(port.Port)),
+				ToPort:     aws.Int64(int64(port.Port)),
+			}
+			if strings.Contains(cidr, ":") {
+				if ipAddressType != elbv2model.IPAddressTypeDualStack {
+					continue
+				}
+				permission.IPv6Range = []ec2model.IPv6Range{
+					{
+						CIDRIPv6: cidr,
+					},
+				}
+			} else {
+				permission.IPRanges = []ec2model.IPRange{
+					{
+						CIDRIP: cidr,
+					},
+				}
+			}
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions, nil
+}
+
+// buildTargetGroupBindingNetworkingWithSecurityGroup builds the networking rules that allow traffic from the NLB's managed securityGroup.
+// returns nil if securityGroups are specified explicitly, in which case the backend rules are managed by users.
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingWithSecurityGroup(_ context.Context, tgPort intstr.IntOrString,
+	hcPort intstr.IntOrString, tgProtocol corev1.Protocol) *elbv2model.TargetGroupBindingNetworking {
+	if t.managedSG == nil {
+		return nil
+	}
+	networkingProtocol := elbv2api.NetworkingProtocolTCP
+	if tgProtocol == corev1.ProtocolUDP {
+		networkingProtocol = elbv2api.NetworkingProtocolUDP
+	}
+	ports := []elbv2api.NetworkingPort{
+		{
+			Port:     &tgPort,
+			Protocol: &networkingProtocol,
+		},
+	}
+	if tgProtocol == corev1.Protocol(elbv2model.ProtocolTCP_UDP) {
+		networkingProtocolUDP := elbv2api.NetworkingProtocolUDP
+		ports = append(ports, elbv2api.NetworkingPort{
+			Port:     &tgPort,
+			Protocol: &networkingProtocolUDP,
+		})
+	}
+	if tgProtocol == corev1.ProtocolUDP || (hcPort.String() != healthCheckPortTrafficPort && hcPort.IntValue() != tgPort.IntValue()) {
+		networkingProtocolTCP := elbv2api.NetworkingProtocolTCP
+		networkingHealthCheckPort := hcPort
+		if hcPort.String() == healthCheckPortTrafficPort {
+			networkingHealthCheckPort = tgPort
+		}
+		ports = append(ports, elbv2api.NetworkingPort{
+			Port:     &networkingHealthCheckPort,
+			Protocol: &networkingProtocolTCP,
+		})
+	}
+	return &elbv2model.TargetGroupBindingNetworking{
+		Ingress: []elbv2model.NetworkingIngressRule{
+			{
+				From: []elbv2model.NetworkingPeer{
+					{
+						SecurityGroup: &elbv2model.SecurityGroup{
+							GroupID: t.managedSG.GroupID(),
+						},
+					},
+				},
+				Ports: ports,
+			},
+		},
+	}
+}
@@ -38,6 +38,9 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 	if err != nil {
 		return nil, err
 	}
+	if targetType == elbv2model.TargetTypeALB {
+		return t.buildALBTargetGroup(ctx, tgResourceID, port, tgProtocol)
+	}
 	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, targetType)
 	if err != nil {
 		return nil, err
@@ -69,9 +72,13 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, tgProt
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, targetType)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
 	targetPort := t.buildTargetGroupPort(ctx, targetType, port)
-	tgName := t.buildTargetGroupName(ctx, intstr.FromInt(int(port.Port)), targetPort, targetType, tgProtocol, healthCheckConfig)
-	return elbv2model.TargetGroupSpec{
+	tgName := t.buildTargetGroupName(ctx, intstr.FromInt(int(port.Port)), targetPort, targetType, tgProtocol, healthCheckConfig, ipAddressType)
+	tgSpec := elbv2model.TargetGroupSpec{
 		Name:                  tgName,
 		TargetType:            targetType,
 		Port:                  targetPort,
@@ -79,7 +86,23 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, tgProt
 		HealthCheckConfig:     healthCheckConfig,
 		TargetGroupAttributes: tgAttrs,
 		Tags:                  tags,
-	}, nil
+	}
+	if ipAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+		tgSpec.IPAddressType = &ipAddressType
+	}
+	return tgSpec, nil
+}
+
+// buildTargetGroupIPAddressType constructs the TargetGroup's IPAddressType.
+// ip targetType uses IPv6 TargetGroup when Service's primary IP family is IPv6, which can only be fronted by a dualstack LoadBalancer.
+func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context, targetType elbv2model.TargetType) (elbv2model.TargetGroupIPAddressType, error) {
+	if targetType != elbv2model.TargetTypeIP || len(t.service.Spec.IPFamilies) == 0 || t.service.Spec.IPFamilies[0] != corev1.IPv6Protocol {
+		return elbv2model.TargetGroupIPAddressTypeIPv4, nil
+	}
+	if t.loadBalancer == nil || t.loadBalancer.Spec.IPAddressType == nil || *t.loadBalancer.Spec.IPAddressType != elbv2model.IPAddressTypeDualStack {
+		return "", errors.Errorf("unsupported IPv6 configuration, load balancer IPAddressType must be %v for IPv6 targets", elbv2model.IPAddressTypeDualStack)
+	}
+	return elbv2model.TargetGroupIPAddressTypeIPv6, nil
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, targetType elbv2model.TargetType) (*elbv2model.TargetGroupHealthCheckConfig, error) {
@@ -163,7 +186,7 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigForInstanceMode
 var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
 
 func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort intstr.IntOrString, tgPort int64,
-	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, hc *elbv2model.TargetGroupHealthCheckConfig) string {
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, hc *elbv2model.TargetGroupHealthCheckConfig, ipAddressType elbv2model.TargetGroupIPAddressType) string {
 	healthCheckProtocol := string(elbv2model.ProtocolTCP)
 	healthCheckInterval := strconv.FormatInt(t.defaultHealthCheckInterval, 10)
 	if hc.Protocol != nil {
@@ -181,6 +204,10 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort
 	_, _ = uuidHash.Write([]byte(tgProtocol))
 	_, _ = uuidHash.Write([]byte(healthCheckProtocol))
 	_, _ = uuidHash.Write([]byte(healthCheckInterval))
+	// IPAddressType is only hashed for IPv6 to keep names of existing IPv4 TargetGroups unchanged.
+	if ipAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+		_, _ = uuidHash.Write([]byte(ipAddressType))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(t.service.Namespace, "")
@@ -254,6 +281,10 @@ func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetTy
 	if svcPort.TargetPort.Type == intstr.Int {
 		return int64(svcPort.TargetPort.IntValue())
 	}
+	// for alb targetType, the TargetGroup's port is the listener port of registered Application LoadBalancer, which is in the data path.
+	if targetType == elbv2model.TargetTypeALB {
+		return int64(svcPort.Port)
+	}
 
 	// when a literal targetPort is used, we just use a fixed 1 here as this setting is not in the data path.
 	// also, under extreme edge case, it can actually be different ports for different pods.
@@ -332,6 +363,13 @@ func (t *defaultModelBuildTask) buildTargetType(_ context.Context) (elbv2model.T
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, t.service.Annotations)
 	var lbTargetType string
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &lbTargetType, t.service.Annotations)
+	// Services selected via spec.loadBalancerClass don't need the aws-load-balancer-type annotation, and default to instance targets.
+	if lbType == "" && t.service.Spec.LoadBalancerClass != nil {
+		lbType = LoadBalancerTypeExternal
+		if lbTargetType == "" {
+			lbTargetType = LoadBalancerTargetTypeInstance
+		}
+	}
 	if lbType == LoadBalancerTypeNLBIP || (lbType == LoadBalancerTypeExternal && lbTargetType == LoadBalancerTargetTypeIP) {
 		return elbv2model.TargetTypeIP, nil
 	}
@@ -341,6 +379,9 @@ func (t *defaultModelBuildTask) buildTargetType(_ context.Context) (elbv2model.T
 		}
 		return elbv2model.TargetTypeInstance, nil
 	}
+	if lbType == LoadBalancerTypeExternal && lbTargetType == LoadBalancerTargetTypeALB {
+		return elbv2model.TargetTypeALB, nil
+	}
 	return "", errors.Errorf("unsupported target type \"%v\" for load balancer type \"%v\"", lbTargetType, lbType)
 }
 
@@ -372,14 +413,35 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	if targetType == elbv2api.TargetTypeInstance {
 		targetPort = intstr.FromInt(int(port.NodePort))
 	}
-	defaultSourceRanges := []string{"0.0.0.0/0"}
-	if (port.Protocol == corev1.ProtocolUDP || preserveClientIP) && scheme == elbv2model.LoadBalancerSchemeInternal {
-		defaultSourceRanges, err = t.vpcResolver.ResolveCIDRs(ctx)
-		if err != nil {
-			return elbv2model.TargetGroupBindingResourceSpec{}, err
+	ipAddressType := elbv2model.TargetGroupIPAddressTypeIPv4
+	if targetGroup.Spec.IPAddressType != nil {
+		ipAddressType = *targetGroup.Spec.IPAddressType
+	}
+	var tgbNetworking *elbv2model.TargetGroupBindingNetworking
+	if t.loadBalancer != nil && len(t.loadBalancer.Spec.SecurityGroups) != 0 {
+		tgbNetworking = t.buildTargetGroupBindingNetworkingWithSecurityGroup(ctx, targetPort, *hc.Port, port.Protocol)
+	} else {
+		defaultSourceRanges := []string{"0.0.0.0/0"}
+		if ipAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+			defaultSourceRanges = []string{"::/0"}
+		}
+		if (port.Protocol == corev1.ProtocolUDP || port.Protocol == corev1.Protocol(elbv2model.ProtocolTCP_UDP) || preserveClientIP) &&
+			scheme == elbv2model.LoadBalancerSchemeInternal {
+			if ipAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+				defaultSourceRanges, err = t.vpcResolver.ResolveIPv6CIDRs(ctx)
+			} else {
+				defaultSourceRanges, err = t.vpcResolver.ResolveCIDRs(ctx)
+			}
+			if err != nil {
+				return elbv2model.TargetGroupBindingResourceSpec{}, err
+			}
 		}
+		tgbNetworking = t.buildTargetGroupBindingNetworking(ctx, targetPort, preserveClientIP, *hc.Port, port.Protocol, defaultSourceRanges, ipAddressType)
+	}
+	var tgbIPAddressType *elbv2api.TargetGroupIPAddressType
+	if targetGroup.Spec.IPAddressType != nil {
+		tgbIPAddressType = (*elbv2api.TargetGroupIPAddressType)(targetGroup.Spec.IPAddressType)
 	}
-	tgbNetworking := t.buildTargetGroupBindingNetworking(ctx, targetPort, preserveClientIP, *hc.Port, port.Protocol, defaultSourceRanges)
 	return elbv2model.TargetGroupBindingResourceSpec{
 		Template: elbv2model.TargetGroupBindingTemplate{
 			ObjectMeta: metav1.ObjectMeta{
@@ -393,23 +455,30 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 					Name: t.service.Name,
 					Port: intstr.FromInt(int(port.Port)),
 				},
-				Networking:   tgbNetworking,
-				NodeSelector: nodeSelector,
+				Networking:    tgbNetworking,
+				NodeSelector:  nodeSelector,
+				IPAddressType: tgbIPAddressType,
 			},
 		},
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildPeersFromSourceRanges(_ context.Context, defaultSourceRanges []string) ([]elbv2model.NetworkingPeer, bool) {
+// buildLoadBalancerSourceRanges returns the source ranges specified via service spec or annotation.
+func (t *defaultModelBuildTask) buildLoadBalancerSourceRanges(_ context.Context) []string {
 	var sourceRanges []string
-	var peers []elbv2model.NetworkingPeer
-	customSourceRangesConfigured := true
 	for _, cidr := range t.service.Spec.LoadBalancerSourceRanges {
 		sourceRanges = append(sourceRanges, cidr)
 	}
 	if len(sourceRanges) == 0 {
 		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSourceRanges, &sourceRanges, t.service.Annotations)
 	}
+	return sourceRanges
+}
+
+func (t *defaultModelBuildTask) buildPeersFromSourceRanges(ctx context.Context, defaultSourceRanges []string) ([]elbv2model.NetworkingPeer, bool) {
+	var peers []elbv2model.NetworkingPeer
+	customSourceRangesConfigured := true
+	sourceRanges := t.buildLoadBalancerSourceRanges(ctx)
 	if len(sourceRanges) == 0 {
 		sourceRanges = defaultSourceRanges
 		customSourceRangesConfigured = false
@@ -425,15 +494,28 @@ func (t *defaultModelBuildTask) buildPeersFromSourceRanges(_ context.Context, de
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Context, tgPort intstr.IntOrString, preserveClientIP bool,
-	hcPort intstr.IntOrString, tgProtocol corev1.Protocol, defaultSourceRanges []string) *elbv2model.TargetGroupBindingNetworking {
+	hcPort intstr.IntOrString, tgProtocol corev1.Protocol, defaultSourceRanges []string, ipAddressType elbv2model.TargetGroupIPAddressType) *elbv2model.TargetGroupBindingNetworking {
 	var fromVPC []elbv2model.NetworkingPeer
 	for _, subnet := range t.ec2Subnets {
+		if ipAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+			for _, ipv6CIDRAssociation := range subnet.Ipv6CidrBlockAssociationSet {
+				fromVPC = append(fromVPC, elbv2model.NetworkingPeer{
+					IPBlock: &elbv2api.IPBlock{
+						CIDR: aws.StringValue(ipv6CIDRAssociation.Ipv6CidrBlock),
+					},
+				})
+			}
+			continue
+		}
 		fromVPC = append(fromVPC, elbv2model.NetworkingPeer{
 			IPBlock: &elbv2api.IPBlock{
 				CIDR: aws.StringValue(subnet.CidrBlock),
 			},
 		})
 	}
+	if tgProtocol == corev1.Protocol(elbv2model.ProtocolTCP_UDP) {
+		return t.buildTargetGroupBindingNetworkingForTCPUDP(ctx, fromVPC, tgPort, preserveClientIP, hcPort, defaultSourceRanges)
+	}
 	networkingProtocol := elbv2api.NetworkingProtocolTCP
 	if tgProtocol == corev1.ProtocolUDP {
 		networkingProtocol = elbv2api.NetworkingProtocolUDP
@@ -464,6 +546,51 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 	return tgbNetworking
 }
 
+// buildTargetGroupBindingNetworkingForTCPUDP constructs the networking rules for TCP_UDP TargetGroup.
+// UDP traffic always preserves client IP, while TCP traffic comes from LoadBalancer's subnets unless client IP preservation is enabled.
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingForTCPUDP(ctx context.Context, fromVPC []elbv2model.NetworkingPeer, tgPort intstr.IntOrString,
+	preserveClientIP bool, hcPort intstr.IntOrString, defaultSourceRanges []string) *elbv2model.TargetGroupBindingNetworking {
+	networkingProtocolTCP := elbv2api.NetworkingProtocolTCP
+	networkingProtocolUDP := elbv2api.NetworkingProtocolUDP
+	trafficSource, customSourceRangesConfigured := t.buildPeersFromSourceRanges(ctx, defaultSourceRanges)
+	var clientTrafficPorts []elbv2api.NetworkingPort
+	if preserveClientIP {
+		clientTrafficPorts = append(clientTrafficPorts, elbv2api.NetworkingPort{
+			Port:     &tgPort,
+			Protocol: &networkingProtocolTCP,
+		})
+	}
+	clientTrafficPorts = append(clientTrafficPorts, elbv2api.NetworkingPort{
+		Port:     &tgPort,
+		Protocol: &networkingProtocolUDP,
+	})
+	tgbNetworking := &elbv2model.TargetGroupBindingNetworking{
+		Ingress: []elbv2model.NetworkingIngressRule{
+			{
+				From:  trafficSource,
+				Ports: clientTrafficPorts,
+			},
+		},
+	}
+	if !preserveClientIP {
+		tgbNetworking.Ingress = append(tgbNetworking.Ingress, elbv2model.NetworkingIngressRule{
+			From: fromVPC,
+			Ports: []elbv2api.NetworkingPort{
+				{
+					Port:     &tgPort,
+					Protocol: &networkingProtocolTCP,
+				},
+			},
+		})
+	}
+	// health checks are performed over TCP, which is covered by the TCP traffic rules same as TCP TargetGroup.
+	if hcIngressRules := t.buildHealthCheckNetworkingIngressRules(trafficSource, fromVPC, tgPort, hcPort, corev1.ProtocolTCP,
+		preserveClientIP, customSourceRangesConfigured); len(hcIngressRules) > 0 {
+		tgbNetworking.Ingress = append(tgbNetworking.Ingress, hcIngressRules...)
+	}
+	return tgbNetworking
+}
+
 func (t *defaultModelBuildTask) buildTargetGroupBindingNodeSelector(_ context.Context, targetType elbv2model.TargetType) (*metav1.LabelSelector, error) {
 	if targetType != elbv2model.TargetTypeInstance {
 		return nil, nil
@@ -491,7 +618,7 @@ func (t *defaultModelBuildTask) buildHealthCheckNetworkingIngressRules(trafficSo
 			return []elbv2model.NetworkingIngressRule{}
 		}
 		for _, src := range trafficSource {
-			if src.IPBlock.CIDR == "0.0.0.0/0" {
+			if src.IPBlock.CIDR == "0.0.0.0/0" || src.IPBlock.CIDR == "::/0" {
 				return []elbv2model.NetworkingIngressRule{}
 			}
 		}
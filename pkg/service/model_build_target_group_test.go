@@ -391,6 +391,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworking(t *testing.T
 		tgProtocol          corev1.Protocol
 		preserveClientIP    bool
 		defaultSourceRanges []string
+		ipAddressType       elbv2.TargetGroupIPAddressType
 		want                *elbv2.TargetGroupBindingNetworking
 	}{
 		{
@@ -869,12 +870,203 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworking(t *testing.T
 				},
 			},
 		},
+		{
+			name:   "ipv6 tcp-service with preserve client IP",
+			svc:    &corev1.Service{},
+			tgPort: port80,
+			hcPort: trafficPort,
+			subnets: []*ec2.Subnet{{
+				CidrBlock: aws.String("172.16.0.0/19"),
+				SubnetId:  aws.String("az-1"),
+				Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					{
+						Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
+					},
+				},
+			}},
+			tgProtocol:          corev1.ProtocolTCP,
+			preserveClientIP:    true,
+			defaultSourceRanges: []string{"::/0"},
+			ipAddressType:       elbv2.TargetGroupIPAddressTypeIPv6,
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "::/0",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "ipv6 tcp-service",
+			svc:    &corev1.Service{},
+			tgPort: port80,
+			hcPort: port808,
+			subnets: []*ec2.Subnet{{
+				CidrBlock: aws.String("172.16.0.0/19"),
+				SubnetId:  aws.String("az-1"),
+				Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					{
+						Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
+					},
+				},
+			}},
+			tgProtocol:          corev1.ProtocolTCP,
+			defaultSourceRanges: []string{"::/0"},
+			ipAddressType:       elbv2.TargetGroupIPAddressTypeIPv6,
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "2600:1f13:837:8500::/64",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "2600:1f13:837:8500::/64",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port808,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "tcp_udp-service",
+			svc:    &corev1.Service{},
+			tgPort: port80,
+			hcPort: trafficPort,
+			subnets: []*ec2.Subnet{{
+				CidrBlock: aws.String("172.16.0.0/19"),
+				SubnetId:  aws.String("az-1"),
+			}},
+			tgProtocol:          corev1.Protocol(elbv2.ProtocolTCP_UDP),
+			defaultSourceRanges: []string{"0.0.0.0/0"},
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "0.0.0.0/0",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+						},
+					},
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.0.0/19",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tcp_udp-service with source ranges and preserve client IP",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					LoadBalancerSourceRanges: []string{"10.0.0.0/16"},
+				},
+			},
+			tgPort: port80,
+			hcPort: trafficPort,
+			subnets: []*ec2.Subnet{{
+				CidrBlock: aws.String("172.16.0.0/19"),
+				SubnetId:  aws.String("az-1"),
+			}},
+			tgProtocol:          corev1.Protocol(elbv2.ProtocolTCP_UDP),
+			preserveClientIP:    true,
+			defaultSourceRanges: []string{"0.0.0.0/0"},
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "10.0.0.0/16",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+						},
+					},
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.0.0/19",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			parser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			builder := &defaultModelBuildTask{service: tt.svc, annotationParser: parser, ec2Subnets: tt.subnets}
-			got := builder.buildTargetGroupBindingNetworking(context.Background(), tt.tgPort, tt.preserveClientIP, tt.hcPort, tt.tgProtocol, tt.defaultSourceRanges)
+			got := builder.buildTargetGroupBindingNetworking(context.Background(), tt.tgPort, tt.preserveClientIP, tt.hcPort, tt.tgProtocol, tt.defaultSourceRanges, tt.ipAddressType)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -1022,6 +1214,18 @@ func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
 			},
 			want: elbv2.TargetTypeIP,
 		},
+		{
+			testName: "lb type external, target alb",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "alb",
+					},
+				},
+			},
+			want: elbv2.TargetTypeALB,
+		},
 		{
 			testName: "external, no target type",
 			svc: &corev1.Service{
@@ -1060,6 +1264,31 @@ func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
 			},
 			wantErr: errors.New("unsupported service type \"ClusterIP\" for load balancer target type \"instance\""),
 		},
+		{
+			testName: "loadBalancerClass, no target type",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: aws.String("service.k8s.aws/nlb"),
+				},
+			},
+			want: elbv2.TargetTypeInstance,
+		},
+		{
+			testName: "loadBalancerClass, target ip",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: aws.String("service.k8s.aws/nlb"),
+				},
+			},
+			want: elbv2.TargetTypeIP,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -1154,6 +1383,90 @@ func Test_defaultModelBuilder_buildTargetGroupBindingNodeSelector(t *testing.T)
 	}
 }
 
+func Test_defaultModelBuilder_buildTargetGroupIPAddressType(t *testing.T) {
+	ipAddressTypeIPV4 := elbv2.IPAddressTypeIPV4
+	ipAddressTypeDualStack := elbv2.IPAddressTypeDualStack
+	tests := []struct {
+		testName      string
+		svc           *corev1.Service
+		targetType    elbv2.TargetType
+		ipAddressType *elbv2.IPAddressType
+		want          elbv2.TargetGroupIPAddressType
+		wantErr       error
+	}{
+		{
+			testName:      "IP target without IP families",
+			svc:           &corev1.Service{},
+			targetType:    elbv2.TargetTypeIP,
+			ipAddressType: &ipAddressTypeIPV4,
+			want:          elbv2.TargetGroupIPAddressTypeIPv4,
+		},
+		{
+			testName: "IP target with IPv4 primary IP family",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				},
+			},
+			targetType:    elbv2.TargetTypeIP,
+			ipAddressType: &ipAddressTypeDualStack,
+			want:          elbv2.TargetGroupIPAddressTypeIPv4,
+		},
+		{
+			testName: "IP target with IPv6 primary IP family",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+				},
+			},
+			targetType:    elbv2.TargetTypeIP,
+			ipAddressType: &ipAddressTypeDualStack,
+			want:          elbv2.TargetGroupIPAddressTypeIPv6,
+		},
+		{
+			testName: "IP target with IPv6 primary IP family on ipv4 load balancer",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+				},
+			},
+			targetType:    elbv2.TargetTypeIP,
+			ipAddressType: &ipAddressTypeIPV4,
+			wantErr:       errors.New("unsupported IPv6 configuration, load balancer IPAddressType must be dualstack for IPv6 targets"),
+		},
+		{
+			testName: "Instance target with IPv6 primary IP family",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+				},
+			},
+			targetType:    elbv2.TargetTypeInstance,
+			ipAddressType: &ipAddressTypeDualStack,
+			want:          elbv2.TargetGroupIPAddressTypeIPv4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			builder := &defaultModelBuildTask{
+				service: tt.svc,
+				loadBalancer: &elbv2.LoadBalancer{
+					Spec: elbv2.LoadBalancerSpec{
+						IPAddressType: tt.ipAddressType,
+					},
+				},
+			}
+			got, err := builder.buildTargetGroupIPAddressType(context.Background(), tt.targetType)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuilder_buildTargetGroupHealthCheckPort(t *testing.T) {
 	tests := []struct {
 		testName    string
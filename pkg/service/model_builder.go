@@ -7,13 +7,16 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -21,6 +24,7 @@ const (
 	LoadBalancerTypeExternal       = "external"
 	LoadBalancerTargetTypeIP       = "ip"
 	LoadBalancerTargetTypeInstance = "instance"
+	LoadBalancerTargetTypeALB      = "alb"
 )
 
 // ModelBuilder builds the model stack for the service resource.
@@ -30,28 +34,37 @@ type ModelBuilder interface {
 }
 
 // NewDefaultModelBuilder construct a new defaultModelBuilder
-func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
-	vpcResolver networking.VPCResolver, trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager,
-	clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string) *defaultModelBuilder {
+func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventRecorder, annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
+	vpcResolver networking.VPCResolver, sgResolver networking.SecurityGroupResolver, trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager,
+	clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string, enableCertImport bool,
+	enableNLBSecurityGroups bool) *defaultModelBuilder {
 	return &defaultModelBuilder{
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
 		annotationParser:    annotationParser,
 		subnetsResolver:     subnetsResolver,
 		vpcResolver:         vpcResolver,
+		sgResolver:          sgResolver,
 		trackingProvider:    trackingProvider,
 		elbv2TaggingManager: elbv2TaggingManager,
 		clusterName:         clusterName,
 		defaultTags:         defaultTags,
 		externalManagedTags: sets.NewString(externalManagedTags...),
 		defaultSSLPolicy:    defaultSSLPolicy,
+		enableCertImport:    enableCertImport,
+		enableNLBSGs:        enableNLBSecurityGroups,
 	}
 }
 
 var _ ModelBuilder = &defaultModelBuilder{}
 
 type defaultModelBuilder struct {
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
 	annotationParser    annotations.Parser
 	subnetsResolver     networking.SubnetsResolver
 	vpcResolver         networking.VPCResolver
+	sgResolver          networking.SecurityGroupResolver
 	trackingProvider    tracking.Provider
 	elbv2TaggingManager elbv2deploy.TaggingManager
 
@@ -59,17 +72,24 @@ type defaultModelBuilder struct {
 	defaultTags         map[string]string
 	externalManagedTags sets.String
 	defaultSSLPolicy    string
+	enableCertImport    bool
+	enableNLBSGs        bool
 }
 
 func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error) {
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(service)))
 	task := &defaultModelBuildTask{
+		k8sClient:           b.k8sClient,
+		eventRecorder:       b.eventRecorder,
 		clusterName:         b.clusterName,
 		annotationParser:    b.annotationParser,
 		subnetsResolver:     b.subnetsResolver,
 		vpcResolver:         b.vpcResolver,
+		sgResolver:          b.sgResolver,
 		trackingProvider:    b.trackingProvider,
 		elbv2TaggingManager: b.elbv2TaggingManager,
+		enableCertImport:    b.enableCertImport,
+		enableNLBSGs:        b.enableNLBSGs,
 
 		service:   service,
 		stack:     stack,
@@ -99,6 +119,8 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		defaultHealthCheckTimeoutForInstanceModeLocal:            6,
 		defaultHealthCheckHealthyThresholdForInstanceModeLocal:   2,
 		defaultHealthCheckUnhealthyThresholdForInstanceModeLocal: 2,
+
+		defaultHealthCheckProtocolForALBTarget: elbv2model.ProtocolHTTP,
 	}
 
 	if err := task.run(ctx); err != nil {
@@ -108,20 +130,33 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 }
 
 type defaultModelBuildTask struct {
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
 	clusterName         string
 	annotationParser    annotations.Parser
 	subnetsResolver     networking.SubnetsResolver
 	vpcResolver         networking.VPCResolver
+	sgResolver          networking.SecurityGroupResolver
 	trackingProvider    tracking.Provider
 	elbv2TaggingManager elbv2deploy.TaggingManager
+	enableCertImport    bool
+	enableNLBSGs        bool
 
 	service *corev1.Service
 
 	stack        core.Stack
 	loadBalancer *elbv2model.LoadBalancer
+	managedSG    *ec2model.SecurityGroup
 	tgByResID    map[string]*elbv2model.TargetGroup
 	ec2Subnets   []*ec2.Subnet
 
+	// the existing LoadBalancer for the service, only fetched once on demand.
+	existingLoadBalancer        *elbv2deploy.LoadBalancerWithTags
+	existingLoadBalancerFetched bool
+
+	// the ARN of Application LoadBalancer registered as target for alb targetType, only resolved once on demand.
+	targetALBARN string
+
 	defaultTags                          map[string]string
 	externalManagedTags                  sets.String
 	defaultSSLPolicy                     string
@@ -148,6 +183,9 @@ type defaultModelBuildTask struct {
 	defaultHealthCheckTimeoutForInstanceModeLocal            int64
 	defaultHealthCheckHealthyThresholdForInstanceModeLocal   int64
 	defaultHealthCheckUnhealthyThresholdForInstanceModeLocal int64
+
+	// Default health check protocol for alb targetType, Application LoadBalancer targets only support HTTP and HTTPS health checks.
+	defaultHealthCheckProtocolForALBTarget elbv2model.Protocol
 }
 
 func (t *defaultModelBuildTask) run(ctx context.Context) error {
@@ -26,6 +26,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		subnets []*ec2.Subnet
 		err     error
 	}
+	type resolveViaNameOrIDSliceCall struct {
+		subnetNameOrIDs []string
+		subnets         []*ec2.Subnet
+		err             error
+	}
 	type listLoadBalancerCall struct {
 		sdkLBs []elbv2.LoadBalancerWithTags
 		err    error
@@ -34,6 +39,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		cidrs []string
 		err   error
 	}
+	type resolveSGViaNameOrIDCall struct {
+		sgNameOrIDs []string
+		sgIDs       []string
+		err         error
+	}
 	resolveViaDiscoveryCallForOneSubnet := resolveViaDiscoveryCall{
 		subnets: []*ec2.Subnet{
 			{
@@ -72,14 +82,17 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 	}
 
 	tests := []struct {
-		testName                 string
-		resolveViaDiscoveryCalls []resolveViaDiscoveryCall
-		listLoadBalancerCalls    []listLoadBalancerCall
-		resolveCIDRsCalls        []resolveCIDRsCall
-		svc                      *corev1.Service
-		wantError                bool
-		wantValue                string
-		wantNumResources         int
+		testName                    string
+		resolveViaDiscoveryCalls    []resolveViaDiscoveryCall
+		resolveViaNameOrIDSliceCall []resolveViaNameOrIDSliceCall
+		listLoadBalancerCalls       []listLoadBalancerCall
+		resolveCIDRsCalls           []resolveCIDRsCall
+		resolveSGViaNameOrIDCall    []resolveSGViaNameOrIDCall
+		enableNLBSGs                bool
+		svc                         *corev1.Service
+		wantError                   bool
+		wantValue                   string
+		wantNumResources            int
 	}{
 		{
 			testName: "Simple service",
@@ -1294,13 +1307,23 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 					},
 				},
 			},
-			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForThreeSubnet},
+			resolveViaNameOrIDSliceCall: []resolveViaNameOrIDSliceCall{
+				{
+					subnetNameOrIDs: []string{"subnet-1", "subnet-2", "subnet-3"},
+					subnets:         resolveViaDiscoveryCallForThreeSubnet.subnets,
+				},
+			},
 			listLoadBalancerCalls: []listLoadBalancerCall{
 				{
 					sdkLBs: []elbv2.LoadBalancerWithTags{
 						{
 							LoadBalancer: &elbv2sdk.LoadBalancer{
 								Scheme: aws.String("internet-facing"),
+								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+									{SubnetId: aws.String("subnet-1")},
+									{SubnetId: aws.String("subnet-2")},
+									{SubnetId: aws.String("subnet-3")},
+								},
 							},
 						},
 					},
@@ -1937,6 +1960,583 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			testName:     "service with managed security group",
+			enableNLBSGs: true,
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":   "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:                     corev1.ServiceTypeLoadBalancer,
+					Selector:                 map[string]string{"app": "hello"},
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForOneSubnet},
+			wantError:                false,
+			wantValue: `
+{
+   "id":"default/nlb-sg",
+   "resources":{
+      "AWS::EC2::SecurityGroup":{
+         "ManagedLBSecurityGroup":{
+            "spec":{
+               "groupName":"k8s-default-nlbsg-c52375307c",
+               "description":"[k8s] Managed SecurityGroup for LoadBalancer",
+               "ingress":[
+                  {
+                     "ipProtocol":"tcp",
+                     "fromPort":80,
+                     "toPort":80,
+                     "ipRanges":[
+                        {
+                           "cidrIP":"10.0.0.0/8"
+                        }
+                     ]
+                  }
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::Listener":{
+         "80":{
+            "spec":{
+               "loadBalancerARN":{
+                  "$ref":"#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+               },
+               "port":80,
+               "protocol":"TCP",
+               "defaultActions":[
+                  {
+                     "type":"forward",
+                     "forwardConfig":{
+                        "targetGroups":[
+                           {
+                              "targetGroupARN":{
+                                 "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                              }
+                           }
+                        ]
+                     }
+                  }
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::LoadBalancer":{
+         "LoadBalancer":{
+            "spec":{
+               "name":"k8s-default-nlbsg-33e41aa671",
+               "type":"network",
+               "scheme":"internet-facing",
+               "ipAddressType":"ipv4",
+               "subnetMapping":[
+                  {
+                     "subnetID":"subnet-1"
+                  }
+               ],
+               "securityGroups":[
+                  {
+                     "$ref":"#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                  }
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::TargetGroup":{
+         "default/nlb-sg:80":{
+            "spec":{
+               "name":"k8s-default-nlbsg-876854cdb4",
+               "targetType":"ip",
+               "port":8080,
+               "protocol":"TCP",
+               "healthCheckConfig":{
+                  "port":"traffic-port",
+                  "protocol":"TCP",
+                  "intervalSeconds":10,
+                  "healthyThresholdCount":3,
+                  "unhealthyThresholdCount":3
+               },
+               "targetGroupAttributes":[
+                  {
+                     "key":"proxy_protocol_v2.enabled",
+                     "value":"false"
+                  }
+               ]
+            }
+         }
+      },
+      "K8S::ElasticLoadBalancingV2::TargetGroupBinding":{
+         "default/nlb-sg:80":{
+            "spec":{
+               "template":{
+                  "metadata":{
+                     "name":"k8s-default-nlbsg-876854cdb4",
+                     "namespace":"default",
+                     "creationTimestamp":null
+                  },
+                  "spec":{
+                     "targetGroupARN":{
+                        "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                     },
+                     "targetType":"ip",
+                     "serviceRef":{
+                        "name":"nlb-sg",
+                        "port":80
+                     },
+                     "networking":{
+                        "ingress":[
+                           {
+                              "from":[
+                                 {
+                                    "securityGroup":{
+                                       "groupID":{
+                                          "$ref":"#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                                       }
+                                    }
+                                 }
+                              ],
+                              "ports":[
+                                 {
+                                    "protocol":"TCP",
+                                    "port":8080
+                                 }
+                              ]
+                           }
+                        ]
+                     }
+                  }
+               }
+            }
+         }
+      }
+   }
+}
+`,
+			wantNumResources: 5,
+		},
+		{
+			testName:     "managed security group kept on existing NLB with NLB security groups disabled",
+			enableNLBSGs: false,
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":   "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:                     corev1.ServiceTypeLoadBalancer,
+					Selector:                 map[string]string{"app": "hello"},
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			resolveViaNameOrIDSliceCall: []resolveViaNameOrIDSliceCall{
+				{
+					subnetNameOrIDs: []string{"subnet-1"},
+					subnets:         resolveViaDiscoveryCallForOneSubnet.subnets,
+				},
+			},
+			listLoadBalancerCalls: []listLoadBalancerCall{
+				{
+					sdkLBs: []elbv2.LoadBalancerWithTags{
+						{
+							LoadBalancer: &elbv2sdk.LoadBalancer{
+								LoadBalancerArn: aws.String("lb-arn"),
+								Scheme:          aws.String("internet-facing"),
+								SecurityGroups:  aws.StringSlice([]string{"sg-managed"}),
+								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+									{SubnetId: aws.String("subnet-1")},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantError: false,
+			wantValue: `
+{
+   "id":"default/nlb-sg",
+   "resources":{
+      "AWS::EC2::SecurityGroup":{
+         "ManagedLBSecurityGroup":{
+            "spec":{
+               "groupName":"k8s-default-nlbsg-c52375307c",
+               "description":"[k8s] Managed SecurityGroup for LoadBalancer",
+               "ingress":[
+                  {
+                     "ipProtocol":"tcp",
+                     "fromPort":80,
+                     "toPort":80,
+                     "ipRanges":[
+                        {
+                           "cidrIP":"10.0.0.0/8"
+                        }
+                     ]
+                  }
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::Listener":{
+         "80":{
+            "spec":{
+               "loadBalancerARN":{
+                  "$ref":"#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+               },
+               "port":80,
+               "protocol":"TCP",
+               "defaultActions":[
+                  {
+                     "type":"forward",
+                     "forwardConfig":{
+                        "targetGroups":[
+                           {
+                              "targetGroupARN":{
+                                 "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                              }
+                           }
+                        ]
+                     }
+                  }
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::LoadBalancer":{
+         "LoadBalancer":{
+            "spec":{
+               "name":"k8s-default-nlbsg-33e41aa671",
+               "type":"network",
+               "scheme":"internet-facing",
+               "ipAddressType":"ipv4",
+               "subnetMapping":[
+                  {
+                     "subnetID":"subnet-1"
+                  }
+               ],
+               "securityGroups":[
+                  {
+                     "$ref":"#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                  }
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::TargetGroup":{
+         "default/nlb-sg:80":{
+            "spec":{
+               "name":"k8s-default-nlbsg-876854cdb4",
+               "targetType":"ip",
+               "port":8080,
+               "protocol":"TCP",
+               "healthCheckConfig":{
+                  "port":"traffic-port",
+                  "protocol":"TCP",
+                  "intervalSeconds":10,
+                  "healthyThresholdCount":3,
+                  "unhealthyThresholdCount":3
+               },
+               "targetGroupAttributes":[
+                  {
+                     "key":"proxy_protocol_v2.enabled",
+                     "value":"false"
+                  }
+               ]
+            }
+         }
+      },
+      "K8S::ElasticLoadBalancingV2::TargetGroupBinding":{
+         "default/nlb-sg:80":{
+            "spec":{
+               "template":{
+                  "metadata":{
+                     "name":"k8s-default-nlbsg-876854cdb4",
+                     "namespace":"default",
+                     "creationTimestamp":null
+                  },
+                  "spec":{
+                     "targetGroupARN":{
+                        "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                     },
+                     "targetType":"ip",
+                     "serviceRef":{
+                        "name":"nlb-sg",
+                        "port":80
+                     },
+                     "networking":{
+                        "ingress":[
+                           {
+                              "from":[
+                                 {
+                                    "securityGroup":{
+                                       "groupID":{
+                                          "$ref":"#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                                       }
+                                    }
+                                 }
+                              ],
+                              "ports":[
+                                 {
+                                    "protocol":"TCP",
+                                    "port":8080
+                                 }
+                              ]
+                           }
+                        ]
+                     }
+                  }
+               }
+            }
+         }
+      }
+   }
+}
+`,
+			wantNumResources: 5,
+		},
+		{
+			testName:     "service with explicit security groups",
+			enableNLBSGs: true,
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+						"service.beta.kubernetes.io/aws-load-balancer-security-groups": "sg-abc, awesome-sg",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:     corev1.ServiceTypeLoadBalancer,
+					Selector: map[string]string{"app": "hello"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForOneSubnet},
+			resolveSGViaNameOrIDCall: []resolveSGViaNameOrIDCall{
+				{
+					sgNameOrIDs: []string{"sg-abc", "awesome-sg"},
+					sgIDs:       []string{"sg-abc", "sg-def"},
+				},
+			},
+			wantError: false,
+			wantValue: `
+{
+   "id":"default/nlb-sg",
+   "resources":{
+      "AWS::ElasticLoadBalancingV2::Listener":{
+         "80":{
+            "spec":{
+               "loadBalancerARN":{
+                  "$ref":"#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+               },
+               "port":80,
+               "protocol":"TCP",
+               "defaultActions":[
+                  {
+                     "type":"forward",
+                     "forwardConfig":{
+                        "targetGroups":[
+                           {
+                              "targetGroupARN":{
+                                 "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                              }
+                           }
+                        ]
+                     }
+                  }
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::LoadBalancer":{
+         "LoadBalancer":{
+            "spec":{
+               "name":"k8s-default-nlbsg-33e41aa671",
+               "type":"network",
+               "scheme":"internet-facing",
+               "ipAddressType":"ipv4",
+               "subnetMapping":[
+                  {
+                     "subnetID":"subnet-1"
+                  }
+               ],
+               "securityGroups":[
+                  "sg-abc",
+                  "sg-def"
+               ]
+            }
+         }
+      },
+      "AWS::ElasticLoadBalancingV2::TargetGroup":{
+         "default/nlb-sg:80":{
+            "spec":{
+               "name":"k8s-default-nlbsg-876854cdb4",
+               "targetType":"ip",
+               "port":8080,
+               "protocol":"TCP",
+               "healthCheckConfig":{
+                  "port":"traffic-port",
+                  "protocol":"TCP",
+                  "intervalSeconds":10,
+                  "healthyThresholdCount":3,
+                  "unhealthyThresholdCount":3
+               },
+               "targetGroupAttributes":[
+                  {
+                     "key":"proxy_protocol_v2.enabled",
+                     "value":"false"
+                  }
+               ]
+            }
+         }
+      },
+      "K8S::ElasticLoadBalancingV2::TargetGroupBinding":{
+         "default/nlb-sg:80":{
+            "spec":{
+               "template":{
+                  "metadata":{
+                     "name":"k8s-default-nlbsg-876854cdb4",
+                     "namespace":"default",
+                     "creationTimestamp":null
+                  },
+                  "spec":{
+                     "targetGroupARN":{
+                        "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                     },
+                     "targetType":"ip",
+                     "serviceRef":{
+                        "name":"nlb-sg",
+                        "port":80
+                     }
+                  }
+               }
+            }
+         }
+      }
+   }
+}
+`,
+			wantNumResources: 4,
+		},
+		{
+			testName: "security groups annotation without NLB security groups enabled",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-security-groups": "sg-abc",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:     corev1.ServiceTypeLoadBalancer,
+					Selector: map[string]string{"app": "hello"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForOneSubnet},
+			wantError:                true,
+		},
+		{
+			testName:     "security groups annotation on existing NLB without security groups",
+			enableNLBSGs: true,
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internal",
+						"service.beta.kubernetes.io/aws-load-balancer-security-groups": "sg-abc",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:     corev1.ServiceTypeLoadBalancer,
+					Selector: map[string]string{"app": "hello"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+				Status: corev1.ServiceStatus{
+					LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{
+							{
+								Hostname: "k8s-default-nlbsg-xxxxxxxxxx.elb.us-west-2.amazonaws.com",
+							},
+						},
+					},
+				},
+			},
+			resolveViaNameOrIDSliceCall: []resolveViaNameOrIDSliceCall{
+				{
+					subnetNameOrIDs: []string{"subnet-1"},
+					subnets:         resolveViaDiscoveryCallForOneSubnet.subnets,
+				},
+			},
+			listLoadBalancerCalls: []listLoadBalancerCall{
+				{
+					sdkLBs: []elbv2.LoadBalancerWithTags{
+						{
+							LoadBalancer: &elbv2sdk.LoadBalancer{
+								LoadBalancerArn: aws.String("lb-arn"),
+								Scheme:          aws.String("internal"),
+								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+									{SubnetId: aws.String("subnet-1")},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1948,6 +2548,9 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			for _, call := range tt.resolveViaDiscoveryCalls {
 				subnetsResolver.EXPECT().ResolveViaDiscovery(gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
 			}
+			for _, call := range tt.resolveViaNameOrIDSliceCall {
+				subnetsResolver.EXPECT().ResolveViaNameOrIDSlice(gomock.Any(), call.subnetNameOrIDs, gomock.Any()).Return(call.subnets, call.err)
+			}
 
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "my-cluster")
@@ -1956,13 +2559,21 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			for _, call := range tt.listLoadBalancerCalls {
 				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(call.sdkLBs, call.err)
 			}
+			if len(tt.listLoadBalancerCalls) == 0 {
+				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			}
+
+			sgResolver := networking.NewMockSecurityGroupResolver(ctrl)
+			for _, call := range tt.resolveSGViaNameOrIDCall {
+				sgResolver.EXPECT().ResolveViaNameOrID(gomock.Any(), call.sgNameOrIDs).Return(call.sgIDs, call.err)
+			}
 
 			vpcResolver := networking.NewMockVPCResolver(ctrl)
 			for _, call := range tt.resolveCIDRsCalls {
 				vpcResolver.EXPECT().ResolveCIDRs(gomock.Any()).Return(call.cidrs, call.err).AnyTimes()
 			}
-			builder := NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcResolver, trackingProvider, elbv2TaggingManager,
-				"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08")
+			builder := NewDefaultModelBuilder(nil, nil, annotationParser, subnetsResolver, vpcResolver, sgResolver, trackingProvider, elbv2TaggingManager,
+				"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", false, tt.enableNLBSGs)
 			ctx := context.Background()
 			stack, _, err := builder.Build(ctx, tt.svc)
 			if tt.wantError {
@@ -0,0 +1,29 @@
+package service
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+const (
+	// IndexKeySecretRefName is index key for secrets referenced by Service.
+	IndexKeySecretRefName = "service.secretRef.name"
+	// IndexKeyTargetIngressStackID is index key for IngressGroups referenced by Service for alb targetType.
+	IndexKeyTargetIngressStackID = "service.targetIngress.stackID"
+)
+
+// BuildSecretRefIndexes returns the name of TLS secrets referenced by Service for certificate import.
+func BuildSecretRefIndexes(annotationParser annotations.Parser, svc *corev1.Service) []string {
+	var secretNames []string
+	_ = annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLCertSecrets, &secretNames, svc.Annotations)
+	return secretNames
+}
+
+// BuildTargetIngressStackIDIndexes returns the stackID of IngressGroup referenced by Service for alb targetType.
+func BuildTargetIngressStackIDIndexes(annotationParser annotations.Parser, svc *corev1.Service) []string {
+	stackID, exists, err := BuildTargetIngressStackID(annotationParser, svc)
+	if err != nil || !exists {
+		return nil
+	}
+	return []string{stackID.String()}
+}
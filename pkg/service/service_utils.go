@@ -0,0 +1,63 @@
+package service
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// ServiceUtils includes helper functions to decide whether Services are managed by this controller.
+type ServiceUtils interface {
+	// IsServiceSupported returns true if the Service is supported by this controller.
+	IsServiceSupported(service *corev1.Service) bool
+
+	// IsServicePendingFinalization returns true if the Service still has AWS resources managed by this controller.
+	IsServicePendingFinalization(service *corev1.Service) bool
+}
+
+// NewServiceUtils constructs new defaultServiceUtils.
+func NewServiceUtils(annotationParser annotations.Parser, serviceFinalizer string, loadBalancerClass string) *defaultServiceUtils {
+	return &defaultServiceUtils{
+		annotationParser:  annotationParser,
+		serviceFinalizer:  serviceFinalizer,
+		loadBalancerClass: loadBalancerClass,
+	}
+}
+
+var _ ServiceUtils = &defaultServiceUtils{}
+
+// default implementation for ServiceUtils
+type defaultServiceUtils struct {
+	annotationParser  annotations.Parser
+	serviceFinalizer  string
+	loadBalancerClass string
+}
+
+// IsServiceSupported returns true if the Service is supported by this controller:
+//  1. Services with spec.loadBalancerClass are supported if the class matches the one of this controller.
+//  2. Services without spec.loadBalancerClass are supported via the aws-load-balancer-type annotation.
+func (u *defaultServiceUtils) IsServiceSupported(service *corev1.Service) bool {
+	if service.Spec.LoadBalancerClass != nil {
+		return *service.Spec.LoadBalancerClass == u.loadBalancerClass
+	}
+	return u.checkAWSLoadBalancerTypeAnnotation(service)
+}
+
+func (u *defaultServiceUtils) IsServicePendingFinalization(service *corev1.Service) bool {
+	return k8s.HasFinalizer(service, u.serviceFinalizer)
+}
+
+func (u *defaultServiceUtils) checkAWSLoadBalancerTypeAnnotation(service *corev1.Service) bool {
+	lbType := ""
+	_ = u.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, service.Annotations)
+	if lbType == LoadBalancerTypeNLBIP {
+		return true
+	}
+	var lbTargetType string
+	_ = u.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &lbTargetType, service.Annotations)
+	if lbType == LoadBalancerTypeExternal && (lbTargetType == LoadBalancerTargetTypeIP ||
+		lbTargetType == LoadBalancerTargetTypeInstance) {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_defaultServiceUtils_IsServiceSupported(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want bool
+	}{
+		{
+			name: "service without loadBalancerClass or annotation",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "service with matching loadBalancerClass",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: aws.String("service.k8s.aws/nlb"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "service with foreign loadBalancerClass",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: aws.String("some.other/lb"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "service with foreign loadBalancerClass and aws-load-balancer-type annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type": "nlb-ip",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: aws.String("some.other/lb"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "service with nlb-ip annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type": "nlb-ip",
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "service with external annotation and instance target type",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "service with external annotation and no target type",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type": "external",
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "service with in-tree nlb annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb")
+			got := serviceUtils.IsServiceSupported(tt.svc)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultServiceUtils_IsServicePendingFinalization(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want bool
+	}{
+		{
+			name: "service with finalizer",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Finalizers: []string{"service.k8s.aws/resources"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "service without finalizer",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Finalizers: []string{"some.other/finalizer"},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb")
+			got := serviceUtils.IsServicePendingFinalization(tt.svc)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
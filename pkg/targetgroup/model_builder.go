@@ -0,0 +1,149 @@
+package targetgroup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// resourceIDTargetGroup is the resource ID of the TargetGroup within stack.
+	resourceIDTargetGroup = "targetGroup"
+)
+
+// ModelBuilder is responsible for build mode stack for a TargetGroup.
+type ModelBuilder interface {
+	// build mode stack for a TargetGroup.
+	Build(ctx context.Context, tg *elbv2api.TargetGroup) (core.Stack, *elbv2model.TargetGroup, error)
+}
+
+// NewDefaultModelBuilder constructs new defaultModelBuilder.
+func NewDefaultModelBuilder(clusterName string, defaultTags map[string]string, externalManagedTags []string) *defaultModelBuilder {
+	return &defaultModelBuilder{
+		clusterName:         clusterName,
+		defaultTags:         defaultTags,
+		externalManagedTags: sets.NewString(externalManagedTags...),
+	}
+}
+
+var _ ModelBuilder = &defaultModelBuilder{}
+
+// default implementation for ModelBuilder
+type defaultModelBuilder struct {
+	clusterName         string
+	defaultTags         map[string]string
+	externalManagedTags sets.String
+}
+
+// build mode stack for a TargetGroup.
+func (b *defaultModelBuilder) Build(ctx context.Context, tg *elbv2api.TargetGroup) (core.Stack, *elbv2model.TargetGroup, error) {
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(tg)))
+	tgSpec, err := b.buildTargetGroupSpec(ctx, stack, tg)
+	if err != nil {
+		return nil, nil, err
+	}
+	resTG := elbv2model.NewTargetGroup(stack, resourceIDTargetGroup, tgSpec)
+	return stack, resTG, nil
+}
+
+func (b *defaultModelBuilder) buildTargetGroupSpec(ctx context.Context, stack core.Stack, tg *elbv2api.TargetGroup) (elbv2model.TargetGroupSpec, error) {
+	tags, err := b.buildTargetGroupTags(ctx, tg)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgSpec := elbv2model.TargetGroupSpec{
+		Name:                  b.buildTargetGroupName(ctx, stack, tg),
+		TargetType:            elbv2model.TargetType(tg.Spec.TargetType),
+		Port:                  tg.Spec.Port,
+		Protocol:              elbv2model.Protocol(tg.Spec.Protocol),
+		ProtocolVersion:       (*elbv2model.ProtocolVersion)(tg.Spec.ProtocolVersion),
+		IPAddressType:         (*elbv2model.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
+		HealthCheckConfig:     buildTargetGroupHealthCheckConfig(tg.Spec.HealthCheckConfig),
+		TargetGroupAttributes: buildTargetGroupAttributes(tg.Spec.TargetGroupAttributes),
+		Tags:                  tags,
+	}
+	return tgSpec, nil
+}
+
+var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// buildTargetGroupName will calculate the targetGroup's name.
+// fields that requires replacement of targetGroup are part of the hash, so that the replacement can be created before old one is deleted.
+func (b *defaultModelBuilder) buildTargetGroupName(_ context.Context, stack core.Stack, tg *elbv2api.TargetGroup) string {
+	if tg.Spec.Name != nil && len(*tg.Spec.Name) != 0 {
+		return *tg.Spec.Name
+	}
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(b.clusterName))
+	_, _ = uuidHash.Write([]byte(stack.StackID().String()))
+	_, _ = uuidHash.Write([]byte(tg.Spec.TargetType))
+	_, _ = uuidHash.Write([]byte(tg.Spec.Protocol))
+	if tg.Spec.ProtocolVersion != nil {
+		_, _ = uuidHash.Write([]byte(*tg.Spec.ProtocolVersion))
+	}
+	if tg.Spec.IPAddressType != nil {
+		_, _ = uuidHash.Write([]byte(*tg.Spec.IPAddressType))
+	}
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(tg.Namespace, "")
+	sanitizedName := invalidTargetGroupNamePattern.ReplaceAllString(tg.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (b *defaultModelBuilder) buildTargetGroupTags(_ context.Context, tg *elbv2api.TargetGroup) (map[string]string, error) {
+	for tagKey := range tg.Spec.Tags {
+		if b.externalManagedTags.Has(tagKey) {
+			return nil, errors.Errorf("failed build tags for TargetGroup %v: external managed tag key %v cannot be specified",
+				k8s.NamespacedName(tg).String(), tagKey)
+		}
+	}
+	return algorithm.MergeStringMap(tg.Spec.Tags, b.defaultTags), nil
+}
+
+func buildTargetGroupHealthCheckConfig(hcConfig *elbv2api.TargetGroupHealthCheckConfig) *elbv2model.TargetGroupHealthCheckConfig {
+	if hcConfig == nil {
+		return nil
+	}
+	var matcher *elbv2model.HealthCheckMatcher
+	if hcConfig.Matcher != nil {
+		matcher = &elbv2model.HealthCheckMatcher{
+			HTTPCode: hcConfig.Matcher.HTTPCode,
+			GRPCCode: hcConfig.Matcher.GRPCCode,
+		}
+	}
+	return &elbv2model.TargetGroupHealthCheckConfig{
+		Port:                    hcConfig.Port,
+		Protocol:                (*elbv2model.Protocol)(hcConfig.Protocol),
+		Path:                    hcConfig.Path,
+		Matcher:                 matcher,
+		IntervalSeconds:         hcConfig.IntervalSeconds,
+		TimeoutSeconds:          hcConfig.TimeoutSeconds,
+		HealthyThresholdCount:   hcConfig.HealthyThresholdCount,
+		UnhealthyThresholdCount: hcConfig.UnhealthyThresholdCount,
+	}
+}
+
+func buildTargetGroupAttributes(attributes []elbv2api.Attribute) []elbv2model.TargetGroupAttribute {
+	if len(attributes) == 0 {
+		return nil
+	}
+	tgAttributes := make([]elbv2model.TargetGroupAttribute, 0, len(attributes))
+	for _, attr := range attributes {
+		tgAttributes = append(tgAttributes, elbv2model.TargetGroupAttribute{
+			Key:   attr.Key,
+			Value: attr.Value,
+		})
+	}
+	return tgAttributes
+}
@@ -0,0 +1,183 @@
+package targetgroup
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuilder_Build(t *testing.T) {
+	protocolVersionHTTP2 := elbv2api.TargetGroupProtocolVersionHTTP2
+	ipAddressTypeIPv6 := elbv2api.TargetGroupIPAddressTypeIPv6
+	healthCheckProtocolHTTP := elbv2api.TargetGroupProtocolHTTP
+	healthCheckPort := intstr.FromString("traffic-port")
+	modelProtocolVersionHTTP2 := elbv2model.ProtocolVersionHTTP2
+	modelIPAddressTypeIPv6 := elbv2model.TargetGroupIPAddressTypeIPv6
+	modelHealthCheckProtocolHTTP := elbv2model.ProtocolHTTP
+	type fields struct {
+		defaultTags         map[string]string
+		externalManagedTags []string
+	}
+	type args struct {
+		tg *elbv2api.TargetGroup
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    elbv2model.TargetGroupSpec
+		wantErr error
+	}{
+		{
+			name: "targetGroup with minimal spec",
+			args: args{
+				tg: &elbv2api.TargetGroup{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					Spec: elbv2api.TargetGroupSpec{
+						TargetType: elbv2api.TargetTypeIP,
+						Port:       8080,
+						Protocol:   elbv2api.TargetGroupProtocolHTTP,
+					},
+				},
+			},
+			want: elbv2model.TargetGroupSpec{
+				Name:       "k8s-awesomen-awesomet-ac34674a22",
+				TargetType: elbv2model.TargetTypeIP,
+				Port:       8080,
+				Protocol:   elbv2model.ProtocolHTTP,
+				Tags:       map[string]string{},
+			},
+		},
+		{
+			name: "targetGroup with full spec",
+			fields: fields{
+				defaultTags: map[string]string{"team": "platform", "env": "prod"},
+			},
+			args: args{
+				tg: &elbv2api.TargetGroup{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					Spec: elbv2api.TargetGroupSpec{
+						Name:            awssdk.String("my-tg"),
+						TargetType:      elbv2api.TargetTypeIP,
+						Port:            8080,
+						Protocol:        elbv2api.TargetGroupProtocolHTTP,
+						ProtocolVersion: &protocolVersionHTTP2,
+						IPAddressType:   &ipAddressTypeIPv6,
+						HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+							Port:     &healthCheckPort,
+							Protocol: &healthCheckProtocolHTTP,
+							Path:     awssdk.String("/healthz"),
+							Matcher: &elbv2api.HealthCheckMatcher{
+								HTTPCode: awssdk.String("200-299"),
+							},
+							IntervalSeconds:         awssdk.Int64(10),
+							TimeoutSeconds:          awssdk.Int64(5),
+							HealthyThresholdCount:   awssdk.Int64(3),
+							UnhealthyThresholdCount: awssdk.Int64(2),
+						},
+						TargetGroupAttributes: []elbv2api.Attribute{
+							{
+								Key:   "deregistration_delay.timeout_seconds",
+								Value: "30",
+							},
+						},
+						Tags: map[string]string{"team": "web"},
+					},
+				},
+			},
+			want: elbv2model.TargetGroupSpec{
+				Name:            "my-tg",
+				TargetType:      elbv2model.TargetTypeIP,
+				Port:            8080,
+				Protocol:        elbv2model.ProtocolHTTP,
+				ProtocolVersion: &modelProtocolVersionHTTP2,
+				IPAddressType:   &modelIPAddressTypeIPv6,
+				HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+					Port:     &healthCheckPort,
+					Protocol: &modelHealthCheckProtocolHTTP,
+					Path:     awssdk.String("/healthz"),
+					Matcher: &elbv2model.HealthCheckMatcher{
+						HTTPCode: awssdk.String("200-299"),
+					},
+					IntervalSeconds:         awssdk.Int64(10),
+					TimeoutSeconds:          awssdk.Int64(5),
+					HealthyThresholdCount:   awssdk.Int64(3),
+					UnhealthyThresholdCount: awssdk.Int64(2),
+				},
+				TargetGroupAttributes: []elbv2model.TargetGroupAttribute{
+					{
+						Key:   "deregistration_delay.timeout_seconds",
+						Value: "30",
+					},
+				},
+				Tags: map[string]string{"team": "web", "env": "prod"},
+			},
+		},
+		{
+			name: "targetGroup with external managed tags",
+			fields: fields{
+				externalManagedTags: []string{"team"},
+			},
+			args: args{
+				tg: &elbv2api.TargetGroup{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					Spec: elbv2api.TargetGroupSpec{
+						TargetType: elbv2api.TargetTypeInstance,
+						Port:       30080,
+						Protocol:   elbv2api.TargetGroupProtocolTCP,
+						Tags:       map[string]string{"team": "web"},
+					},
+				},
+			},
+			wantErr: errors.New("failed build tags for TargetGroup awesome-ns/awesome-tg: external managed tag key team cannot be specified"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewDefaultModelBuilder("cluster-name", tt.fields.defaultTags, tt.fields.externalManagedTags)
+			stack, resTG, err := b.Build(context.Background(), tt.args.tg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "awesome-ns/awesome-tg", stack.StackID().String())
+				assert.Equal(t, "targetGroup", resTG.ID())
+				assert.Equal(t, tt.want, resTG.Spec)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuilder_buildTargetGroupName(t *testing.T) {
+	b := NewDefaultModelBuilder("cluster-name", nil, nil)
+	ipAddressTypeIPv6 := elbv2api.TargetGroupIPAddressTypeIPv6
+	tg := &elbv2api.TargetGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+		Spec: elbv2api.TargetGroupSpec{
+			TargetType: elbv2api.TargetTypeIP,
+			Port:       8080,
+			Protocol:   elbv2api.TargetGroupProtocolHTTP,
+		},
+	}
+	_, resTG, err := b.Build(context.Background(), tg)
+	assert.NoError(t, err)
+
+	targetTypeChangedTG := tg.DeepCopy()
+	targetTypeChangedTG.Spec.TargetType = elbv2api.TargetTypeInstance
+	_, targetTypeChangedResTG, err := b.Build(context.Background(), targetTypeChangedTG)
+	assert.NoError(t, err)
+	assert.NotEqual(t, resTG.Spec.Name, targetTypeChangedResTG.Spec.Name, "targetType change requires replacement")
+
+	ipv6TG := tg.DeepCopy()
+	ipv6TG.Spec.IPAddressType = &ipAddressTypeIPv6
+	_, ipv6ResTG, err := b.Build(context.Background(), ipv6TG)
+	assert.NoError(t, err)
+	assert.NotEqual(t, resTG.Spec.Name, ipv6ResTG.Spec.Name, "ipAddressType change requires replacement")
+}
@@ -0,0 +1,200 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the name prefix of ConfigMap that tracks the targets owned by TargetGroupBinding.
+	trackedTargetsConfigMapNamePrefix = "aws-lbc-tgb-"
+	// the separator between targets within ConfigMap data.
+	trackedTargetsSeparator = ","
+)
+
+// MultiClusterManager tracks the targets registered by current cluster for TargetGroupBindings in multi-cluster mode,
+// so that targets registered by other clusters into the same TargetGroup are left untouched.
+// The targets are tracked in a ConfigMap per TargetGroupBinding, keyed by cluster name.
+type MultiClusterManager interface {
+	// FilterOwnedTargets returns the targets owned by current cluster.
+	// Tracked targets that no longer exist in TargetGroup are forgotten.
+	FilterOwnedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) ([]TargetInfo, error)
+
+	// TrackTargets records targets as owned by current cluster, it must be invoked before targets are registered.
+	TrackTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []elbv2sdk.TargetDescription) error
+
+	// Cleanup removes the tracked targets for TargetGroupBinding.
+	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+}
+
+// NewDefaultMultiClusterManager constructs new defaultMultiClusterManager.
+func NewDefaultMultiClusterManager(k8sClient client.Client, apiReader client.Reader, clusterName string, logger logr.Logger) *defaultMultiClusterManager {
+	return &defaultMultiClusterManager{
+		k8sClient:   k8sClient,
+		apiReader:   apiReader,
+		clusterName: clusterName,
+		logger:      logger,
+	}
+}
+
+var _ MultiClusterManager = &defaultMultiClusterManager{}
+
+// default implementation for MultiClusterManager.
+type defaultMultiClusterManager struct {
+	k8sClient client.Client
+	// apiReader reads ConfigMaps directly from API server, so that we don't need to cache all ConfigMaps in cluster.
+	apiReader   client.Reader
+	clusterName string
+	logger      logr.Logger
+}
+
+func (m *defaultMultiClusterManager) FilterOwnedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) ([]TargetInfo, error) {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return targets, nil
+	}
+	cm, err := m.getTrackedTargetsConfigMap(ctx, tgb)
+	if err != nil {
+		return nil, err
+	}
+	trackedTargets := m.decodeTrackedTargets(cm)
+	existingTargets := sets.NewString()
+	var ownedTargets []TargetInfo
+	for _, target := range targets {
+		targetUID := UniqueIDForTargetDescription(target.Target)
+		existingTargets.Insert(targetUID)
+		if trackedTargets.Has(targetUID) {
+			ownedTargets = append(ownedTargets, target)
+		}
+	}
+
+	staleTargets := trackedTargets.Difference(existingTargets)
+	if staleTargets.Len() != 0 {
+		m.logger.V(1).Info("forgetting tracked targets no longer in targetGroup",
+			"tgb", k8s.NamespacedName(tgb),
+			"targets", staleTargets.List())
+		if err := m.updateTrackedTargetsConfigMap(ctx, tgb, cm, trackedTargets.Intersection(existingTargets)); err != nil {
+			return nil, err
+		}
+	}
+	return ownedTargets, nil
+}
+
+func (m *defaultMultiClusterManager) TrackTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []elbv2sdk.TargetDescription) error {
+	if !tgb.Spec.MultiClusterTargetGroup || len(targets) == 0 {
+		return nil
+	}
+	cm, err := m.getTrackedTargetsConfigMap(ctx, tgb)
+	if err != nil {
+		return err
+	}
+	trackedTargets := m.decodeTrackedTargets(cm)
+	newTrackedTargets := sets.NewString(trackedTargets.List()...)
+	for _, target := range targets {
+		newTrackedTargets.Insert(UniqueIDForTargetDescription(target))
+	}
+	if newTrackedTargets.Equal(trackedTargets) {
+		return nil
+	}
+	return m.updateTrackedTargetsConfigMap(ctx, tgb, cm, newTrackedTargets)
+}
+
+func (m *defaultMultiClusterManager) Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return nil
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: tgb.Namespace,
+			Name:      buildTrackedTargetsConfigMapName(tgb),
+		},
+	}
+	if err := m.k8sClient.Delete(ctx, cm); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return nil
+}
+
+// getTrackedTargetsConfigMap returns the ConfigMap that tracks targets for TargetGroupBinding, or nil if it doesn't exist yet.
+func (m *defaultMultiClusterManager) getTrackedTargetsConfigMap(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	cmKey := types.NamespacedName{Namespace: tgb.Namespace, Name: buildTrackedTargetsConfigMapName(tgb)}
+	if err := m.apiReader.Get(ctx, cmKey, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get tracked targets: %v", cmKey)
+	}
+	return cm, nil
+}
+
+// updateTrackedTargetsConfigMap persists the tracked targets, the ConfigMap will be created if cm is nil.
+func (m *defaultMultiClusterManager) updateTrackedTargetsConfigMap(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	cm *corev1.ConfigMap, trackedTargets sets.String) error {
+	if cm == nil {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: tgb.Namespace,
+				Name:      buildTrackedTargetsConfigMapName(tgb),
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(tgb, elbv2api.GroupVersion.WithKind("TargetGroupBinding")),
+				},
+			},
+			Data: map[string]string{
+				m.clusterName: strings.Join(trackedTargets.List(), trackedTargetsSeparator),
+			},
+		}
+		if err := m.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create tracked targets: %v", k8s.NamespacedName(cm))
+		}
+		return nil
+	}
+
+	cm = cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[m.clusterName] = strings.Join(trackedTargets.List(), trackedTargetsSeparator)
+	if err := m.k8sClient.Update(ctx, cm); err != nil {
+		return errors.Wrapf(err, "failed to update tracked targets: %v", k8s.NamespacedName(cm))
+	}
+	return nil
+}
+
+func (m *defaultMultiClusterManager) decodeTrackedTargets(cm *corev1.ConfigMap) sets.String {
+	trackedTargets := sets.NewString()
+	if cm == nil {
+		return trackedTargets
+	}
+	for _, targetUID := range strings.Split(cm.Data[m.clusterName], trackedTargetsSeparator) {
+		if targetUID != "" {
+			trackedTargets.Insert(targetUID)
+		}
+	}
+	return trackedTargets
+}
+
+// buildTrackedTargetsConfigMapName constructs the name of ConfigMap that tracks targets for TargetGroupBinding.
+// TargetGroupBinding name is hashed if the result would exceed the limit of ConfigMap name.
+func buildTrackedTargetsConfigMapName(tgb *elbv2api.TargetGroupBinding) string {
+	name := trackedTargetsConfigMapNamePrefix + tgb.Name
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(tgb.Name))
+	return trackedTargetsConfigMapNamePrefix + hex.EncodeToString(hash[:])
+}
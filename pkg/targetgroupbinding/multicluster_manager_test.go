@@ -0,0 +1,311 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultMultiClusterManager_FilterOwnedTargets(t *testing.T) {
+	buildTGB := func(multiCluster bool) *elbv2api.TargetGroupBinding {
+		return &elbv2api.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "my-tgb",
+				UID:       "tgb-uuid",
+			},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN:          "tg-1",
+				MultiClusterTargetGroup: multiCluster,
+			},
+		}
+	}
+	buildTarget := func(id string) TargetInfo {
+		return TargetInfo{
+			Target: elbv2sdk.TargetDescription{
+				Id:   awssdk.String(id),
+				Port: awssdk.Int64(8080),
+			},
+		}
+	}
+	type env struct {
+		configMaps []*corev1.ConfigMap
+	}
+	type args struct {
+		tgb     *elbv2api.TargetGroupBinding
+		targets []TargetInfo
+	}
+	tests := []struct {
+		name              string
+		env               env
+		args              args
+		want              []TargetInfo
+		wantTrackedTarget *string
+	}{
+		{
+			name: "multi-cluster mode disabled",
+			args: args{
+				tgb:     buildTGB(false),
+				targets: []TargetInfo{buildTarget("192.168.1.1"), buildTarget("192.168.1.2")},
+			},
+			want: []TargetInfo{buildTarget("192.168.1.1"), buildTarget("192.168.1.2")},
+		},
+		{
+			name: "multi-cluster mode enabled without tracked targets",
+			args: args{
+				tgb:     buildTGB(true),
+				targets: []TargetInfo{buildTarget("192.168.1.1"), buildTarget("192.168.1.2")},
+			},
+			want: nil,
+		},
+		{
+			name: "multi-cluster mode enabled with tracked targets",
+			env: env{
+				configMaps: []*corev1.ConfigMap{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "aws-lbc-tgb-my-tgb",
+						},
+						Data: map[string]string{
+							"cluster-a": "192.168.1.1:8080,192.168.1.3:8080",
+							"cluster-b": "192.168.1.2:8080",
+						},
+					},
+				},
+			},
+			args: args{
+				tgb:     buildTGB(true),
+				targets: []TargetInfo{buildTarget("192.168.1.1"), buildTarget("192.168.1.2"), buildTarget("192.168.1.3")},
+			},
+			want:              []TargetInfo{buildTarget("192.168.1.1"), buildTarget("192.168.1.3")},
+			wantTrackedTarget: awssdk.String("192.168.1.1:8080,192.168.1.3:8080"),
+		},
+		{
+			name: "multi-cluster mode enabled with stale tracked targets",
+			env: env{
+				configMaps: []*corev1.ConfigMap{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "aws-lbc-tgb-my-tgb",
+						},
+						Data: map[string]string{
+							"cluster-a": "192.168.1.1:8080,192.168.1.3:8080",
+						},
+					},
+				},
+			},
+			args: args{
+				tgb:     buildTGB(true),
+				targets: []TargetInfo{buildTarget("192.168.1.1"), buildTarget("192.168.1.2")},
+			},
+			want:              []TargetInfo{buildTarget("192.168.1.1")},
+			wantTrackedTarget: awssdk.String("192.168.1.1:8080"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			ctx := context.Background()
+			for _, cm := range tt.env.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+
+			m := NewDefaultMultiClusterManager(k8sClient, k8sClient, "cluster-a", &log.NullLogger{})
+			got, err := m.FilterOwnedTargets(ctx, tt.args.tgb, tt.args.targets)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			if tt.wantTrackedTarget != nil {
+				cm := &corev1.ConfigMap{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "aws-lbc-tgb-my-tgb"}, cm)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.wantTrackedTarget, cm.Data["cluster-a"])
+			}
+		})
+	}
+}
+
+func Test_defaultMultiClusterManager_TrackTargets(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-tgb",
+			UID:       "tgb-uuid",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN:          "tg-1",
+			MultiClusterTargetGroup: true,
+		},
+	}
+	type env struct {
+		configMaps []*corev1.ConfigMap
+	}
+	tests := []struct {
+		name     string
+		env      env
+		targets  []elbv2sdk.TargetDescription
+		wantData map[string]string
+	}{
+		{
+			name: "tracked targets created",
+			targets: []elbv2sdk.TargetDescription{
+				{
+					Id:   awssdk.String("192.168.1.2"),
+					Port: awssdk.Int64(8080),
+				},
+				{
+					Id:   awssdk.String("192.168.1.1"),
+					Port: awssdk.Int64(8080),
+				},
+			},
+			wantData: map[string]string{
+				"cluster-a": "192.168.1.1:8080,192.168.1.2:8080",
+			},
+		},
+		{
+			name: "tracked targets updated",
+			env: env{
+				configMaps: []*corev1.ConfigMap{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "aws-lbc-tgb-my-tgb",
+						},
+						Data: map[string]string{
+							"cluster-a": "192.168.1.1:8080",
+							"cluster-b": "192.168.1.3:8080",
+						},
+					},
+				},
+			},
+			targets: []elbv2sdk.TargetDescription{
+				{
+					Id:   awssdk.String("192.168.1.2"),
+					Port: awssdk.Int64(8080),
+				},
+			},
+			wantData: map[string]string{
+				"cluster-a": "192.168.1.1:8080,192.168.1.2:8080",
+				"cluster-b": "192.168.1.3:8080",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			ctx := context.Background()
+			for _, cm := range tt.env.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+
+			m := NewDefaultMultiClusterManager(k8sClient, k8sClient, "cluster-a", &log.NullLogger{})
+			err := m.TrackTargets(ctx, tgb, tt.targets)
+			assert.NoError(t, err)
+
+			cm := &corev1.ConfigMap{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "aws-lbc-tgb-my-tgb"}, cm)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantData, cm.Data)
+		})
+	}
+}
+
+func Test_defaultMultiClusterManager_Cleanup(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-tgb",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN:          "tg-1",
+			MultiClusterTargetGroup: true,
+		},
+	}
+	tests := []struct {
+		name       string
+		configMaps []*corev1.ConfigMap
+	}{
+		{
+			name: "tracked targets exists",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "aws-lbc-tgb-my-tgb",
+					},
+				},
+			},
+		},
+		{
+			name: "tracked targets not exists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			ctx := context.Background()
+			for _, cm := range tt.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+
+			m := NewDefaultMultiClusterManager(k8sClient, k8sClient, "cluster-a", &log.NullLogger{})
+			err := m.Cleanup(ctx, tgb)
+			assert.NoError(t, err)
+
+			cm := &corev1.ConfigMap{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "aws-lbc-tgb-my-tgb"}, cm)
+			assert.True(t, apierrors.IsNotFound(err))
+		})
+	}
+}
+
+func Test_buildTrackedTargetsConfigMapName(t *testing.T) {
+	tests := []struct {
+		name    string
+		tgbName string
+		want    string
+	}{
+		{
+			name:    "short name",
+			tgbName: "my-tgb",
+			want:    "aws-lbc-tgb-my-tgb",
+		},
+		{
+			name:    "long name",
+			tgbName: strings.Repeat("a", 250),
+			want:    "aws-lbc-tgb-3f3e35e0a775d9b1d5ec2eccca06381c41efedeb59d5ac5491ebe9696cb0887b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      tt.tgbName,
+				},
+			}
+			got := buildTrackedTargetsConfigMapName(tgb)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -90,7 +90,7 @@ func (m *defaultNetworkingManager) ReconcileForPodEndpoints(ctx context.Context,
 	var ingressPermissionsPerSG map[string][]networking.IPPermissionInfo
 	if tgb.Spec.Networking != nil {
 		var err error
-		ingressPermissionsPerSG, err = m.computeIngressPermissionsPerSGWithPodEndpoints(ctx, *tgb.Spec.Networking, endpoints)
+		ingressPermissionsPerSG, err = m.computeIngressPermissionsPerSGWithPodEndpoints(ctx, *tgb.Spec.Networking, tgb.Spec.IPAddressType, endpoints)
 		if err != nil {
 			return err
 		}
@@ -102,7 +102,7 @@ func (m *defaultNetworkingManager) ReconcileForNodePortEndpoints(ctx context.Con
 	var ingressPermissionsPerSG map[string][]networking.IPPermissionInfo
 	if tgb.Spec.Networking != nil {
 		var err error
-		ingressPermissionsPerSG, err = m.computeIngressPermissionsPerSGWithNodePortEndpoints(ctx, *tgb.Spec.Networking, endpoints)
+		ingressPermissionsPerSG, err = m.computeIngressPermissionsPerSGWithNodePortEndpoints(ctx, *tgb.Spec.Networking, tgb.Spec.IPAddressType, endpoints)
 		if err != nil {
 			return err
 		}
@@ -114,7 +114,8 @@ func (m *defaultNetworkingManager) Cleanup(ctx context.Context, tgb *elbv2api.Ta
 	return m.reconcileWithIngressPermissionsPerSG(ctx, tgb, nil)
 }
 
-func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithPodEndpoints(ctx context.Context, tgbNetworking elbv2api.TargetGroupBindingNetworking, endpoints []backend.PodEndpoint) (map[string][]networking.IPPermissionInfo, error) {
+func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithPodEndpoints(ctx context.Context, tgbNetworking elbv2api.TargetGroupBindingNetworking,
+	ipAddressType *elbv2api.TargetGroupIPAddressType, endpoints []backend.PodEndpoint) (map[string][]networking.IPPermissionInfo, error) {
 	pods := make([]k8s.PodInfo, 0, len(endpoints))
 	podByPodKey := make(map[types.NamespacedName]k8s.PodInfo, len(endpoints))
 	for _, endpoint := range endpoints {
@@ -138,7 +139,7 @@ func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithPodEndpoint
 
 	permissionsPerSG := make(map[string][]networking.IPPermissionInfo, len(podsBySG))
 	for sgID, pods := range podsBySG {
-		permissions, err := m.computeIngressPermissionsForTGBNetworking(ctx, tgbNetworking, pods)
+		permissions, err := m.computeIngressPermissionsForTGBNetworking(ctx, tgbNetworking, ipAddressType, pods)
 		if err != nil {
 			return nil, err
 		}
@@ -147,7 +148,8 @@ func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithPodEndpoint
 	return permissionsPerSG, nil
 }
 
-func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithNodePortEndpoints(ctx context.Context, tgbNetworking elbv2api.TargetGroupBindingNetworking, endpoints []backend.NodePortEndpoint) (map[string][]networking.IPPermissionInfo, error) {
+func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithNodePortEndpoints(ctx context.Context, tgbNetworking elbv2api.TargetGroupBindingNetworking,
+	ipAddressType *elbv2api.TargetGroupIPAddressType, endpoints []backend.NodePortEndpoint) (map[string][]networking.IPPermissionInfo, error) {
 	nodes := make([]*corev1.Node, 0, len(endpoints))
 	for _, endpoint := range endpoints {
 		nodes = append(nodes, endpoint.Node)
@@ -164,7 +166,7 @@ func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithNodePortEnd
 		}
 		sgIDs.Insert(sgID)
 	}
-	permissions, err := m.computeIngressPermissionsForTGBNetworking(ctx, tgbNetworking, nil)
+	permissions, err := m.computeIngressPermissionsForTGBNetworking(ctx, tgbNetworking, ipAddressType, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -250,11 +252,16 @@ func (m *defaultNetworkingManager) computeAggregatedIngressPermissionsPerSG(_ co
 
 // computeIngressPermissionsForTGBNetworking computes the needed Inbound IPPermissions for specified TargetGroupBinding.
 // an optional list of pods if provided if pod endpoints are used, and named ports will be resolved to the pod port.
-func (m *defaultNetworkingManager) computeIngressPermissionsForTGBNetworking(ctx context.Context, tgbNetworking elbv2api.TargetGroupBindingNetworking, pods []k8s.PodInfo) ([]networking.IPPermissionInfo, error) {
+// an optional ipAddressType if provided, and IPBlock peers of the other IP family will be ignored since they cannot reach the targets.
+func (m *defaultNetworkingManager) computeIngressPermissionsForTGBNetworking(ctx context.Context, tgbNetworking elbv2api.TargetGroupBindingNetworking,
+	ipAddressType *elbv2api.TargetGroupIPAddressType, pods []k8s.PodInfo) ([]networking.IPPermissionInfo, error) {
 	var permissions []networking.IPPermissionInfo
 	protocolTCP := elbv2api.NetworkingProtocolTCP
 	for _, rule := range tgbNetworking.Ingress {
 		for _, rulePeer := range rule.From {
+			if !peerMatchesIPAddressType(rulePeer, ipAddressType) {
+				continue
+			}
 			for _, rulePort := range rule.Ports {
 				permissionsForPeerPort, err := m.computePermissionsForPeerPort(ctx, rulePeer, rulePort, pods)
 				if err != nil {
@@ -278,6 +285,16 @@ func (m *defaultNetworkingManager) computeIngressPermissionsForTGBNetworking(ctx
 	return permissions, nil
 }
 
+// peerMatchesIPAddressType checks whether peer matches the IP family of ipAddressType.
+// SecurityGroup peers match both IP families, and all peers match if ipAddressType is unspecified.
+func peerMatchesIPAddressType(peer elbv2api.NetworkingPeer, ipAddressType *elbv2api.TargetGroupIPAddressType) bool {
+	if ipAddressType == nil || peer.IPBlock == nil {
+		return true
+	}
+	isIPv6CIDR := strings.Contains(peer.IPBlock.CIDR, ":")
+	return isIPv6CIDR == (*ipAddressType == elbv2api.TargetGroupIPAddressTypeIPv6)
+}
+
 type sdkFromToPortPair struct {
 	fromPort int64
 	toPort   int64
@@ -18,8 +18,11 @@ import (
 func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *testing.T) {
 	port8080 := intstr.FromInt(8080)
 	port8443 := intstr.FromInt(8443)
+	ipAddressTypeIPv4 := elbv2api.TargetGroupIPAddressTypeIPv4
+	ipAddressTypeIPv6 := elbv2api.TargetGroupIPAddressTypeIPv6
 	type args struct {
 		tgbNetworking elbv2api.TargetGroupBindingNetworking
+		ipAddressType *elbv2api.TargetGroupIPAddressType
 		pods          []k8s.PodInfo
 	}
 	tests := []struct {
@@ -223,11 +226,216 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 				},
 			},
 		},
+		{
+			name: "with mixed IP family peers / ipAddressType unspecified",
+			args: args{
+				tgbNetworking: elbv2api.TargetGroupBindingNetworking{
+					Ingress: []elbv2api.NetworkingIngressRule{
+						{
+							From: []elbv2api.NetworkingPeer{
+								{
+									SecurityGroup: &elbv2api.SecurityGroup{
+										GroupID: "sg-abcdefg",
+									},
+								},
+								{
+									IPBlock: &elbv2api.IPBlock{
+										CIDR: "192.168.1.1/16",
+									},
+								},
+								{
+									IPBlock: &elbv2api.IPBlock{
+										CIDR: "2600:1f13:837:8500::/56",
+									},
+								},
+							},
+							Ports: []elbv2api.NetworkingPort{
+								{
+									Port: &port8080,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []networking.IPPermissionInfo{
+				{
+					Permission: ec2sdk.IpPermission{
+						IpProtocol: awssdk.String("tcp"),
+						FromPort:   awssdk.Int64(8080),
+						ToPort:     awssdk.Int64(8080),
+						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							{
+								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								GroupId:     awssdk.String("sg-abcdefg"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+				{
+					Permission: ec2sdk.IpPermission{
+						IpProtocol: awssdk.String("tcp"),
+						FromPort:   awssdk.Int64(8080),
+						ToPort:     awssdk.Int64(8080),
+						IpRanges: []*ec2sdk.IpRange{
+							{
+								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								CidrIp:      awssdk.String("192.168.1.1/16"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+				{
+					Permission: ec2sdk.IpPermission{
+						IpProtocol: awssdk.String("tcp"),
+						FromPort:   awssdk.Int64(8080),
+						ToPort:     awssdk.Int64(8080),
+						Ipv6Ranges: []*ec2sdk.Ipv6Range{
+							{
+								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								CidrIpv6:    awssdk.String("2600:1f13:837:8500::/56"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+			},
+		},
+		{
+			name: "with mixed IP family peers / ipv4 ipAddressType",
+			args: args{
+				tgbNetworking: elbv2api.TargetGroupBindingNetworking{
+					Ingress: []elbv2api.NetworkingIngressRule{
+						{
+							From: []elbv2api.NetworkingPeer{
+								{
+									SecurityGroup: &elbv2api.SecurityGroup{
+										GroupID: "sg-abcdefg",
+									},
+								},
+								{
+									IPBlock: &elbv2api.IPBlock{
+										CIDR: "192.168.1.1/16",
+									},
+								},
+								{
+									IPBlock: &elbv2api.IPBlock{
+										CIDR: "2600:1f13:837:8500::/56",
+									},
+								},
+							},
+							Ports: []elbv2api.NetworkingPort{
+								{
+									Port: &port8080,
+								},
+							},
+						},
+					},
+				},
+				ipAddressType: &ipAddressTypeIPv4,
+			},
+			want: []networking.IPPermissionInfo{
+				{
+					Permission: ec2sdk.IpPermission{
+						IpProtocol: awssdk.String("tcp"),
+						FromPort:   awssdk.Int64(8080),
+						ToPort:     awssdk.Int64(8080),
+						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							{
+								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								GroupId:     awssdk.String("sg-abcdefg"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+				{
+					Permission: ec2sdk.IpPermission{
+						IpProtocol: awssdk.String("tcp"),
+						FromPort:   awssdk.Int64(8080),
+						ToPort:     awssdk.Int64(8080),
+						IpRanges: []*ec2sdk.IpRange{
+							{
+								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								CidrIp:      awssdk.String("192.168.1.1/16"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+			},
+		},
+		{
+			name: "with mixed IP family peers / ipv6 ipAddressType",
+			args: args{
+				tgbNetworking: elbv2api.TargetGroupBindingNetworking{
+					Ingress: []elbv2api.NetworkingIngressRule{
+						{
+							From: []elbv2api.NetworkingPeer{
+								{
+									SecurityGroup: &elbv2api.SecurityGroup{
+										GroupID: "sg-abcdefg",
+									},
+								},
+								{
+									IPBlock: &elbv2api.IPBlock{
+										CIDR: "192.168.1.1/16",
+									},
+								},
+								{
+									IPBlock: &elbv2api.IPBlock{
+										CIDR: "2600:1f13:837:8500::/56",
+									},
+								},
+							},
+							Ports: []elbv2api.NetworkingPort{
+								{
+									Port: &port8080,
+								},
+							},
+						},
+					},
+				},
+				ipAddressType: &ipAddressTypeIPv6,
+			},
+			want: []networking.IPPermissionInfo{
+				{
+					Permission: ec2sdk.IpPermission{
+						IpProtocol: awssdk.String("tcp"),
+						FromPort:   awssdk.Int64(8080),
+						ToPort:     awssdk.Int64(8080),
+						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							{
+								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								GroupId:     awssdk.String("sg-abcdefg"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+				{
+					Permission: ec2sdk.IpPermission{
+						IpProtocol: awssdk.String("tcp"),
+						FromPort:   awssdk.Int64(8080),
+						ToPort:     awssdk.Int64(8080),
+						Ipv6Ranges: []*ec2sdk.Ipv6Range{
+							{
+								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								CidrIpv6:    awssdk.String("2600:1f13:837:8500::/56"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := &defaultNetworkingManager{}
-			got, err := m.computeIngressPermissionsForTGBNetworking(context.Background(), tt.args.tgbNetworking, tt.args.pods)
+			got, err := m.computeIngressPermissionsForTGBNetworking(context.Background(), tt.args.tgbNetworking, tt.args.ipAddressType, tt.args.pods)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"k8s.io/client-go/tools/record"
+	"net"
+	"sort"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -27,7 +29,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const defaultTargetHealthRequeueDuration = 15 * time.Second
+const (
+	defaultTargetHealthRequeueDuration = 15 * time.Second
+	// the max number of draining or unhealthy targets to be listed in TargetGroupBinding status.
+	maxTargetsInStatus = 100
+)
 
 // ResourceManager manages the TargetGroupBinding resource.
 type ResourceManager interface {
@@ -36,21 +42,25 @@ type ResourceManager interface {
 }
 
 // NewDefaultResourceManager constructs new defaultResourceManager.
-func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2,
+func NewDefaultResourceManager(k8sClient client.Client, apiReader client.Reader, elbv2Client services.ELBV2,
 	podInfoRepo k8s.PodInfoRepo, podENIResolver networking.PodENIInfoResolver, nodeENIResolver networking.NodeENIInfoResolver,
 	sgManager networking.SecurityGroupManager, sgReconciler networking.SecurityGroupReconciler,
-	vpcID string, clusterName string, eventRecorder record.EventRecorder, logger logr.Logger) *defaultResourceManager {
+	vpcID string, clusterName string, endpointSliceEnabled bool, gracefulDrainingEnabled bool,
+	eventRecorder record.EventRecorder, logger logr.Logger) *defaultResourceManager {
 	targetsManager := NewCachedTargetsManager(elbv2Client, logger)
-	endpointResolver := backend.NewDefaultEndpointResolver(k8sClient, podInfoRepo, logger)
+	endpointResolver := backend.NewDefaultEndpointResolver(k8sClient, podInfoRepo, endpointSliceEnabled, logger)
 	networkingManager := NewDefaultNetworkingManager(k8sClient, podENIResolver, nodeENIResolver, sgManager, sgReconciler, vpcID, clusterName, logger)
+	multiClusterManager := NewDefaultMultiClusterManager(k8sClient, apiReader, clusterName, logger)
 	return &defaultResourceManager{
-		k8sClient:         k8sClient,
-		targetsManager:    targetsManager,
-		endpointResolver:  endpointResolver,
-		networkingManager: networkingManager,
-		eventRecorder:     eventRecorder,
-		logger:            logger,
-
+		k8sClient:           k8sClient,
+		targetsManager:      targetsManager,
+		endpointResolver:    endpointResolver,
+		networkingManager:   networkingManager,
+		multiClusterManager: multiClusterManager,
+		eventRecorder:       eventRecorder,
+		logger:              logger,
+
+		gracefulDrainingEnabled:     gracefulDrainingEnabled,
 		targetHealthRequeueDuration: defaultTargetHealthRequeueDuration,
 	}
 }
@@ -59,13 +69,16 @@ var _ ResourceManager = &defaultResourceManager{}
 
 // default implementation for ResourceManager.
 type defaultResourceManager struct {
-	k8sClient         client.Client
-	targetsManager    TargetsManager
-	endpointResolver  backend.EndpointResolver
-	networkingManager NetworkingManager
-	eventRecorder     record.EventRecorder
-	logger            logr.Logger
-
+	k8sClient           client.Client
+	targetsManager      TargetsManager
+	endpointResolver    backend.EndpointResolver
+	networkingManager   NetworkingManager
+	multiClusterManager MultiClusterManager
+	eventRecorder       record.EventRecorder
+	logger              logr.Logger
+
+	// whether to keep track of terminating pods until their targets finished draining.
+	gracefulDrainingEnabled     bool
 	targetHealthRequeueDuration time.Duration
 }
 
@@ -86,6 +99,10 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	if err := m.networkingManager.Cleanup(ctx, tgb); err != nil {
 		return err
 	}
+	tgb.Status.Targets = nil
+	tgb.Status.UnhealthyTargets = nil
+	tgb.Status.DrainingTargets = nil
+	tgb.Status.Zones = nil
 	return nil
 }
 
@@ -96,6 +113,12 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	resolveOpts := []backend.EndpointResolveOption{
 		backend.WithPodReadinessGate(targetHealthCondType),
 	}
+	if m.gracefulDrainingEnabled {
+		resolveOpts = append(resolveOpts, backend.WithTerminatingEndpoints())
+	}
+	if tgb.Spec.IPAddressType != nil {
+		resolveOpts = append(resolveOpts, backend.WithPodEndpointIPFamily(buildPodEndpointIPFamily(*tgb.Spec.IPAddressType)))
+	}
 	endpoints, containsPotentialReadyEndpoints, err := m.endpointResolver.ResolvePodEndpoints(ctx, svcKey, tgb.Spec.ServiceRef.Port, resolveOpts...)
 	if err != nil {
 		if errors.Is(err, backend.ErrNotFound) {
@@ -104,24 +127,60 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		}
 		return err
 	}
+	if err := validatePodEndpointsIPAddressType(tgb, endpoints); err != nil {
+		return err
+	}
 
 	tgARN := tgb.Spec.TargetGroupARN
 	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
 	if err != nil {
 		return err
 	}
+	targets, err = m.multiClusterManager.FilterOwnedTargets(ctx, tgb, targets)
+	if err != nil {
+		return err
+	}
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
-	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(endpoints, notDrainingTargets)
+	// terminating endpoints are never matched, so that their targets are deregistered and start draining.
+	activeEndpoints, terminatingEndpoints := partitionPodEndpointsByTerminatingStatus(endpoints)
+	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(activeEndpoints, notDrainingTargets)
 
+	// terminating endpoints still need network access from ELB to serve in-flight requests while draining.
 	if err := m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints); err != nil {
 		return err
 	}
 	if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
 		return err
 	}
-	if err := m.registerPodEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
+	if len(unmatchedTargets) != 0 {
+		tgb.Status.LastDeregisterTime = &metav1.Time{Time: time.Now()}
+	}
+	if err := m.registerPodEndpoints(ctx, tgb, unmatchedEndpoints); err != nil {
 		return err
 	}
+	if len(unmatchedEndpoints) != 0 {
+		tgb.Status.LastRegisterTime = &metav1.Time{Time: time.Now()}
+	}
+	drainingTargets = append(drainingTargets, unmatchedTargets...)
+	registeredTargets := make([]TargetInfo, 0, len(matchedEndpointAndTargets))
+	podNameByTargetUID := make(map[string]string, len(matchedEndpointAndTargets))
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		registeredTargets = append(registeredTargets, endpointAndTarget.target)
+		podNameByTargetUID[UniqueIDForTargetDescription(endpointAndTarget.target.Target)] = endpointAndTarget.endpoint.Pod.Key.Name
+	}
+	tgb.Status.Targets = buildTargetHealthSummary(registeredTargets, len(unmatchedEndpoints), drainingTargets)
+	tgb.Status.UnhealthyTargets = buildUnhealthyTargetsStatus(registeredTargets, podNameByTargetUID)
+	tgb.Status.DrainingTargets = buildDrainingTargetsStatus(drainingTargets, terminatingEndpoints)
+	tgb.Status.Zones = buildZonesStatus(activeEndpoints)
+
+	anyTargetDraining := false
+	if m.gracefulDrainingEnabled {
+		targetDrainCondType := BuildTargetDrainPodConditionType(tgb)
+		anyTargetDraining, err = m.updateTargetDrainPodCondition(ctx, targetDrainCondType, terminatingEndpoints, drainingTargets)
+		if err != nil {
+			return err
+		}
+	}
 
 	anyPodNeedFurtherProbe, err := m.updateTargetHealthPodCondition(ctx, targetHealthCondType, matchedEndpointAndTargets, unmatchedEndpoints)
 	if err != nil {
@@ -139,7 +198,9 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		return runtime.NewRequeueNeeded("monitor potential ready endpoints")
 	}
 
-	_ = drainingTargets
+	if anyTargetDraining {
+		return runtime.NewRequeueNeededAfter("monitor draining targets", m.targetHealthRequeueDuration)
+	}
 	return nil
 }
 
@@ -164,8 +225,12 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 	if err != nil {
 		return err
 	}
+	targets, err = m.multiClusterManager.FilterOwnedTargets(ctx, tgb, targets)
+	if err != nil {
+		return err
+	}
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
-	_, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
+	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
 
 	if err := m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints); err != nil {
 		return err
@@ -173,10 +238,23 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 	if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
 		return err
 	}
-	if err := m.registerNodePortEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
+	if len(unmatchedTargets) != 0 {
+		tgb.Status.LastDeregisterTime = &metav1.Time{Time: time.Now()}
+	}
+	if err := m.registerNodePortEndpoints(ctx, tgb, unmatchedEndpoints); err != nil {
 		return err
 	}
-	_ = drainingTargets
+	if len(unmatchedEndpoints) != 0 {
+		tgb.Status.LastRegisterTime = &metav1.Time{Time: time.Now()}
+	}
+	drainingTargets = append(drainingTargets, unmatchedTargets...)
+	registeredTargets := make([]TargetInfo, 0, len(matchedEndpointAndTargets))
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		registeredTargets = append(registeredTargets, endpointAndTarget.target)
+	}
+	tgb.Status.Targets = buildTargetHealthSummary(registeredTargets, len(unmatchedEndpoints), drainingTargets)
+	tgb.Status.UnhealthyTargets = buildUnhealthyTargetsStatus(registeredTargets, nil)
+	tgb.Status.DrainingTargets = buildDrainingTargetsStatus(drainingTargets, nil)
 	return nil
 }
 
@@ -184,17 +262,21 @@ func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2a
 	targets, err := m.targetsManager.ListTargets(ctx, tgb.Spec.TargetGroupARN)
 	if err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
-			return nil
+			return m.multiClusterManager.Cleanup(ctx, tgb)
 		}
 		return err
 	}
+	targets, err = m.multiClusterManager.FilterOwnedTargets(ctx, tgb, targets)
+	if err != nil {
+		return err
+	}
 	if err := m.deregisterTargets(ctx, tgb.Spec.TargetGroupARN, targets); err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
-			return nil
+			return m.multiClusterManager.Cleanup(ctx, tgb)
 		}
 		return err
 	}
-	return nil
+	return m.multiClusterManager.Cleanup(ctx, tgb)
 }
 
 // updateTargetHealthPodCondition will updates pod's targetHealth condition for matchedEndpointAndTargets and unmatchedEndpoints.
@@ -255,26 +337,71 @@ func (m *defaultResourceManager) updateTargetHealthPodConditionForPod(ctx contex
 	}
 	needFurtherProbe := targetHealthCondStatus != corev1.ConditionTrue
 
-	existingTargetHealthCond, exists := pod.GetPodCondition(targetHealthCondType)
+	podExists, err := m.patchPodCondition(ctx, pod, targetHealthCondType, targetHealthCondStatus, reason, message)
+	if err != nil {
+		return false, err
+	}
+	if !podExists {
+		return false, nil
+	}
+	return needFurtherProbe, nil
+}
+
+// updateTargetDrainPodCondition will updates terminating pod's targetDrain condition based on whether its target is still draining.
+// returns whether any target for terminating pods is still draining.
+func (m *defaultResourceManager) updateTargetDrainPodCondition(ctx context.Context, targetDrainCondType corev1.PodConditionType,
+	terminatingEndpoints []backend.PodEndpoint, drainingTargets []TargetInfo) (bool, error) {
+	drainingTargetUIDs := sets.NewString()
+	for _, target := range drainingTargets {
+		drainingTargetUIDs.Insert(UniqueIDForTargetDescription(target.Target))
+	}
+
+	anyTargetDraining := false
+	for _, endpoint := range terminatingEndpoints {
+		endpointUID := uniqueIDForPodEndpoint(endpoint)
+		condStatus := corev1.ConditionTrue
+		reason := elbv2sdk.TargetHealthReasonEnumTargetNotRegistered
+		message := "Target deregistration completed"
+		if drainingTargetUIDs.Has(endpointUID) {
+			condStatus = corev1.ConditionFalse
+			reason = elbv2sdk.TargetHealthReasonEnumTargetDeregistrationInProgress
+			message = "Target deregistration is in progress"
+		}
+		podExists, err := m.patchPodCondition(ctx, endpoint.Pod, targetDrainCondType, condStatus, reason, message)
+		if err != nil {
+			return false, err
+		}
+		if podExists && condStatus != corev1.ConditionTrue {
+			anyTargetDraining = true
+		}
+	}
+	return anyTargetDraining, nil
+}
+
+// patchPodCondition will patch pod's condition if it doesn't match the desired status/reason/message.
+// returns whether pod still exists.
+func (m *defaultResourceManager) patchPodCondition(ctx context.Context, pod k8s.PodInfo, condType corev1.PodConditionType,
+	condStatus corev1.ConditionStatus, reason string, message string) (bool, error) {
+	existingCond, exists := pod.GetPodCondition(condType)
 	// we skip patch pod if it matches current computed status/reason/message.
 	if exists &&
-		existingTargetHealthCond.Status == targetHealthCondStatus &&
-		existingTargetHealthCond.Reason == reason &&
-		existingTargetHealthCond.Message == message {
-		return needFurtherProbe, nil
+		existingCond.Status == condStatus &&
+		existingCond.Reason == reason &&
+		existingCond.Message == message {
+		return true, nil
 	}
 
-	newTargetHealthCond := corev1.PodCondition{
-		Type:    targetHealthCondType,
-		Status:  targetHealthCondStatus,
+	newCond := corev1.PodCondition{
+		Type:    condType,
+		Status:  condStatus,
 		Reason:  reason,
 		Message: message,
 	}
-	if !exists || existingTargetHealthCond.Status != targetHealthCondStatus {
-		newTargetHealthCond.LastTransitionTime = metav1.Now()
+	if !exists || existingCond.Status != condStatus {
+		newCond.LastTransitionTime = metav1.Now()
 	}
 
-	patch, err := buildPodConditionPatch(pod, newTargetHealthCond)
+	patch, err := buildPodConditionPatch(pod, newCond)
 	if err != nil {
 		return false, err
 	}
@@ -291,8 +418,7 @@ func (m *defaultResourceManager) updateTargetHealthPodConditionForPod(ctx contex
 		}
 		return false, err
 	}
-
-	return needFurtherProbe, nil
+	return true, nil
 }
 
 func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgARN string, targets []TargetInfo) error {
@@ -303,7 +429,7 @@ func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgARN st
 	return m.targetsManager.DeregisterTargets(ctx, tgARN, sdkTargets)
 }
 
-func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN string, endpoints []backend.PodEndpoint) error {
+func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) error {
 	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(endpoints))
 	for _, endpoint := range endpoints {
 		sdkTargets = append(sdkTargets, elbv2sdk.TargetDescription{
@@ -311,10 +437,10 @@ func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN
 			Port: awssdk.Int64(endpoint.Port),
 		})
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return m.registerTargets(ctx, tgb, sdkTargets)
 }
 
-func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, tgARN string, endpoints []backend.NodePortEndpoint) error {
+func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.NodePortEndpoint) error {
 	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(endpoints))
 	for _, endpoint := range endpoints {
 		sdkTargets = append(sdkTargets, elbv2sdk.TargetDescription{
@@ -322,7 +448,15 @@ func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context,
 			Port: awssdk.Int64(endpoint.Port),
 		})
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return m.registerTargets(ctx, tgb, sdkTargets)
+}
+
+func (m *defaultResourceManager) registerTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, sdkTargets []elbv2sdk.TargetDescription) error {
+	// targets are tracked ahead of registration, so that they won't be leaked if registration partially succeeded.
+	if err := m.multiClusterManager.TrackTargets(ctx, tgb, sdkTargets); err != nil {
+		return err
+	}
+	return m.targetsManager.RegisterTargets(ctx, tgb.Spec.TargetGroupARN, sdkTargets)
 }
 
 type podEndpointAndTargetPair struct {
@@ -343,6 +477,142 @@ func partitionTargetsByDrainingStatus(targets []TargetInfo) ([]TargetInfo, []Tar
 	return notDrainingTargets, drainingTargets
 }
 
+// partitionPodEndpointsByTerminatingStatus partitions endpoints into active and terminating ones.
+func partitionPodEndpointsByTerminatingStatus(endpoints []backend.PodEndpoint) ([]backend.PodEndpoint, []backend.PodEndpoint) {
+	var activeEndpoints []backend.PodEndpoint
+	var terminatingEndpoints []backend.PodEndpoint
+	for _, endpoint := range endpoints {
+		if endpoint.Terminating {
+			terminatingEndpoints = append(terminatingEndpoints, endpoint)
+		} else {
+			activeEndpoints = append(activeEndpoints, endpoint)
+		}
+	}
+	return activeEndpoints, terminatingEndpoints
+}
+
+// buildDrainingTargetsStatus builds the status for draining targets, the pod is filled if it's known from terminating endpoints.
+func buildDrainingTargetsStatus(drainingTargets []TargetInfo, terminatingEndpoints []backend.PodEndpoint) []elbv2api.DrainingTarget {
+	if len(drainingTargets) == 0 {
+		return nil
+	}
+	podNameByEndpointUID := make(map[string]string, len(terminatingEndpoints))
+	for _, endpoint := range terminatingEndpoints {
+		endpointUID := uniqueIDForPodEndpoint(endpoint)
+		podNameByEndpointUID[endpointUID] = endpoint.Pod.Key.Name
+	}
+
+	drainingTargetsStatus := make([]elbv2api.DrainingTarget, 0, len(drainingTargets))
+	for _, target := range drainingTargets {
+		drainingTarget := elbv2api.DrainingTarget{
+			ID:   awssdk.StringValue(target.Target.Id),
+			Port: awssdk.Int64Value(target.Target.Port),
+		}
+		if podName, ok := podNameByEndpointUID[UniqueIDForTargetDescription(target.Target)]; ok {
+			drainingTarget.Pod = awssdk.String(podName)
+		}
+		drainingTargetsStatus = append(drainingTargetsStatus, drainingTarget)
+	}
+	sort.Slice(drainingTargetsStatus, func(i, j int) bool {
+		if drainingTargetsStatus[i].ID != drainingTargetsStatus[j].ID {
+			return drainingTargetsStatus[i].ID < drainingTargetsStatus[j].ID
+		}
+		return drainingTargetsStatus[i].Port < drainingTargetsStatus[j].Port
+	})
+	if len(drainingTargetsStatus) > maxTargetsInStatus {
+		drainingTargetsStatus = drainingTargetsStatus[:maxTargetsInStatus]
+	}
+	return drainingTargetsStatus
+}
+
+// buildZonesStatus builds the per zone summary of active endpoints along with their topology aware hints.
+// endpoints resolved from Endpoints carry no zone and are not summarized.
+func buildZonesStatus(activeEndpoints []backend.PodEndpoint) []elbv2api.ZoneEndpoints {
+	zoneEndpointsByZone := make(map[string]*elbv2api.ZoneEndpoints)
+	getOrCreateZoneEndpoints := func(zone string) *elbv2api.ZoneEndpoints {
+		zoneEndpoints, ok := zoneEndpointsByZone[zone]
+		if !ok {
+			zoneEndpoints = &elbv2api.ZoneEndpoints{Zone: zone}
+			zoneEndpointsByZone[zone] = zoneEndpoints
+		}
+		return zoneEndpoints
+	}
+	anyEndpointHinted := false
+	for _, endpoint := range activeEndpoints {
+		if endpoint.Zone != "" {
+			getOrCreateZoneEndpoints(endpoint.Zone).Endpoints++
+		}
+		for _, zoneHint := range endpoint.ZoneHints {
+			anyEndpointHinted = true
+			zoneEndpoints := getOrCreateZoneEndpoints(zoneHint)
+			zoneEndpoints.HintedEndpoints = awssdk.Int32(awssdk.Int32Value(zoneEndpoints.HintedEndpoints) + 1)
+		}
+	}
+	if len(zoneEndpointsByZone) == 0 {
+		return nil
+	}
+
+	zonesStatus := make([]elbv2api.ZoneEndpoints, 0, len(zoneEndpointsByZone))
+	for _, zoneEndpoints := range zoneEndpointsByZone {
+		// zones without any hinted endpoints are reported explicitly, since they'll be served by endpoints from other zones.
+		if anyEndpointHinted && zoneEndpoints.HintedEndpoints == nil {
+			zoneEndpoints.HintedEndpoints = awssdk.Int32(0)
+		}
+		zonesStatus = append(zonesStatus, *zoneEndpoints)
+	}
+	sort.Slice(zonesStatus, func(i, j int) bool {
+		return zonesStatus[i].Zone < zonesStatus[j].Zone
+	})
+	return zonesStatus
+}
+
+// buildTargetHealthSummary builds the summary of targets by health state.
+// newlyRegisteredCount is the number of targets just registered, whose health state is not known yet.
+func buildTargetHealthSummary(registeredTargets []TargetInfo, newlyRegisteredCount int, drainingTargets []TargetInfo) *elbv2api.TargetHealthSummary {
+	summary := &elbv2api.TargetHealthSummary{
+		Registered: int32(len(registeredTargets) + newlyRegisteredCount),
+		Draining:   int32(len(drainingTargets)),
+	}
+	for _, target := range registeredTargets {
+		if target.IsHealthy() {
+			summary.Healthy++
+		} else if target.IsUnhealthy() {
+			summary.Unhealthy++
+		}
+	}
+	return summary
+}
+
+// buildUnhealthyTargetsStatus builds the status for unhealthy targets, the pod is filled if it's known from podNameByTargetUID.
+func buildUnhealthyTargetsStatus(registeredTargets []TargetInfo, podNameByTargetUID map[string]string) []elbv2api.UnhealthyTarget {
+	var unhealthyTargetsStatus []elbv2api.UnhealthyTarget
+	for _, target := range registeredTargets {
+		if !target.IsUnhealthy() {
+			continue
+		}
+		unhealthyTarget := elbv2api.UnhealthyTarget{
+			ID:          awssdk.StringValue(target.Target.Id),
+			Port:        awssdk.Int64Value(target.Target.Port),
+			Reason:      awssdk.StringValue(target.TargetHealth.Reason),
+			Description: awssdk.StringValue(target.TargetHealth.Description),
+		}
+		if podName, ok := podNameByTargetUID[UniqueIDForTargetDescription(target.Target)]; ok {
+			unhealthyTarget.Pod = awssdk.String(podName)
+		}
+		unhealthyTargetsStatus = append(unhealthyTargetsStatus, unhealthyTarget)
+	}
+	sort.Slice(unhealthyTargetsStatus, func(i, j int) bool {
+		if unhealthyTargetsStatus[i].ID != unhealthyTargetsStatus[j].ID {
+			return unhealthyTargetsStatus[i].ID < unhealthyTargetsStatus[j].ID
+		}
+		return unhealthyTargetsStatus[i].Port < unhealthyTargetsStatus[j].Port
+	})
+	if len(unhealthyTargetsStatus) > maxTargetsInStatus {
+		unhealthyTargetsStatus = unhealthyTargetsStatus[:maxTargetsInStatus]
+	}
+	return unhealthyTargetsStatus
+}
+
 func containsTargetsInInitialState(matchedEndpointAndTargets []podEndpointAndTargetPair) bool {
 	for _, endpointAndTarget := range matchedEndpointAndTargets {
 		if endpointAndTarget.target.IsInitial() {
@@ -359,13 +629,12 @@ func matchPodEndpointWithTargets(endpoints []backend.PodEndpoint, targets []Targ
 
 	endpointsByUID := make(map[string]backend.PodEndpoint, len(endpoints))
 	for _, endpoint := range endpoints {
-		endpointUID := fmt.Sprintf("%v:%v", endpoint.IP, endpoint.Port)
+		endpointUID := uniqueIDForPodEndpoint(endpoint)
 		endpointsByUID[endpointUID] = endpoint
 	}
 	targetsByUID := make(map[string]TargetInfo, len(targets))
 	for _, target := range targets {
-		targetUID := fmt.Sprintf("%v:%v", awssdk.StringValue(target.Target.Id), awssdk.Int64Value(target.Target.Port))
-		targetsByUID[targetUID] = target
+		targetsByUID[UniqueIDForTargetDescription(target.Target)] = target
 	}
 	endpointUIDs := sets.StringKeySet(endpointsByUID)
 	targetUIDs := sets.StringKeySet(targetsByUID)
@@ -386,6 +655,39 @@ func matchPodEndpointWithTargets(endpoints []backend.PodEndpoint, targets []Targ
 	return matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets
 }
 
+// uniqueIDForPodEndpoint generates a unique ID for pod endpoint, which matches the unique ID of its target.
+func uniqueIDForPodEndpoint(endpoint backend.PodEndpoint) string {
+	return fmt.Sprintf("%v:%v", canonicalTargetID(endpoint.IP), endpoint.Port)
+}
+
+// buildPodEndpointIPFamily builds the IP family of pod endpoints for ipAddressType of TargetGroupBinding.
+func buildPodEndpointIPFamily(ipAddressType elbv2api.TargetGroupIPAddressType) corev1.IPFamily {
+	if ipAddressType == elbv2api.TargetGroupIPAddressTypeIPv6 {
+		return corev1.IPv6Protocol
+	}
+	return corev1.IPv4Protocol
+}
+
+// validatePodEndpointsIPAddressType validates pod endpoints are of the same IP family as TargetGroupBinding's ipAddressType.
+// TargetGroup only accepts IP targets of its own IP address type, thus mismatched endpoints are rejected ahead of registration.
+func validatePodEndpointsIPAddressType(tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) error {
+	if tgb.Spec.IPAddressType == nil {
+		return nil
+	}
+	wantIPv6 := *tgb.Spec.IPAddressType == elbv2api.TargetGroupIPAddressTypeIPv6
+	for _, endpoint := range endpoints {
+		ip := net.ParseIP(endpoint.IP)
+		if ip == nil {
+			return errors.Errorf("invalid pod endpoint IP %v", endpoint.IP)
+		}
+		isIPv6 := ip.To4() == nil
+		if isIPv6 != wantIPv6 {
+			return errors.Errorf("pod endpoint IP %v mismatches ipAddressType %v of TargetGroupBinding", endpoint.IP, *tgb.Spec.IPAddressType)
+		}
+	}
+	return nil
+}
+
 type nodePortEndpointAndTargetPair struct {
 	endpoint backend.NodePortEndpoint
 	target   TargetInfo
@@ -403,8 +705,7 @@ func matchNodePortEndpointWithTargets(endpoints []backend.NodePortEndpoint, targ
 	}
 	targetsByUID := make(map[string]TargetInfo, len(targets))
 	for _, target := range targets {
-		targetUID := fmt.Sprintf("%v:%v", awssdk.StringValue(target.Target.Id), awssdk.Int64Value(target.Target.Port))
-		targetsByUID[targetUID] = target
+		targetsByUID[UniqueIDForTargetDescription(target.Target)] = target
 	}
 	endpointUIDs := sets.StringKeySet(endpointsByUID)
 	targetUIDs := sets.StringKeySet(targetsByUID)
@@ -6,12 +6,15 @@ import (
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -408,6 +411,430 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 	}
 }
 
+func Test_defaultResourceManager_updateTargetDrainPodCondition(t *testing.T) {
+	buildPod := func(name string, conditions []corev1.PodCondition) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				UID:       types.UID(name + "-uuid"),
+			},
+			Status: corev1.PodStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+	buildPodEndpoint := func(name string, ip string) backend.PodEndpoint {
+		return backend.PodEndpoint{
+			IP:   ip,
+			Port: 8080,
+			Pod: k8s.PodInfo{
+				Key: types.NamespacedName{Namespace: "default", Name: name},
+				UID: types.UID(name + "-uuid"),
+			},
+			Terminating: true,
+		}
+	}
+	drainingCond := corev1.PodCondition{
+		Type:    "target-drain.elbv2.k8s.aws/my-tgb",
+		Status:  corev1.ConditionFalse,
+		Reason:  elbv2sdk.TargetHealthReasonEnumTargetDeregistrationInProgress,
+		Message: "Target deregistration is in progress",
+	}
+	drainedCond := corev1.PodCondition{
+		Type:    "target-drain.elbv2.k8s.aws/my-tgb",
+		Status:  corev1.ConditionTrue,
+		Reason:  elbv2sdk.TargetHealthReasonEnumTargetNotRegistered,
+		Message: "Target deregistration completed",
+	}
+
+	type env struct {
+		pods []*corev1.Pod
+	}
+	type args struct {
+		terminatingEndpoints []backend.PodEndpoint
+		drainingTargets      []TargetInfo
+	}
+	tests := []struct {
+		name     string
+		env      env
+		args     args
+		want     bool
+		wantPods []*corev1.Pod
+	}{
+		{
+			name: "terminating pod with draining target",
+			env: env{
+				pods: []*corev1.Pod{buildPod("pod-1", nil)},
+			},
+			args: args{
+				terminatingEndpoints: []backend.PodEndpoint{buildPodEndpoint("pod-1", "192.168.1.1")},
+				drainingTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.1"),
+							Port: awssdk.Int64(8080),
+						},
+					},
+				},
+			},
+			want:     true,
+			wantPods: []*corev1.Pod{buildPod("pod-1", []corev1.PodCondition{drainingCond})},
+		},
+		{
+			name: "terminating pod whose target finished draining",
+			env: env{
+				pods: []*corev1.Pod{buildPod("pod-1", []corev1.PodCondition{drainingCond})},
+			},
+			args: args{
+				terminatingEndpoints: []backend.PodEndpoint{buildPodEndpoint("pod-1", "192.168.1.1")},
+				drainingTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.2"),
+							Port: awssdk.Int64(8080),
+						},
+					},
+				},
+			},
+			want:     false,
+			wantPods: []*corev1.Pod{buildPod("pod-1", []corev1.PodCondition{drainedCond})},
+		},
+		{
+			name: "terminating pod already deleted",
+			env: env{
+				pods: nil,
+			},
+			args: args{
+				terminatingEndpoints: []backend.PodEndpoint{buildPodEndpoint("pod-1", "192.168.1.1")},
+				drainingTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.1"),
+							Port: awssdk.Int64(8080),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+
+			m := &defaultResourceManager{
+				k8sClient: k8sClient,
+				logger:    &log.NullLogger{},
+			}
+
+			ctx := context.Background()
+			for _, pod := range tt.env.pods {
+				err := k8sClient.Create(ctx, pod.DeepCopy())
+				assert.NoError(t, err)
+			}
+
+			got, err := m.updateTargetDrainPodCondition(ctx, "target-drain.elbv2.k8s.aws/my-tgb",
+				tt.args.terminatingEndpoints, tt.args.drainingTargets)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			for _, wantPod := range tt.wantPods {
+				updatedPod := &corev1.Pod{}
+				err := m.k8sClient.Get(ctx, k8s.NamespacedName(wantPod), updatedPod)
+				assert.NoError(t, err)
+
+				opts := cmp.Options{
+					equality.IgnoreFakeClientPopulatedFields(),
+					cmpopts.IgnoreTypes(metav1.Time{}),
+				}
+				assert.True(t, cmp.Equal(wantPod, updatedPod, opts), "diff", cmp.Diff(wantPod, updatedPod, opts))
+			}
+		})
+	}
+}
+
+func Test_buildDrainingTargetsStatus(t *testing.T) {
+	type args struct {
+		drainingTargets      []TargetInfo
+		terminatingEndpoints []backend.PodEndpoint
+	}
+	tests := []struct {
+		name string
+		args args
+		want []elbv2api.DrainingTarget
+	}{
+		{
+			name: "no draining targets",
+			args: args{
+				drainingTargets: nil,
+			},
+			want: nil,
+		},
+		{
+			name: "draining targets with and without known pods",
+			args: args{
+				drainingTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.2"),
+							Port: awssdk.Int64(8080),
+						},
+					},
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.1"),
+							Port: awssdk.Int64(8080),
+						},
+					},
+				},
+				terminatingEndpoints: []backend.PodEndpoint{
+					{
+						IP:   "192.168.1.2",
+						Port: 8080,
+						Pod: k8s.PodInfo{
+							Key: types.NamespacedName{Namespace: "default", Name: "pod-2"},
+						},
+						Terminating: true,
+					},
+				},
+			},
+			want: []elbv2api.DrainingTarget{
+				{
+					ID:   "192.168.1.1",
+					Port: 8080,
+				},
+				{
+					ID:   "192.168.1.2",
+					Port: 8080,
+					Pod:  awssdk.String("pod-2"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildDrainingTargetsStatus(tt.args.drainingTargets, tt.args.terminatingEndpoints)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildZonesStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		activeEndpoints []backend.PodEndpoint
+		want            []elbv2api.ZoneEndpoints
+	}{
+		{
+			name: "endpoints without zone",
+			activeEndpoints: []backend.PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080},
+			},
+			want: nil,
+		},
+		{
+			name: "endpoints without hints",
+			activeEndpoints: []backend.PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080, Zone: "us-west-2b"},
+				{IP: "192.168.1.2", Port: 8080, Zone: "us-west-2a"},
+				{IP: "192.168.1.3", Port: 8080, Zone: "us-west-2b"},
+			},
+			want: []elbv2api.ZoneEndpoints{
+				{Zone: "us-west-2a", Endpoints: 1},
+				{Zone: "us-west-2b", Endpoints: 2},
+			},
+		},
+		{
+			name: "endpoints with hints",
+			activeEndpoints: []backend.PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080, Zone: "us-west-2a", ZoneHints: []string{"us-west-2a"}},
+				{IP: "192.168.1.2", Port: 8080, Zone: "us-west-2a", ZoneHints: []string{"us-west-2c"}},
+				{IP: "192.168.1.3", Port: 8080, Zone: "us-west-2b", ZoneHints: []string{"us-west-2a"}},
+			},
+			want: []elbv2api.ZoneEndpoints{
+				{Zone: "us-west-2a", Endpoints: 2, HintedEndpoints: awssdk.Int32(2)},
+				{Zone: "us-west-2b", Endpoints: 1, HintedEndpoints: awssdk.Int32(0)},
+				{Zone: "us-west-2c", Endpoints: 0, HintedEndpoints: awssdk.Int32(1)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildZonesStatus(tt.activeEndpoints)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildTargetHealthSummary(t *testing.T) {
+	type args struct {
+		registeredTargets    []TargetInfo
+		newlyRegisteredCount int
+		drainingTargets      []TargetInfo
+	}
+	tests := []struct {
+		name string
+		args args
+		want *elbv2api.TargetHealthSummary
+	}{
+		{
+			name: "no targets",
+			args: args{},
+			want: &elbv2api.TargetHealthSummary{},
+		},
+		{
+			name: "targets in various states",
+			args: args{
+				registeredTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.1"),
+							Port: awssdk.Int64(8080),
+						},
+						TargetHealth: &elbv2sdk.TargetHealth{
+							State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+						},
+					},
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.2"),
+							Port: awssdk.Int64(8080),
+						},
+						TargetHealth: &elbv2sdk.TargetHealth{
+							State:  awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
+							Reason: awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetTimeout),
+						},
+					},
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.3"),
+							Port: awssdk.Int64(8080),
+						},
+						TargetHealth: &elbv2sdk.TargetHealth{
+							State:  awssdk.String(elbv2sdk.TargetHealthStateEnumInitial),
+							Reason: awssdk.String(elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress),
+						},
+					},
+				},
+				newlyRegisteredCount: 2,
+				drainingTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.4"),
+							Port: awssdk.Int64(8080),
+						},
+					},
+				},
+			},
+			want: &elbv2api.TargetHealthSummary{
+				Registered: 5,
+				Healthy:    1,
+				Unhealthy:  1,
+				Draining:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildTargetHealthSummary(tt.args.registeredTargets, tt.args.newlyRegisteredCount, tt.args.drainingTargets)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildUnhealthyTargetsStatus(t *testing.T) {
+	type args struct {
+		registeredTargets  []TargetInfo
+		podNameByTargetUID map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []elbv2api.UnhealthyTarget
+	}{
+		{
+			name: "no unhealthy targets",
+			args: args{
+				registeredTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.1"),
+							Port: awssdk.Int64(8080),
+						},
+						TargetHealth: &elbv2sdk.TargetHealth{
+							State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "unhealthy targets with and without known pods",
+			args: args{
+				registeredTargets: []TargetInfo{
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.2"),
+							Port: awssdk.Int64(8080),
+						},
+						TargetHealth: &elbv2sdk.TargetHealth{
+							State:       awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
+							Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetResponseCodeMismatch),
+							Description: awssdk.String("Health checks failed with these codes: [500]"),
+						},
+					},
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.1"),
+							Port: awssdk.Int64(8080),
+						},
+						TargetHealth: &elbv2sdk.TargetHealth{
+							State:  awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
+							Reason: awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetTimeout),
+						},
+					},
+					{
+						Target: elbv2sdk.TargetDescription{
+							Id:   awssdk.String("192.168.1.3"),
+							Port: awssdk.Int64(8080),
+						},
+						TargetHealth: &elbv2sdk.TargetHealth{
+							State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+						},
+					},
+				},
+				podNameByTargetUID: map[string]string{
+					"192.168.1.2:8080": "pod-2",
+					"192.168.1.3:8080": "pod-3",
+				},
+			},
+			want: []elbv2api.UnhealthyTarget{
+				{
+					ID:     "192.168.1.1",
+					Port:   8080,
+					Reason: elbv2sdk.TargetHealthReasonEnumTargetTimeout,
+				},
+				{
+					ID:          "192.168.1.2",
+					Port:        8080,
+					Pod:         awssdk.String("pod-2"),
+					Reason:      elbv2sdk.TargetHealthReasonEnumTargetResponseCodeMismatch,
+					Description: "Health checks failed with these codes: [500]",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildUnhealthyTargetsStatus(tt.args.registeredTargets, tt.args.podNameByTargetUID)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_containsTargetsInInitialState(t *testing.T) {
 	type args struct {
 		matchedEndpointAndTargets []podEndpointAndTargetPair
@@ -458,6 +885,141 @@ func Test_containsTargetsInInitialState(t *testing.T) {
 	}
 }
 
+func Test_matchPodEndpointWithTargets(t *testing.T) {
+	type args struct {
+		endpoints []backend.PodEndpoint
+		targets   []TargetInfo
+	}
+	tests := []struct {
+		name                          string
+		args                          args
+		wantMatchedEndpointAndTargets []podEndpointAndTargetPair
+		wantUnmatchedEndpoints        []backend.PodEndpoint
+		wantUnmatchedTargets          []TargetInfo
+	}{
+		{
+			name: "ipv4 endpoints and targets",
+			args: args{
+				endpoints: []backend.PodEndpoint{
+					{IP: "192.168.1.1", Port: 8080},
+					{IP: "192.168.1.2", Port: 8080},
+				},
+				targets: []TargetInfo{
+					{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int64(8080)}},
+					{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.3"), Port: awssdk.Int64(8080)}},
+				},
+			},
+			wantMatchedEndpointAndTargets: []podEndpointAndTargetPair{
+				{
+					endpoint: backend.PodEndpoint{IP: "192.168.1.1", Port: 8080},
+					target:   TargetInfo{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int64(8080)}},
+				},
+			},
+			wantUnmatchedEndpoints: []backend.PodEndpoint{
+				{IP: "192.168.1.2", Port: 8080},
+			},
+			wantUnmatchedTargets: []TargetInfo{
+				{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.3"), Port: awssdk.Int64(8080)}},
+			},
+		},
+		{
+			name: "ipv6 endpoints and targets in different forms",
+			args: args{
+				endpoints: []backend.PodEndpoint{
+					{IP: "2600:1f13:837:8504::1", Port: 8080},
+					{IP: "2600:1f13:837:8504::2", Port: 8080},
+				},
+				targets: []TargetInfo{
+					{Target: elbv2sdk.TargetDescription{Id: awssdk.String("2600:1f13:837:8504:0:0:0:1"), Port: awssdk.Int64(8080)}},
+				},
+			},
+			wantMatchedEndpointAndTargets: []podEndpointAndTargetPair{
+				{
+					endpoint: backend.PodEndpoint{IP: "2600:1f13:837:8504::1", Port: 8080},
+					target:   TargetInfo{Target: elbv2sdk.TargetDescription{Id: awssdk.String("2600:1f13:837:8504:0:0:0:1"), Port: awssdk.Int64(8080)}},
+				},
+			},
+			wantUnmatchedEndpoints: []backend.PodEndpoint{
+				{IP: "2600:1f13:837:8504::2", Port: 8080},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMatchedEndpointAndTargets, gotUnmatchedEndpoints, gotUnmatchedTargets := matchPodEndpointWithTargets(tt.args.endpoints, tt.args.targets)
+			assert.Equal(t, tt.wantMatchedEndpointAndTargets, gotMatchedEndpointAndTargets)
+			assert.Equal(t, tt.wantUnmatchedEndpoints, gotUnmatchedEndpoints)
+			assert.Equal(t, tt.wantUnmatchedTargets, gotUnmatchedTargets)
+		})
+	}
+}
+
+func Test_validatePodEndpointsIPAddressType(t *testing.T) {
+	ipAddressTypeIPv4 := elbv2api.TargetGroupIPAddressTypeIPv4
+	ipAddressTypeIPv6 := elbv2api.TargetGroupIPAddressTypeIPv6
+	type args struct {
+		ipAddressType *elbv2api.TargetGroupIPAddressType
+		endpoints     []backend.PodEndpoint
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ipAddressType unspecified",
+			args: args{
+				endpoints: []backend.PodEndpoint{{IP: "2600:1f13:837:8504::1", Port: 8080}},
+			},
+		},
+		{
+			name: "ipv4 endpoints with ipv4 ipAddressType",
+			args: args{
+				ipAddressType: &ipAddressTypeIPv4,
+				endpoints:     []backend.PodEndpoint{{IP: "192.168.1.1", Port: 8080}},
+			},
+		},
+		{
+			name: "ipv6 endpoints with ipv6 ipAddressType",
+			args: args{
+				ipAddressType: &ipAddressTypeIPv6,
+				endpoints:     []backend.PodEndpoint{{IP: "2600:1f13:837:8504::1", Port: 8080}},
+			},
+		},
+		{
+			name: "ipv4 endpoints with ipv6 ipAddressType",
+			args: args{
+				ipAddressType: &ipAddressTypeIPv6,
+				endpoints:     []backend.PodEndpoint{{IP: "192.168.1.1", Port: 8080}},
+			},
+			wantErr: errors.New("pod endpoint IP 192.168.1.1 mismatches ipAddressType ipv6 of TargetGroupBinding"),
+		},
+		{
+			name: "ipv6 endpoints with ipv4 ipAddressType",
+			args: args{
+				ipAddressType: &ipAddressTypeIPv4,
+				endpoints:     []backend.PodEndpoint{{IP: "2600:1f13:837:8504::1", Port: 8080}},
+			},
+			wantErr: errors.New("pod endpoint IP 2600:1f13:837:8504::1 mismatches ipAddressType ipv4 of TargetGroupBinding"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					IPAddressType: tt.args.ipAddressType,
+				},
+			}
+			err := validatePodEndpointsIPAddressType(tgb, tt.args.endpoints)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_buildPodConditionPatch(t *testing.T) {
 	type args struct {
 		pod       k8s.PodInfo
@@ -2,6 +2,8 @@ package targetgroupbinding
 
 import (
 	"fmt"
+	"net"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 )
@@ -24,6 +26,14 @@ func (t *TargetInfo) IsHealthy() bool {
 	return awssdk.StringValue(t.TargetHealth.State) == elbv2sdk.TargetHealthStateEnumHealthy
 }
 
+// IsUnhealthy returns whether target is unhealthy.
+func (t *TargetInfo) IsUnhealthy() bool {
+	if t.TargetHealth == nil {
+		return false
+	}
+	return awssdk.StringValue(t.TargetHealth.State) == elbv2sdk.TargetHealthStateEnumUnhealthy
+}
+
 // IsNotRegistered returns whether target is not registered.
 func (t *TargetInfo) IsNotRegistered() bool {
 	if t.TargetHealth == nil {
@@ -51,5 +61,14 @@ func (t *TargetInfo) IsInitial() bool {
 
 // UniqueIDForTargetDescription generates a unique ID to differentiate targets.
 func UniqueIDForTargetDescription(target elbv2sdk.TargetDescription) string {
-	return fmt.Sprintf("%v:%v", awssdk.StringValue(target.Id), awssdk.Int64Value(target.Port))
+	return fmt.Sprintf("%v:%v", canonicalTargetID(awssdk.StringValue(target.Id)), awssdk.Int64Value(target.Port))
+}
+
+// canonicalTargetID returns the canonical form of target's ID.
+// IP addresses are canonicalized since an IPv6 address can be written in multiple forms, other IDs are kept as is.
+func canonicalTargetID(targetID string) string {
+	if ip := net.ParseIP(targetID); ip != nil {
+		return ip.String()
+	}
+	return targetID
 }
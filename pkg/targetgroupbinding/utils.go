@@ -13,6 +13,8 @@ const (
 	TargetHealthPodConditionTypePrefix = "target-health.elbv2.k8s.aws"
 	// Legacy Prefix for TargetHealth pod condition type(used by AWS ALB Ingress Controller)
 	TargetHealthPodConditionTypePrefixLegacy = "target-health.alb.ingress.k8s.aws"
+	// Prefix for TargetDrain pod condition type.
+	TargetDrainPodConditionTypePrefix = "target-drain.elbv2.k8s.aws"
 
 	// Index Key for "ServiceReference" index.
 	IndexKeyServiceRefName = "spec.serviceRef.name"
@@ -23,6 +25,11 @@ func BuildTargetHealthPodConditionType(tgb *elbv2api.TargetGroupBinding) corev1.
 	return corev1.PodConditionType(fmt.Sprintf("%s/%s", TargetHealthPodConditionTypePrefix, tgb.Name))
 }
 
+// BuildTargetDrainPodConditionType constructs the condition type for TargetDrain pod condition.
+func BuildTargetDrainPodConditionType(tgb *elbv2api.TargetGroupBinding) corev1.PodConditionType {
+	return corev1.PodConditionType(fmt.Sprintf("%s/%s", TargetDrainPodConditionTypePrefix, tgb.Name))
+}
+
 // IndexFuncServiceRefName is IndexFunc for "ServiceReference" index.
 func IndexFuncServiceRefName(obj client.Object) []string {
 	tgb := obj.(*elbv2api.TargetGroupBinding)
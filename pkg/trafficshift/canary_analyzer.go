@@ -0,0 +1,287 @@
+package trafficshift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	cloudwatchsdk "github.com/aws/aws-sdk-go/service/cloudwatch"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	cloudWatchNamespaceALB         = "AWS/ApplicationELB"
+	cloudWatchMetricRequestCount   = "RequestCount"
+	cloudWatchMetricTarget5xxCount = "HTTPCode_Target_5XX_Count"
+	cloudWatchDimensionTargetGroup = "TargetGroup"
+	cloudWatchDimensionLB          = "LoadBalancer"
+	// the granularity of ALB metrics in CloudWatch.
+	cloudWatchMetricPeriod = 1 * time.Minute
+	// ingressResourceIDTagKey is the tag key used by the ingress controller to track the resource ID of TargetGroups.
+	ingressResourceIDTagKey = "ingress.k8s.aws/resource"
+	// the maximum number of resources per DescribeTags call.
+	describeTagsChunkSize = 20
+)
+
+// CanaryAnalyzer analyzes the health of canaryService of TrafficShift.
+type CanaryAnalyzer interface {
+	Analyze(ctx context.Context, ts *elbv2api.TrafficShift) (CanaryAnalysisResult, error)
+}
+
+// NewDefaultCanaryAnalyzer constructs new defaultCanaryAnalyzer.
+func NewDefaultCanaryAnalyzer(k8sClient client.Client, elbv2Client services.ELBV2, cloudWatchClient services.CloudWatch,
+	annotationParser annotations.Parser, logger logr.Logger) *defaultCanaryAnalyzer {
+	return &defaultCanaryAnalyzer{
+		k8sClient:        k8sClient,
+		elbv2Client:      elbv2Client,
+		cloudWatchClient: cloudWatchClient,
+		annotationParser: annotationParser,
+		logger:           logger,
+	}
+}
+
+var _ CanaryAnalyzer = &defaultCanaryAnalyzer{}
+
+// default implementation for CanaryAnalyzer.
+// The TargetGroups of canaryService are discovered via TargetGroupBindings referencing canaryService,
+// and only the ones provisioned for the Ingress action shifted by TrafficShift are analyzed.
+type defaultCanaryAnalyzer struct {
+	k8sClient        client.Client
+	elbv2Client      services.ELBV2
+	cloudWatchClient services.CloudWatch
+	annotationParser annotations.Parser
+	logger           logr.Logger
+}
+
+func (a *defaultCanaryAnalyzer) Analyze(ctx context.Context, ts *elbv2api.TrafficShift) (CanaryAnalysisResult, error) {
+	tgARNs, err := a.resolveCanaryTargetGroupARNs(ctx, ts)
+	if err != nil {
+		return CanaryAnalysisResult{}, err
+	}
+	result := CanaryAnalysisResult{}
+	if len(tgARNs) == 0 {
+		return result, nil
+	}
+	for _, tgARN := range tgARNs {
+		req := &elbv2sdk.DescribeTargetHealthInput{
+			TargetGroupArn: awssdk.String(tgARN),
+		}
+		resp, err := a.elbv2Client.DescribeTargetHealthWithContext(ctx, req)
+		if err != nil {
+			return CanaryAnalysisResult{}, err
+		}
+		for _, elem := range resp.TargetHealthDescriptions {
+			if elem.TargetHealth == nil {
+				continue
+			}
+			switch awssdk.StringValue(elem.TargetHealth.State) {
+			case elbv2sdk.TargetHealthStateEnumHealthy:
+				result.HealthyTargets++
+			case elbv2sdk.TargetHealthStateEnumUnhealthy:
+				result.UnhealthyTargets++
+			}
+		}
+	}
+
+	if ts.Spec.Analysis != nil && ts.Spec.Analysis.Max5xxPercent != nil {
+		requestCount, target5xxCount, err := a.fetchRequestMetrics(ctx, tgARNs, buildAnalysisInterval(ts.Spec.Analysis))
+		if err != nil {
+			return CanaryAnalysisResult{}, err
+		}
+		result.RequestCount = awssdk.Float64(requestCount)
+		result.Target5xxCount = awssdk.Float64(target5xxCount)
+	}
+	return result, nil
+}
+
+// resolveCanaryTargetGroupARNs returns the ARNs of canaryService's TargetGroups that the Ingress action forwards to.
+// TargetGroups bound to canaryService are matched against the resource IDs the ingress controller tagged them with.
+func (a *defaultCanaryAnalyzer) resolveCanaryTargetGroupARNs(ctx context.Context, ts *elbv2api.TrafficShift) ([]string, error) {
+	tgResIDs, err := a.resolveCanaryTargetGroupResourceIDs(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	if len(tgResIDs) == 0 {
+		return nil, nil
+	}
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := a.k8sClient.List(ctx, tgbList, client.InNamespace(ts.Namespace)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list targetGroupBindings for trafficShift: %v", k8s.NamespacedName(ts))
+	}
+	var candidateTGARNs []string
+	for _, tgb := range tgbList.Items {
+		// targetGroupARN is empty until the TargetGroup referenced by targetGroupName is provisioned.
+		if tgb.Spec.ServiceRef.Name == ts.Spec.CanaryService && tgb.Spec.TargetGroupARN != "" {
+			candidateTGARNs = append(candidateTGARNs, tgb.Spec.TargetGroupARN)
+		}
+	}
+	if len(candidateTGARNs) == 0 {
+		return nil, nil
+	}
+	tagsByARN, err := a.describeTargetGroupTags(ctx, candidateTGARNs)
+	if err != nil {
+		return nil, err
+	}
+	var tgARNs []string
+	for _, tgARN := range candidateTGARNs {
+		if tgResIDs.Has(tagsByARN[tgARN][ingressResourceIDTagKey]) {
+			tgARNs = append(tgARNs, tgARN)
+		}
+	}
+	return tgARNs, nil
+}
+
+// resolveCanaryTargetGroupResourceIDs returns the resource IDs of canaryService's TargetGroups in the Ingress action.
+func (a *defaultCanaryAnalyzer) resolveCanaryTargetGroupResourceIDs(ctx context.Context, ts *elbv2api.TrafficShift) (sets.String, error) {
+	ing := &networking.Ingress{}
+	ingKey := types.NamespacedName{Namespace: ts.Namespace, Name: ts.Spec.IngressName}
+	if err := a.k8sClient.Get(ctx, ingKey, ing); err != nil {
+		return nil, errors.Wrapf(err, "failed to load ingress for trafficShift: %v", k8s.NamespacedName(ts))
+	}
+	action := ingress.Action{}
+	annotationKey := fmt.Sprintf("actions.%v", ts.Spec.ActionName)
+	exists, err := a.annotationParser.ParseJSONAnnotation(annotationKey, &action, ing.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("missing %v configuration on ingress: %v", annotationKey, ingKey)
+	}
+	tgResIDs := sets.NewString()
+	if action.ForwardConfig == nil {
+		return tgResIDs, nil
+	}
+	svcKey := types.NamespacedName{Namespace: ts.Namespace, Name: ts.Spec.CanaryService}
+	for _, tgt := range action.ForwardConfig.TargetGroups {
+		if awssdk.StringValue(tgt.ServiceName) != ts.Spec.CanaryService || tgt.ServicePort == nil {
+			continue
+		}
+		tgResIDs.Insert(ingress.BuildTargetGroupResourceID(ingKey, svcKey, *tgt.ServicePort))
+	}
+	return tgResIDs, nil
+}
+
+// describeTargetGroupTags describes tags for TargetGroups.
+// returns tags indexed by TargetGroup ARN.
+func (a *defaultCanaryAnalyzer) describeTargetGroupTags(ctx context.Context, tgARNs []string) (map[string]map[string]string, error) {
+	tagsByARN := make(map[string]map[string]string, len(tgARNs))
+	for _, tgARNsChunk := range algorithm.ChunkStrings(tgARNs, describeTagsChunkSize) {
+		req := &elbv2sdk.DescribeTagsInput{
+			ResourceArns: awssdk.StringSlice(tgARNsChunk),
+		}
+		resp, err := a.elbv2Client.DescribeTagsWithContext(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, tagDescription := range resp.TagDescriptions {
+			tags := make(map[string]string, len(tagDescription.Tags))
+			for _, tag := range tagDescription.Tags {
+				tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+			}
+			tagsByARN[awssdk.StringValue(tagDescription.ResourceArn)] = tags
+		}
+	}
+	return tagsByARN, nil
+}
+
+// fetchRequestMetrics returns the total number of requests and the number of 5xx responses from targets for TargetGroups during the interval.
+func (a *defaultCanaryAnalyzer) fetchRequestMetrics(ctx context.Context, tgARNs []string, interval time.Duration) (float64, float64, error) {
+	req := &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: awssdk.StringSlice(tgARNs),
+	}
+	resp, err := a.elbv2Client.DescribeTargetGroupsWithContext(ctx, req)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	period := interval.Truncate(cloudWatchMetricPeriod)
+	if period < cloudWatchMetricPeriod {
+		period = cloudWatchMetricPeriod
+	}
+	var queries []*cloudwatchsdk.MetricDataQuery
+	for _, tg := range resp.TargetGroups {
+		// TargetGroup dimension can only be used together with LoadBalancer dimension.
+		for _, lbARN := range tg.LoadBalancerArns {
+			dimensions := []*cloudwatchsdk.Dimension{
+				{
+					Name:  awssdk.String(cloudWatchDimensionTargetGroup),
+					Value: awssdk.String(buildCloudWatchDimensionValue(awssdk.StringValue(tg.TargetGroupArn), "")),
+				},
+				{
+					Name:  awssdk.String(cloudWatchDimensionLB),
+					Value: awssdk.String(buildCloudWatchDimensionValue(awssdk.StringValue(lbARN), "loadbalancer/")),
+				},
+			}
+			queryIndex := len(queries) / 2
+			queries = append(queries,
+				buildMetricDataQuery(fmt.Sprintf("requests_%d", queryIndex), cloudWatchMetricRequestCount, dimensions, period),
+				buildMetricDataQuery(fmt.Sprintf("target5xx_%d", queryIndex), cloudWatchMetricTarget5xxCount, dimensions, period),
+			)
+		}
+	}
+	if len(queries) == 0 {
+		return 0, 0, nil
+	}
+
+	now := time.Now()
+	metricReq := &cloudwatchsdk.GetMetricDataInput{
+		StartTime:         awssdk.Time(now.Add(-period)),
+		EndTime:           awssdk.Time(now),
+		MetricDataQueries: queries,
+	}
+	var requestCount, target5xxCount float64
+	if err := a.cloudWatchClient.GetMetricDataPagesWithContext(ctx, metricReq, func(output *cloudwatchsdk.GetMetricDataOutput, _ bool) bool {
+		for _, metricResult := range output.MetricDataResults {
+			sum := 0.0
+			for _, value := range metricResult.Values {
+				sum += awssdk.Float64Value(value)
+			}
+			if strings.HasPrefix(awssdk.StringValue(metricResult.Id), "requests_") {
+				requestCount += sum
+			} else {
+				target5xxCount += sum
+			}
+		}
+		return true
+	}); err != nil {
+		return 0, 0, err
+	}
+	return requestCount, target5xxCount, nil
+}
+
+func buildMetricDataQuery(id string, metricName string, dimensions []*cloudwatchsdk.Dimension, period time.Duration) *cloudwatchsdk.MetricDataQuery {
+	return &cloudwatchsdk.MetricDataQuery{
+		Id: awssdk.String(id),
+		MetricStat: &cloudwatchsdk.MetricStat{
+			Metric: &cloudwatchsdk.Metric{
+				Namespace:  awssdk.String(cloudWatchNamespaceALB),
+				MetricName: awssdk.String(metricName),
+				Dimensions: dimensions,
+			},
+			Period: awssdk.Int64(int64(period.Seconds())),
+			Stat:   awssdk.String(cloudwatchsdk.StatisticSum),
+		},
+		ReturnData: awssdk.Bool(true),
+	}
+}
+
+// buildCloudWatchDimensionValue converts the ARN into CloudWatch dimension value, which is the resource part of ARN without resourceTypePrefix.
+// e.g. arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067 into targetgroup/my-tg/73e2d6bc24d8a067
+func buildCloudWatchDimensionValue(arn string, resourceTypePrefix string) string {
+	resource := arn[strings.LastIndex(arn, ":")+1:]
+	return strings.TrimPrefix(resource, resourceTypePrefix)
+}
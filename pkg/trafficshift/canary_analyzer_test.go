@@ -0,0 +1,311 @@
+package trafficshift
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	cloudwatchsdk "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeCloudWatch returns pre-defined metric data results, and records the queries it received.
+type fakeCloudWatch struct {
+	cloudwatchiface.CloudWatchAPI
+	results  []*cloudwatchsdk.MetricDataResult
+	gotInput *cloudwatchsdk.GetMetricDataInput
+}
+
+func (c *fakeCloudWatch) GetMetricDataPagesWithContext(_ context.Context, input *cloudwatchsdk.GetMetricDataInput,
+	fn func(*cloudwatchsdk.GetMetricDataOutput, bool) bool, _ ...request.Option) error {
+	c.gotInput = input
+	fn(&cloudwatchsdk.GetMetricDataOutput{MetricDataResults: c.results}, true)
+	return nil
+}
+
+var _ services.CloudWatch = &fakeCloudWatch{}
+
+func Test_defaultCanaryAnalyzer_Analyze(t *testing.T) {
+	type describeTargetHealthWithContextCall struct {
+		req  *elbv2sdk.DescribeTargetHealthInput
+		resp *elbv2sdk.DescribeTargetHealthOutput
+	}
+	type describeTargetGroupsWithContextCall struct {
+		req  *elbv2sdk.DescribeTargetGroupsInput
+		resp *elbv2sdk.DescribeTargetGroupsOutput
+	}
+	type describeTagsWithContextCall struct {
+		req  *elbv2sdk.DescribeTagsInput
+		resp *elbv2sdk.DescribeTagsOutput
+	}
+	type fields struct {
+		describeTagsWithContextCalls         []describeTagsWithContextCall
+		describeTargetHealthWithContextCalls []describeTargetHealthWithContextCall
+		describeTargetGroupsWithContextCalls []describeTargetGroupsWithContextCall
+		metricDataResults                    []*cloudwatchsdk.MetricDataResult
+	}
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ing",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/actions.shift": `{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"svc-stable","servicePort":"80","weight":90},{"serviceName":"svc-canary","servicePort":"80","weight":10}]}}`,
+			},
+		},
+	}
+	tgbs := []*elbv2api.TargetGroupBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tgb-canary"},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary/73e2d6bc24d8a067",
+				ServiceRef:     elbv2api.ServiceReference{Name: "svc-canary"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tgb-canary-other-ing"},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary-other/2f0e8a1c5b7d3e9f",
+				ServiceRef:     elbv2api.ServiceReference{Name: "svc-canary"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tgb-stable"},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-stable/0b1e0a4f3d2c1b0a",
+				ServiceRef:     elbv2api.ServiceReference{Name: "svc-stable"},
+			},
+		},
+	}
+	describeCanaryTargetHealthCall := describeTargetHealthWithContextCall{
+		req: &elbv2sdk.DescribeTargetHealthInput{
+			TargetGroupArn: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary/73e2d6bc24d8a067"),
+		},
+		resp: &elbv2sdk.DescribeTargetHealthOutput{
+			TargetHealthDescriptions: []*elbv2sdk.TargetHealthDescription{
+				{TargetHealth: &elbv2sdk.TargetHealth{State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy)}},
+				{TargetHealth: &elbv2sdk.TargetHealth{State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy)}},
+				{TargetHealth: &elbv2sdk.TargetHealth{State: awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy)}},
+				{TargetHealth: &elbv2sdk.TargetHealth{State: awssdk.String(elbv2sdk.TargetHealthStateEnumInitial)}},
+			},
+		},
+	}
+	describeCanaryTagsCall := describeTagsWithContextCall{
+		req: &elbv2sdk.DescribeTagsInput{
+			ResourceArns: awssdk.StringSlice([]string{
+				"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary/73e2d6bc24d8a067",
+				"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary-other/2f0e8a1c5b7d3e9f",
+			}),
+		},
+		resp: &elbv2sdk.DescribeTagsOutput{
+			TagDescriptions: []*elbv2sdk.TagDescription{
+				{
+					ResourceArn: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary/73e2d6bc24d8a067"),
+					Tags: []*elbv2sdk.Tag{
+						{Key: awssdk.String("ingress.k8s.aws/resource"), Value: awssdk.String("default/ing-svc-canary:80")},
+					},
+				},
+				{
+					ResourceArn: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary-other/2f0e8a1c5b7d3e9f"),
+					Tags: []*elbv2sdk.Tag{
+						{Key: awssdk.String("ingress.k8s.aws/resource"), Value: awssdk.String("default/other-ing-svc-canary:80")},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		ts      *elbv2api.TrafficShift
+		want    CanaryAnalysisResult
+		wantErr error
+	}{
+		{
+			name: "no targetGroups for canaryService",
+			ts: &elbv2api.TrafficShift{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shift"},
+				Spec: elbv2api.TrafficShiftSpec{
+					IngressName:   "ing",
+					ActionName:    "shift",
+					CanaryService: "svc-other",
+				},
+			},
+			want: CanaryAnalysisResult{},
+		},
+		{
+			name: "missing action on ingress",
+			ts: &elbv2api.TrafficShift{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shift"},
+				Spec: elbv2api.TrafficShiftSpec{
+					IngressName:   "ing",
+					ActionName:    "other",
+					CanaryService: "svc-canary",
+				},
+			},
+			wantErr: errors.New("missing actions.other configuration on ingress: default/ing"),
+		},
+		{
+			name: "analyze target health only",
+			fields: fields{
+				describeTagsWithContextCalls:         []describeTagsWithContextCall{describeCanaryTagsCall},
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{describeCanaryTargetHealthCall},
+			},
+			ts: &elbv2api.TrafficShift{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shift"},
+				Spec: elbv2api.TrafficShiftSpec{
+					IngressName:   "ing",
+					ActionName:    "shift",
+					CanaryService: "svc-canary",
+					Analysis: &elbv2api.TrafficShiftAnalysis{
+						MaxUnhealthyTargets: awssdk.Int32(0),
+					},
+				},
+			},
+			want: CanaryAnalysisResult{
+				HealthyTargets:   2,
+				UnhealthyTargets: 1,
+			},
+		},
+		{
+			name: "analyze target health and request metrics",
+			fields: fields{
+				describeTagsWithContextCalls:         []describeTagsWithContextCall{describeCanaryTagsCall},
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{describeCanaryTargetHealthCall},
+				describeTargetGroupsWithContextCalls: []describeTargetGroupsWithContextCall{
+					{
+						req: &elbv2sdk.DescribeTargetGroupsInput{
+							TargetGroupArns: awssdk.StringSlice([]string{"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary/73e2d6bc24d8a067"}),
+						},
+						resp: &elbv2sdk.DescribeTargetGroupsOutput{
+							TargetGroups: []*elbv2sdk.TargetGroup{
+								{
+									TargetGroupArn:   awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-canary/73e2d6bc24d8a067"),
+									LoadBalancerArns: awssdk.StringSlice([]string{"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"}),
+								},
+							},
+						},
+					},
+				},
+				metricDataResults: []*cloudwatchsdk.MetricDataResult{
+					{
+						Id:     awssdk.String("requests_0"),
+						Values: awssdk.Float64Slice([]float64{100, 50}),
+					},
+					{
+						Id:     awssdk.String("target5xx_0"),
+						Values: awssdk.Float64Slice([]float64{3}),
+					},
+				},
+			},
+			ts: &elbv2api.TrafficShift{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shift"},
+				Spec: elbv2api.TrafficShiftSpec{
+					IngressName:   "ing",
+					ActionName:    "shift",
+					CanaryService: "svc-canary",
+					Analysis: &elbv2api.TrafficShiftAnalysis{
+						Max5xxPercent: awssdk.Int32(5),
+					},
+				},
+			},
+			want: CanaryAnalysisResult{
+				HealthyTargets:   2,
+				UnhealthyTargets: 1,
+				RequestCount:     awssdk.Float64(150),
+				Target5xxCount:   awssdk.Float64(3),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.describeTagsWithContextCalls {
+				elbv2Client.EXPECT().DescribeTagsWithContext(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+			for _, call := range tt.fields.describeTargetHealthWithContextCalls {
+				elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+			for _, call := range tt.fields.describeTargetGroupsWithContextCalls {
+				elbv2Client.EXPECT().DescribeTargetGroupsWithContext(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+			cloudWatchClient := &fakeCloudWatch{results: tt.fields.metricDataResults}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			ctx := context.Background()
+			assert.NoError(t, k8sClient.Create(ctx, ing.DeepCopy()))
+			for _, tgb := range tgbs {
+				assert.NoError(t, k8sClient.Create(ctx, tgb.DeepCopy()))
+			}
+
+			annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+			a := NewDefaultCanaryAnalyzer(k8sClient, elbv2Client, cloudWatchClient, annotationParser, &log.NullLogger{})
+			got, err := a.Analyze(ctx, tt.ts)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			if len(tt.fields.metricDataResults) != 0 {
+				assert.Len(t, cloudWatchClient.gotInput.MetricDataQueries, 2)
+				assert.Equal(t, []*cloudwatchsdk.Dimension{
+					{
+						Name:  awssdk.String("TargetGroup"),
+						Value: awssdk.String("targetgroup/tg-canary/73e2d6bc24d8a067"),
+					},
+					{
+						Name:  awssdk.String("LoadBalancer"),
+						Value: awssdk.String("app/my-lb/50dc6c495c0c9188"),
+					},
+				}, cloudWatchClient.gotInput.MetricDataQueries[0].MetricStat.Metric.Dimensions)
+			}
+		})
+	}
+}
+
+func Test_buildCloudWatchDimensionValue(t *testing.T) {
+	tests := []struct {
+		name               string
+		arn                string
+		resourceTypePrefix string
+		want               string
+	}{
+		{
+			name: "targetGroup",
+			arn:  "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067",
+			want: "targetgroup/my-tg/73e2d6bc24d8a067",
+		},
+		{
+			name:               "loadBalancer",
+			arn:                "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			resourceTypePrefix: "loadbalancer/",
+			want:               "app/my-lb/50dc6c495c0c9188",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildCloudWatchDimensionValue(tt.arn, tt.resourceTypePrefix)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
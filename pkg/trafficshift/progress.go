@@ -0,0 +1,117 @@
+package trafficshift
+
+import (
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+const (
+	// the default interval between canary analysis.
+	defaultAnalysisInterval = 1 * time.Minute
+)
+
+// CanaryAnalysisResult is the result of canary analysis.
+type CanaryAnalysisResult struct {
+	// the number of healthy targets in TargetGroups of canaryService.
+	HealthyTargets int32
+	// the number of unhealthy targets in TargetGroups of canaryService.
+	UnhealthyTargets int32
+	// the number of requests to canaryService during analysis interval, nil if it's not analyzed.
+	RequestCount *float64
+	// the number of requests that targets responded with 5xx code during analysis interval, nil if it's not analyzed.
+	Target5xxCount *float64
+}
+
+// Start starts traffic shifting from the first step.
+func Start(ts *elbv2api.TrafficShift, now time.Time) {
+	ts.Status = elbv2api.TrafficShiftStatus{
+		ObservedGeneration: awssdk.Int64(ts.Generation),
+		Phase:              elbv2api.TrafficShiftPhaseProgressing,
+		CurrentStep:        0,
+		CanaryWeight:       awssdk.Int32(ts.Spec.Steps[0].Weight),
+		StepStartTime:      &metav1.Time{Time: now},
+	}
+}
+
+// Progress progresses traffic shifting according to the canary analysis result.
+// Traffic is shifted back to stableService once canary breached any threshold,
+// otherwise it proceeds to next step once current step is paused long enough and canary has healthy targets.
+// It returns the duration until next progress is due, or zero if traffic shifting has completed.
+func Progress(ts *elbv2api.TrafficShift, result CanaryAnalysisResult, now time.Time) time.Duration {
+	if ts.Status.Phase != elbv2api.TrafficShiftPhaseProgressing {
+		return 0
+	}
+	if reason, breached := checkAnalysisThresholds(ts.Spec.Analysis, result); breached {
+		ts.Status.Phase = elbv2api.TrafficShiftPhaseRolledBack
+		ts.Status.CanaryWeight = awssdk.Int32(0)
+		ts.Status.StepStartTime = &metav1.Time{Time: now}
+		ts.Status.Message = reason
+		return 0
+	}
+
+	analysisInterval := buildAnalysisInterval(ts.Spec.Analysis)
+	currentStep := ts.Spec.Steps[ts.Status.CurrentStep]
+	if currentStep.Pause != nil {
+		pausedDuration := now.Sub(ts.Status.StepStartTime.Time)
+		if pausedDuration < currentStep.Pause.Duration {
+			return minDuration(currentStep.Pause.Duration-pausedDuration, analysisInterval)
+		}
+	}
+	if result.HealthyTargets == 0 {
+		ts.Status.Message = "waiting for healthy targets of canaryService"
+		return analysisInterval
+	}
+
+	nextStepIndex := ts.Status.CurrentStep + 1
+	if int(nextStepIndex) >= len(ts.Spec.Steps) {
+		ts.Status.Phase = elbv2api.TrafficShiftPhaseSucceeded
+		ts.Status.Message = "all steps completed"
+		return 0
+	}
+	nextStep := ts.Spec.Steps[nextStepIndex]
+	ts.Status.CurrentStep = nextStepIndex
+	ts.Status.CanaryWeight = awssdk.Int32(nextStep.Weight)
+	ts.Status.StepStartTime = &metav1.Time{Time: now}
+	ts.Status.Message = ""
+	if nextStep.Pause != nil && nextStep.Pause.Duration > 0 {
+		return minDuration(nextStep.Pause.Duration, analysisInterval)
+	}
+	return analysisInterval
+}
+
+// checkAnalysisThresholds checks whether canary breached any threshold, and returns the reason if so.
+func checkAnalysisThresholds(analysis *elbv2api.TrafficShiftAnalysis, result CanaryAnalysisResult) (string, bool) {
+	if analysis == nil {
+		return "", false
+	}
+	if analysis.MaxUnhealthyTargets != nil && result.UnhealthyTargets > *analysis.MaxUnhealthyTargets {
+		return fmt.Sprintf("canaryService has %d unhealthy targets, exceeds maxUnhealthyTargets %d",
+			result.UnhealthyTargets, *analysis.MaxUnhealthyTargets), true
+	}
+	if analysis.Max5xxPercent != nil && result.RequestCount != nil && result.Target5xxCount != nil && *result.RequestCount > 0 {
+		target5xxPercent := *result.Target5xxCount / *result.RequestCount * 100
+		if target5xxPercent > float64(*analysis.Max5xxPercent) {
+			return fmt.Sprintf("canaryService responded 5xx to %.1f%% of requests, exceeds max5xxPercent %d",
+				target5xxPercent, *analysis.Max5xxPercent), true
+		}
+	}
+	return "", false
+}
+
+func buildAnalysisInterval(analysis *elbv2api.TrafficShiftAnalysis) time.Duration {
+	if analysis == nil || analysis.Interval == nil || analysis.Interval.Duration <= 0 {
+		return defaultAnalysisInterval
+	}
+	return analysis.Interval.Duration
+}
+
+func minDuration(a time.Duration, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,247 @@
+package trafficshift
+
+import (
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func TestStart(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	ts := &elbv2api.TrafficShift{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+		},
+		Spec: elbv2api.TrafficShiftSpec{
+			Steps: []elbv2api.TrafficShiftStep{{Weight: 10}, {Weight: 50}},
+		},
+		Status: elbv2api.TrafficShiftStatus{
+			Phase:        elbv2api.TrafficShiftPhaseRolledBack,
+			CurrentStep:  1,
+			CanaryWeight: awssdk.Int32(0),
+			Message:      "rolled back",
+		},
+	}
+	Start(ts, now)
+	assert.Equal(t, elbv2api.TrafficShiftStatus{
+		ObservedGeneration: awssdk.Int64(2),
+		Phase:              elbv2api.TrafficShiftPhaseProgressing,
+		CurrentStep:        0,
+		CanaryWeight:       awssdk.Int32(10),
+		StepStartTime:      &metav1.Time{Time: now},
+	}, ts.Status)
+}
+
+func TestProgress(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	steps := []elbv2api.TrafficShiftStep{
+		{Weight: 10, Pause: &metav1.Duration{Duration: 10 * time.Minute}},
+		{Weight: 50, Pause: &metav1.Duration{Duration: 30 * time.Second}},
+		{Weight: 100},
+	}
+	analysis := &elbv2api.TrafficShiftAnalysis{
+		MaxUnhealthyTargets: awssdk.Int32(1),
+		Max5xxPercent:       awssdk.Int32(5),
+		Interval:            &metav1.Duration{Duration: 2 * time.Minute},
+	}
+	type args struct {
+		analysis *elbv2api.TrafficShiftAnalysis
+		status   elbv2api.TrafficShiftStatus
+		result   CanaryAnalysisResult
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus elbv2api.TrafficShiftStatus
+		want       time.Duration
+	}{
+		{
+			name: "not progressing",
+			args: args{
+				analysis: analysis,
+				status: elbv2api.TrafficShiftStatus{
+					Phase:        elbv2api.TrafficShiftPhaseSucceeded,
+					CurrentStep:  2,
+					CanaryWeight: awssdk.Int32(100),
+				},
+				result: CanaryAnalysisResult{UnhealthyTargets: 5},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:        elbv2api.TrafficShiftPhaseSucceeded,
+				CurrentStep:  2,
+				CanaryWeight: awssdk.Int32(100),
+			},
+			want: 0,
+		},
+		{
+			name: "rolled back due to unhealthy targets",
+			args: args{
+				analysis: analysis,
+				status: elbv2api.TrafficShiftStatus{
+					Phase:         elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:   1,
+					CanaryWeight:  awssdk.Int32(50),
+					StepStartTime: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+				},
+				result: CanaryAnalysisResult{HealthyTargets: 3, UnhealthyTargets: 2},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:         elbv2api.TrafficShiftPhaseRolledBack,
+				CurrentStep:   1,
+				CanaryWeight:  awssdk.Int32(0),
+				StepStartTime: &metav1.Time{Time: now},
+				Message:       "canaryService has 2 unhealthy targets, exceeds maxUnhealthyTargets 1",
+			},
+			want: 0,
+		},
+		{
+			name: "rolled back due to 5xx responses",
+			args: args{
+				analysis: analysis,
+				status: elbv2api.TrafficShiftStatus{
+					Phase:         elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:   0,
+					CanaryWeight:  awssdk.Int32(10),
+					StepStartTime: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+				},
+				result: CanaryAnalysisResult{
+					HealthyTargets: 3,
+					RequestCount:   awssdk.Float64(200),
+					Target5xxCount: awssdk.Float64(20),
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:         elbv2api.TrafficShiftPhaseRolledBack,
+				CurrentStep:   0,
+				CanaryWeight:  awssdk.Int32(0),
+				StepStartTime: &metav1.Time{Time: now},
+				Message:       "canaryService responded 5xx to 10.0% of requests, exceeds max5xxPercent 5",
+			},
+			want: 0,
+		},
+		{
+			name: "paused at current step",
+			args: args{
+				analysis: analysis,
+				status: elbv2api.TrafficShiftStatus{
+					Phase:         elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:   0,
+					CanaryWeight:  awssdk.Int32(10),
+					StepStartTime: &metav1.Time{Time: now.Add(-9 * time.Minute)},
+				},
+				result: CanaryAnalysisResult{
+					HealthyTargets: 3,
+					RequestCount:   awssdk.Float64(200),
+					Target5xxCount: awssdk.Float64(2),
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:         elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:   0,
+				CanaryWeight:  awssdk.Int32(10),
+				StepStartTime: &metav1.Time{Time: now.Add(-9 * time.Minute)},
+			},
+			want: 1 * time.Minute,
+		},
+		{
+			name: "waiting for healthy targets",
+			args: args{
+				analysis: analysis,
+				status: elbv2api.TrafficShiftStatus{
+					Phase:         elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:   0,
+					CanaryWeight:  awssdk.Int32(10),
+					StepStartTime: &metav1.Time{Time: now.Add(-11 * time.Minute)},
+				},
+				result: CanaryAnalysisResult{},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:         elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:   0,
+				CanaryWeight:  awssdk.Int32(10),
+				StepStartTime: &metav1.Time{Time: now.Add(-11 * time.Minute)},
+				Message:       "waiting for healthy targets of canaryService",
+			},
+			want: 2 * time.Minute,
+		},
+		{
+			name: "proceeds to next step",
+			args: args{
+				analysis: analysis,
+				status: elbv2api.TrafficShiftStatus{
+					Phase:         elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:   0,
+					CanaryWeight:  awssdk.Int32(10),
+					StepStartTime: &metav1.Time{Time: now.Add(-11 * time.Minute)},
+					Message:       "waiting for healthy targets of canaryService",
+				},
+				result: CanaryAnalysisResult{HealthyTargets: 3},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:         elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:   1,
+				CanaryWeight:  awssdk.Int32(50),
+				StepStartTime: &metav1.Time{Time: now},
+			},
+			want: 30 * time.Second,
+		},
+		{
+			name: "proceeds to last step without pause, using default interval",
+			args: args{
+				status: elbv2api.TrafficShiftStatus{
+					Phase:         elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:   1,
+					CanaryWeight:  awssdk.Int32(50),
+					StepStartTime: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+				},
+				result: CanaryAnalysisResult{HealthyTargets: 3},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:         elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:   2,
+				CanaryWeight:  awssdk.Int32(100),
+				StepStartTime: &metav1.Time{Time: now},
+			},
+			want: 1 * time.Minute,
+		},
+		{
+			name: "all steps completed",
+			args: args{
+				analysis: analysis,
+				status: elbv2api.TrafficShiftStatus{
+					Phase:         elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:   2,
+					CanaryWeight:  awssdk.Int32(100),
+					StepStartTime: &metav1.Time{Time: now.Add(-2 * time.Minute)},
+				},
+				result: CanaryAnalysisResult{HealthyTargets: 3},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				Phase:         elbv2api.TrafficShiftPhaseSucceeded,
+				CurrentStep:   2,
+				CanaryWeight:  awssdk.Int32(100),
+				StepStartTime: &metav1.Time{Time: now.Add(-2 * time.Minute)},
+				Message:       "all steps completed",
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &elbv2api.TrafficShift{
+				Spec: elbv2api.TrafficShiftSpec{
+					Steps:    steps,
+					Analysis: tt.args.analysis,
+				},
+				Status: tt.args.status,
+			}
+			got := Progress(ts, tt.args.result, now)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantStatus, ts.Status)
+		})
+	}
+}
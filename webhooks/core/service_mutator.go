@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	apiPathMutateService = "/mutate-v1-service"
+)
+
+// NewServiceMutator returns a mutator for Service.
+func NewServiceMutator(loadBalancerClass string, annotationParser annotations.Parser, logger logr.Logger) *serviceMutator {
+	return &serviceMutator{
+		loadBalancerClass: loadBalancerClass,
+		annotationParser:  annotationParser,
+		logger:            logger,
+	}
+}
+
+var _ webhook.Mutator = &serviceMutator{}
+
+type serviceMutator struct {
+	loadBalancerClass string
+	annotationParser  annotations.Parser
+	logger            logr.Logger
+}
+
+func (m *serviceMutator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &corev1.Service{}, nil
+}
+
+// MutateCreate defaults spec.loadBalancerClass of new LoadBalancer Services.
+// Services that already select a load balancer implementation via spec.loadBalancerClass or
+// the aws-load-balancer-type annotation are left unchanged.
+func (m *serviceMutator) MutateCreate(_ context.Context, obj runtime.Object) (runtime.Object, error) {
+	svc := obj.(*corev1.Service)
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return svc, nil
+	}
+	if svc.Spec.LoadBalancerClass != nil && *svc.Spec.LoadBalancerClass != "" {
+		return svc, nil
+	}
+	var lbType string
+	if m.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, svc.Annotations) {
+		return svc, nil
+	}
+	lbClass := m.loadBalancerClass
+	svc.Spec.LoadBalancerClass = &lbClass
+	m.logger.V(1).Info("defaulted loadBalancerClass", "service", k8s.NamespacedName(svc), "loadBalancerClass", lbClass)
+	return svc, nil
+}
+
+// MutateUpdate leaves Services unchanged, since spec.loadBalancerClass cannot be changed once set.
+func (m *serviceMutator) MutateUpdate(_ context.Context, obj runtime.Object, _ runtime.Object) (runtime.Object, error) {
+	return obj, nil
+}
+
+// +kubebuilder:webhook:path=/mutate-v1-service,mutating=true,failurePolicy=ignore,groups="",resources=services,verbs=create,versions=v1,name=mservice.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (m *serviceMutator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathMutateService, webhook.MutatingWebhookForMutator(m))
+}
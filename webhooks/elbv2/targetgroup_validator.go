@@ -0,0 +1,110 @@
+package elbv2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const apiPathValidateELBv2TargetGroup = "/validate-elbv2-k8s-aws-v1beta1-targetgroup"
+
+// NewTargetGroupValidator returns a validator for TargetGroup CRD.
+func NewTargetGroupValidator(k8sClient client.Client, logger logr.Logger) *targetGroupValidator {
+	return &targetGroupValidator{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ webhook.Validator = &targetGroupValidator{}
+
+type targetGroupValidator struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (v *targetGroupValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &elbv2api.TargetGroup{}, nil
+}
+
+func (v *targetGroupValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *targetGroupValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	tg := obj.(*elbv2api.TargetGroup)
+	oldTG := oldObj.(*elbv2api.TargetGroup)
+	if err := v.checkImmutableFields(tg, oldTG); err != nil {
+		return err
+	}
+	if err := v.checkReferredImmutableFields(ctx, tg, oldTG); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v *targetGroupValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// checkImmutableFields will check immutable fields are not changed.
+// port of TargetGroup cannot be modified in AWS, and isn't part of the replacement of TargetGroup either.
+func (v *targetGroupValidator) checkImmutableFields(tg *elbv2api.TargetGroup, oldTG *elbv2api.TargetGroup) error {
+	var changedImmutableFields []string
+	if tg.Spec.Port != oldTG.Spec.Port {
+		changedImmutableFields = append(changedImmutableFields, "spec.port")
+	}
+	if len(changedImmutableFields) != 0 {
+		return errors.Errorf("%s update may not change these fields: %s", "TargetGroup", strings.Join(changedImmutableFields, ","))
+	}
+	return nil
+}
+
+// checkReferredImmutableFields will check fields that TargetGroupBindings depend on are not changed while they refer to the TargetGroup.
+// targetType and ipAddressType of TargetGroupBinding are immutable, which must match the replaced TargetGroup.
+func (v *targetGroupValidator) checkReferredImmutableFields(ctx context.Context, tg *elbv2api.TargetGroup, oldTG *elbv2api.TargetGroup) error {
+	var changedImmutableFields []string
+	if tg.Spec.TargetType != oldTG.Spec.TargetType {
+		changedImmutableFields = append(changedImmutableFields, "spec.targetType")
+	}
+	if targetGroupIPAddressType(tg) != targetGroupIPAddressType(oldTG) {
+		changedImmutableFields = append(changedImmutableFields, "spec.ipAddressType")
+	}
+	if len(changedImmutableFields) == 0 {
+		return nil
+	}
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := v.k8sClient.List(ctx, tgbList, client.InNamespace(tg.Namespace)); err != nil {
+		return errors.Wrap(err, "failed to list TargetGroupBindings")
+	}
+	for _, tgb := range tgbList.Items {
+		if tgb.Spec.TargetGroupName == tg.Name {
+			return errors.Errorf("%s update may not change these fields while TargetGroupBinding %v refers to it: %s",
+				"TargetGroup", k8s.NamespacedName(&tgb), strings.Join(changedImmutableFields, ","))
+		}
+	}
+	return nil
+}
+
+// targetGroupIPAddressType returns the ipAddressType of TargetGroup, which defaults to ipv4.
+func targetGroupIPAddressType(tg *elbv2api.TargetGroup) elbv2api.TargetGroupIPAddressType {
+	if tg.Spec.IPAddressType == nil {
+		return elbv2api.TargetGroupIPAddressTypeIPv4
+	}
+	return *tg.Spec.IPAddressType
+}
+
+// +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-targetgroup,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=targetgroups,verbs=update,versions=v1beta1,name=vtargetgroup.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *targetGroupValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateELBv2TargetGroup, webhook.ValidatingWebhookForValidator(v))
+}
@@ -0,0 +1,92 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_targetGroupValidator_ValidateUpdate(t *testing.T) {
+	ipv6AddressType := elbv2api.TargetGroupIPAddressTypeIPv6
+	oldTG := &elbv2api.TargetGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+		Spec: elbv2api.TargetGroupSpec{
+			TargetType: elbv2api.TargetTypeInstance,
+			Port:       8080,
+			Protocol:   elbv2api.TargetGroupProtocolHTTP,
+		},
+	}
+	referringTGB := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+		Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupName: "awesome-tg"},
+	}
+	otherTGB := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "other-tgb"},
+		Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupName: "other-tg"},
+	}
+	tests := []struct {
+		name    string
+		tgbs    []*elbv2api.TargetGroupBinding
+		mutate  func(tg *elbv2api.TargetGroup)
+		wantErr error
+	}{
+		{
+			name: "protocol is changed",
+			tgbs: []*elbv2api.TargetGroupBinding{referringTGB},
+			mutate: func(tg *elbv2api.TargetGroup) {
+				tg.Spec.Protocol = elbv2api.TargetGroupProtocolHTTPS
+			},
+		},
+		{
+			name: "port is changed",
+			mutate: func(tg *elbv2api.TargetGroup) {
+				tg.Spec.Port = 9090
+			},
+			wantErr: errors.New("TargetGroup update may not change these fields: spec.port"),
+		},
+		{
+			name: "targetType is changed without referring targetGroupBindings",
+			tgbs: []*elbv2api.TargetGroupBinding{otherTGB},
+			mutate: func(tg *elbv2api.TargetGroup) {
+				tg.Spec.TargetType = elbv2api.TargetTypeIP
+			},
+		},
+		{
+			name: "targetType and ipAddressType are changed with referring targetGroupBindings",
+			tgbs: []*elbv2api.TargetGroupBinding{otherTGB, referringTGB},
+			mutate: func(tg *elbv2api.TargetGroup) {
+				tg.Spec.TargetType = elbv2api.TargetTypeIP
+				tg.Spec.IPAddressType = &ipv6AddressType
+			},
+			wantErr: errors.New("TargetGroup update may not change these fields while TargetGroupBinding awesome-ns/awesome-tgb refers to it: spec.targetType,spec.ipAddressType"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, tgb := range tt.tgbs {
+				assert.NoError(t, k8sClient.Create(context.Background(), tgb.DeepCopy()))
+			}
+			v := NewTargetGroupValidator(k8sClient, &log.NullLogger{})
+			tg := oldTG.DeepCopy()
+			tt.mutate(tg)
+			err := v.ValidateUpdate(context.Background(), tg, oldTG)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
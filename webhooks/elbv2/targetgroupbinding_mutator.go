@@ -7,18 +7,21 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 const apiPathMutateELBv2TargetGroupBinding = "/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding"
 
 // NewTargetGroupBindingMutator returns a mutator for TargetGroupBinding CRD.
-func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, logger logr.Logger) *targetGroupBindingMutator {
+func NewTargetGroupBindingMutator(k8sClient client.Client, elbv2Client services.ELBV2, logger logr.Logger) *targetGroupBindingMutator {
 	return &targetGroupBindingMutator{
+		k8sClient:   k8sClient,
 		elbv2Client: elbv2Client,
 		logger:      logger,
 	}
@@ -27,6 +30,7 @@ func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, logger logr.Logger
 var _ webhook.Mutator = &targetGroupBindingMutator{}
 
 type targetGroupBindingMutator struct {
+	k8sClient   client.Client
 	elbv2Client services.ELBV2
 	logger      logr.Logger
 }
@@ -37,6 +41,9 @@ func (m *targetGroupBindingMutator) Prototype(_ admission.Request) (runtime.Obje
 
 func (m *targetGroupBindingMutator) MutateCreate(ctx context.Context, obj runtime.Object) (runtime.Object, error) {
 	tgb := obj.(*elbv2api.TargetGroupBinding)
+	if err := m.defaultingFromTargetGroup(ctx, tgb); err != nil {
+		return nil, err
+	}
 	if err := m.defaultingTargetType(ctx, tgb); err != nil {
 		return nil, err
 	}
@@ -47,6 +54,42 @@ func (m *targetGroupBindingMutator) MutateUpdate(ctx context.Context, obj runtim
 	return obj, nil
 }
 
+// defaultingFromTargetGroup resolves targetGroupARN, targetType and ipAddressType from the TargetGroup referenced by targetGroupName.
+// targetGroupARN is left empty if the TargetGroup isn't provisioned yet, which will be resolved by the TargetGroupBinding reconciler.
+func (m *targetGroupBindingMutator) defaultingFromTargetGroup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if tgb.Spec.TargetGroupName == "" {
+		if tgb.Spec.TargetGroupARN == "" {
+			return errors.New("either targetGroupARN or targetGroupName must be specified")
+		}
+		return nil
+	}
+	tgKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.TargetGroupName}
+	tg := &elbv2api.TargetGroup{}
+	if err := m.k8sClient.Get(ctx, tgKey, tg); err != nil {
+		return errors.Wrapf(err, "couldn't load TargetGroup: %v", tgKey)
+	}
+	if tgb.Spec.TargetType == nil {
+		targetType := tg.Spec.TargetType
+		tgb.Spec.TargetType = &targetType
+	}
+	if tgb.Spec.IPAddressType == nil && tg.Spec.IPAddressType != nil {
+		ipAddressType := *tg.Spec.IPAddressType
+		tgb.Spec.IPAddressType = &ipAddressType
+	}
+	if tg.Status.TargetGroupARN == nil {
+		if tgb.Spec.TargetGroupARN != "" {
+			return errors.Errorf("targetGroupARN %v mismatches TargetGroup %v that isn't provisioned yet", tgb.Spec.TargetGroupARN, tgKey)
+		}
+		return nil
+	}
+	tgARN := awssdk.StringValue(tg.Status.TargetGroupARN)
+	if tgb.Spec.TargetGroupARN != "" && tgb.Spec.TargetGroupARN != tgARN {
+		return errors.Errorf("targetGroupARN %v mismatches TargetGroup %v: %v", tgb.Spec.TargetGroupARN, tgKey, tgARN)
+	}
+	tgb.Spec.TargetGroupARN = tgARN
+	return nil
+}
+
 func (m *targetGroupBindingMutator) defaultingTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	if tgb.Spec.TargetType != nil {
 		return nil
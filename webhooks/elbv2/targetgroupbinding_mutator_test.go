@@ -7,8 +7,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
 )
@@ -22,10 +26,12 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 
 	type fields struct {
 		describeTargetGroupsAsListCalls []describeTargetGroupsAsListCall
+		targetGroups                    []*elbv2api.TargetGroup
 	}
 
 	instanceTargetType := elbv2api.TargetTypeInstance
 	ipTargetType := elbv2api.TargetTypeIP
+	ipv6AddressType := elbv2api.TargetGroupIPAddressTypeIPv6
 	type args struct {
 		obj *elbv2api.TargetGroupBinding
 	}
@@ -147,6 +153,127 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 			},
 			wantErr: errors.New("unsupported TargetType: lambda"),
 		},
+		{
+			name: "targetGroupBinding with TargetGroupName will be resolved to TargetGroupARN",
+			fields: fields{
+				targetGroups: []*elbv2api.TargetGroup{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+						Status: elbv2api.TargetGroupStatus{
+							TargetGroupARN: awssdk.String("tg-1"),
+						},
+					},
+				},
+			},
+			args: args{
+				obj: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupName: "awesome-tg",
+						TargetType:      &instanceTargetType,
+					},
+				},
+			},
+			want: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:  "tg-1",
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+		},
+		{
+			name: "targetGroupBinding with TargetGroupName that isn't provisioned yet",
+			fields: fields{
+				targetGroups: []*elbv2api.TargetGroup{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+					},
+				},
+			},
+			args: args{
+				obj: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupName: "awesome-tg",
+						TargetType:      &instanceTargetType,
+					},
+				},
+			},
+			want: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupName: "awesome-tg",
+					TargetType:      &instanceTargetType,
+				},
+			},
+		},
+		{
+			name: "targetGroupBinding with TargetGroupName will be defaulted with TargetType and IPAddressType of TargetGroup",
+			fields: fields{
+				targetGroups: []*elbv2api.TargetGroup{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+						Spec: elbv2api.TargetGroupSpec{
+							TargetType:    elbv2api.TargetTypeIP,
+							IPAddressType: &ipv6AddressType,
+						},
+					},
+				},
+			},
+			args: args{
+				obj: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupName: "awesome-tg",
+					},
+				},
+			},
+			want: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupName: "awesome-tg",
+					TargetType:      &ipTargetType,
+					IPAddressType:   &ipv6AddressType,
+				},
+			},
+		},
+		{
+			name: "targetGroupBinding with TargetGroupName mismatches TargetGroupARN",
+			fields: fields{
+				targetGroups: []*elbv2api.TargetGroup{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tg"},
+						Status: elbv2api.TargetGroupStatus{
+							TargetGroupARN: awssdk.String("tg-1"),
+						},
+					},
+				},
+			},
+			args: args{
+				obj: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-tgb"},
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:  "tg-2",
+						TargetGroupName: "awesome-tg",
+						TargetType:      &instanceTargetType,
+					},
+				},
+			},
+			wantErr: errors.New("targetGroupARN tg-2 mismatches TargetGroup awesome-ns/awesome-tg: tg-1"),
+		},
+		{
+			name: "targetGroupBinding without TargetGroupARN and TargetGroupName",
+			args: args{
+				obj: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType: &instanceTargetType,
+					},
+				},
+			},
+			wantErr: errors.New("either targetGroupARN or targetGroupName must be specified"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -156,8 +283,16 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 			for _, call := range tt.fields.describeTargetGroupsAsListCalls {
 				elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), call.req).Return(call.resp, call.err)
 			}
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, tg := range tt.fields.targetGroups {
+				assert.NoError(t, k8sClient.Create(context.Background(), tg.DeepCopy()))
+			}
 
 			m := &targetGroupBindingMutator{
+				k8sClient:   k8sClient,
 				elbv2Client: elbv2Client,
 				logger:      &log.NullLogger{},
 			}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"strings"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
@@ -59,6 +61,9 @@ func (v *targetGroupBindingValidator) ValidateUpdate(ctx context.Context, obj ru
 	if err := v.checkImmutableFields(tgb, oldTgb); err != nil {
 		return err
 	}
+	if err := v.checkResolvedTargetGroupARN(ctx, tgb, oldTgb); err != nil {
+		return err
+	}
 	if err := v.checkNodeSelector(tgb); err != nil {
 		return err
 	}
@@ -84,15 +89,28 @@ func (v *targetGroupBindingValidator) checkRequiredFields(tgb *elbv2api.TargetGr
 // checkImmutableFields will check immutable fields are not changed.
 func (v *targetGroupBindingValidator) checkImmutableFields(tgb *elbv2api.TargetGroupBinding, oldTGB *elbv2api.TargetGroupBinding) error {
 	var changedImmutableFields []string
-	if tgb.Spec.TargetGroupARN != oldTGB.Spec.TargetGroupARN {
+	// targetGroupARN of TargetGroupBinding referring TargetGroup by name is resolved by controller, see checkResolvedTargetGroupARN.
+	if tgb.Spec.TargetGroupARN != oldTGB.Spec.TargetGroupARN && tgb.Spec.TargetGroupName == "" {
 		changedImmutableFields = append(changedImmutableFields, "spec.targetGroupARN")
 	}
+	if tgb.Spec.TargetGroupName != oldTGB.Spec.TargetGroupName {
+		changedImmutableFields = append(changedImmutableFields, "spec.targetGroupName")
+	}
 	if (tgb.Spec.TargetType == nil) != (oldTGB.Spec.TargetType == nil) {
 		changedImmutableFields = append(changedImmutableFields, "spec.targetType")
 	}
 	if tgb.Spec.TargetType != nil && oldTGB.Spec.TargetType != nil && (*tgb.Spec.TargetType) != (*oldTGB.Spec.TargetType) {
 		changedImmutableFields = append(changedImmutableFields, "spec.targetType")
 	}
+	if tgb.Spec.MultiClusterTargetGroup != oldTGB.Spec.MultiClusterTargetGroup {
+		changedImmutableFields = append(changedImmutableFields, "spec.multiClusterTargetGroup")
+	}
+	if (tgb.Spec.IPAddressType == nil) != (oldTGB.Spec.IPAddressType == nil) {
+		changedImmutableFields = append(changedImmutableFields, "spec.ipAddressType")
+	}
+	if tgb.Spec.IPAddressType != nil && oldTGB.Spec.IPAddressType != nil && (*tgb.Spec.IPAddressType) != (*oldTGB.Spec.IPAddressType) {
+		changedImmutableFields = append(changedImmutableFields, "spec.ipAddressType")
+	}
 
 	if len(changedImmutableFields) != 0 {
 		return errors.Errorf("%s update may not change these fields: %s", "TargetGroupBinding", strings.Join(changedImmutableFields, ","))
@@ -100,6 +118,24 @@ func (v *targetGroupBindingValidator) checkImmutableFields(tgb *elbv2api.TargetG
 	return nil
 }
 
+// checkResolvedTargetGroupARN will check targetGroupARN is only changed to the ARN of TargetGroup referred by targetGroupName.
+// the ARN is resolved by controller once the TargetGroup is provisioned, and is changed if the TargetGroup is replaced.
+func (v *targetGroupBindingValidator) checkResolvedTargetGroupARN(ctx context.Context, tgb *elbv2api.TargetGroupBinding, oldTGB *elbv2api.TargetGroupBinding) error {
+	if tgb.Spec.TargetGroupName == "" || tgb.Spec.TargetGroupARN == oldTGB.Spec.TargetGroupARN {
+		return nil
+	}
+	tgKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.TargetGroupName}
+	tg := &elbv2api.TargetGroup{}
+	if err := v.k8sClient.Get(ctx, tgKey, tg); err != nil {
+		return errors.Wrapf(err, "couldn't load TargetGroup: %v", tgKey)
+	}
+	if tgb.Spec.TargetGroupARN != awssdk.StringValue(tg.Status.TargetGroupARN) {
+		return errors.Errorf("targetGroupARN %v mismatches TargetGroup %v: %v",
+			tgb.Spec.TargetGroupARN, tgKey, awssdk.StringValue(tg.Status.TargetGroupARN))
+	}
+	return nil
+}
+
 // checkExistingTargetGroups will check for unique TargetGroup per TargetGroupBinding
 func (v *targetGroupBindingValidator) checkExistingTargetGroups(tgb *elbv2api.TargetGroupBinding) error {
 	ctx := context.Background()
@@ -108,7 +144,11 @@ func (v *targetGroupBindingValidator) checkExistingTargetGroups(tgb *elbv2api.Ta
 		return errors.Wrap(err, "failed to list TargetGroupBindings in the cluster")
 	}
 	for _, tgbObj := range tgbList.Items {
-		if tgbObj.Spec.TargetGroupARN == tgb.Spec.TargetGroupARN {
+		if tgb.Spec.TargetGroupName != "" && tgbObj.Namespace == tgb.Namespace && tgbObj.Spec.TargetGroupName == tgb.Spec.TargetGroupName {
+			return errors.Errorf("TargetGroup %v is already bound to TargetGroupBinding %v", tgb.Spec.TargetGroupName, k8s.NamespacedName(&tgbObj).String())
+		}
+		// targetGroupARN of TargetGroupBinding referring TargetGroup that isn't provisioned yet is empty.
+		if tgb.Spec.TargetGroupARN != "" && tgbObj.Spec.TargetGroupARN == tgb.Spec.TargetGroupARN {
 			return errors.Errorf("TargetGroup %v is already bound to TargetGroupBinding %v", tgb.Spec.TargetGroupARN, k8s.NamespacedName(&tgbObj).String())
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -239,6 +240,8 @@ func Test_targetGroupBindingValidator_checkImmutableFields(t *testing.T) {
 	}
 	instanceTargetType := elbv2api.TargetTypeInstance
 	ipTargetType := elbv2api.TargetTypeIP
+	ipv4AddressType := elbv2api.TargetGroupIPAddressTypeIPv4
+	ipv6AddressType := elbv2api.TargetGroupIPAddressTypeIPv6
 	tests := []struct {
 		name    string
 		args    args
@@ -262,6 +265,45 @@ func Test_targetGroupBindingValidator_checkImmutableFields(t *testing.T) {
 			},
 			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.targetGroupARN"),
 		},
+		{
+			name: "targetGroupARN is resolved from targetGroupName",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:  "tg-1",
+						TargetGroupName: "tg-a",
+						TargetType:      &instanceTargetType,
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupName: "tg-a",
+						TargetType:      &instanceTargetType,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "targetGroupName is changed",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:  "tg-1",
+						TargetGroupName: "tg-b",
+						TargetType:      &instanceTargetType,
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:  "tg-1",
+						TargetGroupName: "tg-a",
+						TargetType:      &instanceTargetType,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.targetGroupName"),
+		},
 		{
 			name: "targetType is changed",
 			args: args{
@@ -316,6 +358,64 @@ func Test_targetGroupBindingValidator_checkImmutableFields(t *testing.T) {
 			},
 			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.targetType"),
 		},
+		{
+			name: "multiClusterTargetGroup is changed",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:          "tg-1",
+						TargetType:              &ipTargetType,
+						MultiClusterTargetGroup: true,
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "tg-1",
+						TargetType:     &ipTargetType,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.multiClusterTargetGroup"),
+		},
+		{
+			name: "ipAddressType is changed",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "tg-1",
+						TargetType:     &ipTargetType,
+						IPAddressType:  &ipv6AddressType,
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "tg-1",
+						TargetType:     &ipTargetType,
+						IPAddressType:  &ipv4AddressType,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.ipAddressType"),
+		},
+		{
+			name: "ipAddressType is changed from unset to set",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "tg-1",
+						TargetType:     &ipTargetType,
+						IPAddressType:  &ipv6AddressType,
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "tg-1",
+						TargetType:     &ipTargetType,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.ipAddressType"),
+		},
 		{
 			name: "both targetGroupARN and targetType are changed",
 			args: args{
@@ -635,6 +735,71 @@ func Test_targetGroupBindingValidator_checkExistingTargetGroups(t *testing.T) {
 			},
 			wantErr: errors.New("TargetGroup tg-111 is already bound to TargetGroupBinding ns2/tgb2"),
 		},
+		{
+			name: "[ok] no duplicate target groups - target groups not provisioned yet",
+			env: env{
+				existingTGBs: []elbv2api.TargetGroupBinding{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "tgb1",
+							Namespace: "ns1",
+						},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupName: "tg-a",
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "tgb2",
+							Namespace: "ns2",
+						},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupName: "tg-b",
+						},
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tgb3",
+						Namespace: "ns1",
+					},
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupName: "tg-b",
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] duplicate target groups - target group not provisioned yet",
+			env: env{
+				existingTGBs: []elbv2api.TargetGroupBinding{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "tgb1",
+							Namespace: "ns1",
+						},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupName: "tg-a",
+						},
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tgb2",
+						Namespace: "ns1",
+					},
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupName: "tg-a",
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroup tg-a is already bound to TargetGroupBinding ns1/tgb1"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -658,3 +823,121 @@ func Test_targetGroupBindingValidator_checkExistingTargetGroups(t *testing.T) {
 		})
 	}
 }
+
+func Test_targetGroupBindingValidator_checkResolvedTargetGroupARN(t *testing.T) {
+	type env struct {
+		existingTGs []elbv2api.TargetGroup
+	}
+	type args struct {
+		tgb    *elbv2api.TargetGroupBinding
+		oldTGB *elbv2api.TargetGroupBinding
+	}
+	tests := []struct {
+		name    string
+		env     env
+		args    args
+		wantErr error
+	}{
+		{
+			name: "[ok] targetGroupARN is resolved to the ARN of targetGroup",
+			env: env{
+				existingTGs: []elbv2api.TargetGroup{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tg-a"},
+						Status:     elbv2api.TargetGroupStatus{TargetGroupARN: awssdk.String("tg-1")},
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-1", TargetGroupName: "tg-a"},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupName: "tg-a"},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[ok] targetGroupARN is re-resolved to the ARN of replaced targetGroup",
+			env: env{
+				existingTGs: []elbv2api.TargetGroup{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tg-a"},
+						Status:     elbv2api.TargetGroupStatus{TargetGroupARN: awssdk.String("tg-2")},
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-2", TargetGroupName: "tg-a"},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-1", TargetGroupName: "tg-a"},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] targetGroupARN mismatches the ARN of targetGroup",
+			env: env{
+				existingTGs: []elbv2api.TargetGroup{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tg-a"},
+						Status:     elbv2api.TargetGroupStatus{TargetGroupARN: awssdk.String("tg-1")},
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-other", TargetGroupName: "tg-a"},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupName: "tg-a"},
+				},
+			},
+			wantErr: errors.New("targetGroupARN tg-other mismatches TargetGroup ns1/tg-a: tg-1"),
+		},
+		{
+			name: "[ok] targetGroupARN isn't changed",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-1", TargetGroupName: "tg-a"},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tgb1"},
+					Spec:       elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-1", TargetGroupName: "tg-a"},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			v := &targetGroupBindingValidator{
+				k8sClient: k8sClient,
+				logger:    &log.NullLogger{},
+			}
+			for _, tg := range tt.env.existingTGs {
+				assert.NoError(t, k8sClient.Create(context.Background(), tg.DeepCopy()))
+			}
+			err := v.checkResolvedTargetGroupARN(context.Background(), tt.args.tgb, tt.args.oldTGB)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			}
+		})
+	}
+}